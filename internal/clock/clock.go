@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clock
+
+import (
+	"context"
+	"time"
+)
+
+// Clock is a source of time.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After waits for the duration to elapse and then sends the current
+	// time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// Real returns a Clock which uses the system time.
+func Real() Clock {
+	return realClock{}
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// contextKey is a context.Context key to store the Clock.
+// Reference: https://staticcheck.io/docs/checks/#SA1029
+type contextKey struct{}
+
+// NewContext returns a context with the Clock stored.
+func NewContext(ctx context.Context, c Clock) context.Context {
+	return context.WithValue(ctx, contextKey{}, c)
+}
+
+// FromContext returns the Clock stored in the context, or the Real clock if
+// none is stored.
+func FromContext(ctx context.Context) Clock {
+	c, ok := ctx.Value(contextKey{}).(Clock)
+
+	if !ok || c == nil {
+		return Real()
+	}
+
+	return c
+}
+
+// Since returns the time elapsed since t according to the Clock stored in the
+// context.
+func Since(ctx context.Context, t time.Time) time.Duration {
+	return FromContext(ctx).Now().Sub(t)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clock_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/internal/clock"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c testClock) Now() time.Time {
+	return c.now
+}
+
+func (c testClock) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- c.now.Add(d)
+
+	return ch
+}
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := map[string]struct {
+		ctx      context.Context
+		expected time.Time
+	}{
+		"missing": {
+			ctx: context.Background(),
+		},
+		"nil": {
+			ctx: clock.NewContext(context.Background(), nil),
+		},
+		"clock": {
+			ctx:      clock.NewContext(context.Background(), testClock{now: now}),
+			expected: now,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := clock.FromContext(testCase.ctx)
+
+			if got == nil {
+				t.Fatal("expected clock, got nil")
+			}
+
+			if !testCase.expected.IsZero() && !got.Now().Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got.Now())
+			}
+		})
+	}
+}
+
+func TestSince(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	ctx := clock.NewContext(context.Background(), testClock{now: now})
+
+	got := clock.Since(ctx, now.Add(-3*time.Second))
+
+	if got != 3*time.Second {
+		t.Errorf("expected 3s, got %s", got)
+	}
+}
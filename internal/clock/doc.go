@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package clock contains the time source abstraction used by the protocol
+// servers, so time dependent behaviors can be tested deterministically.
+package clock
@@ -11,11 +11,33 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
 
+// ActionContext injects the action type into logger contexts.
+func ActionContext(ctx context.Context, action string) context.Context {
+	ctx = tfsdklog.SetField(ctx, KeyActionType, action)
+	ctx = tfsdklog.SubsystemSetField(ctx, SubsystemProto, KeyActionType, action)
+	ctx = tflog.SetField(ctx, KeyActionType, action)
+	ctx = context.WithValue(ctx, ContextKeyActionType{}, action)
+
+	return ctx
+}
+
 // DataSourceContext injects the data source type into logger contexts.
 func DataSourceContext(ctx context.Context, dataSource string) context.Context {
 	ctx = tfsdklog.SetField(ctx, KeyDataSourceType, dataSource)
 	ctx = tfsdklog.SubsystemSetField(ctx, SubsystemProto, KeyDataSourceType, dataSource)
 	ctx = tflog.SetField(ctx, KeyDataSourceType, dataSource)
+	ctx = context.WithValue(ctx, ContextKeyDataSourceType{}, dataSource)
+
+	return ctx
+}
+
+// EphemeralResourceContext injects the ephemeral resource type into logger
+// contexts.
+func EphemeralResourceContext(ctx context.Context, ephemeralResource string) context.Context {
+	ctx = tfsdklog.SetField(ctx, KeyEphemeralResourceType, ephemeralResource)
+	ctx = tfsdklog.SubsystemSetField(ctx, SubsystemProto, KeyEphemeralResourceType, ephemeralResource)
+	ctx = tflog.SetField(ctx, KeyEphemeralResourceType, ephemeralResource)
+	ctx = context.WithValue(ctx, ContextKeyEphemeralResourceType{}, ephemeralResource)
 
 	return ctx
 }
@@ -54,6 +76,7 @@ func ProviderAddressContext(ctx context.Context, providerAddress string) context
 	ctx = tfsdklog.SetField(ctx, KeyProviderAddress, providerAddress)
 	ctx = tfsdklog.SubsystemSetField(ctx, SubsystemProto, KeyProviderAddress, providerAddress)
 	ctx = tflog.SetField(ctx, KeyProviderAddress, providerAddress)
+	ctx = context.WithValue(ctx, ContextKeyProviderAddress{}, providerAddress)
 
 	return ctx
 }
@@ -69,6 +92,7 @@ func RequestIdContext(ctx context.Context) context.Context {
 	ctx = tfsdklog.SetField(ctx, KeyRequestID, reqID)
 	ctx = tfsdklog.SubsystemSetField(ctx, SubsystemProto, KeyRequestID, reqID)
 	ctx = tflog.SetField(ctx, KeyRequestID, reqID)
+	ctx = context.WithValue(ctx, ContextKeyRequestID{}, reqID)
 
 	return ctx
 }
@@ -78,6 +102,7 @@ func ResourceContext(ctx context.Context, resource string) context.Context {
 	ctx = tfsdklog.SetField(ctx, KeyResourceType, resource)
 	ctx = tfsdklog.SubsystemSetField(ctx, SubsystemProto, KeyResourceType, resource)
 	ctx = tflog.SetField(ctx, KeyResourceType, resource)
+	ctx = context.WithValue(ctx, ContextKeyResourceType{}, resource)
 
 	return ctx
 }
@@ -87,6 +112,25 @@ func RpcContext(ctx context.Context, rpc string) context.Context {
 	ctx = tfsdklog.SetField(ctx, KeyRPC, rpc)
 	ctx = tfsdklog.SubsystemSetField(ctx, SubsystemProto, KeyRPC, rpc)
 	ctx = tflog.SetField(ctx, KeyRPC, rpc)
+	ctx = context.WithValue(ctx, ContextKeyRPC{}, rpc)
 
 	return ctx
 }
+
+// StateStoreContext injects the state store type into logger contexts.
+func StateStoreContext(ctx context.Context, stateStore string) context.Context {
+	ctx = tfsdklog.SetField(ctx, KeyStateStoreType, stateStore)
+	ctx = tfsdklog.SubsystemSetField(ctx, SubsystemProto, KeyStateStoreType, stateStore)
+	ctx = tflog.SetField(ctx, KeyStateStoreType, stateStore)
+	ctx = context.WithValue(ctx, ContextKeyStateStoreType{}, stateStore)
+
+	return ctx
+}
+
+// StringFromContext returns the string value stored in the context under the
+// given key, such as ContextKeyRPC{}, and whether it was found.
+func StringFromContext(ctx context.Context, key any) (string, bool) {
+	value, ok := ctx.Value(key).(string)
+
+	return value, ok
+}
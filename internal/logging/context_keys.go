@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+// Context key types.
+// Reference: https://staticcheck.io/docs/checks/#SA1029
+
+// ContextKeyActionType is a context.Context key to store the action type of
+// the request.
+type ContextKeyActionType struct{}
+
+// ContextKeyDataSourceType is a context.Context key to store the data source
+// type of the request.
+type ContextKeyDataSourceType struct{}
+
+// ContextKeyEphemeralResourceType is a context.Context key to store the
+// ephemeral resource type of the request.
+type ContextKeyEphemeralResourceType struct{}
+
+// ContextKeyProviderAddress is a context.Context key to store the provider
+// address.
+type ContextKeyProviderAddress struct{}
+
+// ContextKeyRequestID is a context.Context key to store the unique request
+// ID.
+type ContextKeyRequestID struct{}
+
+// ContextKeyResourceType is a context.Context key to store the resource type
+// of the request.
+type ContextKeyResourceType struct{}
+
+// ContextKeyRPC is a context.Context key to store the RPC name of the
+// request.
+type ContextKeyRPC struct{}
+
+// ContextKeyStateStoreType is a context.Context key to store the state store
+// type of the request.
+type ContextKeyStateStoreType struct{}
@@ -22,4 +22,9 @@ const (
 	// EnvTfLogSdkProtoDataDir is an environment variable that sets the
 	// directory to write raw protocol data files for debugging purposes.
 	EnvTfLogSdkProtoDataDir = "TF_LOG_SDK_PROTO_DATA_DIR"
+
+	// EnvTfLogSdkProtoDumpDir is an environment variable that sets the
+	// directory to write every raw protocol buffers request and response
+	// message, along with its JSON rendering, for debugging purposes.
+	EnvTfLogSdkProtoDumpDir = "TF_LOG_SDK_PROTO_DUMP_DIR"
 )
@@ -38,9 +38,15 @@ const (
 	// Message of the function error.
 	KeyFunctionErrorText = "function_error_text"
 
+	// The cause of a request context cancellation
+	KeyContextCancelCause = "tf_context_cancel_cause"
+
 	// Duration in milliseconds for the RPC request
 	KeyRequestDurationMs = "tf_req_duration_ms"
 
+	// Duration in milliseconds after which an RPC request is considered slow
+	KeySlowRPCThresholdMs = "tf_slow_rpc_threshold_ms"
+
 	// A unique ID for the RPC request
 	KeyRequestID = "tf_req_id"
 
@@ -51,12 +57,30 @@ const (
 	// The RPC being run, such as "ApplyResourceChange"
 	KeyRPC = "tf_rpc"
 
+	// The protocol version 5 gRPC method name of a deprecated RPC
+	KeyRPCProtocolV5Method = "tf_rpc_proto_v5_method"
+
+	// The protocol version 6 gRPC method name equivalent of a deprecated RPC
+	KeyRPCProtocolV6Equivalent = "tf_rpc_proto_v6_equivalent"
+
 	// The type of resource being operated on, such as "random_pet"
 	KeyResourceType = "tf_resource_type"
 
 	// The type of data source being operated on, such as "archive_file"
 	KeyDataSourceType = "tf_data_source_type"
 
+	// The type of ephemeral resource being operated on, such as "random_password"
+	KeyEphemeralResourceType = "tf_ephemeral_resource_type"
+
+	// The type of action being invoked, such as "examplecloud_restart"
+	KeyActionType = "tf_action_type"
+
+	// The type of state store being operated on, such as "examplecloud_bucket"
+	KeyStateStoreType = "tf_state_store_type"
+
+	// The negotiated size in bytes of state chunks, such as 1048576
+	KeyStateStoreChunkSize = "tf_state_store_chunk_size"
+
 	// Path to protocol data file, such as "/tmp/example.json"
 	KeyProtocolDataFile = "tf_proto_data_file"
 
@@ -74,4 +98,16 @@ const (
 
 	// Whether the DeferralAllowed client capability is enabled
 	KeyClientCapabilityDeferralAllowed = "tf_client_capability_deferral_allowed"
+
+	// Whether the WriteOnlyAttributesAllowed client capability is enabled
+	KeyClientCapabilityWriteOnlyAttributesAllowed = "tf_client_capability_write_only_attributes_allowed"
+
+	// Attribute path of the plan annotation being logged.
+	KeyPlanAnnotationAttribute = "tf_plan_annotation_attribute"
+
+	// Kind of the plan annotation being logged.
+	KeyPlanAnnotationKind = "tf_plan_annotation_kind"
+
+	// Reason of the plan annotation being logged.
+	KeyPlanAnnotationReason = "tf_plan_annotation_reason"
 )
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// fileExtProtobuf is the file extension for binary protocol buffers
+	// message data.
+	fileExtProtobuf = "binpb"
+)
+
+// protocolMessageSequence is incremented for every protocol message written,
+// so file names sort in the order messages were handled by the process.
+var protocolMessageSequence atomic.Uint64
+
+// ProtocolMessage emits a raw protocol buffers message, along with its JSON
+// rendering, to files, if given a directory.
+//
+// The directory must exist and be writable, prior to invoking this function.
+//
+// File names are in the format: {SEQUENCE}_{RPC}_{MESSAGE}.{binpb|json}
+func ProtocolMessage(ctx context.Context, dumpDir string, rpc string, message string, msg proto.Message) {
+	if dumpDir == "" {
+		return
+	}
+
+	fileName := fmt.Sprintf("%06d_%s_%s", protocolMessageSequence.Add(1), rpc, message)
+
+	binaryContents, err := proto.Marshal(msg)
+
+	if err != nil {
+		ProtocolError(ctx, "Unable to marshal protocol message", map[string]any{KeyError: err.Error()})
+		return
+	}
+
+	writeProtocolMessageFile(ctx, path.Join(dumpDir, fileName+"."+fileExtProtobuf), binaryContents)
+
+	jsonContents, err := protojson.MarshalOptions{Multiline: true}.Marshal(msg)
+
+	if err != nil {
+		ProtocolError(ctx, "Unable to marshal protocol message as JSON", map[string]any{KeyError: err.Error()})
+		return
+	}
+
+	writeProtocolMessageFile(ctx, path.Join(dumpDir, fileName+"."+fileExtJson), jsonContents)
+}
+
+func writeProtocolMessageFile(ctx context.Context, filePath string, fileContents []byte) {
+	ctx = ProtocolSetField(ctx, KeyProtocolDataFile, filePath)
+
+	ProtocolTrace(ctx, "Writing protocol message file")
+
+	err := os.WriteFile(filePath, fileContents, 0644)
+
+	if err != nil {
+		ProtocolError(ctx, "Unable to write protocol message file", map[string]any{KeyError: err.Error()})
+		return
+	}
+
+	ProtocolTrace(ctx, "Wrote protocol message file")
+}
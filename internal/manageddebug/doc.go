@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package manageddebug contains the protocol version independent
+// implementation of the managed debug mode of the tf5server and tf6server
+// packages, so their behaviors cannot drift.
+package manageddebug
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package manageddebug
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/go-plugin"
+	tfaddr "github.com/hashicorp/terraform-registry-address"
+)
+
+// EnvTfReattachProviders is the environment variable used by Terraform CLI
+// to directly connect to already running provider processes, such as those
+// being inspected by debugging processes. When connecting to providers in
+// this manner, Terraform CLI disables certain plugin handshake checks and
+// will not stop the provider process.
+const EnvTfReattachProviders = "TF_REATTACH_PROVIDERS"
+
+// reattachConfig is the JSON format of each provider in the
+// TF_REATTACH_PROVIDERS environment variable.
+//
+// Duplicate implementation is required because the go-plugin
+// ReattachConfig.Addr implementation is not friendly for JSON encoding and to
+// avoid importing terraform-exec.
+type reattachConfig struct {
+	Protocol        string
+	ProtocolVersion int
+	Pid             int
+	Test            bool
+	Addr            reattachConfigAddr
+}
+
+// reattachConfigAddr is the JSON format of a provider address in the
+// TF_REATTACH_PROVIDERS environment variable.
+type reattachConfigAddr struct {
+	Network string
+	String  string
+}
+
+// ReattachConfigString returns the JSON value of the TF_REATTACH_PROVIDERS
+// environment variable for the provider name and go-plugin reattach
+// configuration, using addr as the address.
+func ReattachConfigString(name string, config *plugin.ReattachConfig, addr net.Addr) (string, error) {
+	reattachBytes, err := json.Marshal(map[string]reattachConfig{
+		name: {
+			Protocol:        string(config.Protocol),
+			ProtocolVersion: config.ProtocolVersion,
+			Pid:             config.Pid,
+			Test:            config.Test,
+			Addr: reattachConfigAddr{
+				Network: addr.Network(),
+				String:  addr.String(),
+			},
+		},
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("Error building reattach string: %w", err)
+	}
+
+	return string(reattachBytes), nil
+}
+
+// ParseReattachConfigs returns the go-plugin reattach configuration of each
+// provider in the JSON value of the TF_REATTACH_PROVIDERS environment
+// variable, keyed by fully qualified provider address, such as
+// registry.terraform.io/hashicorp/example. Provider addresses are parsed the
+// same way as Terraform CLI, so the value can use short addresses, such as
+// hashicorp/example.
+func ParseReattachConfigs(value string) (map[string]*plugin.ReattachConfig, error) {
+	var in map[string]reattachConfig
+
+	if err := json.Unmarshal([]byte(value), &in); err != nil {
+		return nil, fmt.Errorf("Error parsing %s: %w", EnvTfReattachProviders, err)
+	}
+
+	configs := make(map[string]*plugin.ReattachConfig, len(in))
+
+	for name, c := range in {
+		providerAddress, err := NormalizeProviderAddress(name)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing %s: %w", EnvTfReattachProviders, err)
+		}
+
+		var addr net.Addr
+
+		switch c.Addr.Network {
+		case "unix":
+			addr, err = net.ResolveUnixAddr("unix", c.Addr.String)
+		case "tcp":
+			addr, err = net.ResolveTCPAddr("tcp", c.Addr.String)
+		default:
+			err = fmt.Errorf("unknown address type %q", c.Addr.Network)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing %s address for %q: %w", EnvTfReattachProviders, name, err)
+		}
+
+		configs[providerAddress] = &plugin.ReattachConfig{
+			Protocol:        plugin.Protocol(c.Protocol),
+			ProtocolVersion: c.ProtocolVersion,
+			Pid:             c.Pid,
+			Test:            c.Test,
+			Addr:            addr,
+		}
+	}
+
+	return configs, nil
+}
+
+// NormalizeProviderAddress returns the fully qualified form of a provider
+// address, such as registry.terraform.io/hashicorp/example for
+// hashicorp/example.
+func NormalizeProviderAddress(providerAddress string) (string, error) {
+	provider, err := tfaddr.ParseProviderSource(providerAddress)
+
+	if err != nil {
+		return "", fmt.Errorf("invalid provider address %q: %w", providerAddress, err)
+	}
+
+	return provider.String(), nil
+}
+
+// WriteReattachConfig writes human friendly instructions for setting the
+// reattach configuration in the current platform's shells.
+func WriteReattachConfig(w io.Writer, reattachStr string) {
+	fmt.Fprintf(w, "Provider started. To attach Terraform CLI, set the %s environment variable with the following:\n\n", EnvTfReattachProviders)
+
+	switch runtime.GOOS {
+	case "windows":
+		fmt.Fprintf(w, "\tCommand Prompt:\tset \"%s=%s\"\n", EnvTfReattachProviders, reattachStr)
+		fmt.Fprintf(w, "\tPowerShell:\t$env:%s='%s'\n", EnvTfReattachProviders, strings.ReplaceAll(reattachStr, `'`, `''`))
+	default:
+		fmt.Fprintf(w, "\t%s='%s'\n", EnvTfReattachProviders, strings.ReplaceAll(reattachStr, `'`, `'"'"'`))
+	}
+
+	fmt.Fprintln(w, "")
+}
+
+// WriteEnvFile writes the reattach configuration to the file at path in
+// dotenv format.
+func WriteEnvFile(path string, reattachStr string) error {
+	// Single quoted dotenv values are not interpolated, so only single
+	// quotes need escaping.
+	contents := fmt.Sprintf("%s='%s'\n", EnvTfReattachProviders, strings.ReplaceAll(reattachStr, `'`, `\'`))
+
+	if err := os.WriteFile(path, []byte(contents), 0600); err != nil {
+		return fmt.Errorf("Error writing reattach configuration env file: %w", err)
+	}
+
+	return nil
+}
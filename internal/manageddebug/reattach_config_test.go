@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package manageddebug
+
+import (
+	"bytes"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-plugin"
+)
+
+func TestReattachConfigString(t *testing.T) {
+	t.Parallel()
+
+	config := &plugin.ReattachConfig{
+		Protocol:        plugin.ProtocolGRPC,
+		ProtocolVersion: 5,
+		Pid:             123,
+		Test:            true,
+		Addr:            &net.UnixAddr{Name: "/tmp/plugin", Net: "unix"},
+	}
+
+	got, err := ReattachConfigString("registry.terraform.io/hashicorp/test", config, &net.UnixAddr{Name: "/tmp/proxy", Net: "unix"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"registry.terraform.io/hashicorp/test":{"Protocol":"grpc","ProtocolVersion":5,"Pid":123,"Test":true,"Addr":{"Network":"unix","String":"/tmp/proxy"}}}`
+
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestParseReattachConfigs(t *testing.T) {
+	t.Parallel()
+
+	config := &plugin.ReattachConfig{
+		Protocol:        plugin.ProtocolGRPC,
+		ProtocolVersion: 6,
+		Pid:             123,
+		Test:            true,
+		Addr:            &net.UnixAddr{Name: "/tmp/plugin", Net: "unix"},
+	}
+
+	reattachStr, err := ReattachConfigString("hashicorp/test", config, config.Addr)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := ParseReattachConfigs(reattachStr)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, map[string]*plugin.ReattachConfig{"registry.terraform.io/hashicorp/test": config}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestWriteReattachConfig(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("shell quoting differs on Windows")
+	}
+
+	var buf bytes.Buffer
+
+	WriteReattachConfig(&buf, `{"test":"it's"}`)
+
+	expected := "Provider started. To attach Terraform CLI, set the TF_REATTACH_PROVIDERS environment variable with the following:\n\n" +
+		"\tTF_REATTACH_PROVIDERS='{\"test\":\"it'\"'\"'s\"}'\n\n"
+
+	if got := buf.String(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestWriteEnvFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), ".env")
+
+	if err := WriteEnvFile(path, `{"test":"it's"}`); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error reading file: %s", err)
+	}
+
+	expected := `TF_REATTACH_PROVIDERS='{"test":"it\'s"}'` + "\n"
+
+	if string(got) != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package manageddebug
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+)
+
+// EnvRestartListenerFD is the environment variable used to pass the file
+// descriptor of the restart listener to the restarted provider process.
+const EnvRestartListenerFD = "TF_PLUGIN_DEBUG_RESTART_LISTENER_FD"
+
+// Proxy accepts connections on a listener which outlives the provider process
+// when it is restarted, forwarding each connection to the go-plugin server.
+// The go-plugin server always listens on a new address, so the proxy listener
+// address is the one given to Terraform.
+type Proxy struct {
+	listener net.Listener
+	target   net.Addr
+
+	// conns tracks the connections being forwarded, so they can be drained
+	// before the process is restarted.
+	conns sync.WaitGroup
+
+	// closed is set once the listener is closed or handed off, after which
+	// accepted connections are no longer tracked in conns.
+	closed   bool
+	closedMu sync.Mutex
+}
+
+// NewProxy returns a Proxy forwarding connections to the target address. The
+// listener is inherited from the previous provider process when restarted,
+// otherwise a new Unix domain socket is created.
+func NewProxy(target net.Addr) (*Proxy, error) {
+	listener, err := restartListener()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Proxy{
+		listener: listener,
+		target:   target,
+	}, nil
+}
+
+// restartListener returns the listener inherited from the
+// previous provider process, if any, or a new listener.
+func restartListener() (net.Listener, error) {
+	fdStr := os.Getenv(EnvRestartListenerFD)
+
+	if fdStr == "" {
+		dir, err := os.MkdirTemp("", "tf-plugin-debug")
+
+		if err != nil {
+			return nil, err
+		}
+
+		return net.Listen("unix", filepath.Join(dir, "plugin.sock"))
+	}
+
+	// The variable must not be inherited by any processes started by the
+	// provider.
+	if err := os.Unsetenv(EnvRestartListenerFD); err != nil {
+		return nil, err
+	}
+
+	fd, err := strconv.Atoi(fdStr)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s value %q: %w", EnvRestartListenerFD, fdStr, err)
+	}
+
+	listenerFile := os.NewFile(uintptr(fd), "managed-debug-listener")
+
+	defer func() {
+		_ = listenerFile.Close()
+	}()
+
+	return net.FileListener(listenerFile)
+}
+
+// Addr returns the address of the proxy listener.
+func (p *Proxy) Addr() net.Addr {
+	return p.listener.Addr()
+}
+
+// Close stops accepting connections and removes the Unix domain socket.
+func (p *Proxy) Close() error {
+	p.markClosed()
+
+	err := p.listener.Close()
+
+	// Inherited listeners do not remove the socket on close, so the
+	// temporary directory is always removed.
+	if p.listener.Addr().Network() == "unix" {
+		_ = os.RemoveAll(filepath.Dir(p.listener.Addr().String()))
+	}
+
+	return err
+}
+
+// Serve accepts connections until the listener is closed.
+func (p *Proxy) Serve() {
+	for {
+		conn, err := p.listener.Accept()
+
+		if err != nil {
+			return
+		}
+
+		if !p.track() {
+			_ = conn.Close()
+
+			return
+		}
+
+		go func() {
+			defer p.conns.Done()
+
+			p.forward(conn)
+		}()
+	}
+}
+
+// track adds a connection to conns, unless the listener was already closed
+// or handed off, in which case conns may already be drained and false is
+// returned.
+func (p *Proxy) track() bool {
+	p.closedMu.Lock()
+	defer p.closedMu.Unlock()
+
+	if p.closed {
+		return false
+	}
+
+	p.conns.Add(1)
+
+	return true
+}
+
+// markClosed stops tracking new connections. Any connection accepted
+// afterwards is closed instead of forwarded.
+func (p *Proxy) markClosed() {
+	p.closedMu.Lock()
+	defer p.closedMu.Unlock()
+
+	p.closed = true
+}
+
+// forward copies data between the connection and a new connection to the
+// target until either side is closed.
+func (p *Proxy) forward(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	targetConn, err := net.Dial(p.target.Network(), p.target.String())
+
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		_ = targetConn.Close()
+	}()
+
+	doneCh := make(chan struct{}, 2)
+
+	go func() {
+		_, _ = io.Copy(targetConn, conn)
+		doneCh <- struct{}{}
+	}()
+
+	go func() {
+		_, _ = io.Copy(conn, targetConn)
+		doneCh <- struct{}{}
+	}()
+
+	<-doneCh
+}
+
+// Handoff stops accepting connections and returns a file for the listener,
+// which can be inherited by the restarted provider process. The Unix domain
+// socket is kept, as it is used by the restarted process.
+func (p *Proxy) Handoff() (*os.File, error) {
+	unixListener, ok := p.listener.(*net.UnixListener)
+
+	if !ok {
+		return nil, fmt.Errorf("unsupported managed debug restart listener type %T", p.listener)
+	}
+
+	p.markClosed()
+
+	listenerFile, err := unixListener.File()
+
+	if err != nil {
+		return nil, err
+	}
+
+	unixListener.SetUnlinkOnClose(false)
+
+	if err := unixListener.Close(); err != nil {
+		_ = listenerFile.Close()
+
+		return nil, err
+	}
+
+	return listenerFile, nil
+}
+
+// Restart restarts the provider process in place, once all connections of
+// the proxy are drained. The stop function must stop the go-plugin server
+// and closeCh is closed once it is stopped. Nil is returned without
+// restarting if ctx is cancelled, such as by a stop signal, while waiting.
+func Restart(ctx context.Context, proxy *Proxy, stop func(), closeCh chan struct{}) error {
+	listenerFile, err := proxy.Handoff()
+
+	if err != nil {
+		return fmt.Errorf("Error handing off managed debug listener: %w", err)
+	}
+
+	defer func() {
+		_ = listenerFile.Close()
+	}()
+
+	fmt.Println("Restarting provider once active connections are closed.")
+
+	drainedCh := make(chan struct{})
+
+	go func() {
+		proxy.conns.Wait()
+		close(drainedCh)
+	}()
+
+	select {
+	case <-drainedCh:
+	case <-ctx.Done():
+		return nil
+	}
+
+	stop()
+	<-closeCh
+
+	executable, err := os.Executable()
+
+	if err != nil {
+		return fmt.Errorf("Error finding provider executable: %w", err)
+	}
+
+	// restartExec only returns on error.
+	return fmt.Errorf("Error restarting provider: %w", restartExec(executable, listenerFile))
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !unix
+
+package manageddebug
+
+import (
+	"errors"
+	"os"
+)
+
+// restartExec is not supported on this platform, which is verified
+// before the server is started.
+func restartExec(_ string, _ *os.File) error {
+	return errors.New("restarting the provider process is not supported on this platform")
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build unix
+
+package manageddebug
+
+import (
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestProxyHandoff(t *testing.T) { //nolint:paralleltest // t.Setenv cannot be used with t.Parallel
+	t.Setenv(EnvRestartListenerFD, "")
+
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("unexpected error creating target listener: %s", err)
+	}
+
+	defer func() {
+		_ = target.Close()
+	}()
+
+	// Echo server
+	go func() {
+		for {
+			conn, err := target.Accept()
+
+			if err != nil {
+				return
+			}
+
+			go func() {
+				_, _ = io.Copy(conn, conn)
+				_ = conn.Close()
+			}()
+		}
+	}()
+
+	proxy, err := NewProxy(target.Addr())
+
+	if err != nil {
+		t.Fatalf("unexpected error creating proxy: %s", err)
+	}
+
+	go proxy.Serve()
+
+	testProxyEcho(t, proxy.Addr())
+
+	// Active connections are drained before handoff completes.
+	proxy.conns.Wait()
+
+	listenerFile, err := proxy.Handoff()
+
+	if err != nil {
+		t.Fatalf("unexpected error handing off listener: %s", err)
+	}
+
+	if _, err := os.Stat(proxy.Addr().String()); err != nil {
+		t.Fatalf("expected socket to be kept after handoff: %s", err)
+	}
+
+	t.Setenv(EnvRestartListenerFD, strconv.Itoa(int(listenerFile.Fd())))
+
+	restartedProxy, err := NewProxy(target.Addr())
+
+	if err != nil {
+		t.Fatalf("unexpected error creating restarted proxy: %s", err)
+	}
+
+	_ = listenerFile.Close()
+
+	if os.Getenv(EnvRestartListenerFD) != "" {
+		t.Errorf("expected %s to be unset", EnvRestartListenerFD)
+	}
+
+	if got, expected := restartedProxy.Addr().String(), proxy.Addr().String(); got != expected {
+		t.Errorf("expected restarted address %q, got %q", expected, got)
+	}
+
+	go restartedProxy.Serve()
+
+	testProxyEcho(t, restartedProxy.Addr())
+
+	if err := restartedProxy.Close(); err != nil {
+		t.Fatalf("unexpected error closing restarted proxy: %s", err)
+	}
+
+	if _, err := os.Stat(restartedProxy.Addr().String()); !os.IsNotExist(err) {
+		t.Errorf("expected socket to be removed after close, got: %v", err)
+	}
+}
+
+func TestProxyTrackAfterHandoff(t *testing.T) { //nolint:paralleltest // t.Setenv cannot be used with t.Parallel
+	t.Setenv(EnvRestartListenerFD, "")
+
+	proxy, err := NewProxy(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+
+	if err != nil {
+		t.Fatalf("unexpected error creating proxy: %s", err)
+	}
+
+	if !proxy.track() {
+		t.Fatal("expected connection to be tracked before handoff")
+	}
+
+	proxy.conns.Done()
+
+	listenerFile, err := proxy.Handoff()
+
+	if err != nil {
+		t.Fatalf("unexpected error handing off listener: %s", err)
+	}
+
+	defer func() {
+		_ = listenerFile.Close()
+		_ = os.RemoveAll(filepath.Dir(proxy.Addr().String()))
+	}()
+
+	// A connection accepted while the listener is handed off must not be
+	// tracked, as draining may have already started waiting.
+	if proxy.track() {
+		proxy.conns.Done()
+
+		t.Error("expected connection to not be tracked after handoff")
+	}
+}
+
+func testProxyEcho(t *testing.T, addr net.Addr) {
+	t.Helper()
+
+	conn, err := net.Dial(addr.Network(), addr.String())
+
+	if err != nil {
+		t.Fatalf("unexpected error connecting to proxy: %s", err)
+	}
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	if _, err := conn.Write([]byte("test")); err != nil {
+		t.Fatalf("unexpected error writing: %s", err)
+	}
+
+	got := make([]byte, 4)
+
+	if _, err := io.ReadFull(conn, got); err != nil {
+		t.Fatalf("unexpected error reading: %s", err)
+	}
+
+	if string(got) != "test" {
+		t.Errorf("expected echo %q, got %q", "test", got)
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build unix
+
+package manageddebug
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// restartExec replaces the current process with a new execution of the
+// executable, which inherits the listener file. The process ID is unchanged,
+// so the Terraform CLI reattach configuration remains valid.
+func restartExec(executable string, listenerFile *os.File) error {
+	fd := listenerFile.Fd()
+
+	// Files are opened with the close-on-exec flag, which must be cleared
+	// for the new process to inherit the listener.
+	if _, _, errno := syscall.Syscall(syscall.SYS_FCNTL, fd, syscall.F_SETFD, 0); errno != 0 {
+		return errno
+	}
+
+	env := append(os.Environ(), fmt.Sprintf("%s=%d", EnvRestartListenerFD, fd))
+
+	return syscall.Exec(executable, os.Args, env)
+}
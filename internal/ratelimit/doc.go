@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ratelimit contains a token bucket rate limiter for limiting
+// downstream requests.
+package ratelimit
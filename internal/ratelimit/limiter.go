@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/internal/clock"
+)
+
+// Limiter is a token bucket rate limiter. Tokens are added at a rate of
+// limit per second, up to burst tokens, and each request takes one token.
+// Time is read from the clock stored in the request context.
+type Limiter struct {
+	mu sync.Mutex
+
+	limit  float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// New returns a Limiter allowing limit requests per second on average, with
+// bursts of up to burst requests. The Limiter starts with a full bucket.
+func New(limit float64, burst int) *Limiter {
+	return &Limiter{
+		limit:  limit,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+// Wait blocks until a request is allowed or the context is done, in which
+// case the context cause is returned.
+func (l *Limiter) Wait(ctx context.Context) error {
+	c := clock.FromContext(ctx)
+
+	for {
+		delay := l.reserve(c.Now())
+
+		if delay == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case <-c.After(delay):
+		}
+	}
+}
+
+// reserve takes a token and returns zero if one is available at the given
+// time, otherwise it returns the duration until one will be available.
+func (l *Limiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.last.IsZero() && now.After(l.last) {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.limit)
+	}
+
+	if l.last.IsZero() || now.After(l.last) {
+		l.last = now
+	}
+
+	if l.tokens >= 1 {
+		l.tokens--
+
+		return 0
+	}
+
+	delay := time.Duration((1 - l.tokens) / l.limit * float64(time.Second))
+
+	// Guard against rounding down to zero, which would not wait.
+	if delay <= 0 {
+		delay = time.Nanosecond
+	}
+
+	return delay
+}
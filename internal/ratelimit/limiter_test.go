@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/internal/clock"
+	"github.com/hashicorp/terraform-plugin-go/internal/ratelimit"
+)
+
+// testClock is a clock which advances when waited on.
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+
+	return ch
+}
+
+// blockingClock is a clock which never finishes waiting.
+type blockingClock struct {
+	now time.Time
+}
+
+func (c blockingClock) Now() time.Time {
+	return c.now
+}
+
+func (c blockingClock) After(_ time.Duration) <-chan time.Time {
+	return nil
+}
+
+func TestLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		limit    float64
+		burst    int
+		requests int
+		expected time.Duration
+	}{
+		"within-burst": {
+			limit:    1,
+			burst:    3,
+			requests: 3,
+			expected: 0,
+		},
+		"exceeds-burst": {
+			limit:    2,
+			burst:    1,
+			requests: 3,
+			expected: time.Second,
+		},
+		"exceeds-burst-slow": {
+			limit:    0.5,
+			burst:    2,
+			requests: 4,
+			expected: 4 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			c := &testClock{now: start}
+			ctx := clock.NewContext(context.Background(), c)
+			limiter := ratelimit.New(testCase.limit, testCase.burst)
+
+			for i := 0; i < testCase.requests; i++ {
+				if err := limiter.Wait(ctx); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			if got := c.Now().Sub(start); got != testCase.expected {
+				t.Errorf("expected to wait %s, waited %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestLimiterWait_ContextDone(t *testing.T) {
+	t.Parallel()
+
+	expected := errors.New("test cause")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(expected)
+
+	ctx = clock.NewContext(ctx, blockingClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+	limiter := ratelimit.New(1, 1)
+
+	// The first request uses the burst, so only the second waits.
+	if err := limiter.Wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := limiter.Wait(ctx); !errors.Is(err, expected) {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package recording contains the protocol version agnostic implementation of
+// recording provider RPC exchanges and replaying them later. Exchanges are
+// stored as JSON Lines, where each line contains the RPC name along with the
+// Protocol Buffers JSON encoding of the request and response messages.
+package recording
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Exchange is a single recorded RPC request and response.
+type Exchange struct {
+	// RPC is the name of the RPC, such as ReadResource.
+	RPC string `json:"rpc"`
+
+	// Request is the Protocol Buffers JSON encoding of the request message.
+	Request json.RawMessage `json:"request"`
+
+	// Response is the Protocol Buffers JSON encoding of the response message.
+	Response json.RawMessage `json:"response"`
+}
+
+// Recorder writes RPC exchanges to an underlying writer. It is safe for
+// concurrent use.
+type Recorder struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewRecorder returns a Recorder which writes exchanges to w.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{
+		w: w,
+	}
+}
+
+// Record writes a single RPC exchange. It is a no-op on a nil Recorder.
+func (r *Recorder) Record(rpc string, req proto.Message, resp proto.Message) error {
+	if r == nil {
+		return nil
+	}
+
+	reqJSON, err := protojson.Marshal(req)
+
+	if err != nil {
+		return fmt.Errorf("unable to marshal %s request: %w", rpc, err)
+	}
+
+	respJSON, err := protojson.Marshal(resp)
+
+	if err != nil {
+		return fmt.Errorf("unable to marshal %s response: %w", rpc, err)
+	}
+
+	line, err := json.Marshal(Exchange{
+		RPC:      rpc,
+		Request:  reqJSON,
+		Response: respJSON,
+	})
+
+	if err != nil {
+		return fmt.Errorf("unable to marshal %s exchange: %w", rpc, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, err = r.w.Write(append(line, '\n'))
+
+	if err != nil {
+		return fmt.Errorf("unable to write %s exchange: %w", rpc, err)
+	}
+
+	return nil
+}
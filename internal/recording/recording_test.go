@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/hashicorp/terraform-plugin-go/internal/recording"
+)
+
+func TestRecorderRecord(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	recorder := recording.NewRecorder(&buf)
+
+	if err := recorder.Record("TestRPC", wrapperspb.String("request"), wrapperspb.String("response")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"rpc":"TestRPC","request":"request","response":"response"}` + "\n"
+
+	if got := buf.String(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestRecorderRecord_nil(t *testing.T) {
+	t.Parallel()
+
+	var recorder *recording.Recorder
+
+	if err := recorder.Record("TestRPC", wrapperspb.String("request"), wrapperspb.String("response")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestNewReplayer(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		recording     string
+		expectedError string
+	}{
+		"empty": {
+			recording: "",
+		},
+		"blank-lines": {
+			recording: "\n" + `{"rpc":"TestRPC","request":"request","response":"response"}` + "\n\n",
+		},
+		"invalid": {
+			recording:     `{"rpc":`,
+			expectedError: "unable to read recorded exchange on line 1: unexpected end of JSON input",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := recording.NewReplayer(strings.NewReader(testCase.recording))
+
+			if testCase.expectedError == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.expectedError != "" && (err == nil || err.Error() != testCase.expectedError) {
+				t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestReplayerReplay(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	recorder := recording.NewRecorder(&buf)
+
+	exchanges := []struct {
+		rpc      string
+		request  string
+		response string
+	}{
+		{rpc: "TestRPC", request: "first", response: "first-response-1"},
+		{rpc: "OtherRPC", request: "first", response: "other-response"},
+		{rpc: "TestRPC", request: "second", response: "second-response"},
+		{rpc: "TestRPC", request: "first", response: "first-response-2"},
+	}
+
+	for _, exchange := range exchanges {
+		err := recorder.Record(exchange.rpc, wrapperspb.String(exchange.request), wrapperspb.String(exchange.response))
+
+		if err != nil {
+			t.Fatalf("unexpected error recording: %s", err)
+		}
+	}
+
+	replayer, err := recording.NewReplayer(&buf)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	replays := []struct {
+		rpc           string
+		request       string
+		expected      string
+		expectedError string
+	}{
+		{rpc: "TestRPC", request: "second", expected: "second-response"},
+		{rpc: "TestRPC", request: "first", expected: "first-response-1"},
+		{rpc: "TestRPC", request: "first", expected: "first-response-2"},
+		{rpc: "TestRPC", request: "first", expectedError: "no recorded TestRPC exchange matches the request"},
+		{rpc: "OtherRPC", request: "first", expected: "other-response"},
+		{rpc: "MissingRPC", request: "first", expectedError: "no recorded MissingRPC exchange matches the request"},
+	}
+
+	for _, replay := range replays {
+		got := &wrapperspb.StringValue{}
+
+		err := replayer.Replay(replay.rpc, wrapperspb.String(replay.request), got)
+
+		if replay.expectedError != "" {
+			if err == nil || err.Error() != replay.expectedError {
+				t.Fatalf("expected error %q, got: %v", replay.expectedError, err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error replaying %s %s: %s", replay.rpc, replay.request, err)
+		}
+
+		if !proto.Equal(got, wrapperspb.String(replay.expected)) {
+			t.Errorf("expected %q, got %q", replay.expected, got.GetValue())
+		}
+	}
+}
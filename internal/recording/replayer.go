@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxExchangeSize is the maximum size of a single recorded exchange line.
+// Schemas and state for large providers can easily exceed the default
+// bufio.Scanner buffer size.
+const maxExchangeSize = 256 * 1024 * 1024
+
+// Replayer returns previously recorded RPC responses for matching requests.
+// It is safe for concurrent use.
+type Replayer struct {
+	mu        sync.Mutex
+	exchanges []Exchange
+	replayed  []bool
+}
+
+// NewReplayer reads all recorded exchanges from r.
+func NewReplayer(r io.Reader) (*Replayer, error) {
+	replayer := &Replayer{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxExchangeSize)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var exchange Exchange
+
+		if err := json.Unmarshal(scanner.Bytes(), &exchange); err != nil {
+			return nil, fmt.Errorf("unable to read recorded exchange on line %d: %w", line, err)
+		}
+
+		replayer.exchanges = append(replayer.exchanges, exchange)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read recorded exchanges: %w", err)
+	}
+
+	replayer.replayed = make([]bool, len(replayer.exchanges))
+
+	return replayer, nil
+}
+
+// Replay finds the first recorded exchange, which has not yet been replayed,
+// for the given RPC with a request equal to req and unmarshals its response
+// into resp. An error is returned if no such exchange exists.
+func (r *Replayer) Replay(rpc string, req proto.Message, resp proto.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, exchange := range r.exchanges {
+		if r.replayed[i] || exchange.RPC != rpc {
+			continue
+		}
+
+		recordedReq := req.ProtoReflect().New().Interface()
+
+		if err := protojson.Unmarshal(exchange.Request, recordedReq); err != nil {
+			return fmt.Errorf("unable to unmarshal recorded %s request: %w", rpc, err)
+		}
+
+		if !proto.Equal(req, recordedReq) {
+			continue
+		}
+
+		if err := protojson.Unmarshal(exchange.Response, resp); err != nil {
+			return fmt.Errorf("unable to unmarshal recorded %s response: %w", rpc, err)
+		}
+
+		r.replayed[i] = true
+
+		return nil
+	}
+
+	return fmt.Errorf("no recorded %s exchange matches the request", rpc)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatestate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// NamespaceSeparator separates a namespace from the keys within it.
+const NamespaceSeparator = "."
+
+// Data is private state made up of keys, each with a JSON encoded value. It
+// is encoded as a JSON object.
+//
+// Data returned by Namespace shares its keys with the Data it was created
+// from, and only reads and writes keys prefixed with its namespace.
+type Data struct {
+	values map[string]json.RawMessage
+	prefix string
+}
+
+// NewData returns empty Data.
+func NewData() *Data {
+	return &Data{
+		values: map[string]json.RawMessage{},
+	}
+}
+
+// Decode returns the Data encoded in private state. Empty private state
+// returns empty Data. An error is returned if the private state is not a JSON
+// object, such as private state written by Marshal or by another encoding.
+func Decode(data []byte) (*Data, error) {
+	d := NewData()
+
+	if len(data) == 0 {
+		return d, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	tok, err := dec.Token()
+
+	if err != nil {
+		return nil, fmt.Errorf("error decoding private state: %w", err)
+	}
+
+	if tok != json.Delim('{') {
+		return nil, errors.New("error decoding private state: expected JSON object")
+	}
+
+	if err := json.Unmarshal(data, &d.values); err != nil {
+		return nil, fmt.Errorf("error decoding private state: %w", err)
+	}
+
+	return d, nil
+}
+
+// Bytes returns the JSON encoding of all keys of the Data, including keys
+// outside of its namespace. Nil is returned if the Data has no keys.
+func (d *Data) Bytes() ([]byte, error) {
+	if len(d.values) == 0 {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(d.values)
+
+	if err != nil {
+		return nil, fmt.Errorf("error encoding private state: %w", err)
+	}
+
+	return b, nil
+}
+
+// Get decodes the JSON value of the key into the value pointed to by v. It
+// returns false, leaving v unmodified, if the key is not set.
+func (d *Data) Get(key string, v any) (bool, error) {
+	value, ok := d.values[d.prefix+key]
+
+	if !ok {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(value, v); err != nil {
+		return true, fmt.Errorf("error decoding private state key %q: %w", d.prefix+key, err)
+	}
+
+	return true, nil
+}
+
+// Set stores the JSON encoding of v as the value of the key. An error is
+// returned if the key is empty.
+func (d *Data) Set(key string, v any) error {
+	if key == "" {
+		return errors.New("private state key cannot be empty")
+	}
+
+	value, err := json.Marshal(v)
+
+	if err != nil {
+		return fmt.Errorf("error encoding private state key %q: %w", d.prefix+key, err)
+	}
+
+	d.values[d.prefix+key] = value
+
+	return nil
+}
+
+// Delete removes the key, if it is set.
+func (d *Data) Delete(key string) {
+	delete(d.values, d.prefix+key)
+}
+
+// Keys returns the sorted keys of the Data within its namespace, without the
+// namespace prefix.
+func (d *Data) Keys() []string {
+	keys := make([]string, 0, len(d.values))
+
+	for key := range d.values {
+		if strings.HasPrefix(key, d.prefix) {
+			keys = append(keys, strings.TrimPrefix(key, d.prefix))
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Namespace returns Data whose keys are prefixed with the namespace and
+// NamespaceSeparator, nested within the namespace of d, if any. Changes to
+// the returned Data are made to d.
+func (d *Data) Namespace(namespace string) *Data {
+	return &Data{
+		values: d.values,
+		prefix: d.prefix + namespace + NamespaceSeparator,
+	}
+}
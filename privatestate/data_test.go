@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatestate_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/privatestate"
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data          []byte
+		expectedKeys  []string
+		expectedError string
+	}{
+		"nil": {
+			data:         nil,
+			expectedKeys: []string{},
+		},
+		"object": {
+			data:         []byte(`{"b":true,"a":"test"}`),
+			expectedKeys: []string{"a", "b"},
+		},
+		"not-object": {
+			data:          []byte(`["a"]`),
+			expectedError: "error decoding private state: expected JSON object",
+		},
+		"null": {
+			data:          []byte(`null`),
+			expectedError: "error decoding private state: expected JSON object",
+		},
+		"invalid": {
+			data:          []byte(`{"a":`),
+			expectedError: "error decoding private state: unexpected end of JSON input",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := privatestate.Decode(testCase.data)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got.Keys(), testCase.expectedKeys); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestData(t *testing.T) {
+	t.Parallel()
+
+	data, err := privatestate.Decode([]byte(`{"etag":"test-etag"}`))
+
+	if err != nil {
+		t.Fatalf("unexpected error decoding: %s", err)
+	}
+
+	retry := data.Namespace("retry")
+
+	if err := retry.Set("attempts", 2); err != nil {
+		t.Fatalf("unexpected error setting key: %s", err)
+	}
+
+	if err := retry.Namespace("last").Set("error", "test-error"); err != nil {
+		t.Fatalf("unexpected error setting nested key: %s", err)
+	}
+
+	if err := data.Set("", "value"); err == nil {
+		t.Fatal("expected error setting empty key, got none")
+	}
+
+	if diff := cmp.Diff(data.Keys(), []string{"etag", "retry.attempts", "retry.last.error"}); diff != "" {
+		t.Errorf("unexpected keys difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(retry.Keys(), []string{"attempts", "last.error"}); diff != "" {
+		t.Errorf("unexpected namespace keys difference: %s", diff)
+	}
+
+	b, err := retry.Bytes()
+
+	if err != nil {
+		t.Fatalf("unexpected error encoding: %s", err)
+	}
+
+	if diff := cmp.Diff(string(b), `{"etag":"test-etag","retry.attempts":2,"retry.last.error":"test-error"}`); diff != "" {
+		t.Errorf("unexpected bytes difference: %s", diff)
+	}
+
+	decoded, err := privatestate.Decode(b)
+
+	if err != nil {
+		t.Fatalf("unexpected error decoding: %s", err)
+	}
+
+	var attempts int
+
+	found, err := decoded.Namespace("retry").Get("attempts", &attempts)
+
+	if err != nil {
+		t.Fatalf("unexpected error getting key: %s", err)
+	}
+
+	if !found || attempts != 2 {
+		t.Errorf("expected attempts 2, got found %t and attempts %d", found, attempts)
+	}
+
+	var etag int
+
+	if _, err := decoded.Get("etag", &etag); err == nil {
+		t.Error("expected error getting key into wrong type, got none")
+	}
+
+	found, err = decoded.Get("missing", &etag)
+
+	if err != nil || found {
+		t.Errorf("expected missing key not found, got found %t and error %v", found, err)
+	}
+
+	decoded.Delete("etag")
+	decoded.Namespace("retry").Delete("attempts")
+	decoded.Namespace("retry").Namespace("last").Delete("error")
+
+	b, err = decoded.Bytes()
+
+	if err != nil {
+		t.Fatalf("unexpected error encoding: %s", err)
+	}
+
+	if b != nil {
+		t.Errorf("expected nil bytes after deleting all keys, got %s", b)
+	}
+}
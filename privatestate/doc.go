@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package privatestate encodes and decodes the provider-defined private
+// state passed between resource RPCs in fields such as
+// PlanResourceChangeResponse.PlannedPrivate and
+// ApplyResourceChangeRequest.PlannedPrivate. Private state is encoded the same
+// way in every protocol version, so this package is used with both tfprotov5
+// and tfprotov6.
+//
+// Terraform treats private state as opaque bytes, so providers are free to use
+// any encoding. This package uses JSON, either for a single Go value with
+// Marshal and Unmarshal, or for a set of independently keyed values with Data,
+// so separate parts of a provider can store private state without conflict:
+//
+//	data, err := privatestate.Decode(req.PriorPrivate)
+//
+//	if err != nil {
+//		// handle error
+//	}
+//
+//	err = data.Namespace("retry").Set("attempts", attempts)
+//
+//	if err != nil {
+//		// handle error
+//	}
+//
+//	resp.PlannedPrivate, err = data.Bytes()
+package privatestate
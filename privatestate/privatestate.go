@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatestate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Marshal returns the JSON encoding of v as private state. Nil is returned
+// if v encodes to JSON null, as Terraform does not require private state to
+// be set.
+func Marshal(v any) ([]byte, error) {
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return nil, fmt.Errorf("error encoding private state: %w", err)
+	}
+
+	if bytes.Equal(b, []byte("null")) {
+		return nil, nil
+	}
+
+	return b, nil
+}
+
+// Unmarshal decodes the JSON encoded private state into the value pointed to
+// by v. Empty private state, such as the private state of a resource not
+// previously managed by the provider, leaves v unmodified.
+func Unmarshal(data []byte, v any) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("error decoding private state: %w", err)
+	}
+
+	return nil
+}
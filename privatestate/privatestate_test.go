@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatestate_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/privatestate"
+)
+
+type testPrivateState struct {
+	ETag     string `json:"etag"`
+	Attempts int    `json:"attempts,omitempty"`
+}
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         any
+		expected      []byte
+		expectedError string
+	}{
+		"nil": {
+			value:    nil,
+			expected: nil,
+		},
+		"nil-pointer": {
+			value:    (*testPrivateState)(nil),
+			expected: nil,
+		},
+		"struct": {
+			value: testPrivateState{
+				ETag:     "test-etag",
+				Attempts: 2,
+			},
+			expected: []byte(`{"etag":"test-etag","attempts":2}`),
+		},
+		"unsupported": {
+			value:         make(chan int),
+			expectedError: "error encoding private state: json: unsupported type: chan int",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := privatestate.Marshal(testCase.value)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data          []byte
+		expected      testPrivateState
+		expectedError string
+	}{
+		"nil": {
+			data: nil,
+			expected: testPrivateState{
+				ETag: "default",
+			},
+		},
+		"empty": {
+			data: []byte{},
+			expected: testPrivateState{
+				ETag: "default",
+			},
+		},
+		"struct": {
+			data: []byte(`{"etag":"test-etag","attempts":2}`),
+			expected: testPrivateState{
+				ETag:     "test-etag",
+				Attempts: 2,
+			},
+		},
+		"invalid": {
+			data:          []byte(`{"etag":1}`),
+			expectedError: "error decoding private state: json: cannot unmarshal number",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testPrivateState{
+				ETag: "default",
+			}
+
+			err := privatestate.Unmarshal(testCase.data, &got)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tf5to6server serves a tfprotov5.ProviderServer over protocol
+// version 6, so providers can switch protocol versions, such as to adopt
+// protocol version 6 only features in part of the provider, without
+// immediately rewriting their implementation:
+//
+//	err := tf6server.Serve("registry.terraform.io/example/example", func() tfprotov6.ProviderServer {
+//		return tf5to6server.UpgradeServer(provider.New())
+//	})
+package tf5to6server
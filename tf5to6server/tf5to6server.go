@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5to6server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5tov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6tov5"
+)
+
+var (
+	_ tfprotov6.ProviderServerWithActions            = server{}
+	_ tfprotov6.ProviderServerWithEphemeralResources = server{}
+)
+
+// UpgradeServer returns a tfprotov6.ProviderServer which serves the given
+// tfprotov5.ProviderServer, converting each protocol version 6 request into
+// its protocol version 5 equivalent and each response back, so providers can
+// be served over protocol version 6, such as with tf6server, before their
+// implementation is migrated. Protocol version 5 is a subset of protocol
+// version 6, so no conversion can fail.
+//
+// The returned server implements the tfprotov6.ProviderServerWithActions and
+// tfprotov6.ProviderServerWithEphemeralResources interfaces. Their RPCs
+// return an error diagnostic if the given server does not implement the
+// equivalent tfprotov5 interface. The state store RPCs, which have no
+// protocol version 5 equivalent, are not implemented.
+func UpgradeServer(v5Server tfprotov5.ProviderServer) tfprotov6.ProviderServer {
+	s := server{
+		actions:            tfprotov5.UnimplementedProviderServer{},
+		ephemeralResources: tfprotov5.UnimplementedProviderServer{},
+		v5Server:           v5Server,
+	}
+
+	if actions, ok := v5Server.(tfprotov5.ActionServer); ok {
+		s.actions = actions
+	}
+
+	if ephemeralResources, ok := v5Server.(tfprotov5.EphemeralResourceServer); ok {
+		s.ephemeralResources = ephemeralResources
+	}
+
+	return s
+}
+
+// server is the tfprotov6.ProviderServer returned by UpgradeServer.
+type server struct {
+	// actions is the v5Server if it implements the action RPCs, otherwise
+	// an implementation returning not implemented diagnostics.
+	actions tfprotov5.ActionServer
+
+	// ephemeralResources is the v5Server if it implements the ephemeral
+	// resource RPCs, otherwise an implementation returning not implemented
+	// diagnostics.
+	ephemeralResources tfprotov5.EphemeralResourceServer
+
+	v5Server tfprotov5.ProviderServer
+}
+
+func (s server) GetMetadata(ctx context.Context, req *tfprotov6.GetMetadataRequest) (*tfprotov6.GetMetadataResponse, error) {
+	resp, err := s.v5Server.GetMetadata(ctx, tfprotov6tov5.GetMetadataRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.GetMetadataResponse(resp), nil
+}
+
+func (s server) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	resp, err := s.v5Server.GetProviderSchema(ctx, tfprotov6tov5.GetProviderSchemaRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.GetProviderSchemaResponse(resp), nil
+}
+
+func (s server) ValidateProviderConfig(ctx context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
+	resp, err := s.v5Server.PrepareProviderConfig(ctx, tfprotov6tov5.PrepareProviderConfigRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.ValidateProviderConfigResponse(resp), nil
+}
+
+func (s server) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	resp, err := s.v5Server.ConfigureProvider(ctx, tfprotov6tov5.ConfigureProviderRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.ConfigureProviderResponse(resp), nil
+}
+
+func (s server) StopProvider(ctx context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	resp, err := s.v5Server.StopProvider(ctx, tfprotov6tov5.StopProviderRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.StopProviderResponse(resp), nil
+}
+
+func (s server) ValidateResourceConfig(ctx context.Context, req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
+	resp, err := s.v5Server.ValidateResourceTypeConfig(ctx, tfprotov6tov5.ValidateResourceTypeConfigRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.ValidateResourceConfigResponse(resp), nil
+}
+
+func (s server) UpgradeResourceState(ctx context.Context, req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
+	resp, err := s.v5Server.UpgradeResourceState(ctx, tfprotov6tov5.UpgradeResourceStateRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.UpgradeResourceStateResponse(resp), nil
+}
+
+func (s server) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	resp, err := s.v5Server.ReadResource(ctx, tfprotov6tov5.ReadResourceRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.ReadResourceResponse(resp), nil
+}
+
+func (s server) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	resp, err := s.v5Server.PlanResourceChange(ctx, tfprotov6tov5.PlanResourceChangeRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.PlanResourceChangeResponse(resp), nil
+}
+
+func (s server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	resp, err := s.v5Server.ApplyResourceChange(ctx, tfprotov6tov5.ApplyResourceChangeRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.ApplyResourceChangeResponse(resp), nil
+}
+
+func (s server) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	resp, err := s.v5Server.ImportResourceState(ctx, tfprotov6tov5.ImportResourceStateRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.ImportResourceStateResponse(resp), nil
+}
+
+func (s server) MoveResourceState(ctx context.Context, req *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error) {
+	resp, err := s.v5Server.MoveResourceState(ctx, tfprotov6tov5.MoveResourceStateRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.MoveResourceStateResponse(resp), nil
+}
+
+func (s server) ValidateDataResourceConfig(ctx context.Context, req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
+	resp, err := s.v5Server.ValidateDataSourceConfig(ctx, tfprotov6tov5.ValidateDataSourceConfigRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.ValidateDataResourceConfigResponse(resp), nil
+}
+
+func (s server) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	resp, err := s.v5Server.ReadDataSource(ctx, tfprotov6tov5.ReadDataSourceRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.ReadDataSourceResponse(resp), nil
+}
+
+func (s server) CallFunction(ctx context.Context, req *tfprotov6.CallFunctionRequest) (*tfprotov6.CallFunctionResponse, error) {
+	resp, err := s.v5Server.CallFunction(ctx, tfprotov6tov5.CallFunctionRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.CallFunctionResponse(resp), nil
+}
+
+func (s server) GetFunctions(ctx context.Context, req *tfprotov6.GetFunctionsRequest) (*tfprotov6.GetFunctionsResponse, error) {
+	resp, err := s.v5Server.GetFunctions(ctx, tfprotov6tov5.GetFunctionsRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.GetFunctionsResponse(resp), nil
+}
+
+func (s server) ValidateEphemeralResourceConfig(ctx context.Context, req *tfprotov6.ValidateEphemeralResourceConfigRequest) (*tfprotov6.ValidateEphemeralResourceConfigResponse, error) {
+	resp, err := s.ephemeralResources.ValidateEphemeralResourceConfig(ctx, tfprotov6tov5.ValidateEphemeralResourceConfigRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.ValidateEphemeralResourceConfigResponse(resp), nil
+}
+
+func (s server) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	resp, err := s.ephemeralResources.OpenEphemeralResource(ctx, tfprotov6tov5.OpenEphemeralResourceRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.OpenEphemeralResourceResponse(resp), nil
+}
+
+func (s server) RenewEphemeralResource(ctx context.Context, req *tfprotov6.RenewEphemeralResourceRequest) (*tfprotov6.RenewEphemeralResourceResponse, error) {
+	resp, err := s.ephemeralResources.RenewEphemeralResource(ctx, tfprotov6tov5.RenewEphemeralResourceRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.RenewEphemeralResourceResponse(resp), nil
+}
+
+func (s server) CloseEphemeralResource(ctx context.Context, req *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	resp, err := s.ephemeralResources.CloseEphemeralResource(ctx, tfprotov6tov5.CloseEphemeralResourceRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.CloseEphemeralResourceResponse(resp), nil
+}
+
+func (s server) ValidateActionConfig(ctx context.Context, req *tfprotov6.ValidateActionConfigRequest) (*tfprotov6.ValidateActionConfigResponse, error) {
+	resp, err := s.actions.ValidateActionConfig(ctx, tfprotov6tov5.ValidateActionConfigRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.ValidateActionConfigResponse(resp), nil
+}
+
+func (s server) InvokeAction(ctx context.Context, req *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionResponse, error) {
+	resp, err := s.actions.InvokeAction(ctx, tfprotov6tov5.InvokeActionRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov5tov6.InvokeActionResponse(resp), nil
+}
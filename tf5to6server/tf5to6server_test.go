@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5to6server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testProviderServer is a tfprotov5.ProviderServer which only implements the
+// ProviderServer interface methods, so the optional interfaces are not
+// implemented.
+type testProviderServer struct {
+	tfprotov5.ProviderServer
+
+	readResourceErr error
+}
+
+func (s testProviderServer) GetProviderSchema(_ context.Context, _ *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return &tfprotov5.GetProviderSchemaResponse{
+		ResourceSchemas: map[string]*tfprotov5.Schema{
+			"test_resource": {
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "test",
+							Type:     tftypes.String,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (s testProviderServer) ReadResource(_ context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	if s.readResourceErr != nil {
+		return nil, s.readResourceErr
+	}
+
+	return &tfprotov5.ReadResourceResponse{
+		NewState: req.CurrentState,
+		Private:  req.Private,
+	}, nil
+}
+
+// testEphemeralProviderServer is a tfprotov5.ProviderServer which also
+// implements the ephemeral resource RPCs.
+type testEphemeralProviderServer struct {
+	tfprotov5.UnimplementedProviderServer
+}
+
+func (s testEphemeralProviderServer) OpenEphemeralResource(_ context.Context, req *tfprotov5.OpenEphemeralResourceRequest) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	return &tfprotov5.OpenEphemeralResourceResponse{
+		Result: req.Config,
+	}, nil
+}
+
+func TestUpgradeServer_GetProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	server := tf5to6server.UpgradeServer(testProviderServer{})
+
+	got, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfprotov6.GetProviderSchemaResponse{
+		ResourceSchemas: map[string]*tfprotov6.Schema{
+			"test_resource": {
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "test",
+							Type:     tftypes.String,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestUpgradeServer_ReadResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server           tfprotov5.ProviderServer
+		expectedResponse *tfprotov6.ReadResourceResponse
+		expectedError    error
+	}{
+		"response": {
+			server: testProviderServer{},
+			expectedResponse: &tfprotov6.ReadResourceResponse{
+				NewState: &tfprotov6.DynamicValue{JSON: []byte(`{"test":"value"}`)},
+				Private:  []byte(`{}`),
+			},
+		},
+		"error": {
+			server: testProviderServer{
+				readResourceErr: tfprotov5.ErrResourceNotFound,
+			},
+			expectedError: tfprotov5.ErrResourceNotFound,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tf5to6server.UpgradeServer(testCase.server)
+
+			got, err := server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+				CurrentState: &tfprotov6.DynamicValue{JSON: []byte(`{"test":"value"}`)},
+				Private:      []byte(`{}`),
+				TypeName:     "test_resource",
+			})
+
+			if !errors.Is(err, testCase.expectedError) {
+				t.Errorf("expected error %v, got: %v", testCase.expectedError, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUpgradeServer_OpenEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server   tfprotov5.ProviderServer
+		expected *tfprotov6.OpenEphemeralResourceResponse
+	}{
+		"implemented": {
+			server: testEphemeralProviderServer{},
+			expected: &tfprotov6.OpenEphemeralResourceResponse{
+				Result: &tfprotov6.DynamicValue{JSON: []byte(`{}`)},
+			},
+		},
+		"not-implemented": {
+			server: testProviderServer{},
+			expected: &tfprotov6.OpenEphemeralResourceResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Provider Ephemeral Resource Not Implemented",
+						Detail: "A OpenEphemeralResource call was received by the provider, however the provider does not implement the RPC. " +
+							"Either upgrade the provider to a version that implements ephemeral resource support or this is always an error in the provider that should be reported to the provider developers.",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server, ok := tf5to6server.UpgradeServer(testCase.server).(tfprotov6.ProviderServerWithEphemeralResources)
+
+			if !ok {
+				t.Fatal("expected server to implement tfprotov6.ProviderServerWithEphemeralResources")
+			}
+
+			got, err := server.OpenEphemeralResource(context.Background(), &tfprotov6.OpenEphemeralResourceRequest{
+				Config:   &tfprotov6.DynamicValue{JSON: []byte(`{}`)},
+				TypeName: "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tf6to5server serves a tfprotov6.ProviderServer over protocol
+// version 5, so providers implemented against protocol version 6 can
+// continue to support Terraform versions before 1.0, which only support
+// protocol version 5:
+//
+//	ctx := context.Background()
+//
+//	server, err := tf6to5server.DowngradeServer(ctx, provider.New())
+//
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	err = tf5server.Serve("registry.terraform.io/example/example", func() tfprotov5.ProviderServer {
+//		return server
+//	})
+package tf6to5server
@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6to5server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5tov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6tov5"
+)
+
+var (
+	_ tfprotov5.ProviderServerWithActions            = server{}
+	_ tfprotov5.ProviderServerWithEphemeralResources = server{}
+)
+
+// ErrStateStoresNotImplemented is returned by DowngradeServer when the
+// provider implements state stores, which are not supported by protocol
+// version 5.
+var ErrStateStoresNotImplemented = errors.New("state stores are not supported in protocol version 5")
+
+// DowngradeServer returns a tfprotov5.ProviderServer which serves the given
+// tfprotov6.ProviderServer, converting each protocol version 5 request into
+// its protocol version 6 equivalent and each response back, so providers can
+// be served over protocol version 5, such as with tf5server, to support
+// Terraform versions before 1.0.
+//
+// Protocol version 6 is a superset of protocol version 5, so the provider
+// schema is fetched and converted during construction. An error is returned
+// if the provider uses features which cannot be downgraded:
+//
+//   - Attributes with a NestedType, where the error wraps
+//     tfprotov6tov5.ErrSchemaAttributeNestedTypeNotImplemented and is a
+//     tftypes.AttributePathError for the attribute.
+//   - State stores, where the error wraps ErrStateStoresNotImplemented.
+//
+// The returned server implements the tfprotov5.ProviderServerWithActions and
+// tfprotov5.ProviderServerWithEphemeralResources interfaces. Their RPCs
+// return an error diagnostic if the given server does not implement the
+// equivalent tfprotov6 interface.
+func DowngradeServer(ctx context.Context, v6Server tfprotov6.ProviderServer) (tfprotov5.ProviderServer, error) {
+	resp, err := v6Server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to get provider schema: %w", err)
+	}
+
+	if _, err := tfprotov6tov5.GetProviderSchemaResponse(resp); err != nil {
+		return nil, fmt.Errorf("unable to downgrade provider schema: %w", err)
+	}
+
+	if resp != nil && len(resp.StateStoreSchemas) > 0 {
+		return nil, fmt.Errorf("unable to downgrade provider schema: %w", ErrStateStoresNotImplemented)
+	}
+
+	s := server{
+		actions:            tfprotov6.UnimplementedProviderServer{},
+		ephemeralResources: tfprotov6.UnimplementedProviderServer{},
+		v6Server:           v6Server,
+	}
+
+	if actions, ok := v6Server.(tfprotov6.ActionServer); ok {
+		s.actions = actions
+	}
+
+	if ephemeralResources, ok := v6Server.(tfprotov6.EphemeralResourceServer); ok {
+		s.ephemeralResources = ephemeralResources
+	}
+
+	return s, nil
+}
+
+// server is the tfprotov5.ProviderServer returned by DowngradeServer.
+type server struct {
+	// actions is the v6Server if it implements the action RPCs, otherwise
+	// an implementation returning not implemented diagnostics.
+	actions tfprotov6.ActionServer
+
+	// ephemeralResources is the v6Server if it implements the ephemeral
+	// resource RPCs, otherwise an implementation returning not implemented
+	// diagnostics.
+	ephemeralResources tfprotov6.EphemeralResourceServer
+
+	v6Server tfprotov6.ProviderServer
+}
+
+func (s server) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.v6Server.GetProviderSchema(ctx, tfprotov5tov6.GetProviderSchemaRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.GetProviderSchemaResponse(resp)
+}
+
+func (s server) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	resp, err := s.v6Server.GetMetadata(ctx, tfprotov5tov6.GetMetadataRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.GetMetadataResponse(resp), nil
+}
+
+func (s server) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	resp, err := s.v6Server.ValidateProviderConfig(ctx, tfprotov5tov6.ValidateProviderConfigRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.PrepareProviderConfigResponse(resp), nil
+}
+
+func (s server) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	resp, err := s.v6Server.ConfigureProvider(ctx, tfprotov5tov6.ConfigureProviderRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.ConfigureProviderResponse(resp), nil
+}
+
+func (s server) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	resp, err := s.v6Server.StopProvider(ctx, tfprotov5tov6.StopProviderRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.StopProviderResponse(resp), nil
+}
+
+func (s server) ValidateResourceTypeConfig(ctx context.Context, req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	resp, err := s.v6Server.ValidateResourceConfig(ctx, tfprotov5tov6.ValidateResourceConfigRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.ValidateResourceTypeConfigResponse(resp), nil
+}
+
+func (s server) UpgradeResourceState(ctx context.Context, req *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	resp, err := s.v6Server.UpgradeResourceState(ctx, tfprotov5tov6.UpgradeResourceStateRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.UpgradeResourceStateResponse(resp), nil
+}
+
+func (s server) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	resp, err := s.v6Server.ReadResource(ctx, tfprotov5tov6.ReadResourceRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.ReadResourceResponse(resp), nil
+}
+
+func (s server) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.v6Server.PlanResourceChange(ctx, tfprotov5tov6.PlanResourceChangeRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.PlanResourceChangeResponse(resp), nil
+}
+
+func (s server) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	resp, err := s.v6Server.ApplyResourceChange(ctx, tfprotov5tov6.ApplyResourceChangeRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.ApplyResourceChangeResponse(resp), nil
+}
+
+func (s server) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	resp, err := s.v6Server.ImportResourceState(ctx, tfprotov5tov6.ImportResourceStateRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.ImportResourceStateResponse(resp), nil
+}
+
+func (s server) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	resp, err := s.v6Server.MoveResourceState(ctx, tfprotov5tov6.MoveResourceStateRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.MoveResourceStateResponse(resp), nil
+}
+
+func (s server) ValidateDataSourceConfig(ctx context.Context, req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	resp, err := s.v6Server.ValidateDataResourceConfig(ctx, tfprotov5tov6.ValidateDataResourceConfigRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.ValidateDataSourceConfigResponse(resp), nil
+}
+
+func (s server) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	resp, err := s.v6Server.ReadDataSource(ctx, tfprotov5tov6.ReadDataSourceRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.ReadDataSourceResponse(resp), nil
+}
+
+func (s server) CallFunction(ctx context.Context, req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	resp, err := s.v6Server.CallFunction(ctx, tfprotov5tov6.CallFunctionRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.CallFunctionResponse(resp), nil
+}
+
+func (s server) GetFunctions(ctx context.Context, req *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	resp, err := s.v6Server.GetFunctions(ctx, tfprotov5tov6.GetFunctionsRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.GetFunctionsResponse(resp), nil
+}
+
+func (s server) ValidateEphemeralResourceConfig(ctx context.Context, req *tfprotov5.ValidateEphemeralResourceConfigRequest) (*tfprotov5.ValidateEphemeralResourceConfigResponse, error) {
+	resp, err := s.ephemeralResources.ValidateEphemeralResourceConfig(ctx, tfprotov5tov6.ValidateEphemeralResourceConfigRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.ValidateEphemeralResourceConfigResponse(resp), nil
+}
+
+func (s server) OpenEphemeralResource(ctx context.Context, req *tfprotov5.OpenEphemeralResourceRequest) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	resp, err := s.ephemeralResources.OpenEphemeralResource(ctx, tfprotov5tov6.OpenEphemeralResourceRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.OpenEphemeralResourceResponse(resp), nil
+}
+
+func (s server) RenewEphemeralResource(ctx context.Context, req *tfprotov5.RenewEphemeralResourceRequest) (*tfprotov5.RenewEphemeralResourceResponse, error) {
+	resp, err := s.ephemeralResources.RenewEphemeralResource(ctx, tfprotov5tov6.RenewEphemeralResourceRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.RenewEphemeralResourceResponse(resp), nil
+}
+
+func (s server) CloseEphemeralResource(ctx context.Context, req *tfprotov5.CloseEphemeralResourceRequest) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	resp, err := s.ephemeralResources.CloseEphemeralResource(ctx, tfprotov5tov6.CloseEphemeralResourceRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.CloseEphemeralResourceResponse(resp), nil
+}
+
+func (s server) ValidateActionConfig(ctx context.Context, req *tfprotov5.ValidateActionConfigRequest) (*tfprotov5.ValidateActionConfigResponse, error) {
+	resp, err := s.actions.ValidateActionConfig(ctx, tfprotov5tov6.ValidateActionConfigRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.ValidateActionConfigResponse(resp), nil
+}
+
+func (s server) InvokeAction(ctx context.Context, req *tfprotov5.InvokeActionRequest) (*tfprotov5.InvokeActionResponse, error) {
+	resp, err := s.actions.InvokeAction(ctx, tfprotov5tov6.InvokeActionRequest(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfprotov6tov5.InvokeActionResponse(resp), nil
+}
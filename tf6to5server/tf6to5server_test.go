@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6to5server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tf6to5server"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6tov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testProviderServer is a tfprotov6.ProviderServer which only implements the
+// ProviderServer interface methods, so the optional interfaces are not
+// implemented.
+type testProviderServer struct {
+	tfprotov6.ProviderServer
+
+	getProviderSchemaResponse *tfprotov6.GetProviderSchemaResponse
+}
+
+func (s testProviderServer) GetProviderSchema(_ context.Context, _ *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	return s.getProviderSchemaResponse, nil
+}
+
+func (s testProviderServer) ReadResource(_ context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	return &tfprotov6.ReadResourceResponse{
+		NewState: req.CurrentState,
+		Private:  req.Private,
+	}, nil
+}
+
+// testEphemeralProviderServer is a tfprotov6.ProviderServer which also
+// implements the ephemeral resource RPCs.
+type testEphemeralProviderServer struct {
+	tfprotov6.UnimplementedProviderServer
+}
+
+func (s testEphemeralProviderServer) GetProviderSchema(_ context.Context, _ *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	return &tfprotov6.GetProviderSchemaResponse{}, nil
+}
+
+func (s testEphemeralProviderServer) OpenEphemeralResource(_ context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	return &tfprotov6.OpenEphemeralResourceResponse{
+		Result: req.Config,
+	}, nil
+}
+
+func TestDowngradeServer(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		getProviderSchemaResponse *tfprotov6.GetProviderSchemaResponse
+		expectedError             error
+	}{
+		"empty": {
+			getProviderSchemaResponse: &tfprotov6.GetProviderSchemaResponse{},
+		},
+		"attributes": {
+			getProviderSchemaResponse: &tfprotov6.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name:     "test",
+									Type:     tftypes.String,
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"nested-attributes": {
+			getProviderSchemaResponse: &tfprotov6.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name: "test",
+									NestedType: &tfprotov6.SchemaObject{
+										Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+									},
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: tfprotov6tov5.ErrSchemaAttributeNestedTypeNotImplemented,
+		},
+		"state-stores": {
+			getProviderSchemaResponse: &tfprotov6.GetProviderSchemaResponse{
+				StateStoreSchemas: map[string]*tfprotov6.Schema{
+					"test_store": {
+						Block: &tfprotov6.SchemaBlock{},
+					},
+				},
+			},
+			expectedError: tf6to5server.ErrStateStoresNotImplemented,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server, err := tf6to5server.DowngradeServer(context.Background(), testProviderServer{
+				getProviderSchemaResponse: testCase.getProviderSchemaResponse,
+			})
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if server == nil {
+					t.Fatal("expected server, got none")
+				}
+
+				return
+			}
+
+			if !errors.Is(err, testCase.expectedError) {
+				t.Fatalf("expected error %v, got: %v", testCase.expectedError, err)
+			}
+
+			if server != nil {
+				t.Errorf("expected no server, got: %v", server)
+			}
+		})
+	}
+}
+
+func TestDowngradeServer_ReadResource(t *testing.T) {
+	t.Parallel()
+
+	server, err := tf6to5server.DowngradeServer(context.Background(), testProviderServer{
+		getProviderSchemaResponse: &tfprotov6.GetProviderSchemaResponse{},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := server.ReadResource(context.Background(), &tfprotov5.ReadResourceRequest{
+		CurrentState: &tfprotov5.DynamicValue{JSON: []byte(`{"test":"value"}`)},
+		Private:      []byte(`{}`),
+		TypeName:     "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfprotov5.ReadResourceResponse{
+		NewState: &tfprotov5.DynamicValue{JSON: []byte(`{"test":"value"}`)},
+		Private:  []byte(`{}`),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestDowngradeServer_OpenEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server   tfprotov6.ProviderServer
+		expected *tfprotov5.OpenEphemeralResourceResponse
+	}{
+		"implemented": {
+			server: testEphemeralProviderServer{},
+			expected: &tfprotov5.OpenEphemeralResourceResponse{
+				Result: &tfprotov5.DynamicValue{JSON: []byte(`{}`)},
+			},
+		},
+		"not-implemented": {
+			server: testProviderServer{
+				getProviderSchemaResponse: &tfprotov6.GetProviderSchemaResponse{},
+			},
+			expected: &tfprotov5.OpenEphemeralResourceResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider Ephemeral Resource Not Implemented",
+						Detail: "A OpenEphemeralResource call was received by the provider, however the provider does not implement the RPC. " +
+							"Either upgrade the provider to a version that implements ephemeral resource support or this is always an error in the provider that should be reported to the provider developers.",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			downgraded, err := tf6to5server.DowngradeServer(context.Background(), testCase.server)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			server, ok := downgraded.(tfprotov5.ProviderServerWithEphemeralResources)
+
+			if !ok {
+				t.Fatal("expected server to implement tfprotov5.ProviderServerWithEphemeralResources")
+			}
+
+			got, err := server.OpenEphemeralResource(context.Background(), &tfprotov5.OpenEphemeralResourceRequest{
+				Config:   &tfprotov5.DynamicValue{JSON: []byte(`{}`)},
+				TypeName: "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -12,6 +12,16 @@ type ConfigureProviderClientCapabilities struct {
 	DeferralAllowed bool
 }
 
+// GetDeferralAllowed returns the DeferralAllowed field, or false if the
+// ConfigureProviderClientCapabilities is nil.
+func (c *ConfigureProviderClientCapabilities) GetDeferralAllowed() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.DeferralAllowed
+}
+
 // ReadDataSourceClientCapabilities allows Terraform to publish information
 // regarding optionally supported protocol features for the ReadDataSource RPC,
 // such as forward-compatible Terraform behavior changes.
@@ -21,6 +31,16 @@ type ReadDataSourceClientCapabilities struct {
 	DeferralAllowed bool
 }
 
+// GetDeferralAllowed returns the DeferralAllowed field, or false if the
+// ReadDataSourceClientCapabilities is nil.
+func (c *ReadDataSourceClientCapabilities) GetDeferralAllowed() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.DeferralAllowed
+}
+
 // ReadResourceClientCapabilities allows Terraform to publish information
 // regarding optionally supported protocol features for the ReadResource RPC,
 // such as forward-compatible Terraform behavior changes.
@@ -30,6 +50,16 @@ type ReadResourceClientCapabilities struct {
 	DeferralAllowed bool
 }
 
+// GetDeferralAllowed returns the DeferralAllowed field, or false if the
+// ReadResourceClientCapabilities is nil.
+func (c *ReadResourceClientCapabilities) GetDeferralAllowed() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.DeferralAllowed
+}
+
 // PlanResourceChangeClientCapabilities allows Terraform to publish information
 // regarding optionally supported protocol features for the PlanResourceChange RPC,
 // such as forward-compatible Terraform behavior changes.
@@ -39,6 +69,16 @@ type PlanResourceChangeClientCapabilities struct {
 	DeferralAllowed bool
 }
 
+// GetDeferralAllowed returns the DeferralAllowed field, or false if the
+// PlanResourceChangeClientCapabilities is nil.
+func (c *PlanResourceChangeClientCapabilities) GetDeferralAllowed() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.DeferralAllowed
+}
+
 // ImportResourceStateClientCapabilities allows Terraform to publish information
 // regarding optionally supported protocol features for the ImportResourceState RPC,
 // such as forward-compatible Terraform behavior changes.
@@ -47,3 +87,53 @@ type ImportResourceStateClientCapabilities struct {
 	// handle deferred responses from the provider.
 	DeferralAllowed bool
 }
+
+// GetDeferralAllowed returns the DeferralAllowed field, or false if the
+// ImportResourceStateClientCapabilities is nil.
+func (c *ImportResourceStateClientCapabilities) GetDeferralAllowed() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.DeferralAllowed
+}
+
+// OpenEphemeralResourceClientCapabilities allows Terraform to publish
+// information regarding optionally supported protocol features for the
+// OpenEphemeralResource RPC, such as forward-compatible Terraform behavior
+// changes.
+type OpenEphemeralResourceClientCapabilities struct {
+	// DeferralAllowed signals that the request from Terraform is able to
+	// handle deferred responses from the provider.
+	DeferralAllowed bool
+}
+
+// GetDeferralAllowed returns the DeferralAllowed field, or false if the
+// OpenEphemeralResourceClientCapabilities is nil.
+func (c *OpenEphemeralResourceClientCapabilities) GetDeferralAllowed() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.DeferralAllowed
+}
+
+// ValidateResourceTypeConfigClientCapabilities allows Terraform to publish
+// information regarding optionally supported protocol features for the
+// ValidateResourceTypeConfig RPC, such as forward-compatible Terraform behavior
+// changes.
+type ValidateResourceTypeConfigClientCapabilities struct {
+	// WriteOnlyAttributesAllowed signals that the request from Terraform is
+	// able to handle write-only attributes.
+	WriteOnlyAttributesAllowed bool
+}
+
+// GetWriteOnlyAttributesAllowed returns the WriteOnlyAttributesAllowed field,
+// or false if the ValidateResourceTypeConfigClientCapabilities is nil.
+func (c *ValidateResourceTypeConfigClientCapabilities) GetWriteOnlyAttributesAllowed() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.WriteOnlyAttributesAllowed
+}
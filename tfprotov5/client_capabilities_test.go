@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestClientCapabilitiesGetDeferralAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		capabilities interface{ GetDeferralAllowed() bool }
+		expected     bool
+	}{
+		"ConfigureProvider-nil": {
+			capabilities: (*tfprotov5.ConfigureProviderClientCapabilities)(nil),
+			expected:     false,
+		},
+		"ConfigureProvider": {
+			capabilities: &tfprotov5.ConfigureProviderClientCapabilities{DeferralAllowed: true},
+			expected:     true,
+		},
+		"ImportResourceState-nil": {
+			capabilities: (*tfprotov5.ImportResourceStateClientCapabilities)(nil),
+			expected:     false,
+		},
+		"OpenEphemeralResource": {
+			capabilities: &tfprotov5.OpenEphemeralResourceClientCapabilities{DeferralAllowed: true},
+			expected:     true,
+		},
+		"PlanResourceChange-nil": {
+			capabilities: (*tfprotov5.PlanResourceChangeClientCapabilities)(nil),
+			expected:     false,
+		},
+		"ReadDataSource": {
+			capabilities: &tfprotov5.ReadDataSourceClientCapabilities{DeferralAllowed: true},
+			expected:     true,
+		},
+		"ReadResource-false": {
+			capabilities: &tfprotov5.ReadResourceClientCapabilities{},
+			expected:     false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.capabilities.GetDeferralAllowed(); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateResourceTypeConfigClientCapabilitiesGetWriteOnlyAttributesAllowed(t *testing.T) {
+	t.Parallel()
+
+	var capabilities *tfprotov5.ValidateResourceTypeConfigClientCapabilities
+
+	if capabilities.GetWriteOnlyAttributesAllowed() {
+		t.Error("expected false for nil capabilities")
+	}
+
+	capabilities = &tfprotov5.ValidateResourceTypeConfigClientCapabilities{
+		WriteOnlyAttributesAllowed: true,
+	}
+
+	if !capabilities.GetWriteOnlyAttributesAllowed() {
+		t.Error("expected true")
+	}
+}
+
+func TestServerCapabilitiesGetters(t *testing.T) {
+	t.Parallel()
+
+	var capabilities *tfprotov5.ServerCapabilities
+
+	if capabilities.GetGetProviderSchemaOptional() || capabilities.GetMoveResourceState() || capabilities.GetPlanDestroy() {
+		t.Error("expected false for nil capabilities")
+	}
+
+	capabilities = &tfprotov5.ServerCapabilities{
+		GetProviderSchemaOptional: true,
+		MoveResourceState:         true,
+		PlanDestroy:               true,
+	}
+
+	if !capabilities.GetGetProviderSchemaOptional() || !capabilities.GetMoveResourceState() || !capabilities.GetPlanDestroy() {
+		t.Error("expected true for all capabilities")
+	}
+}
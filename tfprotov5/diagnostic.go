@@ -59,3 +59,50 @@ func (d DiagnosticSeverity) String() string {
 	}
 	return "UNKNOWN"
 }
+
+// NewErrorDiagnostic returns a new error severity Diagnostic with the given
+// summary and detail.
+func NewErrorDiagnostic(summary string, detail string) *Diagnostic {
+	return &Diagnostic{
+		Severity: DiagnosticSeverityError,
+		Summary:  summary,
+		Detail:   detail,
+	}
+}
+
+// NewWarningDiagnostic returns a new warning severity Diagnostic with the
+// given summary and detail.
+func NewWarningDiagnostic(summary string, detail string) *Diagnostic {
+	return &Diagnostic{
+		Severity: DiagnosticSeverityWarning,
+		Summary:  summary,
+		Detail:   detail,
+	}
+}
+
+// DiagnosticFromErr returns a new error severity Diagnostic with the error
+// message as its summary, or nil if err is nil.
+func DiagnosticFromErr(err error) *Diagnostic {
+	if err == nil {
+		return nil
+	}
+
+	return &Diagnostic{
+		Severity: DiagnosticSeverityError,
+		Summary:  err.Error(),
+	}
+}
+
+// WithAttributePath returns a copy of the Diagnostic with its Attribute set to
+// the given path. The original Diagnostic is not modified. It returns nil if
+// the Diagnostic is nil.
+func (d *Diagnostic) WithAttributePath(path *tftypes.AttributePath) *Diagnostic {
+	if d == nil {
+		return nil
+	}
+
+	diag := *d
+	diag.Attribute = path
+
+	return &diag
+}
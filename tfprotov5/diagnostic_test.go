@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNewErrorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	got := tfprotov5.NewErrorDiagnostic("test summary", "test detail")
+	expected := &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "test summary",
+		Detail:   "test detail",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestNewWarningDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	got := tfprotov5.NewWarningDiagnostic("test summary", "test detail")
+	expected := &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  "test summary",
+		Detail:   "test detail",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestDiagnosticFromErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected *tfprotov5.Diagnostic
+	}{
+		"nil": {
+			err:      nil,
+			expected: nil,
+		},
+		"error": {
+			err: errors.New("test error"),
+			expected: &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "test error",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfprotov5.DiagnosticFromErr(testCase.err)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnosticWithAttributePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     *tfprotov5.Diagnostic
+		path     *tftypes.AttributePath
+		expected *tfprotov5.Diagnostic
+	}{
+		"nil": {
+			diag:     nil,
+			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			expected: nil,
+		},
+		"path": {
+			diag: tfprotov5.NewErrorDiagnostic("test summary", "test detail"),
+			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			expected: &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "test summary",
+				Detail:    "test detail",
+				Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+			},
+		},
+		"replace-path": {
+			diag: tfprotov5.NewWarningDiagnostic("test summary", "").WithAttributePath(
+				tftypes.NewAttributePath().WithAttributeName("other"),
+			),
+			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			expected: &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityWarning,
+				Summary:   "test summary",
+				Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var original *tfprotov5.Diagnostic
+
+			if testCase.diag != nil {
+				diag := *testCase.diag
+				original = &diag
+			}
+
+			got := testCase.diag.WithAttributePath(testCase.path)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.diag, original); diff != "" {
+				t.Errorf("unexpected original modification: %s", diff)
+			}
+		})
+	}
+}
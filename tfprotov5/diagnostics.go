@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// DiagnosticDetailTruncatedSuffix is appended to the Detail of diagnostics
+// truncated by Diagnostics.TruncateDetails.
+const DiagnosticDetailTruncatedSuffix = "... (truncated)"
+
+// Diagnostics is a collection of Diagnostic with methods for querying them.
+// Response Diagnostics fields can be converted to Diagnostics to use these
+// methods, such as Diagnostics(resp.Diagnostics).HasErrors(). Nil Diagnostic
+// in the collection are ignored by all methods.
+type Diagnostics []*Diagnostic
+
+// Append adds the given diagnostics to the collection, skipping nil
+// diagnostics.
+func (d *Diagnostics) Append(diags ...*Diagnostic) {
+	for _, diag := range diags {
+		if diag == nil {
+			continue
+		}
+
+		*d = append(*d, diag)
+	}
+}
+
+// MergeDiagnostics returns a new collection containing the diagnostics of
+// all given slices in order, skipping nil diagnostics. Use Deduplicate on the
+// result to remove diagnostics reported by more than one slice.
+func MergeDiagnostics(diags ...[]*Diagnostic) Diagnostics {
+	var result Diagnostics
+
+	for _, d := range diags {
+		result.Append(d...)
+	}
+
+	return result
+}
+
+// DiagnosticsFromAttributePathErrors returns a new collection with an error
+// Diagnostic for each of the errors, such as those collected from validating
+// a value. The Summary of each Diagnostic is the message of the error
+// without its path, and the Attribute is the path of the error, unless it is
+// empty.
+func DiagnosticsFromAttributePathErrors(errs tftypes.AttributePathErrors) Diagnostics {
+	var result Diagnostics
+
+	for _, err := range errs {
+		diag := &Diagnostic{
+			Severity: DiagnosticSeverityError,
+			Summary:  err.Error(),
+		}
+
+		if unwrapped := err.Unwrap(); unwrapped != nil {
+			diag.Summary = unwrapped.Error()
+		}
+
+		if len(err.Path.Steps()) > 0 {
+			diag.Attribute = err.Path
+		}
+
+		result.Append(diag)
+	}
+
+	return result
+}
+
+// Deduplicate returns a new collection without diagnostics which have the
+// same severity, summary, detail, and attribute path as an earlier
+// diagnostic in the collection, such as those reported by multiple layers of
+// validation. The order of the remaining diagnostics is preserved.
+func (d Diagnostics) Deduplicate() Diagnostics {
+	var result Diagnostics
+
+	for _, diag := range d {
+		if diag == nil || result.contains(diag) {
+			continue
+		}
+
+		result = append(result, diag)
+	}
+
+	return result
+}
+
+// TruncateDetails returns a new collection where each Detail longer than
+// maxLength characters is cut to maxLength characters followed by
+// DiagnosticDetailTruncatedSuffix, so extremely long details, such as those
+// containing entire API responses, do not overwhelm practitioners. Truncated
+// diagnostics are copies, leaving the original diagnostics unmodified.
+func (d Diagnostics) TruncateDetails(maxLength int) Diagnostics {
+	var result Diagnostics
+
+	for _, diag := range d {
+		if diag == nil {
+			continue
+		}
+
+		if utf8.RuneCountInString(diag.Detail) <= maxLength {
+			result = append(result, diag)
+
+			continue
+		}
+
+		truncated := *diag
+		truncated.Detail = string([]rune(diag.Detail)[:max(maxLength, 0)]) + DiagnosticDetailTruncatedSuffix
+
+		result = append(result, &truncated)
+	}
+
+	return result
+}
+
+// HasErrors returns true if the collection contains an error diagnostic.
+func (d Diagnostics) HasErrors() bool {
+	return d.Count(DiagnosticSeverityError) > 0
+}
+
+// Errors returns the error diagnostics of the collection.
+func (d Diagnostics) Errors() Diagnostics {
+	return d.withSeverity(DiagnosticSeverityError)
+}
+
+// Warnings returns the warning diagnostics of the collection.
+func (d Diagnostics) Warnings() Diagnostics {
+	return d.withSeverity(DiagnosticSeverityWarning)
+}
+
+// Count returns the number of diagnostics in the collection with the given
+// severity.
+func (d Diagnostics) Count(severity DiagnosticSeverity) int {
+	var count int
+
+	for _, diag := range d {
+		if diag != nil && diag.Severity == severity {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Err returns the error diagnostics of the collection joined into a single
+// error, or nil if there are no error diagnostics. Each error contains the
+// attribute path, if any, summary, and detail of the diagnostic.
+func (d Diagnostics) Err() error {
+	var errs []error
+
+	for _, diag := range d.Errors() {
+		errs = append(errs, errors.New(diag.errorText()))
+	}
+
+	return errors.Join(errs...)
+}
+
+// contains returns true if the collection contains a diagnostic with the same
+// severity, summary, detail, and attribute path as the given diagnostic.
+func (d Diagnostics) contains(diag *Diagnostic) bool {
+	for _, existing := range d {
+		if existing == nil {
+			continue
+		}
+
+		if existing.Severity != diag.Severity || existing.Summary != diag.Summary || existing.Detail != diag.Detail {
+			continue
+		}
+
+		if existing.Attribute.Equal(diag.Attribute) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withSeverity returns the diagnostics of the collection with the given
+// severity, or nil if there are none.
+func (d Diagnostics) withSeverity(severity DiagnosticSeverity) Diagnostics {
+	var result Diagnostics
+
+	for _, diag := range d {
+		if diag != nil && diag.Severity == severity {
+			result = append(result, diag)
+		}
+	}
+
+	return result
+}
+
+// errorText returns the text of the diagnostic when converted into an error.
+func (d *Diagnostic) errorText() string {
+	text := d.Summary
+
+	if d.Detail != "" {
+		text += ": " + d.Detail
+	}
+
+	if path := d.Attribute.String(); path != "" {
+		text = path + ": " + text
+	}
+
+	return text
+}
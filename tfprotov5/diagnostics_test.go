@@ -0,0 +1,437 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDiagnosticsAppend(t *testing.T) {
+	t.Parallel()
+
+	diag := &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "test summary",
+	}
+
+	var got tfprotov5.Diagnostics
+
+	got.Append(diag, nil)
+	got.Append()
+	got.Append(diag)
+
+	expected := tfprotov5.Diagnostics{diag, diag}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestDiagnosticsQueries(t *testing.T) {
+	t.Parallel()
+
+	errorDiag := &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "error summary",
+	}
+	warningDiag := &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  "warning summary",
+	}
+
+	testCases := map[string]struct {
+		diags            tfprotov5.Diagnostics
+		expectedErrors   tfprotov5.Diagnostics
+		expectedWarnings tfprotov5.Diagnostics
+	}{
+		"nil": {
+			diags: nil,
+		},
+		"nil-diagnostic": {
+			diags: tfprotov5.Diagnostics{nil},
+		},
+		"errors": {
+			diags:          tfprotov5.Diagnostics{errorDiag, errorDiag},
+			expectedErrors: tfprotov5.Diagnostics{errorDiag, errorDiag},
+		},
+		"warnings": {
+			diags:            tfprotov5.Diagnostics{warningDiag},
+			expectedWarnings: tfprotov5.Diagnostics{warningDiag},
+		},
+		"mixed": {
+			diags:            tfprotov5.Diagnostics{warningDiag, nil, errorDiag},
+			expectedErrors:   tfprotov5.Diagnostics{errorDiag},
+			expectedWarnings: tfprotov5.Diagnostics{warningDiag},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.diags.Errors(), testCase.expectedErrors); diff != "" {
+				t.Errorf("unexpected Errors difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.diags.Warnings(), testCase.expectedWarnings); diff != "" {
+				t.Errorf("unexpected Warnings difference: %s", diff)
+			}
+
+			if got, expected := testCase.diags.HasErrors(), len(testCase.expectedErrors) > 0; got != expected {
+				t.Errorf("expected HasErrors %t, got %t", expected, got)
+			}
+
+			if got, expected := testCase.diags.Count(tfprotov5.DiagnosticSeverityError), len(testCase.expectedErrors); got != expected {
+				t.Errorf("expected %d errors, got %d", expected, got)
+			}
+
+			if got, expected := testCase.diags.Count(tfprotov5.DiagnosticSeverityWarning), len(testCase.expectedWarnings); got != expected {
+				t.Errorf("expected %d warnings, got %d", expected, got)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    tfprotov5.Diagnostics
+		expected string
+	}{
+		"nil": {
+			diags: nil,
+		},
+		"warnings": {
+			diags: tfprotov5.Diagnostics{
+				{
+					Severity: tfprotov5.DiagnosticSeverityWarning,
+					Summary:  "warning summary",
+				},
+			},
+		},
+		"summary": {
+			diags: tfprotov5.Diagnostics{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "error summary",
+				},
+			},
+			expected: "error summary",
+		},
+		"detail-and-attribute": {
+			diags: tfprotov5.Diagnostics{
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					Detail:    "error detail",
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "error summary",
+				},
+			},
+			expected: `AttributeName("test"): error summary: error detail`,
+		},
+		"multiple": {
+			diags: tfprotov5.Diagnostics{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "first summary",
+				},
+				{
+					Severity: tfprotov5.DiagnosticSeverityWarning,
+					Summary:  "warning summary",
+				},
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "second summary",
+				},
+			},
+			expected: "first summary\nsecond summary",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.diags.Err()
+
+			if testCase.expected == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expected)
+			}
+
+			if err.Error() != testCase.expected {
+				t.Errorf("expected error %q, got %q", testCase.expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestMergeDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	diag1 := &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "test summary 1",
+	}
+	diag2 := &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  "test summary 2",
+	}
+
+	got := tfprotov5.MergeDiagnostics([]*tfprotov5.Diagnostic{diag1, nil}, nil, tfprotov5.Diagnostics{diag2, diag1})
+	expected := tfprotov5.Diagnostics{diag1, diag2, diag1}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if got := tfprotov5.MergeDiagnostics(); got != nil {
+		t.Errorf("expected nil, got: %v", got)
+	}
+}
+
+func TestDiagnosticsDeduplicate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    tfprotov5.Diagnostics
+		expected tfprotov5.Diagnostics
+	}{
+		"nil": {
+			diags:    nil,
+			expected: nil,
+		},
+		"duplicates": {
+			diags: tfprotov5.Diagnostics{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+				nil,
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath(),
+				},
+			},
+			expected: tfprotov5.Diagnostics{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+			},
+		},
+		"differences": {
+			diags: tfprotov5.Diagnostics{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity: tfprotov5.DiagnosticSeverityWarning,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "other summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "other detail",
+				},
+				{
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+				},
+				{
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
+				},
+				{
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
+				},
+			},
+			expected: tfprotov5.Diagnostics{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity: tfprotov5.DiagnosticSeverityWarning,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "other summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "other detail",
+				},
+				{
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+				},
+				{
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.diags.Deduplicate()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsTruncateDetails(t *testing.T) {
+	t.Parallel()
+
+	diag := &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "test summary",
+		Detail:   "ünïcode detail",
+	}
+
+	testCases := map[string]struct {
+		maxLength int
+		expected  tfprotov5.Diagnostics
+	}{
+		"under": {
+			maxLength: 20,
+			expected:  tfprotov5.Diagnostics{diag},
+		},
+		"equal": {
+			maxLength: 14,
+			expected:  tfprotov5.Diagnostics{diag},
+		},
+		"over": {
+			maxLength: 7,
+			expected: tfprotov5.Diagnostics{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "ünïcode... (truncated)",
+				},
+			},
+		},
+		"zero": {
+			maxLength: 0,
+			expected: tfprotov5.Diagnostics{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "... (truncated)",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfprotov5.Diagnostics{diag, nil}.TruncateDetails(testCase.maxLength)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diag.Detail != "ünïcode detail" {
+				t.Errorf("original diagnostic was modified: %s", diag.Detail)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsFromAttributePathErrors(t *testing.T) {
+	t.Parallel()
+
+	var errs tftypes.AttributePathErrors
+
+	errs.Append(
+		tftypes.NewAttributePath().WithAttributeName("test").NewErrorf("attribute error"),
+		errors.New("value error"),
+	)
+
+	expected := tfprotov5.Diagnostics{
+		{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "attribute error",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+		},
+		{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "value error",
+		},
+	}
+
+	got := tfprotov5.DiagnosticsFromAttributePathErrors(errs)
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if got := tfprotov5.DiagnosticsFromAttributePathErrors(nil); got != nil {
+		t.Errorf("expected no diagnostics, got: %v", got)
+	}
+}
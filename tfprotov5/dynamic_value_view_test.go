@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDynamicValueOpen(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string_attribute": tftypes.String,
+			"test_list_attribute":   tftypes.List{ElementType: tftypes.String},
+		},
+	}
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test_string_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+		"test_list_attribute": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "test-element"),
+		}),
+	})
+
+	testCases := map[string]struct {
+		dynamicValue  tfprotov5.DynamicValue
+		path          *tftypes.AttributePath
+		expected      tftypes.Value
+		expectedError string
+	}{
+		"msgpack": {
+			dynamicValue: testNewDynamicValueMust(t, testType, testValue),
+			path:         tftypes.NewAttributePath().WithAttributeName("test_string_attribute"),
+			expected:     tftypes.NewValue(tftypes.String, "test-value"),
+		},
+		"msgpack-nested": {
+			dynamicValue: testNewDynamicValueMust(t, testType, testValue),
+			path:         tftypes.NewAttributePath().WithAttributeName("test_list_attribute").WithElementKeyInt(0),
+			expected:     tftypes.NewValue(tftypes.String, "test-element"),
+		},
+		"msgpack-root": {
+			dynamicValue: testNewDynamicValueMust(t, testType, testValue),
+			path:         tftypes.NewAttributePath(),
+			expected:     testValue,
+		},
+		"msgpack-missing": {
+			dynamicValue:  testNewDynamicValueMust(t, testType, testValue),
+			path:          tftypes.NewAttributePath().WithAttributeName("test_list_attribute").WithElementKeyInt(1),
+			expectedError: `AttributeName("test_list_attribute").ElementKeyInt(1): step cannot be applied to this value`,
+		},
+		"json": {
+			dynamicValue: tfprotov5.DynamicValue{
+				JSON: []byte(`{"test_string_attribute":"test-value","test_list_attribute":["test-element"]}`),
+			},
+			path:     tftypes.NewAttributePath().WithAttributeName("test_list_attribute").WithElementKeyInt(0),
+			expected: tftypes.NewValue(tftypes.String, "test-element"),
+		},
+		"json-missing": {
+			dynamicValue: tfprotov5.DynamicValue{
+				JSON: []byte(`{"test_string_attribute":"test-value","test_list_attribute":["test-element"]}`),
+			},
+			path:          tftypes.NewAttributePath().WithAttributeName("test_list_attribute").WithElementKeyInt(1),
+			expectedError: `AttributeName("test_list_attribute").ElementKeyInt(1): step cannot be applied to this value`,
+		},
+		"invalid-msgpack": {
+			dynamicValue: tfprotov5.DynamicValue{
+				MsgPack: []byte{0xc1},
+			},
+			path:          tftypes.NewAttributePath().WithAttributeName("test_string_attribute"),
+			expectedError: "error decoding map length: msgpack: unexpected code=c1 decoding map length",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			view, err := testCase.dynamicValue.Open(testType)
+
+			if err != nil {
+				t.Fatalf("unexpected error opening: %s", err)
+			}
+
+			got, err := view.AtPath(testCase.path)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("wanted no error, got error: %s", err)
+				}
+
+				if diff := cmp.Diff(testCase.expectedError, err.Error()); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("got no error, wanted err: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicValueOpen_Empty(t *testing.T) {
+	t.Parallel()
+
+	_, err := tfprotov5.DynamicValue{}.Open(tftypes.String)
+
+	if !errors.Is(err, tfprotov5.ErrUnknownDynamicValueType) {
+		t.Fatalf("wanted error %q, got: %v", tfprotov5.ErrUnknownDynamicValueType, err)
+	}
+}
+
+func TestDynamicValueViewValue(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string_attribute": tftypes.String,
+		},
+	}
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test_string_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	view, err := testNewDynamicValueMust(t, testType, testValue).Open(testType)
+
+	if err != nil {
+		t.Fatalf("unexpected error opening: %s", err)
+	}
+
+	got, err := view.Value()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, testValue); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	// Paths are looked up in the decoded value once it is available.
+	attr, err := view.AtPath(tftypes.NewAttributePath().WithAttributeName("test_string_attribute"))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(attr, tftypes.NewValue(tftypes.String, "test-value")); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+import (
+	"context"
+	"time"
+)
+
+// EphemeralResourceMetadata describes metadata for an ephemeral resource in
+// the GetMetadata RPC.
+type EphemeralResourceMetadata struct {
+	// TypeName is the name of the ephemeral resource.
+	TypeName string
+}
+
+// EphemeralResourceServer is an interface containing the methods an ephemeral
+// resource implementation needs to fill.
+//
+// Ephemeral resources are resources whose data is never persisted by
+// Terraform in plans or state, such as short-lived credentials. Terraform
+// opens an ephemeral resource when its data is needed, renews it as
+// requested by the provider for as long as the data is in use, and closes it
+// afterwards.
+type EphemeralResourceServer interface {
+	// ValidateEphemeralResourceConfig is called when Terraform is checking
+	// that an ephemeral resource's configuration is valid. It is guaranteed
+	// to have types conforming to your schema, but it is not guaranteed
+	// that all values will be known. This is your opportunity to do custom
+	// or advanced validation prior to the ephemeral resource being opened.
+	ValidateEphemeralResourceConfig(context.Context, *ValidateEphemeralResourceConfigRequest) (*ValidateEphemeralResourceConfigResponse, error)
+
+	// OpenEphemeralResource is called when Terraform needs the data of an
+	// ephemeral resource, such as when reading a credential from a remote
+	// system.
+	OpenEphemeralResource(context.Context, *OpenEphemeralResourceRequest) (*OpenEphemeralResourceResponse, error)
+
+	// RenewEphemeralResource is called when Terraform detects that the
+	// RenewAt time returned by OpenEphemeralResource or a previous
+	// RenewEphemeralResource call has passed and the ephemeral resource is
+	// still in use.
+	RenewEphemeralResource(context.Context, *RenewEphemeralResourceRequest) (*RenewEphemeralResourceResponse, error)
+
+	// CloseEphemeralResource is called when Terraform no longer needs the
+	// data of an ephemeral resource, such as to revoke a credential in a
+	// remote system.
+	CloseEphemeralResource(context.Context, *CloseEphemeralResourceRequest) (*CloseEphemeralResourceResponse, error)
+}
+
+// ValidateEphemeralResourceConfigRequest is the request Terraform sends when
+// it wants to validate an ephemeral resource's configuration.
+type ValidateEphemeralResourceConfigRequest struct {
+	// TypeName is the type of ephemeral resource Terraform is validating.
+	TypeName string
+
+	// Config is the configuration the user supplied for that ephemeral
+	// resource. See the documentation on `DynamicValue` for more
+	// information about safely accessing the configuration.
+	//
+	// The configuration is represented as a tftypes.Object, with each
+	// attribute and nested block getting its own key and value.
+	//
+	// This configuration may contain unknown values if a user uses
+	// interpolation or other functionality that would prevent Terraform
+	// from knowing the value at request time.
+	Config *DynamicValue
+}
+
+// ValidateEphemeralResourceConfigResponse is the response from the provider
+// about the validity of an ephemeral resource's configuration.
+type ValidateEphemeralResourceConfigResponse struct {
+	// Diagnostics report errors or warnings related to the given
+	// configuration. Returning an empty slice indicates a successful
+	// validation with no warnings or errors generated.
+	Diagnostics []*Diagnostic
+}
+
+// OpenEphemeralResourceRequest is the request Terraform sends when it wants
+// to open an ephemeral resource.
+type OpenEphemeralResourceRequest struct {
+	// TypeName is the type of ephemeral resource Terraform is opening.
+	TypeName string
+
+	// Config is the configuration the user supplied for that ephemeral
+	// resource. See the documentation on `DynamicValue` for information
+	// about safely accessing the configuration.
+	//
+	// The configuration is represented as a tftypes.Object, with each
+	// attribute and nested block getting its own key and value.
+	//
+	// This configuration may have unknown values.
+	Config *DynamicValue
+
+	// ClientCapabilities defines optionally supported protocol features for
+	// the OpenEphemeralResource RPC, such as forward-compatible Terraform
+	// behavior changes.
+	ClientCapabilities *OpenEphemeralResourceClientCapabilities
+}
+
+// OpenEphemeralResourceResponse is the response from the provider about the
+// data of the opened ephemeral resource.
+type OpenEphemeralResourceResponse struct {
+	// Result is the data of the ephemeral resource, represented as a
+	// `DynamicValue`. See the documentation on `DynamicValue` for
+	// information about safely creating the `DynamicValue`.
+	//
+	// The result should be represented as a tftypes.Object, with each
+	// attribute and nested block getting its own key and value.
+	Result *DynamicValue
+
+	// Diagnostics report errors or warnings related to opening the
+	// requested ephemeral resource. Returning an empty slice indicates a
+	// successful response with no warnings or errors generated.
+	Diagnostics []*Diagnostic
+
+	// Private should be set to any private data that the provider would
+	// like sent to the RenewEphemeralResource and CloseEphemeralResource
+	// calls for this ephemeral resource, such as a lease identifier.
+	Private []byte
+
+	// RenewAt indicates to Terraform that the ephemeral resource needs to
+	// be renewed at the specified time. Terraform will call the
+	// RenewEphemeralResource RPC when the specified time has passed and the
+	// ephemeral resource is still in use. The zero value indicates that
+	// the ephemeral resource does not need to be renewed.
+	RenewAt time.Time
+
+	// Deferred is used to indicate to Terraform that the
+	// OpenEphemeralResource operation needs to be deferred for a reason.
+	Deferred *Deferred
+}
+
+// RenewEphemeralResourceRequest is the request Terraform sends when it wants
+// to renew an ephemeral resource.
+type RenewEphemeralResourceRequest struct {
+	// TypeName is the type of ephemeral resource Terraform is renewing.
+	TypeName string
+
+	// Private is any provider-defined private data stored with the
+	// ephemeral resource from the most recent OpenEphemeralResource or
+	// RenewEphemeralResource call.
+	Private []byte
+}
+
+// RenewEphemeralResourceResponse is the response from the provider about the
+// renewed ephemeral resource.
+type RenewEphemeralResourceResponse struct {
+	// Diagnostics report errors or warnings related to renewing the
+	// requested ephemeral resource. Returning an empty slice indicates a
+	// successful response with no warnings or errors generated.
+	Diagnostics []*Diagnostic
+
+	// Private should be set to any private data that the provider would
+	// like sent to the next RenewEphemeralResource call and the
+	// CloseEphemeralResource call for this ephemeral resource.
+	Private []byte
+
+	// RenewAt indicates to Terraform that the ephemeral resource needs to
+	// be renewed again at the specified time. The zero value indicates that
+	// the ephemeral resource does not need to be renewed again.
+	RenewAt time.Time
+}
+
+// CloseEphemeralResourceRequest is the request Terraform sends when it wants
+// to close an ephemeral resource.
+type CloseEphemeralResourceRequest struct {
+	// TypeName is the type of ephemeral resource Terraform is closing.
+	TypeName string
+
+	// Private is any provider-defined private data stored with the
+	// ephemeral resource from the most recent OpenEphemeralResource or
+	// RenewEphemeralResource call.
+	Private []byte
+}
+
+// CloseEphemeralResourceResponse is the response from the provider about the
+// closed ephemeral resource.
+type CloseEphemeralResourceResponse struct {
+	// Diagnostics report errors or warnings related to closing the
+	// requested ephemeral resource. Returning an empty slice indicates a
+	// successful response with no warnings or errors generated.
+	Diagnostics []*Diagnostic
+}
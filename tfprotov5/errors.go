@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+import "errors"
+
+var (
+	// ErrUnsupportedRPC can be returned, optionally wrapped, by ProviderServer
+	// RPCs which the provider does not support. The tf5server package returns
+	// it to Terraform as a gRPC Unimplemented status, which Terraform treats
+	// as an optional RPC being unavailable where the protocol allows it, such
+	// as falling back to GetProviderSchema when GetMetadata is unsupported.
+	ErrUnsupportedRPC = errors.New("unsupported RPC")
+
+	// ErrResourceNotFound can be returned, optionally wrapped, by
+	// ProviderServer RPCs when the remote object of a resource no longer
+	// exists. For ReadResource, the tf5server package returns a null
+	// NewState, which signals Terraform to remove the resource from state.
+	// For other RPCs, it returns an error diagnostic with the error message.
+	ErrResourceNotFound = errors.New("resource not found")
+)
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestNewFunctionError(t *testing.T) {
+	t.Parallel()
+
+	got := tfprotov5.NewFunctionError("test error")
+	expected := &tfprotov5.FunctionError{
+		Text: "test error",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestNewArgumentFunctionError(t *testing.T) {
+	t.Parallel()
+
+	got := tfprotov5.NewArgumentFunctionError(1, "test error")
+	expected := &tfprotov5.FunctionError{
+		Text:             "test error",
+		FunctionArgument: pointer(int64(1)),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestFunctionErrorFromErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected *tfprotov5.FunctionError
+	}{
+		"nil": {
+			err:      nil,
+			expected: nil,
+		},
+		"error": {
+			err: errors.New("test error"),
+			expected: &tfprotov5.FunctionError{
+				Text: "test error",
+			},
+		},
+		"function-error": {
+			err: tfprotov5.NewArgumentFunctionError(0, "test error"),
+			expected: &tfprotov5.FunctionError{
+				Text:             "test error",
+				FunctionArgument: pointer(int64(0)),
+			},
+		},
+		"wrapped-function-error": {
+			err: fmt.Errorf("wrapped: %w", tfprotov5.NewArgumentFunctionError(2, "test error")),
+			expected: &tfprotov5.FunctionError{
+				Text:             "test error",
+				FunctionArgument: pointer(int64(2)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfprotov5.FunctionErrorFromErr(testCase.err)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFunctionErrorError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		funcErr  *tfprotov5.FunctionError
+		expected string
+	}{
+		"nil": {
+			funcErr:  nil,
+			expected: "",
+		},
+		"text": {
+			funcErr:  tfprotov5.NewFunctionError("test error"),
+			expected: "test error",
+		},
+		"argument": {
+			funcErr:  tfprotov5.NewArgumentFunctionError(1, "test error"),
+			expected: "argument 1: test error",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.funcErr.Error()
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
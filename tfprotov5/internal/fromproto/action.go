@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+)
+
+func ValidateActionConfigRequest(in *tfplugin5.ValidateActionConfig_Request) *tfprotov5.ValidateActionConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.ValidateActionConfigRequest{
+		ActionType: in.ActionType,
+		Config:     DynamicValue(in.Config),
+	}
+
+	return resp
+}
+
+func InvokeActionRequest(in *tfplugin5.InvokeAction_Request) *tfprotov5.InvokeActionRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.InvokeActionRequest{
+		ActionType: in.ActionType,
+		Config:     DynamicValue(in.Config),
+	}
+
+	return resp
+}
+
+func ActionMetadata(in *tfplugin5.GetMetadata_ActionMetadata) tfprotov5.ActionMetadata {
+	if in == nil {
+		return tfprotov5.ActionMetadata{}
+	}
+
+	return tfprotov5.ActionMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+func ActionSchema(in *tfplugin5.ActionSchema) (*tfprotov5.ActionSchema, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	schema, err := Schema(in.Schema)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.ActionSchema{
+		Schema: schema,
+	}
+
+	return resp, nil
+}
+
+func ValidateActionConfigResponse(in *tfplugin5.ValidateActionConfig_Response) (*tfprotov5.ValidateActionConfigResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.ValidateActionConfigResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func InvokeActionEvent(in *tfplugin5.InvokeAction_Event) (*tfprotov5.InvokeActionEvent, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	switch eventType := in.Type.(type) {
+	case *tfplugin5.InvokeAction_Event_Progress_:
+		resp := &tfprotov5.InvokeActionEvent{
+			Type: tfprotov5.ProgressInvokeActionEventType{
+				Message: eventType.Progress.GetMessage(),
+			},
+		}
+
+		return resp, nil
+	case *tfplugin5.InvokeAction_Event_Completed_:
+		diags, err := Diagnostics(eventType.Completed.GetDiagnostics())
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp := &tfprotov5.InvokeActionEvent{
+			Type: tfprotov5.CompletedInvokeActionEventType{
+				Diagnostics: diags,
+			},
+		}
+
+		return resp, nil
+	default:
+		return nil, fmt.Errorf("unknown InvokeAction event type: %T", in.Type)
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/fromproto"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+)
+
+func TestValidateActionConfigRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfplugin5.ValidateActionConfig_Request
+		expected *tfprotov5.ValidateActionConfigRequest
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfplugin5.ValidateActionConfig_Request{},
+			expected: &tfprotov5.ValidateActionConfigRequest{},
+		},
+		"ActionType": {
+			in: &tfplugin5.ValidateActionConfig_Request{
+				ActionType: "test",
+			},
+			expected: &tfprotov5.ValidateActionConfigRequest{
+				ActionType: "test",
+			},
+		},
+		"Config": {
+			in: &tfplugin5.ValidateActionConfig_Request{
+				Config: testTfplugin5DynamicValue(),
+			},
+			expected: &tfprotov5.ValidateActionConfigRequest{
+				Config: testTfprotov5DynamicValue(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto.ValidateActionConfigRequest(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInvokeActionRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfplugin5.InvokeAction_Request
+		expected *tfprotov5.InvokeActionRequest
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfplugin5.InvokeAction_Request{},
+			expected: &tfprotov5.InvokeActionRequest{},
+		},
+		"ActionType": {
+			in: &tfplugin5.InvokeAction_Request{
+				ActionType: "test",
+			},
+			expected: &tfprotov5.InvokeActionRequest{
+				ActionType: "test",
+			},
+		},
+		"Config": {
+			in: &tfplugin5.InvokeAction_Request{
+				Config: testTfplugin5DynamicValue(),
+			},
+			expected: &tfprotov5.InvokeActionRequest{
+				Config: testTfprotov5DynamicValue(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto.InvokeActionRequest(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInvokeActionEvent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            *tfplugin5.InvokeAction_Event
+		expected      *tfprotov5.InvokeActionEvent
+		expectedError string
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:            &tfplugin5.InvokeAction_Event{},
+			expectedError: "unknown InvokeAction event type: <nil>",
+		},
+		"Progress": {
+			in: &tfplugin5.InvokeAction_Event{
+				Type: &tfplugin5.InvokeAction_Event_Progress_{
+					Progress: &tfplugin5.InvokeAction_Event_Progress{
+						Message: "test",
+					},
+				},
+			},
+			expected: &tfprotov5.InvokeActionEvent{
+				Type: tfprotov5.ProgressInvokeActionEventType{
+					Message: "test",
+				},
+			},
+		},
+		"Completed": {
+			in: &tfplugin5.InvokeAction_Event{
+				Type: &tfplugin5.InvokeAction_Event_Completed_{
+					Completed: &tfplugin5.InvokeAction_Event_Completed{
+						Diagnostics: []*tfplugin5.Diagnostic{
+							{
+								Severity: tfplugin5.Diagnostic_WARNING,
+								Summary:  "test summary",
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.InvokeActionEvent{
+				Type: tfprotov5.CompletedInvokeActionEventType{
+					Diagnostics: []*tfprotov5.Diagnostic{
+						{
+							Severity: tfprotov5.DiagnosticSeverityWarning,
+							Summary:  "test summary",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromproto.InvokeActionEvent(testCase.in)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/fromproto"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAttributePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            *tfplugin5.AttributePath
+		expected      *tftypes.AttributePath
+		expectedError error
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfplugin5.AttributePath{},
+			expected: tftypes.NewAttributePath(),
+		},
+		"steps": {
+			in: &tfplugin5.AttributePath{
+				Steps: []*tfplugin5.AttributePath_Step{
+					{
+						Selector: &tfplugin5.AttributePath_Step_AttributeName{
+							AttributeName: "test",
+						},
+					},
+					{
+						Selector: &tfplugin5.AttributePath_Step_ElementKeyString{
+							ElementKeyString: "test-key",
+						},
+					},
+					{
+						Selector: &tfplugin5.AttributePath_Step_ElementKeyInt{
+							ElementKeyInt: 1,
+						},
+					},
+				},
+			},
+			expected: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("test-key").WithElementKeyInt(1),
+		},
+		"unknown-step": {
+			in: &tfplugin5.AttributePath{
+				Steps: []*tfplugin5.AttributePath_Step{
+					{},
+				},
+			},
+			expected:      nil,
+			expectedError: fromproto.ErrUnknownAttributePathStepType,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromproto.AttributePath(testCase.in)
+
+			if err != testCase.expectedError {
+				t.Fatalf("expected error %v, got: %v", testCase.expectedError, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
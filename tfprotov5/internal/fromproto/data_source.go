@@ -35,3 +35,51 @@ func ReadDataSourceRequest(in *tfplugin5.ReadDataSource_Request) *tfprotov5.Read
 
 	return resp
 }
+
+func DataSourceMetadata(in *tfplugin5.GetMetadata_DataSourceMetadata) tfprotov5.DataSourceMetadata {
+	if in == nil {
+		return tfprotov5.DataSourceMetadata{}
+	}
+
+	return tfprotov5.DataSourceMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+func ValidateDataSourceConfigResponse(in *tfplugin5.ValidateDataSourceConfig_Response) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.ValidateDataSourceConfigResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func ReadDataSourceResponse(in *tfplugin5.ReadDataSource_Response) (*tfprotov5.ReadDataSourceResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.ReadDataSourceResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: diags,
+		State:       DynamicValue(in.State),
+	}
+
+	return resp, nil
+}
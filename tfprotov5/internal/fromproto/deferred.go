@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+)
+
+func Deferred(in *tfplugin5.Deferred) *tfprotov5.Deferred {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.Deferred{
+		Reason: tfprotov5.DeferredReason(in.Reason),
+	}
+
+	return resp
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/fromproto"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       []*tfplugin5.Diagnostic
+		expected []*tfprotov5.Diagnostic
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       []*tfplugin5.Diagnostic{},
+			expected: []*tfprotov5.Diagnostic{},
+		},
+		"diagnostics": {
+			in: []*tfplugin5.Diagnostic{
+				{
+					Attribute: &tfplugin5.AttributePath{
+						Steps: []*tfplugin5.AttributePath_Step{
+							{
+								Selector: &tfplugin5.AttributePath_Step_AttributeName{
+									AttributeName: "test",
+								},
+							},
+						},
+					},
+					Detail:   "test detail",
+					Severity: tfplugin5.Diagnostic_ERROR,
+					Summary:  "test summary",
+				},
+				{
+					Severity: tfplugin5.Diagnostic_WARNING,
+					Summary:  "test summary",
+				},
+			},
+			expected: []*tfprotov5.Diagnostic{
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					Detail:    "test detail",
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "test summary",
+				},
+				{
+					Severity: tfprotov5.DiagnosticSeverityWarning,
+					Summary:  "test summary",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromproto.Diagnostics(testCase.in)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+)
+
+func ValidateEphemeralResourceConfigRequest(in *tfplugin5.ValidateEphemeralResourceConfig_Request) *tfprotov5.ValidateEphemeralResourceConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.ValidateEphemeralResourceConfigRequest{
+		Config:   DynamicValue(in.Config),
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func OpenEphemeralResourceRequest(in *tfplugin5.OpenEphemeralResource_Request) *tfprotov5.OpenEphemeralResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.OpenEphemeralResourceRequest{
+		ClientCapabilities: OpenEphemeralResourceClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TypeName:           in.TypeName,
+	}
+
+	return resp
+}
+
+func RenewEphemeralResourceRequest(in *tfplugin5.RenewEphemeralResource_Request) *tfprotov5.RenewEphemeralResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.RenewEphemeralResourceRequest{
+		Private:  in.Private,
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func CloseEphemeralResourceRequest(in *tfplugin5.CloseEphemeralResource_Request) *tfprotov5.CloseEphemeralResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.CloseEphemeralResourceRequest{
+		Private:  in.Private,
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func EphemeralResourceMetadata(in *tfplugin5.GetMetadata_EphemeralResourceMetadata) tfprotov5.EphemeralResourceMetadata {
+	if in == nil {
+		return tfprotov5.EphemeralResourceMetadata{}
+	}
+
+	return tfprotov5.EphemeralResourceMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+func ValidateEphemeralResourceConfigResponse(in *tfplugin5.ValidateEphemeralResourceConfig_Response) (*tfprotov5.ValidateEphemeralResourceConfigResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.ValidateEphemeralResourceConfigResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func OpenEphemeralResourceResponse(in *tfplugin5.OpenEphemeralResource_Response) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.OpenEphemeralResourceResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: diags,
+		Private:     in.Private,
+		RenewAt:     Timestamp(in.RenewAt),
+		Result:      DynamicValue(in.Result),
+	}
+
+	return resp, nil
+}
+
+func RenewEphemeralResourceResponse(in *tfplugin5.RenewEphemeralResource_Response) (*tfprotov5.RenewEphemeralResourceResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.RenewEphemeralResourceResponse{
+		Diagnostics: diags,
+		Private:     in.Private,
+		RenewAt:     Timestamp(in.RenewAt),
+	}
+
+	return resp, nil
+}
+
+func CloseEphemeralResourceResponse(in *tfplugin5.CloseEphemeralResource_Response) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.CloseEphemeralResourceResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+// Timestamp returns the time of a protocol timestamp, or the zero time if the
+// timestamp is not set.
+func Timestamp(in *timestamppb.Timestamp) time.Time {
+	if in == nil {
+		return time.Time{}
+	}
+
+	return in.AsTime()
+}
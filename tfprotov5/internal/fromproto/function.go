@@ -34,3 +34,136 @@ func GetFunctionsRequest(in *tfplugin5.GetFunctions_Request) *tfprotov5.GetFunct
 
 	return resp
 }
+
+func CallFunctionResponse(in *tfplugin5.CallFunction_Response) *tfprotov5.CallFunctionResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.CallFunctionResponse{
+		Error:  FunctionError(in.Error),
+		Result: DynamicValue(in.Result),
+	}
+
+	return resp
+}
+
+func Function(in *tfplugin5.Function) (*tfprotov5.Function, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	returnParam, err := FunctionReturn(in.Return)
+
+	if err != nil {
+		return nil, err
+	}
+
+	variadicParam, err := FunctionParameter(in.VariadicParameter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.Function{
+		Description:        in.Description,
+		DescriptionKind:    StringKind(in.DescriptionKind),
+		DeprecationMessage: in.DeprecationMessage,
+		Parameters:         make([]*tfprotov5.FunctionParameter, 0, len(in.Parameters)),
+		Return:             returnParam,
+		Summary:            in.Summary,
+		VariadicParameter:  variadicParam,
+	}
+
+	for _, p := range in.Parameters {
+		parameter, err := FunctionParameter(p)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Parameters = append(resp.Parameters, parameter)
+	}
+
+	return resp, nil
+}
+
+func FunctionMetadata(in *tfplugin5.GetMetadata_FunctionMetadata) tfprotov5.FunctionMetadata {
+	if in == nil {
+		return tfprotov5.FunctionMetadata{}
+	}
+
+	return tfprotov5.FunctionMetadata{
+		Name: in.Name,
+	}
+}
+
+func FunctionParameter(in *tfplugin5.Function_Parameter) (*tfprotov5.FunctionParameter, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	typ, err := CtyType(in.Type)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.FunctionParameter{
+		AllowNullValue:     in.AllowNullValue,
+		AllowUnknownValues: in.AllowUnknownValues,
+		Description:        in.Description,
+		DescriptionKind:    StringKind(in.DescriptionKind),
+		Name:               in.Name,
+		Type:               typ,
+	}
+
+	return resp, nil
+}
+
+func FunctionReturn(in *tfplugin5.Function_Return) (*tfprotov5.FunctionReturn, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	typ, err := CtyType(in.Type)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.FunctionReturn{
+		Type: typ,
+	}
+
+	return resp, nil
+}
+
+func GetFunctionsResponse(in *tfplugin5.GetFunctions_Response) (*tfprotov5.GetFunctionsResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.GetFunctionsResponse{
+		Diagnostics: diags,
+		Functions:   make(map[string]*tfprotov5.Function, len(in.Functions)),
+	}
+
+	for name, f := range in.Functions {
+		function, err := Function(f)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Functions[name] = function
+	}
+
+	return resp, nil
+}
@@ -63,3 +63,184 @@ func StopProviderRequest(in *tfplugin5.Stop_Request) *tfprotov5.StopProviderRequ
 
 	return resp
 }
+
+func GetMetadataResponse(in *tfplugin5.GetMetadata_Response) (*tfprotov5.GetMetadataResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.GetMetadataResponse{
+		Actions:            make([]tfprotov5.ActionMetadata, 0, len(in.Actions)),
+		DataSources:        make([]tfprotov5.DataSourceMetadata, 0, len(in.DataSources)),
+		Diagnostics:        diags,
+		EphemeralResources: make([]tfprotov5.EphemeralResourceMetadata, 0, len(in.EphemeralResources)),
+		Functions:          make([]tfprotov5.FunctionMetadata, 0, len(in.Functions)),
+		Resources:          make([]tfprotov5.ResourceMetadata, 0, len(in.Resources)),
+		ServerCapabilities: ServerCapabilities(in.ServerCapabilities),
+	}
+
+	for _, action := range in.Actions {
+		resp.Actions = append(resp.Actions, ActionMetadata(action))
+	}
+
+	for _, datasource := range in.DataSources {
+		resp.DataSources = append(resp.DataSources, DataSourceMetadata(datasource))
+	}
+
+	for _, ephemeralResource := range in.EphemeralResources {
+		resp.EphemeralResources = append(resp.EphemeralResources, EphemeralResourceMetadata(ephemeralResource))
+	}
+
+	for _, function := range in.Functions {
+		resp.Functions = append(resp.Functions, FunctionMetadata(function))
+	}
+
+	for _, resource := range in.Resources {
+		resp.Resources = append(resp.Resources, ResourceMetadata(resource))
+	}
+
+	return resp, nil
+}
+
+func GetProviderSchemaResponse(in *tfplugin5.GetProviderSchema_Response) (*tfprotov5.GetProviderSchemaResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := Schema(in.Provider)
+
+	if err != nil {
+		return nil, err
+	}
+
+	providerMeta, err := Schema(in.ProviderMeta)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.GetProviderSchemaResponse{
+		ActionSchemas:            make(map[string]*tfprotov5.ActionSchema, len(in.ActionSchemas)),
+		DataSourceSchemas:        make(map[string]*tfprotov5.Schema, len(in.DataSourceSchemas)),
+		Diagnostics:              diags,
+		EphemeralResourceSchemas: make(map[string]*tfprotov5.Schema, len(in.EphemeralResourceSchemas)),
+		Functions:                make(map[string]*tfprotov5.Function, len(in.Functions)),
+		Provider:                 provider,
+		ProviderMeta:             providerMeta,
+		ResourceSchemas:          make(map[string]*tfprotov5.Schema, len(in.ResourceSchemas)),
+		ServerCapabilities:       ServerCapabilities(in.ServerCapabilities),
+	}
+
+	for name, s := range in.ResourceSchemas {
+		schema, err := Schema(s)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.ResourceSchemas[name] = schema
+	}
+
+	for name, s := range in.DataSourceSchemas {
+		schema, err := Schema(s)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.DataSourceSchemas[name] = schema
+	}
+
+	for name, s := range in.EphemeralResourceSchemas {
+		schema, err := Schema(s)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.EphemeralResourceSchemas[name] = schema
+	}
+
+	for name, s := range in.ActionSchemas {
+		schema, err := ActionSchema(s)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.ActionSchemas[name] = schema
+	}
+
+	for name, f := range in.Functions {
+		function, err := Function(f)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Functions[name] = function
+	}
+
+	return resp, nil
+}
+
+func PrepareProviderConfigResponse(in *tfplugin5.PrepareProviderConfig_Response) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.PrepareProviderConfigResponse{
+		Diagnostics:    diags,
+		PreparedConfig: DynamicValue(in.PreparedConfig),
+	}
+
+	return resp, nil
+}
+
+func ConfigureProviderResponse(in *tfplugin5.Configure_Response) (*tfprotov5.ConfigureProviderResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.ConfigureProviderResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func StopProviderResponse(in *tfplugin5.Stop_Response) *tfprotov5.StopProviderResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.StopProviderResponse{
+		Error: in.Error,
+	}
+
+	return resp
+}
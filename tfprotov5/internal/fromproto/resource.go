@@ -14,8 +14,9 @@ func ValidateResourceTypeConfigRequest(in *tfplugin5.ValidateResourceTypeConfig_
 	}
 
 	resp := &tfprotov5.ValidateResourceTypeConfigRequest{
-		Config:   DynamicValue(in.Config),
-		TypeName: in.TypeName,
+		ClientCapabilities: ValidateResourceTypeConfigClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TypeName:           in.TypeName,
 	}
 
 	return resp
@@ -116,3 +117,189 @@ func MoveResourceStateRequest(in *tfplugin5.MoveResourceState_Request) *tfprotov
 
 	return resp
 }
+
+func ResourceMetadata(in *tfplugin5.GetMetadata_ResourceMetadata) tfprotov5.ResourceMetadata {
+	if in == nil {
+		return tfprotov5.ResourceMetadata{}
+	}
+
+	return tfprotov5.ResourceMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+func ValidateResourceTypeConfigResponse(in *tfplugin5.ValidateResourceTypeConfig_Response) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.ValidateResourceTypeConfigResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func UpgradeResourceStateResponse(in *tfplugin5.UpgradeResourceState_Response) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.UpgradeResourceStateResponse{
+		Diagnostics:   diags,
+		UpgradedState: DynamicValue(in.UpgradedState),
+	}
+
+	return resp, nil
+}
+
+func ReadResourceResponse(in *tfplugin5.ReadResource_Response) (*tfprotov5.ReadResourceResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.ReadResourceResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: diags,
+		NewState:    DynamicValue(in.NewState),
+		Private:     in.Private,
+	}
+
+	return resp, nil
+}
+
+func PlanResourceChangeResponse(in *tfplugin5.PlanResourceChange_Response) (*tfprotov5.PlanResourceChangeResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	requiresReplace, err := AttributePaths(in.RequiresReplace)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.PlanResourceChangeResponse{
+		Deferred:                    Deferred(in.Deferred),
+		Diagnostics:                 diags,
+		PlannedPrivate:              in.PlannedPrivate,
+		PlannedState:                DynamicValue(in.PlannedState),
+		RequiresReplace:             requiresReplace,
+		UnsafeToUseLegacyTypeSystem: in.LegacyTypeSystem, //nolint:staticcheck
+	}
+
+	return resp, nil
+}
+
+func ApplyResourceChangeResponse(in *tfplugin5.ApplyResourceChange_Response) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.ApplyResourceChangeResponse{
+		Diagnostics:                 diags,
+		NewState:                    DynamicValue(in.NewState),
+		Private:                     in.Private,
+		UnsafeToUseLegacyTypeSystem: in.LegacyTypeSystem, //nolint:staticcheck
+	}
+
+	return resp, nil
+}
+
+func ImportResourceStateResponse(in *tfplugin5.ImportResourceState_Response) (*tfprotov5.ImportResourceStateResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.ImportResourceStateResponse{
+		Deferred:          Deferred(in.Deferred),
+		Diagnostics:       diags,
+		ImportedResources: ImportedResources(in.ImportedResources),
+	}
+
+	return resp, nil
+}
+
+func ImportedResource(in *tfplugin5.ImportResourceState_ImportedResource) *tfprotov5.ImportedResource {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.ImportedResource{
+		Private:  in.Private,
+		State:    DynamicValue(in.State),
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func ImportedResources(in []*tfplugin5.ImportResourceState_ImportedResource) []*tfprotov5.ImportedResource {
+	if in == nil {
+		return nil
+	}
+
+	resp := make([]*tfprotov5.ImportedResource, 0, len(in))
+
+	for _, i := range in {
+		resp = append(resp, ImportedResource(i))
+	}
+
+	return resp
+}
+
+func MoveResourceStateResponse(in *tfplugin5.MoveResourceState_Response) (*tfprotov5.MoveResourceStateResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.MoveResourceStateResponse{
+		Diagnostics:   diags,
+		TargetPrivate: in.TargetPrivate,
+		TargetState:   DynamicValue(in.TargetState),
+	}
+
+	return resp, nil
+}
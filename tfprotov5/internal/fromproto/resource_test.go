@@ -479,6 +479,18 @@ func TestValidateResourceTypeConfigRequest(t *testing.T) {
 				Config: testTfprotov5DynamicValue(),
 			},
 		},
+		"ClientCapabilities": {
+			in: &tfplugin5.ValidateResourceTypeConfig_Request{
+				ClientCapabilities: &tfplugin5.ClientCapabilities{
+					WriteOnlyAttributesAllowed: true,
+				},
+			},
+			expected: &tfprotov5.ValidateResourceTypeConfigRequest{
+				ClientCapabilities: &tfprotov5.ValidateResourceTypeConfigClientCapabilities{
+					WriteOnlyAttributesAllowed: true,
+				},
+			},
+		},
 		"TypeName": {
 			in: &tfplugin5.ValidateResourceTypeConfig_Request{
 				TypeName: "test",
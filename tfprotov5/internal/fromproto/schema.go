@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+)
+
+func Schema(in *tfplugin5.Schema) (*tfprotov5.Schema, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	block, err := SchemaBlock(in.Block)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.Schema{
+		Block:   block,
+		Version: in.Version,
+	}
+
+	return resp, nil
+}
+
+func SchemaBlock(in *tfplugin5.Schema_Block) (*tfprotov5.SchemaBlock, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	attributes, err := SchemaAttributes(in.Attributes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blockTypes, err := SchemaNestedBlocks(in.BlockTypes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.SchemaBlock{
+		Attributes:      attributes,
+		BlockTypes:      blockTypes,
+		Deprecated:      in.Deprecated,
+		Description:     in.Description,
+		DescriptionKind: StringKind(in.DescriptionKind),
+		Version:         in.Version,
+	}
+
+	return resp, nil
+}
+
+func SchemaAttribute(in *tfplugin5.Schema_Attribute) (*tfprotov5.SchemaAttribute, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	typ, err := CtyType(in.Type)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.SchemaAttribute{
+		Computed:        in.Computed,
+		Deprecated:      in.Deprecated,
+		Description:     in.Description,
+		DescriptionKind: StringKind(in.DescriptionKind),
+		Name:            in.Name,
+		Optional:        in.Optional,
+		Required:        in.Required,
+		Sensitive:       in.Sensitive,
+		Type:            typ,
+	}
+
+	return resp, nil
+}
+
+func SchemaAttributes(in []*tfplugin5.Schema_Attribute) ([]*tfprotov5.SchemaAttribute, error) {
+	resp := make([]*tfprotov5.SchemaAttribute, 0, len(in))
+
+	for _, a := range in {
+		attribute, err := SchemaAttribute(a)
+
+		if err != nil {
+			return resp, err
+		}
+
+		resp = append(resp, attribute)
+	}
+
+	return resp, nil
+}
+
+func SchemaNestedBlock(in *tfplugin5.Schema_NestedBlock) (*tfprotov5.SchemaNestedBlock, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	block, err := SchemaBlock(in.Block)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.SchemaNestedBlock{
+		Block:    block,
+		MaxItems: in.MaxItems,
+		MinItems: in.MinItems,
+		Nesting:  SchemaNestedBlockNestingMode(in.Nesting),
+		TypeName: in.TypeName,
+	}
+
+	return resp, nil
+}
+
+func SchemaNestedBlocks(in []*tfplugin5.Schema_NestedBlock) ([]*tfprotov5.SchemaNestedBlock, error) {
+	resp := make([]*tfprotov5.SchemaNestedBlock, 0, len(in))
+
+	for _, b := range in {
+		block, err := SchemaNestedBlock(b)
+
+		if err != nil {
+			return resp, err
+		}
+
+		resp = append(resp, block)
+	}
+
+	return resp, nil
+}
+
+func SchemaNestedBlockNestingMode(in tfplugin5.Schema_NestedBlock_NestingMode) tfprotov5.SchemaNestedBlockNestingMode {
+	return tfprotov5.SchemaNestedBlockNestingMode(in)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/fromproto"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfplugin5.Schema
+		expected *tfprotov5.Schema
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfplugin5.Schema{},
+			expected: &tfprotov5.Schema{},
+		},
+		"Block": {
+			in: &tfplugin5.Schema{
+				Block: &tfplugin5.Schema_Block{
+					Attributes: []*tfplugin5.Schema_Attribute{
+						{
+							Name:     "test",
+							Required: true,
+							Type:     []byte(`"string"`),
+						},
+					},
+					BlockTypes: []*tfplugin5.Schema_NestedBlock{
+						{
+							Block:    &tfplugin5.Schema_Block{},
+							Nesting:  tfplugin5.Schema_NestedBlock_LIST,
+							TypeName: "test_block",
+						},
+					},
+					Description:     "test description",
+					DescriptionKind: tfplugin5.StringKind_MARKDOWN,
+				},
+				Version: 1,
+			},
+			expected: &tfprotov5.Schema{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "test",
+							Required: true,
+							Type:     tftypes.String,
+						},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{},
+								BlockTypes: []*tfprotov5.SchemaNestedBlock{},
+							},
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+							TypeName: "test_block",
+						},
+					},
+					Description:     "test description",
+					DescriptionKind: tfprotov5.StringKindMarkdown,
+				},
+				Version: 1,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromproto.Schema(testCase.in)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaAttribute_invalidType(t *testing.T) {
+	t.Parallel()
+
+	_, err := fromproto.SchemaAttribute(&tfplugin5.Schema_Attribute{
+		Name: "test",
+		Type: []byte(`"invalid"`),
+	})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -9,3 +9,11 @@ package tf5serverlogging
 // ContextKeyDownstreamRequestStartTime is a context.Context key to store the
 // time.Time when the server began a downstream request.
 type ContextKeyDownstreamRequestStartTime struct{}
+
+// ContextKeySlowRPCThreshold is a context.Context key to store the
+// time.Duration after which a downstream request is logged as slow.
+type ContextKeySlowRPCThreshold struct{}
+
+// ContextKeySlowRPCTimer is a context.Context key to store the timer which
+// generates the slow RPC log while a downstream request is in progress.
+type ContextKeySlowRPCTimer struct{}
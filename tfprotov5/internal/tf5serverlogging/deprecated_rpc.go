@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5serverlogging
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/internal/logging"
+)
+
+// protocolV6Equivalents maps protocol version 5 gRPC method names to the
+// protocol version 6 gRPC method names which replaced them.
+var protocolV6Equivalents = map[string]string{
+	"Configure":                  "ConfigureProvider",
+	"GetSchema":                  "GetProviderSchema",
+	"PrepareProviderConfig":      "ValidateProviderConfig",
+	"Stop":                       "StopProvider",
+	"ValidateDataSourceConfig":   "ValidateDataResourceConfig",
+	"ValidateResourceTypeConfig": "ValidateResourceConfig",
+}
+
+// DeprecatedRPC generates a WARN "Received deprecated protocol version 5
+// RPC" log if the protocol version 5 gRPC method was renamed in protocol
+// version 6, including the name of the protocol version 6 equivalent.
+func DeprecatedRPC(ctx context.Context, method string) {
+	equivalent, ok := protocolV6Equivalents[method]
+
+	if !ok {
+		return
+	}
+
+	logging.ProtocolWarn(ctx, "Received deprecated protocol version 5 RPC", map[string]interface{}{
+		logging.KeyRPCProtocolV5Method:     method,
+		logging.KeyRPCProtocolV6Equivalent: equivalent,
+	})
+}
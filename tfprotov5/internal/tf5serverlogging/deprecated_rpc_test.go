@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5serverlogging_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/internal/logging"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tf5serverlogging"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+)
+
+func TestDeprecatedRPC(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		method   string
+		expected []map[string]interface{}
+	}{
+		"GetSchema": {
+			method: "GetSchema",
+			expected: []map[string]interface{}{
+				{
+					"@level":                     "warn",
+					"@message":                   "Received deprecated protocol version 5 RPC",
+					"@module":                    "sdk.proto",
+					"tf_rpc_proto_v5_method":     "GetSchema",
+					"tf_rpc_proto_v6_equivalent": "GetProviderSchema",
+				},
+			},
+		},
+		"PrepareProviderConfig": {
+			method: "PrepareProviderConfig",
+			expected: []map[string]interface{}{
+				{
+					"@level":                     "warn",
+					"@message":                   "Received deprecated protocol version 5 RPC",
+					"@module":                    "sdk.proto",
+					"tf_rpc_proto_v5_method":     "PrepareProviderConfig",
+					"tf_rpc_proto_v6_equivalent": "ValidateProviderConfig",
+				},
+			},
+		},
+		"ReadResource": {
+			method:   "ReadResource",
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+			ctx = logging.ProtoSubsystemContext(ctx, tfsdklog.Options{})
+
+			tf5serverlogging.DeprecatedRPC(ctx, testCase.method)
+
+			entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			if diff := cmp.Diff(entries, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
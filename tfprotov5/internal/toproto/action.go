@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+)
+
+func GetMetadata_ActionMetadata(in *tfprotov5.ActionMetadata) *tfplugin5.GetMetadata_ActionMetadata {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.GetMetadata_ActionMetadata{
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func ActionSchema(in *tfprotov5.ActionSchema) *tfplugin5.ActionSchema {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.ActionSchema{
+		Schema: Schema(in.Schema),
+	}
+
+	return resp
+}
+
+func ValidateActionConfig_Response(in *tfprotov5.ValidateActionConfigResponse) *tfplugin5.ValidateActionConfig_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.ValidateActionConfig_Response{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+
+	return resp
+}
+
+// InvokeAction_Event returns the protocol event of an InvokeActionEvent. The
+// type of the returned event is not set for unknown event types.
+func InvokeAction_Event(in *tfprotov5.InvokeActionEvent) *tfplugin5.InvokeAction_Event {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.InvokeAction_Event{}
+
+	switch eventType := in.Type.(type) {
+	case tfprotov5.ProgressInvokeActionEventType:
+		resp.Type = &tfplugin5.InvokeAction_Event_Progress_{
+			Progress: &tfplugin5.InvokeAction_Event_Progress{
+				Message: eventType.Message,
+			},
+		}
+	case tfprotov5.CompletedInvokeActionEventType:
+		resp.Type = &tfplugin5.InvokeAction_Event_Completed_{
+			Completed: &tfplugin5.InvokeAction_Event_Completed{
+				Diagnostics: Diagnostics(eventType.Diagnostics),
+			},
+		}
+	}
+
+	return resp
+}
+
+func ValidateActionConfig_Request(in *tfprotov5.ValidateActionConfigRequest) *tfplugin5.ValidateActionConfig_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.ValidateActionConfig_Request{
+		ActionType: in.ActionType,
+		Config:     DynamicValue(in.Config),
+	}
+
+	return resp
+}
+
+func InvokeAction_Request(in *tfprotov5.InvokeActionRequest) *tfplugin5.InvokeAction_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.InvokeAction_Request{
+		ActionType: in.ActionType,
+		Config:     DynamicValue(in.Config),
+	}
+
+	return resp
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/toproto"
+)
+
+func TestValidateActionConfig_Response(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov5.ValidateActionConfigResponse
+		expected *tfplugin5.ValidateActionConfig_Response
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in: &tfprotov5.ValidateActionConfigResponse{},
+			expected: &tfplugin5.ValidateActionConfig_Response{
+				Diagnostics: []*tfplugin5.Diagnostic{},
+			},
+		},
+		"Diagnostics": {
+			in: &tfprotov5.ValidateActionConfigResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					testTfprotov5Diagnostic,
+				},
+			},
+			expected: &tfplugin5.ValidateActionConfig_Response{
+				Diagnostics: []*tfplugin5.Diagnostic{
+					testTfplugin5Diagnostic,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto.ValidateActionConfig_Response(testCase.in)
+
+			// Protocol Buffers generated types must have unexported fields
+			// ignored or cmp.Diff() will raise an error. This is easier than
+			// writing a custom Comparer for each type, which would have no
+			// benefits.
+			diffOpts := cmpopts.IgnoreUnexported(
+				tfplugin5.Diagnostic{},
+				tfplugin5.ValidateActionConfig_Response{},
+			)
+
+			if diff := cmp.Diff(got, testCase.expected, diffOpts); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInvokeAction_Event(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov5.InvokeActionEvent
+		expected *tfplugin5.InvokeAction_Event
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfprotov5.InvokeActionEvent{},
+			expected: &tfplugin5.InvokeAction_Event{},
+		},
+		"Progress": {
+			in: &tfprotov5.InvokeActionEvent{
+				Type: tfprotov5.ProgressInvokeActionEventType{
+					Message: "test",
+				},
+			},
+			expected: &tfplugin5.InvokeAction_Event{
+				Type: &tfplugin5.InvokeAction_Event_Progress_{
+					Progress: &tfplugin5.InvokeAction_Event_Progress{
+						Message: "test",
+					},
+				},
+			},
+		},
+		"Completed": {
+			in: &tfprotov5.InvokeActionEvent{
+				Type: tfprotov5.CompletedInvokeActionEventType{
+					Diagnostics: []*tfprotov5.Diagnostic{
+						testTfprotov5Diagnostic,
+					},
+				},
+			},
+			expected: &tfplugin5.InvokeAction_Event{
+				Type: &tfplugin5.InvokeAction_Event_Completed_{
+					Completed: &tfplugin5.InvokeAction_Event_Completed{
+						Diagnostics: []*tfplugin5.Diagnostic{
+							testTfplugin5Diagnostic,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto.InvokeAction_Event(testCase.in)
+
+			// Protocol Buffers generated types must have unexported fields
+			// ignored or cmp.Diff() will raise an error. This is easier than
+			// writing a custom Comparer for each type, which would have no
+			// benefits.
+			diffOpts := cmpopts.IgnoreUnexported(
+				tfplugin5.Diagnostic{},
+				tfplugin5.InvokeAction_Event{},
+				tfplugin5.InvokeAction_Event_Completed{},
+				tfplugin5.InvokeAction_Event_Progress{},
+			)
+
+			if diff := cmp.Diff(got, testCase.expected, diffOpts); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
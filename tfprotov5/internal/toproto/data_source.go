@@ -45,3 +45,31 @@ func ReadDataSource_Response(in *tfprotov5.ReadDataSourceResponse) *tfplugin5.Re
 
 	return resp
 }
+
+func ValidateDataSourceConfig_Request(in *tfprotov5.ValidateDataSourceConfigRequest) *tfplugin5.ValidateDataSourceConfig_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.ValidateDataSourceConfig_Request{
+		Config:   DynamicValue(in.Config),
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func ReadDataSource_Request(in *tfprotov5.ReadDataSourceRequest) *tfplugin5.ReadDataSource_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.ReadDataSource_Request{
+		ClientCapabilities: ReadDataSourceClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		ProviderMeta:       DynamicValue(in.ProviderMeta),
+		TypeName:           in.TypeName,
+	}
+
+	return resp
+}
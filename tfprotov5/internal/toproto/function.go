@@ -100,3 +100,30 @@ func GetMetadata_FunctionMetadata(in *tfprotov5.FunctionMetadata) *tfplugin5.Get
 
 	return resp
 }
+
+func CallFunction_Request(in *tfprotov5.CallFunctionRequest) *tfplugin5.CallFunction_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.CallFunction_Request{
+		Arguments: make([]*tfplugin5.DynamicValue, 0, len(in.Arguments)),
+		Name:      in.Name,
+	}
+
+	for _, argument := range in.Arguments {
+		resp.Arguments = append(resp.Arguments, DynamicValue(argument))
+	}
+
+	return resp
+}
+
+func GetFunctions_Request(in *tfprotov5.GetFunctionsRequest) *tfplugin5.GetFunctions_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.GetFunctions_Request{}
+
+	return resp
+}
@@ -14,17 +14,27 @@ func GetMetadata_Response(in *tfprotov5.GetMetadataResponse) *tfplugin5.GetMetad
 	}
 
 	resp := &tfplugin5.GetMetadata_Response{
+		Actions:            make([]*tfplugin5.GetMetadata_ActionMetadata, 0, len(in.Actions)),
 		DataSources:        make([]*tfplugin5.GetMetadata_DataSourceMetadata, 0, len(in.DataSources)),
 		Diagnostics:        Diagnostics(in.Diagnostics),
+		EphemeralResources: make([]*tfplugin5.GetMetadata_EphemeralResourceMetadata, 0, len(in.EphemeralResources)),
 		Functions:          make([]*tfplugin5.GetMetadata_FunctionMetadata, 0, len(in.Functions)),
 		Resources:          make([]*tfplugin5.GetMetadata_ResourceMetadata, 0, len(in.Resources)),
 		ServerCapabilities: ServerCapabilities(in.ServerCapabilities),
 	}
 
+	for _, action := range in.Actions {
+		resp.Actions = append(resp.Actions, GetMetadata_ActionMetadata(&action))
+	}
+
 	for _, datasource := range in.DataSources {
 		resp.DataSources = append(resp.DataSources, GetMetadata_DataSourceMetadata(&datasource))
 	}
 
+	for _, ephemeralResource := range in.EphemeralResources {
+		resp.EphemeralResources = append(resp.EphemeralResources, GetMetadata_EphemeralResourceMetadata(&ephemeralResource))
+	}
+
 	for _, function := range in.Functions {
 		resp.Functions = append(resp.Functions, GetMetadata_FunctionMetadata(&function))
 	}
@@ -42,13 +52,15 @@ func GetProviderSchema_Response(in *tfprotov5.GetProviderSchemaResponse) *tfplug
 	}
 
 	resp := &tfplugin5.GetProviderSchema_Response{
-		DataSourceSchemas:  make(map[string]*tfplugin5.Schema, len(in.DataSourceSchemas)),
-		Diagnostics:        Diagnostics(in.Diagnostics),
-		Functions:          make(map[string]*tfplugin5.Function, len(in.Functions)),
-		Provider:           Schema(in.Provider),
-		ProviderMeta:       Schema(in.ProviderMeta),
-		ResourceSchemas:    make(map[string]*tfplugin5.Schema, len(in.ResourceSchemas)),
-		ServerCapabilities: ServerCapabilities(in.ServerCapabilities),
+		ActionSchemas:            make(map[string]*tfplugin5.ActionSchema, len(in.ActionSchemas)),
+		DataSourceSchemas:        make(map[string]*tfplugin5.Schema, len(in.DataSourceSchemas)),
+		Diagnostics:              Diagnostics(in.Diagnostics),
+		EphemeralResourceSchemas: make(map[string]*tfplugin5.Schema, len(in.EphemeralResourceSchemas)),
+		Functions:                make(map[string]*tfplugin5.Function, len(in.Functions)),
+		Provider:                 Schema(in.Provider),
+		ProviderMeta:             Schema(in.ProviderMeta),
+		ResourceSchemas:          make(map[string]*tfplugin5.Schema, len(in.ResourceSchemas)),
+		ServerCapabilities:       ServerCapabilities(in.ServerCapabilities),
 	}
 
 	for name, schema := range in.ResourceSchemas {
@@ -59,6 +71,14 @@ func GetProviderSchema_Response(in *tfprotov5.GetProviderSchemaResponse) *tfplug
 		resp.DataSourceSchemas[name] = Schema(schema)
 	}
 
+	for name, schema := range in.EphemeralResourceSchemas {
+		resp.EphemeralResourceSchemas[name] = Schema(schema)
+	}
+
+	for name, schema := range in.ActionSchemas {
+		resp.ActionSchemas[name] = ActionSchema(schema)
+	}
+
 	for name, function := range in.Functions {
 		resp.Functions[name] = Function(function)
 	}
@@ -102,3 +122,59 @@ func Stop_Response(in *tfprotov5.StopProviderResponse) *tfplugin5.Stop_Response
 
 	return resp
 }
+
+func GetMetadata_Request(in *tfprotov5.GetMetadataRequest) *tfplugin5.GetMetadata_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.GetMetadata_Request{}
+
+	return resp
+}
+
+func GetProviderSchema_Request(in *tfprotov5.GetProviderSchemaRequest) *tfplugin5.GetProviderSchema_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.GetProviderSchema_Request{}
+
+	return resp
+}
+
+func PrepareProviderConfig_Request(in *tfprotov5.PrepareProviderConfigRequest) *tfplugin5.PrepareProviderConfig_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.PrepareProviderConfig_Request{
+		Config: DynamicValue(in.Config),
+	}
+
+	return resp
+}
+
+func Configure_Request(in *tfprotov5.ConfigureProviderRequest) *tfplugin5.Configure_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.Configure_Request{
+		ClientCapabilities: ConfigureProviderClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TerraformVersion:   in.TerraformVersion,
+	}
+
+	return resp
+}
+
+func Stop_Request(in *tfprotov5.StopProviderRequest) *tfplugin5.Stop_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.Stop_Request{}
+
+	return resp
+}
@@ -83,10 +83,33 @@ func TestGetMetadata_Response(t *testing.T) {
 		"zero": {
 			in: &tfprotov5.GetMetadataResponse{},
 			expected: &tfplugin5.GetMetadata_Response{
-				DataSources: []*tfplugin5.GetMetadata_DataSourceMetadata{},
-				Diagnostics: []*tfplugin5.Diagnostic{},
-				Functions:   []*tfplugin5.GetMetadata_FunctionMetadata{},
-				Resources:   []*tfplugin5.GetMetadata_ResourceMetadata{},
+				Actions:            []*tfplugin5.GetMetadata_ActionMetadata{},
+				DataSources:        []*tfplugin5.GetMetadata_DataSourceMetadata{},
+				Diagnostics:        []*tfplugin5.Diagnostic{},
+				EphemeralResources: []*tfplugin5.GetMetadata_EphemeralResourceMetadata{},
+				Functions:          []*tfplugin5.GetMetadata_FunctionMetadata{},
+				Resources:          []*tfplugin5.GetMetadata_ResourceMetadata{},
+			},
+		},
+		"Actions": {
+			in: &tfprotov5.GetMetadataResponse{
+				Actions: []tfprotov5.ActionMetadata{
+					{
+						TypeName: "test",
+					},
+				},
+			},
+			expected: &tfplugin5.GetMetadata_Response{
+				Actions: []*tfplugin5.GetMetadata_ActionMetadata{
+					{
+						TypeName: "test",
+					},
+				},
+				DataSources:        []*tfplugin5.GetMetadata_DataSourceMetadata{},
+				Diagnostics:        []*tfplugin5.Diagnostic{},
+				EphemeralResources: []*tfplugin5.GetMetadata_EphemeralResourceMetadata{},
+				Functions:          []*tfplugin5.GetMetadata_FunctionMetadata{},
+				Resources:          []*tfplugin5.GetMetadata_ResourceMetadata{},
 			},
 		},
 		"DataSources": {
@@ -98,14 +121,16 @@ func TestGetMetadata_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin5.GetMetadata_Response{
+				Actions: []*tfplugin5.GetMetadata_ActionMetadata{},
 				DataSources: []*tfplugin5.GetMetadata_DataSourceMetadata{
 					{
 						TypeName: "test",
 					},
 				},
-				Diagnostics: []*tfplugin5.Diagnostic{},
-				Functions:   []*tfplugin5.GetMetadata_FunctionMetadata{},
-				Resources:   []*tfplugin5.GetMetadata_ResourceMetadata{},
+				Diagnostics:        []*tfplugin5.Diagnostic{},
+				EphemeralResources: []*tfplugin5.GetMetadata_EphemeralResourceMetadata{},
+				Functions:          []*tfplugin5.GetMetadata_FunctionMetadata{},
+				Resources:          []*tfplugin5.GetMetadata_ResourceMetadata{},
 			},
 		},
 		"Diagnostics": {
@@ -115,10 +140,33 @@ func TestGetMetadata_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin5.GetMetadata_Response{
+				Actions:     []*tfplugin5.GetMetadata_ActionMetadata{},
 				DataSources: []*tfplugin5.GetMetadata_DataSourceMetadata{},
 				Diagnostics: []*tfplugin5.Diagnostic{
 					testTfplugin5Diagnostic,
 				},
+				EphemeralResources: []*tfplugin5.GetMetadata_EphemeralResourceMetadata{},
+				Functions:          []*tfplugin5.GetMetadata_FunctionMetadata{},
+				Resources:          []*tfplugin5.GetMetadata_ResourceMetadata{},
+			},
+		},
+		"EphemeralResources": {
+			in: &tfprotov5.GetMetadataResponse{
+				EphemeralResources: []tfprotov5.EphemeralResourceMetadata{
+					{
+						TypeName: "test",
+					},
+				},
+			},
+			expected: &tfplugin5.GetMetadata_Response{
+				Actions:     []*tfplugin5.GetMetadata_ActionMetadata{},
+				DataSources: []*tfplugin5.GetMetadata_DataSourceMetadata{},
+				Diagnostics: []*tfplugin5.Diagnostic{},
+				EphemeralResources: []*tfplugin5.GetMetadata_EphemeralResourceMetadata{
+					{
+						TypeName: "test",
+					},
+				},
 				Functions: []*tfplugin5.GetMetadata_FunctionMetadata{},
 				Resources: []*tfplugin5.GetMetadata_ResourceMetadata{},
 			},
@@ -132,8 +180,10 @@ func TestGetMetadata_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin5.GetMetadata_Response{
-				DataSources: []*tfplugin5.GetMetadata_DataSourceMetadata{},
-				Diagnostics: []*tfplugin5.Diagnostic{},
+				Actions:            []*tfplugin5.GetMetadata_ActionMetadata{},
+				DataSources:        []*tfplugin5.GetMetadata_DataSourceMetadata{},
+				Diagnostics:        []*tfplugin5.Diagnostic{},
+				EphemeralResources: []*tfplugin5.GetMetadata_EphemeralResourceMetadata{},
 				Functions: []*tfplugin5.GetMetadata_FunctionMetadata{
 					{
 						Name: "test",
@@ -151,9 +201,11 @@ func TestGetMetadata_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin5.GetMetadata_Response{
-				DataSources: []*tfplugin5.GetMetadata_DataSourceMetadata{},
-				Diagnostics: []*tfplugin5.Diagnostic{},
-				Functions:   []*tfplugin5.GetMetadata_FunctionMetadata{},
+				Actions:            []*tfplugin5.GetMetadata_ActionMetadata{},
+				DataSources:        []*tfplugin5.GetMetadata_DataSourceMetadata{},
+				Diagnostics:        []*tfplugin5.Diagnostic{},
+				EphemeralResources: []*tfplugin5.GetMetadata_EphemeralResourceMetadata{},
+				Functions:          []*tfplugin5.GetMetadata_FunctionMetadata{},
 				Resources: []*tfplugin5.GetMetadata_ResourceMetadata{
 					{
 						TypeName: "test",
@@ -168,10 +220,12 @@ func TestGetMetadata_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin5.GetMetadata_Response{
-				DataSources: []*tfplugin5.GetMetadata_DataSourceMetadata{},
-				Diagnostics: []*tfplugin5.Diagnostic{},
-				Functions:   []*tfplugin5.GetMetadata_FunctionMetadata{},
-				Resources:   []*tfplugin5.GetMetadata_ResourceMetadata{},
+				Actions:            []*tfplugin5.GetMetadata_ActionMetadata{},
+				DataSources:        []*tfplugin5.GetMetadata_DataSourceMetadata{},
+				Diagnostics:        []*tfplugin5.Diagnostic{},
+				EphemeralResources: []*tfplugin5.GetMetadata_EphemeralResourceMetadata{},
+				Functions:          []*tfplugin5.GetMetadata_FunctionMetadata{},
+				Resources:          []*tfplugin5.GetMetadata_ResourceMetadata{},
 				ServerCapabilities: &tfplugin5.ServerCapabilities{
 					PlanDestroy: true,
 				},
@@ -193,7 +247,9 @@ func TestGetMetadata_Response(t *testing.T) {
 			// benefits.
 			diffOpts := cmpopts.IgnoreUnexported(
 				tfplugin5.Diagnostic{},
+				tfplugin5.GetMetadata_ActionMetadata{},
 				tfplugin5.GetMetadata_DataSourceMetadata{},
+				tfplugin5.GetMetadata_EphemeralResourceMetadata{},
 				tfplugin5.GetMetadata_FunctionMetadata{},
 				tfplugin5.GetMetadata_Response{},
 				tfplugin5.GetMetadata_ResourceMetadata{},
@@ -221,10 +277,12 @@ func TestGetProviderSchema_Response(t *testing.T) {
 		"zero": {
 			in: &tfprotov5.GetProviderSchemaResponse{},
 			expected: &tfplugin5.GetProviderSchema_Response{
-				DataSourceSchemas: map[string]*tfplugin5.Schema{},
-				Diagnostics:       []*tfplugin5.Diagnostic{},
-				Functions:         map[string]*tfplugin5.Function{},
-				ResourceSchemas:   map[string]*tfplugin5.Schema{},
+				ActionSchemas:            map[string]*tfplugin5.ActionSchema{},
+				DataSourceSchemas:        map[string]*tfplugin5.Schema{},
+				Diagnostics:              []*tfplugin5.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin5.Schema{},
+				Functions:                map[string]*tfplugin5.Function{},
+				ResourceSchemas:          map[string]*tfplugin5.Schema{},
 			},
 		},
 		"DataSources": {
@@ -242,6 +300,7 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin5.GetProviderSchema_Response{
+				ActionSchemas: map[string]*tfplugin5.ActionSchema{},
 				DataSourceSchemas: map[string]*tfplugin5.Schema{
 					"test": {
 						Block: &tfplugin5.Schema_Block{
@@ -254,6 +313,79 @@ func TestGetProviderSchema_Response(t *testing.T) {
 						},
 					},
 				},
+				Diagnostics:              []*tfplugin5.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin5.Schema{},
+				Functions:                map[string]*tfplugin5.Function{},
+				ResourceSchemas:          map[string]*tfplugin5.Schema{},
+			},
+		},
+		"ActionSchemas": {
+			in: &tfprotov5.GetProviderSchemaResponse{
+				ActionSchemas: map[string]*tfprotov5.ActionSchema{
+					"test": {
+						Schema: &tfprotov5.Schema{
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name: "test",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfplugin5.GetProviderSchema_Response{
+				ActionSchemas: map[string]*tfplugin5.ActionSchema{
+					"test": {
+						Schema: &tfplugin5.Schema{
+							Block: &tfplugin5.Schema_Block{
+								Attributes: []*tfplugin5.Schema_Attribute{
+									{
+										Name: "test",
+									},
+								},
+								BlockTypes: []*tfplugin5.Schema_NestedBlock{},
+							},
+						},
+					},
+				},
+				DataSourceSchemas:        map[string]*tfplugin5.Schema{},
+				Diagnostics:              []*tfplugin5.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin5.Schema{},
+				Functions:                map[string]*tfplugin5.Function{},
+				ResourceSchemas:          map[string]*tfplugin5.Schema{},
+			},
+		},
+		"EphemeralResources": {
+			in: &tfprotov5.GetProviderSchemaResponse{
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{
+					"test": {
+						Block: &tfprotov5.SchemaBlock{
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name: "test",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfplugin5.GetProviderSchema_Response{
+				ActionSchemas:     map[string]*tfplugin5.ActionSchema{},
+				DataSourceSchemas: map[string]*tfplugin5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfplugin5.Schema{
+					"test": {
+						Block: &tfplugin5.Schema_Block{
+							Attributes: []*tfplugin5.Schema_Attribute{
+								{
+									Name: "test",
+								},
+							},
+							BlockTypes: []*tfplugin5.Schema_NestedBlock{},
+						},
+					},
+				},
 				Diagnostics:     []*tfplugin5.Diagnostic{},
 				Functions:       map[string]*tfplugin5.Function{},
 				ResourceSchemas: map[string]*tfplugin5.Schema{},
@@ -266,12 +398,14 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin5.GetProviderSchema_Response{
+				ActionSchemas:     map[string]*tfplugin5.ActionSchema{},
 				DataSourceSchemas: map[string]*tfplugin5.Schema{},
 				Diagnostics: []*tfplugin5.Diagnostic{
 					testTfplugin5Diagnostic,
 				},
-				Functions:       map[string]*tfplugin5.Function{},
-				ResourceSchemas: map[string]*tfplugin5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfplugin5.Schema{},
+				Functions:                map[string]*tfplugin5.Function{},
+				ResourceSchemas:          map[string]*tfplugin5.Schema{},
 			},
 		},
 		"Functions": {
@@ -285,8 +419,10 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin5.GetProviderSchema_Response{
-				DataSourceSchemas: map[string]*tfplugin5.Schema{},
-				Diagnostics:       []*tfplugin5.Diagnostic{},
+				ActionSchemas:            map[string]*tfplugin5.ActionSchema{},
+				DataSourceSchemas:        map[string]*tfplugin5.Schema{},
+				Diagnostics:              []*tfplugin5.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin5.Schema{},
 				Functions: map[string]*tfplugin5.Function{
 					"test": {
 						Parameters: []*tfplugin5.Function_Parameter{},
@@ -311,9 +447,11 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin5.GetProviderSchema_Response{
-				DataSourceSchemas: map[string]*tfplugin5.Schema{},
-				Diagnostics:       []*tfplugin5.Diagnostic{},
-				Functions:         map[string]*tfplugin5.Function{},
+				ActionSchemas:            map[string]*tfplugin5.ActionSchema{},
+				DataSourceSchemas:        map[string]*tfplugin5.Schema{},
+				Diagnostics:              []*tfplugin5.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin5.Schema{},
+				Functions:                map[string]*tfplugin5.Function{},
 				Provider: &tfplugin5.Schema{
 					Block: &tfplugin5.Schema_Block{
 						Attributes: []*tfplugin5.Schema_Attribute{
@@ -340,9 +478,11 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin5.GetProviderSchema_Response{
-				DataSourceSchemas: map[string]*tfplugin5.Schema{},
-				Diagnostics:       []*tfplugin5.Diagnostic{},
-				Functions:         map[string]*tfplugin5.Function{},
+				ActionSchemas:            map[string]*tfplugin5.ActionSchema{},
+				DataSourceSchemas:        map[string]*tfplugin5.Schema{},
+				Diagnostics:              []*tfplugin5.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin5.Schema{},
+				Functions:                map[string]*tfplugin5.Function{},
 				ProviderMeta: &tfplugin5.Schema{
 					Block: &tfplugin5.Schema_Block{
 						Attributes: []*tfplugin5.Schema_Attribute{
@@ -371,9 +511,11 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin5.GetProviderSchema_Response{
-				DataSourceSchemas: map[string]*tfplugin5.Schema{},
-				Diagnostics:       []*tfplugin5.Diagnostic{},
-				Functions:         map[string]*tfplugin5.Function{},
+				ActionSchemas:            map[string]*tfplugin5.ActionSchema{},
+				DataSourceSchemas:        map[string]*tfplugin5.Schema{},
+				Diagnostics:              []*tfplugin5.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin5.Schema{},
+				Functions:                map[string]*tfplugin5.Function{},
 				ResourceSchemas: map[string]*tfplugin5.Schema{
 					"test": {
 						Block: &tfplugin5.Schema_Block{
@@ -395,10 +537,12 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin5.GetProviderSchema_Response{
-				DataSourceSchemas: map[string]*tfplugin5.Schema{},
-				Diagnostics:       []*tfplugin5.Diagnostic{},
-				Functions:         map[string]*tfplugin5.Function{},
-				ResourceSchemas:   map[string]*tfplugin5.Schema{},
+				ActionSchemas:            map[string]*tfplugin5.ActionSchema{},
+				DataSourceSchemas:        map[string]*tfplugin5.Schema{},
+				Diagnostics:              []*tfplugin5.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin5.Schema{},
+				Functions:                map[string]*tfplugin5.Function{},
+				ResourceSchemas:          map[string]*tfplugin5.Schema{},
 				ServerCapabilities: &tfplugin5.ServerCapabilities{
 					PlanDestroy: true,
 				},
@@ -419,6 +563,7 @@ func TestGetProviderSchema_Response(t *testing.T) {
 			// writing a custom Comparer for each type, which would have no
 			// benefits.
 			diffOpts := cmpopts.IgnoreUnexported(
+				tfplugin5.ActionSchema{},
 				tfplugin5.Diagnostic{},
 				tfplugin5.Function{},
 				tfplugin5.Function_Return{},
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+)
+
+func RawState(in *tfprotov5.RawState) *tfplugin5.RawState {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.RawState{
+		Json:    in.JSON,
+		Flatmap: in.Flatmap,
+	}
+
+	return resp
+}
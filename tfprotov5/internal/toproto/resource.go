@@ -143,3 +143,113 @@ func MoveResourceState_Response(in *tfprotov5.MoveResourceStateResponse) *tfplug
 
 	return resp
 }
+
+func ValidateResourceTypeConfig_Request(in *tfprotov5.ValidateResourceTypeConfigRequest) *tfplugin5.ValidateResourceTypeConfig_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.ValidateResourceTypeConfig_Request{
+		ClientCapabilities: ValidateResourceTypeConfigClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TypeName:           in.TypeName,
+	}
+
+	return resp
+}
+
+func UpgradeResourceState_Request(in *tfprotov5.UpgradeResourceStateRequest) *tfplugin5.UpgradeResourceState_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.UpgradeResourceState_Request{
+		RawState: RawState(in.RawState),
+		TypeName: in.TypeName,
+		Version:  in.Version,
+	}
+
+	return resp
+}
+
+func ReadResource_Request(in *tfprotov5.ReadResourceRequest) *tfplugin5.ReadResource_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.ReadResource_Request{
+		ClientCapabilities: ReadResourceClientCapabilities(in.ClientCapabilities),
+		CurrentState:       DynamicValue(in.CurrentState),
+		Private:            in.Private,
+		ProviderMeta:       DynamicValue(in.ProviderMeta),
+		TypeName:           in.TypeName,
+	}
+
+	return resp
+}
+
+func PlanResourceChange_Request(in *tfprotov5.PlanResourceChangeRequest) *tfplugin5.PlanResourceChange_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.PlanResourceChange_Request{
+		ClientCapabilities: PlanResourceChangeClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		PriorPrivate:       in.PriorPrivate,
+		PriorState:         DynamicValue(in.PriorState),
+		ProposedNewState:   DynamicValue(in.ProposedNewState),
+		ProviderMeta:       DynamicValue(in.ProviderMeta),
+		TypeName:           in.TypeName,
+	}
+
+	return resp
+}
+
+func ApplyResourceChange_Request(in *tfprotov5.ApplyResourceChangeRequest) *tfplugin5.ApplyResourceChange_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.ApplyResourceChange_Request{
+		Config:         DynamicValue(in.Config),
+		PlannedPrivate: in.PlannedPrivate,
+		PlannedState:   DynamicValue(in.PlannedState),
+		PriorState:     DynamicValue(in.PriorState),
+		ProviderMeta:   DynamicValue(in.ProviderMeta),
+		TypeName:       in.TypeName,
+	}
+
+	return resp
+}
+
+func ImportResourceState_Request(in *tfprotov5.ImportResourceStateRequest) *tfplugin5.ImportResourceState_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.ImportResourceState_Request{
+		ClientCapabilities: ImportResourceStateClientCapabilities(in.ClientCapabilities),
+		Id:                 in.ID,
+		TypeName:           in.TypeName,
+	}
+
+	return resp
+}
+
+func MoveResourceState_Request(in *tfprotov5.MoveResourceStateRequest) *tfplugin5.MoveResourceState_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin5.MoveResourceState_Request{
+		SourcePrivate:         in.SourcePrivate,
+		SourceProviderAddress: in.SourceProviderAddress,
+		SourceSchemaVersion:   in.SourceSchemaVersion,
+		SourceState:           RawState(in.SourceState),
+		SourceTypeName:        in.SourceTypeName,
+		TargetTypeName:        in.TargetTypeName,
+	}
+
+	return resp
+}
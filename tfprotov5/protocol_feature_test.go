@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestProtocolFeatureSupported(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		feature  tfprotov5.ProtocolFeature
+		expected bool
+	}{
+		"actions": {
+			feature:  tfprotov5.ProtocolFeatureActions,
+			expected: true,
+		},
+		"deferred-actions": {
+			feature:  tfprotov5.ProtocolFeatureDeferredActions,
+			expected: true,
+		},
+		"ephemeral-resources": {
+			feature:  tfprotov5.ProtocolFeatureEphemeralResources,
+			expected: true,
+		},
+		"functions": {
+			feature:  tfprotov5.ProtocolFeatureFunctions,
+			expected: true,
+		},
+		"move-resource-state": {
+			feature:  tfprotov5.ProtocolFeatureMoveResourceState,
+			expected: true,
+		},
+		"resource-identity": {
+			feature:  tfprotov5.ProtocolFeatureResourceIdentity,
+			expected: false,
+		},
+		"write-only-attributes": {
+			feature:  tfprotov5.ProtocolFeatureWriteOnlyAttributes,
+			expected: true,
+		},
+		"unrecognized": {
+			feature:  tfprotov5.ProtocolFeature("unrecognized"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.feature.Supported()
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestProtocolFeatures(t *testing.T) {
+	t.Parallel()
+
+	expected := map[tfprotov5.ProtocolFeature]bool{
+		tfprotov5.ProtocolFeatureActions:             true,
+		tfprotov5.ProtocolFeatureDeferredActions:     true,
+		tfprotov5.ProtocolFeatureEphemeralResources:  true,
+		tfprotov5.ProtocolFeatureFunctions:           true,
+		tfprotov5.ProtocolFeatureMoveResourceState:   true,
+		tfprotov5.ProtocolFeatureResourceIdentity:    false,
+		tfprotov5.ProtocolFeatureWriteOnlyAttributes: true,
+	}
+
+	got := tfprotov5.ProtocolFeatures()
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	// Modifying the result must not affect support.
+	got[tfprotov5.ProtocolFeatureResourceIdentity] = true
+
+	if tfprotov5.ProtocolFeatureResourceIdentity.Supported() {
+		t.Error("expected modifying ProtocolFeatures result to not affect support")
+	}
+}
+
+func TestProtocolFeaturesProtocolVersion(t *testing.T) {
+	t.Parallel()
+
+	// introducedMinor contains the protocol minor version which introduced
+	// each feature. A feature must not be reported as supported before
+	// ProtocolVersionMinor includes it.
+	introducedMinor := map[tfprotov5.ProtocolFeature]int{
+		tfprotov5.ProtocolFeatureActions:             10,
+		tfprotov5.ProtocolFeatureDeferredActions:     6,
+		tfprotov5.ProtocolFeatureEphemeralResources:  7,
+		tfprotov5.ProtocolFeatureFunctions:           5,
+		tfprotov5.ProtocolFeatureMoveResourceState:   5,
+		tfprotov5.ProtocolFeatureResourceIdentity:    9,
+		tfprotov5.ProtocolFeatureWriteOnlyAttributes: 8,
+	}
+
+	for feature, supported := range tfprotov5.ProtocolFeatures() {
+		minor, ok := introducedMinor[feature]
+
+		if !ok {
+			t.Errorf("missing introduced protocol version for feature %q", feature)
+
+			continue
+		}
+
+		if supported && minor > tfprotov5.ProtocolVersionMinor {
+			t.Errorf("feature %q requires protocol version %d.%d, but ProtocolVersionMinor is %d", feature, tfprotov5.ProtocolVersionMajor, minor, tfprotov5.ProtocolVersionMinor)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+import "fmt"
+
+const (
+	// ProtocolVersionMajor is the major version number of the protocol
+	// implemented by this version of the package. Terraform and providers
+	// must agree on it during the plugin handshake.
+	ProtocolVersionMajor = 5
+
+	// ProtocolVersionMinor is the minor version number of the protocol
+	// definitions in this version of the package. Minor versions contain
+	// backwards compatible additions to the protocol definitions, however
+	// some features, such as resource identity, may not be supported yet.
+	// ProtocolVersion and ProtocolVersionSupported only report minor versions
+	// whose features are all supported.
+	ProtocolVersionMinor = 10
+)
+
+// ProtocolVersion returns the combined major and minor version numbers of the
+// newest protocol version fully implemented by this version of the package,
+// such as "5.8". Use ProtocolFeature.Supported to check features of newer
+// minor versions.
+func ProtocolVersion() string {
+	return fmt.Sprintf("%d.%d", ProtocolVersionMajor, supportedProtocolVersionMinor())
+}
+
+// ProtocolVersionSupported returns true if the given protocol version is
+// fully implemented by this version of the package, which is the case when
+// the major version matches ProtocolVersionMajor and every ProtocolFeature
+// introduced in the minor version or earlier is supported. SDKs and mux
+// servers can use this to check compatibility at runtime. Use
+// ProtocolFeature.Supported to check individual features.
+func ProtocolVersionSupported(major int, minor int) bool {
+	return major == ProtocolVersionMajor && minor >= 0 && minor <= supportedProtocolVersionMinor()
+}
+
+// supportedProtocolVersionMinor returns the newest protocol minor version,
+// up to ProtocolVersionMinor, whose features and those of all earlier minor
+// versions are supported.
+func supportedProtocolVersionMinor() int {
+	minor := ProtocolVersionMinor
+
+	for feature, introduced := range protocolFeatureMinorVersions {
+		if introduced <= minor && !feature.Supported() {
+			minor = introduced - 1
+		}
+	}
+
+	return minor
+}
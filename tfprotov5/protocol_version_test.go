@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestProtocolVersion(t *testing.T) {
+	t.Parallel()
+
+	if got, expected := tfprotov5.ProtocolVersion(), "5.8"; got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestProtocolVersionSupported(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		major    int
+		minor    int
+		expected bool
+	}{
+		"current": {
+			major:    tfprotov5.ProtocolVersionMajor,
+			minor:    8,
+			expected: true,
+		},
+		"unsupported-feature": {
+			// Resource identity was introduced in 5.9.
+			major:    tfprotov5.ProtocolVersionMajor,
+			minor:    9,
+			expected: false,
+		},
+		"definitions": {
+			major:    tfprotov5.ProtocolVersionMajor,
+			minor:    tfprotov5.ProtocolVersionMinor,
+			expected: false,
+		},
+		"older-minor": {
+			major:    tfprotov5.ProtocolVersionMajor,
+			minor:    0,
+			expected: true,
+		},
+		"newer-minor": {
+			major:    tfprotov5.ProtocolVersionMajor,
+			minor:    tfprotov5.ProtocolVersionMinor + 1,
+			expected: false,
+		},
+		"negative-minor": {
+			major:    tfprotov5.ProtocolVersionMajor,
+			minor:    -1,
+			expected: false,
+		},
+		"other-major": {
+			major:    tfprotov5.ProtocolVersionMajor + 1,
+			minor:    0,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfprotov5.ProtocolVersionSupported(testCase.major, testCase.minor); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
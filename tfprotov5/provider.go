@@ -10,11 +10,47 @@ import (
 // ProviderServer is an interface that reflects that Terraform protocol.
 // Providers must implement this interface.
 type ProviderServer interface {
+	// ProviderConfigServer is an interface encapsulating the
+	// provider-level RPC requests, such as returning schemas and
+	// configuring the provider. ProviderServer implementations must
+	// implement them, but they are their own interface that is composed into
+	// ProviderServer, so they can be implemented separately from the other
+	// parts of a provider.
+	ProviderConfigServer
+
+	// ResourceServer is an interface encapsulating all the
+	// resource-related RPC requests. ProviderServer implementations must
+	// implement them, but they are a handy interface for defining what a
+	// resource is to terraform-plugin-go, so they're their own interface
+	// that is composed into ProviderServer.
+	ResourceServer
+
+	// DataSourceServer is an interface encapsulating all the data
+	// source-related RPC requests. ProviderServer implementations must
+	// implement them, but they are a handy interface for defining what a
+	// data source is to terraform-plugin-go, so they're their own
+	// interface that is composed into ProviderServer.
+	DataSourceServer
+
+	// FunctionServer is an interface encapsulating all the function-related RPC
+	// requests. ProviderServer implementations must implement them, but they
+	// are a handy interface for defining what a function is to
+	// terraform-plugin-go, so they are their own interface that is composed
+	// into ProviderServer.
+	FunctionServer
+}
+
+// ProviderConfigServer is an interface containing the provider-level methods
+// of ProviderServer, which are not specific to resources, data sources, or
+// functions.
+type ProviderConfigServer interface {
 	// GetMetadata returns upfront information about server capabilities and
 	// supported resource types without requiring the server to instantiate all
 	// schema information, which may be memory intensive. This RPC is optional,
 	// where clients may receive an unimplemented RPC error. Clients should
 	// ignore the error and call the GetProviderSchema RPC as a fallback.
+	// Implementations can return the Metadata of their GetProviderSchema
+	// response when there is no more efficient source of the metadata.
 	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
 
 	// GetProviderSchema is called when Terraform needs to know what the
@@ -33,27 +69,47 @@ type ProviderServer interface {
 	// StopProvider is called when Terraform would like providers to shut
 	// down as quickly as possible, and usually represents an interrupt.
 	StopProvider(context.Context, *StopProviderRequest) (*StopProviderResponse, error)
+}
 
-	// ResourceServer is an interface encapsulating all the
-	// resource-related RPC requests. ProviderServer implementations must
-	// implement them, but they are a handy interface for defining what a
-	// resource is to terraform-plugin-go, so they're their own interface
-	// that is composed into ProviderServer.
-	ResourceServer
+// ProviderServerWithEncodedProviderSchema is an optional interface for
+// ProviderServer implementations which can supply the GetProviderSchema
+// response as protocol buffers data encoded ahead of time, such as during a
+// build step of a generated provider. When implemented, the server returns the
+// decoded data instead of calling GetProviderSchema, which avoids constructing
+// a GetProviderSchemaResponse for very large schemas.
+//
+// Use the tf5server.EncodeGetProviderSchemaResponse function to create the
+// encoded data.
+type ProviderServerWithEncodedProviderSchema interface {
+	ProviderServer
+
+	// EncodedProviderSchema returns the encoded GetProviderSchema
+	// response.
+	EncodedProviderSchema(context.Context, *GetProviderSchemaRequest) ([]byte, error)
+}
 
-	// DataSourceServer is an interface encapsulating all the data
-	// source-related RPC requests. ProviderServer implementations must
-	// implement them, but they are a handy interface for defining what a
-	// data source is to terraform-plugin-go, so they're their own
-	// interface that is composed into ProviderServer.
-	DataSourceServer
+// ProviderServerWithEphemeralResources is an optional interface for
+// ProviderServer implementations which support ephemeral resources. The
+// ephemeral resource RPCs return an error diagnostic for ProviderServer
+// implementations which do not implement this interface.
+type ProviderServerWithEphemeralResources interface {
+	ProviderServer
 
-	// FunctionServer is an interface encapsulating all the function-related RPC
-	// requests. ProviderServer implementations must implement them, but they
-	// are a handy interface for defining what a function is to
-	// terraform-plugin-go, so they are their own interface that is composed
-	// into ProviderServer.
-	FunctionServer
+	// EphemeralResourceServer is an interface encapsulating all the
+	// ephemeral resource-related RPC requests.
+	EphemeralResourceServer
+}
+
+// ProviderServerWithActions is an optional interface for ProviderServer
+// implementations which support actions. The action RPCs return an error
+// diagnostic for ProviderServer implementations which do not implement this
+// interface.
+type ProviderServerWithActions interface {
+	ProviderServer
+
+	// ActionServer is an interface encapsulating all the action-related
+	// RPC requests.
+	ActionServer
 }
 
 // GetMetadataRequest represents a GetMetadata RPC request.
@@ -70,9 +126,15 @@ type GetMetadataResponse struct {
 	// no errors or warnings generated.
 	Diagnostics []*Diagnostic
 
+	// Actions returns metadata for all actions.
+	Actions []ActionMetadata
+
 	// DataSources returns metadata for all data resources.
 	DataSources []DataSourceMetadata
 
+	// EphemeralResources returns metadata for all ephemeral resources.
+	EphemeralResources []EphemeralResourceMetadata
+
 	// Functions returns metadata for all functions.
 	Functions []FunctionMetadata
 
@@ -116,6 +178,19 @@ type GetProviderSchemaResponse struct {
 	// `data` in a user's configuration.
 	DataSourceSchemas map[string]*Schema
 
+	// EphemeralResourceSchemas is a map of ephemeral resource names to the
+	// schema for the configuration specified in the ephemeral resource.
+	// The name should be an ephemeral resource name, and should be
+	// prefixed with your provider's shortname and an underscore. It should
+	// match the first label after `ephemeral` in a user's configuration.
+	EphemeralResourceSchemas map[string]*Schema
+
+	// ActionSchemas is a map of action names to their schema. The name
+	// should be an action name, and should be prefixed with your
+	// provider's shortname and an underscore. It should match the first
+	// label after `action` in a user's configuration.
+	ActionSchemas map[string]*ActionSchema
+
 	// Functions is a map of function names to their definition.
 	//
 	// Unlike data resources and managed resources, the name should NOT be
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// configEnvReference matches ${env:NAME} references, including references
+// escaped with an additional leading $.
+var configEnvReference = regexp.MustCompile(`\$?\$\{env:([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// ConfigValueOpts contains options for ConfigureProviderRequest.ConfigValue.
+type ConfigValueOpts struct {
+	// ExpandEnv enables replacing ${env:NAME} references in known string
+	// values with the value of the NAME environment variable. References
+	// can be escaped as $${env:NAME}, which results in a literal
+	// ${env:NAME}. Referencing an environment variable which is not set
+	// results in an error diagnostic.
+	ExpandEnv bool
+
+	// LookupEnv is the function used to read environment variables when
+	// ExpandEnv is enabled. When nil, os.LookupEnv is used.
+	LookupEnv func(name string) (string, bool)
+}
+
+// ConfigValue returns the Config of the request decoded using the provider
+// schema, which should be the same schema returned for the provider in the
+// GetProviderSchema response. Any decoding errors or undefined environment
+// variable references are returned as error diagnostics, which include the
+// path of the attribute when available.
+func (r *ConfigureProviderRequest) ConfigValue(schema *Schema, opts ConfigValueOpts) (tftypes.Value, []*Diagnostic) {
+	typ := schema.ValueType()
+
+	if r == nil || r.Config == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	value, err := r.Config.Unmarshal(typ)
+
+	if err != nil {
+		return tftypes.Value{}, []*Diagnostic{
+			configValueDiagnostic("Invalid Provider Configuration", "The provider configuration could not be decoded using the provider schema. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Error: %s", err), configValueErrorPath(err)),
+		}
+	}
+
+	if !opts.ExpandEnv {
+		return value, nil
+	}
+
+	lookupEnv := opts.LookupEnv
+
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+
+	var diags []*Diagnostic
+
+	value, err = tftypes.Transform(value, func(path *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.Type().Is(tftypes.String) || !v.IsKnown() || v.IsNull() {
+			return v, nil
+		}
+
+		var s string
+
+		if err := v.As(&s); err != nil {
+			return v, path.NewError(err)
+		}
+
+		expanded := configEnvReference.ReplaceAllStringFunc(s, func(reference string) string {
+			// Escaped references are unescaped rather than expanded.
+			if reference[1] == '$' {
+				return reference[1:]
+			}
+
+			name := configEnvReference.FindStringSubmatch(reference)[1]
+			envValue, ok := lookupEnv(name)
+
+			if !ok {
+				diags = append(diags, configValueDiagnostic("Undefined Environment Variable",
+					fmt.Sprintf("The provider configuration references the %s environment variable, which is not set.", name),
+					path))
+			}
+
+			return envValue
+		})
+
+		if expanded == s {
+			return v, nil
+		}
+
+		return tftypes.NewValue(v.Type(), expanded), nil
+	})
+
+	if err != nil {
+		diags = append(diags, configValueDiagnostic("Invalid Provider Configuration", "The provider configuration could not be expanded. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("Error: %s", err), configValueErrorPath(err)))
+	}
+
+	return value, diags
+}
+
+// configValueDiagnostic returns an error diagnostic for the attribute path,
+// if any.
+func configValueDiagnostic(summary string, detail string, path *tftypes.AttributePath) *Diagnostic {
+	diag := &Diagnostic{
+		Severity: DiagnosticSeverityError,
+		Summary:  summary,
+		Detail:   detail,
+	}
+
+	if path != nil && len(path.Steps()) > 0 {
+		diag.Attribute = path
+	}
+
+	return diag
+}
+
+// configValueErrorPath returns the attribute path of a
+// tftypes.AttributePathError, or nil for other errors.
+func configValueErrorPath(err error) *tftypes.AttributePath {
+	var pathErr tftypes.AttributePathError
+
+	if errors.As(err, &pathErr) {
+		return pathErr.Path
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestGetProviderSchemaResponseMetadata(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resp     *tfprotov5.GetProviderSchemaResponse
+		expected *tfprotov5.GetMetadataResponse
+	}{
+		"nil": {
+			resp:     nil,
+			expected: nil,
+		},
+		"zero": {
+			resp: &tfprotov5.GetProviderSchemaResponse{},
+			expected: &tfprotov5.GetMetadataResponse{
+				Actions:            []tfprotov5.ActionMetadata{},
+				DataSources:        []tfprotov5.DataSourceMetadata{},
+				EphemeralResources: []tfprotov5.EphemeralResourceMetadata{},
+				Functions:          []tfprotov5.FunctionMetadata{},
+				Resources:          []tfprotov5.ResourceMetadata{},
+			},
+		},
+		"all": {
+			resp: &tfprotov5.GetProviderSchemaResponse{
+				ActionSchemas: map[string]*tfprotov5.ActionSchema{
+					"test_action": {},
+				},
+				DataSourceSchemas: map[string]*tfprotov5.Schema{
+					"test_data_source_b": {},
+					"test_data_source_a": {},
+				},
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityWarning,
+						Summary:  "test summary",
+					},
+				},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_ephemeral_resource": {},
+				},
+				Functions: map[string]*tfprotov5.Function{
+					"test_function": {},
+				},
+				Provider: &tfprotov5.Schema{},
+				ResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_resource_b": {},
+					"test_resource_a": {},
+				},
+				ServerCapabilities: &tfprotov5.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+				},
+			},
+			expected: &tfprotov5.GetMetadataResponse{
+				Actions: []tfprotov5.ActionMetadata{
+					{TypeName: "test_action"},
+				},
+				DataSources: []tfprotov5.DataSourceMetadata{
+					{TypeName: "test_data_source_a"},
+					{TypeName: "test_data_source_b"},
+				},
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityWarning,
+						Summary:  "test summary",
+					},
+				},
+				EphemeralResources: []tfprotov5.EphemeralResourceMetadata{
+					{TypeName: "test_ephemeral_resource"},
+				},
+				Functions: []tfprotov5.FunctionMetadata{
+					{Name: "test_function"},
+				},
+				Resources: []tfprotov5.ResourceMetadata{
+					{TypeName: "test_resource_a"},
+					{TypeName: "test_resource_b"},
+				},
+				ServerCapabilities: &tfprotov5.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.resp.Metadata()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+type testDataSourceServer struct{}
+
+func (s testDataSourceServer) ValidateDataSourceConfig(_ context.Context, _ *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	return &tfprotov5.ValidateDataSourceConfigResponse{}, nil
+}
+
+func (s testDataSourceServer) ReadDataSource(_ context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	return &tfprotov5.ReadDataSourceResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  req.TypeName,
+			},
+		},
+	}, nil
+}
+
+func TestNewProviderServerFromParts(t *testing.T) {
+	t.Parallel()
+
+	server := tfprotov5.NewProviderServerFromParts(tfprotov5.ProviderServerParts{
+		DataSources: testDataSourceServer{},
+	})
+
+	readResp, err := server.ReadDataSource(context.Background(), &tfprotov5.ReadDataSourceRequest{
+		TypeName: "test_data_source",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedReadResp := &tfprotov5.ReadDataSourceResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  "test_data_source",
+			},
+		},
+	}
+
+	if diff := cmp.Diff(readResp, expectedReadResp); diff != "" {
+		t.Errorf("unexpected ReadDataSource difference: %s", diff)
+	}
+
+	stopResp, err := server.StopProvider(context.Background(), &tfprotov5.StopProviderRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(stopResp, &tfprotov5.StopProviderResponse{}); diff != "" {
+		t.Errorf("unexpected StopProvider difference: %s", diff)
+	}
+
+	for name, implements := range map[string]bool{
+		"ProviderServerWithActions": func() bool {
+			_, ok := server.(tfprotov5.ProviderServerWithActions)
+			return ok
+		}(),
+		"ProviderServerWithEphemeralResources": func() bool {
+			_, ok := server.(tfprotov5.ProviderServerWithEphemeralResources)
+			return ok
+		}(),
+	} {
+		if !implements {
+			t.Errorf("expected %s to be implemented", name)
+		}
+	}
+}
+
+func TestNewProviderServerFromPartsNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	server := tfprotov5.NewProviderServerFromParts(tfprotov5.ProviderServerParts{})
+
+	testCases := map[string]struct {
+		call            func() ([]*tfprotov5.Diagnostic, error)
+		expectedSummary string
+	}{
+		"GetProviderSchema": {
+			call: func() ([]*tfprotov5.Diagnostic, error) {
+				resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedSummary: "Provider Configuration Not Implemented",
+		},
+		"ReadResource": {
+			call: func() ([]*tfprotov5.Diagnostic, error) {
+				resp, err := server.ReadResource(context.Background(), &tfprotov5.ReadResourceRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedSummary: "Provider Resource Not Implemented",
+		},
+		"ReadDataSource": {
+			call: func() ([]*tfprotov5.Diagnostic, error) {
+				resp, err := server.ReadDataSource(context.Background(), &tfprotov5.ReadDataSourceRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedSummary: "Provider Data Source Not Implemented",
+		},
+		"GetFunctions": {
+			call: func() ([]*tfprotov5.Diagnostic, error) {
+				resp, err := server.GetFunctions(context.Background(), &tfprotov5.GetFunctionsRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedSummary: "Provider Function Not Implemented",
+		},
+		"OpenEphemeralResource": {
+			call: func() ([]*tfprotov5.Diagnostic, error) {
+				resp, err := server.(tfprotov5.ProviderServerWithEphemeralResources).OpenEphemeralResource(context.Background(), &tfprotov5.OpenEphemeralResourceRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedSummary: "Provider Ephemeral Resource Not Implemented",
+		},
+		"InvokeAction": {
+			call: func() ([]*tfprotov5.Diagnostic, error) {
+				resp, err := server.(tfprotov5.ProviderServerWithActions).InvokeAction(context.Background(), &tfprotov5.InvokeActionRequest{})
+
+				if err != nil {
+					return nil, err
+				}
+
+				var diags []*tfprotov5.Diagnostic
+
+				resp.Events(func(event tfprotov5.InvokeActionEvent) bool {
+					if completed, ok := event.Type.(tfprotov5.CompletedInvokeActionEventType); ok {
+						diags = append(diags, completed.Diagnostics...)
+					}
+
+					return true
+				})
+
+				return diags, nil
+			},
+			expectedSummary: "Provider Action Not Implemented",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags, err := testCase.call()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+			}
+
+			if diags[0].Severity != tfprotov5.DiagnosticSeverityError || diags[0].Summary != testCase.expectedSummary {
+				t.Errorf("unexpected diagnostic: %+v", diags[0])
+			}
+		})
+	}
+}
+
+func TestNewProviderServerFromPartsCallFunctionNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	server := tfprotov5.NewProviderServerFromParts(tfprotov5.ProviderServerParts{})
+
+	resp, err := server.CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resp.Error == nil || resp.Error.Text == "" {
+		t.Errorf("expected function error, got: %+v", resp.Error)
+	}
+}
+
+func TestNewProviderServerFromPartsGetMetadataNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	resp, err := tfprotov5.NewProviderServerFromParts(tfprotov5.ProviderServerParts{}).GetMetadata(context.Background(), &tfprotov5.GetMetadataRequest{})
+
+	if !errors.Is(err, tfprotov5.ErrUnsupportedRPC) {
+		t.Errorf("expected ErrUnsupportedRPC, got: %v", err)
+	}
+
+	if resp != nil {
+		t.Errorf("unexpected response: %v", resp)
+	}
+}
@@ -83,6 +83,10 @@ type ValidateResourceTypeConfigRequest struct {
 	// from knowing the value at request time. Any attributes not directly
 	// set in the configuration will be null.
 	Config *DynamicValue
+
+	// ClientCapabilities defines optionally supported protocol features for the
+	// ValidateResourceTypeConfig RPC, such as forward-compatible Terraform behavior changes.
+	ClientCapabilities *ValidateResourceTypeConfigClientCapabilities
 }
 
 // ValidateResourceTypeConfigResponse is the response from the provider about
@@ -351,6 +355,13 @@ type PlanResourceChangeResponse struct {
 	// Deferred is used to indicate to Terraform that the PlanResourceChange operation
 	// needs to be deferred for a reason.
 	Deferred *Deferred
+
+	// Annotations contains human-readable explanations of the planned
+	// change, such as why attributes are unknown or require replacement.
+	// Annotations are not sent to Terraform. They are logged by the server
+	// and, if the server was started with the WithPlanAnnotationDiagnostics
+	// ServeOpt, returned to Terraform as warning diagnostics.
+	Annotations []*PlanAnnotation
 }
 
 // ApplyResourceChangeRequest is the request Terraform sends when it needs to
@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	// ResourceChangeActionNoop indicates the resource will not change.
+	ResourceChangeActionNoop = "no-op"
+
+	// ResourceChangeActionCreate indicates the resource will be created.
+	ResourceChangeActionCreate = "create"
+
+	// ResourceChangeActionUpdate indicates the resource will be updated in
+	// place.
+	ResourceChangeActionUpdate = "update"
+
+	// ResourceChangeActionDelete indicates the resource will be deleted.
+	ResourceChangeActionDelete = "delete"
+)
+
+// ResourceChange is the JSON representation of a planned resource change. It
+// matches the format of the resource_changes entries in the machine readable
+// plan output of `terraform show -json`, so provider developers can render
+// the result of their plan logic in tests and debug tooling.
+//
+// Providers are not aware of the resource address or name in the
+// configuration, so Address and Name are empty unless set by the caller.
+type ResourceChange struct {
+	// Address is the absolute resource address, such as
+	// examplecloud_thing.example.
+	Address string `json:"address,omitempty"`
+
+	// Mode is always managed for resources.
+	Mode string `json:"mode"`
+
+	// Type is the resource type name.
+	Type string `json:"type"`
+
+	// Name is the resource name in the configuration.
+	Name string `json:"name,omitempty"`
+
+	// Change describes the planned change.
+	Change ResourceChangeChange `json:"change"`
+}
+
+// ResourceChangeChange is the JSON representation of the change portion of a
+// ResourceChange.
+type ResourceChangeChange struct {
+	// Actions is the list of actions Terraform will take, such as
+	// ["create"], ["update"], or ["delete", "create"] for replacement.
+	Actions []string `json:"actions"`
+
+	// Before is the prior state, or null when creating.
+	Before interface{} `json:"before"`
+
+	// After is the planned state with unknown values omitted, or null when
+	// deleting.
+	After interface{} `json:"after"`
+
+	// AfterUnknown mirrors the structure of After, with true for each value
+	// that will only be known after apply.
+	AfterUnknown interface{} `json:"after_unknown"`
+
+	// BeforeSensitive mirrors the structure of Before, with true for each
+	// value of a sensitive attribute.
+	BeforeSensitive interface{} `json:"before_sensitive"`
+
+	// AfterSensitive mirrors the structure of After, with true for each
+	// value of a sensitive attribute.
+	AfterSensitive interface{} `json:"after_sensitive"`
+
+	// ReplacePaths contains the paths of attributes which require the
+	// resource to be replaced and have changed.
+	ReplacePaths [][]interface{} `json:"replace_paths,omitempty"`
+}
+
+// NewResourceChange returns the ResourceChange described by a
+// PlanResourceChange request and response pair, using the resource schema to
+// decode the prior and planned states.
+func NewResourceChange(schema *Schema, req *PlanResourceChangeRequest, resp *PlanResourceChangeResponse) (*ResourceChange, error) {
+	if req == nil || resp == nil {
+		return nil, errors.New("PlanResourceChange request and response are required")
+	}
+
+	typ := schema.ValueType()
+
+	prior, err := resourceChangeValue(typ, req.PriorState)
+
+	if err != nil {
+		return nil, fmt.Errorf("error decoding prior state: %w", err)
+	}
+
+	planned, err := resourceChangeValue(typ, resp.PlannedState)
+
+	if err != nil {
+		return nil, fmt.Errorf("error decoding planned state: %w", err)
+	}
+
+	var block *SchemaBlock
+
+	if schema != nil {
+		block = schema.Block
+	}
+
+	change := ResourceChangeChange{
+		Before:          resourceChangeJSON(prior),
+		After:           resourceChangeJSON(planned),
+		AfterUnknown:    resourceChangeUnknownJSON(planned),
+		BeforeSensitive: resourceChangeSensitiveJSON(block, prior),
+		AfterSensitive:  resourceChangeSensitiveJSON(block, planned),
+	}
+
+	switch {
+	case prior.IsNull() && planned.IsNull():
+		change.Actions = []string{ResourceChangeActionNoop}
+	case prior.IsNull():
+		change.Actions = []string{ResourceChangeActionCreate}
+	case planned.IsNull():
+		change.Actions = []string{ResourceChangeActionDelete}
+	default:
+		for _, path := range resp.RequiresReplace {
+			if resourceChangePathChanged(prior, planned, path) {
+				change.ReplacePaths = append(change.ReplacePaths, resourceChangePathJSON(path))
+			}
+		}
+
+		switch {
+		case len(change.ReplacePaths) > 0:
+			change.Actions = []string{ResourceChangeActionDelete, ResourceChangeActionCreate}
+		case prior.Equal(planned):
+			change.Actions = []string{ResourceChangeActionNoop}
+		default:
+			change.Actions = []string{ResourceChangeActionUpdate}
+		}
+	}
+
+	return &ResourceChange{
+		Mode:   "managed",
+		Type:   req.TypeName,
+		Change: change,
+	}, nil
+}
+
+// resourceChangeValue decodes a DynamicValue, treating a missing DynamicValue
+// as null.
+func resourceChangeValue(typ tftypes.Type, in *DynamicValue) (tftypes.Value, error) {
+	if in == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	return in.Unmarshal(typ)
+}
+
+// resourceChangeJSON returns the JSON representation of a value, where null
+// and unknown values are represented as JSON null.
+func resourceChangeJSON(in tftypes.Value) interface{} {
+	if in.IsNull() || !in.IsKnown() {
+		return nil
+	}
+
+	typ := in.Type()
+
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		_ = in.As(&s)
+		return s
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		_ = in.As(&n)
+		return json.Number(n.Text('f', -1))
+	case typ.Is(tftypes.Bool):
+		var b bool
+		_ = in.As(&b)
+		return b
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		_ = in.As(&elems)
+
+		result := make([]interface{}, 0, len(elems))
+
+		for _, elem := range elems {
+			result = append(result, resourceChangeJSON(elem))
+		}
+
+		return result
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		_ = in.As(&elems)
+
+		result := make(map[string]interface{}, len(elems))
+
+		for key, elem := range elems {
+			result[key] = resourceChangeJSON(elem)
+		}
+
+		return result
+	}
+
+	return nil
+}
+
+// resourceChangeUnknownJSON returns the after_unknown representation of a
+// value: true for unknown values, false for wholly known values, and
+// otherwise a structure mirroring the value.
+func resourceChangeUnknownJSON(in tftypes.Value) interface{} {
+	if !in.IsKnown() {
+		return true
+	}
+
+	typ := in.Type()
+
+	switch {
+	case in.IsNull():
+		return false
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		_ = in.As(&elems)
+
+		result := make([]interface{}, 0, len(elems))
+
+		for _, elem := range elems {
+			result = append(result, resourceChangeUnknownJSON(elem))
+		}
+
+		return result
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		_ = in.As(&elems)
+
+		result := map[string]interface{}{}
+
+		for key, elem := range elems {
+			if unknown := resourceChangeUnknownJSON(elem); unknown != false {
+				result[key] = unknown
+			}
+		}
+
+		return result
+	}
+
+	return false
+}
+
+// resourceChangeSensitiveJSON returns the sensitive representation of a
+// block value: true for sensitive attributes and a structure mirroring
+// nested blocks.
+func resourceChangeSensitiveJSON(block *SchemaBlock, in tftypes.Value) interface{} {
+	if block == nil || in.IsNull() || !in.IsKnown() {
+		return false
+	}
+
+	var attributes map[string]tftypes.Value
+	_ = in.As(&attributes)
+
+	result := map[string]interface{}{}
+
+	for _, attribute := range block.Attributes {
+		if attribute == nil || !attribute.Sensitive {
+			continue
+		}
+
+		result[attribute.Name] = true
+	}
+
+	for _, nestedBlock := range block.BlockTypes {
+		if nestedBlock == nil {
+			continue
+		}
+
+		value, ok := attributes[nestedBlock.TypeName]
+
+		if !ok || value.IsNull() || !value.IsKnown() {
+			continue
+		}
+
+		switch nestedBlock.Nesting {
+		case SchemaNestedBlockNestingModeSingle, SchemaNestedBlockNestingModeGroup:
+			result[nestedBlock.TypeName] = resourceChangeSensitiveJSON(nestedBlock.Block, value)
+		case SchemaNestedBlockNestingModeList, SchemaNestedBlockNestingModeSet:
+			var elems []tftypes.Value
+			_ = value.As(&elems)
+
+			nested := make([]interface{}, 0, len(elems))
+
+			for _, elem := range elems {
+				nested = append(nested, resourceChangeSensitiveJSON(nestedBlock.Block, elem))
+			}
+
+			result[nestedBlock.TypeName] = nested
+		case SchemaNestedBlockNestingModeMap:
+			var elems map[string]tftypes.Value
+			_ = value.As(&elems)
+
+			nested := make(map[string]interface{}, len(elems))
+
+			for key, elem := range elems {
+				nested[key] = resourceChangeSensitiveJSON(nestedBlock.Block, elem)
+			}
+
+			result[nestedBlock.TypeName] = nested
+		}
+	}
+
+	return result
+}
+
+// resourceChangePathChanged returns true if the values at the path differ
+// between the prior and planned states.
+func resourceChangePathChanged(prior tftypes.Value, planned tftypes.Value, path *tftypes.AttributePath) bool {
+	priorAtPath, _, priorErr := tftypes.WalkAttributePath(prior, path)
+	plannedAtPath, _, plannedErr := tftypes.WalkAttributePath(planned, path)
+
+	// A path only existing in one state, such as a newly added list
+	// element, is a change.
+	if priorErr != nil || plannedErr != nil {
+		return priorErr == nil || plannedErr == nil
+	}
+
+	priorValue, priorOk := priorAtPath.(tftypes.Value)
+	plannedValue, plannedOk := plannedAtPath.(tftypes.Value)
+
+	if !priorOk || !plannedOk {
+		return priorOk != plannedOk
+	}
+
+	return !priorValue.Equal(plannedValue)
+}
+
+// resourceChangePathJSON returns the replace_paths representation of an
+// attribute path.
+func resourceChangePathJSON(path *tftypes.AttributePath) []interface{} {
+	steps := path.Steps()
+	result := make([]interface{}, 0, len(steps))
+
+	for _, step := range steps {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			result = append(result, string(step))
+		case tftypes.ElementKeyString:
+			result = append(result, string(step))
+		case tftypes.ElementKeyInt:
+			result = append(result, int64(step))
+		case tftypes.ElementKeyValue:
+			result = append(result, resourceChangeJSON(tftypes.Value(step)))
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+// Copy returns a deep copy of the Schema, which can be safely modified
+// without affecting the original. The tftypes.Type of attributes are not
+// copied as they are immutable. It returns nil if the Schema is nil.
+func (s *Schema) Copy() *Schema {
+	if s == nil {
+		return nil
+	}
+
+	return &Schema{
+		Version: s.Version,
+		Block:   s.Block.Copy(),
+	}
+}
+
+// Copy returns a deep copy of the SchemaBlock, including its attributes and
+// nested blocks. It returns nil if the SchemaBlock is nil.
+func (s *SchemaBlock) Copy() *SchemaBlock {
+	if s == nil {
+		return nil
+	}
+
+	block := *s
+
+	if s.Attributes != nil {
+		block.Attributes = make([]*SchemaAttribute, len(s.Attributes))
+
+		for i, attribute := range s.Attributes {
+			block.Attributes[i] = attribute.Copy()
+		}
+	}
+
+	if s.BlockTypes != nil {
+		block.BlockTypes = make([]*SchemaNestedBlock, len(s.BlockTypes))
+
+		for i, blockType := range s.BlockTypes {
+			block.BlockTypes[i] = blockType.Copy()
+		}
+	}
+
+	return &block
+}
+
+// Copy returns a copy of the SchemaAttribute, including its Default. It
+// returns nil if the SchemaAttribute is nil.
+func (s *SchemaAttribute) Copy() *SchemaAttribute {
+	if s == nil {
+		return nil
+	}
+
+	attribute := *s
+
+	if s.Default != nil {
+		value := s.Default.Copy()
+		attribute.Default = &value
+	}
+
+	return &attribute
+}
+
+// Copy returns a deep copy of the SchemaNestedBlock, including its block. It
+// returns nil if the SchemaNestedBlock is nil.
+func (s *SchemaNestedBlock) Copy() *SchemaNestedBlock {
+	if s == nil {
+		return nil
+	}
+
+	blockType := *s
+	blockType.Block = s.Block.Copy()
+
+	return &blockType
+}
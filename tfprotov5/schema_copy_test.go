@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaCopy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema *tfprotov5.Schema
+	}{
+		"nil": {
+			schema: nil,
+		},
+		"nil-block": {
+			schema: &tfprotov5.Schema{
+				Version: 1,
+			},
+		},
+		"nil-elements": {
+			schema: &tfprotov5.Schema{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{nil},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{nil},
+				},
+			},
+		},
+		"schema": {
+			schema: testSchemaEqualSchema(),
+		},
+		"default": {
+			schema: func() *tfprotov5.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes[0].Default = pointer(tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "test"),
+				}))
+				return s
+			}(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.Copy()
+
+			if diff := cmp.Diff(got, testCase.schema); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if !got.Equal(testCase.schema) {
+				t.Error("expected copy to be equal")
+			}
+		})
+	}
+}
+
+func TestSchemaCopy_Independent(t *testing.T) {
+	t.Parallel()
+
+	schema := testSchemaEqualSchema()
+	got := schema.Copy()
+
+	got.Version = 2
+	got.Block.Description = "other description"
+	got.Block.Attributes[0].Name = "other"
+	got.Block.Attributes = append(got.Block.Attributes, &tfprotov5.SchemaAttribute{
+		Name:     "appended",
+		Type:     tftypes.String,
+		Optional: true,
+	})
+	got.Block.BlockTypes[0].MaxItems = 2
+	got.Block.BlockTypes[0].Block.Attributes[0].Required = false
+
+	if diff := cmp.Diff(schema, testSchemaEqualSchema()); diff != "" {
+		t.Errorf("unexpected original modification: %s", diff)
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ApplyDefaultsOpts configures Schema.ApplyValueDefaults and
+// Schema.ApplyDynamicValueDefaults.
+type ApplyDefaultsOpts struct {
+	// Defaults are the default values of attributes, keyed by the dotted
+	// path of the attribute in the schema, such as "rule.port". Paths do
+	// not include element keys, so the default of an attribute of a nested
+	// block applies to every element of the block. Defaults take
+	// precedence over the Default of the attributes.
+	Defaults map[string]tftypes.Value
+
+	// IgnoreSchemaDefaults, when set to true, indicates that the Default
+	// of the attributes should not be applied, so only Defaults are
+	// applied.
+	IgnoreSchemaDefaults bool
+}
+
+// ApplyValueDefaults returns a copy of the configuration value with null
+// attributes replaced by their default values, which is the effective
+// configuration used to plan a resource change, such as when implementing
+// PlanResourceChange. The value must be of the schema type.
+//
+// Defaults are applied to the attributes of every known, non-null block,
+// including each element of nested blocks. Attributes of null or unknown
+// blocks and unknown attributes are not changed. It returns an error if a
+// path in opts.Defaults is not an attribute of the schema or if a default is
+// not of the attribute type.
+func (s *Schema) ApplyValueDefaults(config tftypes.Value, opts ApplyDefaultsOpts) (tftypes.Value, error) {
+	var block *SchemaBlock
+
+	if s != nil {
+		block = s.Block
+	}
+
+	d, err := newDefaulter(block, opts)
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	if len(d.defaults) == 0 {
+		return config, nil
+	}
+
+	return d.block(tftypes.NewAttributePath(), "", block, config)
+}
+
+// ApplyDynamicValueDefaults unmarshals the configuration value with the
+// schema type and returns a copy of it with defaults applied as described
+// by ApplyValueDefaults.
+func (s *Schema) ApplyDynamicValueDefaults(config *DynamicValue, opts ApplyDefaultsOpts) (tftypes.Value, error) {
+	if config == nil {
+		return tftypes.NewValue(s.ValueType(), nil), nil
+	}
+
+	unmarshalled, err := config.Unmarshal(s.ValueType())
+
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("unable to unmarshal value: %w", err)
+	}
+
+	return s.ApplyValueDefaults(unmarshalled, opts)
+}
+
+// defaulter applies default values, keyed by the dotted schema path of the
+// attribute.
+type defaulter struct {
+	defaults map[string]tftypes.Value
+}
+
+// newDefaulter returns a defaulter with the defaults of the attributes of
+// the block and opts.Defaults, returning an error for invalid defaults.
+func newDefaulter(s *SchemaBlock, opts ApplyDefaultsOpts) (defaulter, error) {
+	attributes := map[string]*SchemaAttribute{}
+
+	schemaDefaultAttributes(attributes, "", s)
+
+	d := defaulter{
+		defaults: map[string]tftypes.Value{},
+	}
+
+	if !opts.IgnoreSchemaDefaults {
+		for path, attribute := range attributes {
+			if attribute.Default != nil {
+				d.defaults[path] = *attribute.Default
+			}
+		}
+	}
+
+	for path, value := range opts.Defaults {
+		if _, ok := attributes[path]; !ok {
+			return defaulter{}, fmt.Errorf("%w: no attribute at path %q", ErrPathNotFound, path)
+		}
+
+		d.defaults[path] = value
+	}
+
+	paths := make([]string, 0, len(d.defaults))
+
+	for path := range d.defaults {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if !schemaDefaultUsable(d.defaults[path], attributes[path].ValueType()) {
+			return defaulter{}, fmt.Errorf("default of attribute %q is not of the attribute type", path)
+		}
+	}
+
+	return d, nil
+}
+
+// block returns the object value of the block with defaults applied to its
+// attributes and nested blocks, where schemaPath is the dotted schema path of
+// the block.
+func (d defaulter) block(path *tftypes.AttributePath, schemaPath string, s *SchemaBlock, value tftypes.Value) (tftypes.Value, error) {
+	if s == nil || value.IsNull() || !value.IsKnown() {
+		return value, nil
+	}
+
+	var values map[string]tftypes.Value
+
+	if err := value.As(&values); err != nil {
+		return tftypes.Value{}, path.NewError(err)
+	}
+
+	result := make(map[string]tftypes.Value, len(values))
+
+	for name, attributeValue := range values {
+		result[name] = attributeValue
+	}
+
+	for _, attribute := range s.Attributes {
+		if attribute == nil {
+			continue
+		}
+
+		attributeValue, ok := values[attribute.Name]
+
+		if !ok {
+			continue
+		}
+
+		result[attribute.Name] = d.attribute(schemaValidationPath(schemaPath, attribute.Name), attributeValue)
+	}
+
+	for _, blockType := range s.BlockTypes {
+		if blockType == nil {
+			continue
+		}
+
+		blockValue, ok := values[blockType.TypeName]
+
+		if !ok {
+			continue
+		}
+
+		defaulted, err := d.nestedBlock(path.WithAttributeName(blockType.TypeName), schemaValidationPath(schemaPath, blockType.TypeName), blockType, blockValue)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		result[blockType.TypeName] = defaulted
+	}
+
+	if err := tftypes.ValidateValue(value.Type(), result); err != nil {
+		return tftypes.Value{}, path.NewError(err)
+	}
+
+	return tftypes.NewValue(value.Type(), result), nil
+}
+
+// nestedBlock returns the value of the nested block with defaults applied to
+// each of its elements.
+func (d defaulter) nestedBlock(path *tftypes.AttributePath, schemaPath string, s *SchemaNestedBlock, value tftypes.Value) (tftypes.Value, error) {
+	if value.IsNull() || !value.IsKnown() {
+		return value, nil
+	}
+
+	switch s.Nesting {
+	case SchemaNestedBlockNestingModeSingle, SchemaNestedBlockNestingModeGroup:
+		return d.block(path, schemaPath, s.Block, value)
+	case SchemaNestedBlockNestingModeList, SchemaNestedBlockNestingModeSet:
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return tftypes.Value{}, path.NewError(err)
+		}
+
+		result := make([]tftypes.Value, 0, len(elements))
+
+		for index, element := range elements {
+			elementPath := path.WithElementKeyInt(index)
+
+			if s.Nesting == SchemaNestedBlockNestingModeSet {
+				elementPath = path.WithElementKeyValue(element)
+			}
+
+			defaulted, err := d.block(elementPath, schemaPath, s.Block, element)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			result = append(result, defaulted)
+		}
+
+		return tftypes.NewValue(value.Type(), result), nil
+	case SchemaNestedBlockNestingModeMap:
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return tftypes.Value{}, path.NewError(err)
+		}
+
+		result := make(map[string]tftypes.Value, len(elements))
+
+		for key, element := range elements {
+			defaulted, err := d.block(path.WithElementKeyString(key), schemaPath, s.Block, element)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			result[key] = defaulted
+		}
+
+		return tftypes.NewValue(value.Type(), result), nil
+	default:
+		return tftypes.Value{}, path.NewErrorf("invalid nesting mode %s", s.Nesting)
+	}
+}
+
+// attribute returns the default of the attribute if its value is null and it
+// has a default, or its value otherwise.
+func (d defaulter) attribute(schemaPath string, value tftypes.Value) tftypes.Value {
+	if !value.IsNull() {
+		return value
+	}
+
+	if defaultValue, ok := d.defaults[schemaPath]; ok {
+		return defaultValue
+	}
+
+	return value
+}
+
+// schemaDefaultAttributes records the attributes of the block and its nested
+// blocks in attributes, keyed by their dotted schema path, where path is the
+// dotted schema path of the block.
+func schemaDefaultAttributes(attributes map[string]*SchemaAttribute, path string, s *SchemaBlock) {
+	if s == nil {
+		return
+	}
+
+	for _, attribute := range s.Attributes {
+		if attribute != nil {
+			attributes[schemaValidationPath(path, attribute.Name)] = attribute
+		}
+	}
+
+	for _, blockType := range s.BlockTypes {
+		if blockType != nil {
+			schemaDefaultAttributes(attributes, schemaValidationPath(path, blockType.TypeName), blockType.Block)
+		}
+	}
+}
+
+// schemaDefaultUsable returns true if the default value can be used as a
+// value of the attribute type.
+func schemaDefaultUsable(value tftypes.Value, typ tftypes.Type) bool {
+	if value.Type() == nil || typ == nil {
+		return false
+	}
+
+	return value.Type().UsableAs(typ)
+}
@@ -0,0 +1,358 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaApplyValueDefaults(t *testing.T) {
+	t.Parallel()
+
+	nestedBlock := &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{
+				Name:     "port",
+				Type:     tftypes.Number,
+				Optional: true,
+				Computed: true,
+				Default:  pointer(tftypes.NewValue(tftypes.Number, 80)),
+			},
+			{
+				Name:     "protocol",
+				Type:     tftypes.String,
+				Optional: true,
+			},
+		},
+	}
+	schema := &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{
+					Name:     "name",
+					Type:     tftypes.String,
+					Optional: true,
+					Computed: true,
+					Default:  pointer(tftypes.NewValue(tftypes.String, "default")),
+				},
+				{
+					Name:     "description",
+					Type:     tftypes.String,
+					Optional: true,
+				},
+				{
+					Name:     "extra",
+					Type:     tftypes.DynamicPseudoType,
+					Optional: true,
+					Computed: true,
+				},
+			},
+			BlockTypes: []*tfprotov5.SchemaNestedBlock{
+				{
+					TypeName: "list",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "set",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "map",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeMap,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "single",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+					Block:    nestedBlock,
+				},
+			},
+		},
+	}
+	schemaType := schema.ValueType().(tftypes.Object) //nolint:forcetypeassert // ValueType always returns an Object
+	nestedType := nestedBlock.ValueType()
+
+	testValue := func(values map[string]tftypes.Value) tftypes.Value {
+		value := map[string]tftypes.Value{
+			"name":        tftypes.NewValue(tftypes.String, nil),
+			"description": tftypes.NewValue(tftypes.String, nil),
+			"extra":       tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+			"list":        tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{}),
+			"set":         tftypes.NewValue(schemaType.AttributeTypes["set"], []tftypes.Value{}),
+			"map":         tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{}),
+			"single":      tftypes.NewValue(nestedType, nil),
+		}
+
+		for name, v := range values {
+			value[name] = v
+		}
+
+		return tftypes.NewValue(schemaType, value)
+	}
+	testNested := func(port interface{}, protocol interface{}) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"port":     tftypes.NewValue(tftypes.Number, port),
+			"protocol": tftypes.NewValue(tftypes.String, protocol),
+		})
+	}
+
+	testCases := map[string]struct {
+		schema        *tfprotov5.Schema
+		value         tftypes.Value
+		opts          tfprotov5.ApplyDefaultsOpts
+		expected      tftypes.Value
+		expectedError string
+	}{
+		"nil-schema": {
+			value:    tftypes.NewValue(tftypes.String, "test"),
+			expected: tftypes.NewValue(tftypes.String, "test"),
+		},
+		"nil-schema-defaults": {
+			value: tftypes.NewValue(tftypes.String, "test"),
+			opts: tfprotov5.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test"),
+				},
+			},
+			expectedError: `path not found in schema: no attribute at path "name"`,
+		},
+		"null": {
+			schema:   schema,
+			value:    tftypes.NewValue(schemaType, nil),
+			expected: tftypes.NewValue(schemaType, nil),
+		},
+		"unknown": {
+			schema:   schema,
+			value:    tftypes.NewValue(schemaType, tftypes.UnknownValue),
+			expected: tftypes.NewValue(schemaType, tftypes.UnknownValue),
+		},
+		"schema-defaults": {
+			schema: schema,
+			value:  testValue(nil),
+			expected: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "default"),
+			}),
+		},
+		"configured": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "configured"),
+			}),
+			expected: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "configured"),
+			}),
+		},
+		"configured-unknown": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"nested-blocks": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{
+					testNested(nil, "tcp"),
+					testNested(443, nil),
+				}),
+				"set": tftypes.NewValue(schemaType.AttributeTypes["set"], []tftypes.Value{
+					testNested(nil, "udp"),
+				}),
+				"map": tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{
+					"key": testNested(nil, nil),
+				}),
+				"single": testNested(nil, nil),
+			}),
+			expected: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "default"),
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{
+					testNested(80, "tcp"),
+					testNested(443, nil),
+				}),
+				"set": tftypes.NewValue(schemaType.AttributeTypes["set"], []tftypes.Value{
+					testNested(80, "udp"),
+				}),
+				"map": tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{
+					"key": testNested(80, nil),
+				}),
+				"single": testNested(80, nil),
+			}),
+		},
+		"nested-block-unknown": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], tftypes.UnknownValue),
+			}),
+			expected: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "default"),
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], tftypes.UnknownValue),
+			}),
+		},
+		"opts-defaults": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"single": testNested(nil, nil),
+			}),
+			opts: tfprotov5.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"name":            tftypes.NewValue(tftypes.String, "override"),
+					"description":     tftypes.NewValue(tftypes.String, "added"),
+					"single.protocol": tftypes.NewValue(tftypes.String, "tcp"),
+				},
+			},
+			expected: testValue(map[string]tftypes.Value{
+				"name":        tftypes.NewValue(tftypes.String, "override"),
+				"description": tftypes.NewValue(tftypes.String, "added"),
+				"single":      testNested(80, "tcp"),
+			}),
+		},
+		"opts-defaults-dynamic": {
+			schema: schema,
+			value:  testValue(nil),
+			opts: tfprotov5.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"extra": tftypes.NewValue(tftypes.Bool, true),
+				},
+			},
+			expected: testValue(map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, "default"),
+				"extra": tftypes.NewValue(tftypes.Bool, true),
+			}),
+		},
+		"ignore-schema-defaults": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"single": testNested(nil, nil),
+			}),
+			opts: tfprotov5.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"description": tftypes.NewValue(tftypes.String, "added"),
+				},
+				IgnoreSchemaDefaults: true,
+			},
+			expected: testValue(map[string]tftypes.Value{
+				"description": tftypes.NewValue(tftypes.String, "added"),
+				"single":      testNested(nil, nil),
+			}),
+		},
+		"opts-defaults-missing-attribute": {
+			schema: schema,
+			value:  testValue(nil),
+			opts: tfprotov5.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"list.missing": tftypes.NewValue(tftypes.String, "test"),
+				},
+			},
+			expectedError: `path not found in schema: no attribute at path "list.missing"`,
+		},
+		"opts-defaults-block": {
+			schema: schema,
+			value:  testValue(nil),
+			opts: tfprotov5.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"single": tftypes.NewValue(nestedType, nil),
+				},
+			},
+			expectedError: `path not found in schema: no attribute at path "single"`,
+		},
+		"opts-defaults-invalid-type": {
+			schema: schema,
+			value:  testValue(nil),
+			opts: tfprotov5.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"list.port": tftypes.NewValue(tftypes.String, "80"),
+				},
+			},
+			expectedError: `default of attribute "list.port" is not of the attribute type`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.schema.ApplyValueDefaults(testCase.value, testCase.opts)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Errorf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaApplyDynamicValueDefaults(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{
+					Name:     "name",
+					Type:     tftypes.String,
+					Required: true,
+				},
+				{
+					Name:     "port",
+					Type:     tftypes.Number,
+					Optional: true,
+					Computed: true,
+					Default:  pointer(tftypes.NewValue(tftypes.Number, 80)),
+				},
+			},
+		},
+	}
+
+	got, err := schema.ApplyDynamicValueDefaults(&tfprotov5.DynamicValue{
+		JSON: []byte(`{"name":"test","port":null}`),
+	}, tfprotov5.ApplyDefaultsOpts{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := tftypes.NewValue(schema.ValueType(), map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test"),
+		"port": tftypes.NewValue(tftypes.Number, 80),
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = schema.ApplyDynamicValueDefaults(&tfprotov5.DynamicValue{
+		JSON: []byte(`{"name":["invalid"]}`),
+	}, tfprotov5.ApplyDefaultsOpts{})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// DeprecationDiagnostics returns a warning Diagnostic for every deprecated
+// attribute or nested block in the schema which is set in the given config,
+// such as the Config of a ValidateResourceTypeConfigRequest, so providers can
+// warn practitioners consistently during deprecations. Each Diagnostic has the
+// path of the attribute or block, including any element steps of the nested
+// blocks containing it.
+//
+// Attributes are set when their value is not null. Nested blocks are set when
+// their value is not null and, for list, set, and map nesting modes, not
+// empty, or for the group nesting mode, when any attribute or block within it
+// is set. Unknown values are considered set. An error is returned if the
+// config cannot be unmarshalled with the schema type.
+func (s *Schema) DeprecationDiagnostics(config *DynamicValue) (Diagnostics, error) {
+	if s == nil || config == nil {
+		return nil, nil
+	}
+
+	value, err := config.Unmarshal(s.ValueType())
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal config: %w", err)
+	}
+
+	var diags Diagnostics
+
+	_, err = s.Block.deprecationDiagnostics(tftypes.NewAttributePath(), value, &diags)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return diags, nil
+}
+
+// deprecationDiagnostics appends the deprecation diagnostics for the
+// attributes and nested blocks set in the block value and returns whether
+// any of them are set.
+func (s *SchemaBlock) deprecationDiagnostics(path *tftypes.AttributePath, value tftypes.Value, diags *Diagnostics) (bool, error) {
+	if s == nil || value.IsNull() || !value.IsKnown() {
+		return false, nil
+	}
+
+	var values map[string]tftypes.Value
+
+	if err := value.As(&values); err != nil {
+		return false, path.NewError(err)
+	}
+
+	var set bool
+
+	for _, attribute := range s.Attributes {
+		if attribute == nil {
+			continue
+		}
+
+		attributeValue, ok := values[attribute.Name]
+
+		if !ok || attributeValue.IsNull() {
+			continue
+		}
+
+		set = true
+
+		if attribute.Deprecated {
+			attributePath := path.WithAttributeName(attribute.Name)
+
+			diags.Append(NewWarningDiagnostic(
+				"Deprecated Attribute",
+				fmt.Sprintf("The %q attribute is deprecated. Refer to the provider documentation for details.", attribute.Name),
+			).WithAttributePath(attributePath))
+		}
+	}
+
+	for _, blockType := range s.BlockTypes {
+		if blockType == nil {
+			continue
+		}
+
+		blockValue, ok := values[blockType.TypeName]
+
+		if !ok {
+			continue
+		}
+
+		blockSet, err := blockType.deprecationDiagnostics(path.WithAttributeName(blockType.TypeName), blockValue, diags)
+
+		if err != nil {
+			return false, err
+		}
+
+		set = set || blockSet
+	}
+
+	return set, nil
+}
+
+// deprecationDiagnostics appends the deprecation diagnostics for the nested
+// block and its elements and returns whether the nested block is set.
+func (s *SchemaNestedBlock) deprecationDiagnostics(path *tftypes.AttributePath, value tftypes.Value, diags *Diagnostics) (bool, error) {
+	if value.IsNull() {
+		return false, nil
+	}
+
+	// Insert the block diagnostic before the diagnostics of its elements,
+	// once it is known whether the block is set.
+	var elementDiags Diagnostics
+
+	set := !value.IsKnown()
+
+	if value.IsKnown() {
+		switch s.Nesting {
+		case SchemaNestedBlockNestingModeSingle:
+			set = true
+
+			if _, err := s.Block.deprecationDiagnostics(path, value, &elementDiags); err != nil {
+				return false, err
+			}
+		case SchemaNestedBlockNestingModeGroup:
+			var err error
+
+			set, err = s.Block.deprecationDiagnostics(path, value, &elementDiags)
+
+			if err != nil {
+				return false, err
+			}
+		case SchemaNestedBlockNestingModeList, SchemaNestedBlockNestingModeSet:
+			var elements []tftypes.Value
+
+			if err := value.As(&elements); err != nil {
+				return false, path.NewError(err)
+			}
+
+			set = len(elements) > 0
+
+			for index, element := range elements {
+				elementPath := path.WithElementKeyInt(index)
+
+				if s.Nesting == SchemaNestedBlockNestingModeSet {
+					elementPath = path.WithElementKeyValue(element)
+				}
+
+				if _, err := s.Block.deprecationDiagnostics(elementPath, element, &elementDiags); err != nil {
+					return false, err
+				}
+			}
+		case SchemaNestedBlockNestingModeMap:
+			var elements map[string]tftypes.Value
+
+			if err := value.As(&elements); err != nil {
+				return false, path.NewError(err)
+			}
+
+			set = len(elements) > 0
+
+			keys := make([]string, 0, len(elements))
+
+			for key := range elements {
+				keys = append(keys, key)
+			}
+
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				if _, err := s.Block.deprecationDiagnostics(path.WithElementKeyString(key), elements[key], &elementDiags); err != nil {
+					return false, err
+				}
+			}
+		}
+	}
+
+	if set && s.Block != nil && s.Block.Deprecated {
+		diags.Append(NewWarningDiagnostic(
+			"Deprecated Block",
+			fmt.Sprintf("The %q block is deprecated. Refer to the provider documentation for details.", s.TypeName),
+		).WithAttributePath(path))
+	}
+
+	diags.Append(elementDiags...)
+
+	return set, nil
+}
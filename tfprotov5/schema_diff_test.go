@@ -0,0 +1,441 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaRenderValueDiff(t *testing.T) {
+	t.Parallel()
+
+	nestedBlock := &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{
+				Name:     "value",
+				Type:     tftypes.String,
+				Optional: true,
+			},
+		},
+	}
+	schema := &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{
+					Name:     "id",
+					Type:     tftypes.String,
+					Computed: true,
+				},
+				{
+					Name:     "name",
+					Type:     tftypes.String,
+					Optional: true,
+				},
+				{
+					Name:      "password",
+					Type:      tftypes.String,
+					Optional:  true,
+					Sensitive: true,
+				},
+				{
+					Name:     "dynamic",
+					Type:     tftypes.DynamicPseudoType,
+					Optional: true,
+				},
+				{
+					Name: "config",
+					Type: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+						"enabled": tftypes.Bool,
+						"size":    tftypes.Number,
+					}},
+					Optional: true,
+				},
+				{
+					Name:     "ports",
+					Type:     tftypes.List{ElementType: tftypes.Number},
+					Optional: true,
+				},
+				{
+					Name:     "ids",
+					Type:     tftypes.Set{ElementType: tftypes.String},
+					Optional: true,
+				},
+				{
+					Name:     "tags",
+					Type:     tftypes.Map{ElementType: tftypes.String},
+					Optional: true,
+				},
+			},
+			BlockTypes: []*tfprotov5.SchemaNestedBlock{
+				{
+					TypeName: "single",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "list",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "set",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "map",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeMap,
+					Block:    nestedBlock,
+				},
+			},
+		},
+	}
+	schemaType := schema.ValueType().(tftypes.Object) //nolint:forcetypeassert // ValueType always returns an Object
+	nestedType := nestedBlock.ValueType()
+	configType := schemaType.AttributeTypes["config"]
+
+	// newValue returns a value of the schema type, with the attributes
+	// and blocks which are not set null.
+	newValue := func(values map[string]tftypes.Value) tftypes.Value {
+		attributes := make(map[string]tftypes.Value, len(schemaType.AttributeTypes))
+
+		for name, attributeType := range schemaType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+
+			if value, ok := values[name]; ok {
+				attributes[name] = value
+			}
+		}
+
+		return tftypes.NewValue(schemaType, attributes)
+	}
+	newNested := func(value string) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"value": tftypes.NewValue(tftypes.String, value),
+		})
+	}
+	newNumbers := func(numbers ...int) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(numbers))
+
+		for _, number := range numbers {
+			elements = append(elements, tftypes.NewValue(tftypes.Number, number))
+		}
+
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, elements)
+	}
+	newStrings := func(typ tftypes.Type, values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(values))
+
+		for _, s := range values {
+			elements = append(elements, tftypes.NewValue(tftypes.String, s))
+		}
+
+		return tftypes.NewValue(typ, elements)
+	}
+	newTags := func(tags map[string]string) tftypes.Value {
+		elements := make(map[string]tftypes.Value, len(tags))
+
+		for key, value := range tags {
+			elements[key] = tftypes.NewValue(tftypes.String, value)
+		}
+
+		return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elements)
+	}
+
+	testCases := map[string]struct {
+		prior    tftypes.Value
+		proposed tftypes.Value
+		opts     tfprotov5.RenderDiffOpts
+		expected string
+	}{
+		"create": {
+			prior: tftypes.NewValue(schemaType, nil),
+			proposed: newValue(map[string]tftypes.Value{
+				"id":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"name":     tftypes.NewValue(tftypes.String, "test"),
+				"password": tftypes.NewValue(tftypes.String, "hunter2"),
+				"ports":    newNumbers(80, 443),
+				"tags":     newTags(map[string]string{}),
+				"single":   newNested("a"),
+			}),
+			expected: `+ {
+    + id       = (known after apply)
+    + name     = "test"
+    + password = (sensitive value)
+    + ports    = [
+        + 80,
+        + 443,
+      ]
+    + tags     = {}
+    + single {
+        + value = "a"
+      }
+  }`,
+		},
+		"destroy": {
+			prior: newValue(map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "abc"),
+				"tags": newTags(map[string]string{"env": "prod"}),
+				"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{newNested("a")}),
+			}),
+			proposed: tftypes.NewValue(schemaType, nil),
+			expected: `- {
+    - id   = "abc" -> null
+    - tags = {
+        - "env" = "prod"
+      } -> null
+    - list {
+        - value = "a" -> null
+      }
+  }`,
+		},
+		"no-changes": {
+			prior: newValue(map[string]tftypes.Value{
+				"id":     tftypes.NewValue(tftypes.String, "abc"),
+				"name":   tftypes.NewValue(tftypes.String, "test"),
+				"single": newNested("a"),
+			}),
+			proposed: newValue(map[string]tftypes.Value{
+				"id":     tftypes.NewValue(tftypes.String, "abc"),
+				"name":   tftypes.NewValue(tftypes.String, "test"),
+				"single": newNested("a"),
+			}),
+			expected: `  {
+      # (2 unchanged attributes hidden)
+      # (1 unchanged block hidden)
+  }`,
+		},
+		"update-attributes": {
+			prior: newValue(map[string]tftypes.Value{
+				"id":       tftypes.NewValue(tftypes.String, "abc"),
+				"name":     tftypes.NewValue(tftypes.String, "before"),
+				"password": tftypes.NewValue(tftypes.String, "hunter2"),
+				"dynamic":  tftypes.NewValue(tftypes.String, "1"),
+				"config": tftypes.NewValue(configType, map[string]tftypes.Value{
+					"enabled": tftypes.NewValue(tftypes.Bool, true),
+					"size":    tftypes.NewValue(tftypes.Number, 1),
+				}),
+			}),
+			proposed: newValue(map[string]tftypes.Value{
+				"id":       tftypes.NewValue(tftypes.String, "abc"),
+				"name":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"password": tftypes.NewValue(tftypes.String, "hunter3"),
+				"dynamic":  newNumbers(1),
+				"config": tftypes.NewValue(configType, map[string]tftypes.Value{
+					"enabled": tftypes.NewValue(tftypes.Bool, true),
+					"size":    tftypes.NewValue(tftypes.Number, 1.5),
+				}),
+			}),
+			expected: `~ {
+    ~ config   = {
+        ~ size = 1 -> 1.5
+          # (1 unchanged attribute hidden)
+      }
+    - dynamic  = "1"
+    + dynamic  = [
+        + 1,
+      ]
+    ~ name     = "before" -> (known after apply)
+    ~ password = (sensitive value)
+      # (1 unchanged attribute hidden)
+  }`,
+		},
+		"update-collections": {
+			prior: newValue(map[string]tftypes.Value{
+				"ports": newNumbers(22, 80, 443, 8080, 8443),
+				"ids":   newStrings(tftypes.Set{ElementType: tftypes.String}, "a", "b"),
+				"tags":  newTags(map[string]string{"env": "prod", "team": "a", "owner": "b"}),
+			}),
+			proposed: newValue(map[string]tftypes.Value{
+				"ports": newNumbers(22, 80, 81, 443, 8443),
+				"ids":   newStrings(tftypes.Set{ElementType: tftypes.String}, "b", "c"),
+				"tags":  newTags(map[string]string{"env": "dev", "team": "a", "cost": "c"}),
+			}),
+			expected: `~ {
+    ~ ids   = [
+          # (1 unchanged element hidden)
+        - "a",
+        + "c",
+      ]
+    ~ ports = [
+          # (2 unchanged elements hidden)
+        + 81,
+          # (1 unchanged element hidden)
+        - 8080,
+          # (1 unchanged element hidden)
+      ]
+    ~ tags  = {
+        + "cost"  = "c"
+        ~ "env"   = "prod" -> "dev"
+        - "owner" = "b" -> null
+          # (1 unchanged element hidden)
+      }
+  }`,
+		},
+		"update-blocks": {
+			prior: newValue(map[string]tftypes.Value{
+				"single": newNested("a"),
+				"list":   tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{newNested("a"), newNested("b")}),
+				"set":    tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{newNested("a"), newNested("b")}),
+				"map": tftypes.NewValue(tftypes.Map{ElementType: nestedType}, map[string]tftypes.Value{
+					"a": newNested("a"),
+				}),
+			}),
+			proposed: newValue(map[string]tftypes.Value{
+				"single": newNested("b"),
+				"list":   tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{newNested("a"), newNested("c"), newNested("d")}),
+				"set":    tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{newNested("a"), newNested("c")}),
+				"map": tftypes.NewValue(tftypes.Map{ElementType: nestedType}, map[string]tftypes.Value{
+					"a": newNested("a"),
+					"b": tftypes.NewValue(nestedType, tftypes.UnknownValue),
+				}),
+			}),
+			expected: `~ {
+    ~ list {
+        ~ value = "b" -> "c"
+      }
+    + list {
+        + value = "d"
+      }
+    + map "b" (known after apply)
+    - set {
+        - value = "b" -> null
+      }
+    + set {
+        + value = "c"
+      }
+    ~ single {
+        ~ value = "a" -> "b"
+      }
+      # (3 unchanged blocks hidden)
+  }`,
+		},
+		"show-unchanged": {
+			prior: newValue(map[string]tftypes.Value{
+				"id":    tftypes.NewValue(tftypes.String, "abc"),
+				"ports": newNumbers(22, 80),
+				"list":  tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{newNested("a")}),
+			}),
+			proposed: newValue(map[string]tftypes.Value{
+				"id":    tftypes.NewValue(tftypes.String, "abc"),
+				"ports": newNumbers(22, 443),
+				"list":  tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{newNested("a")}),
+			}),
+			opts: tfprotov5.RenderDiffOpts{
+				ShowUnchanged: true,
+			},
+			expected: `~ {
+      config   = null
+      dynamic  = null
+      id       = "abc"
+      ids      = null
+      name     = null
+      password = null
+    ~ ports    = [
+          22,
+        - 80,
+        + 443,
+      ]
+      tags     = null
+      list {
+          value = "a"
+      }
+  }`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := schema.RenderValueDiff(testCase.prior, testCase.proposed, testCase.opts)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s\n\ngot:\n%s", diff, got)
+			}
+		})
+	}
+}
+
+func TestSchemaRenderValueDiff_NilSchema(t *testing.T) {
+	t.Parallel()
+
+	listType := tftypes.List{ElementType: tftypes.String}
+	prior := tftypes.NewValue(listType, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "a"),
+	})
+	proposed := tftypes.NewValue(listType, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "a"),
+		tftypes.NewValue(tftypes.String, "b"),
+	})
+
+	got, err := (*tfprotov5.Schema)(nil).RenderValueDiff(prior, proposed, tfprotov5.RenderDiffOpts{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `~ [
+      # (1 unchanged element hidden)
+    + "b",
+  ]`
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestSchemaRenderDynamicValueDiff(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{
+					Name:     "name",
+					Type:     tftypes.String,
+					Optional: true,
+				},
+			},
+		},
+	}
+	proposed, err := tfprotov5.NewDynamicValue(schema.ValueType(), tftypes.NewValue(schema.ValueType(), map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test"),
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error creating DynamicValue: %s", err)
+	}
+
+	got, err := schema.RenderDynamicValueDiff(nil, &proposed, tfprotov5.RenderDiffOpts{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `+ {
+    + name = "test"
+  }`
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = schema.RenderDynamicValueDiff(&tfprotov5.DynamicValue{JSON: []byte(`{"name":`)}, &proposed, tfprotov5.RenderDiffOpts{})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+import "github.com/hashicorp/terraform-plugin-go/tftypes"
+
+// Equal returns true if the Schema is deeply equal to the other Schema,
+// including the ordering of attributes and nested blocks. Two nil Schema are
+// considered equal.
+func (s *Schema) Equal(o *Schema) bool {
+	if s == nil || o == nil {
+		return s == o
+	}
+
+	return s.Version == o.Version && s.Block.Equal(o.Block)
+}
+
+// Equal returns true if the SchemaBlock is deeply equal to the other
+// SchemaBlock, including the ordering of attributes and nested blocks. Two
+// nil SchemaBlock are considered equal.
+func (s *SchemaBlock) Equal(o *SchemaBlock) bool {
+	if s == nil || o == nil {
+		return s == o
+	}
+
+	if s.Version != o.Version ||
+		s.Description != o.Description ||
+		s.DescriptionKind != o.DescriptionKind ||
+		s.Deprecated != o.Deprecated {
+		return false
+	}
+
+	if len(s.Attributes) != len(o.Attributes) || len(s.BlockTypes) != len(o.BlockTypes) {
+		return false
+	}
+
+	for i, attribute := range s.Attributes {
+		if !attribute.Equal(o.Attributes[i]) {
+			return false
+		}
+	}
+
+	for i, blockType := range s.BlockTypes {
+		if !blockType.Equal(o.BlockTypes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal returns true if the SchemaAttribute is deeply equal to the other
+// SchemaAttribute. Two nil SchemaAttribute are considered equal.
+func (s *SchemaAttribute) Equal(o *SchemaAttribute) bool {
+	if s == nil || o == nil {
+		return s == o
+	}
+
+	return s.Name == o.Name &&
+		schemaTypeEqual(s.Type, o.Type) &&
+		s.Description == o.Description &&
+		s.Required == o.Required &&
+		s.Optional == o.Optional &&
+		s.Computed == o.Computed &&
+		s.Sensitive == o.Sensitive &&
+		s.DescriptionKind == o.DescriptionKind &&
+		s.Deprecated == o.Deprecated &&
+		schemaDefaultEqual(s.Default, o.Default)
+}
+
+// Equal returns true if the SchemaNestedBlock is deeply equal to the other
+// SchemaNestedBlock. Two nil SchemaNestedBlock are considered equal.
+func (s *SchemaNestedBlock) Equal(o *SchemaNestedBlock) bool {
+	if s == nil || o == nil {
+		return s == o
+	}
+
+	return s.TypeName == o.TypeName &&
+		s.Nesting == o.Nesting &&
+		s.MinItems == o.MinItems &&
+		s.MaxItems == o.MaxItems &&
+		s.Block.Equal(o.Block)
+}
+
+// schemaTypeEqual returns true if both types are nil or equal.
+func schemaTypeEqual(a tftypes.Type, b tftypes.Type) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.Equal(b)
+}
+
+// schemaDefaultEqual returns true if both attribute defaults are nil or
+// equal values.
+func schemaDefaultEqual(a *tftypes.Value, b *tftypes.Value) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
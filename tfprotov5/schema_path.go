@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	// ErrPathNotFound is returned by AttributeAtPath and BlockAtPath when the
+	// path does not refer to an attribute or block of the expected kind in
+	// the schema.
+	ErrPathNotFound = errors.New("path not found in schema")
+
+	// ErrPathInsideAttribute is returned by AttributeAtPath and BlockAtPath
+	// when the path refers to a value inside an attribute, such as an
+	// element of a list attribute, rather than the attribute itself.
+	ErrPathInsideAttribute = errors.New("path leads inside attribute")
+)
+
+// AttributeAtPath returns the SchemaAttribute at the given path, such as the
+// path of a Diagnostic, so schema metadata like Sensitive or Deprecated can
+// be looked up. Element steps for nested blocks, such as the index of a list
+// block, are followed.
+//
+// The returned error wraps ErrPathNotFound or ErrPathInsideAttribute and is a
+// tftypes.AttributePathError for the invalid part of the path, if any.
+func (s *Schema) AttributeAtPath(path *tftypes.AttributePath) (*SchemaAttribute, error) {
+	if s == nil {
+		return nil, fmt.Errorf("%w: schema is nil", ErrPathNotFound)
+	}
+
+	return s.Block.AttributeAtPath(path)
+}
+
+// BlockAtPath returns the SchemaNestedBlock at the given path. Paths referring
+// to an element of a nested block, such as the index of a list block, return
+// the nested block. See AttributeAtPath for more information.
+func (s *Schema) BlockAtPath(path *tftypes.AttributePath) (*SchemaNestedBlock, error) {
+	if s == nil {
+		return nil, fmt.Errorf("%w: schema is nil", ErrPathNotFound)
+	}
+
+	return s.Block.BlockAtPath(path)
+}
+
+// AttributeAtPath returns the SchemaAttribute at the given path, relative to
+// the SchemaBlock. See Schema.AttributeAtPath for more information.
+func (s *SchemaBlock) AttributeAtPath(path *tftypes.AttributePath) (*SchemaAttribute, error) {
+	attribute, block, err := s.walkPath(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if block != nil {
+		return nil, path.NewError(fmt.Errorf("%w: path is a block, not an attribute", ErrPathNotFound))
+	}
+
+	return attribute, nil
+}
+
+// BlockAtPath returns the SchemaNestedBlock at the given path, relative to
+// the SchemaBlock. See Schema.BlockAtPath for more information.
+func (s *SchemaBlock) BlockAtPath(path *tftypes.AttributePath) (*SchemaNestedBlock, error) {
+	attribute, block, err := s.walkPath(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if attribute != nil {
+		return nil, path.NewError(fmt.Errorf("%w: path is an attribute, not a block", ErrPathNotFound))
+	}
+
+	return block, nil
+}
+
+// walkPath returns either the attribute or the nested block at the path.
+func (s *SchemaBlock) walkPath(path *tftypes.AttributePath) (*SchemaAttribute, *SchemaNestedBlock, error) {
+	steps := path.Steps()
+
+	if len(steps) == 0 {
+		return nil, nil, fmt.Errorf("%w: path is empty", ErrPathNotFound)
+	}
+
+	block := s
+
+	for i := 0; i < len(steps); i++ {
+		stepPath := tftypes.NewAttributePathWithSteps(steps[:i+1])
+
+		if block == nil {
+			return nil, nil, stepPath.NewError(fmt.Errorf("%w: block is nil", ErrPathNotFound))
+		}
+
+		name, ok := steps[i].(tftypes.AttributeName)
+
+		if !ok {
+			return nil, nil, stepPath.NewError(fmt.Errorf("%w: expected attribute name step", ErrPathNotFound))
+		}
+
+		for _, attribute := range block.Attributes {
+			if attribute != nil && attribute.Name == string(name) {
+				if i+1 < len(steps) {
+					return nil, nil, tftypes.NewAttributePathWithSteps(steps[:i+2]).NewError(ErrPathInsideAttribute)
+				}
+
+				return attribute, nil, nil
+			}
+		}
+
+		var blockType *SchemaNestedBlock
+
+		for _, b := range block.BlockTypes {
+			if b != nil && b.TypeName == string(name) {
+				blockType = b
+
+				break
+			}
+		}
+
+		if blockType == nil {
+			return nil, nil, stepPath.NewError(fmt.Errorf("%w: no attribute or block named %q", ErrPathNotFound, name))
+		}
+
+		if i+1 < len(steps) && blockType.Nesting != SchemaNestedBlockNestingModeSingle && blockType.Nesting != SchemaNestedBlockNestingModeGroup {
+			i++
+
+			if !schemaPathElementStepValid(steps[i], blockType.Nesting == SchemaNestedBlockNestingModeList, blockType.Nesting == SchemaNestedBlockNestingModeSet, blockType.Nesting == SchemaNestedBlockNestingModeMap) {
+				return nil, nil, tftypes.NewAttributePathWithSteps(steps[:i+1]).NewError(fmt.Errorf("%w: invalid element step for %s nesting mode", ErrPathNotFound, blockType.Nesting))
+			}
+		}
+
+		if i+1 == len(steps) {
+			return nil, blockType, nil
+		}
+
+		block = blockType.Block
+	}
+
+	return nil, nil, path.NewError(ErrPathNotFound)
+}
+
+// schemaPathElementStepValid returns true if the step is an element step for
+// a list, set, or map collection, as indicated.
+func schemaPathElementStepValid(step tftypes.AttributePathStep, list bool, set bool, m bool) bool {
+	switch step.(type) {
+	case tftypes.ElementKeyInt:
+		return list
+	case tftypes.ElementKeyValue:
+		return set
+	case tftypes.ElementKeyString:
+		return m
+	default:
+		return false
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	testSchemaPathStringAttribute = &tfprotov5.SchemaAttribute{
+		Name:     "string_attribute",
+		Type:     tftypes.String,
+		Optional: true,
+	}
+	testSchemaPathListAttribute = &tfprotov5.SchemaAttribute{
+		Name:     "list_attribute",
+		Type:     tftypes.List{ElementType: tftypes.String},
+		Optional: true,
+	}
+	testSchemaPathBlockAttribute = &tfprotov5.SchemaAttribute{
+		Name:       "block_attribute",
+		Type:       tftypes.String,
+		Optional:   true,
+		Deprecated: true,
+	}
+	testSchemaPathSingleBlock = &tfprotov5.SchemaNestedBlock{
+		TypeName: "single_block",
+		Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{testSchemaPathBlockAttribute},
+		},
+	}
+	testSchemaPathListBlock = &tfprotov5.SchemaNestedBlock{
+		TypeName: "list_block",
+		Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{testSchemaPathBlockAttribute},
+			BlockTypes: []*tfprotov5.SchemaNestedBlock{testSchemaPathSingleBlock},
+		},
+	}
+	testSchemaPathSetBlock = &tfprotov5.SchemaNestedBlock{
+		TypeName: "set_block",
+		Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{testSchemaPathBlockAttribute},
+		},
+	}
+	testSchemaPathMapBlock = &tfprotov5.SchemaNestedBlock{
+		TypeName: "map_block",
+		Nesting:  tfprotov5.SchemaNestedBlockNestingModeMap,
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{testSchemaPathBlockAttribute},
+		},
+	}
+	testSchemaPathSchema = &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				testSchemaPathStringAttribute,
+				testSchemaPathListAttribute,
+			},
+			BlockTypes: []*tfprotov5.SchemaNestedBlock{
+				testSchemaPathListBlock,
+				testSchemaPathSetBlock,
+				testSchemaPathMapBlock,
+			},
+		},
+	}
+)
+
+func TestSchemaAttributeAtPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema        *tfprotov5.Schema
+		path          *tftypes.AttributePath
+		expected      *tfprotov5.SchemaAttribute
+		expectedErr   error
+		expectedError string
+	}{
+		"nil-schema": {
+			schema:        nil,
+			path:          tftypes.NewAttributePath().WithAttributeName("string_attribute"),
+			expectedErr:   tfprotov5.ErrPathNotFound,
+			expectedError: "path not found in schema: schema is nil",
+		},
+		"empty-path": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath(),
+			expectedErr:   tfprotov5.ErrPathNotFound,
+			expectedError: "path not found in schema: path is empty",
+		},
+		"attribute": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("string_attribute"),
+			expected: testSchemaPathStringAttribute,
+		},
+		"attribute-not-found": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("missing"),
+			expectedErr:   tfprotov5.ErrPathNotFound,
+			expectedError: `AttributeName("missing"): path not found in schema: no attribute or block named "missing"`,
+		},
+		"attribute-element": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("list_attribute").WithElementKeyInt(0),
+			expectedErr:   tfprotov5.ErrPathInsideAttribute,
+			expectedError: `AttributeName("list_attribute").ElementKeyInt(0): path leads inside attribute`,
+		},
+		"invalid-first-step": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithElementKeyInt(0),
+			expectedErr:   tfprotov5.ErrPathNotFound,
+			expectedError: `ElementKeyInt(0): path not found in schema: expected attribute name step`,
+		},
+		"list-block-attribute": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("list_block").WithElementKeyInt(0).WithAttributeName("block_attribute"),
+			expected: testSchemaPathBlockAttribute,
+		},
+		"list-block-single-block-attribute": {
+			schema: testSchemaPathSchema,
+			path: tftypes.NewAttributePath().
+				WithAttributeName("list_block").
+				WithElementKeyInt(0).
+				WithAttributeName("single_block").
+				WithAttributeName("block_attribute"),
+			expected: testSchemaPathBlockAttribute,
+		},
+		"list-block-missing-element": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("list_block").WithAttributeName("block_attribute"),
+			expectedErr:   tfprotov5.ErrPathNotFound,
+			expectedError: `AttributeName("list_block").AttributeName("block_attribute"): path not found in schema: invalid element step for LIST nesting mode`,
+		},
+		"set-block-attribute": {
+			schema: testSchemaPathSchema,
+			path: tftypes.NewAttributePath().
+				WithAttributeName("set_block").
+				WithElementKeyValue(tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})).
+				WithAttributeName("block_attribute"),
+			expected: testSchemaPathBlockAttribute,
+		},
+		"map-block-attribute": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("map_block").WithElementKeyString("key").WithAttributeName("block_attribute"),
+			expected: testSchemaPathBlockAttribute,
+		},
+		"block": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("list_block"),
+			expectedErr:   tfprotov5.ErrPathNotFound,
+			expectedError: `AttributeName("list_block"): path not found in schema: path is a block, not an attribute`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.schema.AttributeAtPath(testCase.path)
+
+			if testCase.expectedErr != nil {
+				if !errors.Is(err, testCase.expectedErr) {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedErr, err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Errorf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("unexpected attribute: %s", cmp.Diff(got, testCase.expected))
+			}
+		})
+	}
+}
+
+func TestSchemaBlockAtPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema        *tfprotov5.Schema
+		path          *tftypes.AttributePath
+		expected      *tfprotov5.SchemaNestedBlock
+		expectedErr   error
+		expectedError string
+	}{
+		"nil-schema": {
+			schema:        nil,
+			path:          tftypes.NewAttributePath().WithAttributeName("list_block"),
+			expectedErr:   tfprotov5.ErrPathNotFound,
+			expectedError: "path not found in schema: schema is nil",
+		},
+		"block": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("list_block"),
+			expected: testSchemaPathListBlock,
+		},
+		"block-element": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("list_block").WithElementKeyInt(2),
+			expected: testSchemaPathListBlock,
+		},
+		"block-nested": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("list_block").WithElementKeyInt(0).WithAttributeName("single_block"),
+			expected: testSchemaPathSingleBlock,
+		},
+		"block-not-found": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("list_block").WithElementKeyInt(0).WithAttributeName("missing"),
+			expectedErr:   tfprotov5.ErrPathNotFound,
+			expectedError: `AttributeName("list_block").ElementKeyInt(0).AttributeName("missing"): path not found in schema: no attribute or block named "missing"`,
+		},
+		"attribute": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("string_attribute"),
+			expectedErr:   tfprotov5.ErrPathNotFound,
+			expectedError: `AttributeName("string_attribute"): path not found in schema: path is an attribute, not a block`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.schema.BlockAtPath(testCase.path)
+
+			if testCase.expectedErr != nil {
+				if !errors.Is(err, testCase.expectedErr) {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedErr, err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Errorf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("unexpected block: %s", cmp.Diff(got, testCase.expected))
+			}
+		})
+	}
+}
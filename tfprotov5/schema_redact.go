@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	// DefaultRedactPlaceholder is the placeholder used by Schema.RedactValue
+	// for sensitive values when RedactOpts.Placeholder is empty.
+	DefaultRedactPlaceholder = "(sensitive value)"
+
+	// DefaultRedactMaxStringLength is the number of bytes Schema.RedactValue
+	// truncates strings to when RedactOpts.MaxStringLength is zero.
+	DefaultRedactMaxStringLength = 1024
+
+	// redactTruncatedSuffix is appended to truncated strings.
+	redactTruncatedSuffix = "..."
+)
+
+// RedactOpts configures Schema.RedactValue and Schema.RedactDynamicValue.
+type RedactOpts struct {
+	// Placeholder replaces the values of sensitive attributes. If empty,
+	// DefaultRedactPlaceholder is used.
+	Placeholder string
+
+	// MaxStringLength is the number of bytes strings are truncated to,
+	// not including the "..." suffix added to truncated strings. Strings
+	// are only truncated on UTF-8 character boundaries. If zero,
+	// DefaultRedactMaxStringLength is used. If negative, strings are not
+	// truncated.
+	MaxStringLength int
+}
+
+// RedactValue returns a copy of the value, such as a resource state or
+// config, with the values of sensitive attributes replaced by a placeholder
+// and long strings truncated, so it is safe to include in debug logs and
+// error messages. The value must be of the schema type.
+//
+// Sensitive attributes become tftypes.String values, which are null or
+// unknown if the original value is null or unknown and the placeholder
+// otherwise, so the type of the result differs from the schema type when
+// the schema has sensitive attributes of other types. The result is only
+// intended for display, such as with its String method, and must not be
+// sent to Terraform.
+func (s *Schema) RedactValue(value tftypes.Value, opts RedactOpts) (tftypes.Value, error) {
+	if s == nil {
+		return newRedactor(opts).truncate(value)
+	}
+
+	return newRedactor(opts).block(tftypes.NewAttributePath(), s.Block, value)
+}
+
+// RedactDynamicValue unmarshals the value with the schema type and returns
+// a copy of it as described by RedactValue.
+func (s *Schema) RedactDynamicValue(value *DynamicValue, opts RedactOpts) (tftypes.Value, error) {
+	if value == nil {
+		return tftypes.NewValue(s.ValueType(), nil), nil
+	}
+
+	unmarshalled, err := value.Unmarshal(s.ValueType())
+
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("unable to unmarshal value: %w", err)
+	}
+
+	return s.RedactValue(unmarshalled, opts)
+}
+
+// redactor redacts values with the RedactOpts defaults applied.
+type redactor struct {
+	placeholder     string
+	maxStringLength int
+}
+
+func newRedactor(opts RedactOpts) redactor {
+	r := redactor{
+		placeholder:     opts.Placeholder,
+		maxStringLength: opts.MaxStringLength,
+	}
+
+	if r.placeholder == "" {
+		r.placeholder = DefaultRedactPlaceholder
+	}
+
+	if r.maxStringLength == 0 {
+		r.maxStringLength = DefaultRedactMaxStringLength
+	}
+
+	return r
+}
+
+// block returns the redacted object value of the block.
+func (r redactor) block(path *tftypes.AttributePath, s *SchemaBlock, value tftypes.Value) (tftypes.Value, error) {
+	typ := r.blockType(s)
+
+	if value.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if !value.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var values map[string]tftypes.Value
+
+	if err := value.As(&values); err != nil {
+		return tftypes.Value{}, path.NewError(err)
+	}
+
+	result := make(map[string]tftypes.Value, len(values))
+
+	if s != nil {
+		for _, attribute := range s.Attributes {
+			if attribute == nil {
+				continue
+			}
+
+			attributeValue, ok := values[attribute.Name]
+
+			if !ok {
+				continue
+			}
+
+			redacted, err := r.attribute(path.WithAttributeName(attribute.Name), attribute, attributeValue)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			result[attribute.Name] = redacted
+		}
+
+		for _, blockType := range s.BlockTypes {
+			if blockType == nil {
+				continue
+			}
+
+			blockValue, ok := values[blockType.TypeName]
+
+			if !ok {
+				continue
+			}
+
+			redacted, err := r.nestedBlock(path.WithAttributeName(blockType.TypeName), blockType, blockValue)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			result[blockType.TypeName] = redacted
+		}
+	}
+
+	if err := tftypes.ValidateValue(typ, result); err != nil {
+		return tftypes.Value{}, path.NewError(err)
+	}
+
+	return tftypes.NewValue(typ, result), nil
+}
+
+// nestedBlock returns the redacted value of the nested block.
+func (r redactor) nestedBlock(path *tftypes.AttributePath, s *SchemaNestedBlock, value tftypes.Value) (tftypes.Value, error) {
+	typ := r.nestedBlockType(s)
+
+	if typ == nil {
+		return tftypes.Value{}, path.NewErrorf("invalid nesting mode %s", s.Nesting)
+	}
+
+	if value.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if !value.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	switch s.Nesting {
+	case SchemaNestedBlockNestingModeSingle, SchemaNestedBlockNestingModeGroup:
+		return r.block(path, s.Block, value)
+	case SchemaNestedBlockNestingModeList, SchemaNestedBlockNestingModeSet:
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return tftypes.Value{}, path.NewError(err)
+		}
+
+		result := make([]tftypes.Value, 0, len(elements))
+
+		for index, element := range elements {
+			elementPath := path.WithElementKeyInt(index)
+
+			if s.Nesting == SchemaNestedBlockNestingModeSet {
+				elementPath = path.WithElementKeyValue(element)
+			}
+
+			redacted, err := r.block(elementPath, s.Block, element)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			result = append(result, redacted)
+		}
+
+		return tftypes.NewValue(typ, result), nil
+	default:
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return tftypes.Value{}, path.NewError(err)
+		}
+
+		result := make(map[string]tftypes.Value, len(elements))
+
+		for key, element := range elements {
+			redacted, err := r.block(path.WithElementKeyString(key), s.Block, element)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			result[key] = redacted
+		}
+
+		return tftypes.NewValue(typ, result), nil
+	}
+}
+
+// attribute returns the redacted value of the attribute.
+func (r redactor) attribute(path *tftypes.AttributePath, s *SchemaAttribute, value tftypes.Value) (tftypes.Value, error) {
+	if !s.Sensitive {
+		return r.truncate(value)
+	}
+
+	if value.IsNull() {
+		return tftypes.NewValue(tftypes.String, nil), nil
+	}
+
+	if !value.IsKnown() {
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	}
+
+	return tftypes.NewValue(tftypes.String, r.placeholder), nil
+}
+
+// truncate returns a copy of the value with any known strings longer than
+// the maximum string length truncated.
+func (r redactor) truncate(value tftypes.Value) (tftypes.Value, error) {
+	if r.maxStringLength < 0 {
+		return value, nil
+	}
+
+	return tftypes.Transform(value, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.Type().Is(tftypes.String) || !v.IsKnown() || v.IsNull() {
+			return v, nil
+		}
+
+		var s string
+
+		if err := v.As(&s); err != nil {
+			return v, err
+		}
+
+		if len(s) <= r.maxStringLength {
+			return v, nil
+		}
+
+		end := r.maxStringLength
+
+		for end > 0 && !utf8.RuneStart(s[end]) {
+			end--
+		}
+
+		return tftypes.NewValue(tftypes.String, s[:end]+redactTruncatedSuffix), nil
+	})
+}
+
+// blockType returns the type of the redacted value of the block, which is
+// the block type with tftypes.String for its sensitive attributes.
+func (r redactor) blockType(s *SchemaBlock) tftypes.Type {
+	attributeTypes := map[string]tftypes.Type{}
+
+	if s == nil {
+		return tftypes.Object{
+			AttributeTypes: attributeTypes,
+		}
+	}
+
+	for _, attribute := range s.Attributes {
+		if attribute == nil {
+			continue
+		}
+
+		attributeType := attribute.ValueType()
+
+		if attribute.Sensitive {
+			attributeType = tftypes.String
+		}
+
+		if attributeType == nil {
+			continue
+		}
+
+		attributeTypes[attribute.Name] = attributeType
+	}
+
+	for _, blockType := range s.BlockTypes {
+		if blockType == nil {
+			continue
+		}
+
+		blockValueType := r.nestedBlockType(blockType)
+
+		if blockValueType == nil {
+			continue
+		}
+
+		attributeTypes[blockType.TypeName] = blockValueType
+	}
+
+	return tftypes.Object{
+		AttributeTypes: attributeTypes,
+	}
+}
+
+// nestedBlockType returns the type of the redacted value of the nested
+// block, or nil if the nesting mode is invalid.
+func (r redactor) nestedBlockType(s *SchemaNestedBlock) tftypes.Type {
+	blockType := r.blockType(s.Block)
+
+	switch s.Nesting {
+	case SchemaNestedBlockNestingModeGroup, SchemaNestedBlockNestingModeSingle:
+		return blockType
+	case SchemaNestedBlockNestingModeList:
+		return tftypes.List{
+			ElementType: blockType,
+		}
+	case SchemaNestedBlockNestingModeMap:
+		return tftypes.Map{
+			ElementType: blockType,
+		}
+	case SchemaNestedBlockNestingModeSet:
+		return tftypes.Set{
+			ElementType: blockType,
+		}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaRedactValue(t *testing.T) {
+	t.Parallel()
+
+	nestedBlock := &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{
+				Name:     "value",
+				Type:     tftypes.String,
+				Optional: true,
+			},
+			{
+				Name:      "secret",
+				Type:      tftypes.Number,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+	schema := &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{
+					Name:     "string",
+					Type:     tftypes.String,
+					Optional: true,
+				},
+				{
+					Name:      "password",
+					Type:      tftypes.String,
+					Optional:  true,
+					Sensitive: true,
+				},
+				{
+					Name:      "tokens",
+					Type:      tftypes.List{ElementType: tftypes.String},
+					Optional:  true,
+					Sensitive: true,
+				},
+			},
+			BlockTypes: []*tfprotov5.SchemaNestedBlock{
+				{
+					TypeName: "list",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "map",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeMap,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "single",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+					Block:    nestedBlock,
+				},
+			},
+		},
+	}
+	schemaType := schema.ValueType().(tftypes.Object) //nolint:forcetypeassert // ValueType always returns an Object
+	nestedType := nestedBlock.ValueType()
+	redactedNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"value":  tftypes.String,
+			"secret": tftypes.String,
+		},
+	}
+	redactedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"string":   tftypes.String,
+			"password": tftypes.String,
+			"tokens":   tftypes.String,
+			"list":     tftypes.List{ElementType: redactedNestedType},
+			"map":      tftypes.Map{ElementType: redactedNestedType},
+			"single":   redactedNestedType,
+		},
+	}
+
+	testValue := func(values map[string]tftypes.Value) tftypes.Value {
+		value := map[string]tftypes.Value{
+			"string":   tftypes.NewValue(tftypes.String, nil),
+			"password": tftypes.NewValue(tftypes.String, nil),
+			"tokens":   tftypes.NewValue(schemaType.AttributeTypes["tokens"], nil),
+			"list":     tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{}),
+			"map":      tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{}),
+			"single":   tftypes.NewValue(nestedType, nil),
+		}
+
+		for name, v := range values {
+			value[name] = v
+		}
+
+		return tftypes.NewValue(schemaType, value)
+	}
+	testRedacted := func(values map[string]tftypes.Value) tftypes.Value {
+		value := map[string]tftypes.Value{
+			"string":   tftypes.NewValue(tftypes.String, nil),
+			"password": tftypes.NewValue(tftypes.String, nil),
+			"tokens":   tftypes.NewValue(tftypes.String, nil),
+			"list":     tftypes.NewValue(redactedType.AttributeTypes["list"], []tftypes.Value{}),
+			"map":      tftypes.NewValue(redactedType.AttributeTypes["map"], map[string]tftypes.Value{}),
+			"single":   tftypes.NewValue(redactedNestedType, nil),
+		}
+
+		for name, v := range values {
+			value[name] = v
+		}
+
+		return tftypes.NewValue(redactedType, value)
+	}
+	testNestedValue := func(value interface{}, secret interface{}) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"value":  tftypes.NewValue(tftypes.String, value),
+			"secret": tftypes.NewValue(tftypes.Number, secret),
+		})
+	}
+	testRedactedNestedValue := func(value interface{}, secret interface{}) tftypes.Value {
+		return tftypes.NewValue(redactedNestedType, map[string]tftypes.Value{
+			"value":  tftypes.NewValue(tftypes.String, value),
+			"secret": tftypes.NewValue(tftypes.String, secret),
+		})
+	}
+
+	testCases := map[string]struct {
+		schema   *tfprotov5.Schema
+		value    tftypes.Value
+		opts     tfprotov5.RedactOpts
+		expected tftypes.Value
+	}{
+		"nil-schema": {
+			schema:   nil,
+			value:    tftypes.NewValue(tftypes.String, "test"),
+			expected: tftypes.NewValue(tftypes.String, "test"),
+		},
+		"null": {
+			schema:   schema,
+			value:    tftypes.NewValue(schemaType, nil),
+			expected: tftypes.NewValue(redactedType, nil),
+		},
+		"unknown": {
+			schema:   schema,
+			value:    tftypes.NewValue(schemaType, tftypes.UnknownValue),
+			expected: tftypes.NewValue(redactedType, tftypes.UnknownValue),
+		},
+		"null-attributes": {
+			schema:   schema,
+			value:    testValue(nil),
+			expected: testRedacted(nil),
+		},
+		"sensitive-attributes": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"string":   tftypes.NewValue(tftypes.String, "test"),
+				"password": tftypes.NewValue(tftypes.String, "hunter2"),
+				"tokens": tftypes.NewValue(schemaType.AttributeTypes["tokens"], []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "token"),
+				}),
+			}),
+			expected: testRedacted(map[string]tftypes.Value{
+				"string":   tftypes.NewValue(tftypes.String, "test"),
+				"password": tftypes.NewValue(tftypes.String, tfprotov5.DefaultRedactPlaceholder),
+				"tokens":   tftypes.NewValue(tftypes.String, tfprotov5.DefaultRedactPlaceholder),
+			}),
+		},
+		"sensitive-attribute-unknown": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"password": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: testRedacted(map[string]tftypes.Value{
+				"password": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"placeholder": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"password": tftypes.NewValue(tftypes.String, "hunter2"),
+			}),
+			opts: tfprotov5.RedactOpts{
+				Placeholder: "***",
+			},
+			expected: testRedacted(map[string]tftypes.Value{
+				"password": tftypes.NewValue(tftypes.String, "***"),
+			}),
+		},
+		"nested-blocks": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{
+					testNestedValue("a", 1),
+					testNestedValue("b", nil),
+				}),
+				"map": tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{
+					"key": testNestedValue("c", 2),
+				}),
+				"single": testNestedValue("d", 3),
+			}),
+			expected: testRedacted(map[string]tftypes.Value{
+				"list": tftypes.NewValue(redactedType.AttributeTypes["list"], []tftypes.Value{
+					testRedactedNestedValue("a", tfprotov5.DefaultRedactPlaceholder),
+					testRedactedNestedValue("b", nil),
+				}),
+				"map": tftypes.NewValue(redactedType.AttributeTypes["map"], map[string]tftypes.Value{
+					"key": testRedactedNestedValue("c", tfprotov5.DefaultRedactPlaceholder),
+				}),
+				"single": testRedactedNestedValue("d", tfprotov5.DefaultRedactPlaceholder),
+			}),
+		},
+		"nested-block-unknown": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], tftypes.UnknownValue),
+			}),
+			expected: testRedacted(map[string]tftypes.Value{
+				"list": tftypes.NewValue(redactedType.AttributeTypes["list"], tftypes.UnknownValue),
+			}),
+		},
+		"truncated": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "abcdef"),
+				"single": testNestedValue("ghijkl", nil),
+			}),
+			opts: tfprotov5.RedactOpts{
+				MaxStringLength: 3,
+			},
+			expected: testRedacted(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "abc..."),
+				"single": testRedactedNestedValue("ghi...", nil),
+			}),
+		},
+		"truncated-default": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, strings.Repeat("a", tfprotov5.DefaultRedactMaxStringLength+1)),
+			}),
+			expected: testRedacted(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, strings.Repeat("a", tfprotov5.DefaultRedactMaxStringLength)+"..."),
+			}),
+		},
+		"truncated-multibyte": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "aéb"),
+			}),
+			opts: tfprotov5.RedactOpts{
+				MaxStringLength: 2,
+			},
+			expected: testRedacted(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "a..."),
+			}),
+		},
+		"not-truncated": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, strings.Repeat("a", tfprotov5.DefaultRedactMaxStringLength+1)),
+			}),
+			opts: tfprotov5.RedactOpts{
+				MaxStringLength: -1,
+			},
+			expected: testRedacted(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, strings.Repeat("a", tfprotov5.DefaultRedactMaxStringLength+1)),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.schema.RedactValue(testCase.value, testCase.opts)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaRedactDynamicValue(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{
+					Name:     "name",
+					Type:     tftypes.String,
+					Optional: true,
+				},
+				{
+					Name:      "password",
+					Type:      tftypes.String,
+					Optional:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+
+	got, err := schema.RedactDynamicValue(&tfprotov5.DynamicValue{
+		JSON: []byte(`{"name":"test","password":"hunter2"}`),
+	}, tfprotov5.RedactOpts{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := tftypes.NewValue(schema.ValueType(), map[string]tftypes.Value{
+		"name":     tftypes.NewValue(tftypes.String, "test"),
+		"password": tftypes.NewValue(tftypes.String, tfprotov5.DefaultRedactPlaceholder),
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = schema.RedactDynamicValue(&tfprotov5.DynamicValue{
+		JSON: []byte(`{"name":["invalid"]}`),
+	}, tfprotov5.RedactOpts{})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
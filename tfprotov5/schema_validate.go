@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// schemaNameRegexp matches the attribute and block names accepted by
+// Terraform's own schema validation: lowercase letters, digits, and
+// underscores.
+var schemaNameRegexp = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Validate returns an error describing every protocol violation in the
+// Schema, such as invalid or duplicate names, invalid combinations of
+// Required, Optional, and Computed, invalid nesting modes, missing types, and
+// invalid defaults. Each underlying error is annotated with the dotted path of
+// the attribute or block. It returns nil if the Schema is valid.
+//
+// Validate is intended for provider unit testing, so protocol violations are
+// caught before Terraform fails to decode the schema.
+func (s *Schema) Validate() error {
+	if s == nil {
+		return errors.New("schema is nil")
+	}
+
+	if s.Block == nil {
+		return errors.New("schema block is nil")
+	}
+
+	return s.Block.Validate()
+}
+
+// Validate returns an error describing every protocol violation in the
+// attributes and nested blocks of the SchemaBlock, or nil if it is valid. See
+// Schema.Validate for more information.
+func (s *SchemaBlock) Validate() error {
+	if s == nil {
+		return errors.New("block is nil")
+	}
+
+	return errors.Join(s.validate("")...)
+}
+
+// Validate returns an error describing every protocol violation in the
+// SchemaAttribute, or nil if it is valid. See Schema.Validate for more
+// information.
+func (s *SchemaAttribute) Validate() error {
+	if s == nil {
+		return errors.New("attribute is nil")
+	}
+
+	return errors.Join(s.validate("", map[string]bool{})...)
+}
+
+// Validate returns an error describing every protocol violation in the
+// SchemaNestedBlock, including its nested attributes and blocks, or nil if it
+// is valid. See Schema.Validate for more information.
+func (s *SchemaNestedBlock) Validate() error {
+	if s == nil {
+		return errors.New("nested block is nil")
+	}
+
+	return errors.Join(s.validate("", map[string]bool{})...)
+}
+
+// validate returns errors for invalid attributes and nested blocks of the
+// block, where path is the dotted path of the block.
+func (s *SchemaBlock) validate(path string) []error {
+	var errs []error
+
+	names := make(map[string]bool, len(s.Attributes)+len(s.BlockTypes))
+
+	for _, attribute := range s.Attributes {
+		if attribute == nil {
+			errs = append(errs, fmt.Errorf("%s: attribute is nil", schemaValidationBlockDescription(path)))
+
+			continue
+		}
+
+		errs = append(errs, attribute.validate(path, names)...)
+	}
+
+	for _, blockType := range s.BlockTypes {
+		if blockType == nil {
+			errs = append(errs, fmt.Errorf("%s: nested block is nil", schemaValidationBlockDescription(path)))
+
+			continue
+		}
+
+		errs = append(errs, blockType.validate(path, names)...)
+	}
+
+	return errs
+}
+
+// validate returns errors for an invalid attribute, where path is the dotted
+// path of the containing block, recording the attribute name in names.
+func (s *SchemaAttribute) validate(path string, names map[string]bool) []error {
+	attributePath := schemaValidationPath(path, s.Name)
+
+	errs := validateSchemaName("attribute", attributePath, s.Name, names)
+
+	if s.Type == nil {
+		errs = append(errs, fmt.Errorf("attribute %q: type is nil", attributePath))
+	}
+
+	switch {
+	case !s.Required && !s.Optional && !s.Computed:
+		errs = append(errs, fmt.Errorf("attribute %q: one of Required, Optional, or Computed must be set", attributePath))
+	case s.Required && (s.Optional || s.Computed):
+		errs = append(errs, fmt.Errorf("attribute %q: Required cannot be combined with Optional or Computed", attributePath))
+	}
+
+	if s.Default != nil && (!s.Optional || !s.Computed) {
+		errs = append(errs, fmt.Errorf("attribute %q: Default requires Optional and Computed", attributePath))
+	}
+
+	if s.Default != nil && s.ValueType() != nil && !schemaDefaultUsable(*s.Default, s.ValueType()) {
+		errs = append(errs, fmt.Errorf("attribute %q: Default is not of the attribute type", attributePath))
+	}
+
+	return errs
+}
+
+// validate returns errors for an invalid nested block, including its nested
+// attributes and blocks, where path is the dotted path of the containing
+// block, recording the block name in names.
+func (s *SchemaNestedBlock) validate(path string, names map[string]bool) []error {
+	blockPath := schemaValidationPath(path, s.TypeName)
+
+	errs := validateSchemaName("block", blockPath, s.TypeName, names)
+
+	if s.MinItems < 0 || s.MaxItems < 0 {
+		errs = append(errs, fmt.Errorf("block %q: MinItems and MaxItems cannot be negative", blockPath))
+	}
+
+	switch s.Nesting {
+	case SchemaNestedBlockNestingModeSingle:
+		switch {
+		case s.MinItems != s.MaxItems:
+			errs = append(errs, fmt.Errorf("block %q: MinItems and MaxItems must match in %s nesting mode", blockPath, s.Nesting))
+		case s.MinItems > 1:
+			errs = append(errs, fmt.Errorf("block %q: MinItems and MaxItems must be 0 or 1 in %s nesting mode", blockPath, s.Nesting))
+		}
+	case SchemaNestedBlockNestingModeList, SchemaNestedBlockNestingModeSet:
+		if s.MaxItems != 0 && s.MinItems > s.MaxItems {
+			errs = append(errs, fmt.Errorf("block %q: MinItems cannot be greater than MaxItems in %s nesting mode", blockPath, s.Nesting))
+		}
+	case SchemaNestedBlockNestingModeMap, SchemaNestedBlockNestingModeGroup:
+		if s.MinItems != 0 || s.MaxItems != 0 {
+			errs = append(errs, fmt.Errorf("block %q: MinItems and MaxItems must be 0 in %s nesting mode", blockPath, s.Nesting))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("block %q: invalid nesting mode %s", blockPath, s.Nesting))
+	}
+
+	if s.Block == nil {
+		return append(errs, fmt.Errorf("block %q: block is nil", blockPath))
+	}
+
+	return append(errs, s.Block.validate(blockPath)...)
+}
+
+// validateSchemaName returns errors for an invalid or duplicate attribute or
+// block name, recording the name in names.
+func validateSchemaName(kind string, path string, name string, names map[string]bool) []error {
+	var errs []error
+
+	if !schemaNameRegexp.MatchString(name) {
+		errs = append(errs, fmt.Errorf("%s %q: invalid name", kind, path))
+	}
+
+	if names[name] {
+		errs = append(errs, fmt.Errorf("%s %q: duplicate name", kind, path))
+	}
+
+	names[name] = true
+
+	return errs
+}
+
+// schemaValidationPath returns the dotted path of a name in a block.
+func schemaValidationPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+// schemaValidationBlockDescription returns the description of a block in
+// errors, where path is empty for the root block.
+func schemaValidationBlockDescription(path string) string {
+	if path == "" {
+		return "root block"
+	}
+
+	return fmt.Sprintf("block %q", path)
+}
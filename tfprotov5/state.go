@@ -70,7 +70,8 @@ type RawState struct {
 // from RPC requests.
 //
 // State files written before Terraform 0.12 that haven't been upgraded yet
-// cannot be unmarshaled, and must have their Flatmap property read directly.
+// cannot be unmarshaled, and must be unmarshaled with UnmarshalWithOpts or
+// UnmarshalWithSchema instead.
 func (s RawState) Unmarshal(typ tftypes.Type) (tftypes.Value, error) {
 	if s.JSON != nil {
 		return tftypes.ValueFromJSON(s.JSON, typ) //nolint:staticcheck
@@ -82,20 +83,46 @@ func (s RawState) Unmarshal(typ tftypes.Type) (tftypes.Value, error) {
 }
 
 // UnmarshalOpts contains options that can be used to modify the behaviour when
-// unmarshalling. Currently, this only contains a struct for opts for JSON but
-// could have a field for Flatmap in the future.
+// unmarshalling JSON or Flatmap.
 type UnmarshalOpts struct {
 	ValueFromJSONOpts tftypes.ValueFromJSONOpts
+
+	// FlatmapOpts contains options used when unmarshalling legacy Flatmap
+	// states.
+	FlatmapOpts UnmarshalFlatmapOpts
+}
+
+// UnmarshalFlatmapOpts contains options that can be used to modify the
+// behaviour when unmarshalling Flatmap.
+type UnmarshalFlatmapOpts struct {
+	// IgnoreUndefinedAttributes is used to ignore any Flatmap keys which do
+	// not have a corresponding attribute or element in the type. For example,
+	// raw state where an attribute has been removed from the schema.
+	IgnoreUndefinedAttributes bool
 }
 
 // UnmarshalWithOpts is identical to Unmarshal but also accepts a tftypes.UnmarshalOpts which contains
 // options that can be used to modify the behaviour when unmarshalling JSON or Flatmap.
+//
+// Unlike Unmarshal, UnmarshalWithOpts can unmarshal Flatmap states written
+// before Terraform 0.12, so very old state versions can be upgraded. As
+// Flatmap values are always strings, primitive values are converted to the
+// type, and the Terraform 0.11 unknown value placeholder is converted to an
+// unknown value. Flatmap states cannot represent DynamicPseudoType values, so
+// an error is returned if the type contains one. An error is returned for any
+// key not defined in the type, unless the IgnoreUndefinedAttributes option of
+// FlatmapOpts is set.
 func (s RawState) UnmarshalWithOpts(typ tftypes.Type, opts UnmarshalOpts) (tftypes.Value, error) {
 	if s.JSON != nil {
 		return tftypes.ValueFromJSONWithOpts(s.JSON, typ, opts.ValueFromJSONOpts) //nolint:staticcheck
 	}
 	if s.Flatmap != nil {
-		return tftypes.Value{}, fmt.Errorf("flatmap states cannot be unmarshaled, only states written by Terraform 0.12 and higher can be unmarshaled")
+		if !opts.FlatmapOpts.IgnoreUndefinedAttributes {
+			if err := rawStateFlatmapValidateKeys(typ, s.Flatmap); err != nil {
+				return tftypes.Value{}, err
+			}
+		}
+		return rawStateValueFromFlatmap(tftypes.NewAttributePath(), typ, s.Flatmap, "")
 	}
 	return tftypes.Value{}, ErrUnknownRawStateType
 }
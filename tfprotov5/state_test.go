@@ -16,11 +16,38 @@ import (
 func TestRawStateUnmarshalWithOpts(t *testing.T) {
 	t.Parallel()
 	type testCase struct {
-		rawState tfprotov5.RawState
-		value    tftypes.Value
-		typ      tftypes.Type
-		opts     tfprotov5.UnmarshalOpts
+		rawState      tfprotov5.RawState
+		value         tftypes.Value
+		typ           tftypes.Type
+		opts          tfprotov5.UnmarshalOpts
+		expectedError string
 	}
+	flatmapType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"port": tftypes.Number,
+			"tags": tftypes.Map{ElementType: tftypes.String},
+			"rule": tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"enabled": tftypes.Bool,
+					},
+				},
+			},
+		},
+	}
+	flatmapValue := tftypes.NewValue(flatmapType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "test-id"),
+		"port": tftypes.NewValue(tftypes.Number, big.NewFloat(8080)),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"app.name": tftypes.NewValue(tftypes.String, "test"),
+		}),
+		"rule": tftypes.NewValue(flatmapType.AttributeTypes["rule"], []tftypes.Value{
+			tftypes.NewValue(flatmapType.AttributeTypes["rule"].(tftypes.List).ElementType, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			}),
+		}),
+	})
 	tests := map[string]testCase{
 		"object-of-bool-number": {
 			rawState: tfprotov5.RawState{
@@ -67,6 +94,74 @@ func TestRawStateUnmarshalWithOpts(t *testing.T) {
 				},
 			},
 		},
+		"flatmap": {
+			rawState: tfprotov5.RawState{
+				Flatmap: map[string]string{
+					"id":             "test-id",
+					"port":           "8080",
+					"tags.%":         "1",
+					"tags.app.name":  "test",
+					"rule.#":         "1",
+					"rule.0.enabled": "74D93920-ED26-11E3-AC10-0800200C9A66",
+				},
+			},
+			value: flatmapValue,
+			typ:   flatmapType,
+		},
+		"flatmap-undefined-attribute": {
+			rawState: tfprotov5.RawState{
+				Flatmap: map[string]string{
+					"id":            "test-id",
+					"rule.#":        "1",
+					"rule.0.legacy": "true",
+				},
+			},
+			typ:           flatmapType,
+			expectedError: `AttributeName("rule").ElementKeyInt(0).AttributeName("legacy"): unsupported attribute "legacy"`,
+		},
+		"flatmap-undefined-element-key": {
+			rawState: tfprotov5.RawState{
+				Flatmap: map[string]string{
+					"rule.first": "true",
+				},
+			},
+			typ:           flatmapType,
+			expectedError: `AttributeName("rule"): unsupported element key "first"`,
+		},
+		"flatmap-ignore-undefined-attributes": {
+			rawState: tfprotov5.RawState{
+				Flatmap: map[string]string{
+					"id":             "test-id",
+					"port":           "8080",
+					"tags.%":         "1",
+					"tags.app.name":  "test",
+					"rule.#":         "1",
+					"rule.0.enabled": "74D93920-ED26-11E3-AC10-0800200C9A66",
+					"rule.0.legacy":  "true",
+					"removed":        "value",
+				},
+			},
+			value: flatmapValue,
+			typ:   flatmapType,
+			opts: tfprotov5.UnmarshalOpts{
+				FlatmapOpts: tfprotov5.UnmarshalFlatmapOpts{
+					IgnoreUndefinedAttributes: true,
+				},
+			},
+		},
+		"flatmap-dynamic": {
+			rawState: tfprotov5.RawState{
+				Flatmap: map[string]string{
+					"dynamic": "value",
+				},
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"dynamic": tftypes.DynamicPseudoType,
+				},
+			},
+			expectedError: `AttributeName("dynamic"): flatmap states cannot contain dynamic values`,
+		},
 	}
 	for name, test := range tests {
 		name, test := name, test
@@ -75,7 +170,19 @@ func TestRawStateUnmarshalWithOpts(t *testing.T) {
 
 			val, err := test.rawState.UnmarshalWithOpts(test.typ, test.opts)
 			if err != nil {
-				t.Fatalf("unexpected error unmarshaling: %s", err)
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error unmarshaling: %s", err)
+				}
+
+				if err.Error() != test.expectedError {
+					t.Fatalf("expected error %q, got %q", test.expectedError, err)
+				}
+
+				return
+			}
+
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
 			}
 
 			if diff := cmp.Diff(test.value, val); diff != "" {
@@ -84,3 +191,218 @@ func TestRawStateUnmarshalWithOpts(t *testing.T) {
 		})
 	}
 }
+
+func TestRawStateUnmarshalWithSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{
+				Name: "bool",
+				Type: tftypes.Bool,
+			},
+			{
+				Name: "number",
+				Type: tftypes.Number,
+			},
+			{
+				Name: "string",
+				Type: tftypes.String,
+			},
+			{
+				Name: "map",
+				Type: tftypes.Map{ElementType: tftypes.String},
+			},
+			{
+				Name: "set",
+				Type: tftypes.Set{ElementType: tftypes.Number},
+			},
+		},
+		BlockTypes: []*tfprotov5.SchemaNestedBlock{
+			{
+				TypeName: "list_block",
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name: "id",
+							Type: tftypes.String,
+						},
+					},
+				},
+			},
+			{
+				TypeName: "group_block",
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeGroup,
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name: "id",
+							Type: tftypes.String,
+						},
+					},
+				},
+			},
+		},
+	}
+	blockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+	typ := schema.ValueType()
+	nullGroupBlock := tftypes.NewValue(blockType, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	tests := map[string]struct {
+		rawState      tfprotov5.RawState
+		expected      tftypes.Value
+		expectedError string
+	}{
+		"json": {
+			rawState: tfprotov5.RawState{
+				JSON: []byte(`{"bool":true,"number":1.5,"string":"test","map":{"a":"b"},"set":[1,2],"list_block":[{"id":"one"}],"group_block":{"id":"two"}}`),
+			},
+			expected: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"bool":   tftypes.NewValue(tftypes.Bool, true),
+				"number": tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+				"string": tftypes.NewValue(tftypes.String, "test"),
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"a": tftypes.NewValue(tftypes.String, "b"),
+				}),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{
+					tftypes.NewValue(tftypes.Number, big.NewFloat(1)),
+					tftypes.NewValue(tftypes.Number, big.NewFloat(2)),
+				}),
+				"list_block": tftypes.NewValue(tftypes.List{ElementType: blockType}, []tftypes.Value{
+					tftypes.NewValue(blockType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "one"),
+					}),
+				}),
+				"group_block": tftypes.NewValue(blockType, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, "two"),
+				}),
+			}),
+		},
+		"json-legacy-coercions": {
+			rawState: tfprotov5.RawState{
+				JSON: []byte(`{"bool":"true","number":"1.5","string":2,"set":["1"],"list_block":null,"removed":"value"}`),
+			},
+			expected: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"bool":   tftypes.NewValue(tftypes.Bool, true),
+				"number": tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+				"string": tftypes.NewValue(tftypes.String, "2"),
+				"map":    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{
+					tftypes.NewValue(tftypes.Number, big.NewFloat(1)),
+				}),
+				"list_block":  tftypes.NewValue(tftypes.List{ElementType: blockType}, []tftypes.Value{}),
+				"group_block": nullGroupBlock,
+			}),
+		},
+		"json-invalid-coercion": {
+			rawState: tfprotov5.RawState{
+				JSON: []byte(`{"number":"one"}`),
+			},
+			expectedError: `AttributeName("number"): cannot convert "one" to number: number has no digits`,
+		},
+		"flatmap": {
+			rawState: tfprotov5.RawState{
+				Flatmap: map[string]string{
+					"bool":            "true",
+					"number":          "1.5",
+					"string":          "test",
+					"map.%":           "1",
+					"map.a.b":         "c",
+					"set.#":           "2",
+					"set.1234":        "1",
+					"set.5678":        "2",
+					"list_block.#":    "1",
+					"list_block.0.id": "one",
+					"group_block.id":  "two",
+					"removed":         "value",
+				},
+			},
+			expected: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"bool":   tftypes.NewValue(tftypes.Bool, true),
+				"number": tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+				"string": tftypes.NewValue(tftypes.String, "test"),
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"a.b": tftypes.NewValue(tftypes.String, "c"),
+				}),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{
+					tftypes.NewValue(tftypes.Number, big.NewFloat(1)),
+					tftypes.NewValue(tftypes.Number, big.NewFloat(2)),
+				}),
+				"list_block": tftypes.NewValue(tftypes.List{ElementType: blockType}, []tftypes.Value{
+					tftypes.NewValue(blockType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "one"),
+					}),
+				}),
+				"group_block": tftypes.NewValue(blockType, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, "two"),
+				}),
+			}),
+		},
+		"flatmap-missing-and-unknown": {
+			rawState: tfprotov5.RawState{
+				Flatmap: map[string]string{
+					"string": "74D93920-ED26-11E3-AC10-0800200C9A66",
+					"set.#":  "74D93920-ED26-11E3-AC10-0800200C9A66",
+				},
+			},
+			expected: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"bool":        tftypes.NewValue(tftypes.Bool, nil),
+				"number":      tftypes.NewValue(tftypes.Number, nil),
+				"string":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"map":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"set":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, tftypes.UnknownValue),
+				"list_block":  tftypes.NewValue(tftypes.List{ElementType: blockType}, []tftypes.Value{}),
+				"group_block": nullGroupBlock,
+			}),
+		},
+		"flatmap-invalid-count": {
+			rawState: tfprotov5.RawState{
+				Flatmap: map[string]string{
+					"list_block.#": "many",
+				},
+			},
+			expectedError: `AttributeName("list_block"): invalid count "many": strconv.Atoi: parsing "many": invalid syntax`,
+		},
+		"unknown-raw-state-type": {
+			rawState:      tfprotov5.RawState{},
+			expectedError: tfprotov5.ErrUnknownRawStateType.Error(),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.rawState.UnmarshalWithSchema(schema)
+
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != test.expectedError {
+					t.Fatalf("expected error %q, got %q", test.expectedError, err)
+				}
+
+				return
+			}
+
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+
+			if !got.Equal(test.expected) {
+				t.Errorf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/fromproto"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/toproto"
+)
+
+const (
+	// protocolVersion is the major version of the protocol supported by the
+	// client.
+	protocolVersion = tfprotov5.ProtocolVersionMajor
+
+	// grpcMaxMessageSize is the maximum gRPC send and receive message sizes
+	// for the client, which matches the maximum sizes of tf5server.
+	grpcMaxMessageSize = 256 << 20
+)
+
+var (
+	_ tfprotov5.ProviderServerWithActions            = &Client{}
+	_ tfprotov5.ProviderServerWithEphemeralResources = &Client{}
+)
+
+// Client is a tfprotov5.ProviderServer, including all optional interfaces,
+// which calls the RPCs of a provider over gRPC. Requests and responses are
+// converted between the tfprotov5 and protocol buffers types, so the Client
+// can be used anywhere a tfprotov5.ProviderServer is expected, such as being
+// served by tf5server.
+//
+// RPC errors, such as the provider not implementing an RPC, are returned
+// unmodified as gRPC status errors.
+type Client struct {
+	client tfplugin5.ProviderClient
+	conn   *grpc.ClientConn
+}
+
+// New returns a Client which calls the RPCs of a provider using an existing
+// gRPC connection. The caller is responsible for closing the connection.
+func New(conn grpc.ClientConnInterface) *Client {
+	return &Client{
+		client: tfplugin5.NewProviderClient(conn),
+	}
+}
+
+// Dial returns a Client connected to the provider listening at addr, such as
+// a provider started with managed debug mode. The connection does not use
+// transport security unless overridden by opts. Close must be called to close
+// the connection.
+func Dial(addr net.Addr, opts ...grpc.DialOption) (*Client, error) {
+	if addr == nil {
+		return nil, errors.New("provider address is nil")
+	}
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		var d net.Dialer
+
+		return d.DialContext(ctx, addr.Network(), addr.String())
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(dialer),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(grpcMaxMessageSize),
+			grpc.MaxCallSendMsgSize(grpcMaxMessageSize),
+		),
+	}
+
+	conn, err := grpc.NewClient("passthrough:///"+addr.String(), append(dialOpts, opts...)...)
+
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to provider at %s: %w", addr, err)
+	}
+
+	return &Client{
+		client: tfplugin5.NewProviderClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// DialReattachConfig returns a Client connected to the provider described by
+// the go-plugin reattach configuration, such as the configuration sent by the
+// tf5server.WithDebug ServeOpt. An error is returned if the configuration is
+// not for a gRPC provider using protocol version 5. See Dial for more
+// information.
+func DialReattachConfig(config *plugin.ReattachConfig, opts ...grpc.DialOption) (*Client, error) {
+	if config == nil {
+		return nil, errors.New("reattach configuration is nil")
+	}
+
+	if config.Protocol != "" && config.Protocol != plugin.ProtocolGRPC {
+		return nil, fmt.Errorf("unsupported reattach configuration protocol %q, expected %q", config.Protocol, plugin.ProtocolGRPC)
+	}
+
+	if config.ProtocolVersion != 0 && config.ProtocolVersion != protocolVersion {
+		return nil, fmt.Errorf("unsupported reattach configuration protocol version %d, expected %d", config.ProtocolVersion, protocolVersion)
+	}
+
+	return Dial(config.Addr, opts...)
+}
+
+// Close closes the connection created by Dial or DialReattachConfig. It is a
+// no-op for a Client created by New.
+func (c *Client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+
+	return c.conn.Close()
+}
+
+func (c *Client) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	protoResp, err := c.client.GetMetadata(ctx, toproto.GetMetadata_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetMetadataResponse(protoResp)
+}
+
+func (c *Client) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	protoResp, err := c.client.GetSchema(ctx, toproto.GetProviderSchema_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetProviderSchemaResponse(protoResp)
+}
+
+func (c *Client) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	protoResp, err := c.client.PrepareProviderConfig(ctx, toproto.PrepareProviderConfig_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.PrepareProviderConfigResponse(protoResp)
+}
+
+func (c *Client) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	protoResp, err := c.client.Configure(ctx, toproto.Configure_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ConfigureProviderResponse(protoResp)
+}
+
+func (c *Client) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	protoResp, err := c.client.Stop(ctx, toproto.Stop_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.StopProviderResponse(protoResp), nil
+}
+
+func (c *Client) ValidateDataSourceConfig(ctx context.Context, req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	protoResp, err := c.client.ValidateDataSourceConfig(ctx, toproto.ValidateDataSourceConfig_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateDataSourceConfigResponse(protoResp)
+}
+
+func (c *Client) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	protoResp, err := c.client.ReadDataSource(ctx, toproto.ReadDataSource_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ReadDataSourceResponse(protoResp)
+}
+
+func (c *Client) ValidateEphemeralResourceConfig(ctx context.Context, req *tfprotov5.ValidateEphemeralResourceConfigRequest) (*tfprotov5.ValidateEphemeralResourceConfigResponse, error) {
+	protoResp, err := c.client.ValidateEphemeralResourceConfig(ctx, toproto.ValidateEphemeralResourceConfig_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateEphemeralResourceConfigResponse(protoResp)
+}
+
+func (c *Client) OpenEphemeralResource(ctx context.Context, req *tfprotov5.OpenEphemeralResourceRequest) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	protoResp, err := c.client.OpenEphemeralResource(ctx, toproto.OpenEphemeralResource_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.OpenEphemeralResourceResponse(protoResp)
+}
+
+func (c *Client) RenewEphemeralResource(ctx context.Context, req *tfprotov5.RenewEphemeralResourceRequest) (*tfprotov5.RenewEphemeralResourceResponse, error) {
+	protoResp, err := c.client.RenewEphemeralResource(ctx, toproto.RenewEphemeralResource_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.RenewEphemeralResourceResponse(protoResp)
+}
+
+func (c *Client) CloseEphemeralResource(ctx context.Context, req *tfprotov5.CloseEphemeralResourceRequest) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	protoResp, err := c.client.CloseEphemeralResource(ctx, toproto.CloseEphemeralResource_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.CloseEphemeralResourceResponse(protoResp)
+}
+
+func (c *Client) ValidateActionConfig(ctx context.Context, req *tfprotov5.ValidateActionConfigRequest) (*tfprotov5.ValidateActionConfigResponse, error) {
+	protoResp, err := c.client.ValidateActionConfig(ctx, toproto.ValidateActionConfig_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateActionConfigResponse(protoResp)
+}
+
+// InvokeAction returns a response whose events are received from the
+// provider as they are iterated. An error receiving an event is returned as a
+// completed event with an error diagnostic.
+func (c *Client) InvokeAction(ctx context.Context, req *tfprotov5.InvokeActionRequest) (*tfprotov5.InvokeActionResponse, error) {
+	stream, err := c.client.InvokeAction(ctx, toproto.InvokeAction_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov5.InvokeActionResponse{
+		Events: func(yield func(tfprotov5.InvokeActionEvent) bool) {
+			for {
+				protoEvent, err := stream.Recv()
+
+				if errors.Is(err, io.EOF) {
+					return
+				}
+
+				if err != nil {
+					yield(invokeActionErrorEvent(err))
+
+					return
+				}
+
+				event, err := fromproto.InvokeActionEvent(protoEvent)
+
+				if err != nil {
+					yield(invokeActionErrorEvent(err))
+
+					return
+				}
+
+				if !yield(*event) {
+					return
+				}
+			}
+		},
+	}
+
+	return resp, nil
+}
+
+// invokeActionErrorEvent returns a completed event with an error diagnostic
+// for an error receiving an InvokeAction event.
+func invokeActionErrorEvent(err error) tfprotov5.InvokeActionEvent {
+	return tfprotov5.InvokeActionEvent{
+		Type: tfprotov5.CompletedInvokeActionEventType{
+			Diagnostics: []*tfprotov5.Diagnostic{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Error Receiving InvokeAction Event",
+					Detail:   "An unexpected error was encountered receiving an event from the provider: " + err.Error(),
+				},
+			},
+		},
+	}
+}
+
+func (c *Client) ValidateResourceTypeConfig(ctx context.Context, req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	protoResp, err := c.client.ValidateResourceTypeConfig(ctx, toproto.ValidateResourceTypeConfig_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateResourceTypeConfigResponse(protoResp)
+}
+
+func (c *Client) UpgradeResourceState(ctx context.Context, req *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	protoResp, err := c.client.UpgradeResourceState(ctx, toproto.UpgradeResourceState_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.UpgradeResourceStateResponse(protoResp)
+}
+
+func (c *Client) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	protoResp, err := c.client.ReadResource(ctx, toproto.ReadResource_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ReadResourceResponse(protoResp)
+}
+
+func (c *Client) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	protoResp, err := c.client.PlanResourceChange(ctx, toproto.PlanResourceChange_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.PlanResourceChangeResponse(protoResp)
+}
+
+func (c *Client) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	protoResp, err := c.client.ApplyResourceChange(ctx, toproto.ApplyResourceChange_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ApplyResourceChangeResponse(protoResp)
+}
+
+func (c *Client) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	protoResp, err := c.client.ImportResourceState(ctx, toproto.ImportResourceState_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ImportResourceStateResponse(protoResp)
+}
+
+func (c *Client) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	protoResp, err := c.client.MoveResourceState(ctx, toproto.MoveResourceState_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.MoveResourceStateResponse(protoResp)
+}
+
+func (c *Client) CallFunction(ctx context.Context, req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	protoResp, err := c.client.CallFunction(ctx, toproto.CallFunction_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.CallFunctionResponse(protoResp), nil
+}
+
+func (c *Client) GetFunctions(ctx context.Context, req *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	protoResp, err := c.client.GetFunctions(ctx, toproto.GetFunctions_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetFunctionsResponse(protoResp)
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5client_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5client"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testProviderServer struct {
+	tfprotov5.ProviderServer
+	tfprotov5.ActionServer
+}
+
+func (s testProviderServer) GetProviderSchema(_ context.Context, _ *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return &tfprotov5.GetProviderSchemaResponse{
+		Provider: testSchema,
+		ResourceSchemas: map[string]*tfprotov5.Schema{
+			"test_resource": testSchema,
+		},
+	}, nil
+}
+
+func (s testProviderServer) ConfigureProvider(_ context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	return &tfprotov5.ConfigureProviderResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  "test version " + req.TerraformVersion,
+			},
+		},
+	}, nil
+}
+
+func (s testProviderServer) InvokeAction(_ context.Context, _ *tfprotov5.InvokeActionRequest) (*tfprotov5.InvokeActionResponse, error) {
+	return &tfprotov5.InvokeActionResponse{
+		Events: func(yield func(tfprotov5.InvokeActionEvent) bool) {
+			if !yield(tfprotov5.InvokeActionEvent{Type: tfprotov5.ProgressInvokeActionEventType{Message: "test progress"}}) {
+				return
+			}
+
+			yield(tfprotov5.InvokeActionEvent{Type: tfprotov5.CompletedInvokeActionEventType{}})
+		},
+	}, nil
+}
+
+var testSchema = &tfprotov5.Schema{
+	Block: &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{
+				Name:     "id",
+				Type:     tftypes.String,
+				Computed: true,
+			},
+		},
+	},
+}
+
+// testServe serves the provider over gRPC on a local TCP listener, returning
+// the listener address.
+func testServe(t *testing.T, provider tfprotov5.ProviderServer) net.Addr {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("unable to listen: %s", err)
+	}
+
+	grpcServer := grpc.NewServer()
+
+	if provider != nil {
+		tfplugin5.RegisterProviderServer(grpcServer, tf5server.New("test", provider))
+	}
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	t.Cleanup(grpcServer.Stop)
+
+	return listener.Addr()
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	client, err := tf5client.Dial(testServe(t, testProviderServer{}))
+
+	if err != nil {
+		t.Fatalf("unexpected error dialing: %s", err)
+	}
+
+	t.Cleanup(func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("unexpected error closing: %s", err)
+		}
+	})
+
+	ctx := context.Background()
+
+	schemaResp, err := client.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected GetProviderSchema error: %s", err)
+	}
+
+	if !schemaResp.Provider.Equal(testSchema) || !schemaResp.ResourceSchemas["test_resource"].Equal(testSchema) {
+		t.Errorf("unexpected GetProviderSchema response: %#v", schemaResp)
+	}
+
+	configureResp, err := client.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		TerraformVersion: "1.0.0",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ConfigureProvider error: %s", err)
+	}
+
+	expectedDiagnostics := []*tfprotov5.Diagnostic{
+		{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "test version 1.0.0",
+		},
+	}
+
+	if diff := cmp.Diff(configureResp.Diagnostics, expectedDiagnostics); diff != "" {
+		t.Errorf("unexpected ConfigureProvider diagnostics difference: %s", diff)
+	}
+
+	invokeResp, err := client.InvokeAction(ctx, &tfprotov5.InvokeActionRequest{
+		ActionType: "test_action",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected InvokeAction error: %s", err)
+	}
+
+	var events []tfprotov5.InvokeActionEvent
+
+	invokeResp.Events(func(event tfprotov5.InvokeActionEvent) bool {
+		events = append(events, event)
+
+		return true
+	})
+
+	expectedEvents := []tfprotov5.InvokeActionEvent{
+		{Type: tfprotov5.ProgressInvokeActionEventType{Message: "test progress"}},
+		{Type: tfprotov5.CompletedInvokeActionEventType{}},
+	}
+
+	if diff := cmp.Diff(events, expectedEvents); diff != "" {
+		t.Errorf("unexpected InvokeAction events difference: %s", diff)
+	}
+}
+
+func TestClient_unimplemented(t *testing.T) {
+	t.Parallel()
+
+	client, err := tf5client.Dial(testServe(t, nil))
+
+	if err != nil {
+		t.Fatalf("unexpected error dialing: %s", err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	_, err = client.GetMetadata(context.Background(), &tfprotov5.GetMetadataRequest{})
+
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("expected Unimplemented error, got: %v", err)
+	}
+}
+
+func TestDialReattachConfig(t *testing.T) {
+	t.Parallel()
+
+	addr := testServe(t, testProviderServer{})
+
+	testCases := map[string]struct {
+		config        *plugin.ReattachConfig
+		expectedError string
+	}{
+		"nil": {
+			config:        nil,
+			expectedError: "reattach configuration is nil",
+		},
+		"nil-addr": {
+			config:        &plugin.ReattachConfig{},
+			expectedError: "provider address is nil",
+		},
+		"netrpc": {
+			config: &plugin.ReattachConfig{
+				Protocol: plugin.ProtocolNetRPC,
+				Addr:     addr,
+			},
+			expectedError: `unsupported reattach configuration protocol "netrpc", expected "grpc"`,
+		},
+		"protocol-version-6": {
+			config: &plugin.ReattachConfig{
+				Protocol:        plugin.ProtocolGRPC,
+				ProtocolVersion: 6,
+				Addr:            addr,
+			},
+			expectedError: "unsupported reattach configuration protocol version 6, expected 5",
+		},
+		"valid": {
+			config: &plugin.ReattachConfig{
+				Protocol:        plugin.ProtocolGRPC,
+				ProtocolVersion: 5,
+				Addr:            addr,
+				Test:            true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := tf5client.DialReattachConfig(testCase.config)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			t.Cleanup(func() {
+				_ = client.Close()
+			})
+
+			if _, err := client.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{}); err != nil {
+				t.Errorf("unexpected GetProviderSchema error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5client
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	"github.com/hashicorp/terraform-plugin-go/internal/manageddebug"
+)
+
+// EnvTfReattachProviders is the environment variable used by Terraform CLI
+// to directly connect to already running provider processes, such as those
+// started with the tf5server.WithManagedDebug ServeOpt.
+const EnvTfReattachProviders = manageddebug.EnvTfReattachProviders
+
+// ParseReattachProviders returns the go-plugin reattach configuration of each
+// provider in value, which uses the JSON format of the TF_REATTACH_PROVIDERS
+// environment variable. The returned map is keyed by fully qualified provider
+// address, such as registry.terraform.io/hashicorp/example, even if value
+// uses short provider addresses, such as hashicorp/example.
+func ParseReattachProviders(value string) (map[string]*plugin.ReattachConfig, error) {
+	return manageddebug.ParseReattachConfigs(value)
+}
+
+// DialReattachProviders returns a Client connected to the provider with the
+// given address in value, which uses the JSON format of the
+// TF_REATTACH_PROVIDERS environment variable, such as the value of
+// os.Getenv(EnvTfReattachProviders). See DialReattachConfig for more
+// information.
+func DialReattachProviders(value string, providerAddress string, opts ...grpc.DialOption) (*Client, error) {
+	configs, err := ParseReattachProviders(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	normalizedAddress, err := manageddebug.NormalizeProviderAddress(providerAddress)
+
+	if err != nil {
+		return nil, err
+	}
+
+	config, ok := configs[normalizedAddress]
+
+	if !ok {
+		return nil, fmt.Errorf("provider %q not found in %s", providerAddress, EnvTfReattachProviders)
+	}
+
+	return DialReattachConfig(config, opts...)
+}
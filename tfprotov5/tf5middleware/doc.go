@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tf5middleware wraps a tfprotov5.ProviderServer with interceptors, so
+// cross-cutting provider logic, such as caching, validation, or logging, can
+// be layered around every RPC without gRPC-level hooks. For example:
+//
+//	server := tf5middleware.Wrap(provider, func(ctx context.Context, rpc string, req any, next tf5middleware.Handler) (any, error) {
+//		start := time.Now()
+//
+//		resp, err := next(ctx, req)
+//
+//		log.Printf("%s took %s", rpc, time.Since(start))
+//
+//		return resp, err
+//	})
+//
+// Interceptors receive the typed tfprotov5 request of each RPC, such as
+// *tfprotov5.ReadResourceRequest, and return the typed response, such as
+// *tfprotov5.ReadResourceResponse, so they can use type switches to handle
+// specific RPCs.
+package tf5middleware
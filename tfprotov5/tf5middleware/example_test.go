@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5middleware_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5middleware"
+)
+
+func ExampleWrap() {
+	// Log the type name of every data source read.
+	logging := func(ctx context.Context, rpc string, req any, next tf5middleware.Handler) (any, error) {
+		if readReq, ok := req.(*tfprotov5.ReadDataSourceRequest); ok {
+			fmt.Printf("%s: %s\n", rpc, readReq.TypeName)
+		}
+
+		return next(ctx, req)
+	}
+
+	server := tf5middleware.Wrap(testProviderServer{}, logging)
+
+	resp, _ := server.ReadDataSource(context.Background(), &tfprotov5.ReadDataSourceRequest{
+		TypeName: "example_data_source",
+	})
+
+	fmt.Println(resp.Diagnostics[0].Summary)
+
+	// Output:
+	// ReadDataSource: example_data_source
+	// server example_data_source
+}
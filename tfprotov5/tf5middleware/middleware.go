@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+var (
+	_ tfprotov5.ProviderServerWithEphemeralResources = wrappedServer{}
+	_ tfprotov5.ProviderServerWithActions            = wrappedServer{}
+)
+
+// Handler handles the request of an RPC, returning its response. The request
+// and response are pointers to the tfprotov5 types of the RPC, such as
+// *tfprotov5.ReadResourceRequest and *tfprotov5.ReadResourceResponse.
+type Handler func(ctx context.Context, req any) (any, error)
+
+// Interceptor is called for every RPC of the ProviderServer returned by
+// Wrap, where rpc is the name of the ProviderServer method, such as
+// "ReadResource", and req is a pointer to the tfprotov5 request type of the
+// RPC, such as *tfprotov5.ReadResourceRequest.
+//
+// Interceptors call next to continue handling the request, with the same or
+// a modified request of the same type, or can return a response without
+// calling next. The returned response must be nil or a pointer to the
+// tfprotov5 response type of the RPC, such as *tfprotov5.ReadResourceResponse,
+// otherwise the RPC returns an error.
+type Interceptor func(ctx context.Context, rpc string, req any, next Handler) (any, error)
+
+// Wrap returns a tfprotov5.ProviderServer which calls server through the
+// interceptors, where the first interceptor is called first and the last
+// interceptor calls server.
+//
+// The returned ProviderServer also implements all optional interfaces, such as
+// tfprotov5.ProviderServerWithEphemeralResources, except
+// tfprotov5.ProviderServerWithEncodedProviderSchema, so interceptors see every
+// GetProviderSchema request. The RPCs of optional interfaces not implemented
+// by server return an error diagnostic explaining that the provider does not
+// implement them, as they would without the wrapper.
+func Wrap(server tfprotov5.ProviderServer, interceptors ...Interceptor) tfprotov5.ProviderServer {
+	s := wrappedServer{
+		server:             server,
+		interceptors:       interceptors,
+		ephemeralResources: tfprotov5.UnimplementedProviderServer{},
+		actions:            tfprotov5.UnimplementedProviderServer{},
+	}
+
+	if ephemeralResources, ok := server.(tfprotov5.ProviderServerWithEphemeralResources); ok {
+		s.ephemeralResources = ephemeralResources
+	}
+
+	if actions, ok := server.(tfprotov5.ProviderServerWithActions); ok {
+		s.actions = actions
+	}
+
+	return s
+}
+
+// wrappedServer is the ProviderServer returned by Wrap.
+type wrappedServer struct {
+	server       tfprotov5.ProviderServer
+	interceptors []Interceptor
+
+	ephemeralResources tfprotov5.EphemeralResourceServer
+	actions            tfprotov5.ActionServer
+}
+
+// intercept calls the handler of an RPC through the interceptors of the
+// server, returning the typed response.
+func intercept[Req any, Resp any](ctx context.Context, s wrappedServer, rpc string, req *Req, handler func(context.Context, *Req) (*Resp, error)) (*Resp, error) {
+	next := func(ctx context.Context, req any) (any, error) {
+		typedReq, ok := req.(*Req)
+
+		if !ok {
+			return nil, fmt.Errorf("%s interceptor passed request of type %T, expected %T", rpc, req, (*Req)(nil))
+		}
+
+		return handler(ctx, typedReq)
+	}
+
+	for i := len(s.interceptors) - 1; i >= 0; i-- {
+		interceptor, handler := s.interceptors[i], next
+
+		next = func(ctx context.Context, req any) (any, error) {
+			return interceptor(ctx, rpc, req, handler)
+		}
+	}
+
+	resp, err := next(ctx, req)
+
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	typedResp, ok := resp.(*Resp)
+
+	if !ok {
+		return nil, fmt.Errorf("%s interceptor returned response of type %T, expected %T", rpc, resp, (*Resp)(nil))
+	}
+
+	return typedResp, nil
+}
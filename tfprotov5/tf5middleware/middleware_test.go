@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5middleware_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5middleware"
+)
+
+type testProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s testProviderServer) ReadDataSource(_ context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	return &tfprotov5.ReadDataSourceResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  "server " + req.TypeName,
+			},
+		},
+	}, nil
+}
+
+type testEphemeralProviderServer struct {
+	testProviderServer
+	tfprotov5.EphemeralResourceServer
+}
+
+func (s testEphemeralProviderServer) CloseEphemeralResource(_ context.Context, req *tfprotov5.CloseEphemeralResourceRequest) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	return &tfprotov5.CloseEphemeralResourceResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  "server " + req.TypeName,
+			},
+		},
+	}, nil
+}
+
+// testSummaryInterceptor returns an Interceptor which appends a warning
+// diagnostic with the summary to ReadDataSource responses.
+func testSummaryInterceptor(summary string) tf5middleware.Interceptor {
+	return func(ctx context.Context, rpc string, req any, next tf5middleware.Handler) (any, error) {
+		resp, err := next(ctx, req)
+
+		if readResp, ok := resp.(*tfprotov5.ReadDataSourceResponse); ok && rpc == "ReadDataSource" {
+			readResp.Diagnostics = append(readResp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  summary,
+			})
+		}
+
+		return resp, err
+	}
+}
+
+func TestWrap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		interceptors  []tf5middleware.Interceptor
+		expected      *tfprotov5.ReadDataSourceResponse
+		expectedError string
+	}{
+		"no-interceptors": {
+			expected: &tfprotov5.ReadDataSourceResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityWarning,
+						Summary:  "server test_data_source",
+					},
+				},
+			},
+		},
+		"order": {
+			interceptors: []tf5middleware.Interceptor{
+				testSummaryInterceptor("first"),
+				testSummaryInterceptor("second"),
+			},
+			expected: &tfprotov5.ReadDataSourceResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityWarning,
+						Summary:  "server test_data_source",
+					},
+					{
+						Severity: tfprotov5.DiagnosticSeverityWarning,
+						Summary:  "second",
+					},
+					{
+						Severity: tfprotov5.DiagnosticSeverityWarning,
+						Summary:  "first",
+					},
+				},
+			},
+		},
+		"modified-request": {
+			interceptors: []tf5middleware.Interceptor{
+				func(ctx context.Context, _ string, req any, next tf5middleware.Handler) (any, error) {
+					if readReq, ok := req.(*tfprotov5.ReadDataSourceRequest); ok {
+						req = &tfprotov5.ReadDataSourceRequest{
+							TypeName: readReq.TypeName + "_modified",
+						}
+					}
+
+					return next(ctx, req)
+				},
+			},
+			expected: &tfprotov5.ReadDataSourceResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityWarning,
+						Summary:  "server test_data_source_modified",
+					},
+				},
+			},
+		},
+		"short-circuit": {
+			interceptors: []tf5middleware.Interceptor{
+				func(_ context.Context, _ string, _ any, _ tf5middleware.Handler) (any, error) {
+					return &tfprotov5.ReadDataSourceResponse{}, nil
+				},
+				testSummaryInterceptor("not called"),
+			},
+			expected: &tfprotov5.ReadDataSourceResponse{},
+		},
+		"nil-response": {
+			interceptors: []tf5middleware.Interceptor{
+				func(_ context.Context, _ string, _ any, _ tf5middleware.Handler) (any, error) {
+					return nil, nil
+				},
+			},
+			expected: nil,
+		},
+		"invalid-request-type": {
+			interceptors: []tf5middleware.Interceptor{
+				func(ctx context.Context, _ string, _ any, next tf5middleware.Handler) (any, error) {
+					return next(ctx, &tfprotov5.ReadResourceRequest{})
+				},
+			},
+			expectedError: "ReadDataSource interceptor passed request of type *tfprotov5.ReadResourceRequest, expected *tfprotov5.ReadDataSourceRequest",
+		},
+		"invalid-response-type": {
+			interceptors: []tf5middleware.Interceptor{
+				func(_ context.Context, _ string, _ any, _ tf5middleware.Handler) (any, error) {
+					return &tfprotov5.ReadResourceResponse{}, nil
+				},
+			},
+			expectedError: "ReadDataSource interceptor returned response of type *tfprotov5.ReadResourceResponse, expected *tfprotov5.ReadDataSourceResponse",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tf5middleware.Wrap(testProviderServer{}, testCase.interceptors...)
+
+			got, err := server.ReadDataSource(context.Background(), &tfprotov5.ReadDataSourceRequest{
+				TypeName: "test_data_source",
+			})
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWrap_optionalInterfaces(t *testing.T) {
+	t.Parallel()
+
+	var rpcs []string
+
+	interceptor := func(ctx context.Context, rpc string, req any, next tf5middleware.Handler) (any, error) {
+		rpcs = append(rpcs, rpc)
+
+		return next(ctx, req)
+	}
+
+	ctx := context.Background()
+	req := &tfprotov5.CloseEphemeralResourceRequest{
+		TypeName: "test_ephemeral_resource",
+	}
+
+	got, err := tf5middleware.Wrap(testEphemeralProviderServer{}, interceptor).(tfprotov5.ProviderServerWithEphemeralResources).CloseEphemeralResource(ctx, req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got.Diagnostics[0].Summary, "server test_ephemeral_resource"); diff != "" {
+		t.Errorf("unexpected implemented difference: %s", diff)
+	}
+
+	got, err = tf5middleware.Wrap(testProviderServer{}, interceptor).(tfprotov5.ProviderServerWithEphemeralResources).CloseEphemeralResource(ctx, req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got.Diagnostics[0].Summary, "Provider Ephemeral Resource Not Implemented"); diff != "" {
+		t.Errorf("unexpected not implemented difference: %s", diff)
+	}
+
+	actionResp, err := tf5middleware.Wrap(testProviderServer{}, interceptor).(tfprotov5.ProviderServerWithActions).InvokeAction(ctx, &tfprotov5.InvokeActionRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var events []tfprotov5.InvokeActionEvent
+
+	actionResp.Events(func(event tfprotov5.InvokeActionEvent) bool {
+		events = append(events, event)
+
+		return true
+	})
+
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+
+	completed, ok := events[0].Type.(tfprotov5.CompletedInvokeActionEventType)
+
+	if !ok || len(completed.Diagnostics) != 1 || completed.Diagnostics[0].Summary != "Provider Action Not Implemented" {
+		t.Errorf("unexpected InvokeAction event: %#v", events[0])
+	}
+
+	if diff := cmp.Diff(rpcs, []string{"CloseEphemeralResource", "CloseEphemeralResource", "InvokeAction"}); diff != "" {
+		t.Errorf("unexpected intercepted RPCs difference: %s", diff)
+	}
+}
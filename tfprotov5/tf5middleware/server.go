@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5middleware
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func (s wrappedServer) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	return intercept(ctx, s, "GetMetadata", req, s.server.GetMetadata)
+}
+
+func (s wrappedServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return intercept(ctx, s, "GetProviderSchema", req, s.server.GetProviderSchema)
+}
+
+func (s wrappedServer) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	return intercept(ctx, s, "PrepareProviderConfig", req, s.server.PrepareProviderConfig)
+}
+
+func (s wrappedServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	return intercept(ctx, s, "ConfigureProvider", req, s.server.ConfigureProvider)
+}
+
+func (s wrappedServer) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	return intercept(ctx, s, "StopProvider", req, s.server.StopProvider)
+}
+
+func (s wrappedServer) ValidateDataSourceConfig(ctx context.Context, req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	return intercept(ctx, s, "ValidateDataSourceConfig", req, s.server.ValidateDataSourceConfig)
+}
+
+func (s wrappedServer) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	return intercept(ctx, s, "ReadDataSource", req, s.server.ReadDataSource)
+}
+
+func (s wrappedServer) ValidateResourceTypeConfig(ctx context.Context, req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	return intercept(ctx, s, "ValidateResourceTypeConfig", req, s.server.ValidateResourceTypeConfig)
+}
+
+func (s wrappedServer) UpgradeResourceState(ctx context.Context, req *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	return intercept(ctx, s, "UpgradeResourceState", req, s.server.UpgradeResourceState)
+}
+
+func (s wrappedServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	return intercept(ctx, s, "ReadResource", req, s.server.ReadResource)
+}
+
+func (s wrappedServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	return intercept(ctx, s, "PlanResourceChange", req, s.server.PlanResourceChange)
+}
+
+func (s wrappedServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	return intercept(ctx, s, "ApplyResourceChange", req, s.server.ApplyResourceChange)
+}
+
+func (s wrappedServer) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	return intercept(ctx, s, "ImportResourceState", req, s.server.ImportResourceState)
+}
+
+func (s wrappedServer) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	return intercept(ctx, s, "MoveResourceState", req, s.server.MoveResourceState)
+}
+
+func (s wrappedServer) CallFunction(ctx context.Context, req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	return intercept(ctx, s, "CallFunction", req, s.server.CallFunction)
+}
+
+func (s wrappedServer) GetFunctions(ctx context.Context, req *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	return intercept(ctx, s, "GetFunctions", req, s.server.GetFunctions)
+}
+
+func (s wrappedServer) ValidateEphemeralResourceConfig(ctx context.Context, req *tfprotov5.ValidateEphemeralResourceConfigRequest) (*tfprotov5.ValidateEphemeralResourceConfigResponse, error) {
+	return intercept(ctx, s, "ValidateEphemeralResourceConfig", req, s.ephemeralResources.ValidateEphemeralResourceConfig)
+}
+
+func (s wrappedServer) OpenEphemeralResource(ctx context.Context, req *tfprotov5.OpenEphemeralResourceRequest) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	return intercept(ctx, s, "OpenEphemeralResource", req, s.ephemeralResources.OpenEphemeralResource)
+}
+
+func (s wrappedServer) RenewEphemeralResource(ctx context.Context, req *tfprotov5.RenewEphemeralResourceRequest) (*tfprotov5.RenewEphemeralResourceResponse, error) {
+	return intercept(ctx, s, "RenewEphemeralResource", req, s.ephemeralResources.RenewEphemeralResource)
+}
+
+func (s wrappedServer) CloseEphemeralResource(ctx context.Context, req *tfprotov5.CloseEphemeralResourceRequest) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	return intercept(ctx, s, "CloseEphemeralResource", req, s.ephemeralResources.CloseEphemeralResource)
+}
+
+func (s wrappedServer) ValidateActionConfig(ctx context.Context, req *tfprotov5.ValidateActionConfigRequest) (*tfprotov5.ValidateActionConfigResponse, error) {
+	return intercept(ctx, s, "ValidateActionConfig", req, s.actions.ValidateActionConfig)
+}
+
+func (s wrappedServer) InvokeAction(ctx context.Context, req *tfprotov5.InvokeActionRequest) (*tfprotov5.InvokeActionResponse, error) {
+	return intercept(ctx, s, "InvokeAction", req, s.actions.InvokeAction)
+}
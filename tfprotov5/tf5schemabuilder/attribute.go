@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributeBuilder builds a tfprotov5.SchemaAttribute. Create one with the
+// Attribute function.
+type AttributeBuilder struct {
+	attribute *tfprotov5.SchemaAttribute
+}
+
+// Attribute returns a new AttributeBuilder for an attribute with the given
+// name and type. One of Required, Optional, or Computed must be called for
+// the attribute to be valid.
+func Attribute(name string, typ tftypes.Type) *AttributeBuilder {
+	return &AttributeBuilder{
+		attribute: &tfprotov5.SchemaAttribute{
+			Name: name,
+			Type: typ,
+		},
+	}
+}
+
+// Required marks the attribute as required in configuration.
+func (b *AttributeBuilder) Required() *AttributeBuilder {
+	b.attribute.Required = true
+
+	return b
+}
+
+// Optional marks the attribute as optional in configuration.
+func (b *AttributeBuilder) Optional() *AttributeBuilder {
+	b.attribute.Optional = true
+
+	return b
+}
+
+// Computed marks the attribute as set by the provider. It can be combined
+// with Optional to allow the provider to set a value when configuration does
+// not.
+func (b *AttributeBuilder) Computed() *AttributeBuilder {
+	b.attribute.Computed = true
+
+	return b
+}
+
+// Sensitive marks the attribute value as sensitive in Terraform output.
+func (b *AttributeBuilder) Sensitive() *AttributeBuilder {
+	b.attribute.Sensitive = true
+
+	return b
+}
+
+// Deprecated marks the attribute as deprecated.
+func (b *AttributeBuilder) Deprecated() *AttributeBuilder {
+	b.attribute.Deprecated = true
+
+	return b
+}
+
+// Description sets the plaintext description of the attribute.
+func (b *AttributeBuilder) Description(description string) *AttributeBuilder {
+	b.attribute.Description = description
+	b.attribute.DescriptionKind = tfprotov5.StringKindPlain
+
+	return b
+}
+
+// MarkdownDescription sets the markdown description of the attribute.
+func (b *AttributeBuilder) MarkdownDescription(description string) *AttributeBuilder {
+	b.attribute.Description = description
+	b.attribute.DescriptionKind = tfprotov5.StringKindMarkdown
+
+	return b
+}
+
+// schemaAttribute returns the attribute being built, or nil if the builder is
+// nil, which is reported when the schema is validated.
+func (b *AttributeBuilder) schemaAttribute() *tfprotov5.SchemaAttribute {
+	if b == nil {
+		return nil
+	}
+
+	return b.attribute
+}
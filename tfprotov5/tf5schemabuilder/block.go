@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// BlockBuilder builds a tfprotov5.SchemaNestedBlock. Create one with the
+// Block function.
+type BlockBuilder struct {
+	nestedBlock *tfprotov5.SchemaNestedBlock
+}
+
+// Block returns a new BlockBuilder for a nested block with the given type
+// name and nesting mode.
+func Block(typeName string, nesting tfprotov5.SchemaNestedBlockNestingMode) *BlockBuilder {
+	return &BlockBuilder{
+		nestedBlock: &tfprotov5.SchemaNestedBlock{
+			TypeName: typeName,
+			Nesting:  nesting,
+			Block:    &tfprotov5.SchemaBlock{},
+		},
+	}
+}
+
+// MinItems sets the minimum number of instances of the block.
+func (b *BlockBuilder) MinItems(minItems int64) *BlockBuilder {
+	b.nestedBlock.MinItems = minItems
+
+	return b
+}
+
+// MaxItems sets the maximum number of instances of the block.
+func (b *BlockBuilder) MaxItems(maxItems int64) *BlockBuilder {
+	b.nestedBlock.MaxItems = maxItems
+
+	return b
+}
+
+// Description sets the plaintext description of the block.
+func (b *BlockBuilder) Description(description string) *BlockBuilder {
+	b.nestedBlock.Block.Description = description
+	b.nestedBlock.Block.DescriptionKind = tfprotov5.StringKindPlain
+
+	return b
+}
+
+// MarkdownDescription sets the markdown description of the block.
+func (b *BlockBuilder) MarkdownDescription(description string) *BlockBuilder {
+	b.nestedBlock.Block.Description = description
+	b.nestedBlock.Block.DescriptionKind = tfprotov5.StringKindMarkdown
+
+	return b
+}
+
+// Deprecated marks the block as deprecated.
+func (b *BlockBuilder) Deprecated() *BlockBuilder {
+	b.nestedBlock.Block.Deprecated = true
+
+	return b
+}
+
+// Attributes adds attributes to the block.
+func (b *BlockBuilder) Attributes(attributes ...*AttributeBuilder) *BlockBuilder {
+	for _, attribute := range attributes {
+		b.nestedBlock.Block.Attributes = append(b.nestedBlock.Block.Attributes, attribute.schemaAttribute())
+	}
+
+	return b
+}
+
+// Blocks adds nested blocks to the block.
+func (b *BlockBuilder) Blocks(blocks ...*BlockBuilder) *BlockBuilder {
+	for _, block := range blocks {
+		b.nestedBlock.Block.BlockTypes = append(b.nestedBlock.Block.BlockTypes, block.schemaNestedBlock())
+	}
+
+	return b
+}
+
+// schemaNestedBlock returns the nested block being built, or nil if the
+// builder is nil, which is reported when the schema is validated.
+func (b *BlockBuilder) schemaNestedBlock() *tfprotov5.SchemaNestedBlock {
+	if b == nil {
+		return nil
+	}
+
+	return b.nestedBlock
+}
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tf5schemabuilder provides chainable constructors for building
+// tfprotov5.Schema, as an alternative to authoring large schemas as nested
+// struct literals. For example:
+//
+//	schema, err := tf5schemabuilder.Schema().
+//		Attributes(
+//			tf5schemabuilder.Attribute("id", tftypes.String).Computed(),
+//			tf5schemabuilder.Attribute("name", tftypes.String).Required(),
+//		).
+//		Blocks(
+//			tf5schemabuilder.Block("rule", tfprotov5.SchemaNestedBlockNestingModeList).
+//				MaxItems(10).
+//				Attributes(
+//					tf5schemabuilder.Attribute("priority", tftypes.Number).Required(),
+//				),
+//		).
+//		Build()
+//
+// Builders modify and return themselves, so they should not be shared between
+// schemas while being built. Built schemas are validated and are not affected
+// by further builder changes.
+package tf5schemabuilder
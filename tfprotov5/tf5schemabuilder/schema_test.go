@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5schemabuilder_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5schemabuilder"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaBuilderBuild(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		builder       *tf5schemabuilder.SchemaBuilder
+		expected      *tfprotov5.Schema
+		expectedError string
+	}{
+		"empty": {
+			builder: tf5schemabuilder.Schema(),
+			expected: &tfprotov5.Schema{
+				Block: &tfprotov5.SchemaBlock{},
+			},
+		},
+		"schema": {
+			builder: tf5schemabuilder.Schema().
+				Version(2).
+				MarkdownDescription("test *description*").
+				Deprecated().
+				Attributes(
+					tf5schemabuilder.Attribute("id", tftypes.String).Computed(),
+					tf5schemabuilder.Attribute("name", tftypes.String).Required().Description("test name"),
+					tf5schemabuilder.Attribute("password", tftypes.String).Optional().Sensitive(),
+					tf5schemabuilder.Attribute("tags", tftypes.Map{ElementType: tftypes.String}).
+						Optional().
+						Computed().
+						Deprecated().
+						MarkdownDescription("test *tags*"),
+				).
+				Blocks(
+					tf5schemabuilder.Block("rule", tfprotov5.SchemaNestedBlockNestingModeSet).
+						MinItems(1).
+						MaxItems(10).
+						Description("test rule").
+						Attributes(
+							tf5schemabuilder.Attribute("priority", tftypes.Number).Required(),
+						).
+						Blocks(
+							tf5schemabuilder.Block("filter", tfprotov5.SchemaNestedBlockNestingModeSingle).
+								MarkdownDescription("test *filter*").
+								Deprecated(),
+						),
+				),
+			expected: &tfprotov5.Schema{
+				Version: 2,
+				Block: &tfprotov5.SchemaBlock{
+					Description:     "test *description*",
+					DescriptionKind: tfprotov5.StringKindMarkdown,
+					Deprecated:      true,
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "id",
+							Type:     tftypes.String,
+							Computed: true,
+						},
+						{
+							Name:        "name",
+							Type:        tftypes.String,
+							Required:    true,
+							Description: "test name",
+						},
+						{
+							Name:      "password",
+							Type:      tftypes.String,
+							Optional:  true,
+							Sensitive: true,
+						},
+						{
+							Name:            "tags",
+							Type:            tftypes.Map{ElementType: tftypes.String},
+							Optional:        true,
+							Computed:        true,
+							Deprecated:      true,
+							Description:     "test *tags*",
+							DescriptionKind: tfprotov5.StringKindMarkdown,
+						},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							TypeName: "rule",
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+							MinItems: 1,
+							MaxItems: 10,
+							Block: &tfprotov5.SchemaBlock{
+								Description: "test rule",
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:     "priority",
+										Type:     tftypes.Number,
+										Required: true,
+									},
+								},
+								BlockTypes: []*tfprotov5.SchemaNestedBlock{
+									{
+										TypeName: "filter",
+										Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+										Block: &tfprotov5.SchemaBlock{
+											Description:     "test *filter*",
+											DescriptionKind: tfprotov5.StringKindMarkdown,
+											Deprecated:      true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"invalid": {
+			builder: tf5schemabuilder.Schema().
+				Attributes(
+					nil,
+					tf5schemabuilder.Attribute("no_flags", tftypes.String),
+				).
+				Blocks(
+					tf5schemabuilder.Block("invalid", tfprotov5.SchemaNestedBlockNestingModeMap).MaxItems(1),
+				),
+			expectedError: "root block: attribute is nil\n" +
+				`attribute "no_flags": one of Required, Optional, or Computed must be set` + "\n" +
+				`block "invalid": MinItems and MaxItems must be 0 in MAP nesting mode`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.builder.Build()
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error:\n%s\ngot:\n%s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaBuilderBuild_Independent(t *testing.T) {
+	t.Parallel()
+
+	attribute := tf5schemabuilder.Attribute("test", tftypes.String).Optional()
+	builder := tf5schemabuilder.Schema().Attributes(attribute)
+
+	got, err := builder.Build()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	attribute.Sensitive()
+	builder.Version(1).Attributes(tf5schemabuilder.Attribute("other", tftypes.String).Computed())
+
+	expected := &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{
+					Name:     "test",
+					Type:     tftypes.String,
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestSchemaBuilderMustBuild(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic, got none")
+		}
+	}()
+
+	tf5schemabuilder.Schema().
+		Attributes(tf5schemabuilder.Attribute("no_flags", tftypes.String)).
+		MustBuild()
+}
@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tf5schemajson renders tfprotov5.GetProviderSchemaResponse into the
+// JSON format emitted by the `terraform providers schema -json` command.
+//
+// Documentation generators, registries, and other tooling can use this package
+// to consume provider schemas without invoking Terraform CLI.
+package tf5schemajson
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5schemajson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// FormatVersion is the version of the JSON format emitted by the
+// `terraform providers schema -json` command which this package implements.
+const FormatVersion = "1.0"
+
+// ProviderSchemas is the top level object of the JSON format, containing the
+// schemas of providers keyed by their source address, such as
+// registry.terraform.io/hashicorp/random.
+type ProviderSchemas struct {
+	FormatVersion string                     `json:"format_version"`
+	Schemas       map[string]*ProviderSchema `json:"provider_schemas,omitempty"`
+}
+
+// ProviderSchema is the JSON representation of all schemas of a provider.
+type ProviderSchema struct {
+	Provider                 *Schema                       `json:"provider,omitempty"`
+	ResourceSchemas          map[string]*Schema            `json:"resource_schemas,omitempty"`
+	DataSourceSchemas        map[string]*Schema            `json:"data_source_schemas,omitempty"`
+	EphemeralResourceSchemas map[string]*Schema            `json:"ephemeral_resource_schemas,omitempty"`
+	Functions                map[string]*FunctionSignature `json:"functions,omitempty"`
+	ActionSchemas            map[string]*ActionSchema      `json:"action_schemas,omitempty"`
+}
+
+// Marshal returns the JSON encoding of the GetProviderSchema response of a
+// single provider, identified by its source address, in the format of the
+// `terraform providers schema -json` command.
+func Marshal(providerAddress string, resp *tfprotov5.GetProviderSchemaResponse) ([]byte, error) {
+	providerSchema, err := NewProviderSchema(resp)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(ProviderSchemas{
+		FormatVersion: FormatVersion,
+		Schemas: map[string]*ProviderSchema{
+			providerAddress: providerSchema,
+		},
+	})
+}
+
+// NewProviderSchema returns the JSON representation of a GetProviderSchema
+// response. It returns an error if the response is nil, contains error
+// diagnostics, or contains schemas which cannot be represented, such as
+// attributes without a type.
+func NewProviderSchema(resp *tfprotov5.GetProviderSchemaResponse) (*ProviderSchema, error) {
+	if resp == nil {
+		return nil, errors.New("GetProviderSchema response is nil")
+	}
+
+	if err := tfprotov5.Diagnostics(resp.Diagnostics).Err(); err != nil {
+		return nil, fmt.Errorf("GetProviderSchema response contains error diagnostics: %w", err)
+	}
+
+	var err error
+
+	result := &ProviderSchema{}
+
+	if resp.Provider != nil {
+		result.Provider, err = NewSchema(resp.Provider)
+
+		if err != nil {
+			return nil, fmt.Errorf("provider schema: %w", err)
+		}
+	}
+
+	result.ResourceSchemas, err = newSchemas("resource", resp.ResourceSchemas)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result.DataSourceSchemas, err = newSchemas("data source", resp.DataSourceSchemas)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result.EphemeralResourceSchemas, err = newSchemas("ephemeral resource", resp.EphemeralResourceSchemas)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Functions) > 0 {
+		result.Functions = make(map[string]*FunctionSignature, len(resp.Functions))
+
+		for name, function := range resp.Functions {
+			result.Functions[name], err = NewFunctionSignature(function)
+
+			if err != nil {
+				return nil, fmt.Errorf("function %q: %w", name, err)
+			}
+		}
+	}
+
+	if len(resp.ActionSchemas) > 0 {
+		result.ActionSchemas = make(map[string]*ActionSchema, len(resp.ActionSchemas))
+
+		for typeName, actionSchema := range resp.ActionSchemas {
+			if actionSchema == nil {
+				return nil, fmt.Errorf("action %q: schema is nil", typeName)
+			}
+
+			schema, err := NewSchema(actionSchema.Schema)
+
+			if err != nil {
+				return nil, fmt.Errorf("action %q: %w", typeName, err)
+			}
+
+			result.ActionSchemas[typeName] = &ActionSchema{
+				Block: schema.Block,
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// newSchemas returns the JSON representation of schemas keyed by type name,
+// or nil if there are no schemas.
+func newSchemas(kind string, schemas map[string]*tfprotov5.Schema) (map[string]*Schema, error) {
+	if len(schemas) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]*Schema, len(schemas))
+
+	for typeName, schema := range schemas {
+		jsonSchema, err := NewSchema(schema)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %w", kind, typeName, err)
+		}
+
+		result[typeName] = jsonSchema
+	}
+
+	return result, nil
+}
@@ -0,0 +1,366 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5schemajson_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5schemajson"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	testSchema := &tfprotov5.Schema{
+		Version: 1,
+		Block: &tfprotov5.SchemaBlock{
+			Description:     "test *block*",
+			DescriptionKind: tfprotov5.StringKindMarkdown,
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{
+					Name:     "id",
+					Type:     tftypes.String,
+					Computed: true,
+				},
+				{
+					Name:        "tags",
+					Type:        tftypes.Map{ElementType: tftypes.String},
+					Description: "test tags",
+					Optional:    true,
+					Sensitive:   true,
+					Deprecated:  true,
+				},
+			},
+			BlockTypes: []*tfprotov5.SchemaNestedBlock{
+				{
+					TypeName: "rule",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+					MinItems: 1,
+					MaxItems: 2,
+					Block: &tfprotov5.SchemaBlock{
+						Attributes: []*tfprotov5.SchemaAttribute{
+							{
+								Name:     "priority",
+								Type:     tftypes.Number,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		resp          *tfprotov5.GetProviderSchemaResponse
+		expected      string
+		expectedError string
+	}{
+		"empty": {
+			resp: &tfprotov5.GetProviderSchemaResponse{},
+			expected: `{
+				"format_version": "1.0",
+				"provider_schemas": {
+					"registry.terraform.io/hashicorp/test": {}
+				}
+			}`,
+		},
+		"schemas": {
+			resp: &tfprotov5.GetProviderSchemaResponse{
+				Provider: &tfprotov5.Schema{
+					Block: &tfprotov5.SchemaBlock{},
+				},
+				ProviderMeta: &tfprotov5.Schema{
+					Block: &tfprotov5.SchemaBlock{},
+				},
+				ResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_resource": testSchema,
+				},
+				DataSourceSchemas: map[string]*tfprotov5.Schema{
+					"test_data_source": {
+						Block: &tfprotov5.SchemaBlock{
+							BlockTypes: []*tfprotov5.SchemaNestedBlock{
+								{
+									TypeName: "group",
+									Nesting:  tfprotov5.SchemaNestedBlockNestingModeGroup,
+									Block:    &tfprotov5.SchemaBlock{},
+								},
+							},
+						},
+					},
+				},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_ephemeral_resource": {
+						Block: &tfprotov5.SchemaBlock{},
+					},
+				},
+				ActionSchemas: map[string]*tfprotov5.ActionSchema{
+					"test_action": {
+						Schema: &tfprotov5.Schema{
+							Block: &tfprotov5.SchemaBlock{},
+						},
+					},
+				},
+				Functions: map[string]*tfprotov5.Function{
+					"test_function": {
+						Summary:            "test summary",
+						Description:        "test description",
+						DeprecationMessage: "test deprecation",
+						Parameters: []*tfprotov5.FunctionParameter{
+							{
+								Name:           "input",
+								Description:    "test input",
+								AllowNullValue: true,
+								Type:           tftypes.String,
+							},
+						},
+						VariadicParameter: &tfprotov5.FunctionParameter{
+							Name: "rest",
+							Type: tftypes.List{ElementType: tftypes.Bool},
+						},
+						Return: &tfprotov5.FunctionReturn{
+							Type: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"result": tftypes.DynamicPseudoType,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: `{
+				"format_version": "1.0",
+				"provider_schemas": {
+					"registry.terraform.io/hashicorp/test": {
+						"provider": {
+							"version": 0,
+							"block": {
+								"description_kind": "plain"
+							}
+						},
+						"resource_schemas": {
+							"test_resource": {
+								"version": 1,
+								"block": {
+									"attributes": {
+										"id": {
+											"type": "string",
+											"description_kind": "plain",
+											"computed": true
+										},
+										"tags": {
+											"type": ["map", "string"],
+											"description": "test tags",
+											"description_kind": "plain",
+											"deprecated": true,
+											"optional": true,
+											"sensitive": true
+										}
+									},
+									"block_types": {
+										"rule": {
+											"nesting_mode": "list",
+											"block": {
+												"attributes": {
+													"priority": {
+														"type": "number",
+														"description_kind": "plain",
+														"required": true
+													}
+												},
+												"description_kind": "plain"
+											},
+											"min_items": 1,
+											"max_items": 2
+										}
+									},
+									"description": "test *block*",
+									"description_kind": "markdown"
+								}
+							}
+						},
+						"data_source_schemas": {
+							"test_data_source": {
+								"version": 0,
+								"block": {
+									"block_types": {
+										"group": {
+											"nesting_mode": "group",
+											"block": {
+												"description_kind": "plain"
+											}
+										}
+									},
+									"description_kind": "plain"
+								}
+							}
+						},
+						"ephemeral_resource_schemas": {
+							"test_ephemeral_resource": {
+								"version": 0,
+								"block": {
+									"description_kind": "plain"
+								}
+							}
+						},
+						"functions": {
+							"test_function": {
+								"description": "test description",
+								"summary": "test summary",
+								"deprecation_message": "test deprecation",
+								"return_type": ["object", {"result": "dynamic"}],
+								"parameters": [
+									{
+										"name": "input",
+										"description": "test input",
+										"is_nullable": true,
+										"type": "string"
+									}
+								],
+								"variadic_parameter": {
+									"name": "rest",
+									"type": ["list", "bool"]
+								}
+							}
+						},
+						"action_schemas": {
+							"test_action": {
+								"block": {
+									"description_kind": "plain"
+								}
+							}
+						}
+					}
+				}
+			}`,
+		},
+		"nil": {
+			resp:          nil,
+			expectedError: "GetProviderSchema response is nil",
+		},
+		"error-diagnostics": {
+			resp: &tfprotov5.GetProviderSchemaResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					tfprotov5.NewErrorDiagnostic("test summary", "test detail"),
+				},
+			},
+			expectedError: "GetProviderSchema response contains error diagnostics: test summary: test detail",
+		},
+		"nil-schema": {
+			resp: &tfprotov5.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_resource": nil,
+				},
+			},
+			expectedError: `resource "test_resource": schema is nil`,
+		},
+		"nil-attribute-type": {
+			resp: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{
+					"test_data_source": {
+						Block: &tfprotov5.SchemaBlock{
+							BlockTypes: []*tfprotov5.SchemaNestedBlock{
+								{
+									TypeName: "nested",
+									Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+									Block: &tfprotov5.SchemaBlock{
+										Attributes: []*tfprotov5.SchemaAttribute{
+											{
+												Name:     "test",
+												Optional: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: `data source "test_data_source": block "nested": attribute "test": type is nil`,
+		},
+		"invalid-nesting-mode": {
+			resp: &tfprotov5.GetProviderSchemaResponse{
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_ephemeral_resource": {
+						Block: &tfprotov5.SchemaBlock{
+							BlockTypes: []*tfprotov5.SchemaNestedBlock{
+								{
+									TypeName: "nested",
+									Block:    &tfprotov5.SchemaBlock{},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: `ephemeral resource "test_ephemeral_resource": block "nested": invalid nesting mode INVALID`,
+		},
+		"nil-function-return": {
+			resp: &tfprotov5.GetProviderSchemaResponse{
+				Functions: map[string]*tfprotov5.Function{
+					"test_function": {},
+				},
+			},
+			expectedError: `function "test_function": return is nil`,
+		},
+		"nil-function-parameter-type": {
+			resp: &tfprotov5.GetProviderSchemaResponse{
+				Functions: map[string]*tfprotov5.Function{
+					"test_function": {
+						Parameters: []*tfprotov5.FunctionParameter{
+							{
+								Name: "input",
+							},
+						},
+						Return: &tfprotov5.FunctionReturn{
+							Type: tftypes.String,
+						},
+					},
+				},
+			},
+			expectedError: `function "test_function": parameter 0: type is nil`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tf5schemajson.Marshal("registry.terraform.io/hashicorp/test", testCase.resp)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var expected bytes.Buffer
+
+			if err := json.Compact(&expected, []byte(testCase.expected)); err != nil {
+				t.Fatalf("unable to compact expected JSON: %s", err)
+			}
+
+			if string(got) != expected.String() {
+				t.Errorf("expected JSON:\n%s\ngot:\n%s", expected.String(), got)
+			}
+		})
+	}
+}
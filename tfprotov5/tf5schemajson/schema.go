@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5schemajson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	// DescriptionKindPlain is the JSON representation of
+	// tfprotov5.StringKindPlain.
+	DescriptionKindPlain = "plain"
+
+	// DescriptionKindMarkdown is the JSON representation of
+	// tfprotov5.StringKindMarkdown.
+	DescriptionKindMarkdown = "markdown"
+)
+
+// Schema is the JSON representation of a tfprotov5.Schema.
+type Schema struct {
+	Version int64  `json:"version"`
+	Block   *Block `json:"block,omitempty"`
+}
+
+// ActionSchema is the JSON representation of a tfprotov5.ActionSchema.
+type ActionSchema struct {
+	Block *Block `json:"block,omitempty"`
+}
+
+// Block is the JSON representation of a tfprotov5.SchemaBlock. Attributes
+// and nested blocks are keyed by name.
+type Block struct {
+	Attributes      map[string]*Attribute `json:"attributes,omitempty"`
+	BlockTypes      map[string]*BlockType `json:"block_types,omitempty"`
+	Description     string                `json:"description,omitempty"`
+	DescriptionKind string                `json:"description_kind,omitempty"`
+	Deprecated      bool                  `json:"deprecated,omitempty"`
+}
+
+// Attribute is the JSON representation of a tfprotov5.SchemaAttribute. The
+// Type is the JSON representation of the type signature, such as
+// ["list","string"].
+type Attribute struct {
+	Type            json.RawMessage `json:"type,omitempty"`
+	Description     string          `json:"description,omitempty"`
+	DescriptionKind string          `json:"description_kind,omitempty"`
+	Deprecated      bool            `json:"deprecated,omitempty"`
+	Required        bool            `json:"required,omitempty"`
+	Optional        bool            `json:"optional,omitempty"`
+	Computed        bool            `json:"computed,omitempty"`
+	Sensitive       bool            `json:"sensitive,omitempty"`
+}
+
+// BlockType is the JSON representation of a tfprotov5.SchemaNestedBlock.
+type BlockType struct {
+	NestingMode string `json:"nesting_mode,omitempty"`
+	Block       *Block `json:"block,omitempty"`
+	MinItems    int64  `json:"min_items,omitempty"`
+	MaxItems    int64  `json:"max_items,omitempty"`
+}
+
+// NewSchema returns the JSON representation of a tfprotov5.Schema. It returns
+// an error if the schema cannot be represented, such as a missing block or
+// attribute type.
+func NewSchema(schema *tfprotov5.Schema) (*Schema, error) {
+	if schema == nil {
+		return nil, errors.New("schema is nil")
+	}
+
+	if schema.Block == nil {
+		return nil, errors.New("schema block is nil")
+	}
+
+	block, err := newBlock(schema.Block)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schema{
+		Version: schema.Version,
+		Block:   block,
+	}, nil
+}
+
+// newBlock returns the JSON representation of a block.
+func newBlock(block *tfprotov5.SchemaBlock) (*Block, error) {
+	result := &Block{
+		Description:     block.Description,
+		DescriptionKind: descriptionKind(block.DescriptionKind),
+		Deprecated:      block.Deprecated,
+	}
+
+	if len(block.Attributes) > 0 {
+		result.Attributes = make(map[string]*Attribute, len(block.Attributes))
+	}
+
+	for _, attribute := range block.Attributes {
+		if attribute == nil {
+			return nil, errors.New("attribute is nil")
+		}
+
+		jsonAttribute, err := newAttribute(attribute)
+
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q: %w", attribute.Name, err)
+		}
+
+		result.Attributes[attribute.Name] = jsonAttribute
+	}
+
+	if len(block.BlockTypes) > 0 {
+		result.BlockTypes = make(map[string]*BlockType, len(block.BlockTypes))
+	}
+
+	for _, blockType := range block.BlockTypes {
+		if blockType == nil {
+			return nil, errors.New("nested block is nil")
+		}
+
+		if blockType.Block == nil {
+			return nil, fmt.Errorf("block %q: block is nil", blockType.TypeName)
+		}
+
+		nestingMode, err := blockNestingMode(blockType.Nesting)
+
+		if err != nil {
+			return nil, fmt.Errorf("block %q: %w", blockType.TypeName, err)
+		}
+
+		nestedBlock, err := newBlock(blockType.Block)
+
+		if err != nil {
+			return nil, fmt.Errorf("block %q: %w", blockType.TypeName, err)
+		}
+
+		result.BlockTypes[blockType.TypeName] = &BlockType{
+			NestingMode: nestingMode,
+			Block:       nestedBlock,
+			MinItems:    blockType.MinItems,
+			MaxItems:    blockType.MaxItems,
+		}
+	}
+
+	return result, nil
+}
+
+// newAttribute returns the JSON representation of an attribute.
+func newAttribute(attribute *tfprotov5.SchemaAttribute) (*Attribute, error) {
+	typeJSON, err := typeJSON(attribute.Type)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Attribute{
+		Type:            typeJSON,
+		Description:     attribute.Description,
+		DescriptionKind: descriptionKind(attribute.DescriptionKind),
+		Deprecated:      attribute.Deprecated,
+		Required:        attribute.Required,
+		Optional:        attribute.Optional,
+		Computed:        attribute.Computed,
+		Sensitive:       attribute.Sensitive,
+	}, nil
+}
+
+// blockNestingMode returns the JSON representation of a nested block nesting
+// mode.
+func blockNestingMode(nesting tfprotov5.SchemaNestedBlockNestingMode) (string, error) {
+	switch nesting {
+	case tfprotov5.SchemaNestedBlockNestingModeSingle:
+		return "single", nil
+	case tfprotov5.SchemaNestedBlockNestingModeGroup:
+		return "group", nil
+	case tfprotov5.SchemaNestedBlockNestingModeList:
+		return "list", nil
+	case tfprotov5.SchemaNestedBlockNestingModeSet:
+		return "set", nil
+	case tfprotov5.SchemaNestedBlockNestingModeMap:
+		return "map", nil
+	default:
+		return "", fmt.Errorf("invalid nesting mode %s", nesting)
+	}
+}
+
+// descriptionKind returns the JSON representation of a description kind,
+// which defaults to plain as in Terraform.
+func descriptionKind(kind tfprotov5.StringKind) string {
+	if kind == tfprotov5.StringKindMarkdown {
+		return DescriptionKindMarkdown
+	}
+
+	return DescriptionKindPlain
+}
+
+// typeJSON returns the JSON representation of a type signature.
+func typeJSON(typ tftypes.Type) (json.RawMessage, error) {
+	if typ == nil {
+		return nil, errors.New("type is nil")
+	}
+
+	// MarshalJSON is always error safe
+	result, _ := typ.MarshalJSON()
+
+	return result, nil
+}
@@ -0,0 +1,434 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+)
+
+type testActionProviderServer struct {
+	tfprotov5.ProviderServer
+
+	events func(yield func(tfprotov5.InvokeActionEvent) bool)
+	err    error
+}
+
+func (s testActionProviderServer) ValidateActionConfig(ctx context.Context, _ *tfprotov5.ValidateActionConfigRequest) (*tfprotov5.ValidateActionConfigResponse, error) {
+	actionType, _ := tf5server.ActionTypeFromContext(ctx)
+
+	return &tfprotov5.ValidateActionConfigResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  actionType,
+			},
+		},
+	}, nil
+}
+
+func (s testActionProviderServer) InvokeAction(_ context.Context, _ *tfprotov5.InvokeActionRequest) (*tfprotov5.InvokeActionResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return &tfprotov5.InvokeActionResponse{
+		Events: s.events,
+	}, nil
+}
+
+// testInvokeActionStream is a grpc.ServerStreamingServer which records sent
+// InvokeAction events.
+type testInvokeActionStream struct {
+	grpc.ServerStream
+
+	events  []*tfplugin5.InvokeAction_Event
+	sendErr error
+}
+
+func (s *testInvokeActionStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *testInvokeActionStream) Send(event *tfplugin5.InvokeAction_Event) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+
+	s.events = append(s.events, event)
+
+	return nil
+}
+
+// testInvokeActionEvents returns an InvokeAction events iterator of the
+// given events.
+func testInvokeActionEvents(events ...tfprotov5.InvokeActionEvent) func(yield func(tfprotov5.InvokeActionEvent) bool) {
+	return func(yield func(tfprotov5.InvokeActionEvent) bool) {
+		for _, event := range events {
+			if !yield(event) {
+				return
+			}
+		}
+	}
+}
+
+func testProgressInvokeActionEvent(message string) *tfplugin5.InvokeAction_Event {
+	return &tfplugin5.InvokeAction_Event{
+		Type: &tfplugin5.InvokeAction_Event_Progress_{
+			Progress: &tfplugin5.InvokeAction_Event_Progress{
+				Message: message,
+			},
+		},
+	}
+}
+
+func testCompletedInvokeActionEvent(diags ...*tfplugin5.Diagnostic) *tfplugin5.InvokeAction_Event {
+	return &tfplugin5.InvokeAction_Event{
+		Type: &tfplugin5.InvokeAction_Event_Completed_{
+			Completed: &tfplugin5.InvokeAction_Event_Completed{
+				Diagnostics: diags,
+			},
+		},
+	}
+}
+
+func TestValidateActionConfig(t *testing.T) {
+	t.Parallel()
+
+	server := tf5server.New("registry.terraform.io/hashicorp/test", testActionProviderServer{})
+
+	got, err := server.ValidateActionConfig(context.Background(), &tfplugin5.ValidateActionConfig_Request{
+		ActionType: "test_action",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfplugin5.ValidateActionConfig_Response{
+		Diagnostics: []*tfplugin5.Diagnostic{
+			{
+				Severity: tfplugin5.Diagnostic_WARNING,
+				Summary:  "test_action",
+			},
+		},
+	}
+
+	if !proto.Equal(got, expected) {
+		t.Errorf("expected response %s, got %s", expected, got)
+	}
+}
+
+func TestInvokeAction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		downstream testActionProviderServer
+		expected   []*tfplugin5.InvokeAction_Event
+	}{
+		"events": {
+			downstream: testActionProviderServer{
+				events: testInvokeActionEvents(
+					tfprotov5.InvokeActionEvent{
+						Type: tfprotov5.ProgressInvokeActionEventType{
+							Message: "test progress 1",
+						},
+					},
+					tfprotov5.InvokeActionEvent{
+						Type: tfprotov5.ProgressInvokeActionEventType{
+							Message: "test progress 2",
+						},
+					},
+					tfprotov5.InvokeActionEvent{
+						Type: tfprotov5.CompletedInvokeActionEventType{
+							Diagnostics: []*tfprotov5.Diagnostic{
+								{
+									Severity: tfprotov5.DiagnosticSeverityWarning,
+									Summary:  "test summary",
+								},
+							},
+						},
+					},
+				),
+			},
+			expected: []*tfplugin5.InvokeAction_Event{
+				testProgressInvokeActionEvent("test progress 1"),
+				testProgressInvokeActionEvent("test progress 2"),
+				testCompletedInvokeActionEvent(&tfplugin5.Diagnostic{
+					Severity: tfplugin5.Diagnostic_WARNING,
+					Summary:  "test summary",
+				}),
+			},
+		},
+		"events-after-completed": {
+			downstream: testActionProviderServer{
+				events: testInvokeActionEvents(
+					tfprotov5.InvokeActionEvent{
+						Type: tfprotov5.CompletedInvokeActionEventType{},
+					},
+					tfprotov5.InvokeActionEvent{
+						Type: tfprotov5.ProgressInvokeActionEventType{
+							Message: "test progress",
+						},
+					},
+				),
+			},
+			expected: []*tfplugin5.InvokeAction_Event{
+				testCompletedInvokeActionEvent(),
+			},
+		},
+		"missing-completed": {
+			downstream: testActionProviderServer{
+				events: testInvokeActionEvents(
+					tfprotov5.InvokeActionEvent{
+						Type: tfprotov5.ProgressInvokeActionEventType{
+							Message: "test progress",
+						},
+					},
+				),
+			},
+			expected: []*tfplugin5.InvokeAction_Event{
+				testProgressInvokeActionEvent("test progress"),
+				testCompletedInvokeActionEvent(),
+			},
+		},
+		"nil-events": {
+			downstream: testActionProviderServer{},
+			expected: []*tfplugin5.InvokeAction_Event{
+				testCompletedInvokeActionEvent(),
+			},
+		},
+		"unknown-event-type": {
+			downstream: testActionProviderServer{
+				events: testInvokeActionEvents(
+					tfprotov5.InvokeActionEvent{},
+					tfprotov5.InvokeActionEvent{
+						Type: tfprotov5.CompletedInvokeActionEventType{},
+					},
+				),
+			},
+			expected: []*tfplugin5.InvokeAction_Event{
+				testCompletedInvokeActionEvent(),
+			},
+		},
+		"downstream-joined-errors": {
+			downstream: testActionProviderServer{
+				err: errors.Join(errors.New("test error 1"), errors.New("test error 2")),
+			},
+			expected: []*tfplugin5.InvokeAction_Event{
+				testCompletedInvokeActionEvent(
+					&tfplugin5.Diagnostic{
+						Severity: tfplugin5.Diagnostic_ERROR,
+						Summary:  "Provider Error",
+						Detail:   "test error 1",
+					},
+					&tfplugin5.Diagnostic{
+						Severity: tfplugin5.Diagnostic_ERROR,
+						Summary:  "Provider Error",
+						Detail:   "test error 2",
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tf5server.New("registry.terraform.io/hashicorp/test", testCase.downstream)
+			stream := &testInvokeActionStream{}
+
+			err := server.InvokeAction(&tfplugin5.InvokeAction_Request{
+				ActionType: "test_action",
+			}, stream)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(stream.events, testCase.expected, cmpopts.EquateEmpty(), protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInvokeActionErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		downstream testActionProviderServer
+		sendErr    error
+		expected   string
+	}{
+		"downstream-error": {
+			downstream: testActionProviderServer{
+				err: errors.New("test error"),
+			},
+			expected: "test error",
+		},
+		"send-error": {
+			downstream: testActionProviderServer{
+				events: testInvokeActionEvents(
+					tfprotov5.InvokeActionEvent{
+						Type: tfprotov5.ProgressInvokeActionEventType{
+							Message: "test progress",
+						},
+					},
+				),
+			},
+			sendErr:  errors.New("test send error"),
+			expected: "test send error",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tf5server.New("registry.terraform.io/hashicorp/test", testCase.downstream)
+			stream := &testInvokeActionStream{
+				sendErr: testCase.sendErr,
+			}
+
+			err := server.InvokeAction(&tfplugin5.InvokeAction_Request{
+				ActionType: "test_action",
+			}, stream)
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expected)
+			}
+
+			if err.Error() != testCase.expected {
+				t.Errorf("expected error %q, got %q", testCase.expected, err)
+			}
+		})
+	}
+}
+
+func TestActionNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	server := tf5server.New("registry.terraform.io/hashicorp/test", testContextProviderServer{})
+
+	responses := map[string]func() ([]*tfplugin5.Diagnostic, error){
+		"ValidateActionConfig": func() ([]*tfplugin5.Diagnostic, error) {
+			resp, err := server.ValidateActionConfig(context.Background(), &tfplugin5.ValidateActionConfig_Request{})
+			return resp.GetDiagnostics(), err
+		},
+		"InvokeAction": func() ([]*tfplugin5.Diagnostic, error) {
+			stream := &testInvokeActionStream{}
+			err := server.InvokeAction(&tfplugin5.InvokeAction_Request{}, stream)
+
+			if len(stream.events) != 1 {
+				return nil, err
+			}
+
+			return stream.events[0].GetCompleted().GetDiagnostics(), err
+		},
+	}
+
+	for rpc, f := range responses {
+		rpc, f := rpc, f
+
+		t.Run(rpc, func(t *testing.T) {
+			t.Parallel()
+
+			diags, err := f()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+			}
+
+			if diags[0].Severity != tfplugin5.Diagnostic_ERROR || diags[0].Summary != "Provider Action Not Implemented" {
+				t.Errorf("unexpected diagnostic: %s", diags[0])
+			}
+		})
+	}
+}
+
+func TestInvokeActionReplay(t *testing.T) {
+	t.Parallel()
+
+	var recording bytes.Buffer
+
+	server := tf5server.New("registry.terraform.io/hashicorp/test", testActionProviderServer{
+		events: testInvokeActionEvents(
+			tfprotov5.InvokeActionEvent{
+				Type: tfprotov5.ProgressInvokeActionEventType{
+					Message: "test progress",
+				},
+			},
+			tfprotov5.InvokeActionEvent{
+				Type: tfprotov5.CompletedInvokeActionEventType{},
+			},
+		),
+	}, tf5server.WithRecording(&recording))
+
+	err := server.InvokeAction(&tfplugin5.InvokeAction_Request{
+		ActionType: "test_action",
+	}, &testInvokeActionStream{})
+
+	if err != nil {
+		t.Fatalf("unexpected error recording: %s", err)
+	}
+
+	replayServer, err := tf5server.NewReplayProviderServer(&recording)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := replayServer.(tfprotov5.ProviderServerWithActions).InvokeAction(context.Background(), &tfprotov5.InvokeActionRequest{
+		ActionType: "test_action",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error replaying: %s", err)
+	}
+
+	var got []tfprotov5.InvokeActionEvent
+
+	resp.Events(func(event tfprotov5.InvokeActionEvent) bool {
+		got = append(got, event)
+
+		return true
+	})
+
+	expected := []tfprotov5.InvokeActionEvent{
+		{
+			Type: tfprotov5.ProgressInvokeActionEventType{
+				Message: "test progress",
+			},
+		},
+		{
+			Type: tfprotov5.CompletedInvokeActionEventType{},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/internal/logging"
+)
+
+// ActionTypeFromContext returns the action type of the request being
+// served, such as examplecloud_restart, and whether it was found. It is only
+// found for action RPCs.
+func ActionTypeFromContext(ctx context.Context) (string, bool) {
+	return logging.StringFromContext(ctx, logging.ContextKeyActionType{})
+}
+
+// DataSourceTypeFromContext returns the data source type of the request
+// being served, such as examplecloud_thing, and whether it was found. It is
+// only found for data source RPCs.
+func DataSourceTypeFromContext(ctx context.Context) (string, bool) {
+	return logging.StringFromContext(ctx, logging.ContextKeyDataSourceType{})
+}
+
+// EphemeralResourceTypeFromContext returns the ephemeral resource type of
+// the request being served, such as examplecloud_thing, and whether it was
+// found. It is only found for ephemeral resource RPCs.
+func EphemeralResourceTypeFromContext(ctx context.Context) (string, bool) {
+	return logging.StringFromContext(ctx, logging.ContextKeyEphemeralResourceType{})
+}
+
+// ProviderAddressFromContext returns the provider address passed to the
+// server, such as registry.terraform.io/hashicorp/example, and whether it
+// was found.
+func ProviderAddressFromContext(ctx context.Context) (string, bool) {
+	return logging.StringFromContext(ctx, logging.ContextKeyProviderAddress{})
+}
+
+// RequestIDFromContext returns the unique identifier the server assigned to
+// the request being served, and whether it was found. This is the same value
+// as the tf_req_id field in logs.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	return logging.StringFromContext(ctx, logging.ContextKeyRequestID{})
+}
+
+// ResourceTypeFromContext returns the managed resource type of the request
+// being served, such as examplecloud_thing, and whether it was found. It is
+// only found for managed resource RPCs. For MoveResourceState, it is the
+// target resource type.
+func ResourceTypeFromContext(ctx context.Context) (string, bool) {
+	return logging.StringFromContext(ctx, logging.ContextKeyResourceType{})
+}
+
+// RPCFromContext returns the name of the protocol RPC being served, such as
+// ReadResource, and whether it was found.
+func RPCFromContext(ctx context.Context) (string, bool) {
+	return logging.StringFromContext(ctx, logging.ContextKeyRPC{})
+}
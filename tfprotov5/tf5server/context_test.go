@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+)
+
+type testContextProviderServer struct {
+	tfprotov5.ProviderServer
+
+	got map[string]string
+}
+
+func (s testContextProviderServer) ReadResource(ctx context.Context, _ *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	for name, f := range map[string]func(context.Context) (string, bool){
+		"DataSourceType":  tf5server.DataSourceTypeFromContext,
+		"ProviderAddress": tf5server.ProviderAddressFromContext,
+		"RequestID":       tf5server.RequestIDFromContext,
+		"ResourceType":    tf5server.ResourceTypeFromContext,
+		"RPC":             tf5server.RPCFromContext,
+	} {
+		if value, ok := f(ctx); ok {
+			s.got[name] = value
+		}
+	}
+
+	return &tfprotov5.ReadResourceResponse{}, nil
+}
+
+func TestContextAccessors(t *testing.T) {
+	t.Parallel()
+
+	downstream := testContextProviderServer{
+		got: map[string]string{},
+	}
+
+	server := tf5server.New("registry.terraform.io/hashicorp/test", downstream)
+
+	_, err := server.ReadResource(context.Background(), &tfplugin5.ReadResource_Request{
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if downstream.got["RequestID"] == "" {
+		t.Errorf("expected request ID, got none")
+	}
+
+	delete(downstream.got, "RequestID")
+
+	expected := map[string]string{
+		"ProviderAddress": "registry.terraform.io/hashicorp/test",
+		"ResourceType":    "test_resource",
+		"RPC":             "ReadResource",
+	}
+
+	if diff := cmp.Diff(downstream.got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if _, ok := tf5server.RPCFromContext(context.Background()); ok {
+		t.Error("expected RPC to not be found in background context")
+	}
+}
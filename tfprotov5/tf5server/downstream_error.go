@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server
+
+import (
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+const (
+	// downstreamErrorSummary is the summary of diagnostics created from
+	// joined downstream errors.
+	downstreamErrorSummary = "Provider Error"
+
+	// downstreamErrorResourceNotFoundSummary is the summary of diagnostics
+	// created from downstream errors wrapping ErrResourceNotFound.
+	downstreamErrorResourceNotFoundSummary = "Resource Not Found"
+)
+
+// downstreamErrorDiagnostics returns an error diagnostic for each underlying
+// error of an error created with errors.Join, so practitioners see each
+// error separately rather than a single gRPC error. Nested joined errors are
+// flattened. Errors wrapping tfprotov5.ErrResourceNotFound are also returned
+// as a diagnostic. It returns nil for any other error, which should be
+// returned to Terraform via downstreamErrorStatus.
+//
+// Errors which implement Unwrap() []error but have their own message, such as
+// those created by fmt.Errorf with multiple %w verbs, are not split to
+// preserve the message context.
+func downstreamErrorDiagnostics(err error) []*tfprotov5.Diagnostic {
+	errs := joinedErrors(err)
+
+	if errs == nil {
+		if !errors.Is(err, tfprotov5.ErrResourceNotFound) {
+			return nil
+		}
+
+		errs = []error{err}
+	}
+
+	diags := make([]*tfprotov5.Diagnostic, 0, len(errs))
+
+	for _, err := range errs {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  downstreamErrorDiagnosticSummary(err),
+			Detail:   err.Error(),
+		})
+	}
+
+	return diags
+}
+
+// downstreamErrorDiagnosticSummary returns the summary of the diagnostic
+// created from a downstream error.
+func downstreamErrorDiagnosticSummary(err error) string {
+	if errors.Is(err, tfprotov5.ErrResourceNotFound) {
+		return downstreamErrorResourceNotFoundSummary
+	}
+
+	return downstreamErrorSummary
+}
+
+// downstreamErrorResourceNotFound returns true if the downstream error wraps
+// tfprotov5.ErrResourceNotFound and is not a joined error, in which case
+// ReadResource returns a null NewState so Terraform removes the resource
+// from state.
+func downstreamErrorResourceNotFound(err error) bool {
+	return errors.Is(err, tfprotov5.ErrResourceNotFound) && joinedErrors(err) == nil
+}
+
+// downstreamErrorStatus returns the error to return to Terraform for a
+// downstream error which was not converted into diagnostics. Errors wrapping
+// tfprotov5.ErrUnsupportedRPC are converted into a gRPC Unimplemented status.
+// Any other error is returned as-is.
+func downstreamErrorStatus(err error) error {
+	if errors.Is(err, tfprotov5.ErrUnsupportedRPC) {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	return err
+}
+
+// joinedErrors returns the flattened underlying errors of an error created
+// with errors.Join, or nil if err is not such an error.
+func joinedErrors(err error) []error {
+	joined, ok := err.(interface{ Unwrap() []error })
+
+	if !ok {
+		return nil
+	}
+
+	unwrapped := joined.Unwrap()
+	messages := make([]string, 0, len(unwrapped))
+
+	for _, e := range unwrapped {
+		messages = append(messages, e.Error())
+	}
+
+	// errors.Join messages are the underlying error messages separated by
+	// newlines, which differentiates them from other multiple error types.
+	if err.Error() != strings.Join(messages, "\n") {
+		return nil
+	}
+
+	var result []error
+
+	for _, e := range unwrapped {
+		if nested := joinedErrors(e); nested != nil {
+			result = append(result, nested...)
+
+			continue
+		}
+
+		result = append(result, e)
+	}
+
+	return result
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/toproto"
+)
+
+// EncodeGetProviderSchemaResponse returns the protocol buffers encoding of a
+// GetProviderSchemaResponse, for use with
+// tfprotov5.ProviderServerWithEncodedProviderSchema implementations. The
+// encoding is deterministic, so it can be generated at build time and
+// checked into version control.
+func EncodeGetProviderSchemaResponse(resp *tfprotov5.GetProviderSchemaResponse) ([]byte, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("GetProviderSchemaResponse cannot be nil")
+	}
+
+	return proto.MarshalOptions{Deterministic: true}.Marshal(toproto.GetProviderSchema_Response(resp))
+}
+
+// encodedProviderSchema returns the decoded GetProviderSchema response of a
+// downstream server implementing
+// tfprotov5.ProviderServerWithEncodedProviderSchema.
+func encodedProviderSchema(ctx context.Context, downstream tfprotov5.ProviderServerWithEncodedProviderSchema, req *tfprotov5.GetProviderSchemaRequest) (*tfplugin5.GetProviderSchema_Response, error) {
+	data, err := downstream.EncodedProviderSchema(ctx, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	protoResp := &tfplugin5.GetProviderSchema_Response{}
+
+	if err := proto.Unmarshal(data, protoResp); err != nil {
+		return nil, fmt.Errorf("error decoding encoded provider schema: %w", err)
+	}
+
+	return protoResp, nil
+}
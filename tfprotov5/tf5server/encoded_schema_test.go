@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testEncodedSchemaProviderServer struct {
+	tfprotov5.ProviderServer
+
+	encoded []byte
+}
+
+func (s testEncodedSchemaProviderServer) EncodedProviderSchema(_ context.Context, _ *tfprotov5.GetProviderSchemaRequest) ([]byte, error) {
+	return s.encoded, nil
+}
+
+func TestEncodedProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	encoded, err := tf5server.EncodeGetProviderSchemaResponse(&tfprotov5.GetProviderSchemaResponse{
+		ResourceSchemas: map[string]*tfprotov5.Schema{
+			"test_resource": {
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "id",
+							Type:     tftypes.String,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ServerCapabilities: &tfprotov5.ServerCapabilities{
+			PlanDestroy: true,
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error encoding: %s", err)
+	}
+
+	// The downstream GetProviderSchema is never called, so the embedded nil
+	// ProviderServer would panic if it were.
+	server := tf5server.New("registry.terraform.io/hashicorp/test", testEncodedSchemaProviderServer{encoded: encoded})
+
+	got, err := server.GetSchema(context.Background(), &tfplugin5.GetProviderSchema_Request{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfplugin5.GetProviderSchema_Response{
+		DataSourceSchemas: map[string]*tfplugin5.Schema{},
+		Functions:         map[string]*tfplugin5.Function{},
+		ResourceSchemas: map[string]*tfplugin5.Schema{
+			"test_resource": {
+				Block: &tfplugin5.Schema_Block{
+					Attributes: []*tfplugin5.Schema_Attribute{
+						{
+							Name:     "id",
+							Type:     []byte(`"string"`),
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ServerCapabilities: &tfplugin5.ServerCapabilities{
+			PlanDestroy: true,
+		},
+	}
+
+	if !proto.Equal(got, expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestEncodedProviderSchema_Invalid(t *testing.T) {
+	t.Parallel()
+
+	server := tf5server.New("registry.terraform.io/hashicorp/test", testEncodedSchemaProviderServer{encoded: []byte("invalid")})
+
+	_, err := server.GetSchema(context.Background(), &tfplugin5.GetProviderSchema_Request{})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testFunctionProviderServer struct {
+	tfprotov5.ProviderServer
+
+	callFunction func(*tfprotov5.CallFunctionRequest) *tfprotov5.CallFunctionResponse
+	functions    map[string]*tfprotov5.Function
+}
+
+func (s testFunctionProviderServer) CallFunction(_ context.Context, req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	return s.callFunction(req), nil
+}
+
+func (s testFunctionProviderServer) GetFunctions(_ context.Context, _ *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	return &tfprotov5.GetFunctionsResponse{
+		Functions: s.functions,
+	}, nil
+}
+
+func TestCallFunction(t *testing.T) {
+	t.Parallel()
+
+	argument, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, "test"))
+
+	if err != nil {
+		t.Fatalf("unexpected error creating argument: %s", err)
+	}
+
+	testCases := map[string]struct {
+		resp     *tfprotov5.CallFunctionResponse
+		expected *tfplugin5.CallFunction_Response
+	}{
+		"result": {
+			resp: &tfprotov5.CallFunctionResponse{
+				Result: &argument,
+			},
+			expected: &tfplugin5.CallFunction_Response{
+				Result: &tfplugin5.DynamicValue{
+					Msgpack: argument.MsgPack,
+				},
+			},
+		},
+		"error": {
+			resp: &tfprotov5.CallFunctionResponse{
+				Error: &tfprotov5.FunctionError{
+					Text:             "test error",
+					FunctionArgument: proto.Int64(0),
+				},
+			},
+			expected: &tfplugin5.CallFunction_Response{
+				Error: &tfplugin5.FunctionError{
+					Text:             "test error",
+					FunctionArgument: proto.Int64(0),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got *tfprotov5.CallFunctionRequest
+
+			server := tf5server.New("registry.terraform.io/hashicorp/test", testFunctionProviderServer{
+				callFunction: func(req *tfprotov5.CallFunctionRequest) *tfprotov5.CallFunctionResponse {
+					got = req
+
+					return testCase.resp
+				},
+			})
+
+			resp, err := server.CallFunction(context.Background(), &tfplugin5.CallFunction_Request{
+				Name: "test_function",
+				Arguments: []*tfplugin5.DynamicValue{
+					{
+						Msgpack: argument.MsgPack,
+					},
+				},
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.Name != "test_function" {
+				t.Errorf("expected downstream function name %q, got %q", "test_function", got.Name)
+			}
+
+			if len(got.Arguments) != 1 {
+				t.Fatalf("expected 1 downstream argument, got %d", len(got.Arguments))
+			}
+
+			gotArgument, err := got.Arguments[0].Unmarshal(tftypes.String)
+
+			if err != nil {
+				t.Fatalf("unexpected error unmarshaling argument: %s", err)
+			}
+
+			if !gotArgument.Equal(tftypes.NewValue(tftypes.String, "test")) {
+				t.Errorf("unexpected downstream argument: %s", gotArgument)
+			}
+
+			if !proto.Equal(resp, testCase.expected) {
+				t.Errorf("expected response %s, got %s", testCase.expected, resp)
+			}
+		})
+	}
+}
+
+func TestGetFunctions(t *testing.T) {
+	t.Parallel()
+
+	server := tf5server.New("registry.terraform.io/hashicorp/test", testFunctionProviderServer{
+		functions: map[string]*tfprotov5.Function{
+			"test_function": {
+				Parameters: []*tfprotov5.FunctionParameter{
+					{
+						Name: "input",
+						Type: tftypes.String,
+					},
+				},
+				Return: &tfprotov5.FunctionReturn{
+					Type: tftypes.Bool,
+				},
+				Summary: "test summary",
+			},
+		},
+	})
+
+	got, err := server.GetFunctions(context.Background(), &tfplugin5.GetFunctions_Request{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfplugin5.GetFunctions_Response{
+		Diagnostics: []*tfplugin5.Diagnostic{},
+		Functions: map[string]*tfplugin5.Function{
+			"test_function": {
+				Parameters: []*tfplugin5.Function_Parameter{
+					{
+						Name: "input",
+						Type: []byte(`"string"`),
+					},
+				},
+				Return: &tfplugin5.Function_Return{
+					Type: []byte(`"bool"`),
+				},
+				Summary: "test summary",
+			},
+		},
+	}
+
+	if !proto.Equal(got, expected) {
+		t.Errorf("expected response %s, got %s", expected, got)
+	}
+}
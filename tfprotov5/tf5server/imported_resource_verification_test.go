@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testImportedResourceType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"id": tftypes.String,
+	},
+}
+
+type testImportedResourceProviderServer struct {
+	tfprotov5.ProviderServer
+
+	// schemaCalls, if set, is incremented for each GetProviderSchema call.
+	schemaCalls *atomic.Int64
+
+	schemaDiagnostics []*tfprotov5.Diagnostic
+	readResp          *tfprotov5.ReadResourceResponse
+	readRespNil       bool
+}
+
+func (s testImportedResourceProviderServer) GetProviderSchema(_ context.Context, _ *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	if s.schemaCalls != nil {
+		s.schemaCalls.Add(1)
+	}
+
+	return &tfprotov5.GetProviderSchemaResponse{
+		Diagnostics: s.schemaDiagnostics,
+		ResourceSchemas: map[string]*tfprotov5.Schema{
+			"test_resource": {
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "id",
+							Type:     tftypes.String,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (s testImportedResourceProviderServer) ImportResourceState(_ context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	state, err := tfprotov5.NewDynamicValue(testImportedResourceType, tftypes.NewValue(testImportedResourceType, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, req.ID),
+	}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov5.ImportResourceStateResponse{
+		ImportedResources: []*tfprotov5.ImportedResource{
+			{
+				TypeName: req.TypeName,
+				State:    &state,
+			},
+		},
+	}, nil
+}
+
+func (s testImportedResourceProviderServer) ReadResource(_ context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	if s.readRespNil {
+		return nil, nil
+	}
+
+	if s.readResp != nil {
+		return s.readResp, nil
+	}
+
+	return &tfprotov5.ReadResourceResponse{
+		NewState: req.CurrentState,
+	}, nil
+}
+
+func TestWithImportedResourceVerification(t *testing.T) {
+	t.Parallel()
+
+	nullState, err := tfprotov5.NewDynamicValue(testImportedResourceType, tftypes.NewValue(testImportedResourceType, nil))
+
+	if err != nil {
+		t.Fatalf("unexpected error creating null state: %s", err)
+	}
+
+	testCases := map[string]struct {
+		schemaDiagnostics []*tfprotov5.Diagnostic
+		readResp          *tfprotov5.ReadResourceResponse
+		readRespNil       bool
+		expected          []*tfplugin5.Diagnostic
+	}{
+		"valid": {},
+		"schema-error-diagnostic": {
+			schemaDiagnostics: []*tfprotov5.Diagnostic{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+			},
+			expected: []*tfplugin5.Diagnostic{
+				{
+					Severity: tfplugin5.Diagnostic_ERROR,
+					Summary:  "Imported Resource Verification Failed",
+					Detail: "The provider returned an imported test_resource resource which could not be verified. " +
+						"This is an issue with the provider and should be reported to the provider developers.\n\n" +
+						"Retrieving the provider schema returned an error diagnostic: test summary: test detail",
+				},
+			},
+		},
+		"schema-warning-diagnostic": {
+			schemaDiagnostics: []*tfprotov5.Diagnostic{
+				{
+					Severity: tfprotov5.DiagnosticSeverityWarning,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+			},
+		},
+		"read-nil-response": {
+			readRespNil: true,
+			expected: []*tfplugin5.Diagnostic{
+				{
+					Severity: tfplugin5.Diagnostic_ERROR,
+					Summary:  "Imported Resource Verification Failed",
+					Detail: "The provider returned an imported test_resource resource which could not be verified. " +
+						"This is an issue with the provider and should be reported to the provider developers.\n\n" +
+						"Reading the imported resource returned no response.",
+				},
+			},
+		},
+		"read-error-diagnostic": {
+			readResp: &tfprotov5.ReadResourceResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "test summary",
+						Detail:   "test detail",
+					},
+				},
+			},
+			expected: []*tfplugin5.Diagnostic{
+				{
+					Severity: tfplugin5.Diagnostic_ERROR,
+					Summary:  "Imported Resource Verification Failed",
+					Detail: "The provider returned an imported test_resource resource which could not be verified. " +
+						"This is an issue with the provider and should be reported to the provider developers.\n\n" +
+						"Reading the imported resource returned an error diagnostic: test summary: test detail",
+				},
+			},
+		},
+		"missing-state": {
+			readResp: &tfprotov5.ReadResourceResponse{},
+			expected: []*tfplugin5.Diagnostic{
+				{
+					Severity: tfplugin5.Diagnostic_ERROR,
+					Summary:  "Imported Resource Verification Failed",
+					Detail: "The provider returned an imported test_resource resource which could not be verified. " +
+						"This is an issue with the provider and should be reported to the provider developers.\n\n" +
+						"Reading the imported resource returned no state.",
+				},
+			},
+		},
+		"null-state": {
+			readResp: &tfprotov5.ReadResourceResponse{
+				NewState: &nullState,
+			},
+			expected: []*tfplugin5.Diagnostic{
+				{
+					Severity: tfplugin5.Diagnostic_ERROR,
+					Summary:  "Imported Resource Verification Failed",
+					Detail: "The provider returned an imported test_resource resource which could not be verified. " +
+						"This is an issue with the provider and should be reported to the provider developers.\n\n" +
+						"Reading the imported resource returned a null state, which indicates the resource does not exist.",
+				},
+			},
+		},
+		"incorrect-type": {
+			readResp: &tfprotov5.ReadResourceResponse{
+				NewState: &tfprotov5.DynamicValue{
+					JSON: []byte(`{"id":"test-id","extra":true}`),
+				},
+			},
+			expected: []*tfplugin5.Diagnostic{
+				{
+					Severity: tfplugin5.Diagnostic_ERROR,
+					Summary:  "Imported Resource Verification Failed",
+					Detail: "The provider returned an imported test_resource resource which could not be verified. " +
+						"This is an issue with the provider and should be reported to the provider developers.\n\n" +
+						`Reading the imported resource returned a state which does not match the resource schema: AttributeName("extra"): unsupported attribute "extra"`,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tf5server.New(
+				"registry.terraform.io/hashicorp/test",
+				testImportedResourceProviderServer{
+					schemaDiagnostics: testCase.schemaDiagnostics,
+					readResp:          testCase.readResp,
+					readRespNil:       testCase.readRespNil,
+				},
+				tf5server.WithImportedResourceVerification(),
+			)
+
+			resp, err := server.ImportResourceState(context.Background(), &tfplugin5.ImportResourceState_Request{
+				TypeName: "test_resource",
+				Id:       "test-id",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected, cmpopts.EquateEmpty(), protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWithImportedResourceVerification_SchemaCache(t *testing.T) {
+	t.Parallel()
+
+	var schemaCalls atomic.Int64
+
+	server := tf5server.New(
+		"registry.terraform.io/hashicorp/test",
+		testImportedResourceProviderServer{schemaCalls: &schemaCalls},
+		tf5server.WithImportedResourceVerification(),
+	)
+
+	for i := 0; i < 3; i++ {
+		resp, err := server.ImportResourceState(context.Background(), &tfplugin5.ImportResourceState_Request{
+			TypeName: "test_resource",
+			Id:       "test-id",
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(resp.Diagnostics) > 0 {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+	}
+
+	if got := schemaCalls.Load(); got != 1 {
+		t.Errorf("expected 1 GetProviderSchema call, got %d", got)
+	}
+}
+
+type testEncodedImportedResourceProviderServer struct {
+	testImportedResourceProviderServer
+}
+
+func (s testEncodedImportedResourceProviderServer) GetProviderSchema(_ context.Context, _ *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return nil, errors.New("unexpected GetProviderSchema call")
+}
+
+func (s testEncodedImportedResourceProviderServer) EncodedProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) ([]byte, error) {
+	resp, err := s.testImportedResourceProviderServer.GetProviderSchema(ctx, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tf5server.EncodeGetProviderSchemaResponse(resp)
+}
+
+func TestWithImportedResourceVerification_EncodedProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	server := tf5server.New(
+		"registry.terraform.io/hashicorp/test",
+		testEncodedImportedResourceProviderServer{},
+		tf5server.WithImportedResourceVerification(),
+	)
+
+	resp, err := server.ImportResourceState(context.Background(), &tfplugin5.ImportResourceState_Request{
+		TypeName: "test_resource",
+		Id:       "test-id",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, []*tfplugin5.Diagnostic(nil), cmpopts.EquateEmpty(), protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
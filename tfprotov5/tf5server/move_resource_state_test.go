@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testMoveResourceStateProviderServer struct {
+	tfprotov5.ProviderServer
+
+	moveResourceState func(context.Context, *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error)
+}
+
+func (s testMoveResourceStateProviderServer) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	return s.moveResourceState(ctx, req)
+}
+
+func TestMoveResourceState(t *testing.T) {
+	t.Parallel()
+
+	targetState, err := tfprotov5.NewDynamicValue(
+		tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}},
+		tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "test"),
+		}),
+	)
+
+	if err != nil {
+		t.Fatalf("unexpected error creating target state: %s", err)
+	}
+
+	var gotReq *tfprotov5.MoveResourceStateRequest
+	var gotResourceType string
+
+	server := tf5server.New("registry.terraform.io/hashicorp/test", testMoveResourceStateProviderServer{
+		moveResourceState: func(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+			gotReq = req
+			gotResourceType, _ = tf5server.ResourceTypeFromContext(ctx)
+
+			return &tfprotov5.MoveResourceStateResponse{
+				TargetPrivate: []byte(`{}`),
+				TargetState:   &targetState,
+			}, nil
+		},
+	})
+
+	got, err := server.MoveResourceState(context.Background(), &tfplugin5.MoveResourceState_Request{
+		SourcePrivate:         []byte(`{"source":true}`),
+		SourceProviderAddress: "registry.terraform.io/hashicorp/other",
+		SourceSchemaVersion:   2,
+		SourceState: &tfplugin5.RawState{
+			Json: []byte(`{"id":"test"}`),
+		},
+		SourceTypeName: "other_resource",
+		TargetTypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedReq := &tfprotov5.MoveResourceStateRequest{
+		SourcePrivate:         []byte(`{"source":true}`),
+		SourceProviderAddress: "registry.terraform.io/hashicorp/other",
+		SourceSchemaVersion:   2,
+		SourceState: &tfprotov5.RawState{
+			JSON: []byte(`{"id":"test"}`),
+		},
+		SourceTypeName: "other_resource",
+		TargetTypeName: "test_resource",
+	}
+
+	if diff := cmp.Diff(gotReq, expectedReq); diff != "" {
+		t.Errorf("unexpected downstream request difference: %s", diff)
+	}
+
+	if gotResourceType != "test_resource" {
+		t.Errorf("expected resource type %q in context, got %q", "test_resource", gotResourceType)
+	}
+
+	expected := &tfplugin5.MoveResourceState_Response{
+		Diagnostics:   []*tfplugin5.Diagnostic{},
+		TargetPrivate: []byte(`{}`),
+		TargetState: &tfplugin5.DynamicValue{
+			Msgpack: targetState.MsgPack,
+		},
+	}
+
+	if !proto.Equal(got, expected) {
+		t.Errorf("expected response %s, got %s", expected, got)
+	}
+}
+
+func TestMoveResourceStateJoinedErrors(t *testing.T) {
+	t.Parallel()
+
+	server := tf5server.New("registry.terraform.io/hashicorp/test", testMoveResourceStateProviderServer{
+		moveResourceState: func(_ context.Context, _ *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+			return nil, errors.Join(errors.New("first error"), errors.New("second error"))
+		},
+	})
+
+	got, err := server.MoveResourceState(context.Background(), &tfplugin5.MoveResourceState_Request{
+		TargetTypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfplugin5.MoveResourceState_Response{
+		Diagnostics: []*tfplugin5.Diagnostic{
+			{
+				Severity: tfplugin5.Diagnostic_ERROR,
+				Summary:  "Provider Error",
+				Detail:   "first error",
+			},
+			{
+				Severity: tfplugin5.Diagnostic_ERROR,
+				Summary:  "Provider Error",
+				Detail:   "second error",
+			},
+		},
+	}
+
+	if !proto.Equal(got, expected) {
+		t.Errorf("expected response %s, got %s", expected, got)
+	}
+}
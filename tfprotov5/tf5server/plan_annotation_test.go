@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testPlanAnnotationProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s testPlanAnnotationProviderServer) PlanResourceChange(_ context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	return &tfprotov5.PlanResourceChangeResponse{
+		PlannedState: req.ProposedNewState,
+		Annotations: []*tfprotov5.PlanAnnotation{
+			{
+				Kind:      tfprotov5.PlanAnnotationKindUnknown,
+				Attribute: tftypes.NewAttributePath().WithAttributeName("endpoint"),
+				Reason:    "The endpoint is assigned after the instance is created.",
+			},
+			nil,
+			{
+				Kind:      tfprotov5.PlanAnnotationKindRequiresReplace,
+				Attribute: tftypes.NewAttributePath().WithAttributeName("region"),
+				Reason:    "The region of an instance cannot be updated.",
+			},
+		},
+	}, nil
+}
+
+func TestWithPlanAnnotationDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts     []tf5server.ServeOpt
+		expected []*tfplugin5.Diagnostic
+	}{
+		"disabled": {},
+		"enabled": {
+			opts: []tf5server.ServeOpt{
+				tf5server.WithPlanAnnotationDiagnostics(),
+			},
+			expected: []*tfplugin5.Diagnostic{
+				{
+					Severity: tfplugin5.Diagnostic_WARNING,
+					Summary:  "Attribute Value Unknown Until Apply",
+					Detail:   "The endpoint is assigned after the instance is created.",
+					Attribute: &tfplugin5.AttributePath{
+						Steps: []*tfplugin5.AttributePath_Step{
+							{
+								Selector: &tfplugin5.AttributePath_Step_AttributeName{
+									AttributeName: "endpoint",
+								},
+							},
+						},
+					},
+				},
+				{
+					Severity: tfplugin5.Diagnostic_WARNING,
+					Summary:  "Attribute Change Requires Replacement",
+					Detail:   "The region of an instance cannot be updated.",
+					Attribute: &tfplugin5.AttributePath{
+						Steps: []*tfplugin5.AttributePath_Step{
+							{
+								Selector: &tfplugin5.AttributePath_Step_AttributeName{
+									AttributeName: "region",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tf5server.New(
+				"registry.terraform.io/hashicorp/test",
+				testPlanAnnotationProviderServer{},
+				testCase.opts...,
+			)
+
+			resp, err := server.PlanResourceChange(context.Background(), &tfplugin5.PlanResourceChange_Request{
+				TypeName: "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected, cmpopts.EquateEmpty(), protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"testing"
 )
 
-// MAINTAINER NOTE: This test is a best effort for ensuring that the protocol version variables in the tf5server package
+// MAINTAINER NOTE: This test is a best effort for ensuring that the protocol version constants in the tfprotov5 package
 // stay in sync with the actual protocol file.
 func Test_EnsureVersionConstantMatchesProtoFile(t *testing.T) {
 	t.Parallel()
@@ -45,6 +45,6 @@ func Test_EnsureVersionConstantMatchesProtoFile(t *testing.T) {
 
 	if protocolVersion != expectedProtocolVersion {
 		t.Errorf("protocol version Go variable is different from proto file - expected: %s, got: %s\n", expectedProtocolVersion, protocolVersion)
-		t.Log("MAINTAINER NOTE: Update tf5server.protocolVersionMajor and tf5server.protocolVersionMinor to match the proto file.")
+		t.Log("MAINTAINER NOTE: Update tfprotov5.ProtocolVersionMajor and tfprotov5.ProtocolVersionMinor to match the proto file.")
 	}
 }
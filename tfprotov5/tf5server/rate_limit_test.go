@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+)
+
+// testAdvancingClock is a clock which advances when waited on.
+type testAdvancingClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testAdvancingClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testAdvancingClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+
+	return ch
+}
+
+type testRateLimitProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s testRateLimitProviderServer) ReadResource(_ context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	return &tfprotov5.ReadResourceResponse{
+		NewState: req.CurrentState,
+	}, nil
+}
+
+func TestWithRPCRateLimit(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := &testAdvancingClock{now: start}
+
+	server := tf5server.New(
+		"registry.terraform.io/hashicorp/test",
+		testRateLimitProviderServer{},
+		tf5server.WithClock(c),
+		tf5server.WithRPCRateLimit("ReadResource", 2, 1),
+	)
+
+	for i := 0; i < 3; i++ {
+		_, err := server.ReadResource(context.Background(), &tfplugin5.ReadResource_Request{
+			TypeName: "test_resource",
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// The first request uses the burst and the others each wait 500ms.
+	if got := c.Now().Sub(start); got != time.Second {
+		t.Errorf("expected requests to wait %s, waited %s", time.Second, got)
+	}
+}
+
+func TestWithRPCRateLimit_Invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opt           tf5server.ServeOpt
+		expectedError string
+	}{
+		"stop": {
+			opt:           tf5server.WithRPCRateLimit("Stop", 1, 1),
+			expectedError: "the Stop RPC cannot be rate limited",
+		},
+		"unknown-rpc": {
+			opt:           tf5server.WithRPCRateLimit("ReadResouce", 1, 1),
+			expectedError: `unknown RPC "ReadResouce" cannot be rate limited`,
+		},
+		"limit": {
+			opt:           tf5server.WithRPCRateLimit("ReadResource", 0, 1),
+			expectedError: "RPC rate limit must be greater than zero",
+		},
+		"burst": {
+			opt:           tf5server.WithRPCRateLimit("ReadResource", 1, 0),
+			expectedError: "RPC rate limit burst must be at least one",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.opt.ApplyServeOpt(&tf5server.ServeConfig{})
+
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Errorf("expected error %q, got %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server
+
+import (
+	"context"
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-go/internal/recording"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/fromproto"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/toproto"
+)
+
+var (
+	_ tfprotov5.ProviderServerWithActions            = &replayServer{}
+	_ tfprotov5.ProviderServerWithEphemeralResources = &replayServer{}
+)
+
+// replayServer is a tfprotov5.ProviderServer, including all optional
+// interfaces, which responds to requests with responses previously recorded
+// via WithRecording.
+type replayServer struct {
+	replayer *recording.Replayer
+}
+
+// NewReplayProviderServer returns a tfprotov5.ProviderServer which responds
+// to requests using the RPC exchanges recorded via the WithRecording ServeOpt
+// and read from r. Each recorded exchange is replayed at most once, in the
+// order recorded, for a request equal to the recorded request. Requests
+// without a matching recorded exchange return an error.
+//
+// The returned server can be passed to Serve or New like any other provider
+// server, enabling deterministic testing of Terraform configurations without
+// calling real infrastructure APIs.
+func NewReplayProviderServer(r io.Reader) (tfprotov5.ProviderServer, error) {
+	replayer, err := recording.NewReplayer(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &replayServer{
+		replayer: replayer,
+	}, nil
+}
+
+func (s *replayServer) GetMetadata(_ context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	protoResp := &tfplugin5.GetMetadata_Response{}
+
+	if err := s.replayer.Replay("GetMetadata", toproto.GetMetadata_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetMetadataResponse(protoResp)
+}
+
+func (s *replayServer) GetProviderSchema(_ context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	protoResp := &tfplugin5.GetProviderSchema_Response{}
+
+	if err := s.replayer.Replay("GetProviderSchema", toproto.GetProviderSchema_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetProviderSchemaResponse(protoResp)
+}
+
+func (s *replayServer) PrepareProviderConfig(_ context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	protoResp := &tfplugin5.PrepareProviderConfig_Response{}
+
+	if err := s.replayer.Replay("PrepareProviderConfig", toproto.PrepareProviderConfig_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.PrepareProviderConfigResponse(protoResp)
+}
+
+func (s *replayServer) ConfigureProvider(_ context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	protoResp := &tfplugin5.Configure_Response{}
+
+	if err := s.replayer.Replay("Configure", toproto.Configure_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ConfigureProviderResponse(protoResp)
+}
+
+func (s *replayServer) StopProvider(_ context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	protoResp := &tfplugin5.Stop_Response{}
+
+	if err := s.replayer.Replay("Stop", toproto.Stop_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.StopProviderResponse(protoResp), nil
+}
+
+func (s *replayServer) ValidateDataSourceConfig(_ context.Context, req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	protoResp := &tfplugin5.ValidateDataSourceConfig_Response{}
+
+	if err := s.replayer.Replay("ValidateDataSourceConfig", toproto.ValidateDataSourceConfig_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateDataSourceConfigResponse(protoResp)
+}
+
+func (s *replayServer) ReadDataSource(_ context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	protoResp := &tfplugin5.ReadDataSource_Response{}
+
+	if err := s.replayer.Replay("ReadDataSource", toproto.ReadDataSource_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ReadDataSourceResponse(protoResp)
+}
+
+func (s *replayServer) ValidateEphemeralResourceConfig(_ context.Context, req *tfprotov5.ValidateEphemeralResourceConfigRequest) (*tfprotov5.ValidateEphemeralResourceConfigResponse, error) {
+	protoResp := &tfplugin5.ValidateEphemeralResourceConfig_Response{}
+
+	if err := s.replayer.Replay("ValidateEphemeralResourceConfig", toproto.ValidateEphemeralResourceConfig_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateEphemeralResourceConfigResponse(protoResp)
+}
+
+func (s *replayServer) OpenEphemeralResource(_ context.Context, req *tfprotov5.OpenEphemeralResourceRequest) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	protoResp := &tfplugin5.OpenEphemeralResource_Response{}
+
+	if err := s.replayer.Replay("OpenEphemeralResource", toproto.OpenEphemeralResource_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.OpenEphemeralResourceResponse(protoResp)
+}
+
+func (s *replayServer) RenewEphemeralResource(_ context.Context, req *tfprotov5.RenewEphemeralResourceRequest) (*tfprotov5.RenewEphemeralResourceResponse, error) {
+	protoResp := &tfplugin5.RenewEphemeralResource_Response{}
+
+	if err := s.replayer.Replay("RenewEphemeralResource", toproto.RenewEphemeralResource_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.RenewEphemeralResourceResponse(protoResp)
+}
+
+func (s *replayServer) CloseEphemeralResource(_ context.Context, req *tfprotov5.CloseEphemeralResourceRequest) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	protoResp := &tfplugin5.CloseEphemeralResource_Response{}
+
+	if err := s.replayer.Replay("CloseEphemeralResource", toproto.CloseEphemeralResource_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.CloseEphemeralResourceResponse(protoResp)
+}
+
+func (s *replayServer) ValidateActionConfig(_ context.Context, req *tfprotov5.ValidateActionConfigRequest) (*tfprotov5.ValidateActionConfigResponse, error) {
+	protoResp := &tfplugin5.ValidateActionConfig_Response{}
+
+	if err := s.replayer.Replay("ValidateActionConfig", toproto.ValidateActionConfig_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateActionConfigResponse(protoResp)
+}
+
+// InvokeAction replays the recorded events of the action, which are recorded
+// as separate exchanges, up to and including the completed event.
+func (s *replayServer) InvokeAction(_ context.Context, req *tfprotov5.InvokeActionRequest) (*tfprotov5.InvokeActionResponse, error) {
+	protoReq := toproto.InvokeAction_Request(req)
+
+	var events []tfprotov5.InvokeActionEvent
+
+	for {
+		protoEvent := &tfplugin5.InvokeAction_Event{}
+
+		if err := s.replayer.Replay("InvokeAction", protoReq, protoEvent); err != nil {
+			return nil, err
+		}
+
+		event, err := fromproto.InvokeActionEvent(protoEvent)
+
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, *event)
+
+		if _, ok := event.Type.(tfprotov5.CompletedInvokeActionEventType); ok {
+			break
+		}
+	}
+
+	resp := &tfprotov5.InvokeActionResponse{
+		Events: func(yield func(tfprotov5.InvokeActionEvent) bool) {
+			for _, event := range events {
+				if !yield(event) {
+					return
+				}
+			}
+		},
+	}
+
+	return resp, nil
+}
+
+func (s *replayServer) ValidateResourceTypeConfig(_ context.Context, req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	protoResp := &tfplugin5.ValidateResourceTypeConfig_Response{}
+
+	if err := s.replayer.Replay("ValidateResourceTypeConfig", toproto.ValidateResourceTypeConfig_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateResourceTypeConfigResponse(protoResp)
+}
+
+func (s *replayServer) UpgradeResourceState(_ context.Context, req *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	protoResp := &tfplugin5.UpgradeResourceState_Response{}
+
+	if err := s.replayer.Replay("UpgradeResourceState", toproto.UpgradeResourceState_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.UpgradeResourceStateResponse(protoResp)
+}
+
+func (s *replayServer) ReadResource(_ context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	protoResp := &tfplugin5.ReadResource_Response{}
+
+	if err := s.replayer.Replay("ReadResource", toproto.ReadResource_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ReadResourceResponse(protoResp)
+}
+
+func (s *replayServer) PlanResourceChange(_ context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	protoResp := &tfplugin5.PlanResourceChange_Response{}
+
+	if err := s.replayer.Replay("PlanResourceChange", toproto.PlanResourceChange_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.PlanResourceChangeResponse(protoResp)
+}
+
+func (s *replayServer) ApplyResourceChange(_ context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	protoResp := &tfplugin5.ApplyResourceChange_Response{}
+
+	if err := s.replayer.Replay("ApplyResourceChange", toproto.ApplyResourceChange_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ApplyResourceChangeResponse(protoResp)
+}
+
+func (s *replayServer) ImportResourceState(_ context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	protoResp := &tfplugin5.ImportResourceState_Response{}
+
+	if err := s.replayer.Replay("ImportResourceState", toproto.ImportResourceState_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ImportResourceStateResponse(protoResp)
+}
+
+func (s *replayServer) MoveResourceState(_ context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	protoResp := &tfplugin5.MoveResourceState_Response{}
+
+	if err := s.replayer.Replay("MoveResourceState", toproto.MoveResourceState_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.MoveResourceStateResponse(protoResp)
+}
+
+func (s *replayServer) CallFunction(_ context.Context, req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	protoResp := &tfplugin5.CallFunction_Response{}
+
+	if err := s.replayer.Replay("CallFunction", toproto.CallFunction_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.CallFunctionResponse(protoResp), nil
+}
+
+func (s *replayServer) GetFunctions(_ context.Context, req *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	protoResp := &tfplugin5.GetFunctions_Response{}
+
+	if err := s.replayer.Replay("GetFunctions", toproto.GetFunctions_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetFunctionsResponse(protoResp)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+)
+
+type testReplayProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s testReplayProviderServer) ReadResource(_ context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	return &tfprotov5.ReadResourceResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  "test summary",
+				Detail:   "test detail",
+			},
+		},
+		NewState: req.CurrentState,
+		Private:  []byte(`{"read":"` + req.TypeName + `"}`),
+	}, nil
+}
+
+func TestNewReplayProviderServer(t *testing.T) {
+	t.Parallel()
+
+	var recording bytes.Buffer
+
+	server := tf5server.New("registry.terraform.io/hashicorp/test", testReplayProviderServer{}, tf5server.WithRecording(&recording))
+
+	_, err := server.ReadResource(context.Background(), &tfplugin5.ReadResource_Request{
+		CurrentState: &tfplugin5.DynamicValue{
+			Json: []byte(`{"id":"test"}`),
+		},
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error recording: %s", err)
+	}
+
+	replayServer, err := tf5server.NewReplayProviderServer(&recording)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	req := &tfprotov5.ReadResourceRequest{
+		CurrentState: &tfprotov5.DynamicValue{
+			JSON: []byte(`{"id":"test"}`),
+		},
+		TypeName: "test_resource",
+	}
+
+	got, err := replayServer.ReadResource(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("unexpected error replaying: %s", err)
+	}
+
+	expected := &tfprotov5.ReadResourceResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  "test summary",
+				Detail:   "test detail",
+			},
+		},
+		NewState: &tfprotov5.DynamicValue{
+			JSON: []byte(`{"id":"test"}`),
+		},
+		Private: []byte(`{"read":"test_resource"}`),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = replayServer.ReadResource(context.Background(), req)
+
+	if err == nil {
+		t.Fatal("expected error replaying an already replayed exchange")
+	}
+
+	_, err = replayServer.ReadResource(context.Background(), &tfprotov5.ReadResourceRequest{
+		TypeName: "other_resource",
+	})
+
+	if err == nil {
+		t.Fatal("expected error replaying an unrecorded request")
+	}
+}
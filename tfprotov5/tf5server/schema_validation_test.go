@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testSchemaValidationProviderServer struct {
+	tfprotov5.ProviderServer
+
+	resp *tfprotov5.GetProviderSchemaResponse
+	err  error
+}
+
+func (s testSchemaValidationProviderServer) GetProviderSchema(_ context.Context, _ *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return s.resp, s.err
+}
+
+func TestValidateProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	validSchema := &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{
+					Name:     "id",
+					Type:     tftypes.String,
+					Computed: true,
+				},
+			},
+			BlockTypes: []*tfprotov5.SchemaNestedBlock{
+				{
+					TypeName: "timeouts",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+					Block: &tfprotov5.SchemaBlock{
+						Attributes: []*tfprotov5.SchemaAttribute{
+							{
+								Name:     "create",
+								Type:     tftypes.String,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		server         testSchemaValidationProviderServer
+		expectedErrors []string
+	}{
+		"valid": {
+			server: testSchemaValidationProviderServer{
+				resp: &tfprotov5.GetProviderSchemaResponse{
+					Provider: validSchema,
+					ResourceSchemas: map[string]*tfprotov5.Schema{
+						"test_resource": validSchema,
+					},
+					DataSourceSchemas: map[string]*tfprotov5.Schema{
+						"test_data_source": validSchema,
+					},
+				},
+			},
+		},
+		"error": {
+			server: testSchemaValidationProviderServer{
+				err: errors.New("test error"),
+			},
+			expectedErrors: []string{
+				"Error validating provider schema: test error",
+			},
+		},
+		"nil-response": {
+			server: testSchemaValidationProviderServer{},
+			expectedErrors: []string{
+				"GetProviderSchema returned no response",
+			},
+		},
+		"error-diagnostic": {
+			server: testSchemaValidationProviderServer{
+				resp: &tfprotov5.GetProviderSchemaResponse{
+					Diagnostics: []*tfprotov5.Diagnostic{
+						{
+							Severity: tfprotov5.DiagnosticSeverityError,
+							Summary:  "test summary",
+							Detail:   "test detail",
+						},
+					},
+				},
+			},
+			expectedErrors: []string{
+				"error diagnostic: test summary: test detail",
+			},
+		},
+		"nil-schema": {
+			server: testSchemaValidationProviderServer{
+				resp: &tfprotov5.GetProviderSchemaResponse{
+					ResourceSchemas: map[string]*tfprotov5.Schema{
+						"test_resource": nil,
+					},
+				},
+			},
+			expectedErrors: []string{
+				`resource "test_resource": schema is nil`,
+			},
+		},
+		"nil-block": {
+			server: testSchemaValidationProviderServer{
+				resp: &tfprotov5.GetProviderSchemaResponse{
+					Provider: &tfprotov5.Schema{},
+				},
+			},
+			expectedErrors: []string{
+				"provider: schema block is nil",
+			},
+		},
+		"invalid-type-name": {
+			server: testSchemaValidationProviderServer{
+				resp: &tfprotov5.GetProviderSchemaResponse{
+					DataSourceSchemas: map[string]*tfprotov5.Schema{
+						"test data source": validSchema,
+					},
+				},
+			},
+			expectedErrors: []string{
+				`data source "test data source": invalid type name`,
+			},
+		},
+		"invalid-attributes": {
+			server: testSchemaValidationProviderServer{
+				resp: &tfprotov5.GetProviderSchemaResponse{
+					ResourceSchemas: map[string]*tfprotov5.Schema{
+						"test_resource": {
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									nil,
+									{
+										Name:     "Invalid-Name",
+										Type:     tftypes.String,
+										Optional: true,
+									},
+									{
+										Name:     "no_type",
+										Required: true,
+									},
+									{
+										Name: "no_flags",
+										Type: tftypes.String,
+									},
+									{
+										Name:     "required_computed",
+										Type:     tftypes.String,
+										Required: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedErrors: []string{
+				`resource "test_resource": root block: attribute is nil`,
+				`resource "test_resource": attribute "Invalid-Name": invalid name`,
+				`resource "test_resource": attribute "no_type": type is nil`,
+				`resource "test_resource": attribute "no_flags": one of Required, Optional, or Computed must be set`,
+				`resource "test_resource": attribute "required_computed": Required cannot be combined with Optional or Computed`,
+			},
+		},
+		"invalid-blocks": {
+			server: testSchemaValidationProviderServer{
+				resp: &tfprotov5.GetProviderSchemaResponse{
+					ResourceSchemas: map[string]*tfprotov5.Schema{
+						"test_resource": {
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:     "duplicate",
+										Type:     tftypes.String,
+										Optional: true,
+									},
+								},
+								BlockTypes: []*tfprotov5.SchemaNestedBlock{
+									nil,
+									{
+										TypeName: "duplicate",
+										Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+										Block:    &tfprotov5.SchemaBlock{},
+									},
+									{
+										TypeName: "no_block",
+										Nesting:  tfprotov5.SchemaNestedBlockNestingModeInvalid,
+									},
+									{
+										TypeName: "nested",
+										Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+										Block: &tfprotov5.SchemaBlock{
+											Attributes: []*tfprotov5.SchemaAttribute{
+												{
+													Name:     "no_type",
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedErrors: []string{
+				`resource "test_resource": root block: nested block is nil`,
+				`resource "test_resource": block "duplicate": duplicate name`,
+				`resource "test_resource": block "no_block": invalid nesting mode INVALID`,
+				`resource "test_resource": block "no_block": block is nil`,
+				`resource "test_resource": attribute "nested.no_type": type is nil`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateProviderSchema(context.Background(), testCase.server)
+
+			if len(testCase.expectedErrors) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			for _, expectedError := range testCase.expectedErrors {
+				if !strings.Contains(err.Error(), expectedError) {
+					t.Errorf("expected error to contain %q, got: %s", expectedError, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+)
+
+type testSentinelErrorProviderServer struct {
+	tfprotov5.UnimplementedProviderServer
+
+	err error
+}
+
+func (s testSentinelErrorProviderServer) GetMetadata(_ context.Context, _ *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	return nil, s.err
+}
+
+func (s testSentinelErrorProviderServer) ReadResource(_ context.Context, _ *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	return nil, s.err
+}
+
+func (s testSentinelErrorProviderServer) PlanResourceChange(_ context.Context, _ *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	return nil, s.err
+}
+
+func TestSentinelErrors_ResourceNotFound(t *testing.T) {
+	t.Parallel()
+
+	server := tf5server.New("registry.terraform.io/hashicorp/test", testSentinelErrorProviderServer{
+		err: fmt.Errorf("test_resource: %w", tfprotov5.ErrResourceNotFound),
+	})
+
+	readResp, err := server.ReadResource(context.Background(), &tfplugin5.ReadResource_Request{
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if readResp.NewState != nil {
+		t.Errorf("expected null NewState, got: %s", readResp.NewState)
+	}
+
+	if len(readResp.Diagnostics) != 0 {
+		t.Errorf("unexpected ReadResource diagnostics: %v", readResp.Diagnostics)
+	}
+
+	planResp, err := server.PlanResourceChange(context.Background(), &tfplugin5.PlanResourceChange_Request{
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedDiagnostics := []*tfplugin5.Diagnostic{
+		{
+			Severity: tfplugin5.Diagnostic_ERROR,
+			Summary:  "Resource Not Found",
+			Detail:   "test_resource: resource not found",
+		},
+	}
+
+	if diff := cmp.Diff(planResp.Diagnostics, expectedDiagnostics, cmp.Comparer(func(x, y *tfplugin5.Diagnostic) bool {
+		return x.Severity == y.Severity && x.Summary == y.Summary && x.Detail == y.Detail
+	})); diff != "" {
+		t.Errorf("unexpected PlanResourceChange diagnostics difference: %s", diff)
+	}
+}
+
+func TestSentinelErrors_UnsupportedRPC(t *testing.T) {
+	t.Parallel()
+
+	server := tf5server.New("registry.terraform.io/hashicorp/test", testSentinelErrorProviderServer{
+		err: tfprotov5.ErrUnsupportedRPC,
+	})
+
+	_, err := server.GetMetadata(context.Background(), &tfplugin5.GetMetadata_Request{})
+
+	if diff := cmp.Diff(status.Code(err), codes.Unimplemented); diff != "" {
+		t.Errorf("unexpected code difference: %s", diff)
+	}
+}
@@ -0,0 +1,465 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf5server
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+	"google.golang.org/grpc/keepalive"
+
+	"github.com/hashicorp/terraform-plugin-go/internal/logging"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestServerStoppableContext(t *testing.T) {
+	t.Parallel()
+
+	s := &server{
+		stopCh: make(chan struct{}),
+	}
+
+	ctx, served := s.stoppableContext(context.Background())
+	defer served()
+
+	s.stop()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for context cancellation")
+	}
+
+	if !errors.Is(ctx.Err(), context.Canceled) {
+		t.Errorf("expected context.Canceled error, got: %s", ctx.Err())
+	}
+
+	if !errors.Is(context.Cause(ctx), ErrProviderStopped) {
+		t.Errorf("expected ErrProviderStopped cause, got: %s", context.Cause(ctx))
+	}
+
+	// Contexts created after stopping are unaffected.
+	ctx, served = s.stoppableContext(context.Background())
+	defer served()
+
+	if ctx.Err() != nil {
+		t.Errorf("unexpected context error: %s", ctx.Err())
+	}
+}
+
+func TestServerStoppableContext_ParentCause(t *testing.T) {
+	t.Parallel()
+
+	s := &server{
+		stopCh: make(chan struct{}),
+	}
+
+	expectedCause := errors.New("test cause")
+	parent, cancel := context.WithCancelCause(context.Background())
+	ctx, served := s.stoppableContext(parent)
+	defer served()
+
+	cancel(expectedCause)
+
+	<-ctx.Done()
+
+	if !errors.Is(context.Cause(ctx), expectedCause) {
+		t.Errorf("expected test cause, got: %s", context.Cause(ctx))
+	}
+}
+
+func TestServerStoppableContext_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	s := &server{
+		stopCh:     make(chan struct{}),
+		shutdownCh: make(chan struct{}),
+	}
+
+	ctx, served := s.stoppableContext(context.Background())
+	defer served()
+
+	close(s.shutdownCh)
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for context cancellation")
+	}
+
+	if !errors.Is(context.Cause(ctx), ErrServerShutdown) {
+		t.Errorf("expected ErrServerShutdown cause, got: %s", context.Cause(ctx))
+	}
+}
+
+func TestMergeStop_Canceled(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		served   bool
+		expected []map[string]interface{}
+	}{
+		"served": {
+			served:   true,
+			expected: nil,
+		},
+		"not-served": {
+			expected: []map[string]interface{}{
+				{
+					"@level":                  "trace",
+					"@message":                "Request context canceled",
+					"@module":                 "sdk.proto",
+					"tf_context_cancel_cause": "context canceled",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			parent := tfsdklogtest.RootLogger(context.Background(), &output)
+			parent = logging.ProtoSubsystemContext(parent, tfsdklog.Options{})
+			parent, cancelParent := context.WithCancel(parent)
+			ctx, cancel := context.WithCancelCause(parent)
+			servedCh := make(chan struct{})
+
+			if testCase.served {
+				close(servedCh)
+			}
+
+			// The client disconnecting cancels the parent context.
+			cancelParent()
+
+			mergeStop(ctx, cancel, nil, nil, servedCh)
+
+			entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			if diff := cmp.Diff(entries, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServeConfigAutoMTLS(t *testing.T) {
+	t.Parallel()
+
+	tlsProvider := func() (*tls.Config, error) { return &tls.Config{}, nil } //nolint:gosec
+
+	testCases := map[string]struct {
+		opts          []ServeOpt
+		expectedMode  AutoMTLSMode
+		expectedError string
+	}{
+		"default": {},
+		"disabled": {
+			opts:         []ServeOpt{WithAutoMTLS(AutoMTLSModeDisabled)},
+			expectedMode: AutoMTLSModeDisabled,
+		},
+		"required": {
+			opts:         []ServeOpt{WithAutoMTLS(AutoMTLSModeRequired)},
+			expectedMode: AutoMTLSModeRequired,
+		},
+		"unknown-mode": {
+			opts:          []ServeOpt{WithAutoMTLS(AutoMTLSMode(99))},
+			expectedError: "unknown AutoMTLS mode: 99",
+		},
+		"tls-provider": {
+			opts: []ServeOpt{WithTLSProvider(tlsProvider)},
+		},
+		"tls-provider-nil": {
+			opts:          []ServeOpt{WithTLSProvider(nil)},
+			expectedError: "TLS provider cannot be nil",
+		},
+		"tls-provider-then-auto-mtls": {
+			opts:          []ServeOpt{WithTLSProvider(tlsProvider), WithAutoMTLS(AutoMTLSModeDisabled)},
+			expectedError: "cannot set both WithAutoMTLS and WithTLSProvider",
+		},
+		"auto-mtls-then-tls-provider": {
+			opts:          []ServeOpt{WithAutoMTLS(AutoMTLSModeRequired), WithTLSProvider(tlsProvider)},
+			expectedError: "cannot set both WithAutoMTLS and WithTLSProvider",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var conf ServeConfig
+			var err error
+
+			for _, opt := range testCase.opts {
+				if err = opt.ApplyServeOpt(&conf); err != nil {
+					break
+				}
+			}
+
+			if err != nil {
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if conf.autoMTLSMode != testCase.expectedMode {
+				t.Errorf("expected AutoMTLS mode %d, got %d", testCase.expectedMode, conf.autoMTLSMode)
+			}
+		})
+	}
+}
+
+func TestServeAutoMTLSRequired(t *testing.T) { //nolint:paralleltest // t.Setenv cannot be used with t.Parallel
+	t.Setenv(envPluginClientCert, "")
+
+	err := Serve("test", nil, WithAutoMTLS(AutoMTLSModeRequired))
+
+	expectedError := "AutoMTLS is required, but Terraform did not provide a client certificate"
+
+	if err == nil || err.Error() != expectedError {
+		t.Fatalf("expected error %q, got %v", expectedError, err)
+	}
+}
+
+func TestServeAutoMTLSDisabled(t *testing.T) { //nolint:paralleltest // t.Setenv cannot be used with t.Parallel
+	t.Setenv(envPluginClientCert, "test-client-cert")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reattachCh := make(chan *plugin.ReattachConfig)
+	closeCh := make(chan struct{})
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- Serve(
+			"test",
+			func() tfprotov5.ProviderServer { return tfprotov5.UnimplementedProviderServer{} },
+			WithAutoMTLS(AutoMTLSModeDisabled),
+			WithDebug(ctx, reattachCh, closeCh),
+		)
+	}()
+
+	select {
+	case <-reattachCh:
+	case err := <-errCh:
+		t.Fatalf("unexpected Serve return: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting on reattach configuration")
+	}
+
+	// The variable is restored once go-plugin has started, so it is not
+	// lost for the rest of the process and any child processes.
+	if got := os.Getenv(envPluginClientCert); got != "test-client-cert" {
+		t.Errorf("expected %s to be restored, got %q", envPluginClientCert, got)
+	}
+
+	cancel()
+
+	select {
+	case <-closeCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting on server to close")
+	}
+
+	if err := <-errCh; err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestWithManagedDebugReattachConfigFunc(t *testing.T) {
+	t.Parallel()
+
+	var conf ServeConfig
+
+	err := WithManagedDebugReattachConfigFunc(nil).ApplyServeOpt(&conf)
+
+	if err == nil || err.Error() != "reattach configuration function cannot be nil" {
+		t.Fatalf("expected nil function error, got %v", err)
+	}
+
+	var got string
+
+	err = WithManagedDebugReattachConfigFunc(func(reattachConfig string) { got = reattachConfig }).ApplyServeOpt(&conf)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	conf.managedDebugReattachConfigFunc("test")
+
+	if got != "test" {
+		t.Errorf("expected function to be called with %q, got %q", "test", got)
+	}
+}
+
+func TestServeStandaloneFunc(t *testing.T) { //nolint:paralleltest // t.Setenv cannot be used with t.Parallel
+	t.Setenv("TF_PLUGIN_MAGIC_COOKIE", "")
+
+	expectedError := errors.New("test standalone error")
+
+	err := Serve("test", nil, WithStandaloneFunc(func() error {
+		return expectedError
+	}))
+
+	if !errors.Is(err, expectedError) {
+		t.Fatalf("expected error %q, got %v", expectedError, err)
+	}
+}
+
+func TestWithGRPCKeepalive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params        keepalive.ServerParameters
+		policy        keepalive.EnforcementPolicy
+		expectedError string
+	}{
+		"valid": {
+			params: keepalive.ServerParameters{
+				Time:    30 * time.Second,
+				Timeout: 10 * time.Second,
+			},
+			policy: keepalive.EnforcementPolicy{
+				MinTime:             10 * time.Second,
+				PermitWithoutStream: true,
+			},
+		},
+		"negative-params": {
+			params: keepalive.ServerParameters{
+				Time: -1,
+			},
+			expectedError: "gRPC keepalive durations cannot be negative",
+		},
+		"negative-policy": {
+			policy: keepalive.EnforcementPolicy{
+				MinTime: -1,
+			},
+			expectedError: "gRPC keepalive durations cannot be negative",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var conf ServeConfig
+
+			err := WithGRPCKeepalive(testCase.params, testCase.policy).ApplyServeOpt(&conf)
+
+			if err != nil {
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(conf.grpcKeepaliveParams, &testCase.params); diff != "" {
+				t.Errorf("unexpected params difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(conf.grpcKeepalivePolicy, &testCase.policy); diff != "" {
+				t.Errorf("unexpected policy difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWithHandshakeConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config        plugin.HandshakeConfig
+		expectedError string
+	}{
+		"valid": {
+			config: plugin.HandshakeConfig{
+				ProtocolVersion:  1,
+				MagicCookieKey:   "TEST_MAGIC_COOKIE",
+				MagicCookieValue: "test",
+			},
+		},
+		"missing-magic-cookie-key": {
+			config: plugin.HandshakeConfig{
+				ProtocolVersion:  1,
+				MagicCookieValue: "test",
+			},
+			expectedError: "handshake magic cookie key and value cannot be empty",
+		},
+		"missing-magic-cookie-value": {
+			config: plugin.HandshakeConfig{
+				ProtocolVersion: 1,
+				MagicCookieKey:  "TEST_MAGIC_COOKIE",
+			},
+			expectedError: "handshake magic cookie key and value cannot be empty",
+		},
+		"missing-protocol-version": {
+			config: plugin.HandshakeConfig{
+				MagicCookieKey:   "TEST_MAGIC_COOKIE",
+				MagicCookieValue: "test",
+			},
+			expectedError: "handshake protocol version cannot be zero",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var conf ServeConfig
+
+			err := WithHandshakeConfig(testCase.config).ApplyServeOpt(&conf)
+
+			if err != nil {
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(conf.handshakeConfig, &testCase.config); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5
+
+import (
+	"context"
+)
+
+var (
+	_ ProviderServerWithActions            = UnimplementedProviderServer{}
+	_ ProviderServerWithEphemeralResources = UnimplementedProviderServer{}
+)
+
+// UnimplementedProviderServer implements all ProviderServer RPCs by returning
+// an error diagnostic, or a function error for CallFunction, explaining that
+// the provider does not implement them. GetMetadata returns
+// ErrUnsupportedRPC and StopProvider succeeds. It also
+// implements the optional interfaces ProviderServerWithEphemeralResources
+// and ProviderServerWithActions.
+//
+// Embed UnimplementedProviderServer in partial ProviderServer
+// implementations and test doubles, so they continue to compile when RPCs
+// are added to ProviderServer. The RPCs return the same diagnostics as
+// NewProviderServerFromParts with nil parts.
+type UnimplementedProviderServer struct{}
+
+// GetMetadata returns ErrUnsupportedRPC, so Terraform falls back to
+// GetProviderSchema.
+func (UnimplementedProviderServer) GetMetadata(ctx context.Context, req *GetMetadataRequest) (*GetMetadataResponse, error) {
+	return partsProviderServer{}.GetMetadata(ctx, req)
+}
+
+// GetProviderSchema returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRequest) (*GetProviderSchemaResponse, error) {
+	return partsProviderServer{}.GetProviderSchema(ctx, req)
+}
+
+// PrepareProviderConfig returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) PrepareProviderConfig(ctx context.Context, req *PrepareProviderConfigRequest) (*PrepareProviderConfigResponse, error) {
+	return partsProviderServer{}.PrepareProviderConfig(ctx, req)
+}
+
+// ConfigureProvider returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ConfigureProvider(ctx context.Context, req *ConfigureProviderRequest) (*ConfigureProviderResponse, error) {
+	return partsProviderServer{}.ConfigureProvider(ctx, req)
+}
+
+// StopProvider returns an empty response.
+func (UnimplementedProviderServer) StopProvider(ctx context.Context, req *StopProviderRequest) (*StopProviderResponse, error) {
+	return partsProviderServer{}.StopProvider(ctx, req)
+}
+
+// ValidateResourceTypeConfig returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ValidateResourceTypeConfig(ctx context.Context, req *ValidateResourceTypeConfigRequest) (*ValidateResourceTypeConfigResponse, error) {
+	return partsProviderServer{}.ValidateResourceTypeConfig(ctx, req)
+}
+
+// UpgradeResourceState returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) UpgradeResourceState(ctx context.Context, req *UpgradeResourceStateRequest) (*UpgradeResourceStateResponse, error) {
+	return partsProviderServer{}.UpgradeResourceState(ctx, req)
+}
+
+// ReadResource returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ReadResource(ctx context.Context, req *ReadResourceRequest) (*ReadResourceResponse, error) {
+	return partsProviderServer{}.ReadResource(ctx, req)
+}
+
+// PlanResourceChange returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) PlanResourceChange(ctx context.Context, req *PlanResourceChangeRequest) (*PlanResourceChangeResponse, error) {
+	return partsProviderServer{}.PlanResourceChange(ctx, req)
+}
+
+// ApplyResourceChange returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ApplyResourceChange(ctx context.Context, req *ApplyResourceChangeRequest) (*ApplyResourceChangeResponse, error) {
+	return partsProviderServer{}.ApplyResourceChange(ctx, req)
+}
+
+// ImportResourceState returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ImportResourceState(ctx context.Context, req *ImportResourceStateRequest) (*ImportResourceStateResponse, error) {
+	return partsProviderServer{}.ImportResourceState(ctx, req)
+}
+
+// MoveResourceState returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) MoveResourceState(ctx context.Context, req *MoveResourceStateRequest) (*MoveResourceStateResponse, error) {
+	return partsProviderServer{}.MoveResourceState(ctx, req)
+}
+
+// ValidateDataSourceConfig returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ValidateDataSourceConfig(ctx context.Context, req *ValidateDataSourceConfigRequest) (*ValidateDataSourceConfigResponse, error) {
+	return partsProviderServer{}.ValidateDataSourceConfig(ctx, req)
+}
+
+// ReadDataSource returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest) (*ReadDataSourceResponse, error) {
+	return partsProviderServer{}.ReadDataSource(ctx, req)
+}
+
+// CallFunction returns a not implemented function error.
+func (UnimplementedProviderServer) CallFunction(ctx context.Context, req *CallFunctionRequest) (*CallFunctionResponse, error) {
+	return partsProviderServer{}.CallFunction(ctx, req)
+}
+
+// GetFunctions returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) GetFunctions(ctx context.Context, req *GetFunctionsRequest) (*GetFunctionsResponse, error) {
+	return partsProviderServer{}.GetFunctions(ctx, req)
+}
+
+// ValidateEphemeralResourceConfig returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ValidateEphemeralResourceConfig(ctx context.Context, req *ValidateEphemeralResourceConfigRequest) (*ValidateEphemeralResourceConfigResponse, error) {
+	return partsProviderServer{}.ValidateEphemeralResourceConfig(ctx, req)
+}
+
+// OpenEphemeralResource returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) OpenEphemeralResource(ctx context.Context, req *OpenEphemeralResourceRequest) (*OpenEphemeralResourceResponse, error) {
+	return partsProviderServer{}.OpenEphemeralResource(ctx, req)
+}
+
+// RenewEphemeralResource returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) RenewEphemeralResource(ctx context.Context, req *RenewEphemeralResourceRequest) (*RenewEphemeralResourceResponse, error) {
+	return partsProviderServer{}.RenewEphemeralResource(ctx, req)
+}
+
+// CloseEphemeralResource returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) CloseEphemeralResource(ctx context.Context, req *CloseEphemeralResourceRequest) (*CloseEphemeralResourceResponse, error) {
+	return partsProviderServer{}.CloseEphemeralResource(ctx, req)
+}
+
+// ValidateActionConfig returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ValidateActionConfig(ctx context.Context, req *ValidateActionConfigRequest) (*ValidateActionConfigResponse, error) {
+	return partsProviderServer{}.ValidateActionConfig(ctx, req)
+}
+
+// InvokeAction returns a completed event with a not implemented error
+// diagnostic.
+func (UnimplementedProviderServer) InvokeAction(ctx context.Context, req *InvokeActionRequest) (*InvokeActionResponse, error) {
+	return partsProviderServer{}.InvokeAction(ctx, req)
+}
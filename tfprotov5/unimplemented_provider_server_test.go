@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// testEmbeddedUnimplementedProviderServer only implements ReadDataSource.
+type testEmbeddedUnimplementedProviderServer struct {
+	tfprotov5.UnimplementedProviderServer
+}
+
+func (s testEmbeddedUnimplementedProviderServer) ReadDataSource(_ context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	return &tfprotov5.ReadDataSourceResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  req.TypeName,
+			},
+		},
+	}, nil
+}
+
+func TestUnimplementedProviderServer(t *testing.T) {
+	t.Parallel()
+
+	var server tfprotov5.ProviderServer = testEmbeddedUnimplementedProviderServer{}
+
+	testCases := map[string]struct {
+		call                func() ([]*tfprotov5.Diagnostic, error)
+		expectedDiagnostics []*tfprotov5.Diagnostic
+	}{
+		"GetProviderSchema": {
+			call: func() ([]*tfprotov5.Diagnostic, error) {
+				resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedDiagnostics: []*tfprotov5.Diagnostic{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Provider Configuration Not Implemented",
+					Detail: "A GetProviderSchema call was received by the provider, however the provider does not implement the RPC. " +
+						"Either upgrade the provider to a version that implements configuration support or this is always an error in the provider that should be reported to the provider developers.",
+				},
+			},
+		},
+		"ReadDataSource": {
+			call: func() ([]*tfprotov5.Diagnostic, error) {
+				resp, err := server.ReadDataSource(context.Background(), &tfprotov5.ReadDataSourceRequest{
+					TypeName: "test_data_source",
+				})
+				return resp.Diagnostics, err
+			},
+			expectedDiagnostics: []*tfprotov5.Diagnostic{
+				{
+					Severity: tfprotov5.DiagnosticSeverityWarning,
+					Summary:  "test_data_source",
+				},
+			},
+		},
+		"ReadResource": {
+			call: func() ([]*tfprotov5.Diagnostic, error) {
+				resp, err := server.ReadResource(context.Background(), &tfprotov5.ReadResourceRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedDiagnostics: []*tfprotov5.Diagnostic{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Provider Resource Not Implemented",
+					Detail: "A ReadResource call was received by the provider, however the provider does not implement the RPC. " +
+						"Either upgrade the provider to a version that implements resource support or this is always an error in the provider that should be reported to the provider developers.",
+				},
+			},
+		},
+		"OpenEphemeralResource": {
+			call: func() ([]*tfprotov5.Diagnostic, error) {
+				resp, err := server.(tfprotov5.ProviderServerWithEphemeralResources).OpenEphemeralResource(context.Background(), &tfprotov5.OpenEphemeralResourceRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedDiagnostics: []*tfprotov5.Diagnostic{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Provider Ephemeral Resource Not Implemented",
+					Detail: "A OpenEphemeralResource call was received by the provider, however the provider does not implement the RPC. " +
+						"Either upgrade the provider to a version that implements ephemeral resource support or this is always an error in the provider that should be reported to the provider developers.",
+				},
+			},
+		},
+		"StopProvider": {
+			call: func() ([]*tfprotov5.Diagnostic, error) {
+				resp, err := server.StopProvider(context.Background(), &tfprotov5.StopProviderRequest{})
+
+				if resp.Error != "" {
+					t.Errorf("unexpected StopProvider error: %s", resp.Error)
+				}
+
+				return nil, err
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags, err := testCase.call()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnimplementedProviderServerCallFunction(t *testing.T) {
+	t.Parallel()
+
+	resp, err := tfprotov5.UnimplementedProviderServer{}.CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfprotov5.CallFunctionResponse{
+		Error: &tfprotov5.FunctionError{
+			Text: "A CallFunction call was received by the provider, however the provider does not implement the RPC. " +
+				"Either upgrade the provider to a version that implements function support or this is always an error in the provider that should be reported to the provider developers.",
+		},
+	}
+
+	if diff := cmp.Diff(resp, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestUnimplementedProviderServerGetMetadata(t *testing.T) {
+	t.Parallel()
+
+	resp, err := tfprotov5.UnimplementedProviderServer{}.GetMetadata(context.Background(), &tfprotov5.GetMetadataRequest{})
+
+	if !errors.Is(err, tfprotov5.ErrUnsupportedRPC) {
+		t.Errorf("expected ErrUnsupportedRPC, got: %v", err)
+	}
+
+	if resp != nil {
+		t.Errorf("unexpected response: %v", resp)
+	}
+}
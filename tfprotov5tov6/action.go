@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ValidateActionConfigResponse returns the tfprotov6 equivalent of the
+// tfprotov5 ValidateActionConfigResponse.
+func ValidateActionConfigResponse(in *tfprotov5.ValidateActionConfigResponse) *tfprotov6.ValidateActionConfigResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ValidateActionConfigResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// InvokeActionResponse returns the tfprotov6 equivalent of the tfprotov5
+// InvokeActionResponse. Events are converted as they are yielded.
+func InvokeActionResponse(in *tfprotov5.InvokeActionResponse) *tfprotov6.InvokeActionResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.InvokeActionResponse{}
+
+	if in.Events != nil {
+		resp.Events = func(yield func(tfprotov6.InvokeActionEvent) bool) {
+			in.Events(func(event tfprotov5.InvokeActionEvent) bool {
+				return yield(InvokeActionEvent(event))
+			})
+		}
+	}
+
+	return resp
+}
+
+// InvokeActionEvent returns the tfprotov6 equivalent of the tfprotov5
+// InvokeActionEvent. Unknown event types are converted into a nil Type.
+func InvokeActionEvent(in tfprotov5.InvokeActionEvent) tfprotov6.InvokeActionEvent {
+	switch eventType := in.Type.(type) {
+	case tfprotov5.ProgressInvokeActionEventType:
+		return tfprotov6.InvokeActionEvent{
+			Type: ProgressInvokeActionEventType(eventType),
+		}
+	case tfprotov5.CompletedInvokeActionEventType:
+		return tfprotov6.InvokeActionEvent{
+			Type: CompletedInvokeActionEventType(eventType),
+		}
+	default:
+		return tfprotov6.InvokeActionEvent{}
+	}
+}
+
+// ProgressInvokeActionEventType returns the tfprotov6 equivalent of the
+// tfprotov5 ProgressInvokeActionEventType.
+func ProgressInvokeActionEventType(in tfprotov5.ProgressInvokeActionEventType) tfprotov6.ProgressInvokeActionEventType {
+	return tfprotov6.ProgressInvokeActionEventType{
+		Message: in.Message,
+	}
+}
+
+// CompletedInvokeActionEventType returns the tfprotov6 equivalent of the
+// tfprotov5 CompletedInvokeActionEventType.
+func CompletedInvokeActionEventType(in tfprotov5.CompletedInvokeActionEventType) tfprotov6.CompletedInvokeActionEventType {
+	return tfprotov6.CompletedInvokeActionEventType{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// ActionMetadata returns the tfprotov6 equivalent of the tfprotov5
+// ActionMetadata.
+func ActionMetadata(in tfprotov5.ActionMetadata) tfprotov6.ActionMetadata {
+	return tfprotov6.ActionMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+// ActionSchema returns the tfprotov6 equivalent of the tfprotov5 ActionSchema.
+func ActionSchema(in *tfprotov5.ActionSchema) *tfprotov6.ActionSchema {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ActionSchema{
+		Schema: Schema(in.Schema),
+	}
+}
+
+// ValidateActionConfigRequest returns the tfprotov6 equivalent of the tfprotov5
+// ValidateActionConfigRequest.
+func ValidateActionConfigRequest(in *tfprotov5.ValidateActionConfigRequest) *tfprotov6.ValidateActionConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ValidateActionConfigRequest{
+		ActionType: in.ActionType,
+		Config:     DynamicValue(in.Config),
+	}
+}
+
+// InvokeActionRequest returns the tfprotov6 equivalent of the tfprotov5
+// InvokeActionRequest.
+func InvokeActionRequest(in *tfprotov5.InvokeActionRequest) *tfprotov6.InvokeActionRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.InvokeActionRequest{
+		ActionType: in.ActionType,
+		Config:     DynamicValue(in.Config),
+	}
+}
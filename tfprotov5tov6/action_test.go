@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5tov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestInvokeActionResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov5.InvokeActionResponse
+		expected []tfprotov6.InvokeActionEvent
+	}{
+		"no-events": {
+			in:       &tfprotov5.InvokeActionResponse{},
+			expected: nil,
+		},
+		"events": {
+			in: &tfprotov5.InvokeActionResponse{
+				Events: func(yield func(tfprotov5.InvokeActionEvent) bool) {
+					events := []tfprotov5.InvokeActionEvent{
+						{
+							Type: tfprotov5.ProgressInvokeActionEventType{
+								Message: "test message",
+							},
+						},
+						{
+							Type: tfprotov5.CompletedInvokeActionEventType{
+								Diagnostics: []*tfprotov5.Diagnostic{
+									{
+										Severity: tfprotov5.DiagnosticSeverityError,
+										Summary:  "test summary",
+									},
+								},
+							},
+						},
+					}
+
+					for _, event := range events {
+						if !yield(event) {
+							return
+						}
+					}
+				},
+			},
+			expected: []tfprotov6.InvokeActionEvent{
+				{
+					Type: tfprotov6.ProgressInvokeActionEventType{
+						Message: "test message",
+					},
+				},
+				{
+					Type: tfprotov6.CompletedInvokeActionEventType{
+						Diagnostics: []*tfprotov6.Diagnostic{
+							{
+								Severity: tfprotov6.DiagnosticSeverityError,
+								Summary:  "test summary",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := tfprotov5tov6.InvokeActionResponse(testCase.in)
+
+			var got []tfprotov6.InvokeActionEvent
+
+			if resp.Events != nil {
+				resp.Events(func(event tfprotov6.InvokeActionEvent) bool {
+					got = append(got, event)
+
+					return true
+				})
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ConfigureProviderClientCapabilities returns the tfprotov6 equivalent of the
+// tfprotov5 ConfigureProviderClientCapabilities.
+func ConfigureProviderClientCapabilities(in *tfprotov5.ConfigureProviderClientCapabilities) *tfprotov6.ConfigureProviderClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ConfigureProviderClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// ValidateResourceConfigClientCapabilities returns the tfprotov6 equivalent of
+// the tfprotov5 ValidateResourceTypeConfigClientCapabilities.
+func ValidateResourceConfigClientCapabilities(in *tfprotov5.ValidateResourceTypeConfigClientCapabilities) *tfprotov6.ValidateResourceConfigClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ValidateResourceConfigClientCapabilities{
+		WriteOnlyAttributesAllowed: in.WriteOnlyAttributesAllowed,
+	}
+}
+
+// ReadResourceClientCapabilities returns the tfprotov6 equivalent of the
+// tfprotov5 ReadResourceClientCapabilities.
+func ReadResourceClientCapabilities(in *tfprotov5.ReadResourceClientCapabilities) *tfprotov6.ReadResourceClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ReadResourceClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// PlanResourceChangeClientCapabilities returns the tfprotov6 equivalent of the
+// tfprotov5 PlanResourceChangeClientCapabilities.
+func PlanResourceChangeClientCapabilities(in *tfprotov5.PlanResourceChangeClientCapabilities) *tfprotov6.PlanResourceChangeClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.PlanResourceChangeClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// ImportResourceStateClientCapabilities returns the tfprotov6 equivalent of the
+// tfprotov5 ImportResourceStateClientCapabilities.
+func ImportResourceStateClientCapabilities(in *tfprotov5.ImportResourceStateClientCapabilities) *tfprotov6.ImportResourceStateClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ImportResourceStateClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// ReadDataSourceClientCapabilities returns the tfprotov6 equivalent of the
+// tfprotov5 ReadDataSourceClientCapabilities.
+func ReadDataSourceClientCapabilities(in *tfprotov5.ReadDataSourceClientCapabilities) *tfprotov6.ReadDataSourceClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ReadDataSourceClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// OpenEphemeralResourceClientCapabilities returns the tfprotov6 equivalent of
+// the tfprotov5 OpenEphemeralResourceClientCapabilities.
+func OpenEphemeralResourceClientCapabilities(in *tfprotov5.OpenEphemeralResourceClientCapabilities) *tfprotov6.OpenEphemeralResourceClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.OpenEphemeralResourceClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
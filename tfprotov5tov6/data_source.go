@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ValidateDataResourceConfigResponse returns the tfprotov6 equivalent of the
+// tfprotov5 ValidateDataSourceConfigResponse.
+func ValidateDataResourceConfigResponse(in *tfprotov5.ValidateDataSourceConfigResponse) *tfprotov6.ValidateDataResourceConfigResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ValidateDataResourceConfigResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// ReadDataSourceResponse returns the tfprotov6 equivalent of the tfprotov5
+// ReadDataSourceResponse.
+func ReadDataSourceResponse(in *tfprotov5.ReadDataSourceResponse) *tfprotov6.ReadDataSourceResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ReadDataSourceResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: Diagnostics(in.Diagnostics),
+		State:       DynamicValue(in.State),
+	}
+}
+
+// DataSourceMetadata returns the tfprotov6 equivalent of the tfprotov5
+// DataSourceMetadata.
+func DataSourceMetadata(in tfprotov5.DataSourceMetadata) tfprotov6.DataSourceMetadata {
+	return tfprotov6.DataSourceMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+// ValidateDataResourceConfigRequest returns the tfprotov6 equivalent of the
+// tfprotov5 ValidateDataSourceConfigRequest.
+func ValidateDataResourceConfigRequest(in *tfprotov5.ValidateDataSourceConfigRequest) *tfprotov6.ValidateDataResourceConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ValidateDataResourceConfigRequest{
+		Config:   DynamicValue(in.Config),
+		TypeName: in.TypeName,
+	}
+}
+
+// ReadDataSourceRequest returns the tfprotov6 equivalent of the tfprotov5
+// ReadDataSourceRequest.
+func ReadDataSourceRequest(in *tfprotov5.ReadDataSourceRequest) *tfprotov6.ReadDataSourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ReadDataSourceRequest{
+		ClientCapabilities: ReadDataSourceClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		ProviderMeta:       DynamicValue(in.ProviderMeta),
+		TypeName:           in.TypeName,
+	}
+}
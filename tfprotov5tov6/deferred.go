@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// Deferred returns the tfprotov6 equivalent of the tfprotov5 Deferred.
+func Deferred(in *tfprotov5.Deferred) *tfprotov6.Deferred {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.Deferred{
+		Reason: DeferredReason(in.Reason),
+	}
+}
+
+// DeferredReason returns the tfprotov6 equivalent of the tfprotov5
+// DeferredReason.
+func DeferredReason(in tfprotov5.DeferredReason) tfprotov6.DeferredReason {
+	return tfprotov6.DeferredReason(in)
+}
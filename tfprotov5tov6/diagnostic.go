@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// Diagnostic returns the tfprotov6 equivalent of the tfprotov5 Diagnostic.
+func Diagnostic(in *tfprotov5.Diagnostic) *tfprotov6.Diagnostic {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.Diagnostic{
+		Attribute: in.Attribute,
+		Detail:    in.Detail,
+		Severity:  DiagnosticSeverity(in.Severity),
+		Summary:   in.Summary,
+	}
+}
+
+// Diagnostics returns the tfprotov6 equivalents of the tfprotov5 diagnostics.
+func Diagnostics(in []*tfprotov5.Diagnostic) []*tfprotov6.Diagnostic {
+	if in == nil {
+		return nil
+	}
+
+	diags := make([]*tfprotov6.Diagnostic, 0, len(in))
+
+	for _, diag := range in {
+		diags = append(diags, Diagnostic(diag))
+	}
+
+	return diags
+}
+
+// DiagnosticSeverity returns the tfprotov6 equivalent of the tfprotov5
+// DiagnosticSeverity.
+func DiagnosticSeverity(in tfprotov5.DiagnosticSeverity) tfprotov6.DiagnosticSeverity {
+	return tfprotov6.DiagnosticSeverity(in)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5tov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       []*tfprotov5.Diagnostic
+		expected []*tfprotov6.Diagnostic
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"diagnostics": {
+			in: []*tfprotov5.Diagnostic{
+				{
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+				},
+				nil,
+				{
+					Severity: tfprotov5.DiagnosticSeverityWarning,
+					Summary:  "test warning",
+				},
+			},
+			expected: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+				},
+				nil,
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "test warning",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfprotov5tov6.Diagnostics(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tfprotov5tov6 converts tfprotov5 types into their tfprotov6
+// equivalents, such as schemas, diagnostics, dynamic values, and RPC requests
+// and responses, for translation layers between protocol versions, such as
+// the tf5to6server and tf6to5server packages.
+//
+// Protocol version 6 is a superset of protocol version 5 for these types, so
+// conversions cannot fail. Use the tfprotov6tov5 package for the opposite
+// direction. Nil inputs return nil.
+package tfprotov5tov6
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// DynamicValue returns the tfprotov6 equivalent of the tfprotov5
+// DynamicValue. The encoded data is shared, not copied.
+func DynamicValue(in *tfprotov5.DynamicValue) *tfprotov6.DynamicValue {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.DynamicValue{
+		JSON:    in.JSON,
+		MsgPack: in.MsgPack,
+	}
+}
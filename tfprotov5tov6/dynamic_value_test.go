@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5tov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestDynamicValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov5.DynamicValue
+		expected *tfprotov6.DynamicValue
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"json": {
+			in:       &tfprotov5.DynamicValue{JSON: []byte(`{"test":true}`)},
+			expected: &tfprotov6.DynamicValue{JSON: []byte(`{"test":true}`)},
+		},
+		"msgpack": {
+			in:       &tfprotov5.DynamicValue{MsgPack: []byte{0x81, 0xa4}},
+			expected: &tfprotov6.DynamicValue{MsgPack: []byte{0x81, 0xa4}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfprotov5tov6.DynamicValue(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
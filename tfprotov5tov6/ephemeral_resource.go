@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ValidateEphemeralResourceConfigResponse returns the tfprotov6 equivalent of
+// the tfprotov5 ValidateEphemeralResourceConfigResponse.
+func ValidateEphemeralResourceConfigResponse(in *tfprotov5.ValidateEphemeralResourceConfigResponse) *tfprotov6.ValidateEphemeralResourceConfigResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ValidateEphemeralResourceConfigResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// OpenEphemeralResourceResponse returns the tfprotov6 equivalent of the
+// tfprotov5 OpenEphemeralResourceResponse.
+func OpenEphemeralResourceResponse(in *tfprotov5.OpenEphemeralResourceResponse) *tfprotov6.OpenEphemeralResourceResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.OpenEphemeralResourceResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: Diagnostics(in.Diagnostics),
+		Private:     in.Private,
+		RenewAt:     in.RenewAt,
+		Result:      DynamicValue(in.Result),
+	}
+}
+
+// RenewEphemeralResourceResponse returns the tfprotov6 equivalent of the
+// tfprotov5 RenewEphemeralResourceResponse.
+func RenewEphemeralResourceResponse(in *tfprotov5.RenewEphemeralResourceResponse) *tfprotov6.RenewEphemeralResourceResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.RenewEphemeralResourceResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+		Private:     in.Private,
+		RenewAt:     in.RenewAt,
+	}
+}
+
+// CloseEphemeralResourceResponse returns the tfprotov6 equivalent of the
+// tfprotov5 CloseEphemeralResourceResponse.
+func CloseEphemeralResourceResponse(in *tfprotov5.CloseEphemeralResourceResponse) *tfprotov6.CloseEphemeralResourceResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.CloseEphemeralResourceResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// EphemeralResourceMetadata returns the tfprotov6 equivalent of the tfprotov5
+// EphemeralResourceMetadata.
+func EphemeralResourceMetadata(in tfprotov5.EphemeralResourceMetadata) tfprotov6.EphemeralResourceMetadata {
+	return tfprotov6.EphemeralResourceMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+// ValidateEphemeralResourceConfigRequest returns the tfprotov6 equivalent of
+// the tfprotov5 ValidateEphemeralResourceConfigRequest.
+func ValidateEphemeralResourceConfigRequest(in *tfprotov5.ValidateEphemeralResourceConfigRequest) *tfprotov6.ValidateEphemeralResourceConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ValidateEphemeralResourceConfigRequest{
+		Config:   DynamicValue(in.Config),
+		TypeName: in.TypeName,
+	}
+}
+
+// OpenEphemeralResourceRequest returns the tfprotov6 equivalent of the
+// tfprotov5 OpenEphemeralResourceRequest.
+func OpenEphemeralResourceRequest(in *tfprotov5.OpenEphemeralResourceRequest) *tfprotov6.OpenEphemeralResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.OpenEphemeralResourceRequest{
+		ClientCapabilities: OpenEphemeralResourceClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TypeName:           in.TypeName,
+	}
+}
+
+// RenewEphemeralResourceRequest returns the tfprotov6 equivalent of the
+// tfprotov5 RenewEphemeralResourceRequest.
+func RenewEphemeralResourceRequest(in *tfprotov5.RenewEphemeralResourceRequest) *tfprotov6.RenewEphemeralResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.RenewEphemeralResourceRequest{
+		Private:  in.Private,
+		TypeName: in.TypeName,
+	}
+}
+
+// CloseEphemeralResourceRequest returns the tfprotov6 equivalent of the
+// tfprotov5 CloseEphemeralResourceRequest.
+func CloseEphemeralResourceRequest(in *tfprotov5.CloseEphemeralResourceRequest) *tfprotov6.CloseEphemeralResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.CloseEphemeralResourceRequest{
+		Private:  in.Private,
+		TypeName: in.TypeName,
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// CallFunctionResponse returns the tfprotov6 equivalent of the tfprotov5
+// CallFunctionResponse.
+func CallFunctionResponse(in *tfprotov5.CallFunctionResponse) *tfprotov6.CallFunctionResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.CallFunctionResponse{
+		Error:  FunctionError(in.Error),
+		Result: DynamicValue(in.Result),
+	}
+}
+
+// GetFunctionsResponse returns the tfprotov6 equivalent of the tfprotov5
+// GetFunctionsResponse.
+func GetFunctionsResponse(in *tfprotov5.GetFunctionsResponse) *tfprotov6.GetFunctionsResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.GetFunctionsResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+
+	if in.Functions != nil {
+		resp.Functions = make(map[string]*tfprotov6.Function, len(in.Functions))
+
+		for k, v := range in.Functions {
+			resp.Functions[k] = Function(v)
+		}
+	}
+
+	return resp
+}
+
+// FunctionMetadata returns the tfprotov6 equivalent of the tfprotov5
+// FunctionMetadata.
+func FunctionMetadata(in tfprotov5.FunctionMetadata) tfprotov6.FunctionMetadata {
+	return tfprotov6.FunctionMetadata{
+		Name: in.Name,
+	}
+}
+
+// Function returns the tfprotov6 equivalent of the tfprotov5 Function.
+func Function(in *tfprotov5.Function) *tfprotov6.Function {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.Function{
+		DeprecationMessage: in.DeprecationMessage,
+		Description:        in.Description,
+		DescriptionKind:    StringKind(in.DescriptionKind),
+		Return:             FunctionReturn(in.Return),
+		Summary:            in.Summary,
+		VariadicParameter:  FunctionParameter(in.VariadicParameter),
+	}
+
+	if in.Parameters != nil {
+		resp.Parameters = make([]*tfprotov6.FunctionParameter, 0, len(in.Parameters))
+
+		for _, v := range in.Parameters {
+			resp.Parameters = append(resp.Parameters, FunctionParameter(v))
+		}
+	}
+
+	return resp
+}
+
+// FunctionParameter returns the tfprotov6 equivalent of the tfprotov5
+// FunctionParameter.
+func FunctionParameter(in *tfprotov5.FunctionParameter) *tfprotov6.FunctionParameter {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.FunctionParameter{
+		AllowNullValue:     in.AllowNullValue,
+		AllowUnknownValues: in.AllowUnknownValues,
+		Description:        in.Description,
+		DescriptionKind:    StringKind(in.DescriptionKind),
+		Name:               in.Name,
+		Type:               in.Type,
+	}
+}
+
+// FunctionReturn returns the tfprotov6 equivalent of the tfprotov5
+// FunctionReturn.
+func FunctionReturn(in *tfprotov5.FunctionReturn) *tfprotov6.FunctionReturn {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.FunctionReturn{
+		Type: in.Type,
+	}
+}
+
+// CallFunctionRequest returns the tfprotov6 equivalent of the tfprotov5
+// CallFunctionRequest.
+func CallFunctionRequest(in *tfprotov5.CallFunctionRequest) *tfprotov6.CallFunctionRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.CallFunctionRequest{
+		Name: in.Name,
+	}
+
+	if in.Arguments != nil {
+		resp.Arguments = make([]*tfprotov6.DynamicValue, 0, len(in.Arguments))
+
+		for _, v := range in.Arguments {
+			resp.Arguments = append(resp.Arguments, DynamicValue(v))
+		}
+	}
+
+	return resp
+}
+
+// GetFunctionsRequest returns the tfprotov6 equivalent of the tfprotov5
+// GetFunctionsRequest.
+func GetFunctionsRequest(in *tfprotov5.GetFunctionsRequest) *tfprotov6.GetFunctionsRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.GetFunctionsRequest{}
+}
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// FunctionError returns the tfprotov6 equivalent of the tfprotov5
+// FunctionError.
+func FunctionError(in *tfprotov5.FunctionError) *tfprotov6.FunctionError {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.FunctionError{
+		FunctionArgument: in.FunctionArgument,
+		Text:             in.Text,
+	}
+}
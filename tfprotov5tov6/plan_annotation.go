@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// PlanAnnotation returns the tfprotov6 equivalent of the tfprotov5
+// PlanAnnotation.
+func PlanAnnotation(in *tfprotov5.PlanAnnotation) *tfprotov6.PlanAnnotation {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.PlanAnnotation{
+		Attribute: in.Attribute,
+		Kind:      PlanAnnotationKind(in.Kind),
+		Reason:    in.Reason,
+	}
+}
+
+// PlanAnnotationKind returns the tfprotov6 equivalent of the tfprotov5
+// PlanAnnotationKind.
+func PlanAnnotationKind(in tfprotov5.PlanAnnotationKind) tfprotov6.PlanAnnotationKind {
+	return tfprotov6.PlanAnnotationKind(in)
+}
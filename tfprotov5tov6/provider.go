@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// GetMetadataResponse returns the tfprotov6 equivalent of the tfprotov5
+// GetMetadataResponse.
+func GetMetadataResponse(in *tfprotov5.GetMetadataResponse) *tfprotov6.GetMetadataResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.GetMetadataResponse{
+		Diagnostics:        Diagnostics(in.Diagnostics),
+		ServerCapabilities: ServerCapabilities(in.ServerCapabilities),
+	}
+
+	if in.Actions != nil {
+		resp.Actions = make([]tfprotov6.ActionMetadata, 0, len(in.Actions))
+
+		for _, v := range in.Actions {
+			resp.Actions = append(resp.Actions, ActionMetadata(v))
+		}
+	}
+
+	if in.DataSources != nil {
+		resp.DataSources = make([]tfprotov6.DataSourceMetadata, 0, len(in.DataSources))
+
+		for _, v := range in.DataSources {
+			resp.DataSources = append(resp.DataSources, DataSourceMetadata(v))
+		}
+	}
+
+	if in.EphemeralResources != nil {
+		resp.EphemeralResources = make([]tfprotov6.EphemeralResourceMetadata, 0, len(in.EphemeralResources))
+
+		for _, v := range in.EphemeralResources {
+			resp.EphemeralResources = append(resp.EphemeralResources, EphemeralResourceMetadata(v))
+		}
+	}
+
+	if in.Functions != nil {
+		resp.Functions = make([]tfprotov6.FunctionMetadata, 0, len(in.Functions))
+
+		for _, v := range in.Functions {
+			resp.Functions = append(resp.Functions, FunctionMetadata(v))
+		}
+	}
+
+	if in.Resources != nil {
+		resp.Resources = make([]tfprotov6.ResourceMetadata, 0, len(in.Resources))
+
+		for _, v := range in.Resources {
+			resp.Resources = append(resp.Resources, ResourceMetadata(v))
+		}
+	}
+
+	return resp
+}
+
+// GetProviderSchemaResponse returns the tfprotov6 equivalent of the tfprotov5
+// GetProviderSchemaResponse.
+func GetProviderSchemaResponse(in *tfprotov5.GetProviderSchemaResponse) *tfprotov6.GetProviderSchemaResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.GetProviderSchemaResponse{
+		Diagnostics:        Diagnostics(in.Diagnostics),
+		Provider:           Schema(in.Provider),
+		ProviderMeta:       Schema(in.ProviderMeta),
+		ServerCapabilities: ServerCapabilities(in.ServerCapabilities),
+	}
+
+	if in.ResourceSchemas != nil {
+		resp.ResourceSchemas = make(map[string]*tfprotov6.Schema, len(in.ResourceSchemas))
+
+		for k, v := range in.ResourceSchemas {
+			resp.ResourceSchemas[k] = Schema(v)
+		}
+	}
+
+	if in.DataSourceSchemas != nil {
+		resp.DataSourceSchemas = make(map[string]*tfprotov6.Schema, len(in.DataSourceSchemas))
+
+		for k, v := range in.DataSourceSchemas {
+			resp.DataSourceSchemas[k] = Schema(v)
+		}
+	}
+
+	if in.EphemeralResourceSchemas != nil {
+		resp.EphemeralResourceSchemas = make(map[string]*tfprotov6.Schema, len(in.EphemeralResourceSchemas))
+
+		for k, v := range in.EphemeralResourceSchemas {
+			resp.EphemeralResourceSchemas[k] = Schema(v)
+		}
+	}
+
+	if in.ActionSchemas != nil {
+		resp.ActionSchemas = make(map[string]*tfprotov6.ActionSchema, len(in.ActionSchemas))
+
+		for k, v := range in.ActionSchemas {
+			resp.ActionSchemas[k] = ActionSchema(v)
+		}
+	}
+
+	if in.Functions != nil {
+		resp.Functions = make(map[string]*tfprotov6.Function, len(in.Functions))
+
+		for k, v := range in.Functions {
+			resp.Functions[k] = Function(v)
+		}
+	}
+
+	return resp
+}
+
+// ValidateProviderConfigResponse returns the tfprotov6 equivalent of the
+// tfprotov5 PrepareProviderConfigResponse.
+func ValidateProviderConfigResponse(in *tfprotov5.PrepareProviderConfigResponse) *tfprotov6.ValidateProviderConfigResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ValidateProviderConfigResponse{
+		Diagnostics:    Diagnostics(in.Diagnostics),
+		PreparedConfig: DynamicValue(in.PreparedConfig),
+	}
+}
+
+// ConfigureProviderResponse returns the tfprotov6 equivalent of the tfprotov5
+// ConfigureProviderResponse.
+func ConfigureProviderResponse(in *tfprotov5.ConfigureProviderResponse) *tfprotov6.ConfigureProviderResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ConfigureProviderResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// StopProviderResponse returns the tfprotov6 equivalent of the tfprotov5
+// StopProviderResponse.
+func StopProviderResponse(in *tfprotov5.StopProviderResponse) *tfprotov6.StopProviderResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.StopProviderResponse{
+		Error: in.Error,
+	}
+}
+
+// GetMetadataRequest returns the tfprotov6 equivalent of the tfprotov5
+// GetMetadataRequest.
+func GetMetadataRequest(in *tfprotov5.GetMetadataRequest) *tfprotov6.GetMetadataRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.GetMetadataRequest{}
+}
+
+// GetProviderSchemaRequest returns the tfprotov6 equivalent of the tfprotov5
+// GetProviderSchemaRequest.
+func GetProviderSchemaRequest(in *tfprotov5.GetProviderSchemaRequest) *tfprotov6.GetProviderSchemaRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.GetProviderSchemaRequest{}
+}
+
+// ValidateProviderConfigRequest returns the tfprotov6 equivalent of the
+// tfprotov5 PrepareProviderConfigRequest.
+func ValidateProviderConfigRequest(in *tfprotov5.PrepareProviderConfigRequest) *tfprotov6.ValidateProviderConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ValidateProviderConfigRequest{
+		Config: DynamicValue(in.Config),
+	}
+}
+
+// ConfigureProviderRequest returns the tfprotov6 equivalent of the tfprotov5
+// ConfigureProviderRequest.
+func ConfigureProviderRequest(in *tfprotov5.ConfigureProviderRequest) *tfprotov6.ConfigureProviderRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ConfigureProviderRequest{
+		ClientCapabilities: ConfigureProviderClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TerraformVersion:   in.TerraformVersion,
+	}
+}
+
+// StopProviderRequest returns the tfprotov6 equivalent of the tfprotov5
+// StopProviderRequest.
+func StopProviderRequest(in *tfprotov5.StopProviderRequest) *tfprotov6.StopProviderRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.StopProviderRequest{}
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ValidateResourceConfigResponse returns the tfprotov6 equivalent of the
+// tfprotov5 ValidateResourceTypeConfigResponse.
+func ValidateResourceConfigResponse(in *tfprotov5.ValidateResourceTypeConfigResponse) *tfprotov6.ValidateResourceConfigResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ValidateResourceConfigResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// UpgradeResourceStateResponse returns the tfprotov6 equivalent of the
+// tfprotov5 UpgradeResourceStateResponse.
+func UpgradeResourceStateResponse(in *tfprotov5.UpgradeResourceStateResponse) *tfprotov6.UpgradeResourceStateResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.UpgradeResourceStateResponse{
+		Diagnostics:   Diagnostics(in.Diagnostics),
+		UpgradedState: DynamicValue(in.UpgradedState),
+	}
+}
+
+// ReadResourceResponse returns the tfprotov6 equivalent of the tfprotov5
+// ReadResourceResponse.
+func ReadResourceResponse(in *tfprotov5.ReadResourceResponse) *tfprotov6.ReadResourceResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ReadResourceResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: Diagnostics(in.Diagnostics),
+		NewState:    DynamicValue(in.NewState),
+		Private:     in.Private,
+	}
+}
+
+// PlanResourceChangeResponse returns the tfprotov6 equivalent of the tfprotov5
+// PlanResourceChangeResponse.
+func PlanResourceChangeResponse(in *tfprotov5.PlanResourceChangeResponse) *tfprotov6.PlanResourceChangeResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.PlanResourceChangeResponse{
+		Deferred:                    Deferred(in.Deferred),
+		Diagnostics:                 Diagnostics(in.Diagnostics),
+		PlannedPrivate:              in.PlannedPrivate,
+		PlannedState:                DynamicValue(in.PlannedState),
+		RequiresReplace:             in.RequiresReplace,
+		UnsafeToUseLegacyTypeSystem: in.UnsafeToUseLegacyTypeSystem,
+	}
+
+	if in.Annotations != nil {
+		resp.Annotations = make([]*tfprotov6.PlanAnnotation, 0, len(in.Annotations))
+
+		for _, v := range in.Annotations {
+			resp.Annotations = append(resp.Annotations, PlanAnnotation(v))
+		}
+	}
+
+	return resp
+}
+
+// ApplyResourceChangeResponse returns the tfprotov6 equivalent of the tfprotov5
+// ApplyResourceChangeResponse.
+func ApplyResourceChangeResponse(in *tfprotov5.ApplyResourceChangeResponse) *tfprotov6.ApplyResourceChangeResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ApplyResourceChangeResponse{
+		Diagnostics:                 Diagnostics(in.Diagnostics),
+		NewState:                    DynamicValue(in.NewState),
+		Private:                     in.Private,
+		UnsafeToUseLegacyTypeSystem: in.UnsafeToUseLegacyTypeSystem,
+	}
+}
+
+// ImportResourceStateResponse returns the tfprotov6 equivalent of the tfprotov5
+// ImportResourceStateResponse.
+func ImportResourceStateResponse(in *tfprotov5.ImportResourceStateResponse) *tfprotov6.ImportResourceStateResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.ImportResourceStateResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+
+	if in.ImportedResources != nil {
+		resp.ImportedResources = make([]*tfprotov6.ImportedResource, 0, len(in.ImportedResources))
+
+		for _, v := range in.ImportedResources {
+			resp.ImportedResources = append(resp.ImportedResources, ImportedResource(v))
+		}
+	}
+
+	return resp
+}
+
+// MoveResourceStateResponse returns the tfprotov6 equivalent of the tfprotov5
+// MoveResourceStateResponse.
+func MoveResourceStateResponse(in *tfprotov5.MoveResourceStateResponse) *tfprotov6.MoveResourceStateResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.MoveResourceStateResponse{
+		Diagnostics:   Diagnostics(in.Diagnostics),
+		TargetPrivate: in.TargetPrivate,
+		TargetState:   DynamicValue(in.TargetState),
+	}
+}
+
+// ResourceMetadata returns the tfprotov6 equivalent of the tfprotov5
+// ResourceMetadata.
+func ResourceMetadata(in tfprotov5.ResourceMetadata) tfprotov6.ResourceMetadata {
+	return tfprotov6.ResourceMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+// ImportedResource returns the tfprotov6 equivalent of the tfprotov5
+// ImportedResource.
+func ImportedResource(in *tfprotov5.ImportedResource) *tfprotov6.ImportedResource {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ImportedResource{
+		Private:  in.Private,
+		State:    DynamicValue(in.State),
+		TypeName: in.TypeName,
+	}
+}
+
+// ValidateResourceConfigRequest returns the tfprotov6 equivalent of the
+// tfprotov5 ValidateResourceTypeConfigRequest.
+func ValidateResourceConfigRequest(in *tfprotov5.ValidateResourceTypeConfigRequest) *tfprotov6.ValidateResourceConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ValidateResourceConfigRequest{
+		ClientCapabilities: ValidateResourceConfigClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TypeName:           in.TypeName,
+	}
+}
+
+// UpgradeResourceStateRequest returns the tfprotov6 equivalent of the tfprotov5
+// UpgradeResourceStateRequest.
+func UpgradeResourceStateRequest(in *tfprotov5.UpgradeResourceStateRequest) *tfprotov6.UpgradeResourceStateRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.UpgradeResourceStateRequest{
+		RawState: RawState(in.RawState),
+		TypeName: in.TypeName,
+		Version:  in.Version,
+	}
+}
+
+// ReadResourceRequest returns the tfprotov6 equivalent of the tfprotov5
+// ReadResourceRequest.
+func ReadResourceRequest(in *tfprotov5.ReadResourceRequest) *tfprotov6.ReadResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ReadResourceRequest{
+		ClientCapabilities: ReadResourceClientCapabilities(in.ClientCapabilities),
+		CurrentState:       DynamicValue(in.CurrentState),
+		Private:            in.Private,
+		ProviderMeta:       DynamicValue(in.ProviderMeta),
+		TypeName:           in.TypeName,
+	}
+}
+
+// PlanResourceChangeRequest returns the tfprotov6 equivalent of the tfprotov5
+// PlanResourceChangeRequest.
+func PlanResourceChangeRequest(in *tfprotov5.PlanResourceChangeRequest) *tfprotov6.PlanResourceChangeRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.PlanResourceChangeRequest{
+		ClientCapabilities: PlanResourceChangeClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		PriorPrivate:       in.PriorPrivate,
+		PriorState:         DynamicValue(in.PriorState),
+		ProposedNewState:   DynamicValue(in.ProposedNewState),
+		ProviderMeta:       DynamicValue(in.ProviderMeta),
+		TypeName:           in.TypeName,
+	}
+}
+
+// ApplyResourceChangeRequest returns the tfprotov6 equivalent of the tfprotov5
+// ApplyResourceChangeRequest.
+func ApplyResourceChangeRequest(in *tfprotov5.ApplyResourceChangeRequest) *tfprotov6.ApplyResourceChangeRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ApplyResourceChangeRequest{
+		Config:         DynamicValue(in.Config),
+		PlannedPrivate: in.PlannedPrivate,
+		PlannedState:   DynamicValue(in.PlannedState),
+		PriorState:     DynamicValue(in.PriorState),
+		ProviderMeta:   DynamicValue(in.ProviderMeta),
+		TypeName:       in.TypeName,
+	}
+}
+
+// ImportResourceStateRequest returns the tfprotov6 equivalent of the tfprotov5
+// ImportResourceStateRequest.
+func ImportResourceStateRequest(in *tfprotov5.ImportResourceStateRequest) *tfprotov6.ImportResourceStateRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.ImportResourceStateRequest{
+		ClientCapabilities: ImportResourceStateClientCapabilities(in.ClientCapabilities),
+		ID:                 in.ID,
+		TypeName:           in.TypeName,
+	}
+}
+
+// MoveResourceStateRequest returns the tfprotov6 equivalent of the tfprotov5
+// MoveResourceStateRequest.
+func MoveResourceStateRequest(in *tfprotov5.MoveResourceStateRequest) *tfprotov6.MoveResourceStateRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.MoveResourceStateRequest{
+		SourcePrivate:         in.SourcePrivate,
+		SourceProviderAddress: in.SourceProviderAddress,
+		SourceSchemaVersion:   in.SourceSchemaVersion,
+		SourceState:           RawState(in.SourceState),
+		SourceTypeName:        in.SourceTypeName,
+		TargetTypeName:        in.TargetTypeName,
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5tov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestReadResourceResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov5.ReadResourceResponse
+		expected *tfprotov6.ReadResourceResponse
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"all-fields": {
+			in: &tfprotov5.ReadResourceResponse{
+				Deferred: &tfprotov5.Deferred{
+					Reason: tfprotov5.DeferredReasonResourceConfigUnknown,
+				},
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity:  tfprotov5.DiagnosticSeverityWarning,
+						Summary:   "test summary",
+						Detail:    "test detail",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					},
+				},
+				NewState: &tfprotov5.DynamicValue{JSON: []byte(`{"test":true}`)},
+				Private:  []byte(`{}`),
+			},
+			expected: &tfprotov6.ReadResourceResponse{
+				Deferred: &tfprotov6.Deferred{
+					Reason: tfprotov6.DeferredReasonResourceConfigUnknown,
+				},
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity:  tfprotov6.DiagnosticSeverityWarning,
+						Summary:   "test summary",
+						Detail:    "test detail",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					},
+				},
+				NewState: &tfprotov6.DynamicValue{JSON: []byte(`{"test":true}`)},
+				Private:  []byte(`{}`),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfprotov5tov6.ReadResourceResponse(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReadResourceRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov5.ReadResourceRequest
+		expected *tfprotov6.ReadResourceRequest
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"all-fields": {
+			in: &tfprotov5.ReadResourceRequest{
+				ClientCapabilities: &tfprotov5.ReadResourceClientCapabilities{
+					DeferralAllowed: true,
+				},
+				CurrentState: &tfprotov5.DynamicValue{JSON: []byte(`{"test":true}`)},
+				Private:      []byte(`{}`),
+				ProviderMeta: &tfprotov5.DynamicValue{JSON: []byte(`{}`)},
+				TypeName:     "test_resource",
+			},
+			expected: &tfprotov6.ReadResourceRequest{
+				ClientCapabilities: &tfprotov6.ReadResourceClientCapabilities{
+					DeferralAllowed: true,
+				},
+				CurrentState: &tfprotov6.DynamicValue{JSON: []byte(`{"test":true}`)},
+				Private:      []byte(`{}`),
+				ProviderMeta: &tfprotov6.DynamicValue{JSON: []byte(`{}`)},
+				TypeName:     "test_resource",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfprotov5tov6.ReadResourceRequest(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
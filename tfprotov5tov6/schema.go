@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// Schema returns the tfprotov6 equivalent of the tfprotov5 Schema.
+func Schema(in *tfprotov5.Schema) *tfprotov6.Schema {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.Schema{
+		Block:   SchemaBlock(in.Block),
+		Version: in.Version,
+	}
+}
+
+// SchemaBlock returns the tfprotov6 equivalent of the tfprotov5 SchemaBlock.
+func SchemaBlock(in *tfprotov5.SchemaBlock) *tfprotov6.SchemaBlock {
+	if in == nil {
+		return nil
+	}
+
+	block := &tfprotov6.SchemaBlock{
+		Deprecated:      in.Deprecated,
+		Description:     in.Description,
+		DescriptionKind: StringKind(in.DescriptionKind),
+		Version:         in.Version,
+	}
+
+	for _, attribute := range in.Attributes {
+		block.Attributes = append(block.Attributes, SchemaAttribute(attribute))
+	}
+
+	for _, blockType := range in.BlockTypes {
+		block.BlockTypes = append(block.BlockTypes, SchemaNestedBlock(blockType))
+	}
+
+	return block
+}
+
+// SchemaAttribute returns the tfprotov6 equivalent of the tfprotov5
+// SchemaAttribute.
+func SchemaAttribute(in *tfprotov5.SchemaAttribute) *tfprotov6.SchemaAttribute {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.SchemaAttribute{
+		Computed:        in.Computed,
+		Deprecated:      in.Deprecated,
+		Description:     in.Description,
+		DescriptionKind: StringKind(in.DescriptionKind),
+		Name:            in.Name,
+		Optional:        in.Optional,
+		Required:        in.Required,
+		Sensitive:       in.Sensitive,
+		Type:            in.Type,
+	}
+}
+
+// SchemaNestedBlock returns the tfprotov6 equivalent of the tfprotov5
+// SchemaNestedBlock.
+func SchemaNestedBlock(in *tfprotov5.SchemaNestedBlock) *tfprotov6.SchemaNestedBlock {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.SchemaNestedBlock{
+		Block:    SchemaBlock(in.Block),
+		MaxItems: in.MaxItems,
+		MinItems: in.MinItems,
+		Nesting:  SchemaNestedBlockNestingMode(in.Nesting),
+		TypeName: in.TypeName,
+	}
+}
+
+// SchemaNestedBlockNestingMode returns the tfprotov6 equivalent of the
+// tfprotov5 SchemaNestedBlockNestingMode.
+func SchemaNestedBlockNestingMode(in tfprotov5.SchemaNestedBlockNestingMode) tfprotov6.SchemaNestedBlockNestingMode {
+	return tfprotov6.SchemaNestedBlockNestingMode(in)
+}
+
+// StringKind returns the tfprotov6 equivalent of the tfprotov5 StringKind.
+func StringKind(in tfprotov5.StringKind) tfprotov6.StringKind {
+	return tfprotov6.StringKind(in)
+}
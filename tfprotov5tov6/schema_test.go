@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5tov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov5.Schema
+		expected *tfprotov6.Schema
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"all-fields": {
+			in: &tfprotov5.Schema{
+				Version: 1,
+				Block: &tfprotov5.SchemaBlock{
+					Version:         2,
+					Description:     "test block",
+					DescriptionKind: tfprotov5.StringKindMarkdown,
+					Deprecated:      true,
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:            "test_attribute",
+							Type:            tftypes.List{ElementType: tftypes.String},
+							Description:     "test attribute",
+							DescriptionKind: tfprotov5.StringKindMarkdown,
+							Required:        true,
+							Sensitive:       true,
+							Deprecated:      true,
+						},
+						nil,
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							TypeName: "test_block",
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+							MinItems: 1,
+							MaxItems: 2,
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:     "nested_attribute",
+										Type:     tftypes.Number,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.Schema{
+				Version: 1,
+				Block: &tfprotov6.SchemaBlock{
+					Version:         2,
+					Description:     "test block",
+					DescriptionKind: tfprotov6.StringKindMarkdown,
+					Deprecated:      true,
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:            "test_attribute",
+							Type:            tftypes.List{ElementType: tftypes.String},
+							Description:     "test attribute",
+							DescriptionKind: tfprotov6.StringKindMarkdown,
+							Required:        true,
+							Sensitive:       true,
+							Deprecated:      true,
+						},
+						nil,
+					},
+					BlockTypes: []*tfprotov6.SchemaNestedBlock{
+						{
+							TypeName: "test_block",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+							MinItems: 1,
+							MaxItems: 2,
+							Block: &tfprotov6.SchemaBlock{
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{
+										Name:     "nested_attribute",
+										Type:     tftypes.Number,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfprotov5tov6.Schema(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov5tov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// RawState returns the tfprotov6 equivalent of the tfprotov5 RawState.
+func RawState(in *tfprotov5.RawState) *tfprotov6.RawState {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.RawState{
+		Flatmap: in.Flatmap,
+		JSON:    in.JSON,
+	}
+}
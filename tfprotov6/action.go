@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6
+
+import (
+	"context"
+)
+
+// ActionMetadata describes metadata for an action in the GetMetadata RPC.
+type ActionMetadata struct {
+	// TypeName is the name of the action.
+	TypeName string
+}
+
+// ActionSchema is the schema of an action in the GetProviderSchema RPC.
+type ActionSchema struct {
+	// Schema is the configuration schema of the action.
+	Schema *Schema
+}
+
+// ActionServer is an interface containing the methods an action
+// implementation needs to fill.
+//
+// Actions are imperative operations, such as restarting a server or
+// invalidating a cache, which Terraform invokes outside of the managed
+// resource lifecycle.
+type ActionServer interface {
+	// ValidateActionConfig is called when Terraform is checking that an
+	// action's configuration is valid. It is guaranteed to have types
+	// conforming to your schema, but it is not guaranteed that all values
+	// will be known. This is your opportunity to do custom or advanced
+	// validation prior to the action being invoked.
+	ValidateActionConfig(context.Context, *ValidateActionConfigRequest) (*ValidateActionConfigResponse, error)
+
+	// InvokeAction is called when Terraform wants to run an action. The
+	// action runs while Terraform consumes the Events of the response,
+	// which report its progress and completion.
+	InvokeAction(context.Context, *InvokeActionRequest) (*InvokeActionResponse, error)
+}
+
+// ValidateActionConfigRequest is the request Terraform sends when it wants
+// to validate an action's configuration.
+type ValidateActionConfigRequest struct {
+	// ActionType is the type of action Terraform is validating.
+	ActionType string
+
+	// Config is the configuration the user supplied for that action. See
+	// the documentation on `DynamicValue` for more information about
+	// safely accessing the configuration.
+	//
+	// The configuration is represented as a tftypes.Object, with each
+	// attribute and nested block getting its own key and value.
+	//
+	// This configuration may contain unknown values if a user uses
+	// interpolation or other functionality that would prevent Terraform
+	// from knowing the value at request time.
+	Config *DynamicValue
+}
+
+// ValidateActionConfigResponse is the response from the provider about the
+// validity of an action's configuration.
+type ValidateActionConfigResponse struct {
+	// Diagnostics report errors or warnings related to the given
+	// configuration. Returning an empty slice indicates a successful
+	// validation with no warnings or errors generated.
+	Diagnostics []*Diagnostic
+}
+
+// InvokeActionRequest is the request Terraform sends when it wants to run an
+// action.
+type InvokeActionRequest struct {
+	// ActionType is the type of action Terraform is invoking.
+	ActionType string
+
+	// Config is the configuration the user supplied for that action. See
+	// the documentation on `DynamicValue` for information about safely
+	// accessing the configuration.
+	//
+	// The configuration is represented as a tftypes.Object, with each
+	// attribute and nested block getting its own key and value.
+	Config *DynamicValue
+}
+
+// InvokeActionResponse is the response from the provider when running an
+// action.
+type InvokeActionResponse struct {
+	// Events is an iterator of the events of the action, which is
+	// compatible with iter.Seq[InvokeActionEvent]. The action should yield
+	// any ProgressInvokeActionEventType events while it runs, followed by a
+	// single CompletedInvokeActionEventType event, and stop yielding events
+	// when yield returns false, such as when Terraform has stopped reading
+	// events.
+	//
+	// The server sends a completed event without diagnostics if Events is
+	// nil or does not yield a completed event.
+	Events func(yield func(InvokeActionEvent) bool)
+}
+
+// InvokeActionEvent is an event sent to Terraform while running an action.
+type InvokeActionEvent struct {
+	// Type is the type of the event, which is either
+	// ProgressInvokeActionEventType or CompletedInvokeActionEventType.
+	Type InvokeActionEventType
+}
+
+// InvokeActionEventType is the type of an InvokeActionEvent. It is
+// implemented by ProgressInvokeActionEventType and
+// CompletedInvokeActionEventType.
+type InvokeActionEventType interface {
+	isInvokeActionEventType()
+}
+
+var (
+	_ InvokeActionEventType = ProgressInvokeActionEventType{}
+	_ InvokeActionEventType = CompletedInvokeActionEventType{}
+)
+
+// ProgressInvokeActionEventType is an InvokeActionEvent type which reports
+// the progress of a running action to Terraform.
+type ProgressInvokeActionEventType struct {
+	// Message describes the progress of the action and is displayed to
+	// practitioners.
+	Message string
+}
+
+func (e ProgressInvokeActionEventType) isInvokeActionEventType() {}
+
+// CompletedInvokeActionEventType is an InvokeActionEvent type which reports
+// the completion of an action to Terraform. It must be the last event of the
+// action.
+type CompletedInvokeActionEventType struct {
+	// Diagnostics report errors or warnings related to running the action.
+	// Returning an empty slice indicates a successful action with no
+	// warnings or errors generated.
+	Diagnostics []*Diagnostic
+}
+
+func (e CompletedInvokeActionEventType) isInvokeActionEventType() {}
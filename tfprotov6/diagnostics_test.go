@@ -0,0 +1,437 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDiagnosticsAppend(t *testing.T) {
+	t.Parallel()
+
+	diag := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "test summary",
+	}
+
+	var got tfprotov6.Diagnostics
+
+	got.Append(diag, nil)
+	got.Append()
+	got.Append(diag)
+
+	expected := tfprotov6.Diagnostics{diag, diag}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestDiagnosticsQueries(t *testing.T) {
+	t.Parallel()
+
+	errorDiag := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "error summary",
+	}
+	warningDiag := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+		Summary:  "warning summary",
+	}
+
+	testCases := map[string]struct {
+		diags            tfprotov6.Diagnostics
+		expectedErrors   tfprotov6.Diagnostics
+		expectedWarnings tfprotov6.Diagnostics
+	}{
+		"nil": {
+			diags: nil,
+		},
+		"nil-diagnostic": {
+			diags: tfprotov6.Diagnostics{nil},
+		},
+		"errors": {
+			diags:          tfprotov6.Diagnostics{errorDiag, errorDiag},
+			expectedErrors: tfprotov6.Diagnostics{errorDiag, errorDiag},
+		},
+		"warnings": {
+			diags:            tfprotov6.Diagnostics{warningDiag},
+			expectedWarnings: tfprotov6.Diagnostics{warningDiag},
+		},
+		"mixed": {
+			diags:            tfprotov6.Diagnostics{warningDiag, nil, errorDiag},
+			expectedErrors:   tfprotov6.Diagnostics{errorDiag},
+			expectedWarnings: tfprotov6.Diagnostics{warningDiag},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.diags.Errors(), testCase.expectedErrors); diff != "" {
+				t.Errorf("unexpected Errors difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.diags.Warnings(), testCase.expectedWarnings); diff != "" {
+				t.Errorf("unexpected Warnings difference: %s", diff)
+			}
+
+			if got, expected := testCase.diags.HasErrors(), len(testCase.expectedErrors) > 0; got != expected {
+				t.Errorf("expected HasErrors %t, got %t", expected, got)
+			}
+
+			if got, expected := testCase.diags.Count(tfprotov6.DiagnosticSeverityError), len(testCase.expectedErrors); got != expected {
+				t.Errorf("expected %d errors, got %d", expected, got)
+			}
+
+			if got, expected := testCase.diags.Count(tfprotov6.DiagnosticSeverityWarning), len(testCase.expectedWarnings); got != expected {
+				t.Errorf("expected %d warnings, got %d", expected, got)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    tfprotov6.Diagnostics
+		expected string
+	}{
+		"nil": {
+			diags: nil,
+		},
+		"warnings": {
+			diags: tfprotov6.Diagnostics{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "warning summary",
+				},
+			},
+		},
+		"summary": {
+			diags: tfprotov6.Diagnostics{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "error summary",
+				},
+			},
+			expected: "error summary",
+		},
+		"detail-and-attribute": {
+			diags: tfprotov6.Diagnostics{
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					Detail:    "error detail",
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "error summary",
+				},
+			},
+			expected: `AttributeName("test"): error summary: error detail`,
+		},
+		"multiple": {
+			diags: tfprotov6.Diagnostics{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "first summary",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "warning summary",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "second summary",
+				},
+			},
+			expected: "first summary\nsecond summary",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.diags.Err()
+
+			if testCase.expected == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expected)
+			}
+
+			if err.Error() != testCase.expected {
+				t.Errorf("expected error %q, got %q", testCase.expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestMergeDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	diag1 := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "test summary 1",
+	}
+	diag2 := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+		Summary:  "test summary 2",
+	}
+
+	got := tfprotov6.MergeDiagnostics([]*tfprotov6.Diagnostic{diag1, nil}, nil, tfprotov6.Diagnostics{diag2, diag1})
+	expected := tfprotov6.Diagnostics{diag1, diag2, diag1}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if got := tfprotov6.MergeDiagnostics(); got != nil {
+		t.Errorf("expected nil, got: %v", got)
+	}
+}
+
+func TestDiagnosticsDeduplicate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    tfprotov6.Diagnostics
+		expected tfprotov6.Diagnostics
+	}{
+		"nil": {
+			diags:    nil,
+			expected: nil,
+		},
+		"duplicates": {
+			diags: tfprotov6.Diagnostics{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+				nil,
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath(),
+				},
+			},
+			expected: tfprotov6.Diagnostics{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+			},
+		},
+		"differences": {
+			diags: tfprotov6.Diagnostics{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "other summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "other detail",
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
+				},
+			},
+			expected: tfprotov6.Diagnostics{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "other summary",
+					Detail:   "test detail",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "other detail",
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "test summary",
+					Detail:    "test detail",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.diags.Deduplicate()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsTruncateDetails(t *testing.T) {
+	t.Parallel()
+
+	diag := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "test summary",
+		Detail:   "ünïcode detail",
+	}
+
+	testCases := map[string]struct {
+		maxLength int
+		expected  tfprotov6.Diagnostics
+	}{
+		"under": {
+			maxLength: 20,
+			expected:  tfprotov6.Diagnostics{diag},
+		},
+		"equal": {
+			maxLength: 14,
+			expected:  tfprotov6.Diagnostics{diag},
+		},
+		"over": {
+			maxLength: 7,
+			expected: tfprotov6.Diagnostics{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "ünïcode... (truncated)",
+				},
+			},
+		},
+		"zero": {
+			maxLength: 0,
+			expected: tfprotov6.Diagnostics{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "... (truncated)",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfprotov6.Diagnostics{diag, nil}.TruncateDetails(testCase.maxLength)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diag.Detail != "ünïcode detail" {
+				t.Errorf("original diagnostic was modified: %s", diag.Detail)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsFromAttributePathErrors(t *testing.T) {
+	t.Parallel()
+
+	var errs tftypes.AttributePathErrors
+
+	errs.Append(
+		tftypes.NewAttributePath().WithAttributeName("test").NewErrorf("attribute error"),
+		errors.New("value error"),
+	)
+
+	expected := tfprotov6.Diagnostics{
+		{
+			Severity:  tfprotov6.DiagnosticSeverityError,
+			Summary:   "attribute error",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+		},
+		{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "value error",
+		},
+	}
+
+	got := tfprotov6.DiagnosticsFromAttributePathErrors(errs)
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if got := tfprotov6.DiagnosticsFromAttributePathErrors(nil); got != nil {
+		t.Errorf("expected no diagnostics, got: %v", got)
+	}
+}
@@ -31,14 +31,147 @@ var ErrUnknownDynamicValueType = errors.New("DynamicValue had no JSON or msgpack
 // specify the tftype.Type you want to send the value as, and it must be a type
 // that is compatible with the Type of the Value. Usually it should just be the
 // Type of the Value, but it can also be the DynamicPseudoType.
+//
+// The DynamicValue contains MessagePack data. Use NewDynamicValueWithOpts to
+// select a different encoding.
 func NewDynamicValue(t tftypes.Type, v tftypes.Value) (DynamicValue, error) {
-	b, err := v.MarshalMsgPack(t) //nolint:staticcheck
+	return NewDynamicValueWithOpts(t, v, DynamicValueOpts{})
+}
+
+// DynamicValueEncoding is the encoding of the data in a DynamicValue.
+type DynamicValueEncoding int32
+
+const (
+	// DynamicValueEncodingMsgPack indicates the DynamicValue should only
+	// contain MessagePack data. It is the default encoding.
+	DynamicValueEncodingMsgPack DynamicValueEncoding = 0
+
+	// DynamicValueEncodingJSON indicates the DynamicValue should only contain
+	// JSON data. Unknown values cannot be encoded as JSON.
+	DynamicValueEncodingJSON DynamicValueEncoding = 1
+
+	// DynamicValueEncodingAll indicates the DynamicValue should contain both
+	// MessagePack and JSON data, for tooling which only reads one of them.
+	// Unknown values cannot be encoded as JSON.
+	DynamicValueEncodingAll DynamicValueEncoding = 2
+)
+
+func (e DynamicValueEncoding) String() string {
+	switch e {
+	case 0:
+		return "MSGPACK"
+	case 1:
+		return "JSON"
+	case 2:
+		return "ALL"
+	}
+	return "UNKNOWN"
+}
+
+// DynamicValueOpts contains options that can be used to modify the behaviour
+// when creating a DynamicValue.
+type DynamicValueOpts struct {
+	// Encoding is the encoding of the DynamicValue data, which defaults to
+	// MessagePack.
+	Encoding DynamicValueEncoding
+
+	// PresizeMsgPack encodes the MessagePack data twice, first to determine
+	// its exact size and then into memory of that size, instead of growing
+	// the memory as the data is encoded. This reduces peak memory usage for
+	// very large values, such as multi-megabyte resource state, at the cost
+	// of encoding time.
+	PresizeMsgPack bool
+}
+
+// NewDynamicValueWithOpts is identical to NewDynamicValue with the exception
+// that it accepts DynamicValueOpts which can be used to modify the encoding of
+// the DynamicValue, such as JSON only.
+func NewDynamicValueWithOpts(t tftypes.Type, v tftypes.Value, opts DynamicValueOpts) (DynamicValue, error) {
+	var result DynamicValue
+
+	switch opts.Encoding {
+	case DynamicValueEncodingMsgPack, DynamicValueEncodingJSON, DynamicValueEncodingAll:
+	default:
+		return DynamicValue{}, fmt.Errorf("unknown DynamicValue encoding %s", opts.Encoding)
+	}
+
+	if opts.Encoding != DynamicValueEncodingJSON {
+		var b []byte
+		var err error
+		if opts.PresizeMsgPack {
+			b, err = presizedMsgPack(t, v)
+		} else {
+			b, err = v.MarshalMsgPack(t) //nolint:staticcheck
+		}
+		if err != nil {
+			return DynamicValue{}, err
+		}
+		result.MsgPack = b
+	}
+
+	if opts.Encoding != DynamicValueEncodingMsgPack {
+		b, err := tftypes.ValueToJSON(v, t)
+		if err != nil {
+			return DynamicValue{}, err
+		}
+		result.JSON = b
+	}
+
+	return result, nil
+}
+
+// presizedMsgPack returns the MessagePack encoding of v as t, allocating
+// memory for the encoding only once by first encoding it to determine its
+// size.
+func presizedMsgPack(t tftypes.Type, v tftypes.Value) ([]byte, error) {
+	var counter countingWriter
+
+	if err := tftypes.EncodeMsgPack(&counter, v, t); err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, counter))
+
+	if err := tftypes.EncodeMsgPack(buf, v, t); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// countingWriter is an io.Writer which counts the bytes written to it.
+type countingWriter int
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	*w += countingWriter(len(p))
+
+	return len(p), nil
+}
+
+// NewDynamicValueJSON creates a DynamicValue from the JSON encoding of a value,
+// such as one returned by DynamicValue.ToJSON. The JSON is decoded as the
+// given tftypes.Type, returning an error if it is not compatible, and the
+// DynamicValue is encoded as MessagePack.
+func NewDynamicValueJSON(typ tftypes.Type, data []byte) (DynamicValue, error) {
+	v, err := tftypes.ValueFromJSON(data, typ) //nolint:staticcheck
 	if err != nil {
 		return DynamicValue{}, err
 	}
-	return DynamicValue{
-		MsgPack: b,
-	}, nil
+	return NewDynamicValue(typ, v)
+}
+
+// NewDynamicValueJSONWithUnknowns is identical to NewDynamicValueJSON, except
+// values marked as unknown in the unknowns JSON document, such as one returned
+// by DynamicValue.ToJSONWithUnknowns, are unknown in the DynamicValue. This
+// allows values to be inspected or modified as JSON and sent back to
+// Terraform without losing unknown values. A nil unknowns document marks no
+// values as unknown.
+func NewDynamicValueJSONWithUnknowns(typ tftypes.Type, data []byte, unknowns []byte) (DynamicValue, error) {
+	v, err := tftypes.ValueFromJSONWithUnknowns(data, unknowns, typ) //nolint:staticcheck
+	if err != nil {
+		return DynamicValue{}, err
+	}
+	return NewDynamicValue(typ, v)
 }
 
 // DynamicValue represents a nested encoding value that came from the protocol.
@@ -89,6 +222,53 @@ func (d DynamicValue) IsNull() (bool, error) {
 	return false, fmt.Errorf("unable to read DynamicValue: %w", ErrUnknownDynamicValueType)
 }
 
+// Equal returns true if the DynamicValue and the other DynamicValue represent
+// the same value when interpreted as the given tftypes.Type. It avoids
+// decoding the values when they have identical encoded data or when either is
+// null, otherwise both values are unmarshaled and compared. The encoded data
+// of equal values may differ, such as with JSON whitespace or MessagePack map
+// ordering, so encoded data is never used to determine inequality of non-null
+// values.
+func (d DynamicValue) Equal(o DynamicValue, typ tftypes.Type) (bool, error) {
+	if d.JSON != nil && o.JSON != nil && bytes.Equal(d.JSON, o.JSON) {
+		return true, nil
+	}
+
+	if d.JSON == nil && o.JSON == nil && d.MsgPack != nil && bytes.Equal(d.MsgPack, o.MsgPack) {
+		return true, nil
+	}
+
+	dNull, err := d.IsNull()
+
+	if err != nil {
+		return false, err
+	}
+
+	oNull, err := o.IsNull()
+
+	if err != nil {
+		return false, err
+	}
+
+	if dNull || oNull {
+		return dNull == oNull, nil
+	}
+
+	dValue, err := d.Unmarshal(typ)
+
+	if err != nil {
+		return false, err
+	}
+
+	oValue, err := o.Unmarshal(typ)
+
+	if err != nil {
+		return false, err
+	}
+
+	return dValue.Equal(oValue), nil
+}
+
 // Unmarshal returns a `tftypes.Value` that represents the information
 // contained in the DynamicValue in an easy-to-interact-with way. It is the
 // main purpose of the DynamicValue type, and is how provider developers should
@@ -130,3 +310,82 @@ func (d DynamicValue) Unmarshal(typ tftypes.Type) (tftypes.Value, error) {
 	}
 	return tftypes.Value{}, ErrUnknownDynamicValueType
 }
+
+// DynamicValueUnmarshalOpts contains options that can be used to modify the
+// behaviour when unmarshalling a DynamicValue.
+type DynamicValueUnmarshalOpts struct {
+	// NumberPrecision is the precision, in bits, of decoded Number values.
+	// By default, numbers encoded as strings have a precision of 512 bits
+	// and numbers encoded as MessagePack floats have the 53 bits of
+	// precision of a float64. Integers within the range of int64 or uint64
+	// are always decoded exactly, so their precision is never lower than
+	// 64 bits.
+	NumberPrecision uint
+
+	// Strict rejects malformed data which is otherwise tolerated, for
+	// providers treating requests as untrusted input: data after the
+	// value, duplicate object or map keys, and infinite numbers.
+	Strict bool
+}
+
+// UnmarshalWithOpts is identical to Unmarshal with the exception that it
+// accepts DynamicValueUnmarshalOpts which can be used to modify the
+// unmarshalling behaviour, such as the precision of numbers or rejecting
+// malformed data.
+func (d DynamicValue) UnmarshalWithOpts(typ tftypes.Type, opts DynamicValueUnmarshalOpts) (tftypes.Value, error) {
+	if d.JSON != nil {
+		return tftypes.ValueFromJSONWithOpts(d.JSON, typ, tftypes.ValueFromJSONOpts{
+			NumberPrecision: opts.NumberPrecision,
+			Strict:          opts.Strict,
+		})
+	}
+	if d.MsgPack != nil {
+		return tftypes.ValueFromMsgPackWithOpts(d.MsgPack, typ, tftypes.ValueFromMsgPackOpts{ //nolint:staticcheck
+			NumberPrecision: opts.NumberPrecision,
+			Strict:          opts.Strict,
+		})
+	}
+	return tftypes.Value{}, ErrUnknownDynamicValueType
+}
+
+// Transcode returns a new DynamicValue with the data re-encoded as the given
+// DynamicValueEncoding, which is interpreted as the given tftypes.Type as with
+// Unmarshal. It is intended for interoperability with tooling which only
+// reads one encoding.
+func (d DynamicValue) Transcode(typ tftypes.Type, encoding DynamicValueEncoding) (DynamicValue, error) {
+	v, err := d.Unmarshal(typ)
+	if err != nil {
+		return DynamicValue{}, err
+	}
+	return NewDynamicValueWithOpts(typ, v, DynamicValueOpts{
+		Encoding: encoding,
+	})
+}
+
+// ToJSON returns the JSON encoding of the DynamicValue, which is interpreted
+// as the given tftypes.Type as with Unmarshal, regardless of whether the
+// DynamicValue contains JSON or MessagePack data. Values of DynamicPseudoType
+// are encoded as an object with "value" and "type" properties. Unknown values
+// cannot be represented in JSON and return an error.
+func (d DynamicValue) ToJSON(typ tftypes.Type) ([]byte, error) {
+	v, err := d.Unmarshal(typ)
+	if err != nil {
+		return nil, err
+	}
+	return tftypes.ValueToJSON(v, typ)
+}
+
+// ToJSONWithUnknowns is identical to ToJSON, except unknown values are
+// encoded as null rather than returning an error, and the locations of
+// unknown values are returned as a second JSON document. The unknowns
+// document has the same structure as the value, where true marks an unknown
+// value, similar to "after_unknown" in Terraform's JSON plan output. Use
+// NewDynamicValueJSONWithUnknowns to convert the documents back into a
+// DynamicValue without losing unknown values.
+func (d DynamicValue) ToJSONWithUnknowns(typ tftypes.Type) ([]byte, []byte, error) {
+	v, err := d.Unmarshal(typ)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tftypes.ValueToJSONWithUnknowns(v, typ) //nolint:staticcheck
+}
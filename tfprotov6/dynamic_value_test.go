@@ -5,9 +5,12 @@ package tfprotov6_test
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -94,6 +97,751 @@ func TestDynamicValueIsNull(t *testing.T) {
 	}
 }
 
+func TestDynamicValueEqual(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Map{
+		ElementType: tftypes.String,
+	}
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"a": tftypes.NewValue(tftypes.String, "test-value-a"),
+		"b": tftypes.NewValue(tftypes.String, "test-value-b"),
+	})
+
+	testCases := map[string]struct {
+		dynamicValue  tfprotov6.DynamicValue
+		other         tfprotov6.DynamicValue
+		expected      bool
+		expectedError error
+	}{
+		"empty": {
+			dynamicValue:  tfprotov6.DynamicValue{},
+			other:         tfprotov6.DynamicValue{},
+			expectedError: tfprotov6.ErrUnknownDynamicValueType,
+		},
+		"json-identical": {
+			dynamicValue: tfprotov6.DynamicValue{
+				JSON: []byte(`{"a":"test-value-a"}`),
+			},
+			other: tfprotov6.DynamicValue{
+				JSON: []byte(`{"a":"test-value-a"}`),
+			},
+			expected: true,
+		},
+		"json-whitespace": {
+			dynamicValue: tfprotov6.DynamicValue{
+				JSON: []byte(`{"a":"test-value-a"}`),
+			},
+			other: tfprotov6.DynamicValue{
+				JSON: []byte(`{ "a": "test-value-a" }`),
+			},
+			expected: true,
+		},
+		"json-different": {
+			dynamicValue: tfprotov6.DynamicValue{
+				JSON: []byte(`{"a":"test-value-a"}`),
+			},
+			other: tfprotov6.DynamicValue{
+				JSON: []byte(`{"a":"test-value-b"}`),
+			},
+			expected: false,
+		},
+		"json-invalid": {
+			dynamicValue: tfprotov6.DynamicValue{
+				JSON: []byte(`{"a":"test-value-a"}`),
+			},
+			other: tfprotov6.DynamicValue{
+				JSON: []byte(`["test-value-a"]`),
+			},
+			expectedError: fmt.Errorf("invalid JSON"),
+		},
+		"msgpack-identical": {
+			dynamicValue: testNewDynamicValueMust(t, testType, testValue),
+			other:        testNewDynamicValueMust(t, testType, testValue),
+			expected:     true,
+		},
+		"msgpack-different": {
+			dynamicValue: testNewDynamicValueMust(t, testType, testValue),
+			other: testNewDynamicValueMust(t, testType, tftypes.NewValue(testType, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.String, "test-value-a"),
+			})),
+			expected: false,
+		},
+		"msgpack-unknown": {
+			dynamicValue: testNewDynamicValueMust(t, testType, testValue),
+			other:        testNewDynamicValueMust(t, testType, tftypes.NewValue(testType, tftypes.UnknownValue)),
+			expected:     false,
+		},
+		"null-both": {
+			dynamicValue: testNewDynamicValueMust(t, testType, tftypes.NewValue(testType, nil)),
+			other: tfprotov6.DynamicValue{
+				JSON: []byte(`null`),
+			},
+			expected: true,
+		},
+		"null-one": {
+			dynamicValue: testNewDynamicValueMust(t, testType, testValue),
+			other:        testNewDynamicValueMust(t, testType, tftypes.NewValue(testType, nil)),
+			expected:     false,
+		},
+		"json-msgpack": {
+			dynamicValue: tfprotov6.DynamicValue{
+				JSON: []byte(`{"b":"test-value-b","a":"test-value-a"}`),
+			},
+			other:    testNewDynamicValueMust(t, testType, testValue),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.dynamicValue.Equal(testCase.other, testType)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("wanted no error, got error: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("wanted error %q, got error: %s", testCase.expectedError.Error(), err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, wanted err: %s", testCase.expectedError)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewDynamicValueWithOpts(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.List{
+		ElementType: tftypes.String,
+	}
+	testValue := tftypes.NewValue(testType, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testCases := map[string]struct {
+		value           tftypes.Value
+		opts            tfprotov6.DynamicValueOpts
+		expectedJSON    string
+		expectedMsgPack bool
+		expectedError   error
+	}{
+		"default": {
+			value:           testValue,
+			opts:            tfprotov6.DynamicValueOpts{},
+			expectedMsgPack: true,
+		},
+		"msgpack": {
+			value: testValue,
+			opts: tfprotov6.DynamicValueOpts{
+				Encoding: tfprotov6.DynamicValueEncodingMsgPack,
+			},
+			expectedMsgPack: true,
+		},
+		"msgpack-presized": {
+			value: testValue,
+			opts: tfprotov6.DynamicValueOpts{
+				PresizeMsgPack: true,
+			},
+			expectedMsgPack: true,
+		},
+		"json": {
+			value: testValue,
+			opts: tfprotov6.DynamicValueOpts{
+				Encoding: tfprotov6.DynamicValueEncodingJSON,
+			},
+			expectedJSON: `["test-value"]`,
+		},
+		"json-presized": {
+			value: testValue,
+			opts: tfprotov6.DynamicValueOpts{
+				Encoding:       tfprotov6.DynamicValueEncodingJSON,
+				PresizeMsgPack: true,
+			},
+			expectedJSON: `["test-value"]`,
+		},
+		"all": {
+			value: testValue,
+			opts: tfprotov6.DynamicValueOpts{
+				Encoding: tfprotov6.DynamicValueEncodingAll,
+			},
+			expectedJSON:    `["test-value"]`,
+			expectedMsgPack: true,
+		},
+		"json-unknown": {
+			value: tftypes.NewValue(testType, tftypes.UnknownValue),
+			opts: tfprotov6.DynamicValueOpts{
+				Encoding: tfprotov6.DynamicValueEncodingJSON,
+			},
+			expectedError: fmt.Errorf("unknown values cannot be encoded as JSON"),
+		},
+		"invalid-encoding": {
+			value: testValue,
+			opts: tfprotov6.DynamicValueOpts{
+				Encoding: 3,
+			},
+			expectedError: fmt.Errorf("unknown DynamicValue encoding UNKNOWN"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfprotov6.NewDynamicValueWithOpts(testType, testCase.value, testCase.opts)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("wanted no error, got error: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("wanted error %q, got error: %s", testCase.expectedError.Error(), err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, wanted err: %s", testCase.expectedError)
+			}
+
+			if string(got.JSON) != testCase.expectedJSON {
+				t.Errorf("expected JSON %q, got %q", testCase.expectedJSON, got.JSON)
+			}
+
+			if (got.MsgPack != nil) != testCase.expectedMsgPack {
+				t.Errorf("expected MsgPack %t, got: %v", testCase.expectedMsgPack, got.MsgPack)
+			}
+
+			if testCase.expectedMsgPack {
+				if testCase.opts.PresizeMsgPack && cap(got.MsgPack) != len(got.MsgPack) {
+					t.Errorf("expected presized MsgPack capacity %d, got %d", len(got.MsgPack), cap(got.MsgPack))
+				}
+
+				value, err := tftypes.ValueFromMsgPack(got.MsgPack, testType) //nolint:staticcheck
+
+				if err != nil {
+					t.Fatalf("unable to decode MsgPack: %s", err)
+				}
+
+				if diff := cmp.Diff(value, testCase.value); diff != "" {
+					t.Errorf("unexpected MsgPack difference: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDynamicValueTranscode(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string_attribute": tftypes.String,
+		},
+	}
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test_string_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testCases := map[string]struct {
+		dynamicValue  tfprotov6.DynamicValue
+		encoding      tfprotov6.DynamicValueEncoding
+		expected      tfprotov6.DynamicValue
+		expectedError error
+	}{
+		"empty": {
+			dynamicValue:  tfprotov6.DynamicValue{},
+			encoding:      tfprotov6.DynamicValueEncodingJSON,
+			expectedError: tfprotov6.ErrUnknownDynamicValueType,
+		},
+		"json-to-msgpack": {
+			dynamicValue: tfprotov6.DynamicValue{
+				JSON: []byte(`{"test_string_attribute":"test-value"}`),
+			},
+			encoding: tfprotov6.DynamicValueEncodingMsgPack,
+			expected: testNewDynamicValueMust(t, testType, testValue),
+		},
+		"msgpack-to-json": {
+			dynamicValue: testNewDynamicValueMust(t, testType, testValue),
+			encoding:     tfprotov6.DynamicValueEncodingJSON,
+			expected: tfprotov6.DynamicValue{
+				JSON: []byte(`{"test_string_attribute":"test-value"}`),
+			},
+		},
+		"msgpack-to-all": {
+			dynamicValue: testNewDynamicValueMust(t, testType, testValue),
+			encoding:     tfprotov6.DynamicValueEncodingAll,
+			expected: tfprotov6.DynamicValue{
+				JSON:    []byte(`{"test_string_attribute":"test-value"}`),
+				MsgPack: testNewDynamicValueMust(t, testType, testValue).MsgPack,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.dynamicValue.Transcode(testType, testCase.encoding)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("wanted no error, got error: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("wanted error %q, got error: %s", testCase.expectedError.Error(), err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, wanted err: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicValueUnmarshalWithOpts(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string_attribute": tftypes.String,
+		},
+	}
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test_string_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+	testMsgPack := testNewDynamicValueMust(t, testType, testValue).MsgPack
+
+	testCases := map[string]struct {
+		dynamicValue  tfprotov6.DynamicValue
+		opts          tfprotov6.DynamicValueUnmarshalOpts
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"empty": {
+			dynamicValue:  tfprotov6.DynamicValue{},
+			expectedError: tfprotov6.ErrUnknownDynamicValueType,
+		},
+		"json": {
+			dynamicValue: tfprotov6.DynamicValue{
+				JSON: []byte(`{"test_string_attribute":"test-value"}`),
+			},
+			opts:     tfprotov6.DynamicValueUnmarshalOpts{Strict: true},
+			expected: testValue,
+		},
+		"json-duplicate-attribute": {
+			dynamicValue: tfprotov6.DynamicValue{
+				JSON: []byte(`{"test_string_attribute":"other-value","test_string_attribute":"test-value"}`),
+			},
+			expected: testValue,
+		},
+		"json-duplicate-attribute-strict": {
+			dynamicValue: tfprotov6.DynamicValue{
+				JSON: []byte(`{"test_string_attribute":"other-value","test_string_attribute":"test-value"}`),
+			},
+			opts:          tfprotov6.DynamicValueUnmarshalOpts{Strict: true},
+			expectedError: fmt.Errorf(`duplicate attribute "test_string_attribute"`),
+		},
+		"msgpack": {
+			dynamicValue: tfprotov6.DynamicValue{
+				MsgPack: testMsgPack,
+			},
+			opts:     tfprotov6.DynamicValueUnmarshalOpts{Strict: true},
+			expected: testValue,
+		},
+		"msgpack-trailing-data": {
+			dynamicValue: tfprotov6.DynamicValue{
+				MsgPack: append(append([]byte{}, testMsgPack...), 0xc0),
+			},
+			expected: testValue,
+		},
+		"msgpack-trailing-data-strict": {
+			dynamicValue: tfprotov6.DynamicValue{
+				MsgPack: append(append([]byte{}, testMsgPack...), 0xc0),
+			},
+			opts:          tfprotov6.DynamicValueUnmarshalOpts{Strict: true},
+			expectedError: fmt.Errorf("unexpected data after value, 1 bytes remaining"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.dynamicValue.UnmarshalWithOpts(testType, testCase.opts)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("wanted no error, got error: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("wanted error %q, got error: %s", testCase.expectedError.Error(), err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, wanted err: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicValueUnmarshalWithOpts_NumberPrecision(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dynamicValue tfprotov6.DynamicValue
+		opts         tfprotov6.DynamicValueUnmarshalOpts
+		expectedPrec uint
+	}{
+		"json-default": {
+			dynamicValue: tfprotov6.DynamicValue{
+				JSON: []byte(`0.1`),
+			},
+			expectedPrec: 512,
+		},
+		"json-precision": {
+			dynamicValue: tfprotov6.DynamicValue{
+				JSON: []byte(`0.1`),
+			},
+			opts:         tfprotov6.DynamicValueUnmarshalOpts{NumberPrecision: 64},
+			expectedPrec: 64,
+		},
+		"json-integer-precision": {
+			dynamicValue: tfprotov6.DynamicValue{
+				JSON: []byte(`1`),
+			},
+			opts:         tfprotov6.DynamicValueUnmarshalOpts{NumberPrecision: 128},
+			expectedPrec: 128,
+		},
+		"msgpack-default": {
+			dynamicValue: tfprotov6.DynamicValue{
+				// "0.1"
+				MsgPack: []byte{0xa3, 0x30, 0x2e, 0x31},
+			},
+			expectedPrec: 512,
+		},
+		"msgpack-precision": {
+			dynamicValue: tfprotov6.DynamicValue{
+				// "0.1"
+				MsgPack: []byte{0xa3, 0x30, 0x2e, 0x31},
+			},
+			opts:         tfprotov6.DynamicValueUnmarshalOpts{NumberPrecision: 64},
+			expectedPrec: 64,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.dynamicValue.UnmarshalWithOpts(tftypes.Number, testCase.opts)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var number big.Float
+
+			if err := got.As(&number); err != nil {
+				t.Fatalf("unexpected error converting: %s", err)
+			}
+
+			if number.Prec() != testCase.expectedPrec {
+				t.Errorf("expected precision %d, got %d", testCase.expectedPrec, number.Prec())
+			}
+		})
+	}
+}
+
+func TestNewDynamicValueJSON(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string_attribute": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		data          string
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"null": {
+			data:     `null`,
+			expected: tftypes.NewValue(testType, nil),
+		},
+		"object": {
+			data: `{"test_string_attribute":"test-value"}`,
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_string_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+			}),
+		},
+		"invalid": {
+			data:          `["test-value"]`,
+			expectedError: fmt.Errorf("invalid JSON"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dynamicValue, err := tfprotov6.NewDynamicValueJSON(testType, []byte(testCase.data))
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("wanted no error, got error: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("wanted error %q, got error: %s", testCase.expectedError.Error(), err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, wanted err: %s", testCase.expectedError)
+			}
+
+			if dynamicValue.JSON != nil || dynamicValue.MsgPack == nil {
+				t.Fatalf("expected MsgPack DynamicValue, got: %#v", dynamicValue)
+			}
+
+			got, err := dynamicValue.Unmarshal(testType)
+
+			if err != nil {
+				t.Fatalf("unable to unmarshal DynamicValue: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicValueToJSON(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string_attribute": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		dynamicValue  tfprotov6.DynamicValue
+		typ           tftypes.Type
+		expected      string
+		expectedError error
+	}{
+		"empty": {
+			dynamicValue:  tfprotov6.DynamicValue{},
+			typ:           testType,
+			expectedError: tfprotov6.ErrUnknownDynamicValueType,
+		},
+		"json": {
+			dynamicValue: tfprotov6.DynamicValue{
+				JSON: []byte(`{ "test_string_attribute": "test-value" }`),
+			},
+			typ:      testType,
+			expected: `{"test_string_attribute":"test-value"}`,
+		},
+		"msgpack": {
+			dynamicValue: testNewDynamicValueMust(t,
+				testType,
+				tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test_string_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+			),
+			typ:      testType,
+			expected: `{"test_string_attribute":"test-value"}`,
+		},
+		"msgpack-null": {
+			dynamicValue: testNewDynamicValueMust(t,
+				testType,
+				tftypes.NewValue(testType, nil),
+			),
+			typ:      testType,
+			expected: `null`,
+		},
+		"msgpack-dynamic": {
+			dynamicValue: testNewDynamicValueMust(t,
+				tftypes.DynamicPseudoType,
+				tftypes.NewValue(tftypes.String, "test-value"),
+			),
+			typ:      tftypes.DynamicPseudoType,
+			expected: `{"value":"test-value","type":"string"}`,
+		},
+		"msgpack-unknown": {
+			dynamicValue: testNewDynamicValueMust(t,
+				testType,
+				tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test_string_attribute": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			),
+			typ:           testType,
+			expectedError: fmt.Errorf("unknown values cannot be encoded as JSON"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.dynamicValue.ToJSON(testCase.typ)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("wanted no error, got error: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("wanted error %q, got error: %s", testCase.expectedError.Error(), err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, wanted err: %s", testCase.expectedError)
+			}
+
+			if string(got) != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestDynamicValueToJSONWithUnknowns(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_dynamic_attribute": tftypes.DynamicPseudoType,
+			"test_list_attribute":    tftypes.List{ElementType: tftypes.String},
+			"test_string_attribute":  tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		value            tftypes.Value
+		expected         string
+		expectedUnknowns string
+	}{
+		"known": {
+			value: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_dynamic_attribute": tftypes.NewValue(tftypes.Number, 1),
+				"test_list_attribute": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+				"test_string_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+			}),
+			expected:         `{"test_dynamic_attribute":{"value":1,"type":"number"},"test_list_attribute":["test-value"],"test_string_attribute":"test-value"}`,
+			expectedUnknowns: `false`,
+		},
+		"unknowns": {
+			value: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test_dynamic_attribute": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+				"test_list_attribute": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "test-value"),
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+				"test_string_attribute": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected:         `{"test_dynamic_attribute":{"value":null,"type":"bool"},"test_list_attribute":["test-value",null],"test_string_attribute":null}`,
+			expectedUnknowns: `{"test_dynamic_attribute":true,"test_list_attribute":[false,true],"test_string_attribute":true}`,
+		},
+		"unknown": {
+			value:            tftypes.NewValue(testType, tftypes.UnknownValue),
+			expected:         `null`,
+			expectedUnknowns: `true`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dynamicValue := testNewDynamicValueMust(t, testType, testCase.value)
+
+			got, gotUnknowns, err := dynamicValue.ToJSONWithUnknowns(testType)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(got) != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if string(gotUnknowns) != testCase.expectedUnknowns {
+				t.Errorf("expected unknowns %s, got %s", testCase.expectedUnknowns, gotUnknowns)
+			}
+
+			roundTrip, err := tfprotov6.NewDynamicValueJSONWithUnknowns(testType, got, gotUnknowns)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(roundTrip, dynamicValue); diff != "" {
+				t.Errorf("unexpected round trip difference: %s", diff)
+			}
+		})
+	}
+}
+
 func testNewDynamicValueMust(t *testing.T, typ tftypes.Type, value tftypes.Value) tfprotov6.DynamicValue {
 	t.Helper()
 
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// DynamicValueView is a lazily-decoded view of a DynamicValue, returned by
+// DynamicValue.Open. Values are only decoded as they are accessed with AtPath,
+// which avoids decoding the whole DynamicValue when only a few attributes of a
+// large value are needed.
+//
+// A DynamicValueView is not safe for concurrent use.
+type DynamicValueView struct {
+	typ   tftypes.Type
+	value DynamicValue
+
+	// decoded is the entire decoded value, once it has been needed.
+	decoded *tftypes.Value
+}
+
+// Open returns a DynamicValueView of the DynamicValue, which is interpreted
+// as the given tftypes.Type as with Unmarshal. No data is decoded until it is
+// accessed through the DynamicValueView.
+//
+// MessagePack data is decoded lazily, only decoding the attributes and
+// elements along each path passed to AtPath. JSON data is decoded entirely on
+// first access. If the DynamicValue contains both, the MessagePack data is
+// used.
+//
+// ErrUnknownDynamicValueType is returned if the DynamicValue contains neither
+// MessagePack nor JSON data.
+func (d DynamicValue) Open(typ tftypes.Type) (*DynamicValueView, error) {
+	if d.MsgPack == nil && d.JSON == nil {
+		return nil, ErrUnknownDynamicValueType
+	}
+	return &DynamicValueView{
+		typ:   typ,
+		value: d,
+	}, nil
+}
+
+// AtPath returns the tftypes.Value that the tftypes.AttributePath points to
+// within the DynamicValue, as tftypes.Value.AtPath would for the entire
+// decoded value. An empty or nil path returns the entire value.
+func (v *DynamicValueView) AtPath(path *tftypes.AttributePath) (tftypes.Value, error) {
+	if v.decoded == nil && v.value.MsgPack != nil {
+		return tftypes.ValueFromMsgPackAtPath(v.value.MsgPack, v.typ, path) //nolint:staticcheck
+	}
+	val, err := v.Value()
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	return val.AtPath(path)
+}
+
+// Value returns the entire decoded tftypes.Value. The decoded value is kept
+// and used by later calls to Value and AtPath.
+func (v *DynamicValueView) Value() (tftypes.Value, error) {
+	if v.decoded != nil {
+		return *v.decoded, nil
+	}
+	var val tftypes.Value
+	var err error
+	if v.value.MsgPack != nil {
+		val, err = tftypes.ValueFromMsgPack(v.value.MsgPack, v.typ) //nolint:staticcheck
+	} else {
+		val, err = tftypes.ValueFromJSON(v.value.JSON, v.typ) //nolint:staticcheck
+	}
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	v.decoded = &val
+	return val, nil
+}
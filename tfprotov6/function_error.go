@@ -3,7 +3,17 @@
 
 package tfprotov6
 
+import (
+	"errors"
+	"fmt"
+)
+
 // FunctionError is used to convey information back to the user running Terraform.
+//
+// FunctionError is distinct from Diagnostic as the protocol uses a separate
+// error channel for CallFunction. It implements the error interface, so it
+// can be returned and wrapped as a Go error and later recovered with
+// FunctionErrorFromErr.
 type FunctionError struct {
 	// Text is the description of the error.
 	Text string
@@ -12,3 +22,51 @@ type FunctionError struct {
 	// configuration source.
 	FunctionArgument *int64
 }
+
+// NewFunctionError returns a new FunctionError with the given text, which is
+// not associated with a function argument.
+func NewFunctionError(text string) *FunctionError {
+	return &FunctionError{
+		Text: text,
+	}
+}
+
+// NewArgumentFunctionError returns a new FunctionError with the given text,
+// which is associated with the zero-based positional function argument.
+func NewArgumentFunctionError(functionArgument int64, text string) *FunctionError {
+	return &FunctionError{
+		Text:             text,
+		FunctionArgument: &functionArgument,
+	}
+}
+
+// FunctionErrorFromErr returns the FunctionError in the error chain of err, if
+// any, otherwise a new FunctionError with the error message as its text. It
+// returns nil if err is nil.
+func FunctionErrorFromErr(err error) *FunctionError {
+	if err == nil {
+		return nil
+	}
+
+	var funcErr *FunctionError
+
+	if errors.As(err, &funcErr) && funcErr != nil {
+		return funcErr
+	}
+
+	return NewFunctionError(err.Error())
+}
+
+// Error returns the text of the FunctionError, prefixed with the function
+// argument position if present.
+func (e *FunctionError) Error() string {
+	if e == nil {
+		return ""
+	}
+
+	if e.FunctionArgument != nil {
+		return fmt.Sprintf("argument %d: %s", *e.FunctionArgument, e.Text)
+	}
+
+	return e.Text
+}
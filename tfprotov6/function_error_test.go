@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestNewFunctionError(t *testing.T) {
+	t.Parallel()
+
+	got := tfprotov6.NewFunctionError("test error")
+	expected := &tfprotov6.FunctionError{
+		Text: "test error",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestNewArgumentFunctionError(t *testing.T) {
+	t.Parallel()
+
+	got := tfprotov6.NewArgumentFunctionError(1, "test error")
+	expected := &tfprotov6.FunctionError{
+		Text:             "test error",
+		FunctionArgument: pointer(int64(1)),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestFunctionErrorFromErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected *tfprotov6.FunctionError
+	}{
+		"nil": {
+			err:      nil,
+			expected: nil,
+		},
+		"error": {
+			err: errors.New("test error"),
+			expected: &tfprotov6.FunctionError{
+				Text: "test error",
+			},
+		},
+		"function-error": {
+			err: tfprotov6.NewArgumentFunctionError(0, "test error"),
+			expected: &tfprotov6.FunctionError{
+				Text:             "test error",
+				FunctionArgument: pointer(int64(0)),
+			},
+		},
+		"wrapped-function-error": {
+			err: fmt.Errorf("wrapped: %w", tfprotov6.NewArgumentFunctionError(2, "test error")),
+			expected: &tfprotov6.FunctionError{
+				Text:             "test error",
+				FunctionArgument: pointer(int64(2)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfprotov6.FunctionErrorFromErr(testCase.err)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFunctionErrorError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		funcErr  *tfprotov6.FunctionError
+		expected string
+	}{
+		"nil": {
+			funcErr:  nil,
+			expected: "",
+		},
+		"text": {
+			funcErr:  tfprotov6.NewFunctionError("test error"),
+			expected: "test error",
+		},
+		"argument": {
+			funcErr:  tfprotov6.NewArgumentFunctionError(1, "test error"),
+			expected: "argument 1: test error",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.funcErr.Error()
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
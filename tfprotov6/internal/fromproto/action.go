@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+)
+
+func ValidateActionConfigRequest(in *tfplugin6.ValidateActionConfig_Request) *tfprotov6.ValidateActionConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.ValidateActionConfigRequest{
+		ActionType: in.ActionType,
+		Config:     DynamicValue(in.Config),
+	}
+
+	return resp
+}
+
+func InvokeActionRequest(in *tfplugin6.InvokeAction_Request) *tfprotov6.InvokeActionRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.InvokeActionRequest{
+		ActionType: in.ActionType,
+		Config:     DynamicValue(in.Config),
+	}
+
+	return resp
+}
+
+func ActionMetadata(in *tfplugin6.GetMetadata_ActionMetadata) tfprotov6.ActionMetadata {
+	if in == nil {
+		return tfprotov6.ActionMetadata{}
+	}
+
+	return tfprotov6.ActionMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+func ActionSchema(in *tfplugin6.ActionSchema) (*tfprotov6.ActionSchema, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	schema, err := Schema(in.Schema)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ActionSchema{
+		Schema: schema,
+	}
+
+	return resp, nil
+}
+
+func ValidateActionConfigResponse(in *tfplugin6.ValidateActionConfig_Response) (*tfprotov6.ValidateActionConfigResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ValidateActionConfigResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func InvokeActionEvent(in *tfplugin6.InvokeAction_Event) (*tfprotov6.InvokeActionEvent, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	switch eventType := in.Type.(type) {
+	case *tfplugin6.InvokeAction_Event_Progress_:
+		resp := &tfprotov6.InvokeActionEvent{
+			Type: tfprotov6.ProgressInvokeActionEventType{
+				Message: eventType.Progress.GetMessage(),
+			},
+		}
+
+		return resp, nil
+	case *tfplugin6.InvokeAction_Event_Completed_:
+		diags, err := Diagnostics(eventType.Completed.GetDiagnostics())
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp := &tfprotov6.InvokeActionEvent{
+			Type: tfprotov6.CompletedInvokeActionEventType{
+				Diagnostics: diags,
+			},
+		}
+
+		return resp, nil
+	default:
+		return nil, fmt.Errorf("unknown InvokeAction event type: %T", in.Type)
+	}
+}
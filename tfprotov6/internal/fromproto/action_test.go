@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/fromproto"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+)
+
+func TestValidateActionConfigRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfplugin6.ValidateActionConfig_Request
+		expected *tfprotov6.ValidateActionConfigRequest
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfplugin6.ValidateActionConfig_Request{},
+			expected: &tfprotov6.ValidateActionConfigRequest{},
+		},
+		"ActionType": {
+			in: &tfplugin6.ValidateActionConfig_Request{
+				ActionType: "test",
+			},
+			expected: &tfprotov6.ValidateActionConfigRequest{
+				ActionType: "test",
+			},
+		},
+		"Config": {
+			in: &tfplugin6.ValidateActionConfig_Request{
+				Config: testTfplugin6DynamicValue(),
+			},
+			expected: &tfprotov6.ValidateActionConfigRequest{
+				Config: testTfprotov6DynamicValue(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto.ValidateActionConfigRequest(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInvokeActionRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfplugin6.InvokeAction_Request
+		expected *tfprotov6.InvokeActionRequest
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfplugin6.InvokeAction_Request{},
+			expected: &tfprotov6.InvokeActionRequest{},
+		},
+		"ActionType": {
+			in: &tfplugin6.InvokeAction_Request{
+				ActionType: "test",
+			},
+			expected: &tfprotov6.InvokeActionRequest{
+				ActionType: "test",
+			},
+		},
+		"Config": {
+			in: &tfplugin6.InvokeAction_Request{
+				Config: testTfplugin6DynamicValue(),
+			},
+			expected: &tfprotov6.InvokeActionRequest{
+				Config: testTfprotov6DynamicValue(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto.InvokeActionRequest(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInvokeActionEvent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            *tfplugin6.InvokeAction_Event
+		expected      *tfprotov6.InvokeActionEvent
+		expectedError string
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:            &tfplugin6.InvokeAction_Event{},
+			expectedError: "unknown InvokeAction event type: <nil>",
+		},
+		"Progress": {
+			in: &tfplugin6.InvokeAction_Event{
+				Type: &tfplugin6.InvokeAction_Event_Progress_{
+					Progress: &tfplugin6.InvokeAction_Event_Progress{
+						Message: "test",
+					},
+				},
+			},
+			expected: &tfprotov6.InvokeActionEvent{
+				Type: tfprotov6.ProgressInvokeActionEventType{
+					Message: "test",
+				},
+			},
+		},
+		"Completed": {
+			in: &tfplugin6.InvokeAction_Event{
+				Type: &tfplugin6.InvokeAction_Event_Completed_{
+					Completed: &tfplugin6.InvokeAction_Event_Completed{
+						Diagnostics: []*tfplugin6.Diagnostic{
+							{
+								Severity: tfplugin6.Diagnostic_WARNING,
+								Summary:  "test summary",
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.InvokeActionEvent{
+				Type: tfprotov6.CompletedInvokeActionEventType{
+					Diagnostics: []*tfprotov6.Diagnostic{
+						{
+							Severity: tfprotov6.DiagnosticSeverityWarning,
+							Summary:  "test summary",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromproto.InvokeActionEvent(testCase.in)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
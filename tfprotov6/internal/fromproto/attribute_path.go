@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var ErrUnknownAttributePathStepType = errors.New("unknown type of AttributePath_Step")
+
+func AttributePath(in *tfplugin6.AttributePath) (*tftypes.AttributePath, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	steps, err := AttributePathSteps(in.Steps)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tftypes.NewAttributePathWithSteps(steps), nil
+}
+
+func AttributePaths(in []*tfplugin6.AttributePath) ([]*tftypes.AttributePath, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	resp := make([]*tftypes.AttributePath, 0, len(in))
+
+	for _, a := range in {
+		attributePath, err := AttributePath(a)
+
+		if err != nil {
+			return resp, err
+		}
+
+		resp = append(resp, attributePath)
+	}
+
+	return resp, nil
+}
+
+func AttributePathStep(step *tfplugin6.AttributePath_Step) (tftypes.AttributePathStep, error) {
+	if step == nil {
+		return nil, nil
+	}
+
+	switch selector := step.GetSelector().(type) {
+	case *tfplugin6.AttributePath_Step_AttributeName:
+		return tftypes.AttributeName(selector.AttributeName), nil
+	case *tfplugin6.AttributePath_Step_ElementKeyString:
+		return tftypes.ElementKeyString(selector.ElementKeyString), nil
+	case *tfplugin6.AttributePath_Step_ElementKeyInt:
+		return tftypes.ElementKeyInt(selector.ElementKeyInt), nil
+	}
+
+	return nil, ErrUnknownAttributePathStepType
+}
+
+func AttributePathSteps(in []*tfplugin6.AttributePath_Step) ([]tftypes.AttributePathStep, error) {
+	resp := make([]tftypes.AttributePathStep, 0, len(in))
+
+	for _, step := range in {
+		if step == nil {
+			continue
+		}
+
+		s, err := AttributePathStep(step)
+
+		if err != nil {
+			return resp, err
+		}
+
+		resp = append(resp, s)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func CtyType(in []byte) (tftypes.Type, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	// nolint:staticcheck // Intended first-party usage
+	return tftypes.ParseJSONType(in)
+}
@@ -35,3 +35,51 @@ func ReadDataSourceRequest(in *tfplugin6.ReadDataSource_Request) *tfprotov6.Read
 
 	return resp
 }
+
+func DataSourceMetadata(in *tfplugin6.GetMetadata_DataSourceMetadata) tfprotov6.DataSourceMetadata {
+	if in == nil {
+		return tfprotov6.DataSourceMetadata{}
+	}
+
+	return tfprotov6.DataSourceMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+func ValidateDataResourceConfigResponse(in *tfplugin6.ValidateDataResourceConfig_Response) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ValidateDataResourceConfigResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func ReadDataSourceResponse(in *tfplugin6.ReadDataSource_Response) (*tfprotov6.ReadDataSourceResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ReadDataSourceResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: diags,
+		State:       DynamicValue(in.State),
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+)
+
+func Deferred(in *tfplugin6.Deferred) *tfprotov6.Deferred {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.Deferred{
+		Reason: tfprotov6.DeferredReason(in.Reason),
+	}
+
+	return resp
+}
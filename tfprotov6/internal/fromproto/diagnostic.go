@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+)
+
+func Diagnostic(in *tfplugin6.Diagnostic) (*tfprotov6.Diagnostic, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	attribute, err := AttributePath(in.Attribute)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.Diagnostic{
+		Attribute: attribute,
+		Detail:    in.Detail,
+		Severity:  DiagnosticSeverity(in.Severity),
+		Summary:   in.Summary,
+	}
+
+	return resp, nil
+}
+
+func DiagnosticSeverity(in tfplugin6.Diagnostic_Severity) tfprotov6.DiagnosticSeverity {
+	return tfprotov6.DiagnosticSeverity(in)
+}
+
+func Diagnostics(in []*tfplugin6.Diagnostic) ([]*tfprotov6.Diagnostic, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	resp := make([]*tfprotov6.Diagnostic, 0, len(in))
+
+	for _, d := range in {
+		diag, err := Diagnostic(d)
+
+		if err != nil {
+			return resp, err
+		}
+
+		resp = append(resp, diag)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/fromproto"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       []*tfplugin6.Diagnostic
+		expected []*tfprotov6.Diagnostic
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       []*tfplugin6.Diagnostic{},
+			expected: []*tfprotov6.Diagnostic{},
+		},
+		"diagnostics": {
+			in: []*tfplugin6.Diagnostic{
+				{
+					Attribute: &tfplugin6.AttributePath{
+						Steps: []*tfplugin6.AttributePath_Step{
+							{
+								Selector: &tfplugin6.AttributePath_Step_AttributeName{
+									AttributeName: "test",
+								},
+							},
+						},
+					},
+					Detail:   "test detail",
+					Severity: tfplugin6.Diagnostic_ERROR,
+					Summary:  "test summary",
+				},
+				{
+					Severity: tfplugin6.Diagnostic_WARNING,
+					Summary:  "test summary",
+				},
+			},
+			expected: []*tfprotov6.Diagnostic{
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					Detail:    "test detail",
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "test summary",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "test summary",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromproto.Diagnostics(testCase.in)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
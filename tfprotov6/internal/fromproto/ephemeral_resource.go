@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+)
+
+func ValidateEphemeralResourceConfigRequest(in *tfplugin6.ValidateEphemeralResourceConfig_Request) *tfprotov6.ValidateEphemeralResourceConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.ValidateEphemeralResourceConfigRequest{
+		Config:   DynamicValue(in.Config),
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func OpenEphemeralResourceRequest(in *tfplugin6.OpenEphemeralResource_Request) *tfprotov6.OpenEphemeralResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.OpenEphemeralResourceRequest{
+		ClientCapabilities: OpenEphemeralResourceClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TypeName:           in.TypeName,
+	}
+
+	return resp
+}
+
+func RenewEphemeralResourceRequest(in *tfplugin6.RenewEphemeralResource_Request) *tfprotov6.RenewEphemeralResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.RenewEphemeralResourceRequest{
+		Private:  in.Private,
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func CloseEphemeralResourceRequest(in *tfplugin6.CloseEphemeralResource_Request) *tfprotov6.CloseEphemeralResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.CloseEphemeralResourceRequest{
+		Private:  in.Private,
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func EphemeralResourceMetadata(in *tfplugin6.GetMetadata_EphemeralResourceMetadata) tfprotov6.EphemeralResourceMetadata {
+	if in == nil {
+		return tfprotov6.EphemeralResourceMetadata{}
+	}
+
+	return tfprotov6.EphemeralResourceMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+func ValidateEphemeralResourceConfigResponse(in *tfplugin6.ValidateEphemeralResourceConfig_Response) (*tfprotov6.ValidateEphemeralResourceConfigResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ValidateEphemeralResourceConfigResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func OpenEphemeralResourceResponse(in *tfplugin6.OpenEphemeralResource_Response) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.OpenEphemeralResourceResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: diags,
+		Private:     in.Private,
+		RenewAt:     Timestamp(in.RenewAt),
+		Result:      DynamicValue(in.Result),
+	}
+
+	return resp, nil
+}
+
+func RenewEphemeralResourceResponse(in *tfplugin6.RenewEphemeralResource_Response) (*tfprotov6.RenewEphemeralResourceResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.RenewEphemeralResourceResponse{
+		Diagnostics: diags,
+		Private:     in.Private,
+		RenewAt:     Timestamp(in.RenewAt),
+	}
+
+	return resp, nil
+}
+
+func CloseEphemeralResourceResponse(in *tfplugin6.CloseEphemeralResource_Response) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.CloseEphemeralResourceResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+// Timestamp returns the time of a protocol timestamp, or the zero time if the
+// timestamp is not set.
+func Timestamp(in *timestamppb.Timestamp) time.Time {
+	if in == nil {
+		return time.Time{}
+	}
+
+	return in.AsTime()
+}
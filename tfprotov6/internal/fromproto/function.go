@@ -34,3 +34,136 @@ func GetFunctionsRequest(in *tfplugin6.GetFunctions_Request) *tfprotov6.GetFunct
 
 	return resp
 }
+
+func CallFunctionResponse(in *tfplugin6.CallFunction_Response) *tfprotov6.CallFunctionResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.CallFunctionResponse{
+		Error:  FunctionError(in.Error),
+		Result: DynamicValue(in.Result),
+	}
+
+	return resp
+}
+
+func Function(in *tfplugin6.Function) (*tfprotov6.Function, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	returnParam, err := FunctionReturn(in.Return)
+
+	if err != nil {
+		return nil, err
+	}
+
+	variadicParam, err := FunctionParameter(in.VariadicParameter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.Function{
+		Description:        in.Description,
+		DescriptionKind:    StringKind(in.DescriptionKind),
+		DeprecationMessage: in.DeprecationMessage,
+		Parameters:         make([]*tfprotov6.FunctionParameter, 0, len(in.Parameters)),
+		Return:             returnParam,
+		Summary:            in.Summary,
+		VariadicParameter:  variadicParam,
+	}
+
+	for _, p := range in.Parameters {
+		parameter, err := FunctionParameter(p)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Parameters = append(resp.Parameters, parameter)
+	}
+
+	return resp, nil
+}
+
+func FunctionMetadata(in *tfplugin6.GetMetadata_FunctionMetadata) tfprotov6.FunctionMetadata {
+	if in == nil {
+		return tfprotov6.FunctionMetadata{}
+	}
+
+	return tfprotov6.FunctionMetadata{
+		Name: in.Name,
+	}
+}
+
+func FunctionParameter(in *tfplugin6.Function_Parameter) (*tfprotov6.FunctionParameter, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	typ, err := CtyType(in.Type)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.FunctionParameter{
+		AllowNullValue:     in.AllowNullValue,
+		AllowUnknownValues: in.AllowUnknownValues,
+		Description:        in.Description,
+		DescriptionKind:    StringKind(in.DescriptionKind),
+		Name:               in.Name,
+		Type:               typ,
+	}
+
+	return resp, nil
+}
+
+func FunctionReturn(in *tfplugin6.Function_Return) (*tfprotov6.FunctionReturn, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	typ, err := CtyType(in.Type)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.FunctionReturn{
+		Type: typ,
+	}
+
+	return resp, nil
+}
+
+func GetFunctionsResponse(in *tfplugin6.GetFunctions_Response) (*tfprotov6.GetFunctionsResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.GetFunctionsResponse{
+		Diagnostics: diags,
+		Functions:   make(map[string]*tfprotov6.Function, len(in.Functions)),
+	}
+
+	for name, f := range in.Functions {
+		function, err := Function(f)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Functions[name] = function
+	}
+
+	return resp, nil
+}
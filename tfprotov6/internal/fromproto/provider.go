@@ -63,3 +63,199 @@ func StopProviderRequest(in *tfplugin6.StopProvider_Request) *tfprotov6.StopProv
 
 	return resp
 }
+
+func GetMetadataResponse(in *tfplugin6.GetMetadata_Response) (*tfprotov6.GetMetadataResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.GetMetadataResponse{
+		Actions:            make([]tfprotov6.ActionMetadata, 0, len(in.Actions)),
+		DataSources:        make([]tfprotov6.DataSourceMetadata, 0, len(in.DataSources)),
+		Diagnostics:        diags,
+		EphemeralResources: make([]tfprotov6.EphemeralResourceMetadata, 0, len(in.EphemeralResources)),
+		Functions:          make([]tfprotov6.FunctionMetadata, 0, len(in.Functions)),
+		Resources:          make([]tfprotov6.ResourceMetadata, 0, len(in.Resources)),
+		ServerCapabilities: ServerCapabilities(in.ServerCapabilities),
+		StateStores:        make([]tfprotov6.StateStoreMetadata, 0, len(in.StateStores)),
+	}
+
+	for _, action := range in.Actions {
+		resp.Actions = append(resp.Actions, ActionMetadata(action))
+	}
+
+	for _, datasource := range in.DataSources {
+		resp.DataSources = append(resp.DataSources, DataSourceMetadata(datasource))
+	}
+
+	for _, ephemeralResource := range in.EphemeralResources {
+		resp.EphemeralResources = append(resp.EphemeralResources, EphemeralResourceMetadata(ephemeralResource))
+	}
+
+	for _, function := range in.Functions {
+		resp.Functions = append(resp.Functions, FunctionMetadata(function))
+	}
+
+	for _, resource := range in.Resources {
+		resp.Resources = append(resp.Resources, ResourceMetadata(resource))
+	}
+
+	for _, stateStore := range in.StateStores {
+		resp.StateStores = append(resp.StateStores, StateStoreMetadata(stateStore))
+	}
+
+	return resp, nil
+}
+
+func GetProviderSchemaResponse(in *tfplugin6.GetProviderSchema_Response) (*tfprotov6.GetProviderSchemaResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := Schema(in.Provider)
+
+	if err != nil {
+		return nil, err
+	}
+
+	providerMeta, err := Schema(in.ProviderMeta)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.GetProviderSchemaResponse{
+		ActionSchemas:            make(map[string]*tfprotov6.ActionSchema, len(in.ActionSchemas)),
+		DataSourceSchemas:        make(map[string]*tfprotov6.Schema, len(in.DataSourceSchemas)),
+		Diagnostics:              diags,
+		EphemeralResourceSchemas: make(map[string]*tfprotov6.Schema, len(in.EphemeralResourceSchemas)),
+		Functions:                make(map[string]*tfprotov6.Function, len(in.Functions)),
+		Provider:                 provider,
+		ProviderMeta:             providerMeta,
+		ResourceSchemas:          make(map[string]*tfprotov6.Schema, len(in.ResourceSchemas)),
+		ServerCapabilities:       ServerCapabilities(in.ServerCapabilities),
+		StateStoreSchemas:        make(map[string]*tfprotov6.Schema, len(in.StateStoreSchemas)),
+	}
+
+	for name, s := range in.ResourceSchemas {
+		schema, err := Schema(s)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.ResourceSchemas[name] = schema
+	}
+
+	for name, s := range in.DataSourceSchemas {
+		schema, err := Schema(s)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.DataSourceSchemas[name] = schema
+	}
+
+	for name, s := range in.EphemeralResourceSchemas {
+		schema, err := Schema(s)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.EphemeralResourceSchemas[name] = schema
+	}
+
+	for name, s := range in.ActionSchemas {
+		schema, err := ActionSchema(s)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.ActionSchemas[name] = schema
+	}
+
+	for name, s := range in.StateStoreSchemas {
+		schema, err := Schema(s)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.StateStoreSchemas[name] = schema
+	}
+
+	for name, f := range in.Functions {
+		function, err := Function(f)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Functions[name] = function
+	}
+
+	return resp, nil
+}
+
+func ValidateProviderConfigResponse(in *tfplugin6.ValidateProviderConfig_Response) (*tfprotov6.ValidateProviderConfigResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ValidateProviderConfigResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func ConfigureProviderResponse(in *tfplugin6.ConfigureProvider_Response) (*tfprotov6.ConfigureProviderResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ConfigureProviderResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func StopProviderResponse(in *tfplugin6.StopProvider_Response) *tfprotov6.StopProviderResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.StopProviderResponse{
+		Error: in.Error,
+	}
+
+	return resp
+}
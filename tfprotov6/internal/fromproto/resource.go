@@ -14,8 +14,9 @@ func ValidateResourceConfigRequest(in *tfplugin6.ValidateResourceConfig_Request)
 	}
 
 	resp := &tfprotov6.ValidateResourceConfigRequest{
-		Config:   DynamicValue(in.Config),
-		TypeName: in.TypeName,
+		ClientCapabilities: ValidateResourceConfigClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TypeName:           in.TypeName,
 	}
 
 	return resp
@@ -116,3 +117,189 @@ func MoveResourceStateRequest(in *tfplugin6.MoveResourceState_Request) *tfprotov
 
 	return resp
 }
+
+func ResourceMetadata(in *tfplugin6.GetMetadata_ResourceMetadata) tfprotov6.ResourceMetadata {
+	if in == nil {
+		return tfprotov6.ResourceMetadata{}
+	}
+
+	return tfprotov6.ResourceMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+func ValidateResourceConfigResponse(in *tfplugin6.ValidateResourceConfig_Response) (*tfprotov6.ValidateResourceConfigResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ValidateResourceConfigResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func UpgradeResourceStateResponse(in *tfplugin6.UpgradeResourceState_Response) (*tfprotov6.UpgradeResourceStateResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.UpgradeResourceStateResponse{
+		Diagnostics:   diags,
+		UpgradedState: DynamicValue(in.UpgradedState),
+	}
+
+	return resp, nil
+}
+
+func ReadResourceResponse(in *tfplugin6.ReadResource_Response) (*tfprotov6.ReadResourceResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ReadResourceResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: diags,
+		NewState:    DynamicValue(in.NewState),
+		Private:     in.Private,
+	}
+
+	return resp, nil
+}
+
+func PlanResourceChangeResponse(in *tfplugin6.PlanResourceChange_Response) (*tfprotov6.PlanResourceChangeResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	requiresReplace, err := AttributePaths(in.RequiresReplace)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.PlanResourceChangeResponse{
+		Deferred:                    Deferred(in.Deferred),
+		Diagnostics:                 diags,
+		PlannedPrivate:              in.PlannedPrivate,
+		PlannedState:                DynamicValue(in.PlannedState),
+		RequiresReplace:             requiresReplace,
+		UnsafeToUseLegacyTypeSystem: in.LegacyTypeSystem, //nolint:staticcheck
+	}
+
+	return resp, nil
+}
+
+func ApplyResourceChangeResponse(in *tfplugin6.ApplyResourceChange_Response) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ApplyResourceChangeResponse{
+		Diagnostics:                 diags,
+		NewState:                    DynamicValue(in.NewState),
+		Private:                     in.Private,
+		UnsafeToUseLegacyTypeSystem: in.LegacyTypeSystem, //nolint:staticcheck
+	}
+
+	return resp, nil
+}
+
+func ImportResourceStateResponse(in *tfplugin6.ImportResourceState_Response) (*tfprotov6.ImportResourceStateResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ImportResourceStateResponse{
+		Deferred:          Deferred(in.Deferred),
+		Diagnostics:       diags,
+		ImportedResources: ImportedResources(in.ImportedResources),
+	}
+
+	return resp, nil
+}
+
+func ImportedResource(in *tfplugin6.ImportResourceState_ImportedResource) *tfprotov6.ImportedResource {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.ImportedResource{
+		Private:  in.Private,
+		State:    DynamicValue(in.State),
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func ImportedResources(in []*tfplugin6.ImportResourceState_ImportedResource) []*tfprotov6.ImportedResource {
+	if in == nil {
+		return nil
+	}
+
+	resp := make([]*tfprotov6.ImportedResource, 0, len(in))
+
+	for _, i := range in {
+		resp = append(resp, ImportedResource(i))
+	}
+
+	return resp
+}
+
+func MoveResourceStateResponse(in *tfplugin6.MoveResourceState_Response) (*tfprotov6.MoveResourceStateResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.MoveResourceStateResponse{
+		Diagnostics:   diags,
+		TargetPrivate: in.TargetPrivate,
+		TargetState:   DynamicValue(in.TargetState),
+	}
+
+	return resp, nil
+}
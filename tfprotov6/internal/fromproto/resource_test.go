@@ -479,6 +479,18 @@ func TestValidateResourceConfigRequest(t *testing.T) {
 				Config: testTfprotov6DynamicValue(),
 			},
 		},
+		"ClientCapabilities": {
+			in: &tfplugin6.ValidateResourceConfig_Request{
+				ClientCapabilities: &tfplugin6.ClientCapabilities{
+					WriteOnlyAttributesAllowed: true,
+				},
+			},
+			expected: &tfprotov6.ValidateResourceConfigRequest{
+				ClientCapabilities: &tfprotov6.ValidateResourceConfigClientCapabilities{
+					WriteOnlyAttributesAllowed: true,
+				},
+			},
+		},
 		"TypeName": {
 			in: &tfplugin6.ValidateResourceConfig_Request{
 				TypeName: "test",
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+)
+
+func Schema(in *tfplugin6.Schema) (*tfprotov6.Schema, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	block, err := SchemaBlock(in.Block)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.Schema{
+		Block:   block,
+		Version: in.Version,
+	}
+
+	return resp, nil
+}
+
+func SchemaBlock(in *tfplugin6.Schema_Block) (*tfprotov6.SchemaBlock, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	attributes, err := SchemaAttributes(in.Attributes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blockTypes, err := SchemaNestedBlocks(in.BlockTypes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.SchemaBlock{
+		Attributes:      attributes,
+		BlockTypes:      blockTypes,
+		Deprecated:      in.Deprecated,
+		Description:     in.Description,
+		DescriptionKind: StringKind(in.DescriptionKind),
+		Version:         in.Version,
+	}
+
+	return resp, nil
+}
+
+func SchemaAttribute(in *tfplugin6.Schema_Attribute) (*tfprotov6.SchemaAttribute, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	nestedType, err := SchemaObject(in.NestedType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	typ, err := CtyType(in.Type)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.SchemaAttribute{
+		Computed:        in.Computed,
+		Deprecated:      in.Deprecated,
+		Description:     in.Description,
+		DescriptionKind: StringKind(in.DescriptionKind),
+		Name:            in.Name,
+		NestedType:      nestedType,
+		Optional:        in.Optional,
+		Required:        in.Required,
+		Sensitive:       in.Sensitive,
+		Type:            typ,
+	}
+
+	return resp, nil
+}
+
+func SchemaAttributes(in []*tfplugin6.Schema_Attribute) ([]*tfprotov6.SchemaAttribute, error) {
+	resp := make([]*tfprotov6.SchemaAttribute, 0, len(in))
+
+	for _, a := range in {
+		attribute, err := SchemaAttribute(a)
+
+		if err != nil {
+			return resp, err
+		}
+
+		resp = append(resp, attribute)
+	}
+
+	return resp, nil
+}
+
+func SchemaNestedBlock(in *tfplugin6.Schema_NestedBlock) (*tfprotov6.SchemaNestedBlock, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	block, err := SchemaBlock(in.Block)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.SchemaNestedBlock{
+		Block:    block,
+		MaxItems: in.MaxItems,
+		MinItems: in.MinItems,
+		Nesting:  SchemaNestedBlockNestingMode(in.Nesting),
+		TypeName: in.TypeName,
+	}
+
+	return resp, nil
+}
+
+func SchemaNestedBlocks(in []*tfplugin6.Schema_NestedBlock) ([]*tfprotov6.SchemaNestedBlock, error) {
+	resp := make([]*tfprotov6.SchemaNestedBlock, 0, len(in))
+
+	for _, b := range in {
+		block, err := SchemaNestedBlock(b)
+
+		if err != nil {
+			return resp, err
+		}
+
+		resp = append(resp, block)
+	}
+
+	return resp, nil
+}
+
+func SchemaNestedBlockNestingMode(in tfplugin6.Schema_NestedBlock_NestingMode) tfprotov6.SchemaNestedBlockNestingMode {
+	return tfprotov6.SchemaNestedBlockNestingMode(in)
+}
+
+func SchemaObjectNestingMode(in tfplugin6.Schema_Object_NestingMode) tfprotov6.SchemaObjectNestingMode {
+	return tfprotov6.SchemaObjectNestingMode(in)
+}
+
+func SchemaObject(in *tfplugin6.Schema_Object) (*tfprotov6.SchemaObject, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	attributes, err := SchemaAttributes(in.Attributes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.SchemaObject{
+		Attributes: attributes,
+		Nesting:    SchemaObjectNestingMode(in.Nesting),
+	}
+
+	return resp, nil
+}
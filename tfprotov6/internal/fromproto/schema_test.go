@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/fromproto"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfplugin6.Schema
+		expected *tfprotov6.Schema
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfplugin6.Schema{},
+			expected: &tfprotov6.Schema{},
+		},
+		"Block": {
+			in: &tfplugin6.Schema{
+				Block: &tfplugin6.Schema_Block{
+					Attributes: []*tfplugin6.Schema_Attribute{
+						{
+							Name:     "test",
+							Required: true,
+							Type:     []byte(`"string"`),
+						},
+					},
+					BlockTypes: []*tfplugin6.Schema_NestedBlock{
+						{
+							Block:    &tfplugin6.Schema_Block{},
+							Nesting:  tfplugin6.Schema_NestedBlock_LIST,
+							TypeName: "test_block",
+						},
+					},
+					Description:     "test description",
+					DescriptionKind: tfplugin6.StringKind_MARKDOWN,
+				},
+				Version: 1,
+			},
+			expected: &tfprotov6.Schema{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "test",
+							Required: true,
+							Type:     tftypes.String,
+						},
+					},
+					BlockTypes: []*tfprotov6.SchemaNestedBlock{
+						{
+							Block: &tfprotov6.SchemaBlock{
+								Attributes: []*tfprotov6.SchemaAttribute{},
+								BlockTypes: []*tfprotov6.SchemaNestedBlock{},
+							},
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+							TypeName: "test_block",
+						},
+					},
+					Description:     "test description",
+					DescriptionKind: tfprotov6.StringKindMarkdown,
+				},
+				Version: 1,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromproto.Schema(testCase.in)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaAttribute_nestedType(t *testing.T) {
+	t.Parallel()
+
+	got, err := fromproto.SchemaAttribute(&tfplugin6.Schema_Attribute{
+		Name: "test",
+		NestedType: &tfplugin6.Schema_Object{
+			Attributes: []*tfplugin6.Schema_Attribute{
+				{
+					Name:     "nested",
+					Optional: true,
+					Type:     []byte(`"bool"`),
+				},
+			},
+			Nesting: tfplugin6.Schema_Object_LIST,
+		},
+		Optional: true,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfprotov6.SchemaAttribute{
+		Name: "test",
+		NestedType: &tfprotov6.SchemaObject{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:     "nested",
+					Optional: true,
+					Type:     tftypes.Bool,
+				},
+			},
+			Nesting: tfprotov6.SchemaObjectNestingModeList,
+		},
+		Optional: true,
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestSchemaAttribute_invalidType(t *testing.T) {
+	t.Parallel()
+
+	_, err := fromproto.SchemaAttribute(&tfplugin6.Schema_Attribute{
+		Name: "test",
+		Type: []byte(`"invalid"`),
+	})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
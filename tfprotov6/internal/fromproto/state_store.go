@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+)
+
+func StateStoreMetadata(in *tfplugin6.GetMetadata_StateStoreMetadata) tfprotov6.StateStoreMetadata {
+	if in == nil {
+		return tfprotov6.StateStoreMetadata{}
+	}
+
+	return tfprotov6.StateStoreMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+func ValidateStateStoreConfigRequest(in *tfplugin6.ValidateStateStoreConfig_Request) *tfprotov6.ValidateStateStoreConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.ValidateStateStoreConfigRequest{
+		TypeName: in.TypeName,
+		Config:   DynamicValue(in.Config),
+	}
+
+	return resp
+}
+
+func ConfigureStateStoreRequest(in *tfplugin6.ConfigureStateStore_Request) *tfprotov6.ConfigureStateStoreRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.ConfigureStateStoreRequest{
+		TypeName: in.TypeName,
+		Config:   DynamicValue(in.Config),
+	}
+
+	return resp
+}
+
+func ReadStateBytesRequest(in *tfplugin6.ReadStateBytes_Request) *tfprotov6.ReadStateBytesRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.ReadStateBytesRequest{
+		TypeName: in.TypeName,
+		StateID:  in.StateId,
+	}
+
+	return resp
+}
+
+// WriteStateBytesRequest converts a chunk containing the whole state, such as
+// one assembled from all chunks sent by Terraform.
+func WriteStateBytesRequest(in *tfplugin6.WriteStateBytes_RequestChunk) *tfprotov6.WriteStateBytesRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.WriteStateBytesRequest{
+		TypeName: in.Meta.GetTypeName(),
+		StateID:  in.Meta.GetStateId(),
+		Bytes:    in.Bytes,
+	}
+
+	return resp
+}
+
+func LockStateRequest(in *tfplugin6.LockState_Request) *tfprotov6.LockStateRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.LockStateRequest{
+		TypeName:  in.TypeName,
+		StateID:   in.StateId,
+		Operation: in.Operation,
+	}
+
+	return resp
+}
+
+func UnlockStateRequest(in *tfplugin6.UnlockState_Request) *tfprotov6.UnlockStateRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.UnlockStateRequest{
+		TypeName: in.TypeName,
+		StateID:  in.StateId,
+		LockID:   in.LockId,
+	}
+
+	return resp
+}
+
+func GetStatesRequest(in *tfplugin6.GetStates_Request) *tfprotov6.GetStatesRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.GetStatesRequest{
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func DeleteStateRequest(in *tfplugin6.DeleteState_Request) *tfprotov6.DeleteStateRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov6.DeleteStateRequest{
+		TypeName: in.TypeName,
+		StateID:  in.StateId,
+	}
+
+	return resp
+}
+
+func ValidateStateStoreConfigResponse(in *tfplugin6.ValidateStateStoreConfig_Response) (*tfprotov6.ValidateStateStoreConfigResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ValidateStateStoreConfigResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func ConfigureStateStoreResponse(in *tfplugin6.ConfigureStateStore_Response) (*tfprotov6.ConfigureStateStoreResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ConfigureStateStoreResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+// ReadStateBytesResponse converts a response containing the whole state, such
+// as one recorded by the server.
+func ReadStateBytesResponse(in *tfplugin6.ReadStateBytes_Response) (*tfprotov6.ReadStateBytesResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.ReadStateBytesResponse{
+		Bytes:       in.Bytes,
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func WriteStateBytesResponse(in *tfplugin6.WriteStateBytes_Response) (*tfprotov6.WriteStateBytesResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.WriteStateBytesResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func LockStateResponse(in *tfplugin6.LockState_Response) (*tfprotov6.LockStateResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.LockStateResponse{
+		LockID:      in.LockId,
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func UnlockStateResponse(in *tfplugin6.UnlockState_Response) (*tfprotov6.UnlockStateResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.UnlockStateResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func GetStatesResponse(in *tfplugin6.GetStates_Response) (*tfprotov6.GetStatesResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.GetStatesResponse{
+		StateIDs:    in.StateId,
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
+
+func DeleteStateResponse(in *tfplugin6.DeleteState_Response) (*tfprotov6.DeleteStateResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	diags, err := Diagnostics(in.Diagnostics)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.DeleteStateResponse{
+		Diagnostics: diags,
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/fromproto"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+)
+
+func TestValidateStateStoreConfigRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfplugin6.ValidateStateStoreConfig_Request
+		expected *tfprotov6.ValidateStateStoreConfigRequest
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfplugin6.ValidateStateStoreConfig_Request{},
+			expected: &tfprotov6.ValidateStateStoreConfigRequest{},
+		},
+		"Config": {
+			in: &tfplugin6.ValidateStateStoreConfig_Request{
+				Config: testTfplugin6DynamicValue(),
+			},
+			expected: &tfprotov6.ValidateStateStoreConfigRequest{
+				Config: testTfprotov6DynamicValue(),
+			},
+		},
+		"TypeName": {
+			in: &tfplugin6.ValidateStateStoreConfig_Request{
+				TypeName: "test",
+			},
+			expected: &tfprotov6.ValidateStateStoreConfigRequest{
+				TypeName: "test",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto.ValidateStateStoreConfigRequest(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigureStateStoreRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfplugin6.ConfigureStateStore_Request
+		expected *tfprotov6.ConfigureStateStoreRequest
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfplugin6.ConfigureStateStore_Request{},
+			expected: &tfprotov6.ConfigureStateStoreRequest{},
+		},
+		"Capabilities": {
+			in: &tfplugin6.ConfigureStateStore_Request{
+				Capabilities: &tfplugin6.StateStoreClientCapabilities{
+					ChunkSize: 1024,
+				},
+			},
+			expected: &tfprotov6.ConfigureStateStoreRequest{},
+		},
+		"Config": {
+			in: &tfplugin6.ConfigureStateStore_Request{
+				Config: testTfplugin6DynamicValue(),
+			},
+			expected: &tfprotov6.ConfigureStateStoreRequest{
+				Config: testTfprotov6DynamicValue(),
+			},
+		},
+		"TypeName": {
+			in: &tfplugin6.ConfigureStateStore_Request{
+				TypeName: "test",
+			},
+			expected: &tfprotov6.ConfigureStateStoreRequest{
+				TypeName: "test",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto.ConfigureStateStoreRequest(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteStateBytesRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfplugin6.WriteStateBytes_RequestChunk
+		expected *tfprotov6.WriteStateBytesRequest
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfplugin6.WriteStateBytes_RequestChunk{},
+			expected: &tfprotov6.WriteStateBytesRequest{},
+		},
+		"Bytes": {
+			in: &tfplugin6.WriteStateBytes_RequestChunk{
+				Bytes:       []byte("test"),
+				TotalLength: 4,
+			},
+			expected: &tfprotov6.WriteStateBytesRequest{
+				Bytes: []byte("test"),
+			},
+		},
+		"Meta": {
+			in: &tfplugin6.WriteStateBytes_RequestChunk{
+				Meta: &tfplugin6.WriteStateBytes_RequestChunkMeta{
+					StateId:  "default",
+					TypeName: "test",
+				},
+			},
+			expected: &tfprotov6.WriteStateBytesRequest{
+				StateID:  "default",
+				TypeName: "test",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto.WriteStateBytesRequest(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLockStateRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfplugin6.LockState_Request
+		expected *tfprotov6.LockStateRequest
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfplugin6.LockState_Request{},
+			expected: &tfprotov6.LockStateRequest{},
+		},
+		"all": {
+			in: &tfplugin6.LockState_Request{
+				Operation: "apply",
+				StateId:   "default",
+				TypeName:  "test",
+			},
+			expected: &tfprotov6.LockStateRequest{
+				Operation: "apply",
+				StateID:   "default",
+				TypeName:  "test",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto.LockStateRequest(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReadStateBytesResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfplugin6.ReadStateBytes_Response
+		expected *tfprotov6.ReadStateBytesResponse
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfplugin6.ReadStateBytes_Response{},
+			expected: &tfprotov6.ReadStateBytesResponse{},
+		},
+		"all": {
+			in: &tfplugin6.ReadStateBytes_Response{
+				Bytes: []byte("test"),
+				Diagnostics: []*tfplugin6.Diagnostic{
+					{
+						Severity: tfplugin6.Diagnostic_WARNING,
+						Summary:  "test summary",
+					},
+				},
+				Range: &tfplugin6.StateRange{
+					End: 4,
+				},
+				TotalLength: 4,
+			},
+			expected: &tfprotov6.ReadStateBytesResponse{
+				Bytes: []byte("test"),
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityWarning,
+						Summary:  "test summary",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromproto.ReadStateBytesResponse(testCase.in)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetStatesResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfplugin6.GetStates_Response
+		expected *tfprotov6.GetStatesResponse
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfplugin6.GetStates_Response{},
+			expected: &tfprotov6.GetStatesResponse{},
+		},
+		"StateId": {
+			in: &tfplugin6.GetStates_Response{
+				StateId: []string{"default", "test"},
+			},
+			expected: &tfprotov6.GetStatesResponse{
+				StateIDs: []string{"default", "test"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromproto.GetStatesResponse(testCase.in)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
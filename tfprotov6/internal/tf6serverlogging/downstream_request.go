@@ -5,8 +5,10 @@ package tf6serverlogging
 
 import (
 	"context"
+	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-go/internal/clock"
 	"github.com/hashicorp/terraform-plugin-go/internal/logging"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/diag"
@@ -14,20 +16,46 @@ import (
 )
 
 // DownstreamRequest sets a request duration start time context key and
-// generates a TRACE "Sending request downstream" log.
+// generates a TRACE "Sending request downstream" log. If a slow RPC threshold
+// is set, it also starts a timer which generates a WARN "Downstream request
+// exceeded slow RPC threshold" log once the threshold elapses, so requests
+// which never respond are still reported. The timer is stopped by
+// DownstreamResponse, DownstreamResponseWithError, or DownstreamError.
 func DownstreamRequest(ctx context.Context) context.Context {
-	requestStart := time.Now()
+	requestStart := clock.FromContext(ctx).Now()
 	ctx = context.WithValue(ctx, ContextKeyDownstreamRequestStartTime{}, requestStart)
 
+	if threshold, ok := ctx.Value(ContextKeySlowRPCThreshold{}).(time.Duration); ok {
+		timer := &slowRPCTimer{
+			stopCh: make(chan struct{}),
+		}
+		ctx = context.WithValue(ctx, ContextKeySlowRPCTimer{}, timer)
+
+		go timer.run(ctx, requestStart, threshold)
+	}
+
 	logging.ProtocolTrace(ctx, "Sending request downstream")
 
 	return ctx
 }
 
+// SlowRPCThresholdContext sets the duration after which downstream requests
+// generate a WARN "Downstream request exceeded slow RPC threshold" log. A
+// threshold of zero or less disables the log.
+func SlowRPCThresholdContext(ctx context.Context, threshold time.Duration) context.Context {
+	if threshold <= 0 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, ContextKeySlowRPCThreshold{}, threshold)
+}
+
 // DownstreamResponse generates the following logging:
 //
 //   - TRACE "Received downstream response" log with request duration and
 //     diagnostic severity counts
+//   - WARN "Downstream request exceeded slow RPC threshold" log, if the
+//     request duration exceeded the slow RPC threshold
 //   - Per-diagnostic logs
 func DownstreamResponse(ctx context.Context, diagnostics diag.Diagnostics) {
 	responseFields := map[string]interface{}{
@@ -35,9 +63,7 @@ func DownstreamResponse(ctx context.Context, diagnostics diag.Diagnostics) {
 		logging.KeyDiagnosticWarningCount: diagnostics.WarningCount(),
 	}
 
-	if requestStart, ok := ctx.Value(ContextKeyDownstreamRequestStartTime{}).(time.Time); ok {
-		responseFields[logging.KeyRequestDurationMs] = time.Since(requestStart).Milliseconds()
-	}
+	downstreamRequestDuration(ctx, responseFields)
 
 	logging.ProtocolTrace(ctx, "Received downstream response", responseFields)
 	diagnostics.Log(ctx)
@@ -47,6 +73,8 @@ func DownstreamResponse(ctx context.Context, diagnostics diag.Diagnostics) {
 //
 //   - TRACE "Received downstream response" log with request duration and
 //     whether a function error is present
+//   - WARN "Downstream request exceeded slow RPC threshold" log, if the
+//     request duration exceeded the slow RPC threshold
 //   - Log with function error details
 func DownstreamResponseWithError(ctx context.Context, funcErr *tfprotov6.FunctionError) {
 	fe := (*funcerr.FunctionError)(funcErr)
@@ -55,10 +83,112 @@ func DownstreamResponseWithError(ctx context.Context, funcErr *tfprotov6.Functio
 		logging.KeyFunctionErrorExists: fe.HasError(),
 	}
 
-	if requestStart, ok := ctx.Value(ContextKeyDownstreamRequestStartTime{}).(time.Time); ok {
-		responseFields[logging.KeyRequestDurationMs] = time.Since(requestStart).Milliseconds()
-	}
+	downstreamRequestDuration(ctx, responseFields)
 
 	logging.ProtocolTrace(ctx, "Received downstream response", responseFields)
 	fe.Log(ctx)
 }
+
+// DownstreamError generates the following logging:
+//
+//   - ERROR "Error from downstream" log with request duration and error
+//   - WARN "Downstream request exceeded slow RPC threshold" log, if the
+//     request duration exceeded the slow RPC threshold
+func DownstreamError(ctx context.Context, err error) {
+	errorFields := map[string]interface{}{
+		logging.KeyError: err,
+	}
+
+	downstreamRequestDuration(ctx, errorFields)
+
+	logging.ProtocolError(ctx, "Error from downstream", errorFields)
+}
+
+// downstreamRequestDuration adds the request duration to the response log
+// fields, stops the slow RPC timer, and generates the slow RPC log, if
+// necessary and not already generated by the timer.
+func downstreamRequestDuration(ctx context.Context, responseFields map[string]interface{}) {
+	requestStart, ok := ctx.Value(ContextKeyDownstreamRequestStartTime{}).(time.Time)
+
+	if !ok {
+		return
+	}
+
+	duration := clock.Since(ctx, requestStart)
+	responseFields[logging.KeyRequestDurationMs] = duration.Milliseconds()
+
+	threshold, ok := ctx.Value(ContextKeySlowRPCThreshold{}).(time.Duration)
+
+	if !ok || duration <= threshold {
+		if timer, ok := ctx.Value(ContextKeySlowRPCTimer{}).(*slowRPCTimer); ok {
+			timer.stop()
+		}
+
+		return
+	}
+
+	if timer, ok := ctx.Value(ContextKeySlowRPCTimer{}).(*slowRPCTimer); ok && !timer.stop() {
+		return
+	}
+
+	slowRPC(ctx, duration, threshold)
+}
+
+// slowRPC generates the WARN "Downstream request exceeded slow RPC threshold"
+// log.
+func slowRPC(ctx context.Context, duration time.Duration, threshold time.Duration) {
+	logging.ProtocolWarn(ctx, "Downstream request exceeded slow RPC threshold", map[string]interface{}{
+		logging.KeyRequestDurationMs:  duration.Milliseconds(),
+		logging.KeySlowRPCThresholdMs: threshold.Milliseconds(),
+	})
+}
+
+// slowRPCTimer generates the slow RPC log for a downstream request which is
+// still in progress once the slow RPC threshold elapses. The log is generated
+// at most once across the timer and the downstream response.
+type slowRPCTimer struct {
+	mu      sync.Mutex
+	logged  bool
+	stopped bool
+	stopCh  chan struct{}
+}
+
+// run waits for the threshold to elapse, then generates the slow RPC log
+// unless the timer was stopped or the request context is done.
+func (t *slowRPCTimer) run(ctx context.Context, requestStart time.Time, threshold time.Duration) {
+	select {
+	case <-clock.FromContext(ctx).After(threshold):
+	case <-t.stopCh:
+		return
+	case <-ctx.Done():
+		return
+	}
+
+	t.mu.Lock()
+
+	if t.stopped {
+		t.mu.Unlock()
+		return
+	}
+
+	t.logged = true
+	t.mu.Unlock()
+
+	slowRPC(ctx, clock.Since(ctx, requestStart), threshold)
+}
+
+// stop stops the timer, returning false if the timer already generated the
+// slow RPC log or was already stopped.
+func (t *slowRPCTimer) stop() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stopped {
+		return false
+	}
+
+	t.stopped = true
+	close(t.stopCh)
+
+	return !t.logged
+}
@@ -6,6 +6,8 @@ package tf6serverlogging_test
 import (
 	"bytes"
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
 
+	"github.com/hashicorp/terraform-plugin-go/internal/clock"
 	"github.com/hashicorp/terraform-plugin-go/internal/logging"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/diag"
@@ -52,6 +55,394 @@ func TestDownstreamRequest(t *testing.T) {
 	}
 }
 
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) After(d time.Duration) <-chan time.Time {
+	c.now = c.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+
+	return ch
+}
+
+// testTimerClock is a clock which only fires when the test sends on its
+// after channel.
+type testTimerClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	after chan time.Time
+}
+
+func (c *testTimerClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testTimerClock) After(_ time.Duration) <-chan time.Time {
+	return c.after
+}
+
+func (c *testTimerClock) fire(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	now := c.now
+	c.mu.Unlock()
+
+	c.after <- now
+}
+
+// testNotifyingWriter is a concurrency safe io.Writer which notifies on each
+// write.
+type testNotifyingWriter struct {
+	mu      sync.Mutex
+	output  bytes.Buffer
+	written chan struct{}
+}
+
+func (w *testNotifyingWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	n, err := w.output.Write(p)
+	w.written <- struct{}{}
+
+	return n, err
+}
+
+func TestDownstreamRequest_SlowRPCTimer(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		// respond is called after the timer fires, if set, otherwise the
+		// downstream request is still in progress.
+		respond  func(context.Context)
+		expected []map[string]interface{}
+	}{
+		"in-progress": {
+			expected: []map[string]interface{}{
+				{
+					"@level":   "trace",
+					"@message": "Sending request downstream",
+					"@module":  "sdk.proto",
+				},
+				{
+					"@level":                   "warn",
+					"@message":                 "Downstream request exceeded slow RPC threshold",
+					"@module":                  "sdk.proto",
+					"tf_req_duration_ms":       float64(2000),
+					"tf_slow_rpc_threshold_ms": float64(1000),
+				},
+			},
+		},
+		"error": {
+			respond: func(ctx context.Context) {
+				tf6serverlogging.DownstreamError(ctx, errors.New("test error"))
+			},
+			expected: []map[string]interface{}{
+				{
+					"@level":   "trace",
+					"@message": "Sending request downstream",
+					"@module":  "sdk.proto",
+				},
+				{
+					"@level":                   "warn",
+					"@message":                 "Downstream request exceeded slow RPC threshold",
+					"@module":                  "sdk.proto",
+					"tf_req_duration_ms":       float64(2000),
+					"tf_slow_rpc_threshold_ms": float64(1000),
+				},
+				{
+					"@level":             "error",
+					"@message":           "Error from downstream",
+					"@module":            "sdk.proto",
+					"error":              "test error",
+					"tf_req_duration_ms": float64(2000),
+				},
+			},
+		},
+		"response": {
+			respond: func(ctx context.Context) {
+				tf6serverlogging.DownstreamResponse(ctx, nil)
+			},
+			expected: []map[string]interface{}{
+				{
+					"@level":   "trace",
+					"@message": "Sending request downstream",
+					"@module":  "sdk.proto",
+				},
+				{
+					"@level":                   "warn",
+					"@message":                 "Downstream request exceeded slow RPC threshold",
+					"@module":                  "sdk.proto",
+					"tf_req_duration_ms":       float64(2000),
+					"tf_slow_rpc_threshold_ms": float64(1000),
+				},
+				{
+					"@level":   "trace",
+					"@message": "Received downstream response",
+					"@module":  "sdk.proto",
+					// go-hclog treats int as float64
+					"diagnostic_error_count":   float64(0),
+					"diagnostic_warning_count": float64(0),
+					"tf_req_duration_ms":       float64(2000),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output := &testNotifyingWriter{
+				written: make(chan struct{}, 10),
+			}
+
+			testClock := &testTimerClock{
+				now:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				after: make(chan time.Time),
+			}
+
+			ctx := tfsdklogtest.RootLogger(context.Background(), output)
+			ctx = logging.ProtoSubsystemContext(ctx, tfsdklog.Options{})
+			ctx = clock.NewContext(ctx, testClock)
+			ctx = tf6serverlogging.SlowRPCThresholdContext(ctx, time.Second)
+			ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+			<-output.written
+
+			testClock.fire(2 * time.Second)
+
+			select {
+			case <-output.written:
+			case <-time.After(10 * time.Second):
+				t.Fatal("timed out waiting for slow RPC log")
+			}
+
+			if testCase.respond != nil {
+				testCase.respond(ctx)
+			}
+
+			output.mu.Lock()
+			defer output.mu.Unlock()
+
+			entries, err := tfsdklogtest.MultilineJSONDecode(&output.output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			if diff := cmp.Diff(entries, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDownstreamRequest_SlowRPCTimerStopped(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	testClock := &testTimerClock{
+		now:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		after: make(chan time.Time, 1),
+	}
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = logging.ProtoSubsystemContext(ctx, tfsdklog.Options{})
+	ctx = clock.NewContext(ctx, testClock)
+	ctx = tf6serverlogging.SlowRPCThresholdContext(ctx, time.Second)
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	tf6serverlogging.DownstreamResponse(ctx, nil)
+
+	// The timer firing after the response must not generate the slow RPC
+	// log, as the request duration was under the threshold.
+	testClock.fire(2 * time.Second)
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	expectedEntries := []map[string]interface{}{
+		{
+			"@level":   "trace",
+			"@message": "Sending request downstream",
+			"@module":  "sdk.proto",
+		},
+		{
+			"@level":   "trace",
+			"@message": "Received downstream response",
+			"@module":  "sdk.proto",
+			// go-hclog treats int as float64
+			"diagnostic_error_count":   float64(0),
+			"diagnostic_warning_count": float64(0),
+			"tf_req_duration_ms":       float64(0),
+		},
+	}
+
+	if diff := cmp.Diff(entries, expectedEntries); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestDownstreamResponse_Clock(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	testClock := &testClock{
+		now: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = logging.ProtoSubsystemContext(ctx, tfsdklog.Options{})
+	ctx = clock.NewContext(ctx, testClock)
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	testClock.now = testClock.now.Add(1500 * time.Millisecond)
+
+	tf6serverlogging.DownstreamResponse(ctx, nil)
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	expectedEntries := []map[string]interface{}{
+		{
+			"@level":   "trace",
+			"@message": "Sending request downstream",
+			"@module":  "sdk.proto",
+		},
+		{
+			"@level":   "trace",
+			"@message": "Received downstream response",
+			"@module":  "sdk.proto",
+			// go-hclog treats int as float64
+			"diagnostic_error_count":   float64(0),
+			"diagnostic_warning_count": float64(0),
+			"tf_req_duration_ms":       float64(1500),
+		},
+	}
+
+	if diff := cmp.Diff(entries, expectedEntries); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestDownstreamResponse_SlowRPCThreshold(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		threshold time.Duration
+		duration  time.Duration
+		expected  []map[string]interface{}
+	}{
+		"disabled": {
+			threshold: 0,
+			duration:  time.Hour,
+			expected: []map[string]interface{}{
+				{
+					"@level":   "trace",
+					"@message": "Received downstream response",
+					"@module":  "sdk.proto",
+					// go-hclog treats int as float64
+					"diagnostic_error_count":   float64(0),
+					"diagnostic_warning_count": float64(0),
+					"tf_req_duration_ms":       float64(3600000),
+				},
+			},
+		},
+		"under-threshold": {
+			threshold: time.Second,
+			duration:  time.Second,
+			expected: []map[string]interface{}{
+				{
+					"@level":   "trace",
+					"@message": "Received downstream response",
+					"@module":  "sdk.proto",
+					// go-hclog treats int as float64
+					"diagnostic_error_count":   float64(0),
+					"diagnostic_warning_count": float64(0),
+					"tf_req_duration_ms":       float64(1000),
+				},
+			},
+		},
+		"over-threshold": {
+			threshold: time.Second,
+			duration:  2 * time.Second,
+			expected: []map[string]interface{}{
+				{
+					"@level":                   "warn",
+					"@message":                 "Downstream request exceeded slow RPC threshold",
+					"@module":                  "sdk.proto",
+					"tf_req_duration_ms":       float64(2000),
+					"tf_slow_rpc_threshold_ms": float64(1000),
+				},
+				{
+					"@level":   "trace",
+					"@message": "Received downstream response",
+					"@module":  "sdk.proto",
+					// go-hclog treats int as float64
+					"diagnostic_error_count":   float64(0),
+					"diagnostic_warning_count": float64(0),
+					"tf_req_duration_ms":       float64(2000),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			testClock := &testClock{
+				now: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			}
+
+			ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+			ctx = clock.NewContext(ctx, testClock)
+			ctx = tf6serverlogging.SlowRPCThresholdContext(ctx, testCase.threshold)
+			ctx = context.WithValue(ctx, tf6serverlogging.ContextKeyDownstreamRequestStartTime{}, testClock.now)
+			ctx = logging.ProtoSubsystemContext(ctx, tfsdklog.Options{})
+
+			testClock.now = testClock.now.Add(testCase.duration)
+
+			tf6serverlogging.DownstreamResponse(ctx, nil)
+
+			entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			if diff := cmp.Diff(entries, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestDownstreamResponse(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6serverlogging
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/internal/logging"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// PlanAnnotations generates a TRACE "Received downstream plan annotation" log
+// for each annotation.
+func PlanAnnotations(ctx context.Context, annotations []*tfprotov6.PlanAnnotation) {
+	for _, annotation := range annotations {
+		if annotation == nil {
+			continue
+		}
+
+		annotationFields := map[string]interface{}{
+			logging.KeyPlanAnnotationKind:   annotation.Kind.String(),
+			logging.KeyPlanAnnotationReason: annotation.Reason,
+		}
+
+		if annotation.Attribute != nil {
+			annotationFields[logging.KeyPlanAnnotationAttribute] = annotation.Attribute.String()
+		}
+
+		logging.ProtocolTrace(ctx, "Received downstream plan annotation", annotationFields)
+	}
+}
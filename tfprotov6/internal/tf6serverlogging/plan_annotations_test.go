@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6serverlogging_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/internal/logging"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tf6serverlogging"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+)
+
+func TestPlanAnnotations(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		annotations []*tfprotov6.PlanAnnotation
+		expected    []map[string]interface{}
+	}{
+		"nil": {
+			annotations: nil,
+			expected:    nil,
+		},
+		"nil-annotation": {
+			annotations: []*tfprotov6.PlanAnnotation{nil},
+			expected:    nil,
+		},
+		"annotations": {
+			annotations: []*tfprotov6.PlanAnnotation{
+				{
+					Kind:      tfprotov6.PlanAnnotationKindUnknown,
+					Attribute: tftypes.NewAttributePath().WithAttributeName("endpoint"),
+					Reason:    "The endpoint is assigned after creation.",
+				},
+				{
+					Kind:   tfprotov6.PlanAnnotationKindRequiresReplace,
+					Reason: "The region cannot be updated.",
+				},
+			},
+			expected: []map[string]interface{}{
+				{
+					"@level":                       "trace",
+					"@message":                     "Received downstream plan annotation",
+					"@module":                      "sdk.proto",
+					"tf_plan_annotation_attribute": `AttributeName("endpoint")`,
+					"tf_plan_annotation_kind":      "UNKNOWN",
+					"tf_plan_annotation_reason":    "The endpoint is assigned after creation.",
+				},
+				{
+					"@level":                    "trace",
+					"@message":                  "Received downstream plan annotation",
+					"@module":                   "sdk.proto",
+					"tf_plan_annotation_kind":   "REQUIRES_REPLACE",
+					"tf_plan_annotation_reason": "The region cannot be updated.",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+			ctx = logging.ProtoSubsystemContext(ctx, tfsdklog.Options{})
+
+			tf6serverlogging.PlanAnnotations(ctx, testCase.annotations)
+
+			entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			if diff := cmp.Diff(entries, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+)
+
+func GetMetadata_ActionMetadata(in *tfprotov6.ActionMetadata) *tfplugin6.GetMetadata_ActionMetadata {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.GetMetadata_ActionMetadata{
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func ActionSchema(in *tfprotov6.ActionSchema) *tfplugin6.ActionSchema {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ActionSchema{
+		Schema: Schema(in.Schema),
+	}
+
+	return resp
+}
+
+func ValidateActionConfig_Response(in *tfprotov6.ValidateActionConfigResponse) *tfplugin6.ValidateActionConfig_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ValidateActionConfig_Response{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+
+	return resp
+}
+
+// InvokeAction_Event returns the protocol event of an InvokeActionEvent. The
+// type of the returned event is not set for unknown event types.
+func InvokeAction_Event(in *tfprotov6.InvokeActionEvent) *tfplugin6.InvokeAction_Event {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.InvokeAction_Event{}
+
+	switch eventType := in.Type.(type) {
+	case tfprotov6.ProgressInvokeActionEventType:
+		resp.Type = &tfplugin6.InvokeAction_Event_Progress_{
+			Progress: &tfplugin6.InvokeAction_Event_Progress{
+				Message: eventType.Message,
+			},
+		}
+	case tfprotov6.CompletedInvokeActionEventType:
+		resp.Type = &tfplugin6.InvokeAction_Event_Completed_{
+			Completed: &tfplugin6.InvokeAction_Event_Completed{
+				Diagnostics: Diagnostics(eventType.Diagnostics),
+			},
+		}
+	}
+
+	return resp
+}
+
+func ValidateActionConfig_Request(in *tfprotov6.ValidateActionConfigRequest) *tfplugin6.ValidateActionConfig_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ValidateActionConfig_Request{
+		ActionType: in.ActionType,
+		Config:     DynamicValue(in.Config),
+	}
+
+	return resp
+}
+
+func InvokeAction_Request(in *tfprotov6.InvokeActionRequest) *tfplugin6.InvokeAction_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.InvokeAction_Request{
+		ActionType: in.ActionType,
+		Config:     DynamicValue(in.Config),
+	}
+
+	return resp
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/toproto"
+)
+
+func TestValidateActionConfig_Response(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.ValidateActionConfigResponse
+		expected *tfplugin6.ValidateActionConfig_Response
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in: &tfprotov6.ValidateActionConfigResponse{},
+			expected: &tfplugin6.ValidateActionConfig_Response{
+				Diagnostics: []*tfplugin6.Diagnostic{},
+			},
+		},
+		"Diagnostics": {
+			in: &tfprotov6.ValidateActionConfigResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					testTfprotov6Diagnostic,
+				},
+			},
+			expected: &tfplugin6.ValidateActionConfig_Response{
+				Diagnostics: []*tfplugin6.Diagnostic{
+					testTfplugin6Diagnostic,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto.ValidateActionConfig_Response(testCase.in)
+
+			// Protocol Buffers generated types must have unexported fields
+			// ignored or cmp.Diff() will raise an error. This is easier than
+			// writing a custom Comparer for each type, which would have no
+			// benefits.
+			diffOpts := cmpopts.IgnoreUnexported(
+				tfplugin6.Diagnostic{},
+				tfplugin6.ValidateActionConfig_Response{},
+			)
+
+			if diff := cmp.Diff(got, testCase.expected, diffOpts); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInvokeAction_Event(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.InvokeActionEvent
+		expected *tfplugin6.InvokeAction_Event
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in:       &tfprotov6.InvokeActionEvent{},
+			expected: &tfplugin6.InvokeAction_Event{},
+		},
+		"Progress": {
+			in: &tfprotov6.InvokeActionEvent{
+				Type: tfprotov6.ProgressInvokeActionEventType{
+					Message: "test",
+				},
+			},
+			expected: &tfplugin6.InvokeAction_Event{
+				Type: &tfplugin6.InvokeAction_Event_Progress_{
+					Progress: &tfplugin6.InvokeAction_Event_Progress{
+						Message: "test",
+					},
+				},
+			},
+		},
+		"Completed": {
+			in: &tfprotov6.InvokeActionEvent{
+				Type: tfprotov6.CompletedInvokeActionEventType{
+					Diagnostics: []*tfprotov6.Diagnostic{
+						testTfprotov6Diagnostic,
+					},
+				},
+			},
+			expected: &tfplugin6.InvokeAction_Event{
+				Type: &tfplugin6.InvokeAction_Event_Completed_{
+					Completed: &tfplugin6.InvokeAction_Event_Completed{
+						Diagnostics: []*tfplugin6.Diagnostic{
+							testTfplugin6Diagnostic,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto.InvokeAction_Event(testCase.in)
+
+			// Protocol Buffers generated types must have unexported fields
+			// ignored or cmp.Diff() will raise an error. This is easier than
+			// writing a custom Comparer for each type, which would have no
+			// benefits.
+			diffOpts := cmpopts.IgnoreUnexported(
+				tfplugin6.Diagnostic{},
+				tfplugin6.InvokeAction_Event{},
+				tfplugin6.InvokeAction_Event_Completed{},
+				tfplugin6.InvokeAction_Event_Progress{},
+			)
+
+			if diff := cmp.Diff(got, testCase.expected, diffOpts); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
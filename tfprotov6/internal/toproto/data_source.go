@@ -43,3 +43,31 @@ func ReadDataSource_Response(in *tfprotov6.ReadDataSourceResponse) *tfplugin6.Re
 
 	return resp
 }
+
+func ValidateDataResourceConfig_Request(in *tfprotov6.ValidateDataResourceConfigRequest) *tfplugin6.ValidateDataResourceConfig_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ValidateDataResourceConfig_Request{
+		Config:   DynamicValue(in.Config),
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func ReadDataSource_Request(in *tfprotov6.ReadDataSourceRequest) *tfplugin6.ReadDataSource_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ReadDataSource_Request{
+		ClientCapabilities: ReadDataSourceClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		ProviderMeta:       DynamicValue(in.ProviderMeta),
+		TypeName:           in.TypeName,
+	}
+
+	return resp
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+)
+
+func GetMetadata_EphemeralResourceMetadata(in *tfprotov6.EphemeralResourceMetadata) *tfplugin6.GetMetadata_EphemeralResourceMetadata {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.GetMetadata_EphemeralResourceMetadata{
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func ValidateEphemeralResourceConfig_Response(in *tfprotov6.ValidateEphemeralResourceConfigResponse) *tfplugin6.ValidateEphemeralResourceConfig_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ValidateEphemeralResourceConfig_Response{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+
+	return resp
+}
+
+func OpenEphemeralResource_Response(in *tfprotov6.OpenEphemeralResourceResponse) *tfplugin6.OpenEphemeralResource_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.OpenEphemeralResource_Response{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: Diagnostics(in.Diagnostics),
+		Private:     in.Private,
+		RenewAt:     Timestamp(in.RenewAt),
+		Result:      DynamicValue(in.Result),
+	}
+
+	return resp
+}
+
+func RenewEphemeralResource_Response(in *tfprotov6.RenewEphemeralResourceResponse) *tfplugin6.RenewEphemeralResource_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.RenewEphemeralResource_Response{
+		Diagnostics: Diagnostics(in.Diagnostics),
+		Private:     in.Private,
+		RenewAt:     Timestamp(in.RenewAt),
+	}
+
+	return resp
+}
+
+func CloseEphemeralResource_Response(in *tfprotov6.CloseEphemeralResourceResponse) *tfplugin6.CloseEphemeralResource_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.CloseEphemeralResource_Response{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+
+	return resp
+}
+
+func ValidateEphemeralResourceConfig_Request(in *tfprotov6.ValidateEphemeralResourceConfigRequest) *tfplugin6.ValidateEphemeralResourceConfig_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ValidateEphemeralResourceConfig_Request{
+		Config:   DynamicValue(in.Config),
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func OpenEphemeralResource_Request(in *tfprotov6.OpenEphemeralResourceRequest) *tfplugin6.OpenEphemeralResource_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.OpenEphemeralResource_Request{
+		ClientCapabilities: OpenEphemeralResourceClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TypeName:           in.TypeName,
+	}
+
+	return resp
+}
+
+func RenewEphemeralResource_Request(in *tfprotov6.RenewEphemeralResourceRequest) *tfplugin6.RenewEphemeralResource_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.RenewEphemeralResource_Request{
+		Private:  in.Private,
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func CloseEphemeralResource_Request(in *tfprotov6.CloseEphemeralResourceRequest) *tfplugin6.CloseEphemeralResource_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.CloseEphemeralResource_Request{
+		Private:  in.Private,
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+// Timestamp returns the protocol timestamp of a time, or nil for the zero
+// time.
+func Timestamp(in time.Time) *timestamppb.Timestamp {
+	if in.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(in)
+}
@@ -98,3 +98,30 @@ func GetMetadata_FunctionMetadata(in *tfprotov6.FunctionMetadata) *tfplugin6.Get
 		Name: in.Name,
 	}
 }
+
+func CallFunction_Request(in *tfprotov6.CallFunctionRequest) *tfplugin6.CallFunction_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.CallFunction_Request{
+		Arguments: make([]*tfplugin6.DynamicValue, 0, len(in.Arguments)),
+		Name:      in.Name,
+	}
+
+	for _, argument := range in.Arguments {
+		resp.Arguments = append(resp.Arguments, DynamicValue(argument))
+	}
+
+	return resp
+}
+
+func GetFunctions_Request(in *tfprotov6.GetFunctionsRequest) *tfplugin6.GetFunctions_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.GetFunctions_Request{}
+
+	return resp
+}
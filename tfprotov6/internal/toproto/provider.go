@@ -14,17 +14,28 @@ func GetMetadata_Response(in *tfprotov6.GetMetadataResponse) *tfplugin6.GetMetad
 	}
 
 	resp := &tfplugin6.GetMetadata_Response{
+		Actions:            make([]*tfplugin6.GetMetadata_ActionMetadata, 0, len(in.Actions)),
 		DataSources:        make([]*tfplugin6.GetMetadata_DataSourceMetadata, 0, len(in.DataSources)),
 		Diagnostics:        Diagnostics(in.Diagnostics),
+		EphemeralResources: make([]*tfplugin6.GetMetadata_EphemeralResourceMetadata, 0, len(in.EphemeralResources)),
 		Functions:          make([]*tfplugin6.GetMetadata_FunctionMetadata, 0, len(in.Functions)),
 		Resources:          make([]*tfplugin6.GetMetadata_ResourceMetadata, 0, len(in.Resources)),
 		ServerCapabilities: ServerCapabilities(in.ServerCapabilities),
+		StateStores:        make([]*tfplugin6.GetMetadata_StateStoreMetadata, 0, len(in.StateStores)),
+	}
+
+	for _, action := range in.Actions {
+		resp.Actions = append(resp.Actions, GetMetadata_ActionMetadata(&action))
 	}
 
 	for _, datasource := range in.DataSources {
 		resp.DataSources = append(resp.DataSources, GetMetadata_DataSourceMetadata(&datasource))
 	}
 
+	for _, ephemeralResource := range in.EphemeralResources {
+		resp.EphemeralResources = append(resp.EphemeralResources, GetMetadata_EphemeralResourceMetadata(&ephemeralResource))
+	}
+
 	for _, function := range in.Functions {
 		resp.Functions = append(resp.Functions, GetMetadata_FunctionMetadata(&function))
 	}
@@ -33,6 +44,10 @@ func GetMetadata_Response(in *tfprotov6.GetMetadataResponse) *tfplugin6.GetMetad
 		resp.Resources = append(resp.Resources, GetMetadata_ResourceMetadata(&resource))
 	}
 
+	for _, stateStore := range in.StateStores {
+		resp.StateStores = append(resp.StateStores, GetMetadata_StateStoreMetadata(&stateStore))
+	}
+
 	return resp
 }
 
@@ -42,13 +57,16 @@ func GetProviderSchema_Response(in *tfprotov6.GetProviderSchemaResponse) *tfplug
 	}
 
 	resp := &tfplugin6.GetProviderSchema_Response{
-		DataSourceSchemas:  make(map[string]*tfplugin6.Schema, len(in.DataSourceSchemas)),
-		Diagnostics:        Diagnostics(in.Diagnostics),
-		Functions:          make(map[string]*tfplugin6.Function, len(in.Functions)),
-		Provider:           Schema(in.Provider),
-		ProviderMeta:       Schema(in.ProviderMeta),
-		ResourceSchemas:    make(map[string]*tfplugin6.Schema, len(in.ResourceSchemas)),
-		ServerCapabilities: ServerCapabilities(in.ServerCapabilities),
+		ActionSchemas:            make(map[string]*tfplugin6.ActionSchema, len(in.ActionSchemas)),
+		DataSourceSchemas:        make(map[string]*tfplugin6.Schema, len(in.DataSourceSchemas)),
+		Diagnostics:              Diagnostics(in.Diagnostics),
+		EphemeralResourceSchemas: make(map[string]*tfplugin6.Schema, len(in.EphemeralResourceSchemas)),
+		Functions:                make(map[string]*tfplugin6.Function, len(in.Functions)),
+		Provider:                 Schema(in.Provider),
+		ProviderMeta:             Schema(in.ProviderMeta),
+		ResourceSchemas:          make(map[string]*tfplugin6.Schema, len(in.ResourceSchemas)),
+		ServerCapabilities:       ServerCapabilities(in.ServerCapabilities),
+		StateStoreSchemas:        make(map[string]*tfplugin6.Schema, len(in.StateStoreSchemas)),
 	}
 
 	for name, schema := range in.ResourceSchemas {
@@ -59,6 +77,18 @@ func GetProviderSchema_Response(in *tfprotov6.GetProviderSchemaResponse) *tfplug
 		resp.DataSourceSchemas[name] = Schema(schema)
 	}
 
+	for name, schema := range in.EphemeralResourceSchemas {
+		resp.EphemeralResourceSchemas[name] = Schema(schema)
+	}
+
+	for name, schema := range in.ActionSchemas {
+		resp.ActionSchemas[name] = ActionSchema(schema)
+	}
+
+	for name, schema := range in.StateStoreSchemas {
+		resp.StateStoreSchemas[name] = Schema(schema)
+	}
+
 	for name, function := range in.Functions {
 		resp.Functions[name] = Function(function)
 	}
@@ -101,3 +131,59 @@ func StopProvider_Response(in *tfprotov6.StopProviderResponse) *tfplugin6.StopPr
 
 	return resp
 }
+
+func GetMetadata_Request(in *tfprotov6.GetMetadataRequest) *tfplugin6.GetMetadata_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.GetMetadata_Request{}
+
+	return resp
+}
+
+func GetProviderSchema_Request(in *tfprotov6.GetProviderSchemaRequest) *tfplugin6.GetProviderSchema_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.GetProviderSchema_Request{}
+
+	return resp
+}
+
+func ValidateProviderConfig_Request(in *tfprotov6.ValidateProviderConfigRequest) *tfplugin6.ValidateProviderConfig_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ValidateProviderConfig_Request{
+		Config: DynamicValue(in.Config),
+	}
+
+	return resp
+}
+
+func ConfigureProvider_Request(in *tfprotov6.ConfigureProviderRequest) *tfplugin6.ConfigureProvider_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ConfigureProvider_Request{
+		ClientCapabilities: ConfigureProviderClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TerraformVersion:   in.TerraformVersion,
+	}
+
+	return resp
+}
+
+func StopProvider_Request(in *tfprotov6.StopProviderRequest) *tfplugin6.StopProvider_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.StopProvider_Request{}
+
+	return resp
+}
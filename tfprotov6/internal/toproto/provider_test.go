@@ -83,10 +83,35 @@ func TestGetMetadata_Response(t *testing.T) {
 		"zero": {
 			in: &tfprotov6.GetMetadataResponse{},
 			expected: &tfplugin6.GetMetadata_Response{
-				DataSources: []*tfplugin6.GetMetadata_DataSourceMetadata{},
-				Diagnostics: []*tfplugin6.Diagnostic{},
-				Functions:   []*tfplugin6.GetMetadata_FunctionMetadata{},
-				Resources:   []*tfplugin6.GetMetadata_ResourceMetadata{},
+				Actions:            []*tfplugin6.GetMetadata_ActionMetadata{},
+				DataSources:        []*tfplugin6.GetMetadata_DataSourceMetadata{},
+				Diagnostics:        []*tfplugin6.Diagnostic{},
+				EphemeralResources: []*tfplugin6.GetMetadata_EphemeralResourceMetadata{},
+				Functions:          []*tfplugin6.GetMetadata_FunctionMetadata{},
+				Resources:          []*tfplugin6.GetMetadata_ResourceMetadata{},
+				StateStores:        []*tfplugin6.GetMetadata_StateStoreMetadata{},
+			},
+		},
+		"Actions": {
+			in: &tfprotov6.GetMetadataResponse{
+				Actions: []tfprotov6.ActionMetadata{
+					{
+						TypeName: "test",
+					},
+				},
+			},
+			expected: &tfplugin6.GetMetadata_Response{
+				Actions: []*tfplugin6.GetMetadata_ActionMetadata{
+					{
+						TypeName: "test",
+					},
+				},
+				DataSources:        []*tfplugin6.GetMetadata_DataSourceMetadata{},
+				Diagnostics:        []*tfplugin6.Diagnostic{},
+				EphemeralResources: []*tfplugin6.GetMetadata_EphemeralResourceMetadata{},
+				Functions:          []*tfplugin6.GetMetadata_FunctionMetadata{},
+				Resources:          []*tfplugin6.GetMetadata_ResourceMetadata{},
+				StateStores:        []*tfplugin6.GetMetadata_StateStoreMetadata{},
 			},
 		},
 		"DataSources": {
@@ -98,14 +123,17 @@ func TestGetMetadata_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin6.GetMetadata_Response{
+				Actions: []*tfplugin6.GetMetadata_ActionMetadata{},
 				DataSources: []*tfplugin6.GetMetadata_DataSourceMetadata{
 					{
 						TypeName: "test",
 					},
 				},
-				Diagnostics: []*tfplugin6.Diagnostic{},
-				Functions:   []*tfplugin6.GetMetadata_FunctionMetadata{},
-				Resources:   []*tfplugin6.GetMetadata_ResourceMetadata{},
+				Diagnostics:        []*tfplugin6.Diagnostic{},
+				EphemeralResources: []*tfplugin6.GetMetadata_EphemeralResourceMetadata{},
+				Functions:          []*tfplugin6.GetMetadata_FunctionMetadata{},
+				Resources:          []*tfplugin6.GetMetadata_ResourceMetadata{},
+				StateStores:        []*tfplugin6.GetMetadata_StateStoreMetadata{},
 			},
 		},
 		"Diagnostics": {
@@ -115,12 +143,37 @@ func TestGetMetadata_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin6.GetMetadata_Response{
+				Actions:     []*tfplugin6.GetMetadata_ActionMetadata{},
 				DataSources: []*tfplugin6.GetMetadata_DataSourceMetadata{},
 				Diagnostics: []*tfplugin6.Diagnostic{
 					testTfplugin6Diagnostic,
 				},
-				Functions: []*tfplugin6.GetMetadata_FunctionMetadata{},
-				Resources: []*tfplugin6.GetMetadata_ResourceMetadata{},
+				EphemeralResources: []*tfplugin6.GetMetadata_EphemeralResourceMetadata{},
+				Functions:          []*tfplugin6.GetMetadata_FunctionMetadata{},
+				Resources:          []*tfplugin6.GetMetadata_ResourceMetadata{},
+				StateStores:        []*tfplugin6.GetMetadata_StateStoreMetadata{},
+			},
+		},
+		"EphemeralResources": {
+			in: &tfprotov6.GetMetadataResponse{
+				EphemeralResources: []tfprotov6.EphemeralResourceMetadata{
+					{
+						TypeName: "test",
+					},
+				},
+			},
+			expected: &tfplugin6.GetMetadata_Response{
+				Actions:     []*tfplugin6.GetMetadata_ActionMetadata{},
+				DataSources: []*tfplugin6.GetMetadata_DataSourceMetadata{},
+				Diagnostics: []*tfplugin6.Diagnostic{},
+				EphemeralResources: []*tfplugin6.GetMetadata_EphemeralResourceMetadata{
+					{
+						TypeName: "test",
+					},
+				},
+				Functions:   []*tfplugin6.GetMetadata_FunctionMetadata{},
+				Resources:   []*tfplugin6.GetMetadata_ResourceMetadata{},
+				StateStores: []*tfplugin6.GetMetadata_StateStoreMetadata{},
 			},
 		},
 		"Functions": {
@@ -132,14 +185,17 @@ func TestGetMetadata_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin6.GetMetadata_Response{
-				DataSources: []*tfplugin6.GetMetadata_DataSourceMetadata{},
-				Diagnostics: []*tfplugin6.Diagnostic{},
+				Actions:            []*tfplugin6.GetMetadata_ActionMetadata{},
+				DataSources:        []*tfplugin6.GetMetadata_DataSourceMetadata{},
+				Diagnostics:        []*tfplugin6.Diagnostic{},
+				EphemeralResources: []*tfplugin6.GetMetadata_EphemeralResourceMetadata{},
 				Functions: []*tfplugin6.GetMetadata_FunctionMetadata{
 					{
 						Name: "test",
 					},
 				},
-				Resources: []*tfplugin6.GetMetadata_ResourceMetadata{},
+				Resources:   []*tfplugin6.GetMetadata_ResourceMetadata{},
+				StateStores: []*tfplugin6.GetMetadata_StateStoreMetadata{},
 			},
 		},
 		"Resources": {
@@ -151,14 +207,17 @@ func TestGetMetadata_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin6.GetMetadata_Response{
-				DataSources: []*tfplugin6.GetMetadata_DataSourceMetadata{},
-				Diagnostics: []*tfplugin6.Diagnostic{},
-				Functions:   []*tfplugin6.GetMetadata_FunctionMetadata{},
+				Actions:            []*tfplugin6.GetMetadata_ActionMetadata{},
+				DataSources:        []*tfplugin6.GetMetadata_DataSourceMetadata{},
+				Diagnostics:        []*tfplugin6.Diagnostic{},
+				EphemeralResources: []*tfplugin6.GetMetadata_EphemeralResourceMetadata{},
+				Functions:          []*tfplugin6.GetMetadata_FunctionMetadata{},
 				Resources: []*tfplugin6.GetMetadata_ResourceMetadata{
 					{
 						TypeName: "test",
 					},
 				},
+				StateStores: []*tfplugin6.GetMetadata_StateStoreMetadata{},
 			},
 		},
 		"ServerCapabilities": {
@@ -168,13 +227,38 @@ func TestGetMetadata_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin6.GetMetadata_Response{
-				DataSources: []*tfplugin6.GetMetadata_DataSourceMetadata{},
-				Diagnostics: []*tfplugin6.Diagnostic{},
-				Functions:   []*tfplugin6.GetMetadata_FunctionMetadata{},
-				Resources:   []*tfplugin6.GetMetadata_ResourceMetadata{},
+				Actions:            []*tfplugin6.GetMetadata_ActionMetadata{},
+				DataSources:        []*tfplugin6.GetMetadata_DataSourceMetadata{},
+				Diagnostics:        []*tfplugin6.Diagnostic{},
+				EphemeralResources: []*tfplugin6.GetMetadata_EphemeralResourceMetadata{},
+				Functions:          []*tfplugin6.GetMetadata_FunctionMetadata{},
+				Resources:          []*tfplugin6.GetMetadata_ResourceMetadata{},
 				ServerCapabilities: &tfplugin6.ServerCapabilities{
 					PlanDestroy: true,
 				},
+				StateStores: []*tfplugin6.GetMetadata_StateStoreMetadata{},
+			},
+		},
+		"StateStores": {
+			in: &tfprotov6.GetMetadataResponse{
+				StateStores: []tfprotov6.StateStoreMetadata{
+					{
+						TypeName: "test",
+					},
+				},
+			},
+			expected: &tfplugin6.GetMetadata_Response{
+				Actions:            []*tfplugin6.GetMetadata_ActionMetadata{},
+				DataSources:        []*tfplugin6.GetMetadata_DataSourceMetadata{},
+				Diagnostics:        []*tfplugin6.Diagnostic{},
+				EphemeralResources: []*tfplugin6.GetMetadata_EphemeralResourceMetadata{},
+				Functions:          []*tfplugin6.GetMetadata_FunctionMetadata{},
+				Resources:          []*tfplugin6.GetMetadata_ResourceMetadata{},
+				StateStores: []*tfplugin6.GetMetadata_StateStoreMetadata{
+					{
+						TypeName: "test",
+					},
+				},
 			},
 		},
 	}
@@ -193,10 +277,13 @@ func TestGetMetadata_Response(t *testing.T) {
 			// benefits.
 			diffOpts := cmpopts.IgnoreUnexported(
 				tfplugin6.Diagnostic{},
+				tfplugin6.GetMetadata_ActionMetadata{},
 				tfplugin6.GetMetadata_DataSourceMetadata{},
+				tfplugin6.GetMetadata_EphemeralResourceMetadata{},
 				tfplugin6.GetMetadata_FunctionMetadata{},
 				tfplugin6.GetMetadata_Response{},
 				tfplugin6.GetMetadata_ResourceMetadata{},
+				tfplugin6.GetMetadata_StateStoreMetadata{},
 				tfplugin6.ServerCapabilities{},
 			)
 
@@ -221,10 +308,13 @@ func TestGetProviderSchema_Response(t *testing.T) {
 		"zero": {
 			in: &tfprotov6.GetProviderSchemaResponse{},
 			expected: &tfplugin6.GetProviderSchema_Response{
-				DataSourceSchemas: map[string]*tfplugin6.Schema{},
-				Diagnostics:       []*tfplugin6.Diagnostic{},
-				Functions:         map[string]*tfplugin6.Function{},
-				ResourceSchemas:   map[string]*tfplugin6.Schema{},
+				ActionSchemas:            map[string]*tfplugin6.ActionSchema{},
+				DataSourceSchemas:        map[string]*tfplugin6.Schema{},
+				Diagnostics:              []*tfplugin6.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin6.Schema{},
+				Functions:                map[string]*tfplugin6.Function{},
+				ResourceSchemas:          map[string]*tfplugin6.Schema{},
+				StateStoreSchemas:        map[string]*tfplugin6.Schema{},
 			},
 		},
 		"DataSources": {
@@ -242,6 +332,7 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin6.GetProviderSchema_Response{
+				ActionSchemas: map[string]*tfplugin6.ActionSchema{},
 				DataSourceSchemas: map[string]*tfplugin6.Schema{
 					"test": {
 						Block: &tfplugin6.Schema_Block{
@@ -254,9 +345,85 @@ func TestGetProviderSchema_Response(t *testing.T) {
 						},
 					},
 				},
-				Diagnostics:     []*tfplugin6.Diagnostic{},
-				Functions:       map[string]*tfplugin6.Function{},
-				ResourceSchemas: map[string]*tfplugin6.Schema{},
+				Diagnostics:              []*tfplugin6.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin6.Schema{},
+				Functions:                map[string]*tfplugin6.Function{},
+				ResourceSchemas:          map[string]*tfplugin6.Schema{},
+				StateStoreSchemas:        map[string]*tfplugin6.Schema{},
+			},
+		},
+		"ActionSchemas": {
+			in: &tfprotov6.GetProviderSchemaResponse{
+				ActionSchemas: map[string]*tfprotov6.ActionSchema{
+					"test": {
+						Schema: &tfprotov6.Schema{
+							Block: &tfprotov6.SchemaBlock{
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{
+										Name: "test",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfplugin6.GetProviderSchema_Response{
+				ActionSchemas: map[string]*tfplugin6.ActionSchema{
+					"test": {
+						Schema: &tfplugin6.Schema{
+							Block: &tfplugin6.Schema_Block{
+								Attributes: []*tfplugin6.Schema_Attribute{
+									{
+										Name: "test",
+									},
+								},
+								BlockTypes: []*tfplugin6.Schema_NestedBlock{},
+							},
+						},
+					},
+				},
+				DataSourceSchemas:        map[string]*tfplugin6.Schema{},
+				Diagnostics:              []*tfplugin6.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin6.Schema{},
+				Functions:                map[string]*tfplugin6.Function{},
+				ResourceSchemas:          map[string]*tfplugin6.Schema{},
+				StateStoreSchemas:        map[string]*tfplugin6.Schema{},
+			},
+		},
+		"EphemeralResources": {
+			in: &tfprotov6.GetProviderSchemaResponse{
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{
+					"test": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name: "test",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfplugin6.GetProviderSchema_Response{
+				ActionSchemas:     map[string]*tfplugin6.ActionSchema{},
+				DataSourceSchemas: map[string]*tfplugin6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfplugin6.Schema{
+					"test": {
+						Block: &tfplugin6.Schema_Block{
+							Attributes: []*tfplugin6.Schema_Attribute{
+								{
+									Name: "test",
+								},
+							},
+							BlockTypes: []*tfplugin6.Schema_NestedBlock{},
+						},
+					},
+				},
+				Diagnostics:       []*tfplugin6.Diagnostic{},
+				Functions:         map[string]*tfplugin6.Function{},
+				ResourceSchemas:   map[string]*tfplugin6.Schema{},
+				StateStoreSchemas: map[string]*tfplugin6.Schema{},
 			},
 		},
 		"Diagnostics": {
@@ -266,12 +433,15 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin6.GetProviderSchema_Response{
+				ActionSchemas:     map[string]*tfplugin6.ActionSchema{},
 				DataSourceSchemas: map[string]*tfplugin6.Schema{},
 				Diagnostics: []*tfplugin6.Diagnostic{
 					testTfplugin6Diagnostic,
 				},
-				Functions:       map[string]*tfplugin6.Function{},
-				ResourceSchemas: map[string]*tfplugin6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfplugin6.Schema{},
+				Functions:                map[string]*tfplugin6.Function{},
+				ResourceSchemas:          map[string]*tfplugin6.Schema{},
+				StateStoreSchemas:        map[string]*tfplugin6.Schema{},
 			},
 		},
 		"Functions": {
@@ -285,8 +455,10 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin6.GetProviderSchema_Response{
-				DataSourceSchemas: map[string]*tfplugin6.Schema{},
-				Diagnostics:       []*tfplugin6.Diagnostic{},
+				ActionSchemas:            map[string]*tfplugin6.ActionSchema{},
+				DataSourceSchemas:        map[string]*tfplugin6.Schema{},
+				Diagnostics:              []*tfplugin6.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin6.Schema{},
 				Functions: map[string]*tfplugin6.Function{
 					"test": {
 						Parameters: []*tfplugin6.Function_Parameter{},
@@ -295,7 +467,8 @@ func TestGetProviderSchema_Response(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfplugin6.Schema{},
+				ResourceSchemas:   map[string]*tfplugin6.Schema{},
+				StateStoreSchemas: map[string]*tfplugin6.Schema{},
 			},
 		},
 		"Provider": {
@@ -311,9 +484,11 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin6.GetProviderSchema_Response{
-				DataSourceSchemas: map[string]*tfplugin6.Schema{},
-				Diagnostics:       []*tfplugin6.Diagnostic{},
-				Functions:         map[string]*tfplugin6.Function{},
+				ActionSchemas:            map[string]*tfplugin6.ActionSchema{},
+				DataSourceSchemas:        map[string]*tfplugin6.Schema{},
+				Diagnostics:              []*tfplugin6.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin6.Schema{},
+				Functions:                map[string]*tfplugin6.Function{},
 				Provider: &tfplugin6.Schema{
 					Block: &tfplugin6.Schema_Block{
 						Attributes: []*tfplugin6.Schema_Attribute{
@@ -324,7 +499,8 @@ func TestGetProviderSchema_Response(t *testing.T) {
 						BlockTypes: []*tfplugin6.Schema_NestedBlock{},
 					},
 				},
-				ResourceSchemas: map[string]*tfplugin6.Schema{},
+				ResourceSchemas:   map[string]*tfplugin6.Schema{},
+				StateStoreSchemas: map[string]*tfplugin6.Schema{},
 			},
 		},
 		"ProviderMeta": {
@@ -340,9 +516,11 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin6.GetProviderSchema_Response{
-				DataSourceSchemas: map[string]*tfplugin6.Schema{},
-				Diagnostics:       []*tfplugin6.Diagnostic{},
-				Functions:         map[string]*tfplugin6.Function{},
+				ActionSchemas:            map[string]*tfplugin6.ActionSchema{},
+				DataSourceSchemas:        map[string]*tfplugin6.Schema{},
+				Diagnostics:              []*tfplugin6.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin6.Schema{},
+				Functions:                map[string]*tfplugin6.Function{},
 				ProviderMeta: &tfplugin6.Schema{
 					Block: &tfplugin6.Schema_Block{
 						Attributes: []*tfplugin6.Schema_Attribute{
@@ -353,7 +531,8 @@ func TestGetProviderSchema_Response(t *testing.T) {
 						BlockTypes: []*tfplugin6.Schema_NestedBlock{},
 					},
 				},
-				ResourceSchemas: map[string]*tfplugin6.Schema{},
+				ResourceSchemas:   map[string]*tfplugin6.Schema{},
+				StateStoreSchemas: map[string]*tfplugin6.Schema{},
 			},
 		},
 		"Resources": {
@@ -371,9 +550,11 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin6.GetProviderSchema_Response{
-				DataSourceSchemas: map[string]*tfplugin6.Schema{},
-				Diagnostics:       []*tfplugin6.Diagnostic{},
-				Functions:         map[string]*tfplugin6.Function{},
+				ActionSchemas:            map[string]*tfplugin6.ActionSchema{},
+				DataSourceSchemas:        map[string]*tfplugin6.Schema{},
+				Diagnostics:              []*tfplugin6.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin6.Schema{},
+				Functions:                map[string]*tfplugin6.Function{},
 				ResourceSchemas: map[string]*tfplugin6.Schema{
 					"test": {
 						Block: &tfplugin6.Schema_Block{
@@ -386,6 +567,7 @@ func TestGetProviderSchema_Response(t *testing.T) {
 						},
 					},
 				},
+				StateStoreSchemas: map[string]*tfplugin6.Schema{},
 			},
 		},
 		"ServerCapabilities": {
@@ -395,13 +577,51 @@ func TestGetProviderSchema_Response(t *testing.T) {
 				},
 			},
 			expected: &tfplugin6.GetProviderSchema_Response{
-				DataSourceSchemas: map[string]*tfplugin6.Schema{},
-				Diagnostics:       []*tfplugin6.Diagnostic{},
-				Functions:         map[string]*tfplugin6.Function{},
-				ResourceSchemas:   map[string]*tfplugin6.Schema{},
+				ActionSchemas:            map[string]*tfplugin6.ActionSchema{},
+				DataSourceSchemas:        map[string]*tfplugin6.Schema{},
+				Diagnostics:              []*tfplugin6.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin6.Schema{},
+				Functions:                map[string]*tfplugin6.Function{},
+				ResourceSchemas:          map[string]*tfplugin6.Schema{},
 				ServerCapabilities: &tfplugin6.ServerCapabilities{
 					PlanDestroy: true,
 				},
+				StateStoreSchemas: map[string]*tfplugin6.Schema{},
+			},
+		},
+		"StateStoreSchemas": {
+			in: &tfprotov6.GetProviderSchemaResponse{
+				StateStoreSchemas: map[string]*tfprotov6.Schema{
+					"test": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name: "test",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfplugin6.GetProviderSchema_Response{
+				ActionSchemas:            map[string]*tfplugin6.ActionSchema{},
+				DataSourceSchemas:        map[string]*tfplugin6.Schema{},
+				Diagnostics:              []*tfplugin6.Diagnostic{},
+				EphemeralResourceSchemas: map[string]*tfplugin6.Schema{},
+				Functions:                map[string]*tfplugin6.Function{},
+				ResourceSchemas:          map[string]*tfplugin6.Schema{},
+				StateStoreSchemas: map[string]*tfplugin6.Schema{
+					"test": {
+						Block: &tfplugin6.Schema_Block{
+							Attributes: []*tfplugin6.Schema_Attribute{
+								{
+									Name: "test",
+								},
+							},
+							BlockTypes: []*tfplugin6.Schema_NestedBlock{},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -419,6 +639,7 @@ func TestGetProviderSchema_Response(t *testing.T) {
 			// writing a custom Comparer for each type, which would have no
 			// benefits.
 			diffOpts := cmpopts.IgnoreUnexported(
+				tfplugin6.ActionSchema{},
 				tfplugin6.Diagnostic{},
 				tfplugin6.Function{},
 				tfplugin6.Function_Return{},
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+)
+
+func RawState(in *tfprotov6.RawState) *tfplugin6.RawState {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.RawState{
+		Json:    in.JSON,
+		Flatmap: in.Flatmap,
+	}
+
+	return resp
+}
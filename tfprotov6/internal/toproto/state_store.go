@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+)
+
+func GetMetadata_StateStoreMetadata(in *tfprotov6.StateStoreMetadata) *tfplugin6.GetMetadata_StateStoreMetadata {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.GetMetadata_StateStoreMetadata{
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func ValidateStateStoreConfig_Response(in *tfprotov6.ValidateStateStoreConfigResponse) *tfplugin6.ValidateStateStoreConfig_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ValidateStateStoreConfig_Response{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+
+	return resp
+}
+
+// ConfigureStateStore_Response returns the protocol response without
+// capabilities, which are negotiated by the server.
+func ConfigureStateStore_Response(in *tfprotov6.ConfigureStateStoreResponse) *tfplugin6.ConfigureStateStore_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ConfigureStateStore_Response{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+
+	return resp
+}
+
+// ReadStateBytes_Response returns a protocol response containing the whole
+// state, which the server splits into chunks.
+func ReadStateBytes_Response(in *tfprotov6.ReadStateBytesResponse) *tfplugin6.ReadStateBytes_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ReadStateBytes_Response{
+		Bytes:       in.Bytes,
+		Diagnostics: Diagnostics(in.Diagnostics),
+		Range: &tfplugin6.StateRange{
+			Start: 0,
+			End:   int64(len(in.Bytes)),
+		},
+		TotalLength: int64(len(in.Bytes)),
+	}
+
+	return resp
+}
+
+func WriteStateBytes_Response(in *tfprotov6.WriteStateBytesResponse) *tfplugin6.WriteStateBytes_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.WriteStateBytes_Response{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+
+	return resp
+}
+
+func LockState_Response(in *tfprotov6.LockStateResponse) *tfplugin6.LockState_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.LockState_Response{
+		Diagnostics: Diagnostics(in.Diagnostics),
+		LockId:      in.LockID,
+	}
+
+	return resp
+}
+
+func UnlockState_Response(in *tfprotov6.UnlockStateResponse) *tfplugin6.UnlockState_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.UnlockState_Response{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+
+	return resp
+}
+
+func GetStates_Response(in *tfprotov6.GetStatesResponse) *tfplugin6.GetStates_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.GetStates_Response{
+		Diagnostics: Diagnostics(in.Diagnostics),
+		StateId:     in.StateIDs,
+	}
+
+	return resp
+}
+
+func DeleteState_Response(in *tfprotov6.DeleteStateResponse) *tfplugin6.DeleteState_Response {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.DeleteState_Response{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+
+	return resp
+}
+
+func ValidateStateStoreConfig_Request(in *tfprotov6.ValidateStateStoreConfigRequest) *tfplugin6.ValidateStateStoreConfig_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ValidateStateStoreConfig_Request{
+		Config:   DynamicValue(in.Config),
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+// ConfigureStateStore_Request returns the protocol request without
+// capabilities, which are negotiated by the server.
+func ConfigureStateStore_Request(in *tfprotov6.ConfigureStateStoreRequest) *tfplugin6.ConfigureStateStore_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ConfigureStateStore_Request{
+		Config:   DynamicValue(in.Config),
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func ReadStateBytes_Request(in *tfprotov6.ReadStateBytesRequest) *tfplugin6.ReadStateBytes_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.ReadStateBytes_Request{
+		StateId:  in.StateID,
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+// WriteStateBytes_RequestChunk returns a single protocol chunk containing the
+// metadata and the whole state.
+func WriteStateBytes_RequestChunk(in *tfprotov6.WriteStateBytesRequest) *tfplugin6.WriteStateBytes_RequestChunk {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.WriteStateBytes_RequestChunk{
+		Bytes: in.Bytes,
+		Meta: &tfplugin6.WriteStateBytes_RequestChunkMeta{
+			StateId:  in.StateID,
+			TypeName: in.TypeName,
+		},
+		Range: &tfplugin6.StateRange{
+			Start: 0,
+			End:   int64(len(in.Bytes)),
+		},
+		TotalLength: int64(len(in.Bytes)),
+	}
+
+	return resp
+}
+
+func LockState_Request(in *tfprotov6.LockStateRequest) *tfplugin6.LockState_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.LockState_Request{
+		Operation: in.Operation,
+		StateId:   in.StateID,
+		TypeName:  in.TypeName,
+	}
+
+	return resp
+}
+
+func UnlockState_Request(in *tfprotov6.UnlockStateRequest) *tfplugin6.UnlockState_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.UnlockState_Request{
+		LockId:   in.LockID,
+		StateId:  in.StateID,
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func GetStates_Request(in *tfprotov6.GetStatesRequest) *tfplugin6.GetStates_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.GetStates_Request{
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
+
+func DeleteState_Request(in *tfprotov6.DeleteStateRequest) *tfplugin6.DeleteState_Request {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfplugin6.DeleteState_Request{
+		StateId:  in.StateID,
+		TypeName: in.TypeName,
+	}
+
+	return resp
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/toproto"
+)
+
+func TestReadStateBytes_Response(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.ReadStateBytesResponse
+		expected *tfplugin6.ReadStateBytes_Response
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in: &tfprotov6.ReadStateBytesResponse{},
+			expected: &tfplugin6.ReadStateBytes_Response{
+				Diagnostics: []*tfplugin6.Diagnostic{},
+				Range:       &tfplugin6.StateRange{},
+			},
+		},
+		"Bytes": {
+			in: &tfprotov6.ReadStateBytesResponse{
+				Bytes: []byte("test"),
+			},
+			expected: &tfplugin6.ReadStateBytes_Response{
+				Bytes:       []byte("test"),
+				Diagnostics: []*tfplugin6.Diagnostic{},
+				Range: &tfplugin6.StateRange{
+					End: 4,
+				},
+				TotalLength: 4,
+			},
+		},
+		"Diagnostics": {
+			in: &tfprotov6.ReadStateBytesResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					testTfprotov6Diagnostic,
+				},
+			},
+			expected: &tfplugin6.ReadStateBytes_Response{
+				Diagnostics: []*tfplugin6.Diagnostic{
+					testTfplugin6Diagnostic,
+				},
+				Range: &tfplugin6.StateRange{},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto.ReadStateBytes_Response(testCase.in)
+
+			// Protocol Buffers generated types must have unexported fields
+			// ignored or cmp.Diff() will raise an error. This is easier than
+			// writing a custom Comparer for each type, which would have no
+			// benefits.
+			diffOpts := cmpopts.IgnoreUnexported(
+				tfplugin6.Diagnostic{},
+				tfplugin6.ReadStateBytes_Response{},
+				tfplugin6.StateRange{},
+			)
+
+			if diff := cmp.Diff(got, testCase.expected, diffOpts); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLockState_Response(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.LockStateResponse
+		expected *tfplugin6.LockState_Response
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in: &tfprotov6.LockStateResponse{},
+			expected: &tfplugin6.LockState_Response{
+				Diagnostics: []*tfplugin6.Diagnostic{},
+			},
+		},
+		"Diagnostics": {
+			in: &tfprotov6.LockStateResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					testTfprotov6Diagnostic,
+				},
+			},
+			expected: &tfplugin6.LockState_Response{
+				Diagnostics: []*tfplugin6.Diagnostic{
+					testTfplugin6Diagnostic,
+				},
+			},
+		},
+		"LockID": {
+			in: &tfprotov6.LockStateResponse{
+				LockID: "test",
+			},
+			expected: &tfplugin6.LockState_Response{
+				Diagnostics: []*tfplugin6.Diagnostic{},
+				LockId:      "test",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto.LockState_Response(testCase.in)
+
+			// Protocol Buffers generated types must have unexported fields
+			// ignored or cmp.Diff() will raise an error. This is easier than
+			// writing a custom Comparer for each type, which would have no
+			// benefits.
+			diffOpts := cmpopts.IgnoreUnexported(
+				tfplugin6.Diagnostic{},
+				tfplugin6.LockState_Response{},
+			)
+
+			if diff := cmp.Diff(got, testCase.expected, diffOpts); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetStates_Response(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.GetStatesResponse
+		expected *tfplugin6.GetStates_Response
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in: &tfprotov6.GetStatesResponse{},
+			expected: &tfplugin6.GetStates_Response{
+				Diagnostics: []*tfplugin6.Diagnostic{},
+			},
+		},
+		"StateIDs": {
+			in: &tfprotov6.GetStatesResponse{
+				StateIDs: []string{"default", "test"},
+			},
+			expected: &tfplugin6.GetStates_Response{
+				Diagnostics: []*tfplugin6.Diagnostic{},
+				StateId:     []string{"default", "test"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto.GetStates_Response(testCase.in)
+
+			// Protocol Buffers generated types must have unexported fields
+			// ignored or cmp.Diff() will raise an error. This is easier than
+			// writing a custom Comparer for each type, which would have no
+			// benefits.
+			diffOpts := cmpopts.IgnoreUnexported(
+				tfplugin6.Diagnostic{},
+				tfplugin6.GetStates_Response{},
+			)
+
+			if diff := cmp.Diff(got, testCase.expected, diffOpts); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteStateBytes_RequestChunk(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.WriteStateBytesRequest
+		expected *tfplugin6.WriteStateBytes_RequestChunk
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"zero": {
+			in: &tfprotov6.WriteStateBytesRequest{},
+			expected: &tfplugin6.WriteStateBytes_RequestChunk{
+				Meta:  &tfplugin6.WriteStateBytes_RequestChunkMeta{},
+				Range: &tfplugin6.StateRange{},
+			},
+		},
+		"all": {
+			in: &tfprotov6.WriteStateBytesRequest{
+				Bytes:    []byte("test"),
+				StateID:  "default",
+				TypeName: "test",
+			},
+			expected: &tfplugin6.WriteStateBytes_RequestChunk{
+				Bytes: []byte("test"),
+				Meta: &tfplugin6.WriteStateBytes_RequestChunkMeta{
+					StateId:  "default",
+					TypeName: "test",
+				},
+				Range: &tfplugin6.StateRange{
+					End: 4,
+				},
+				TotalLength: 4,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto.WriteStateBytes_RequestChunk(testCase.in)
+
+			// Protocol Buffers generated types must have unexported fields
+			// ignored or cmp.Diff() will raise an error. This is easier than
+			// writing a custom Comparer for each type, which would have no
+			// benefits.
+			diffOpts := cmpopts.IgnoreUnexported(
+				tfplugin6.StateRange{},
+				tfplugin6.WriteStateBytes_RequestChunk{},
+				tfplugin6.WriteStateBytes_RequestChunkMeta{},
+			)
+
+			if diff := cmp.Diff(got, testCase.expected, diffOpts); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	// PlanAnnotationKindInvalid is used to indicate an invalid
+	// `PlanAnnotationKind`. Provider developers should not use it.
+	PlanAnnotationKindInvalid PlanAnnotationKind = 0
+
+	// PlanAnnotationKindUnknown is used to explain why the planned value of
+	// an attribute is unknown.
+	PlanAnnotationKindUnknown PlanAnnotationKind = 1
+
+	// PlanAnnotationKindRequiresReplace is used to explain why a change to
+	// an attribute requires the resource to be replaced.
+	PlanAnnotationKindRequiresReplace PlanAnnotationKind = 2
+)
+
+// PlanAnnotation is a human-readable explanation of part of a planned
+// resource change, such as why an attribute value will only be known after
+// apply or why a change requires replacement. Plans generated by providers
+// can be surprising to practitioners, especially when an attribute which was
+// not changed in the configuration becomes unknown.
+//
+// Annotations are not part of the protocol and are never sent to Terraform.
+// The server logs each annotation in the PlanResourceChangeResponse and, if
+// enabled by the server, includes them as warning diagnostics.
+type PlanAnnotation struct {
+	// Kind is the kind of change the annotation explains.
+	Kind PlanAnnotationKind
+
+	// Attribute is the path of the attribute the annotation applies to.
+	Attribute *tftypes.AttributePath
+
+	// Reason is the human-readable explanation for the change, such as
+	// "The endpoint is assigned by the API after the instance is
+	// created."
+	Reason string
+}
+
+// Diagnostic returns the annotation as a warning diagnostic for the
+// attribute.
+func (a *PlanAnnotation) Diagnostic() *Diagnostic {
+	if a == nil {
+		return nil
+	}
+
+	var summary string
+
+	switch a.Kind {
+	case PlanAnnotationKindUnknown:
+		summary = "Attribute Value Unknown Until Apply"
+	case PlanAnnotationKindRequiresReplace:
+		summary = "Attribute Change Requires Replacement"
+	default:
+		summary = "Attribute Change"
+	}
+
+	diag := &Diagnostic{
+		Severity: DiagnosticSeverityWarning,
+		Summary:  summary,
+		Detail:   a.Reason,
+	}
+
+	if a.Attribute != nil && len(a.Attribute.Steps()) > 0 {
+		diag.Attribute = a.Attribute
+	}
+
+	return diag
+}
+
+// PlanAnnotationKind represents the different kinds of changes a
+// PlanAnnotation can explain.
+type PlanAnnotationKind int32
+
+func (k PlanAnnotationKind) String() string {
+	switch k {
+	case 0:
+		return "INVALID"
+	case 1:
+		return "UNKNOWN"
+	case 2:
+		return "REQUIRES_REPLACE"
+	}
+	return fmt.Sprintf("PlanAnnotationKind(%d)", int32(k))
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6
+
+const (
+	// ProtocolFeatureActions is the support for actions, such as the
+	// InvokeAction RPC.
+	ProtocolFeatureActions ProtocolFeature = "actions"
+
+	// ProtocolFeatureDeferredActions is the support for deferring resource
+	// and data source changes, such as the Deferred response fields.
+	ProtocolFeatureDeferredActions ProtocolFeature = "deferred_actions"
+
+	// ProtocolFeatureEphemeralResources is the support for ephemeral
+	// resources, such as the OpenEphemeralResource RPC.
+	ProtocolFeatureEphemeralResources ProtocolFeature = "ephemeral_resources"
+
+	// ProtocolFeatureFunctions is the support for provider-defined
+	// functions, such as the GetFunctions and CallFunction RPCs.
+	ProtocolFeatureFunctions ProtocolFeature = "functions"
+
+	// ProtocolFeatureMoveResourceState is the support for moving state
+	// across resource types, such as the MoveResourceState RPC.
+	ProtocolFeatureMoveResourceState ProtocolFeature = "move_resource_state"
+
+	// ProtocolFeatureResourceIdentity is the support for resource identity.
+	ProtocolFeatureResourceIdentity ProtocolFeature = "resource_identity"
+
+	// ProtocolFeatureStateStores is the support for state stores, such as
+	// the ReadStateBytes and WriteStateBytes RPCs.
+	ProtocolFeatureStateStores ProtocolFeature = "state_stores"
+
+	// ProtocolFeatureWriteOnlyAttributes is the support for write-only
+	// schema attributes, such as the WriteOnlyAttributesAllowed client
+	// capability.
+	ProtocolFeatureWriteOnlyAttributes ProtocolFeature = "write_only_attributes"
+)
+
+// supportedProtocolFeatures contains the protocol features implemented by
+// this version of the package. It must be updated whenever support for a
+// ProtocolFeature is added.
+var supportedProtocolFeatures = map[ProtocolFeature]bool{
+	ProtocolFeatureActions:             true,
+	ProtocolFeatureDeferredActions:     true,
+	ProtocolFeatureEphemeralResources:  true,
+	ProtocolFeatureFunctions:           true,
+	ProtocolFeatureMoveResourceState:   true,
+	ProtocolFeatureResourceIdentity:    false,
+	ProtocolFeatureStateStores:         true,
+	ProtocolFeatureWriteOnlyAttributes: true,
+}
+
+// protocolFeatureMinorVersions contains the protocol minor version which
+// introduced each ProtocolFeature. It must be updated whenever a
+// ProtocolFeature is added.
+var protocolFeatureMinorVersions = map[ProtocolFeature]int{
+	ProtocolFeatureActions:             10,
+	ProtocolFeatureDeferredActions:     6,
+	ProtocolFeatureEphemeralResources:  7,
+	ProtocolFeatureFunctions:           5,
+	ProtocolFeatureMoveResourceState:   5,
+	ProtocolFeatureResourceIdentity:    9,
+	ProtocolFeatureStateStores:         10,
+	ProtocolFeatureWriteOnlyAttributes: 8,
+}
+
+// ProtocolFeature is a protocol feature which may or may not be implemented
+// by a version of this package. SDKs and providers can use
+// ProtocolFeature.Supported to determine whether a feature is available at
+// runtime, such as to return an accurate error when a feature is not
+// supported instead of failing during protocol conversion.
+type ProtocolFeature string
+
+// Supported returns true if the protocol feature is implemented by this
+// version of the package. Unrecognized features are not supported.
+func (f ProtocolFeature) Supported() bool {
+	return supportedProtocolFeatures[f]
+}
+
+// ProtocolFeatures returns all protocol features known to this version of
+// the package and whether each is supported. Modifying the returned map does
+// not affect support.
+func ProtocolFeatures() map[ProtocolFeature]bool {
+	result := make(map[ProtocolFeature]bool, len(supportedProtocolFeatures))
+
+	for feature, supported := range supportedProtocolFeatures {
+		result[feature] = supported
+	}
+
+	return result
+}
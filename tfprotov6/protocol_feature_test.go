@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestProtocolFeatureSupported(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		feature  tfprotov6.ProtocolFeature
+		expected bool
+	}{
+		"actions": {
+			feature:  tfprotov6.ProtocolFeatureActions,
+			expected: true,
+		},
+		"deferred-actions": {
+			feature:  tfprotov6.ProtocolFeatureDeferredActions,
+			expected: true,
+		},
+		"ephemeral-resources": {
+			feature:  tfprotov6.ProtocolFeatureEphemeralResources,
+			expected: true,
+		},
+		"functions": {
+			feature:  tfprotov6.ProtocolFeatureFunctions,
+			expected: true,
+		},
+		"move-resource-state": {
+			feature:  tfprotov6.ProtocolFeatureMoveResourceState,
+			expected: true,
+		},
+		"resource-identity": {
+			feature:  tfprotov6.ProtocolFeatureResourceIdentity,
+			expected: false,
+		},
+		"state-stores": {
+			feature:  tfprotov6.ProtocolFeatureStateStores,
+			expected: true,
+		},
+		"write-only-attributes": {
+			feature:  tfprotov6.ProtocolFeatureWriteOnlyAttributes,
+			expected: true,
+		},
+		"unrecognized": {
+			feature:  tfprotov6.ProtocolFeature("unrecognized"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.feature.Supported()
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestProtocolFeatures(t *testing.T) {
+	t.Parallel()
+
+	expected := map[tfprotov6.ProtocolFeature]bool{
+		tfprotov6.ProtocolFeatureActions:             true,
+		tfprotov6.ProtocolFeatureDeferredActions:     true,
+		tfprotov6.ProtocolFeatureEphemeralResources:  true,
+		tfprotov6.ProtocolFeatureFunctions:           true,
+		tfprotov6.ProtocolFeatureMoveResourceState:   true,
+		tfprotov6.ProtocolFeatureResourceIdentity:    false,
+		tfprotov6.ProtocolFeatureStateStores:         true,
+		tfprotov6.ProtocolFeatureWriteOnlyAttributes: true,
+	}
+
+	got := tfprotov6.ProtocolFeatures()
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	// Modifying the result must not affect support.
+	got[tfprotov6.ProtocolFeatureResourceIdentity] = true
+
+	if tfprotov6.ProtocolFeatureResourceIdentity.Supported() {
+		t.Error("expected modifying ProtocolFeatures result to not affect support")
+	}
+}
+
+func TestProtocolFeaturesProtocolVersion(t *testing.T) {
+	t.Parallel()
+
+	// introducedMinor contains the protocol minor version which introduced
+	// each feature. A feature must not be reported as supported before
+	// ProtocolVersionMinor includes it.
+	introducedMinor := map[tfprotov6.ProtocolFeature]int{
+		tfprotov6.ProtocolFeatureActions:             10,
+		tfprotov6.ProtocolFeatureDeferredActions:     6,
+		tfprotov6.ProtocolFeatureEphemeralResources:  7,
+		tfprotov6.ProtocolFeatureFunctions:           5,
+		tfprotov6.ProtocolFeatureMoveResourceState:   5,
+		tfprotov6.ProtocolFeatureResourceIdentity:    9,
+		tfprotov6.ProtocolFeatureStateStores:         10,
+		tfprotov6.ProtocolFeatureWriteOnlyAttributes: 8,
+	}
+
+	for feature, supported := range tfprotov6.ProtocolFeatures() {
+		minor, ok := introducedMinor[feature]
+
+		if !ok {
+			t.Errorf("missing introduced protocol version for feature %q", feature)
+
+			continue
+		}
+
+		if supported && minor > tfprotov6.ProtocolVersionMinor {
+			t.Errorf("feature %q requires protocol version %d.%d, but ProtocolVersionMinor is %d", feature, tfprotov6.ProtocolVersionMajor, minor, tfprotov6.ProtocolVersionMinor)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConfigureProviderRequestConfigValue(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:     "endpoint",
+					Type:     tftypes.String,
+					Optional: true,
+				},
+				{
+					Name:     "tags",
+					Type:     tftypes.List{ElementType: tftypes.String},
+					Optional: true,
+				},
+			},
+		},
+	}
+	typ := schema.ValueType()
+	lookupEnv := func(name string) (string, bool) {
+		if name == "TEST_HOST" {
+			return "example.com", true
+		}
+
+		return "", false
+	}
+
+	newConfig := func(endpoint tftypes.Value, tags ...tftypes.Value) *tfprotov6.DynamicValue {
+		tagsValue := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+
+		if len(tags) > 0 {
+			tagsValue = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tags)
+		}
+
+		config, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+			"endpoint": endpoint,
+			"tags":     tagsValue,
+		}))
+
+		if err != nil {
+			t.Fatalf("unexpected error creating config: %s", err)
+		}
+
+		return &config
+	}
+	newValue := func(endpoint tftypes.Value, tags ...tftypes.Value) tftypes.Value {
+		value, err := newConfig(endpoint, tags...).Unmarshal(typ)
+
+		if err != nil {
+			t.Fatalf("unexpected error creating value: %s", err)
+		}
+
+		return value
+	}
+
+	testCases := map[string]struct {
+		req           *tfprotov6.ConfigureProviderRequest
+		opts          tfprotov6.ConfigValueOpts
+		expected      tftypes.Value
+		expectedDiags []*tfprotov6.Diagnostic
+	}{
+		"nil-config": {
+			req:      &tfprotov6.ConfigureProviderRequest{},
+			expected: tftypes.NewValue(typ, nil),
+		},
+		"no-expansion": {
+			req: &tfprotov6.ConfigureProviderRequest{
+				Config: newConfig(tftypes.NewValue(tftypes.String, "https://${env:TEST_HOST}")),
+			},
+			expected: newValue(tftypes.NewValue(tftypes.String, "https://${env:TEST_HOST}")),
+		},
+		"expansion": {
+			req: &tfprotov6.ConfigureProviderRequest{
+				Config: newConfig(
+					tftypes.NewValue(tftypes.String, "https://${env:TEST_HOST}/$${env:TEST_HOST}"),
+					tftypes.NewValue(tftypes.String, "${env:TEST_HOST}"),
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				),
+			},
+			opts: tfprotov6.ConfigValueOpts{
+				ExpandEnv: true,
+				LookupEnv: lookupEnv,
+			},
+			expected: newValue(
+				tftypes.NewValue(tftypes.String, "https://example.com/${env:TEST_HOST}"),
+				tftypes.NewValue(tftypes.String, "example.com"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			),
+		},
+		"expansion-undefined": {
+			req: &tfprotov6.ConfigureProviderRequest{
+				Config: newConfig(
+					tftypes.NewValue(tftypes.String, nil),
+					tftypes.NewValue(tftypes.String, "${env:TEST_UNDEFINED}"),
+				),
+			},
+			opts: tfprotov6.ConfigValueOpts{
+				ExpandEnv: true,
+				LookupEnv: lookupEnv,
+			},
+			expected: newValue(
+				tftypes.NewValue(tftypes.String, nil),
+				tftypes.NewValue(tftypes.String, ""),
+			),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Undefined Environment Variable",
+					Detail:    "The provider configuration references the TEST_UNDEFINED environment variable, which is not set.",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyInt(0),
+				},
+			},
+		},
+		"invalid-config": {
+			req: &tfprotov6.ConfigureProviderRequest{
+				Config: &tfprotov6.DynamicValue{
+					JSON: []byte(`{"endpoint":"test","tags":"invalid"}`),
+				},
+			},
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Invalid Provider Configuration",
+					Detail: "The provider configuration could not be decoded using the provider schema. " +
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+						`Error: AttributeName("tags"): invalid JSON, expected "[", got "invalid"`,
+					Attribute: tftypes.NewAttributePath().WithAttributeName("tags"),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.req.ConfigValue(schema, testCase.opts)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6
+
+import (
+	"sort"
+)
+
+// Metadata returns a GetMetadataResponse synthesized from the
+// GetProviderSchemaResponse, which enables ProviderServer implementations
+// without a more efficient source of metadata to implement GetMetadata by
+// calling their own GetProviderSchema. The ServerCapabilities and
+// Diagnostics are shared with the GetProviderSchemaResponse. Type and
+// function names are sorted, so the response is deterministic.
+func (r *GetProviderSchemaResponse) Metadata() *GetMetadataResponse {
+	if r == nil {
+		return nil
+	}
+
+	resp := &GetMetadataResponse{
+		Actions:            make([]ActionMetadata, 0, len(r.ActionSchemas)),
+		DataSources:        make([]DataSourceMetadata, 0, len(r.DataSourceSchemas)),
+		Diagnostics:        r.Diagnostics,
+		EphemeralResources: make([]EphemeralResourceMetadata, 0, len(r.EphemeralResourceSchemas)),
+		Functions:          make([]FunctionMetadata, 0, len(r.Functions)),
+		Resources:          make([]ResourceMetadata, 0, len(r.ResourceSchemas)),
+		ServerCapabilities: r.ServerCapabilities,
+		StateStores:        make([]StateStoreMetadata, 0, len(r.StateStoreSchemas)),
+	}
+
+	for _, typeName := range sortedMetadataNames(r.ActionSchemas) {
+		resp.Actions = append(resp.Actions, ActionMetadata{TypeName: typeName})
+	}
+
+	for _, typeName := range sortedMetadataNames(r.DataSourceSchemas) {
+		resp.DataSources = append(resp.DataSources, DataSourceMetadata{TypeName: typeName})
+	}
+
+	for _, typeName := range sortedMetadataNames(r.EphemeralResourceSchemas) {
+		resp.EphemeralResources = append(resp.EphemeralResources, EphemeralResourceMetadata{TypeName: typeName})
+	}
+
+	for _, name := range sortedMetadataNames(r.Functions) {
+		resp.Functions = append(resp.Functions, FunctionMetadata{Name: name})
+	}
+
+	for _, typeName := range sortedMetadataNames(r.ResourceSchemas) {
+		resp.Resources = append(resp.Resources, ResourceMetadata{TypeName: typeName})
+	}
+
+	for _, typeName := range sortedMetadataNames(r.StateStoreSchemas) {
+		resp.StateStores = append(resp.StateStores, StateStoreMetadata{TypeName: typeName})
+	}
+
+	return resp
+}
+
+// sortedMetadataNames returns the sorted keys of a map of schemas or
+// functions.
+func sortedMetadataNames[T any](m map[string]T) []string {
+	names := make([]string, 0, len(m))
+
+	for name := range m {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
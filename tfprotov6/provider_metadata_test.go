@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestGetProviderSchemaResponseMetadata(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resp     *tfprotov6.GetProviderSchemaResponse
+		expected *tfprotov6.GetMetadataResponse
+	}{
+		"nil": {
+			resp:     nil,
+			expected: nil,
+		},
+		"zero": {
+			resp: &tfprotov6.GetProviderSchemaResponse{},
+			expected: &tfprotov6.GetMetadataResponse{
+				Actions:            []tfprotov6.ActionMetadata{},
+				DataSources:        []tfprotov6.DataSourceMetadata{},
+				EphemeralResources: []tfprotov6.EphemeralResourceMetadata{},
+				Functions:          []tfprotov6.FunctionMetadata{},
+				Resources:          []tfprotov6.ResourceMetadata{},
+				StateStores:        []tfprotov6.StateStoreMetadata{},
+			},
+		},
+		"all": {
+			resp: &tfprotov6.GetProviderSchemaResponse{
+				ActionSchemas: map[string]*tfprotov6.ActionSchema{
+					"test_action": {},
+				},
+				DataSourceSchemas: map[string]*tfprotov6.Schema{
+					"test_data_source_b": {},
+					"test_data_source_a": {},
+				},
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityWarning,
+						Summary:  "test summary",
+					},
+				},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_ephemeral_resource": {},
+				},
+				Functions: map[string]*tfprotov6.Function{
+					"test_function": {},
+				},
+				Provider: &tfprotov6.Schema{},
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource_b": {},
+					"test_resource_a": {},
+				},
+				ServerCapabilities: &tfprotov6.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+				},
+				StateStoreSchemas: map[string]*tfprotov6.Schema{
+					"test_state_store": {},
+				},
+			},
+			expected: &tfprotov6.GetMetadataResponse{
+				Actions: []tfprotov6.ActionMetadata{
+					{TypeName: "test_action"},
+				},
+				DataSources: []tfprotov6.DataSourceMetadata{
+					{TypeName: "test_data_source_a"},
+					{TypeName: "test_data_source_b"},
+				},
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityWarning,
+						Summary:  "test summary",
+					},
+				},
+				EphemeralResources: []tfprotov6.EphemeralResourceMetadata{
+					{TypeName: "test_ephemeral_resource"},
+				},
+				Functions: []tfprotov6.FunctionMetadata{
+					{Name: "test_function"},
+				},
+				Resources: []tfprotov6.ResourceMetadata{
+					{TypeName: "test_resource_a"},
+					{TypeName: "test_resource_b"},
+				},
+				ServerCapabilities: &tfprotov6.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+				},
+				StateStores: []tfprotov6.StateStoreMetadata{
+					{TypeName: "test_state_store"},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.resp.Metadata()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
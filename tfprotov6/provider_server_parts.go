@@ -0,0 +1,494 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+var (
+	_ ProviderServerWithActions            = partsProviderServer{}
+	_ ProviderServerWithEphemeralResources = partsProviderServer{}
+	_ ProviderServerWithStateStores        = partsProviderServer{}
+)
+
+// ProviderServerParts contains the parts of a provider passed to
+// NewProviderServerFromParts. Any part can be nil.
+type ProviderServerParts struct {
+	// Provider implements the provider-level RPCs, such as GetProviderSchema
+	// and ConfigureProvider. When nil, StopProvider succeeds.
+	Provider ProviderConfigServer
+
+	// Resources implements the managed resource RPCs.
+	Resources ResourceServer
+
+	// DataSources implements the data source RPCs.
+	DataSources DataSourceServer
+
+	// Functions implements the provider-defined function RPCs.
+	Functions FunctionServer
+
+	// EphemeralResources implements the ephemeral resource RPCs.
+	EphemeralResources EphemeralResourceServer
+
+	// Actions implements the action RPCs.
+	Actions ActionServer
+
+	// StateStores implements the state store RPCs.
+	StateStores StateStoreServer
+}
+
+// NewProviderServerFromParts returns a ProviderServer which calls the RPCs of
+// the given parts, so providers only need to implement the parts they
+// support, such as only data sources. The returned ProviderServer also
+// implements all optional interfaces, such as
+// ProviderServerWithEphemeralResources, except
+// ProviderServerWithEncodedProviderSchema.
+//
+// The RPCs of nil parts return an error diagnostic, or a function error for
+// CallFunction, explaining that the provider does not implement them, except
+// GetMetadata, which returns ErrUnsupportedRPC so Terraform falls back to
+// GetProviderSchema.
+func NewProviderServerFromParts(parts ProviderServerParts) ProviderServer {
+	return partsProviderServer{
+		parts: parts,
+	}
+}
+
+// partsProviderServer is the ProviderServer returned by
+// NewProviderServerFromParts.
+type partsProviderServer struct {
+	parts ProviderServerParts
+}
+
+func (s partsProviderServer) GetMetadata(ctx context.Context, req *GetMetadataRequest) (*GetMetadataResponse, error) {
+	if s.parts.Provider != nil {
+		return s.parts.Provider.GetMetadata(ctx, req)
+	}
+
+	// Terraform falls back to GetProviderSchema when GetMetadata is
+	// unsupported.
+	return nil, ErrUnsupportedRPC
+}
+
+func (s partsProviderServer) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRequest) (*GetProviderSchemaResponse, error) {
+	if s.parts.Provider != nil {
+		return s.parts.Provider.GetProviderSchema(ctx, req)
+	}
+
+	resp := &GetProviderSchemaResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Configuration", "GetProviderSchema"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) ValidateProviderConfig(ctx context.Context, req *ValidateProviderConfigRequest) (*ValidateProviderConfigResponse, error) {
+	if s.parts.Provider != nil {
+		return s.parts.Provider.ValidateProviderConfig(ctx, req)
+	}
+
+	resp := &ValidateProviderConfigResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Configuration", "ValidateProviderConfig"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) ConfigureProvider(ctx context.Context, req *ConfigureProviderRequest) (*ConfigureProviderResponse, error) {
+	if s.parts.Provider != nil {
+		return s.parts.Provider.ConfigureProvider(ctx, req)
+	}
+
+	resp := &ConfigureProviderResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Configuration", "ConfigureProvider"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) StopProvider(ctx context.Context, req *StopProviderRequest) (*StopProviderResponse, error) {
+	if s.parts.Provider != nil {
+		return s.parts.Provider.StopProvider(ctx, req)
+	}
+
+	return &StopProviderResponse{}, nil
+}
+
+func (s partsProviderServer) ValidateResourceConfig(ctx context.Context, req *ValidateResourceConfigRequest) (*ValidateResourceConfigResponse, error) {
+	if s.parts.Resources != nil {
+		return s.parts.Resources.ValidateResourceConfig(ctx, req)
+	}
+
+	resp := &ValidateResourceConfigResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Resource", "ValidateResourceConfig"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) UpgradeResourceState(ctx context.Context, req *UpgradeResourceStateRequest) (*UpgradeResourceStateResponse, error) {
+	if s.parts.Resources != nil {
+		return s.parts.Resources.UpgradeResourceState(ctx, req)
+	}
+
+	resp := &UpgradeResourceStateResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Resource", "UpgradeResourceState"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) ReadResource(ctx context.Context, req *ReadResourceRequest) (*ReadResourceResponse, error) {
+	if s.parts.Resources != nil {
+		return s.parts.Resources.ReadResource(ctx, req)
+	}
+
+	resp := &ReadResourceResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Resource", "ReadResource"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) PlanResourceChange(ctx context.Context, req *PlanResourceChangeRequest) (*PlanResourceChangeResponse, error) {
+	if s.parts.Resources != nil {
+		return s.parts.Resources.PlanResourceChange(ctx, req)
+	}
+
+	resp := &PlanResourceChangeResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Resource", "PlanResourceChange"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) ApplyResourceChange(ctx context.Context, req *ApplyResourceChangeRequest) (*ApplyResourceChangeResponse, error) {
+	if s.parts.Resources != nil {
+		return s.parts.Resources.ApplyResourceChange(ctx, req)
+	}
+
+	resp := &ApplyResourceChangeResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Resource", "ApplyResourceChange"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) ImportResourceState(ctx context.Context, req *ImportResourceStateRequest) (*ImportResourceStateResponse, error) {
+	if s.parts.Resources != nil {
+		return s.parts.Resources.ImportResourceState(ctx, req)
+	}
+
+	resp := &ImportResourceStateResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Resource", "ImportResourceState"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) MoveResourceState(ctx context.Context, req *MoveResourceStateRequest) (*MoveResourceStateResponse, error) {
+	if s.parts.Resources != nil {
+		return s.parts.Resources.MoveResourceState(ctx, req)
+	}
+
+	resp := &MoveResourceStateResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Resource", "MoveResourceState"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) ValidateDataResourceConfig(ctx context.Context, req *ValidateDataResourceConfigRequest) (*ValidateDataResourceConfigResponse, error) {
+	if s.parts.DataSources != nil {
+		return s.parts.DataSources.ValidateDataResourceConfig(ctx, req)
+	}
+
+	resp := &ValidateDataResourceConfigResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Data Source", "ValidateDataResourceConfig"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest) (*ReadDataSourceResponse, error) {
+	if s.parts.DataSources != nil {
+		return s.parts.DataSources.ReadDataSource(ctx, req)
+	}
+
+	resp := &ReadDataSourceResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Data Source", "ReadDataSource"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) CallFunction(ctx context.Context, req *CallFunctionRequest) (*CallFunctionResponse, error) {
+	if s.parts.Functions != nil {
+		return s.parts.Functions.CallFunction(ctx, req)
+	}
+
+	resp := &CallFunctionResponse{
+		Error: &FunctionError{
+			Text: partNotImplementedDiag("Function", "CallFunction").Detail,
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) GetFunctions(ctx context.Context, req *GetFunctionsRequest) (*GetFunctionsResponse, error) {
+	if s.parts.Functions != nil {
+		return s.parts.Functions.GetFunctions(ctx, req)
+	}
+
+	resp := &GetFunctionsResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Function", "GetFunctions"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) ValidateEphemeralResourceConfig(ctx context.Context, req *ValidateEphemeralResourceConfigRequest) (*ValidateEphemeralResourceConfigResponse, error) {
+	if s.parts.EphemeralResources != nil {
+		return s.parts.EphemeralResources.ValidateEphemeralResourceConfig(ctx, req)
+	}
+
+	resp := &ValidateEphemeralResourceConfigResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Ephemeral Resource", "ValidateEphemeralResourceConfig"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) OpenEphemeralResource(ctx context.Context, req *OpenEphemeralResourceRequest) (*OpenEphemeralResourceResponse, error) {
+	if s.parts.EphemeralResources != nil {
+		return s.parts.EphemeralResources.OpenEphemeralResource(ctx, req)
+	}
+
+	resp := &OpenEphemeralResourceResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Ephemeral Resource", "OpenEphemeralResource"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) RenewEphemeralResource(ctx context.Context, req *RenewEphemeralResourceRequest) (*RenewEphemeralResourceResponse, error) {
+	if s.parts.EphemeralResources != nil {
+		return s.parts.EphemeralResources.RenewEphemeralResource(ctx, req)
+	}
+
+	resp := &RenewEphemeralResourceResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Ephemeral Resource", "RenewEphemeralResource"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) CloseEphemeralResource(ctx context.Context, req *CloseEphemeralResourceRequest) (*CloseEphemeralResourceResponse, error) {
+	if s.parts.EphemeralResources != nil {
+		return s.parts.EphemeralResources.CloseEphemeralResource(ctx, req)
+	}
+
+	resp := &CloseEphemeralResourceResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Ephemeral Resource", "CloseEphemeralResource"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) ValidateActionConfig(ctx context.Context, req *ValidateActionConfigRequest) (*ValidateActionConfigResponse, error) {
+	if s.parts.Actions != nil {
+		return s.parts.Actions.ValidateActionConfig(ctx, req)
+	}
+
+	resp := &ValidateActionConfigResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("Action", "ValidateActionConfig"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) InvokeAction(ctx context.Context, req *InvokeActionRequest) (*InvokeActionResponse, error) {
+	if s.parts.Actions != nil {
+		return s.parts.Actions.InvokeAction(ctx, req)
+	}
+
+	resp := &InvokeActionResponse{
+		Events: func(yield func(InvokeActionEvent) bool) {
+			yield(InvokeActionEvent{
+				Type: CompletedInvokeActionEventType{
+					Diagnostics: []*Diagnostic{
+						partNotImplementedDiag("Action", "InvokeAction"),
+					},
+				},
+			})
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) ValidateStateStoreConfig(ctx context.Context, req *ValidateStateStoreConfigRequest) (*ValidateStateStoreConfigResponse, error) {
+	if s.parts.StateStores != nil {
+		return s.parts.StateStores.ValidateStateStoreConfig(ctx, req)
+	}
+
+	resp := &ValidateStateStoreConfigResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("State Store", "ValidateStateStoreConfig"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) ConfigureStateStore(ctx context.Context, req *ConfigureStateStoreRequest) (*ConfigureStateStoreResponse, error) {
+	if s.parts.StateStores != nil {
+		return s.parts.StateStores.ConfigureStateStore(ctx, req)
+	}
+
+	resp := &ConfigureStateStoreResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("State Store", "ConfigureStateStore"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) ReadStateBytes(ctx context.Context, req *ReadStateBytesRequest) (*ReadStateBytesResponse, error) {
+	if s.parts.StateStores != nil {
+		return s.parts.StateStores.ReadStateBytes(ctx, req)
+	}
+
+	resp := &ReadStateBytesResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("State Store", "ReadStateBytes"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) WriteStateBytes(ctx context.Context, req *WriteStateBytesRequest) (*WriteStateBytesResponse, error) {
+	if s.parts.StateStores != nil {
+		return s.parts.StateStores.WriteStateBytes(ctx, req)
+	}
+
+	resp := &WriteStateBytesResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("State Store", "WriteStateBytes"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) LockState(ctx context.Context, req *LockStateRequest) (*LockStateResponse, error) {
+	if s.parts.StateStores != nil {
+		return s.parts.StateStores.LockState(ctx, req)
+	}
+
+	resp := &LockStateResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("State Store", "LockState"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) UnlockState(ctx context.Context, req *UnlockStateRequest) (*UnlockStateResponse, error) {
+	if s.parts.StateStores != nil {
+		return s.parts.StateStores.UnlockState(ctx, req)
+	}
+
+	resp := &UnlockStateResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("State Store", "UnlockState"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) GetStates(ctx context.Context, req *GetStatesRequest) (*GetStatesResponse, error) {
+	if s.parts.StateStores != nil {
+		return s.parts.StateStores.GetStates(ctx, req)
+	}
+
+	resp := &GetStatesResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("State Store", "GetStates"),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s partsProviderServer) DeleteState(ctx context.Context, req *DeleteStateRequest) (*DeleteStateResponse, error) {
+	if s.parts.StateStores != nil {
+		return s.parts.StateStores.DeleteState(ctx, req)
+	}
+
+	resp := &DeleteStateResponse{
+		Diagnostics: []*Diagnostic{
+			partNotImplementedDiag("State Store", "DeleteState"),
+		},
+	}
+
+	return resp, nil
+}
+
+// partNotImplementedDiag returns an error diagnostic for RPCs of nil
+// ProviderServerParts. It is also the diagnostic of UnimplementedProviderServer,
+// which the tf6server and tf6middleware packages use for RPCs of optional
+// interfaces not implemented by a ProviderServer.
+func partNotImplementedDiag(part string, rpc string) *Diagnostic {
+	return &Diagnostic{
+		Severity: DiagnosticSeverityError,
+		Summary:  fmt.Sprintf("Provider %s Not Implemented", part),
+		Detail: fmt.Sprintf("A %s call was received by the provider, however the provider does not implement the RPC. ", rpc) +
+			fmt.Sprintf("Either upgrade the provider to a version that implements %s support or this is always an error in the provider that should be reported to the provider developers.", strings.ToLower(part)),
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+type testDataSourceServer struct{}
+
+func (s testDataSourceServer) ValidateDataResourceConfig(_ context.Context, _ *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
+	return &tfprotov6.ValidateDataResourceConfigResponse{}, nil
+}
+
+func (s testDataSourceServer) ReadDataSource(_ context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	return &tfprotov6.ReadDataSourceResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  req.TypeName,
+			},
+		},
+	}, nil
+}
+
+func TestNewProviderServerFromParts(t *testing.T) {
+	t.Parallel()
+
+	server := tfprotov6.NewProviderServerFromParts(tfprotov6.ProviderServerParts{
+		DataSources: testDataSourceServer{},
+	})
+
+	readResp, err := server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{
+		TypeName: "test_data_source",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedReadResp := &tfprotov6.ReadDataSourceResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  "test_data_source",
+			},
+		},
+	}
+
+	if diff := cmp.Diff(readResp, expectedReadResp); diff != "" {
+		t.Errorf("unexpected ReadDataSource difference: %s", diff)
+	}
+
+	stopResp, err := server.StopProvider(context.Background(), &tfprotov6.StopProviderRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(stopResp, &tfprotov6.StopProviderResponse{}); diff != "" {
+		t.Errorf("unexpected StopProvider difference: %s", diff)
+	}
+
+	for name, implements := range map[string]bool{
+		"ProviderServerWithActions": func() bool {
+			_, ok := server.(tfprotov6.ProviderServerWithActions)
+			return ok
+		}(),
+		"ProviderServerWithEphemeralResources": func() bool {
+			_, ok := server.(tfprotov6.ProviderServerWithEphemeralResources)
+			return ok
+		}(),
+		"ProviderServerWithStateStores": func() bool {
+			_, ok := server.(tfprotov6.ProviderServerWithStateStores)
+			return ok
+		}(),
+	} {
+		if !implements {
+			t.Errorf("expected %s to be implemented", name)
+		}
+	}
+}
+
+func TestNewProviderServerFromPartsNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	server := tfprotov6.NewProviderServerFromParts(tfprotov6.ProviderServerParts{})
+
+	testCases := map[string]struct {
+		call            func() ([]*tfprotov6.Diagnostic, error)
+		expectedSummary string
+	}{
+		"GetProviderSchema": {
+			call: func() ([]*tfprotov6.Diagnostic, error) {
+				resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedSummary: "Provider Configuration Not Implemented",
+		},
+		"ReadResource": {
+			call: func() ([]*tfprotov6.Diagnostic, error) {
+				resp, err := server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedSummary: "Provider Resource Not Implemented",
+		},
+		"ReadDataSource": {
+			call: func() ([]*tfprotov6.Diagnostic, error) {
+				resp, err := server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedSummary: "Provider Data Source Not Implemented",
+		},
+		"GetFunctions": {
+			call: func() ([]*tfprotov6.Diagnostic, error) {
+				resp, err := server.GetFunctions(context.Background(), &tfprotov6.GetFunctionsRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedSummary: "Provider Function Not Implemented",
+		},
+		"OpenEphemeralResource": {
+			call: func() ([]*tfprotov6.Diagnostic, error) {
+				resp, err := server.(tfprotov6.ProviderServerWithEphemeralResources).OpenEphemeralResource(context.Background(), &tfprotov6.OpenEphemeralResourceRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedSummary: "Provider Ephemeral Resource Not Implemented",
+		},
+		"InvokeAction": {
+			call: func() ([]*tfprotov6.Diagnostic, error) {
+				resp, err := server.(tfprotov6.ProviderServerWithActions).InvokeAction(context.Background(), &tfprotov6.InvokeActionRequest{})
+
+				if err != nil {
+					return nil, err
+				}
+
+				var diags []*tfprotov6.Diagnostic
+
+				resp.Events(func(event tfprotov6.InvokeActionEvent) bool {
+					if completed, ok := event.Type.(tfprotov6.CompletedInvokeActionEventType); ok {
+						diags = append(diags, completed.Diagnostics...)
+					}
+
+					return true
+				})
+
+				return diags, nil
+			},
+			expectedSummary: "Provider Action Not Implemented",
+		},
+		"LockState": {
+			call: func() ([]*tfprotov6.Diagnostic, error) {
+				resp, err := server.(tfprotov6.ProviderServerWithStateStores).LockState(context.Background(), &tfprotov6.LockStateRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedSummary: "Provider State Store Not Implemented",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags, err := testCase.call()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+			}
+
+			if diags[0].Severity != tfprotov6.DiagnosticSeverityError || diags[0].Summary != testCase.expectedSummary {
+				t.Errorf("unexpected diagnostic: %+v", diags[0])
+			}
+		})
+	}
+}
+
+func TestNewProviderServerFromPartsCallFunctionNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	server := tfprotov6.NewProviderServerFromParts(tfprotov6.ProviderServerParts{})
+
+	resp, err := server.CallFunction(context.Background(), &tfprotov6.CallFunctionRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resp.Error == nil || resp.Error.Text == "" {
+		t.Errorf("expected function error, got: %+v", resp.Error)
+	}
+}
+
+func TestNewProviderServerFromPartsGetMetadataNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	resp, err := tfprotov6.NewProviderServerFromParts(tfprotov6.ProviderServerParts{}).GetMetadata(context.Background(), &tfprotov6.GetMetadataRequest{})
+
+	if !errors.Is(err, tfprotov6.ErrUnsupportedRPC) {
+		t.Errorf("expected ErrUnsupportedRPC, got: %v", err)
+	}
+
+	if resp != nil {
+		t.Errorf("unexpected response: %v", resp)
+	}
+}
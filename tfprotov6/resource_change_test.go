@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNewResourceChange(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:     "id",
+					Computed: true,
+					Type:     tftypes.String,
+				},
+				{
+					Name:     "name",
+					Required: true,
+					Type:     tftypes.String,
+				},
+				{
+					Name:      "password",
+					Optional:  true,
+					Sensitive: true,
+					Type:      tftypes.String,
+				},
+				{
+					Name:     "size",
+					Optional: true,
+					Type:     tftypes.Number,
+				},
+			},
+			BlockTypes: []*tfprotov6.SchemaNestedBlock{
+				{
+					TypeName: "rule",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+					Block: &tfprotov6.SchemaBlock{
+						Attributes: []*tfprotov6.SchemaAttribute{
+							{
+								Name:      "secret",
+								Optional:  true,
+								Sensitive: true,
+								Type:      tftypes.String,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	typ := schema.ValueType()
+	ruleType := typ.(tftypes.Object).AttributeTypes["rule"].(tftypes.List).ElementType
+
+	value := func(id interface{}, name string, size int64) *tfprotov6.DynamicValue {
+		return testNewDynamicValueMustPointer(t, typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+			"id":       tftypes.NewValue(tftypes.String, id),
+			"name":     tftypes.NewValue(tftypes.String, name),
+			"password": tftypes.NewValue(tftypes.String, nil),
+			"size":     tftypes.NewValue(tftypes.Number, size),
+			"rule": tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{
+				tftypes.NewValue(ruleType, map[string]tftypes.Value{
+					"secret": tftypes.NewValue(tftypes.String, "hunter2"),
+				}),
+			}),
+		}))
+	}
+	null := testNewDynamicValueMustPointer(t, typ, tftypes.NewValue(typ, nil))
+
+	testCases := map[string]struct {
+		req      *tfprotov6.PlanResourceChangeRequest
+		resp     *tfprotov6.PlanResourceChangeResponse
+		expected string
+	}{
+		"create": {
+			req: &tfprotov6.PlanResourceChangeRequest{
+				TypeName:   "test_resource",
+				PriorState: null,
+			},
+			resp: &tfprotov6.PlanResourceChangeResponse{
+				PlannedState: value(tftypes.UnknownValue, "test", 1),
+			},
+			expected: `{"mode":"managed","type":"test_resource","change":{"actions":["create"],"before":null,"after":{"id":null,"name":"test","password":null,"rule":[{"secret":"hunter2"}],"size":1},"after_unknown":{"id":true,"rule":[{}]},"before_sensitive":false,"after_sensitive":{"password":true,"rule":[{"secret":true}]}}}`,
+		},
+		"update": {
+			req: &tfprotov6.PlanResourceChangeRequest{
+				TypeName:   "test_resource",
+				PriorState: value("abc", "test", 1),
+			},
+			resp: &tfprotov6.PlanResourceChangeResponse{
+				PlannedState: value("abc", "test", 2),
+			},
+			expected: `{"mode":"managed","type":"test_resource","change":{"actions":["update"],"before":{"id":"abc","name":"test","password":null,"rule":[{"secret":"hunter2"}],"size":1},"after":{"id":"abc","name":"test","password":null,"rule":[{"secret":"hunter2"}],"size":2},"after_unknown":{"rule":[{}]},"before_sensitive":{"password":true,"rule":[{"secret":true}]},"after_sensitive":{"password":true,"rule":[{"secret":true}]}}}`,
+		},
+		"replace": {
+			req: &tfprotov6.PlanResourceChangeRequest{
+				TypeName:   "test_resource",
+				PriorState: value("abc", "test", 1),
+			},
+			resp: &tfprotov6.PlanResourceChangeResponse{
+				PlannedState: value(tftypes.UnknownValue, "changed", 1),
+				RequiresReplace: []*tftypes.AttributePath{
+					tftypes.NewAttributePath().WithAttributeName("name"),
+					tftypes.NewAttributePath().WithAttributeName("size"),
+				},
+			},
+			expected: `{"mode":"managed","type":"test_resource","change":{"actions":["delete","create"],"before":{"id":"abc","name":"test","password":null,"rule":[{"secret":"hunter2"}],"size":1},"after":{"id":null,"name":"changed","password":null,"rule":[{"secret":"hunter2"}],"size":1},"after_unknown":{"id":true,"rule":[{}]},"before_sensitive":{"password":true,"rule":[{"secret":true}]},"after_sensitive":{"password":true,"rule":[{"secret":true}]},"replace_paths":[["name"]]}}`,
+		},
+		"no-op": {
+			req: &tfprotov6.PlanResourceChangeRequest{
+				TypeName:   "test_resource",
+				PriorState: value("abc", "test", 1),
+			},
+			resp: &tfprotov6.PlanResourceChangeResponse{
+				PlannedState: value("abc", "test", 1),
+				RequiresReplace: []*tftypes.AttributePath{
+					tftypes.NewAttributePath().WithAttributeName("name"),
+				},
+			},
+			expected: `{"mode":"managed","type":"test_resource","change":{"actions":["no-op"],"before":{"id":"abc","name":"test","password":null,"rule":[{"secret":"hunter2"}],"size":1},"after":{"id":"abc","name":"test","password":null,"rule":[{"secret":"hunter2"}],"size":1},"after_unknown":{"rule":[{}]},"before_sensitive":{"password":true,"rule":[{"secret":true}]},"after_sensitive":{"password":true,"rule":[{"secret":true}]}}}`,
+		},
+		"delete": {
+			req: &tfprotov6.PlanResourceChangeRequest{
+				TypeName:   "test_resource",
+				PriorState: value("abc", "test", 1),
+			},
+			resp: &tfprotov6.PlanResourceChangeResponse{
+				PlannedState: null,
+			},
+			expected: `{"mode":"managed","type":"test_resource","change":{"actions":["delete"],"before":{"id":"abc","name":"test","password":null,"rule":[{"secret":"hunter2"}],"size":1},"after":null,"after_unknown":false,"before_sensitive":{"password":true,"rule":[{"secret":true}]},"after_sensitive":false}}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfprotov6.NewResourceChange(schema, testCase.req, testCase.resp)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			gotJSON, err := json.Marshal(got)
+
+			if err != nil {
+				t.Fatalf("unexpected error marshaling: %s", err)
+			}
+
+			if string(gotJSON) != testCase.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", testCase.expected, gotJSON)
+			}
+		})
+	}
+}
+
+func testNewDynamicValueMustPointer(t *testing.T, typ tftypes.Type, value tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	dynamicValue := testNewDynamicValueMust(t, typ, value)
+
+	return &dynamicValue
+}
+
+func TestNewResourceChange_NestedAttributes(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name: "credentials",
+					NestedType: &tfprotov6.SchemaObject{
+						Attributes: []*tfprotov6.SchemaAttribute{
+							{
+								Name:     "username",
+								Optional: true,
+								Type:     tftypes.String,
+							},
+							{
+								Name:      "password",
+								Optional:  true,
+								Sensitive: true,
+								Type:      tftypes.String,
+							},
+						},
+						Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+					},
+					Optional: true,
+				},
+			},
+		},
+	}
+	typ := schema.ValueType()
+	credentialsType := typ.(tftypes.Object).AttributeTypes["credentials"]
+
+	got, err := tfprotov6.NewResourceChange(
+		schema,
+		&tfprotov6.PlanResourceChangeRequest{
+			TypeName:   "test_resource",
+			PriorState: testNewDynamicValueMustPointer(t, typ, tftypes.NewValue(typ, nil)),
+		},
+		&tfprotov6.PlanResourceChangeResponse{
+			PlannedState: testNewDynamicValueMustPointer(t, typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+				"credentials": tftypes.NewValue(credentialsType, map[string]tftypes.Value{
+					"username": tftypes.NewValue(tftypes.String, "admin"),
+					"password": tftypes.NewValue(tftypes.String, "hunter2"),
+				}),
+			})),
+		},
+	)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	gotJSON, err := json.Marshal(got.Change.AfterSensitive)
+
+	if err != nil {
+		t.Fatalf("unexpected error marshaling: %s", err)
+	}
+
+	expected := `{"credentials":{"password":true}}`
+
+	if string(gotJSON) != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, gotJSON)
+	}
+}
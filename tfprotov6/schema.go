@@ -253,6 +253,14 @@ type SchemaAttribute struct {
 	// experiences. Providers should set it when deprecating attributes in
 	// preparation for these tools.
 	Deprecated bool
+
+	// Default is the value of the attribute when it is null in the
+	// configuration, as applied by Schema.ApplyValueDefaults when
+	// planning resource changes. It is not part of the protocol and is
+	// never sent to Terraform, so attributes with a Default must be both
+	// Optional and Computed, which allows the planned value to differ
+	// from the configuration.
+	Default *tftypes.Value
 }
 
 // ValueType returns the tftypes.Type for a SchemaAttribute.
@@ -0,0 +1,500 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaApplyValueDefaults(t *testing.T) {
+	t.Parallel()
+
+	nestedBlock := &tfprotov6.SchemaBlock{
+		Attributes: []*tfprotov6.SchemaAttribute{
+			{
+				Name:     "port",
+				Type:     tftypes.Number,
+				Optional: true,
+				Computed: true,
+				Default:  pointer(tftypes.NewValue(tftypes.Number, 80)),
+			},
+			{
+				Name:     "protocol",
+				Type:     tftypes.String,
+				Optional: true,
+			},
+		},
+	}
+	schema := &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:     "name",
+					Type:     tftypes.String,
+					Optional: true,
+					Computed: true,
+					Default:  pointer(tftypes.NewValue(tftypes.String, "default")),
+				},
+				{
+					Name:     "description",
+					Type:     tftypes.String,
+					Optional: true,
+				},
+				{
+					Name:     "extra",
+					Type:     tftypes.DynamicPseudoType,
+					Optional: true,
+					Computed: true,
+				},
+			},
+			BlockTypes: []*tfprotov6.SchemaNestedBlock{
+				{
+					TypeName: "list",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "set",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeSet,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "map",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeMap,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "single",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+					Block:    nestedBlock,
+				},
+			},
+		},
+	}
+	schemaType := schema.ValueType().(tftypes.Object) //nolint:forcetypeassert // ValueType always returns an Object
+	nestedType := nestedBlock.ValueType()
+
+	testValue := func(values map[string]tftypes.Value) tftypes.Value {
+		value := map[string]tftypes.Value{
+			"name":        tftypes.NewValue(tftypes.String, nil),
+			"description": tftypes.NewValue(tftypes.String, nil),
+			"extra":       tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+			"list":        tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{}),
+			"set":         tftypes.NewValue(schemaType.AttributeTypes["set"], []tftypes.Value{}),
+			"map":         tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{}),
+			"single":      tftypes.NewValue(nestedType, nil),
+		}
+
+		for name, v := range values {
+			value[name] = v
+		}
+
+		return tftypes.NewValue(schemaType, value)
+	}
+	testNested := func(port interface{}, protocol interface{}) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"port":     tftypes.NewValue(tftypes.Number, port),
+			"protocol": tftypes.NewValue(tftypes.String, protocol),
+		})
+	}
+
+	testCases := map[string]struct {
+		schema        *tfprotov6.Schema
+		value         tftypes.Value
+		opts          tfprotov6.ApplyDefaultsOpts
+		expected      tftypes.Value
+		expectedError string
+	}{
+		"nil-schema": {
+			value:    tftypes.NewValue(tftypes.String, "test"),
+			expected: tftypes.NewValue(tftypes.String, "test"),
+		},
+		"nil-schema-defaults": {
+			value: tftypes.NewValue(tftypes.String, "test"),
+			opts: tfprotov6.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test"),
+				},
+			},
+			expectedError: `path not found in schema: no attribute at path "name"`,
+		},
+		"null": {
+			schema:   schema,
+			value:    tftypes.NewValue(schemaType, nil),
+			expected: tftypes.NewValue(schemaType, nil),
+		},
+		"unknown": {
+			schema:   schema,
+			value:    tftypes.NewValue(schemaType, tftypes.UnknownValue),
+			expected: tftypes.NewValue(schemaType, tftypes.UnknownValue),
+		},
+		"schema-defaults": {
+			schema: schema,
+			value:  testValue(nil),
+			expected: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "default"),
+			}),
+		},
+		"configured": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "configured"),
+			}),
+			expected: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "configured"),
+			}),
+		},
+		"configured-unknown": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"nested-blocks": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{
+					testNested(nil, "tcp"),
+					testNested(443, nil),
+				}),
+				"set": tftypes.NewValue(schemaType.AttributeTypes["set"], []tftypes.Value{
+					testNested(nil, "udp"),
+				}),
+				"map": tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{
+					"key": testNested(nil, nil),
+				}),
+				"single": testNested(nil, nil),
+			}),
+			expected: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "default"),
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{
+					testNested(80, "tcp"),
+					testNested(443, nil),
+				}),
+				"set": tftypes.NewValue(schemaType.AttributeTypes["set"], []tftypes.Value{
+					testNested(80, "udp"),
+				}),
+				"map": tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{
+					"key": testNested(80, nil),
+				}),
+				"single": testNested(80, nil),
+			}),
+		},
+		"nested-block-unknown": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], tftypes.UnknownValue),
+			}),
+			expected: testValue(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "default"),
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], tftypes.UnknownValue),
+			}),
+		},
+		"opts-defaults": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"single": testNested(nil, nil),
+			}),
+			opts: tfprotov6.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"name":            tftypes.NewValue(tftypes.String, "override"),
+					"description":     tftypes.NewValue(tftypes.String, "added"),
+					"single.protocol": tftypes.NewValue(tftypes.String, "tcp"),
+				},
+			},
+			expected: testValue(map[string]tftypes.Value{
+				"name":        tftypes.NewValue(tftypes.String, "override"),
+				"description": tftypes.NewValue(tftypes.String, "added"),
+				"single":      testNested(80, "tcp"),
+			}),
+		},
+		"opts-defaults-dynamic": {
+			schema: schema,
+			value:  testValue(nil),
+			opts: tfprotov6.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"extra": tftypes.NewValue(tftypes.Bool, true),
+				},
+			},
+			expected: testValue(map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, "default"),
+				"extra": tftypes.NewValue(tftypes.Bool, true),
+			}),
+		},
+		"ignore-schema-defaults": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"single": testNested(nil, nil),
+			}),
+			opts: tfprotov6.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"description": tftypes.NewValue(tftypes.String, "added"),
+				},
+				IgnoreSchemaDefaults: true,
+			},
+			expected: testValue(map[string]tftypes.Value{
+				"description": tftypes.NewValue(tftypes.String, "added"),
+				"single":      testNested(nil, nil),
+			}),
+		},
+		"opts-defaults-missing-attribute": {
+			schema: schema,
+			value:  testValue(nil),
+			opts: tfprotov6.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"list.missing": tftypes.NewValue(tftypes.String, "test"),
+				},
+			},
+			expectedError: `path not found in schema: no attribute at path "list.missing"`,
+		},
+		"opts-defaults-block": {
+			schema: schema,
+			value:  testValue(nil),
+			opts: tfprotov6.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"single": tftypes.NewValue(nestedType, nil),
+				},
+			},
+			expectedError: `path not found in schema: no attribute at path "single"`,
+		},
+		"opts-defaults-invalid-type": {
+			schema: schema,
+			value:  testValue(nil),
+			opts: tfprotov6.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"list.port": tftypes.NewValue(tftypes.String, "80"),
+				},
+			},
+			expectedError: `default of attribute "list.port" is not of the attribute type`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.schema.ApplyValueDefaults(testCase.value, testCase.opts)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Errorf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaApplyDynamicValueDefaults(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:     "name",
+					Type:     tftypes.String,
+					Required: true,
+				},
+				{
+					Name:     "port",
+					Type:     tftypes.Number,
+					Optional: true,
+					Computed: true,
+					Default:  pointer(tftypes.NewValue(tftypes.Number, 80)),
+				},
+			},
+		},
+	}
+
+	got, err := schema.ApplyDynamicValueDefaults(&tfprotov6.DynamicValue{
+		JSON: []byte(`{"name":"test","port":null}`),
+	}, tfprotov6.ApplyDefaultsOpts{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := tftypes.NewValue(schema.ValueType(), map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test"),
+		"port": tftypes.NewValue(tftypes.Number, 80),
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = schema.ApplyDynamicValueDefaults(&tfprotov6.DynamicValue{
+		JSON: []byte(`{"name":["invalid"]}`),
+	}, tfprotov6.ApplyDefaultsOpts{})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestSchemaApplyValueDefaults_NestedAttributes(t *testing.T) {
+	t.Parallel()
+
+	nestedType := func(nesting tfprotov6.SchemaObjectNestingMode) *tfprotov6.SchemaObject {
+		return &tfprotov6.SchemaObject{
+			Nesting: nesting,
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:     "port",
+					Type:     tftypes.Number,
+					Optional: true,
+					Computed: true,
+					Default:  pointer(tftypes.NewValue(tftypes.Number, 80)),
+				},
+				{
+					Name:     "protocol",
+					Type:     tftypes.String,
+					Optional: true,
+				},
+			},
+		}
+	}
+	schema := &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:       "single",
+					NestedType: nestedType(tfprotov6.SchemaObjectNestingModeSingle),
+					Optional:   true,
+				},
+				{
+					Name:       "list",
+					NestedType: nestedType(tfprotov6.SchemaObjectNestingModeList),
+					Optional:   true,
+				},
+				{
+					Name:       "set",
+					NestedType: nestedType(tfprotov6.SchemaObjectNestingModeSet),
+					Optional:   true,
+				},
+				{
+					Name:       "map",
+					NestedType: nestedType(tfprotov6.SchemaObjectNestingModeMap),
+					Optional:   true,
+				},
+			},
+		},
+	}
+	schemaType := schema.ValueType().(tftypes.Object) //nolint:forcetypeassert // ValueType always returns an Object
+	objectType := schemaType.AttributeTypes["single"]
+
+	testObject := func(port interface{}, protocol interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"port":     tftypes.NewValue(tftypes.Number, port),
+			"protocol": tftypes.NewValue(tftypes.String, protocol),
+		})
+	}
+	testValue := func(port interface{}) tftypes.Value {
+		return tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"single": testObject(port, "tcp"),
+			"list": tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{
+				testObject(port, nil),
+				testObject(443, nil),
+			}),
+			"set": tftypes.NewValue(schemaType.AttributeTypes["set"], []tftypes.Value{
+				testObject(port, "udp"),
+			}),
+			"map": tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{
+				"key": testObject(port, nil),
+			}),
+		})
+	}
+
+	testCases := map[string]struct {
+		value    tftypes.Value
+		opts     tfprotov6.ApplyDefaultsOpts
+		expected tftypes.Value
+	}{
+		"schema-defaults": {
+			value:    testValue(nil),
+			expected: testValue(80),
+		},
+		"opts-defaults": {
+			value: testValue(nil),
+			opts: tfprotov6.ApplyDefaultsOpts{
+				Defaults: map[string]tftypes.Value{
+					"list.port": tftypes.NewValue(tftypes.Number, 8080),
+				},
+			},
+			expected: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"single": testObject(80, "tcp"),
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{
+					testObject(8080, nil),
+					testObject(443, nil),
+				}),
+				"set": tftypes.NewValue(schemaType.AttributeTypes["set"], []tftypes.Value{
+					testObject(80, "udp"),
+				}),
+				"map": tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{
+					"key": testObject(80, nil),
+				}),
+			}),
+		},
+		"null-and-unknown": {
+			value: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"single": tftypes.NewValue(objectType, nil),
+				"list":   tftypes.NewValue(schemaType.AttributeTypes["list"], tftypes.UnknownValue),
+				"set":    tftypes.NewValue(schemaType.AttributeTypes["set"], nil),
+				"map": tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{
+					"key": tftypes.NewValue(objectType, tftypes.UnknownValue),
+				}),
+			}),
+			expected: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"single": tftypes.NewValue(objectType, nil),
+				"list":   tftypes.NewValue(schemaType.AttributeTypes["list"], tftypes.UnknownValue),
+				"set":    tftypes.NewValue(schemaType.AttributeTypes["set"], nil),
+				"map": tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{
+					"key": tftypes.NewValue(objectType, tftypes.UnknownValue),
+				}),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := schema.ApplyValueDefaults(testCase.value, testCase.opts)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
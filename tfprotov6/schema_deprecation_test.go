@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaDeprecationDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	nestedBlock := &tfprotov6.SchemaBlock{
+		Attributes: []*tfprotov6.SchemaAttribute{
+			{
+				Name:     "value",
+				Type:     tftypes.String,
+				Optional: true,
+			},
+			{
+				Name:       "deprecated_value",
+				Type:       tftypes.String,
+				Optional:   true,
+				Deprecated: true,
+			},
+		},
+	}
+	deprecatedNestedBlock := &tfprotov6.SchemaBlock{
+		Attributes: []*tfprotov6.SchemaAttribute{
+			{
+				Name:     "value",
+				Type:     tftypes.String,
+				Optional: true,
+			},
+		},
+		Deprecated: true,
+	}
+	schema := &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:     "string",
+					Type:     tftypes.String,
+					Optional: true,
+				},
+				{
+					Name:       "deprecated_string",
+					Type:       tftypes.String,
+					Optional:   true,
+					Deprecated: true,
+				},
+			},
+			BlockTypes: []*tfprotov6.SchemaNestedBlock{
+				{
+					TypeName: "list",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "map",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeMap,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "deprecated_group",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeGroup,
+					Block:    deprecatedNestedBlock,
+				},
+				{
+					TypeName: "deprecated_set",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeSet,
+					Block:    deprecatedNestedBlock,
+				},
+			},
+		},
+	}
+	schemaType := schema.ValueType().(tftypes.Object) //nolint:forcetypeassert // ValueType always returns an Object
+	nestedType := nestedBlock.ValueType()
+	deprecatedNestedType := deprecatedNestedBlock.ValueType()
+
+	testConfig := func(values map[string]tftypes.Value) tftypes.Value {
+		config := map[string]tftypes.Value{
+			"string":            tftypes.NewValue(tftypes.String, nil),
+			"deprecated_string": tftypes.NewValue(tftypes.String, nil),
+			"list":              tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{}),
+			"map":               tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{}),
+			"deprecated_group": tftypes.NewValue(deprecatedNestedType, map[string]tftypes.Value{
+				"value": tftypes.NewValue(tftypes.String, nil),
+			}),
+			"deprecated_set": tftypes.NewValue(schemaType.AttributeTypes["deprecated_set"], []tftypes.Value{}),
+		}
+
+		for name, value := range values {
+			config[name] = value
+		}
+
+		return tftypes.NewValue(schemaType, config)
+	}
+	testNestedValue := func(value interface{}, deprecatedValue interface{}) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"value":            tftypes.NewValue(tftypes.String, value),
+			"deprecated_value": tftypes.NewValue(tftypes.String, deprecatedValue),
+		})
+	}
+
+	testCases := map[string]struct {
+		schema   *tfprotov6.Schema
+		config   tftypes.Value
+		expected tfprotov6.Diagnostics
+	}{
+		"nil-schema": {
+			schema:   nil,
+			config:   testConfig(nil),
+			expected: nil,
+		},
+		"none-set": {
+			schema:   schema,
+			config:   testConfig(nil),
+			expected: nil,
+		},
+		"not-deprecated-set": {
+			schema: schema,
+			config: testConfig(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "test"),
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{
+					testNestedValue("test", nil),
+				}),
+			}),
+			expected: nil,
+		},
+		"attributes": {
+			schema: schema,
+			config: testConfig(map[string]tftypes.Value{
+				"deprecated_string": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{
+					testNestedValue("test", nil),
+					testNestedValue(nil, "test"),
+				}),
+				"map": tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{
+					"b": testNestedValue(nil, "test"),
+					"a": testNestedValue(nil, "test"),
+				}),
+			}),
+			expected: tfprotov6.Diagnostics{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "Deprecated Attribute",
+					Detail:    `The "deprecated_string" attribute is deprecated. Refer to the provider documentation for details.`,
+					Attribute: tftypes.NewAttributePath().WithAttributeName("deprecated_string"),
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "Deprecated Attribute",
+					Detail:    `The "deprecated_value" attribute is deprecated. Refer to the provider documentation for details.`,
+					Attribute: tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(1).WithAttributeName("deprecated_value"),
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "Deprecated Attribute",
+					Detail:    `The "deprecated_value" attribute is deprecated. Refer to the provider documentation for details.`,
+					Attribute: tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyString("a").WithAttributeName("deprecated_value"),
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "Deprecated Attribute",
+					Detail:    `The "deprecated_value" attribute is deprecated. Refer to the provider documentation for details.`,
+					Attribute: tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyString("b").WithAttributeName("deprecated_value"),
+				},
+			},
+		},
+		"blocks": {
+			schema: schema,
+			config: testConfig(map[string]tftypes.Value{
+				"deprecated_group": tftypes.NewValue(deprecatedNestedType, map[string]tftypes.Value{
+					"value": tftypes.NewValue(tftypes.String, "test"),
+				}),
+				"deprecated_set": tftypes.NewValue(schemaType.AttributeTypes["deprecated_set"], []tftypes.Value{
+					tftypes.NewValue(deprecatedNestedType, map[string]tftypes.Value{
+						"value": tftypes.NewValue(tftypes.String, nil),
+					}),
+				}),
+			}),
+			expected: tfprotov6.Diagnostics{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "Deprecated Block",
+					Detail:    `The "deprecated_group" block is deprecated. Refer to the provider documentation for details.`,
+					Attribute: tftypes.NewAttributePath().WithAttributeName("deprecated_group"),
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "Deprecated Block",
+					Detail:    `The "deprecated_set" block is deprecated. Refer to the provider documentation for details.`,
+					Attribute: tftypes.NewAttributePath().WithAttributeName("deprecated_set"),
+				},
+			},
+		},
+		"unknown-block": {
+			schema: schema,
+			config: testConfig(map[string]tftypes.Value{
+				"deprecated_set": tftypes.NewValue(schemaType.AttributeTypes["deprecated_set"], tftypes.UnknownValue),
+			}),
+			expected: tfprotov6.Diagnostics{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "Deprecated Block",
+					Detail:    `The "deprecated_set" block is deprecated. Refer to the provider documentation for details.`,
+					Attribute: tftypes.NewAttributePath().WithAttributeName("deprecated_set"),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := testNewDynamicValueMust(t, schemaType, testCase.config)
+
+			got, err := testCase.schema.DeprecationDiagnostics(&config)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaDeprecationDiagnostics_NestedAttributes(t *testing.T) {
+	t.Parallel()
+
+	nestedObject := &tfprotov6.SchemaObject{
+		Attributes: []*tfprotov6.SchemaAttribute{
+			{
+				Name:     "value",
+				Type:     tftypes.String,
+				Optional: true,
+			},
+			{
+				Name:       "deprecated_value",
+				Type:       tftypes.String,
+				Optional:   true,
+				Deprecated: true,
+			},
+		},
+	}
+	schema := &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name: "single",
+					NestedType: &tfprotov6.SchemaObject{
+						Attributes: nestedObject.Attributes,
+						Nesting:    tfprotov6.SchemaObjectNestingModeSingle,
+					},
+					Optional: true,
+				},
+				{
+					Name: "deprecated_list",
+					NestedType: &tfprotov6.SchemaObject{
+						Attributes: nestedObject.Attributes,
+						Nesting:    tfprotov6.SchemaObjectNestingModeList,
+					},
+					Optional:   true,
+					Deprecated: true,
+				},
+				{
+					Name: "map",
+					NestedType: &tfprotov6.SchemaObject{
+						Attributes: nestedObject.Attributes,
+						Nesting:    tfprotov6.SchemaObjectNestingModeMap,
+					},
+					Optional: true,
+				},
+			},
+		},
+	}
+	schemaType := schema.ValueType().(tftypes.Object) //nolint:forcetypeassert // ValueType always returns an Object
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"value":            tftypes.String,
+			"deprecated_value": tftypes.String,
+		},
+	}
+	testObject := func(value interface{}, deprecatedValue interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"value":            tftypes.NewValue(tftypes.String, value),
+			"deprecated_value": tftypes.NewValue(tftypes.String, deprecatedValue),
+		})
+	}
+
+	config := testNewDynamicValueMust(t, schemaType, tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"single": testObject(nil, "test"),
+		"deprecated_list": tftypes.NewValue(schemaType.AttributeTypes["deprecated_list"], []tftypes.Value{
+			testObject("test", nil),
+			testObject(nil, tftypes.UnknownValue),
+		}),
+		"map": tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{
+			"a": testObject("test", nil),
+		}),
+	}))
+
+	got, err := schema.DeprecationDiagnostics(&config)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := tfprotov6.Diagnostics{
+		{
+			Severity:  tfprotov6.DiagnosticSeverityWarning,
+			Summary:   "Deprecated Attribute",
+			Detail:    `The "deprecated_value" attribute is deprecated. Refer to the provider documentation for details.`,
+			Attribute: tftypes.NewAttributePath().WithAttributeName("single").WithAttributeName("deprecated_value"),
+		},
+		{
+			Severity:  tfprotov6.DiagnosticSeverityWarning,
+			Summary:   "Deprecated Attribute",
+			Detail:    `The "deprecated_list" attribute is deprecated. Refer to the provider documentation for details.`,
+			Attribute: tftypes.NewAttributePath().WithAttributeName("deprecated_list"),
+		},
+		{
+			Severity:  tfprotov6.DiagnosticSeverityWarning,
+			Summary:   "Deprecated Attribute",
+			Detail:    `The "deprecated_value" attribute is deprecated. Refer to the provider documentation for details.`,
+			Attribute: tftypes.NewAttributePath().WithAttributeName("deprecated_list").WithElementKeyInt(1).WithAttributeName("deprecated_value"),
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestSchemaDeprecationDiagnostics_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:       "test",
+					Type:       tftypes.String,
+					Optional:   true,
+					Deprecated: true,
+				},
+			},
+		},
+	}
+
+	_, err := schema.DeprecationDiagnostics(&tfprotov6.DynamicValue{
+		JSON: []byte(`{"test":["invalid"]}`),
+	})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -0,0 +1,1182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// RenderDiffOpts configures Schema.RenderValueDiff and
+// Schema.RenderDynamicValueDiff.
+type RenderDiffOpts struct {
+	// ShowUnchanged includes unchanged attributes, nested blocks, and
+	// elements in the diff. By default, they are hidden and counted, as in
+	// Terraform plans.
+	ShowUnchanged bool
+}
+
+// RenderValueDiff returns a textual diff between the prior and proposed
+// values of the schema type, such as the prior and planned state of a
+// resource, in the style of a Terraform plan. Created, deleted, and updated
+// attributes, nested blocks, and elements are marked with "+", "-", and "~"
+// respectively, and the values of sensitive attributes are replaced by
+// DefaultRedactPlaceholder. Either value may be null, such as when a
+// resource is created or destroyed.
+//
+// The diff is intended for people, such as in test failures and debug logs,
+// and its format may change between releases.
+func (s *Schema) RenderValueDiff(prior, proposed tftypes.Value, opts RenderDiffOpts) (string, error) {
+	d := differ{
+		showUnchanged: opts.ShowUnchanged,
+	}
+	path := tftypes.NewAttributePath()
+
+	var lines []diffLine
+	var err error
+
+	if s == nil {
+		lines, err = d.value(0, path, "", "", prior, proposed)
+	} else {
+		lines, err = d.blockObject(0, path, "", s.Block, prior, proposed)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return renderDiffLines(lines), nil
+}
+
+// RenderDynamicValueDiff unmarshals the values with the schema type and
+// returns the diff between them as described by RenderValueDiff. A nil value
+// is treated as null.
+func (s *Schema) RenderDynamicValueDiff(prior, proposed *DynamicValue, opts RenderDiffOpts) (string, error) {
+	priorValue, err := s.unmarshalDiffValue(prior)
+
+	if err != nil {
+		return "", fmt.Errorf("unable to unmarshal prior value: %w", err)
+	}
+
+	proposedValue, err := s.unmarshalDiffValue(proposed)
+
+	if err != nil {
+		return "", fmt.Errorf("unable to unmarshal proposed value: %w", err)
+	}
+
+	return s.RenderValueDiff(priorValue, proposedValue, opts)
+}
+
+func (s *Schema) unmarshalDiffValue(value *DynamicValue) (tftypes.Value, error) {
+	if value == nil {
+		return tftypes.NewValue(s.ValueType(), nil), nil
+	}
+
+	return value.Unmarshal(s.ValueType())
+}
+
+// diffAction is the marker of a line of a diff.
+type diffAction byte
+
+const (
+	diffActionNoOp   diffAction = ' '
+	diffActionCreate diffAction = '+'
+	diffActionDelete diffAction = '-'
+	diffActionUpdate diffAction = '~'
+)
+
+// diffActionOf returns the action changing prior to proposed. Missing values
+// are treated as null.
+func diffActionOf(prior, proposed tftypes.Value) diffAction {
+	switch {
+	case prior.IsNull() && proposed.IsNull():
+		return diffActionNoOp
+	case prior.IsNull():
+		return diffActionCreate
+	case proposed.IsNull():
+		return diffActionDelete
+	case prior.Equal(proposed):
+		return diffActionNoOp
+	default:
+		return diffActionUpdate
+	}
+}
+
+// diffLine is a line of a diff, indented by four spaces per level.
+type diffLine struct {
+	indent int
+	action diffAction
+	text   string
+}
+
+func renderDiffLines(lines []diffLine) string {
+	var b strings.Builder
+
+	for index, line := range lines {
+		if index > 0 {
+			b.WriteByte('\n')
+		}
+
+		b.WriteString(strings.Repeat("    ", line.indent))
+		b.WriteByte(byte(line.action))
+		b.WriteByte(' ')
+		b.WriteString(line.text)
+	}
+
+	return b.String()
+}
+
+// diffHiddenLine returns the line counting hidden unchanged things.
+func diffHiddenLine(indent int, count int, noun string) diffLine {
+	if count != 1 {
+		noun += "s"
+	}
+
+	return diffLine{
+		indent: indent,
+		action: diffActionNoOp,
+		text:   fmt.Sprintf("# (%d unchanged %s hidden)", count, noun),
+	}
+}
+
+// diffPrefix returns the prefix of an attribute or map element line, with
+// the name padded to width so the values line up.
+func diffPrefix(name string, width int) string {
+	return name + strings.Repeat(" ", width-len(name)) + " = "
+}
+
+// differ renders diffs with the RenderDiffOpts applied.
+type differ struct {
+	showUnchanged bool
+}
+
+// blockObject returns the lines of a block, starting with header, such as
+// the block type name.
+func (d differ) blockObject(indent int, path *tftypes.AttributePath, header string, s *SchemaBlock, prior, proposed tftypes.Value) ([]diffLine, error) {
+	action := diffActionOf(prior, proposed)
+
+	if !prior.IsKnown() || !proposed.IsKnown() {
+		return []diffLine{{indent: indent, action: action, text: header + "(known after apply)"}}, nil
+	}
+
+	body, err := d.block(indent+1, path, s, prior, proposed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]diffLine, 0, len(body)+2)
+	lines = append(lines, diffLine{indent: indent, action: action, text: header + "{"})
+	lines = append(lines, body...)
+	lines = append(lines, diffLine{indent: indent, action: diffActionNoOp, text: "}"})
+
+	return lines, nil
+}
+
+// block returns the lines of the attributes and nested blocks of a block.
+func (d differ) block(indent int, path *tftypes.AttributePath, s *SchemaBlock, prior, proposed tftypes.Value) ([]diffLine, error) {
+	priorValues, err := diffObjectValues(path, prior)
+
+	if err != nil {
+		return nil, err
+	}
+
+	proposedValues, err := diffObjectValues(path, proposed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s == nil {
+		return nil, nil
+	}
+
+	lines, err := d.attributes(indent, path, s.Attributes, priorValues, proposedValues)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blockTypes := make([]*SchemaNestedBlock, 0, len(s.BlockTypes))
+
+	for _, blockType := range s.BlockTypes {
+		if blockType != nil {
+			blockTypes = append(blockTypes, blockType)
+		}
+	}
+
+	sort.Slice(blockTypes, func(i, j int) bool {
+		return blockTypes[i].TypeName < blockTypes[j].TypeName
+	})
+
+	hidden := 0
+
+	for _, blockType := range blockTypes {
+		name := blockType.TypeName
+		blockLines, blockHidden, err := d.nestedBlock(indent, path.WithAttributeName(name), blockType, priorValues[name], proposedValues[name])
+
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, blockLines...)
+		hidden += blockHidden
+	}
+
+	if hidden > 0 {
+		lines = append(lines, diffHiddenLine(indent, hidden, "block"))
+	}
+
+	return lines, nil
+}
+
+// attributes returns the lines of the attributes, sorted by name.
+func (d differ) attributes(indent int, path *tftypes.AttributePath, attributes []*SchemaAttribute, prior, proposed map[string]tftypes.Value) ([]diffLine, error) {
+	visible := make([]*SchemaAttribute, 0, len(attributes))
+	width := 0
+	hidden := 0
+
+	for _, attribute := range attributes {
+		if attribute == nil {
+			continue
+		}
+
+		if !d.showUnchanged && diffActionOf(prior[attribute.Name], proposed[attribute.Name]) == diffActionNoOp {
+			// Attributes which are not set are not counted.
+			if !prior[attribute.Name].IsNull() {
+				hidden++
+			}
+
+			continue
+		}
+
+		visible = append(visible, attribute)
+		width = max(width, len(attribute.Name))
+	}
+
+	sort.Slice(visible, func(i, j int) bool {
+		return visible[i].Name < visible[j].Name
+	})
+
+	var lines []diffLine
+
+	for _, attribute := range visible {
+		name := attribute.Name
+		attributeLines, err := d.attribute(indent, path.WithAttributeName(name), diffPrefix(name, width), attribute, prior[name], proposed[name])
+
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, attributeLines...)
+	}
+
+	if hidden > 0 {
+		lines = append(lines, diffHiddenLine(indent, hidden, "attribute"))
+	}
+
+	return lines, nil
+}
+
+// attribute returns the lines of the attribute, starting with prefix.
+func (d differ) attribute(indent int, path *tftypes.AttributePath, prefix string, s *SchemaAttribute, prior, proposed tftypes.Value) ([]diffLine, error) {
+	if !s.Sensitive {
+		if s.NestedType != nil {
+			return d.nestedType(indent, path, prefix, s.NestedType, prior, proposed)
+		}
+
+		return d.value(indent, path, prefix, "", prior, proposed)
+	}
+
+	text := DefaultRedactPlaceholder
+
+	if prior.IsNull() && proposed.IsNull() {
+		text = "null"
+	}
+
+	return []diffLine{{indent: indent, action: diffActionOf(prior, proposed), text: prefix + text}}, nil
+}
+
+// nestedType returns the lines of the nested attribute type, starting with
+// prefix.
+func (d differ) nestedType(indent int, path *tftypes.AttributePath, prefix string, s *SchemaObject, prior, proposed tftypes.Value) ([]diffLine, error) {
+	_, priorInline, err := diffInline(path, prior)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, proposedInline, err := diffInline(path, proposed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Null, unknown, and empty values have no attributes to redact, so
+	// are shown as is.
+	if priorInline && proposedInline {
+		return d.value(indent, path, prefix, "", prior, proposed)
+	}
+
+	action := diffActionOf(prior, proposed)
+
+	if !prior.IsKnown() || !proposed.IsKnown() {
+		return []diffLine{{indent: indent, action: action, text: prefix + "(known after apply)"}}, nil
+	}
+
+	closing := ""
+
+	if action == diffActionDelete {
+		closing = " -> null"
+	}
+
+	var lines []diffLine
+	hidden := 0
+
+	switch s.Nesting {
+	case SchemaObjectNestingModeSingle:
+		return d.nestedObject(indent, path, prefix, "", s, prior, proposed)
+	case SchemaObjectNestingModeList, SchemaObjectNestingModeSet:
+		priorElements, proposedElements, err := diffListValues(path, prior, proposed)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var elements []diffElementPair
+
+		if s.Nesting == SchemaObjectNestingModeSet {
+			elements = diffSetElements(priorElements, proposedElements)
+
+			for index, element := range elements {
+				elements[index].path = path.WithElementKeyValue(element.value())
+			}
+		} else {
+			for index := 0; index < max(len(priorElements), len(proposedElements)); index++ {
+				elements = append(elements, diffElementPair{
+					path:     path.WithElementKeyInt(index),
+					prior:    diffElement(priorElements, index),
+					proposed: diffElement(proposedElements, index),
+				})
+			}
+		}
+
+		lines = append(lines, diffLine{indent: indent, action: action, text: prefix + "["})
+
+		for _, element := range elements {
+			if !d.showUnchanged && diffActionOf(element.prior, element.proposed) == diffActionNoOp {
+				hidden++
+
+				continue
+			}
+
+			if hidden > 0 {
+				lines = append(lines, diffHiddenLine(indent+1, hidden, "element"))
+				hidden = 0
+			}
+
+			elementLines, err := d.nestedObject(indent+1, element.path, "", ",", s, element.prior, element.proposed)
+
+			if err != nil {
+				return nil, err
+			}
+
+			lines = append(lines, elementLines...)
+		}
+
+		if hidden > 0 {
+			lines = append(lines, diffHiddenLine(indent+1, hidden, "element"))
+		}
+
+		lines = append(lines, diffLine{indent: indent, action: diffActionNoOp, text: "]" + closing})
+	case SchemaObjectNestingModeMap:
+		priorElements, proposedElements, keys, err := diffMapValues(path, prior, proposed)
+
+		if err != nil {
+			return nil, err
+		}
+
+		visible := make([]string, 0, len(keys))
+		width := 0
+
+		for _, key := range keys {
+			if !d.showUnchanged && diffActionOf(priorElements[key], proposedElements[key]) == diffActionNoOp {
+				hidden++
+
+				continue
+			}
+
+			visible = append(visible, key)
+			width = max(width, len(strconv.Quote(key)))
+		}
+
+		lines = append(lines, diffLine{indent: indent, action: action, text: prefix + "{"})
+
+		for _, key := range visible {
+			elementLines, err := d.nestedObject(indent+1, path.WithElementKeyString(key), diffPrefix(strconv.Quote(key), width), "", s, priorElements[key], proposedElements[key])
+
+			if err != nil {
+				return nil, err
+			}
+
+			lines = append(lines, elementLines...)
+		}
+
+		if hidden > 0 {
+			lines = append(lines, diffHiddenLine(indent+1, hidden, "element"))
+		}
+
+		lines = append(lines, diffLine{indent: indent, action: diffActionNoOp, text: "}" + closing})
+	default:
+		return nil, path.NewErrorf("invalid nesting mode %s", s.Nesting)
+	}
+
+	return lines, nil
+}
+
+// nestedObject returns the lines of a single object of the nested attribute
+// type, starting with prefix and ending with suffix.
+func (d differ) nestedObject(indent int, path *tftypes.AttributePath, prefix, suffix string, s *SchemaObject, prior, proposed tftypes.Value) ([]diffLine, error) {
+	action := diffActionOf(prior, proposed)
+
+	if !prior.IsKnown() || !proposed.IsKnown() {
+		return []diffLine{{indent: indent, action: action, text: prefix + "(known after apply)" + suffix}}, nil
+	}
+
+	if prior.IsNull() && proposed.IsNull() {
+		return []diffLine{{indent: indent, action: action, text: prefix + "null" + suffix}}, nil
+	}
+
+	priorValues, err := diffObjectValues(path, prior)
+
+	if err != nil {
+		return nil, err
+	}
+
+	proposedValues, err := diffObjectValues(path, proposed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := d.attributes(indent+1, path, s.Attributes, priorValues, proposedValues)
+
+	if err != nil {
+		return nil, err
+	}
+
+	closing := ""
+
+	if action == diffActionDelete && prefix != "" {
+		closing = " -> null"
+	}
+
+	lines := make([]diffLine, 0, len(body)+2)
+	lines = append(lines, diffLine{indent: indent, action: action, text: prefix + "{"})
+	lines = append(lines, body...)
+	lines = append(lines, diffLine{indent: indent, action: diffActionNoOp, text: "}" + closing + suffix})
+
+	return lines, nil
+}
+
+// nestedBlock returns the lines of the nested block and the number of its
+// hidden unchanged blocks.
+func (d differ) nestedBlock(indent int, path *tftypes.AttributePath, s *SchemaNestedBlock, prior, proposed tftypes.Value) ([]diffLine, int, error) {
+	header := s.TypeName + " "
+
+	action := diffActionOf(prior, proposed)
+	single := s.Nesting == SchemaNestedBlockNestingModeSingle || s.Nesting == SchemaNestedBlockNestingModeGroup
+
+	// Blocks which are not set are not shown or counted.
+	if action == diffActionNoOp && prior.IsNull() {
+		return nil, 0, nil
+	}
+
+	// Unchanged lists, sets, and maps of blocks are hidden element by
+	// element below, so each unchanged block is counted.
+	if !d.showUnchanged && action == diffActionNoOp && (single || !prior.IsKnown()) {
+		return nil, 1, nil
+	}
+
+	if single {
+		lines, err := d.blockObject(indent, path, header, s.Block, prior, proposed)
+
+		return lines, 0, err
+	}
+
+	if !prior.IsKnown() || !proposed.IsKnown() {
+		return []diffLine{{indent: indent, action: action, text: header + "(known after apply)"}}, 0, nil
+	}
+
+	var lines []diffLine
+	hidden := 0
+
+	switch s.Nesting {
+	case SchemaNestedBlockNestingModeList:
+		priorElements, proposedElements, err := diffListValues(path, prior, proposed)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for index := 0; index < max(len(priorElements), len(proposedElements)); index++ {
+			priorElement := diffElement(priorElements, index)
+			proposedElement := diffElement(proposedElements, index)
+
+			if !d.showUnchanged && diffActionOf(priorElement, proposedElement) == diffActionNoOp {
+				hidden++
+
+				continue
+			}
+
+			elementLines, err := d.blockObject(indent, path.WithElementKeyInt(index), header, s.Block, priorElement, proposedElement)
+
+			if err != nil {
+				return nil, 0, err
+			}
+
+			lines = append(lines, elementLines...)
+		}
+	case SchemaNestedBlockNestingModeSet:
+		priorElements, proposedElements, err := diffListValues(path, prior, proposed)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, pair := range diffSetElements(priorElements, proposedElements) {
+			if !d.showUnchanged && diffActionOf(pair.prior, pair.proposed) == diffActionNoOp {
+				hidden++
+
+				continue
+			}
+
+			elementLines, err := d.blockObject(indent, path.WithElementKeyValue(pair.value()), header, s.Block, pair.prior, pair.proposed)
+
+			if err != nil {
+				return nil, 0, err
+			}
+
+			lines = append(lines, elementLines...)
+		}
+	case SchemaNestedBlockNestingModeMap:
+		priorElements, proposedElements, keys, err := diffMapValues(path, prior, proposed)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, key := range keys {
+			if !d.showUnchanged && diffActionOf(priorElements[key], proposedElements[key]) == diffActionNoOp {
+				hidden++
+
+				continue
+			}
+
+			elementLines, err := d.blockObject(indent, path.WithElementKeyString(key), header+strconv.Quote(key)+" ", s.Block, priorElements[key], proposedElements[key])
+
+			if err != nil {
+				return nil, 0, err
+			}
+
+			lines = append(lines, elementLines...)
+		}
+	default:
+		return nil, 0, path.NewErrorf("invalid nesting mode %s", s.Nesting)
+	}
+
+	return lines, hidden, nil
+}
+
+// value returns the lines of the diff of an attribute or element value,
+// starting with prefix, such as the attribute name, and ending with suffix,
+// such as the comma after list elements.
+func (d differ) value(indent int, path *tftypes.AttributePath, prefix, suffix string, prior, proposed tftypes.Value) ([]diffLine, error) {
+	action := diffActionOf(prior, proposed)
+
+	switch action {
+	case diffActionNoOp, diffActionCreate:
+		return d.whole(indent, path, action, prefix, suffix, "", proposed)
+	case diffActionDelete:
+		closing := ""
+
+		// Attributes and map elements are deleted by setting them to
+		// null, while list and set elements are removed.
+		if prefix != "" {
+			closing = " -> null"
+		}
+
+		return d.whole(indent, path, action, prefix, suffix, closing, prior)
+	}
+
+	priorText, priorInline, err := diffInline(path, prior)
+
+	if err != nil {
+		return nil, err
+	}
+
+	proposedText, proposedInline, err := diffInline(path, proposed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if priorInline && proposedInline {
+		return []diffLine{{indent: indent, action: action, text: prefix + priorText + " -> " + proposedText + suffix}}, nil
+	}
+
+	if prior.IsKnown() && !proposed.IsKnown() {
+		lines, err := d.whole(indent, path, diffActionDelete, prefix, suffix, " -> (known after apply)", prior)
+
+		if err != nil {
+			return nil, err
+		}
+
+		lines[0].action = diffActionUpdate
+
+		return lines, nil
+	}
+
+	// Values of different types, such as dynamically-typed attributes,
+	// are replaced rather than updated.
+	if !prior.IsKnown() || !prior.Type().Equal(proposed.Type()) {
+		deleted, err := d.whole(indent, path, diffActionDelete, prefix, suffix, "", prior)
+
+		if err != nil {
+			return nil, err
+		}
+
+		created, err := d.whole(indent, path, diffActionCreate, prefix, suffix, "", proposed)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return append(deleted, created...), nil
+	}
+
+	switch typ := proposed.Type().(type) {
+	case tftypes.List:
+		return d.list(indent, path, prefix, suffix, prior, proposed)
+	case tftypes.Tuple:
+		return d.tuple(indent, path, prefix, suffix, prior, proposed)
+	case tftypes.Set:
+		return d.set(indent, path, prefix, suffix, prior, proposed)
+	case tftypes.Map:
+		return d.mapValue(indent, path, prefix, suffix, prior, proposed)
+	case tftypes.Object:
+		return d.object(indent, path, prefix, suffix, prior, proposed)
+	default:
+		return nil, path.NewErrorf("unsupported type %s", typ)
+	}
+}
+
+// whole returns the lines of a value which is entirely created, deleted, or
+// unchanged, with all its elements marked with the same action. The closing
+// text is appended to the value, before the suffix.
+func (d differ) whole(indent int, path *tftypes.AttributePath, action diffAction, prefix, suffix, closing string, value tftypes.Value) ([]diffLine, error) {
+	text, inline, err := diffInline(path, value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if inline {
+		return []diffLine{{indent: indent, action: action, text: prefix + text + closing + suffix}}, nil
+	}
+
+	var lines []diffLine
+
+	switch value.Type().(type) {
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		lines = append(lines, diffLine{indent: indent, action: action, text: prefix + "["})
+
+		for index, element := range elements {
+			elementPath := path.WithElementKeyInt(index)
+
+			if value.Type().Is(tftypes.Set{}) {
+				elementPath = path.WithElementKeyValue(element)
+			}
+
+			elementLines, err := d.whole(indent+1, elementPath, action, "", ",", "", element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			lines = append(lines, elementLines...)
+		}
+
+		lines = append(lines, diffLine{indent: indent, action: diffActionNoOp, text: "]" + closing + suffix})
+	default:
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		isObject := value.Type().Is(tftypes.Object{})
+		names := make([]string, 0, len(elements))
+		width := 0
+
+		for key, element := range elements {
+			// Null attributes of created and deleted objects are
+			// omitted, as they are not set.
+			if isObject && action != diffActionNoOp && element.IsNull() {
+				continue
+			}
+
+			name := key
+
+			if !isObject {
+				name = strconv.Quote(key)
+			}
+
+			names = append(names, key)
+			width = max(width, len(name))
+		}
+
+		sort.Strings(names)
+
+		lines = append(lines, diffLine{indent: indent, action: action, text: prefix + "{"})
+
+		for _, key := range names {
+			name := key
+			elementPath := path.WithAttributeName(key)
+
+			if !isObject {
+				name = strconv.Quote(key)
+				elementPath = path.WithElementKeyString(key)
+			}
+
+			elementLines, err := d.whole(indent+1, elementPath, action, diffPrefix(name, width), "", "", elements[key])
+
+			if err != nil {
+				return nil, err
+			}
+
+			lines = append(lines, elementLines...)
+		}
+
+		lines = append(lines, diffLine{indent: indent, action: diffActionNoOp, text: "}" + closing + suffix})
+	}
+
+	return lines, nil
+}
+
+// list returns the lines of an updated list, matching unchanged elements
+// with a longest common subsequence, so inserted and removed elements are
+// shown as such rather than updating every following element.
+func (d differ) list(indent int, path *tftypes.AttributePath, prefix, suffix string, prior, proposed tftypes.Value) ([]diffLine, error) {
+	priorElements, proposedElements, err := diffListValues(path, prior, proposed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	elements := diffListElements(priorElements, proposedElements)
+
+	for index, element := range elements {
+		elements[index].path = path.WithElementKeyInt(element.index)
+	}
+
+	return d.elements(indent, prefix, suffix, elements)
+}
+
+// tuple returns the lines of an updated tuple, comparing elements by
+// position.
+func (d differ) tuple(indent int, path *tftypes.AttributePath, prefix, suffix string, prior, proposed tftypes.Value) ([]diffLine, error) {
+	priorElements, proposedElements, err := diffListValues(path, prior, proposed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	elements := make([]diffElementPair, 0, max(len(priorElements), len(proposedElements)))
+
+	for index := 0; index < max(len(priorElements), len(proposedElements)); index++ {
+		elements = append(elements, diffElementPair{
+			path:     path.WithElementKeyInt(index),
+			prior:    diffElement(priorElements, index),
+			proposed: diffElement(proposedElements, index),
+		})
+	}
+
+	return d.elements(indent, prefix, suffix, elements)
+}
+
+// set returns the lines of an updated set, with the removed elements
+// followed by the added elements.
+func (d differ) set(indent int, path *tftypes.AttributePath, prefix, suffix string, prior, proposed tftypes.Value) ([]diffLine, error) {
+	priorElements, proposedElements, err := diffListValues(path, prior, proposed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	elements := diffSetElements(priorElements, proposedElements)
+
+	for index, element := range elements {
+		elements[index].path = path.WithElementKeyValue(element.value())
+	}
+
+	return d.elements(indent, prefix, suffix, elements)
+}
+
+// elements returns the lines of an updated list, set, or tuple. Runs of
+// hidden unchanged elements are counted where they occur, to show where
+// the changed elements are.
+func (d differ) elements(indent int, prefix, suffix string, elements []diffElementPair) ([]diffLine, error) {
+	lines := []diffLine{{indent: indent, action: diffActionUpdate, text: prefix + "["}}
+	hidden := 0
+
+	for _, element := range elements {
+		if !d.showUnchanged && diffActionOf(element.prior, element.proposed) == diffActionNoOp {
+			hidden++
+
+			continue
+		}
+
+		if hidden > 0 {
+			lines = append(lines, diffHiddenLine(indent+1, hidden, "element"))
+			hidden = 0
+		}
+
+		elementLines, err := d.value(indent+1, element.path, "", ",", element.prior, element.proposed)
+
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, elementLines...)
+	}
+
+	if hidden > 0 {
+		lines = append(lines, diffHiddenLine(indent+1, hidden, "element"))
+	}
+
+	return append(lines, diffLine{indent: indent, action: diffActionNoOp, text: "]" + suffix}), nil
+}
+
+// mapValue returns the lines of an updated map.
+func (d differ) mapValue(indent int, path *tftypes.AttributePath, prefix, suffix string, prior, proposed tftypes.Value) ([]diffLine, error) {
+	priorElements, proposedElements, keys, err := diffMapValues(path, prior, proposed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return d.keys(indent, path, prefix, suffix, "element", keys, priorElements, proposedElements)
+}
+
+// object returns the lines of an updated object.
+func (d differ) object(indent int, path *tftypes.AttributePath, prefix, suffix string, prior, proposed tftypes.Value) ([]diffLine, error) {
+	priorAttributes, proposedAttributes, names, err := diffMapValues(path, prior, proposed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return d.keys(indent, path, prefix, suffix, "attribute", names, priorAttributes, proposedAttributes)
+}
+
+// keys returns the lines of an updated map or object, with the keys of maps
+// quoted.
+func (d differ) keys(indent int, path *tftypes.AttributePath, prefix, suffix, noun string, keys []string, prior, proposed map[string]tftypes.Value) ([]diffLine, error) {
+	visible := make([]string, 0, len(keys))
+	width := 0
+	hidden := 0
+
+	for _, key := range keys {
+		if !d.showUnchanged && diffActionOf(prior[key], proposed[key]) == diffActionNoOp {
+			if !prior[key].IsNull() {
+				hidden++
+			}
+
+			continue
+		}
+
+		visible = append(visible, key)
+		width = max(width, len(diffKeyName(noun, key)))
+	}
+
+	lines := []diffLine{{indent: indent, action: diffActionUpdate, text: prefix + "{"}}
+
+	for _, key := range visible {
+		elementPath := path.WithAttributeName(key)
+
+		if noun == "element" {
+			elementPath = path.WithElementKeyString(key)
+		}
+
+		elementLines, err := d.value(indent+1, elementPath, diffPrefix(diffKeyName(noun, key), width), "", prior[key], proposed[key])
+
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, elementLines...)
+	}
+
+	if hidden > 0 {
+		lines = append(lines, diffHiddenLine(indent+1, hidden, noun))
+	}
+
+	return append(lines, diffLine{indent: indent, action: diffActionNoOp, text: "}" + suffix}), nil
+}
+
+// diffKeyName returns how the key is shown, which is quoted for map
+// elements.
+func diffKeyName(noun, key string) string {
+	if noun == "element" {
+		return strconv.Quote(key)
+	}
+
+	return key
+}
+
+// diffInline returns the text of a value which is shown on a single line,
+// which are primitives, null and unknown values, and empty collections. It
+// returns false for other values.
+func diffInline(path *tftypes.AttributePath, value tftypes.Value) (string, bool, error) {
+	if !value.IsKnown() {
+		return "(known after apply)", true, nil
+	}
+
+	if value.IsNull() {
+		return "null", true, nil
+	}
+
+	switch typ := value.Type(); {
+	case typ.Is(tftypes.String):
+		var s string
+
+		if err := value.As(&s); err != nil {
+			return "", false, path.NewError(err)
+		}
+
+		return strconv.Quote(s), true, nil
+	case typ.Is(tftypes.Number):
+		var n big.Float
+
+		if err := value.As(&n); err != nil {
+			return "", false, path.NewError(err)
+		}
+
+		return n.Text('f', -1), true, nil
+	case typ.Is(tftypes.Bool):
+		var b bool
+
+		if err := value.As(&b); err != nil {
+			return "", false, path.NewError(err)
+		}
+
+		return strconv.FormatBool(b), true, nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return "", false, path.NewError(err)
+		}
+
+		return "[]", len(elements) == 0, nil
+	default:
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return "", false, path.NewError(err)
+		}
+
+		return "{}", len(elements) == 0, nil
+	}
+}
+
+// diffObjectValues returns the attributes of a known object, which are empty
+// if it is null.
+func diffObjectValues(path *tftypes.AttributePath, value tftypes.Value) (map[string]tftypes.Value, error) {
+	var values map[string]tftypes.Value
+
+	if value.IsNull() {
+		return values, nil
+	}
+
+	if err := value.As(&values); err != nil {
+		return nil, path.NewError(err)
+	}
+
+	return values, nil
+}
+
+// diffListValues returns the elements of the known lists, sets, or tuples,
+// which are empty if they are null.
+func diffListValues(path *tftypes.AttributePath, prior, proposed tftypes.Value) ([]tftypes.Value, []tftypes.Value, error) {
+	var priorElements, proposedElements []tftypes.Value
+
+	if !prior.IsNull() {
+		if err := prior.As(&priorElements); err != nil {
+			return nil, nil, path.NewError(err)
+		}
+	}
+
+	if !proposed.IsNull() {
+		if err := proposed.As(&proposedElements); err != nil {
+			return nil, nil, path.NewError(err)
+		}
+	}
+
+	return priorElements, proposedElements, nil
+}
+
+// diffMapValues returns the elements of the known maps or objects, which are
+// empty if they are null, and all their keys, sorted.
+func diffMapValues(path *tftypes.AttributePath, prior, proposed tftypes.Value) (map[string]tftypes.Value, map[string]tftypes.Value, []string, error) {
+	priorElements, err := diffObjectValues(path, prior)
+
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	proposedElements, err := diffObjectValues(path, proposed)
+
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	keys := make([]string, 0, len(priorElements)+len(proposedElements))
+
+	for key := range priorElements {
+		keys = append(keys, key)
+	}
+
+	for key := range proposedElements {
+		if _, ok := priorElements[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return priorElements, proposedElements, keys, nil
+}
+
+// diffElement returns the element at index, or a missing value if there is
+// no such element.
+func diffElement(elements []tftypes.Value, index int) tftypes.Value {
+	if index < len(elements) {
+		return elements[index]
+	}
+
+	return tftypes.Value{}
+}
+
+// diffElementPair is an element of a list, set, or tuple, which is missing
+// from prior if it was added and from proposed if it was removed.
+type diffElementPair struct {
+	path     *tftypes.AttributePath
+	index    int
+	prior    tftypes.Value
+	proposed tftypes.Value
+}
+
+// value returns the proposed element, or the prior element if it was
+// removed.
+func (p diffElementPair) value() tftypes.Value {
+	if p.proposed.IsNull() {
+		return p.prior
+	}
+
+	return p.proposed
+}
+
+// diffListElements pairs the unchanged elements of the lists, which are a
+// longest common subsequence of them, and pairs the other elements with
+// missing values, in the order of the lists. The index of each pair is the
+// index of the element in the proposed list, or the prior list if it was
+// removed.
+func diffListElements(prior, proposed []tftypes.Value) []diffElementPair {
+	// lengths[i][j] is the length of the longest common subsequence of
+	// prior[i:] and proposed[j:].
+	lengths := make([][]int, len(prior)+1)
+
+	for i := range lengths {
+		lengths[i] = make([]int, len(proposed)+1)
+	}
+
+	for i := len(prior) - 1; i >= 0; i-- {
+		for j := len(proposed) - 1; j >= 0; j-- {
+			if prior[i].Equal(proposed[j]) {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	pairs := make([]diffElementPair, 0, max(len(prior), len(proposed)))
+	i, j := 0, 0
+
+	for i < len(prior) || j < len(proposed) {
+		switch {
+		case i < len(prior) && j < len(proposed) && prior[i].Equal(proposed[j]):
+			pairs = append(pairs, diffElementPair{index: j, prior: prior[i], proposed: proposed[j]})
+			i++
+			j++
+		case i < len(prior) && (j == len(proposed) || lengths[i+1][j] >= lengths[i][j+1]):
+			pairs = append(pairs, diffElementPair{index: i, prior: prior[i]})
+			i++
+		default:
+			pairs = append(pairs, diffElementPair{index: j, proposed: proposed[j]})
+			j++
+		}
+	}
+
+	return pairs
+}
+
+// diffSetElements pairs the elements of the sets which are in both, in the
+// order of the proposed set, followed by the removed and then the added
+// elements paired with missing values.
+func diffSetElements(prior, proposed []tftypes.Value) []diffElementPair {
+	pairs := make([]diffElementPair, 0, max(len(prior), len(proposed)))
+	var removed, added []diffElementPair
+
+	for _, element := range prior {
+		if !diffContains(proposed, element) {
+			removed = append(removed, diffElementPair{prior: element})
+		}
+	}
+
+	for _, element := range proposed {
+		if diffContains(prior, element) {
+			pairs = append(pairs, diffElementPair{prior: element, proposed: element})
+		} else {
+			added = append(added, diffElementPair{proposed: element})
+		}
+	}
+
+	pairs = append(pairs, removed...)
+
+	return append(pairs, added...)
+}
+
+func diffContains(elements []tftypes.Value, value tftypes.Value) bool {
+	for _, element := range elements {
+		if element.Equal(value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testSchemaEqualSchema() *tfprotov6.Schema {
+	return &tfprotov6.Schema{
+		Version: 1,
+		Block: &tfprotov6.SchemaBlock{
+			Description: "test description",
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:     "test_attribute",
+					Type:     tftypes.List{ElementType: tftypes.String},
+					Optional: true,
+				},
+				{
+					Name:     "test_nested_type",
+					Computed: true,
+					NestedType: &tfprotov6.SchemaObject{
+						Nesting: tfprotov6.SchemaObjectNestingModeSet,
+						Attributes: []*tfprotov6.SchemaAttribute{
+							{
+								Name:     "test_object_attribute",
+								Type:     tftypes.Bool,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+			BlockTypes: []*tfprotov6.SchemaNestedBlock{
+				{
+					TypeName: "test_block",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+					MaxItems: 1,
+					Block: &tfprotov6.SchemaBlock{
+						Attributes: []*tfprotov6.SchemaAttribute{
+							{
+								Name:     "test_nested_attribute",
+								Type:     tftypes.Number,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSchemaEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   *tfprotov6.Schema
+		other    *tfprotov6.Schema
+		expected bool
+	}{
+		"nil": {
+			schema:   nil,
+			other:    nil,
+			expected: true,
+		},
+		"nil-other": {
+			schema:   testSchemaEqualSchema(),
+			other:    nil,
+			expected: false,
+		},
+		"nil-schema": {
+			schema:   nil,
+			other:    testSchemaEqualSchema(),
+			expected: false,
+		},
+		"equal": {
+			schema:   testSchemaEqualSchema(),
+			other:    testSchemaEqualSchema(),
+			expected: true,
+		},
+		"version": {
+			schema: testSchemaEqualSchema(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Version = 2
+				return s
+			}(),
+			expected: false,
+		},
+		"block-description": {
+			schema: testSchemaEqualSchema(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Description = "other description"
+				return s
+			}(),
+			expected: false,
+		},
+		"attribute-type": {
+			schema: testSchemaEqualSchema(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes[0].Type = tftypes.List{ElementType: tftypes.Number}
+				return s
+			}(),
+			expected: false,
+		},
+		"attribute-type-nil": {
+			schema: testSchemaEqualSchema(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes[0].Type = nil
+				return s
+			}(),
+			expected: false,
+		},
+		"attribute-flags": {
+			schema: testSchemaEqualSchema(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes[0].Computed = true
+				return s
+			}(),
+			expected: false,
+		},
+		"attribute-default": {
+			schema: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes[0].Default = pointer(tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}))
+				return s
+			}(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes[0].Default = pointer(tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}))
+				return s
+			}(),
+			expected: true,
+		},
+		"attribute-default-value": {
+			schema: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes[0].Default = pointer(tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}))
+				return s
+			}(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes[0].Default = pointer(tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "test"),
+				}))
+				return s
+			}(),
+			expected: false,
+		},
+		"attribute-default-nil": {
+			schema: testSchemaEqualSchema(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes[0].Default = pointer(tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}))
+				return s
+			}(),
+			expected: false,
+		},
+		"attribute-nil": {
+			schema: testSchemaEqualSchema(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes[0] = nil
+				return s
+			}(),
+			expected: false,
+		},
+		"attributes-length": {
+			schema: testSchemaEqualSchema(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes = nil
+				return s
+			}(),
+			expected: false,
+		},
+		"nested-type-nil": {
+			schema: testSchemaEqualSchema(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes[1].NestedType = nil
+				return s
+			}(),
+			expected: false,
+		},
+		"nested-type-nesting": {
+			schema: testSchemaEqualSchema(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes[1].NestedType.Nesting = tfprotov6.SchemaObjectNestingModeList
+				return s
+			}(),
+			expected: false,
+		},
+		"nested-type-attribute": {
+			schema: testSchemaEqualSchema(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.Attributes[1].NestedType.Attributes[0].Sensitive = true
+				return s
+			}(),
+			expected: false,
+		},
+		"nested-block-items": {
+			schema: testSchemaEqualSchema(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.BlockTypes[0].MaxItems = 2
+				return s
+			}(),
+			expected: false,
+		},
+		"nested-block-attribute": {
+			schema: testSchemaEqualSchema(),
+			other: func() *tfprotov6.Schema {
+				s := testSchemaEqualSchema()
+				s.Block.BlockTypes[0].Block.Attributes[0].Name = "other"
+				return s
+			}(),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
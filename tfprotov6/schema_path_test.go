@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	testSchemaPathStringAttribute = &tfprotov6.SchemaAttribute{
+		Name:     "string_attribute",
+		Type:     tftypes.String,
+		Optional: true,
+	}
+	testSchemaPathListAttribute = &tfprotov6.SchemaAttribute{
+		Name:     "list_attribute",
+		Type:     tftypes.List{ElementType: tftypes.String},
+		Optional: true,
+	}
+	testSchemaPathNestedInnerAttribute = &tfprotov6.SchemaAttribute{
+		Name:      "inner",
+		Type:      tftypes.String,
+		Optional:  true,
+		Sensitive: true,
+	}
+	testSchemaPathNestedListAttribute = &tfprotov6.SchemaAttribute{
+		Name:     "nested_list",
+		Optional: true,
+		NestedType: &tfprotov6.SchemaObject{
+			Nesting:    tfprotov6.SchemaObjectNestingModeList,
+			Attributes: []*tfprotov6.SchemaAttribute{testSchemaPathNestedInnerAttribute},
+		},
+	}
+	testSchemaPathNestedSingleAttribute = &tfprotov6.SchemaAttribute{
+		Name:     "nested_single",
+		Optional: true,
+		NestedType: &tfprotov6.SchemaObject{
+			Nesting:    tfprotov6.SchemaObjectNestingModeSingle,
+			Attributes: []*tfprotov6.SchemaAttribute{testSchemaPathNestedInnerAttribute},
+		},
+	}
+	testSchemaPathBlockAttribute = &tfprotov6.SchemaAttribute{
+		Name:       "block_attribute",
+		Type:       tftypes.String,
+		Optional:   true,
+		Deprecated: true,
+	}
+	testSchemaPathSingleBlock = &tfprotov6.SchemaNestedBlock{
+		TypeName: "single_block",
+		Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{testSchemaPathBlockAttribute},
+		},
+	}
+	testSchemaPathListBlock = &tfprotov6.SchemaNestedBlock{
+		TypeName: "list_block",
+		Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{testSchemaPathBlockAttribute},
+			BlockTypes: []*tfprotov6.SchemaNestedBlock{testSchemaPathSingleBlock},
+		},
+	}
+	testSchemaPathSetBlock = &tfprotov6.SchemaNestedBlock{
+		TypeName: "set_block",
+		Nesting:  tfprotov6.SchemaNestedBlockNestingModeSet,
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{testSchemaPathBlockAttribute},
+		},
+	}
+	testSchemaPathMapBlock = &tfprotov6.SchemaNestedBlock{
+		TypeName: "map_block",
+		Nesting:  tfprotov6.SchemaNestedBlockNestingModeMap,
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{testSchemaPathBlockAttribute},
+		},
+	}
+	testSchemaPathSchema = &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				testSchemaPathStringAttribute,
+				testSchemaPathListAttribute,
+				testSchemaPathNestedListAttribute,
+				testSchemaPathNestedSingleAttribute,
+			},
+			BlockTypes: []*tfprotov6.SchemaNestedBlock{
+				testSchemaPathListBlock,
+				testSchemaPathSetBlock,
+				testSchemaPathMapBlock,
+			},
+		},
+	}
+)
+
+func TestSchemaAttributeAtPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema        *tfprotov6.Schema
+		path          *tftypes.AttributePath
+		expected      *tfprotov6.SchemaAttribute
+		expectedErr   error
+		expectedError string
+	}{
+		"nil-schema": {
+			schema:        nil,
+			path:          tftypes.NewAttributePath().WithAttributeName("string_attribute"),
+			expectedErr:   tfprotov6.ErrPathNotFound,
+			expectedError: "path not found in schema: schema is nil",
+		},
+		"empty-path": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath(),
+			expectedErr:   tfprotov6.ErrPathNotFound,
+			expectedError: "path not found in schema: path is empty",
+		},
+		"attribute": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("string_attribute"),
+			expected: testSchemaPathStringAttribute,
+		},
+		"attribute-not-found": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("missing"),
+			expectedErr:   tfprotov6.ErrPathNotFound,
+			expectedError: `AttributeName("missing"): path not found in schema: no attribute or block named "missing"`,
+		},
+		"attribute-element": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("list_attribute").WithElementKeyInt(0),
+			expectedErr:   tfprotov6.ErrPathInsideAttribute,
+			expectedError: `AttributeName("list_attribute").ElementKeyInt(0): path leads inside attribute`,
+		},
+		"invalid-first-step": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithElementKeyInt(0),
+			expectedErr:   tfprotov6.ErrPathNotFound,
+			expectedError: `ElementKeyInt(0): path not found in schema: expected attribute name step`,
+		},
+		"nested-attribute-list": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("nested_list").WithElementKeyInt(1).WithAttributeName("inner"),
+			expected: testSchemaPathNestedInnerAttribute,
+		},
+		"nested-attribute-list-element": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("nested_list").WithElementKeyInt(1),
+			expected: testSchemaPathNestedListAttribute,
+		},
+		"nested-attribute-list-invalid-element": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("nested_list").WithElementKeyString("key"),
+			expectedErr:   tfprotov6.ErrPathNotFound,
+			expectedError: `AttributeName("nested_list").ElementKeyString("key"): path not found in schema: invalid element step for LIST nesting mode`,
+		},
+		"nested-attribute-single": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("nested_single").WithAttributeName("inner"),
+			expected: testSchemaPathNestedInnerAttribute,
+		},
+		"nested-attribute-not-found": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("nested_single").WithAttributeName("missing"),
+			expectedErr:   tfprotov6.ErrPathNotFound,
+			expectedError: `AttributeName("nested_single").AttributeName("missing"): path not found in schema: no attribute named "missing"`,
+		},
+		"nested-attribute-inside": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("nested_single").WithAttributeName("inner").WithElementKeyInt(0),
+			expectedErr:   tfprotov6.ErrPathInsideAttribute,
+			expectedError: `AttributeName("nested_single").AttributeName("inner").ElementKeyInt(0): path leads inside attribute`,
+		},
+		"list-block-attribute": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("list_block").WithElementKeyInt(0).WithAttributeName("block_attribute"),
+			expected: testSchemaPathBlockAttribute,
+		},
+		"list-block-single-block-attribute": {
+			schema: testSchemaPathSchema,
+			path: tftypes.NewAttributePath().
+				WithAttributeName("list_block").
+				WithElementKeyInt(0).
+				WithAttributeName("single_block").
+				WithAttributeName("block_attribute"),
+			expected: testSchemaPathBlockAttribute,
+		},
+		"list-block-missing-element": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("list_block").WithAttributeName("block_attribute"),
+			expectedErr:   tfprotov6.ErrPathNotFound,
+			expectedError: `AttributeName("list_block").AttributeName("block_attribute"): path not found in schema: invalid element step for LIST nesting mode`,
+		},
+		"set-block-attribute": {
+			schema: testSchemaPathSchema,
+			path: tftypes.NewAttributePath().
+				WithAttributeName("set_block").
+				WithElementKeyValue(tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})).
+				WithAttributeName("block_attribute"),
+			expected: testSchemaPathBlockAttribute,
+		},
+		"map-block-attribute": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("map_block").WithElementKeyString("key").WithAttributeName("block_attribute"),
+			expected: testSchemaPathBlockAttribute,
+		},
+		"block": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("list_block"),
+			expectedErr:   tfprotov6.ErrPathNotFound,
+			expectedError: `AttributeName("list_block"): path not found in schema: path is a block, not an attribute`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.schema.AttributeAtPath(testCase.path)
+
+			if testCase.expectedErr != nil {
+				if !errors.Is(err, testCase.expectedErr) {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedErr, err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Errorf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("unexpected attribute: %s", cmp.Diff(got, testCase.expected))
+			}
+		})
+	}
+}
+
+func TestSchemaBlockAtPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema        *tfprotov6.Schema
+		path          *tftypes.AttributePath
+		expected      *tfprotov6.SchemaNestedBlock
+		expectedErr   error
+		expectedError string
+	}{
+		"nil-schema": {
+			schema:        nil,
+			path:          tftypes.NewAttributePath().WithAttributeName("list_block"),
+			expectedErr:   tfprotov6.ErrPathNotFound,
+			expectedError: "path not found in schema: schema is nil",
+		},
+		"block": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("list_block"),
+			expected: testSchemaPathListBlock,
+		},
+		"block-element": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("list_block").WithElementKeyInt(2),
+			expected: testSchemaPathListBlock,
+		},
+		"block-nested": {
+			schema:   testSchemaPathSchema,
+			path:     tftypes.NewAttributePath().WithAttributeName("list_block").WithElementKeyInt(0).WithAttributeName("single_block"),
+			expected: testSchemaPathSingleBlock,
+		},
+		"block-not-found": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("list_block").WithElementKeyInt(0).WithAttributeName("missing"),
+			expectedErr:   tfprotov6.ErrPathNotFound,
+			expectedError: `AttributeName("list_block").ElementKeyInt(0).AttributeName("missing"): path not found in schema: no attribute or block named "missing"`,
+		},
+		"attribute": {
+			schema:        testSchemaPathSchema,
+			path:          tftypes.NewAttributePath().WithAttributeName("string_attribute"),
+			expectedErr:   tfprotov6.ErrPathNotFound,
+			expectedError: `AttributeName("string_attribute"): path not found in schema: path is an attribute, not a block`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.schema.BlockAtPath(testCase.path)
+
+			if testCase.expectedErr != nil {
+				if !errors.Is(err, testCase.expectedErr) {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedErr, err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Errorf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("unexpected block: %s", cmp.Diff(got, testCase.expected))
+			}
+		})
+	}
+}
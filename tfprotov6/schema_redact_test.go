@@ -0,0 +1,447 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaRedactValue(t *testing.T) {
+	t.Parallel()
+
+	nestedBlock := &tfprotov6.SchemaBlock{
+		Attributes: []*tfprotov6.SchemaAttribute{
+			{
+				Name:     "value",
+				Type:     tftypes.String,
+				Optional: true,
+			},
+			{
+				Name:      "secret",
+				Type:      tftypes.Number,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+	schema := &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:     "string",
+					Type:     tftypes.String,
+					Optional: true,
+				},
+				{
+					Name:      "password",
+					Type:      tftypes.String,
+					Optional:  true,
+					Sensitive: true,
+				},
+				{
+					Name:      "tokens",
+					Type:      tftypes.List{ElementType: tftypes.String},
+					Optional:  true,
+					Sensitive: true,
+				},
+			},
+			BlockTypes: []*tfprotov6.SchemaNestedBlock{
+				{
+					TypeName: "list",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "map",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeMap,
+					Block:    nestedBlock,
+				},
+				{
+					TypeName: "single",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+					Block:    nestedBlock,
+				},
+			},
+		},
+	}
+	schemaType := schema.ValueType().(tftypes.Object) //nolint:forcetypeassert // ValueType always returns an Object
+	nestedType := nestedBlock.ValueType()
+	redactedNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"value":  tftypes.String,
+			"secret": tftypes.String,
+		},
+	}
+	redactedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"string":   tftypes.String,
+			"password": tftypes.String,
+			"tokens":   tftypes.String,
+			"list":     tftypes.List{ElementType: redactedNestedType},
+			"map":      tftypes.Map{ElementType: redactedNestedType},
+			"single":   redactedNestedType,
+		},
+	}
+
+	testValue := func(values map[string]tftypes.Value) tftypes.Value {
+		value := map[string]tftypes.Value{
+			"string":   tftypes.NewValue(tftypes.String, nil),
+			"password": tftypes.NewValue(tftypes.String, nil),
+			"tokens":   tftypes.NewValue(schemaType.AttributeTypes["tokens"], nil),
+			"list":     tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{}),
+			"map":      tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{}),
+			"single":   tftypes.NewValue(nestedType, nil),
+		}
+
+		for name, v := range values {
+			value[name] = v
+		}
+
+		return tftypes.NewValue(schemaType, value)
+	}
+	testRedacted := func(values map[string]tftypes.Value) tftypes.Value {
+		value := map[string]tftypes.Value{
+			"string":   tftypes.NewValue(tftypes.String, nil),
+			"password": tftypes.NewValue(tftypes.String, nil),
+			"tokens":   tftypes.NewValue(tftypes.String, nil),
+			"list":     tftypes.NewValue(redactedType.AttributeTypes["list"], []tftypes.Value{}),
+			"map":      tftypes.NewValue(redactedType.AttributeTypes["map"], map[string]tftypes.Value{}),
+			"single":   tftypes.NewValue(redactedNestedType, nil),
+		}
+
+		for name, v := range values {
+			value[name] = v
+		}
+
+		return tftypes.NewValue(redactedType, value)
+	}
+	testNestedValue := func(value interface{}, secret interface{}) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"value":  tftypes.NewValue(tftypes.String, value),
+			"secret": tftypes.NewValue(tftypes.Number, secret),
+		})
+	}
+	testRedactedNestedValue := func(value interface{}, secret interface{}) tftypes.Value {
+		return tftypes.NewValue(redactedNestedType, map[string]tftypes.Value{
+			"value":  tftypes.NewValue(tftypes.String, value),
+			"secret": tftypes.NewValue(tftypes.String, secret),
+		})
+	}
+
+	testCases := map[string]struct {
+		schema   *tfprotov6.Schema
+		value    tftypes.Value
+		opts     tfprotov6.RedactOpts
+		expected tftypes.Value
+	}{
+		"nil-schema": {
+			schema:   nil,
+			value:    tftypes.NewValue(tftypes.String, "test"),
+			expected: tftypes.NewValue(tftypes.String, "test"),
+		},
+		"null": {
+			schema:   schema,
+			value:    tftypes.NewValue(schemaType, nil),
+			expected: tftypes.NewValue(redactedType, nil),
+		},
+		"unknown": {
+			schema:   schema,
+			value:    tftypes.NewValue(schemaType, tftypes.UnknownValue),
+			expected: tftypes.NewValue(redactedType, tftypes.UnknownValue),
+		},
+		"null-attributes": {
+			schema:   schema,
+			value:    testValue(nil),
+			expected: testRedacted(nil),
+		},
+		"sensitive-attributes": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"string":   tftypes.NewValue(tftypes.String, "test"),
+				"password": tftypes.NewValue(tftypes.String, "hunter2"),
+				"tokens": tftypes.NewValue(schemaType.AttributeTypes["tokens"], []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "token"),
+				}),
+			}),
+			expected: testRedacted(map[string]tftypes.Value{
+				"string":   tftypes.NewValue(tftypes.String, "test"),
+				"password": tftypes.NewValue(tftypes.String, tfprotov6.DefaultRedactPlaceholder),
+				"tokens":   tftypes.NewValue(tftypes.String, tfprotov6.DefaultRedactPlaceholder),
+			}),
+		},
+		"sensitive-attribute-unknown": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"password": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: testRedacted(map[string]tftypes.Value{
+				"password": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"placeholder": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"password": tftypes.NewValue(tftypes.String, "hunter2"),
+			}),
+			opts: tfprotov6.RedactOpts{
+				Placeholder: "***",
+			},
+			expected: testRedacted(map[string]tftypes.Value{
+				"password": tftypes.NewValue(tftypes.String, "***"),
+			}),
+		},
+		"nested-blocks": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], []tftypes.Value{
+					testNestedValue("a", 1),
+					testNestedValue("b", nil),
+				}),
+				"map": tftypes.NewValue(schemaType.AttributeTypes["map"], map[string]tftypes.Value{
+					"key": testNestedValue("c", 2),
+				}),
+				"single": testNestedValue("d", 3),
+			}),
+			expected: testRedacted(map[string]tftypes.Value{
+				"list": tftypes.NewValue(redactedType.AttributeTypes["list"], []tftypes.Value{
+					testRedactedNestedValue("a", tfprotov6.DefaultRedactPlaceholder),
+					testRedactedNestedValue("b", nil),
+				}),
+				"map": tftypes.NewValue(redactedType.AttributeTypes["map"], map[string]tftypes.Value{
+					"key": testRedactedNestedValue("c", tfprotov6.DefaultRedactPlaceholder),
+				}),
+				"single": testRedactedNestedValue("d", tfprotov6.DefaultRedactPlaceholder),
+			}),
+		},
+		"nested-block-unknown": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"list": tftypes.NewValue(schemaType.AttributeTypes["list"], tftypes.UnknownValue),
+			}),
+			expected: testRedacted(map[string]tftypes.Value{
+				"list": tftypes.NewValue(redactedType.AttributeTypes["list"], tftypes.UnknownValue),
+			}),
+		},
+		"truncated": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "abcdef"),
+				"single": testNestedValue("ghijkl", nil),
+			}),
+			opts: tfprotov6.RedactOpts{
+				MaxStringLength: 3,
+			},
+			expected: testRedacted(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "abc..."),
+				"single": testRedactedNestedValue("ghi...", nil),
+			}),
+		},
+		"truncated-default": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, strings.Repeat("a", tfprotov6.DefaultRedactMaxStringLength+1)),
+			}),
+			expected: testRedacted(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, strings.Repeat("a", tfprotov6.DefaultRedactMaxStringLength)+"..."),
+			}),
+		},
+		"truncated-multibyte": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "aéb"),
+			}),
+			opts: tfprotov6.RedactOpts{
+				MaxStringLength: 2,
+			},
+			expected: testRedacted(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "a..."),
+			}),
+		},
+		"not-truncated": {
+			schema: schema,
+			value: testValue(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, strings.Repeat("a", tfprotov6.DefaultRedactMaxStringLength+1)),
+			}),
+			opts: tfprotov6.RedactOpts{
+				MaxStringLength: -1,
+			},
+			expected: testRedacted(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, strings.Repeat("a", tfprotov6.DefaultRedactMaxStringLength+1)),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.schema.RedactValue(testCase.value, testCase.opts)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaRedactDynamicValue(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:     "name",
+					Type:     tftypes.String,
+					Optional: true,
+				},
+				{
+					Name:      "password",
+					Type:      tftypes.String,
+					Optional:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+
+	got, err := schema.RedactDynamicValue(&tfprotov6.DynamicValue{
+		JSON: []byte(`{"name":"test","password":"hunter2"}`),
+	}, tfprotov6.RedactOpts{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := tftypes.NewValue(schema.ValueType(), map[string]tftypes.Value{
+		"name":     tftypes.NewValue(tftypes.String, "test"),
+		"password": tftypes.NewValue(tftypes.String, tfprotov6.DefaultRedactPlaceholder),
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = schema.RedactDynamicValue(&tfprotov6.DynamicValue{
+		JSON: []byte(`{"name":["invalid"]}`),
+	}, tfprotov6.RedactOpts{})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestSchemaRedactValue_NestedAttributes(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name: "nested",
+					NestedType: &tfprotov6.SchemaObject{
+						Attributes: []*tfprotov6.SchemaAttribute{
+							{
+								Name:     "value",
+								Type:     tftypes.String,
+								Optional: true,
+							},
+							{
+								Name:      "secret",
+								Type:      tftypes.Bool,
+								Optional:  true,
+								Sensitive: true,
+							},
+						},
+						Nesting: tfprotov6.SchemaObjectNestingModeList,
+					},
+					Optional: true,
+				},
+				{
+					Name: "sensitive_nested",
+					NestedType: &tfprotov6.SchemaObject{
+						Attributes: []*tfprotov6.SchemaAttribute{
+							{
+								Name:     "value",
+								Type:     tftypes.String,
+								Optional: true,
+							},
+						},
+						Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+					},
+					Optional:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+	schemaType := schema.ValueType().(tftypes.Object)                            //nolint:forcetypeassert // ValueType always returns an Object
+	nestedType := schemaType.AttributeTypes["nested"].(tftypes.List).ElementType //nolint:forcetypeassert // list nesting mode
+	redactedNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"value":  tftypes.String,
+			"secret": tftypes.String,
+		},
+	}
+	redactedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested":           tftypes.List{ElementType: redactedNestedType},
+			"sensitive_nested": tftypes.String,
+		},
+	}
+
+	value := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"nested": tftypes.NewValue(schemaType.AttributeTypes["nested"], []tftypes.Value{
+			tftypes.NewValue(nestedType, map[string]tftypes.Value{
+				"value":  tftypes.NewValue(tftypes.String, "abcdef"),
+				"secret": tftypes.NewValue(tftypes.Bool, true),
+			}),
+			tftypes.NewValue(nestedType, map[string]tftypes.Value{
+				"value":  tftypes.NewValue(tftypes.String, nil),
+				"secret": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			}),
+		}),
+		"sensitive_nested": tftypes.NewValue(schemaType.AttributeTypes["sensitive_nested"], map[string]tftypes.Value{
+			"value": tftypes.NewValue(tftypes.String, "test"),
+		}),
+	})
+
+	got, err := schema.RedactValue(value, tfprotov6.RedactOpts{
+		MaxStringLength: 3,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := tftypes.NewValue(redactedType, map[string]tftypes.Value{
+		"nested": tftypes.NewValue(redactedType.AttributeTypes["nested"], []tftypes.Value{
+			tftypes.NewValue(redactedNestedType, map[string]tftypes.Value{
+				"value":  tftypes.NewValue(tftypes.String, "abc..."),
+				"secret": tftypes.NewValue(tftypes.String, tfprotov6.DefaultRedactPlaceholder),
+			}),
+			tftypes.NewValue(redactedNestedType, map[string]tftypes.Value{
+				"value":  tftypes.NewValue(tftypes.String, nil),
+				"secret": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		}),
+		"sensitive_nested": tftypes.NewValue(tftypes.String, tfprotov6.DefaultRedactPlaceholder),
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
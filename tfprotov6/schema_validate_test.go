@@ -0,0 +1,476 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   *tfprotov6.Schema
+		expected string
+	}{
+		"nil": {
+			schema:   nil,
+			expected: "schema is nil",
+		},
+		"nil-block": {
+			schema:   &tfprotov6.Schema{},
+			expected: "schema block is nil",
+		},
+		"valid": {
+			schema: &tfprotov6.Schema{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "test_attribute",
+							Type:     tftypes.String,
+							Optional: true,
+							Computed: true,
+						},
+					},
+					BlockTypes: []*tfprotov6.SchemaNestedBlock{
+						{
+							TypeName: "test_list",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+							MinItems: 1,
+							MaxItems: 2,
+							Block: &tfprotov6.SchemaBlock{
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{
+										Name:     "test_attribute",
+										Type:     tftypes.Number,
+										Required: true,
+									},
+								},
+							},
+						},
+						{
+							TypeName: "test_single",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+							MinItems: 1,
+							MaxItems: 1,
+							Block:    &tfprotov6.SchemaBlock{},
+						},
+					},
+				},
+			},
+		},
+		"invalid-attributes": {
+			schema: &tfprotov6.Schema{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						nil,
+						{
+							Name:     "Invalid-Name",
+							Type:     tftypes.String,
+							Optional: true,
+						},
+						{
+							Name:     "no_type",
+							Required: true,
+						},
+						{
+							Name: "no_flags",
+							Type: tftypes.String,
+						},
+						{
+							Name:     "required_computed",
+							Type:     tftypes.String,
+							Required: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			expected: "root block: attribute is nil\n" +
+				`attribute "Invalid-Name": invalid name` + "\n" +
+				`attribute "no_type": one of Type or NestedType must be set` + "\n" +
+				`attribute "no_flags": one of Required, Optional, or Computed must be set` + "\n" +
+				`attribute "required_computed": Required cannot be combined with Optional or Computed`,
+		},
+		"invalid-defaults": {
+			schema: &tfprotov6.Schema{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "valid",
+							Type:     tftypes.String,
+							Optional: true,
+							Computed: true,
+							Default:  pointer(tftypes.NewValue(tftypes.String, "test")),
+						},
+						{
+							Name:     "optional",
+							Type:     tftypes.String,
+							Optional: true,
+							Default:  pointer(tftypes.NewValue(tftypes.String, "test")),
+						},
+						{
+							Name:     "wrong_type",
+							Type:     tftypes.String,
+							Optional: true,
+							Computed: true,
+							Default:  pointer(tftypes.NewValue(tftypes.Number, 1)),
+						},
+					},
+				},
+			},
+			expected: `attribute "optional": Default requires Optional and Computed` + "\n" +
+				`attribute "wrong_type": Default is not of the attribute type`,
+		},
+		"invalid-blocks": {
+			schema: &tfprotov6.Schema{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "duplicate",
+							Type:     tftypes.String,
+							Optional: true,
+						},
+					},
+					BlockTypes: []*tfprotov6.SchemaNestedBlock{
+						nil,
+						{
+							TypeName: "duplicate",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+							Block:    &tfprotov6.SchemaBlock{},
+						},
+						{
+							TypeName: "no_block",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeInvalid,
+						},
+						{
+							TypeName: "nested",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeSet,
+							Block: &tfprotov6.SchemaBlock{
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{
+										Name:     "no_type",
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: "root block: nested block is nil\n" +
+				`block "duplicate": duplicate name` + "\n" +
+				`block "no_block": invalid nesting mode INVALID` + "\n" +
+				`block "no_block": block is nil` + "\n" +
+				`attribute "nested.no_type": one of Type or NestedType must be set`,
+		},
+		"invalid-block-items": {
+			schema: &tfprotov6.Schema{
+				Block: &tfprotov6.SchemaBlock{
+					BlockTypes: []*tfprotov6.SchemaNestedBlock{
+						{
+							TypeName: "negative",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+							MinItems: -1,
+							Block:    &tfprotov6.SchemaBlock{},
+						},
+						{
+							TypeName: "list",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+							MinItems: 2,
+							MaxItems: 1,
+							Block:    &tfprotov6.SchemaBlock{},
+						},
+						{
+							TypeName: "single_mismatch",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+							MaxItems: 1,
+							Block:    &tfprotov6.SchemaBlock{},
+						},
+						{
+							TypeName: "single_many",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+							MinItems: 2,
+							MaxItems: 2,
+							Block:    &tfprotov6.SchemaBlock{},
+						},
+						{
+							TypeName: "map",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeMap,
+							MaxItems: 1,
+							Block:    &tfprotov6.SchemaBlock{},
+						},
+						{
+							TypeName: "group",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeGroup,
+							MinItems: 1,
+							Block:    &tfprotov6.SchemaBlock{},
+						},
+					},
+				},
+			},
+			expected: `block "negative": MinItems and MaxItems cannot be negative` + "\n" +
+				`block "list": MinItems cannot be greater than MaxItems in LIST nesting mode` + "\n" +
+				`block "single_mismatch": MinItems and MaxItems must match in SINGLE nesting mode` + "\n" +
+				`block "single_many": MinItems and MaxItems must be 0 or 1 in SINGLE nesting mode` + "\n" +
+				`block "map": MinItems and MaxItems must be 0 in MAP nesting mode` + "\n" +
+				`block "group": MinItems and MaxItems must be 0 in GROUP nesting mode`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.schema.Validate()
+
+			if testCase.expected == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expected)
+			}
+
+			if err.Error() != testCase.expected {
+				t.Errorf("expected error:\n%s\ngot:\n%s", testCase.expected, err)
+			}
+		})
+	}
+}
+
+func TestSchemaAttributeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute *tfprotov6.SchemaAttribute
+		expected  string
+	}{
+		"nil": {
+			attribute: nil,
+			expected:  "attribute is nil",
+		},
+		"valid": {
+			attribute: &tfprotov6.SchemaAttribute{
+				Name:     "test",
+				Type:     tftypes.Bool,
+				Computed: true,
+			},
+		},
+		"leading-digit": {
+			attribute: &tfprotov6.SchemaAttribute{
+				Name:     "1test",
+				Type:     tftypes.Bool,
+				Computed: true,
+			},
+		},
+		"uppercase": {
+			attribute: &tfprotov6.SchemaAttribute{
+				Name:     "Test",
+				Type:     tftypes.Bool,
+				Computed: true,
+			},
+			expected: `attribute "Test": invalid name`,
+		},
+		"hyphen": {
+			attribute: &tfprotov6.SchemaAttribute{
+				Name:     "test-attribute",
+				Type:     tftypes.Bool,
+				Computed: true,
+			},
+			expected: `attribute "test-attribute": invalid name`,
+		},
+		"invalid": {
+			attribute: &tfprotov6.SchemaAttribute{
+				Name:     "test attribute",
+				Type:     tftypes.Bool,
+				Required: true,
+				Optional: true,
+			},
+			expected: `attribute "test attribute": invalid name` + "\n" +
+				`attribute "test attribute": Required cannot be combined with Optional or Computed`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.attribute.Validate()
+
+			if testCase.expected == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expected)
+			}
+
+			if err.Error() != testCase.expected {
+				t.Errorf("expected error:\n%s\ngot:\n%s", testCase.expected, err)
+			}
+		})
+	}
+}
+
+func TestSchemaNestedBlockValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		block    *tfprotov6.SchemaNestedBlock
+		expected string
+	}{
+		"nil": {
+			block:    nil,
+			expected: "nested block is nil",
+		},
+		"valid": {
+			block: &tfprotov6.SchemaNestedBlock{
+				TypeName: "test",
+				Nesting:  tfprotov6.SchemaNestedBlockNestingModeSet,
+				Block:    &tfprotov6.SchemaBlock{},
+			},
+		},
+		"invalid": {
+			block: &tfprotov6.SchemaNestedBlock{
+				TypeName: "test",
+				Nesting:  tfprotov6.SchemaNestedBlockNestingModeSet,
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "attribute",
+							Optional: true,
+						},
+					},
+				},
+			},
+			expected: `attribute "test.attribute": one of Type or NestedType must be set`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.block.Validate()
+
+			if testCase.expected == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expected)
+			}
+
+			if err.Error() != testCase.expected {
+				t.Errorf("expected error:\n%s\ngot:\n%s", testCase.expected, err)
+			}
+		})
+	}
+}
+
+func TestSchemaObjectValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		object   *tfprotov6.SchemaObject
+		expected string
+	}{
+		"nil": {
+			object:   nil,
+			expected: "nested type is nil",
+		},
+		"valid": {
+			object: &tfprotov6.SchemaObject{
+				Nesting: tfprotov6.SchemaObjectNestingModeList,
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name:     "test",
+						Type:     tftypes.String,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"invalid": {
+			object: &tfprotov6.SchemaObject{
+				Nesting: tfprotov6.SchemaObjectNestingModeInvalid,
+				Attributes: []*tfprotov6.SchemaAttribute{
+					nil,
+					{
+						Name:     "test",
+						Type:     tftypes.String,
+						Optional: true,
+					},
+					{
+						Name:     "test",
+						Type:     tftypes.String,
+						Optional: true,
+						NestedType: &tfprotov6.SchemaObject{
+							Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name: "nested",
+									Type: tftypes.String,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: "nested type: invalid nesting mode INVALID\n" +
+				"nested type: nested attribute is nil\n" +
+				`attribute "test": duplicate name` + "\n" +
+				`attribute "test": Type cannot be combined with NestedType` + "\n" +
+				`attribute "test.nested": one of Required, Optional, or Computed must be set`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.object.Validate()
+
+			if testCase.expected == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expected)
+			}
+
+			if err.Error() != testCase.expected {
+				t.Errorf("expected error:\n%s\ngot:\n%s", testCase.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6
+
+import "github.com/hashicorp/terraform-plugin-go/tftypes"
+
+// AllAttributes returns an iterator of every attribute in the schema and its
+// path, including the attributes of nested blocks and the nested attributes
+// of NestedType, depth-first in schema order. Each attribute is yielded
+// before its nested attributes. Nil attributes and blocks are skipped.
+//
+// Paths only contain AttributeName steps, since they describe the location
+// of the attribute in the schema rather than a value. AttributeAtPath
+// requires element steps for collection nesting modes instead.
+func (s *Schema) AllAttributes() func(yield func(*tftypes.AttributePath, *SchemaAttribute) bool) {
+	if s == nil {
+		return func(func(*tftypes.AttributePath, *SchemaAttribute) bool) {}
+	}
+
+	return s.Block.AllAttributes()
+}
+
+// AllBlocks returns an iterator of every nested block in the schema and its
+// path, depth-first in schema order. Each nested block is yielded before the
+// nested blocks within it. See AllAttributes for more information about the
+// paths.
+func (s *Schema) AllBlocks() func(yield func(*tftypes.AttributePath, *SchemaNestedBlock) bool) {
+	if s == nil {
+		return func(func(*tftypes.AttributePath, *SchemaNestedBlock) bool) {}
+	}
+
+	return s.Block.AllBlocks()
+}
+
+// AllAttributes returns an iterator of every attribute in the block and its
+// path, relative to the block. See Schema.AllAttributes for more information.
+func (s *SchemaBlock) AllAttributes() func(yield func(*tftypes.AttributePath, *SchemaAttribute) bool) {
+	return func(yield func(*tftypes.AttributePath, *SchemaAttribute) bool) {
+		s.walkAttributes(tftypes.NewAttributePath(), yield)
+	}
+}
+
+// AllBlocks returns an iterator of every nested block in the block and its
+// path, relative to the block. See Schema.AllBlocks for more information.
+func (s *SchemaBlock) AllBlocks() func(yield func(*tftypes.AttributePath, *SchemaNestedBlock) bool) {
+	return func(yield func(*tftypes.AttributePath, *SchemaNestedBlock) bool) {
+		s.walkBlocks(tftypes.NewAttributePath(), yield)
+	}
+}
+
+// walkAttributes yields the attributes of the block and its nested blocks,
+// returning false if yield returned false.
+func (s *SchemaBlock) walkAttributes(path *tftypes.AttributePath, yield func(*tftypes.AttributePath, *SchemaAttribute) bool) bool {
+	if s == nil {
+		return true
+	}
+
+	for _, attribute := range s.Attributes {
+		if !attribute.walkAttributes(path, yield) {
+			return false
+		}
+	}
+
+	for _, blockType := range s.BlockTypes {
+		if blockType == nil {
+			continue
+		}
+
+		if !blockType.Block.walkAttributes(path.WithAttributeName(blockType.TypeName), yield) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// walkAttributes yields the attribute and its nested attributes, returning
+// false if yield returned false.
+func (s *SchemaAttribute) walkAttributes(path *tftypes.AttributePath, yield func(*tftypes.AttributePath, *SchemaAttribute) bool) bool {
+	if s == nil {
+		return true
+	}
+
+	attributePath := path.WithAttributeName(s.Name)
+
+	if !yield(attributePath, s) {
+		return false
+	}
+
+	if s.NestedType == nil {
+		return true
+	}
+
+	for _, attribute := range s.NestedType.Attributes {
+		if !attribute.walkAttributes(attributePath, yield) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// walkBlocks yields the nested blocks of the block, returning false if yield
+// returned false.
+func (s *SchemaBlock) walkBlocks(path *tftypes.AttributePath, yield func(*tftypes.AttributePath, *SchemaNestedBlock) bool) bool {
+	if s == nil {
+		return true
+	}
+
+	for _, blockType := range s.BlockTypes {
+		if blockType == nil {
+			continue
+		}
+
+		blockPath := path.WithAttributeName(blockType.TypeName)
+
+		if !yield(blockPath, blockType) {
+			return false
+		}
+
+		if !blockType.Block.walkBlocks(blockPath, yield) {
+			return false
+		}
+	}
+
+	return true
+}
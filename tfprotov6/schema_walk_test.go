@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testSchemaWalkAttribute struct {
+	Path      *tftypes.AttributePath
+	Attribute *tfprotov6.SchemaAttribute
+}
+
+type testSchemaWalkBlock struct {
+	Path  *tftypes.AttributePath
+	Block *tfprotov6.SchemaNestedBlock
+}
+
+func TestSchemaAllAttributes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   *tfprotov6.Schema
+		expected []testSchemaWalkAttribute
+	}{
+		"nil-schema": {
+			schema:   nil,
+			expected: nil,
+		},
+		"nil-block": {
+			schema:   &tfprotov6.Schema{},
+			expected: nil,
+		},
+		"nil-attributes-and-blocks": {
+			schema: &tfprotov6.Schema{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{nil},
+					BlockTypes: []*tfprotov6.SchemaNestedBlock{nil, {TypeName: "nil_block"}},
+				},
+			},
+			expected: nil,
+		},
+		"nested": {
+			schema: testSchemaPathSchema,
+			expected: []testSchemaWalkAttribute{
+				{
+					Path:      tftypes.NewAttributePath().WithAttributeName("string_attribute"),
+					Attribute: testSchemaPathStringAttribute,
+				},
+				{
+					Path:      tftypes.NewAttributePath().WithAttributeName("list_attribute"),
+					Attribute: testSchemaPathListAttribute,
+				},
+				{
+					Path:      tftypes.NewAttributePath().WithAttributeName("nested_list"),
+					Attribute: testSchemaPathNestedListAttribute,
+				},
+				{
+					Path:      tftypes.NewAttributePath().WithAttributeName("nested_list").WithAttributeName("inner"),
+					Attribute: testSchemaPathNestedInnerAttribute,
+				},
+				{
+					Path:      tftypes.NewAttributePath().WithAttributeName("nested_single"),
+					Attribute: testSchemaPathNestedSingleAttribute,
+				},
+				{
+					Path:      tftypes.NewAttributePath().WithAttributeName("nested_single").WithAttributeName("inner"),
+					Attribute: testSchemaPathNestedInnerAttribute,
+				},
+				{
+					Path:      tftypes.NewAttributePath().WithAttributeName("list_block").WithAttributeName("block_attribute"),
+					Attribute: testSchemaPathBlockAttribute,
+				},
+				{
+					Path:      tftypes.NewAttributePath().WithAttributeName("list_block").WithAttributeName("single_block").WithAttributeName("block_attribute"),
+					Attribute: testSchemaPathBlockAttribute,
+				},
+				{
+					Path:      tftypes.NewAttributePath().WithAttributeName("set_block").WithAttributeName("block_attribute"),
+					Attribute: testSchemaPathBlockAttribute,
+				},
+				{
+					Path:      tftypes.NewAttributePath().WithAttributeName("map_block").WithAttributeName("block_attribute"),
+					Attribute: testSchemaPathBlockAttribute,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []testSchemaWalkAttribute
+
+			testCase.schema.AllAttributes()(func(path *tftypes.AttributePath, attribute *tfprotov6.SchemaAttribute) bool {
+				got = append(got, testSchemaWalkAttribute{Path: path, Attribute: attribute})
+
+				return true
+			})
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaAllAttributes_stop(t *testing.T) {
+	t.Parallel()
+
+	var got []*tftypes.AttributePath
+
+	testSchemaPathSchema.AllAttributes()(func(path *tftypes.AttributePath, _ *tfprotov6.SchemaAttribute) bool {
+		got = append(got, path)
+
+		return len(got) < 4
+	})
+
+	expected := []*tftypes.AttributePath{
+		tftypes.NewAttributePath().WithAttributeName("string_attribute"),
+		tftypes.NewAttributePath().WithAttributeName("list_attribute"),
+		tftypes.NewAttributePath().WithAttributeName("nested_list"),
+		tftypes.NewAttributePath().WithAttributeName("nested_list").WithAttributeName("inner"),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestSchemaAllBlocks(t *testing.T) {
+	t.Parallel()
+
+	var got []testSchemaWalkBlock
+
+	testSchemaPathSchema.AllBlocks()(func(path *tftypes.AttributePath, block *tfprotov6.SchemaNestedBlock) bool {
+		got = append(got, testSchemaWalkBlock{Path: path, Block: block})
+
+		return true
+	})
+
+	expected := []testSchemaWalkBlock{
+		{
+			Path:  tftypes.NewAttributePath().WithAttributeName("list_block"),
+			Block: testSchemaPathListBlock,
+		},
+		{
+			Path:  tftypes.NewAttributePath().WithAttributeName("list_block").WithAttributeName("single_block"),
+			Block: testSchemaPathSingleBlock,
+		},
+		{
+			Path:  tftypes.NewAttributePath().WithAttributeName("set_block"),
+			Block: testSchemaPathSetBlock,
+		},
+		{
+			Path:  tftypes.NewAttributePath().WithAttributeName("map_block"),
+			Block: testSchemaPathMapBlock,
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	var stopped int
+
+	testSchemaPathSchema.AllBlocks()(func(*tftypes.AttributePath, *tfprotov6.SchemaNestedBlock) bool {
+		stopped++
+
+		return false
+	})
+
+	if stopped != 1 {
+		t.Errorf("expected iteration to stop after 1 block, got %d", stopped)
+	}
+}
@@ -24,3 +24,33 @@ type ServerCapabilities struct {
 	// ProposedNewState in PlanResourceChangeRequest will be a null value.
 	PlanDestroy bool
 }
+
+// GetGetProviderSchemaOptional returns the GetProviderSchemaOptional field, or
+// false if the ServerCapabilities is nil.
+func (c *ServerCapabilities) GetGetProviderSchemaOptional() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.GetProviderSchemaOptional
+}
+
+// GetMoveResourceState returns the MoveResourceState field, or false if the
+// ServerCapabilities is nil.
+func (c *ServerCapabilities) GetMoveResourceState() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.MoveResourceState
+}
+
+// GetPlanDestroy returns the PlanDestroy field, or false if the
+// ServerCapabilities is nil.
+func (c *ServerCapabilities) GetPlanDestroy() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.PlanDestroy
+}
@@ -0,0 +1,593 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// rawStateFlatmapUnknown is the value used by Terraform 0.11 and earlier to
+// represent unknown values in flatmap states.
+const rawStateFlatmapUnknown = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// UnmarshalWithSchema returns a `tftypes.Value` that represents the
+// information contained in the RawState, decoded using the type information
+// of the passed schema block, which should be the current resource schema
+// block.
+//
+// Unlike Unmarshal, UnmarshalWithSchema supports both JSON and flatmap states
+// and applies the same legacy coercions Terraform applies when upgrading
+// states written by older providers:
+//
+// - Strings are converted to numbers and bools, and numbers and bools are
+// converted to strings, as the types of primitive attributes may have
+// changed or been written loosely by older SDKs.
+//
+// - Attributes not present in the state are set to null and attributes not
+// present in the schema are ignored.
+//
+// - Null list, set, and map nested blocks are converted to empty
+// collections, and null group nested blocks are converted to blocks with
+// null attributes, as Terraform never considers those blocks to be null.
+//
+// Flatmap states cannot represent DynamicPseudoType values, so an error is
+// returned if the schema contains one and the state is in flatmap format.
+func (s RawState) UnmarshalWithSchema(schema *SchemaBlock) (tftypes.Value, error) {
+	typ := schema.ValueType()
+	path := tftypes.NewAttributePath()
+
+	var val tftypes.Value
+
+	switch {
+	case s.JSON != nil:
+		dec := json.NewDecoder(bytes.NewReader(s.JSON))
+		dec.UseNumber()
+
+		var in interface{}
+
+		if err := dec.Decode(&in); err != nil {
+			return tftypes.Value{}, fmt.Errorf("error decoding JSON state: %w", err)
+		}
+
+		v, err := rawStateValueFromJSON(path, typ, in)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		val = v
+	case s.Flatmap != nil:
+		v, err := rawStateValueFromFlatmap(path, typ, s.Flatmap, "")
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		val = v
+	default:
+		return tftypes.Value{}, ErrUnknownRawStateType
+	}
+
+	return rawStateNormalizeBlock(path, schema, val)
+}
+
+// rawStateValueFromJSON converts a decoded JSON value into a tftypes.Value of
+// the given type, applying legacy primitive coercions.
+func rawStateValueFromJSON(path *tftypes.AttributePath, typ tftypes.Type, in interface{}) (tftypes.Value, error) {
+	if in == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.DynamicPseudoType):
+		b, err := json.Marshal(in)
+
+		if err != nil {
+			return tftypes.Value{}, path.NewError(err)
+		}
+
+		v, err := tftypes.ValueFromJSON(b, typ) //nolint:staticcheck
+
+		if err != nil {
+			return tftypes.Value{}, path.NewError(err)
+		}
+
+		return v, nil
+	case typ.Is(tftypes.String), typ.Is(tftypes.Number), typ.Is(tftypes.Bool):
+		var s string
+
+		switch in := in.(type) {
+		case string:
+			s = in
+		case json.Number:
+			s = in.String()
+		case bool:
+			s = strconv.FormatBool(in)
+		default:
+			return tftypes.Value{}, path.NewErrorf("unsupported JSON type %T for %s", in, typ)
+		}
+
+		return rawStatePrimitive(path, typ, s)
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		elems, ok := in.([]interface{})
+
+		if !ok {
+			return tftypes.Value{}, path.NewErrorf("expected JSON array for %s, got %T", typ, in)
+		}
+
+		var elemType func(int) tftypes.Type
+
+		switch typ := typ.(type) {
+		case tftypes.List:
+			elemType = func(int) tftypes.Type { return typ.ElementType }
+		case tftypes.Set:
+			elemType = func(int) tftypes.Type { return typ.ElementType }
+		case tftypes.Tuple:
+			if len(elems) != len(typ.ElementTypes) {
+				return tftypes.Value{}, path.NewErrorf("expected %d tuple elements, got %d", len(typ.ElementTypes), len(elems))
+			}
+
+			elemType = func(i int) tftypes.Type { return typ.ElementTypes[i] }
+		}
+
+		vals := make([]tftypes.Value, 0, len(elems))
+
+		for i, elem := range elems {
+			v, err := rawStateValueFromJSON(path.WithElementKeyInt(i), elemType(i), elem)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			vals = append(vals, v)
+		}
+
+		return tftypes.NewValue(typ, vals), nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		obj, ok := in.(map[string]interface{})
+
+		if !ok {
+			return tftypes.Value{}, path.NewErrorf("expected JSON object for %s, got %T", typ, in)
+		}
+
+		vals := make(map[string]tftypes.Value, len(obj))
+
+		switch typ := typ.(type) {
+		case tftypes.Map:
+			for key, elem := range obj {
+				v, err := rawStateValueFromJSON(path.WithElementKeyString(key), typ.ElementType, elem)
+
+				if err != nil {
+					return tftypes.Value{}, err
+				}
+
+				vals[key] = v
+			}
+		case tftypes.Object:
+			for name, attributeType := range typ.AttributeTypes {
+				v, err := rawStateValueFromJSON(path.WithAttributeName(name), attributeType, obj[name])
+
+				if err != nil {
+					return tftypes.Value{}, err
+				}
+
+				vals[name] = v
+			}
+		}
+
+		return tftypes.NewValue(typ, vals), nil
+	}
+
+	return tftypes.Value{}, path.NewErrorf("unsupported type %s", typ)
+}
+
+// rawStateValueFromFlatmap converts the flatmap entries under key into a
+// tftypes.Value of the given type, applying legacy primitive coercions.
+func rawStateValueFromFlatmap(path *tftypes.AttributePath, typ tftypes.Type, m map[string]string, key string) (tftypes.Value, error) {
+	switch {
+	case typ.Is(tftypes.String), typ.Is(tftypes.Number), typ.Is(tftypes.Bool):
+		s, ok := m[key]
+
+		if !ok {
+			return tftypes.NewValue(typ, nil), nil
+		}
+
+		if s == rawStateFlatmapUnknown {
+			return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+		}
+
+		return rawStatePrimitive(path, typ, s)
+	case typ.Is(tftypes.DynamicPseudoType):
+		return tftypes.Value{}, path.NewErrorf("flatmap states cannot contain dynamic values")
+	}
+
+	// Collections and objects have their entries nested under the key,
+	// except at the root of the state.
+	prefix := key
+
+	if key != "" {
+		prefix = key + "."
+
+		if m[key] == rawStateFlatmapUnknown {
+			return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+		}
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		vals := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+		for name, attributeType := range typ.AttributeTypes {
+			v, err := rawStateValueFromFlatmap(path.WithAttributeName(name), attributeType, m, prefix+name)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			vals[name] = v
+		}
+
+		return tftypes.NewValue(typ, vals), nil
+	case tftypes.List, tftypes.Tuple:
+		count, ok := m[prefix+"#"]
+
+		if !ok {
+			return tftypes.NewValue(typ, nil), nil
+		}
+
+		if count == rawStateFlatmapUnknown {
+			return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+		}
+
+		n, err := strconv.Atoi(count)
+
+		if err != nil {
+			return tftypes.Value{}, path.NewErrorf("invalid count %q: %w", count, err)
+		}
+
+		if tuple, ok := typ.(tftypes.Tuple); ok && n != len(tuple.ElementTypes) {
+			return tftypes.Value{}, path.NewErrorf("expected %d tuple elements, got %d", len(tuple.ElementTypes), n)
+		}
+
+		vals := make([]tftypes.Value, 0, n)
+
+		for i := 0; i < n; i++ {
+			var elemType tftypes.Type
+
+			switch typ := typ.(type) {
+			case tftypes.List:
+				elemType = typ.ElementType
+			case tftypes.Tuple:
+				elemType = typ.ElementTypes[i]
+			}
+
+			v, err := rawStateValueFromFlatmap(path.WithElementKeyInt(i), elemType, m, prefix+strconv.Itoa(i))
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			vals = append(vals, v)
+		}
+
+		return tftypes.NewValue(typ, vals), nil
+	case tftypes.Set:
+		count, ok := m[prefix+"#"]
+
+		if !ok {
+			return tftypes.NewValue(typ, nil), nil
+		}
+
+		if count == rawStateFlatmapUnknown {
+			return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+		}
+
+		// Set elements are keyed by a hash of their value, which is
+		// discarded.
+		vals := []tftypes.Value{}
+
+		for _, elemKey := range rawStateFlatmapKeys(m, prefix, "#") {
+			v, err := rawStateValueFromFlatmap(path, typ.ElementType, m, prefix+elemKey)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			vals = append(vals, v)
+		}
+
+		return tftypes.NewValue(typ, vals), nil
+	case tftypes.Map:
+		count, ok := m[prefix+"%"]
+
+		if !ok {
+			return tftypes.NewValue(typ, nil), nil
+		}
+
+		if count == rawStateFlatmapUnknown {
+			return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+		}
+
+		vals := map[string]tftypes.Value{}
+
+		// Flatmap cannot distinguish map keys containing periods from
+		// nested values, so keys are only split when elements are not
+		// primitives.
+		var elemKeys []string
+
+		if rawStateIsPrimitive(typ.ElementType) {
+			for key := range m {
+				if strings.HasPrefix(key, prefix) && key != prefix+"%" {
+					elemKeys = append(elemKeys, strings.TrimPrefix(key, prefix))
+				}
+			}
+		} else {
+			elemKeys = rawStateFlatmapKeys(m, prefix, "%")
+		}
+
+		for _, elemKey := range elemKeys {
+			v, err := rawStateValueFromFlatmap(path.WithElementKeyString(elemKey), typ.ElementType, m, prefix+elemKey)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			vals[elemKey] = v
+		}
+
+		return tftypes.NewValue(typ, vals), nil
+	}
+
+	return tftypes.Value{}, path.NewErrorf("unsupported type %s", typ)
+}
+
+// rawStateFlatmapKeys returns the sorted, unique element keys of the flatmap
+// entries under prefix, excluding the count entry.
+func rawStateFlatmapKeys(m map[string]string, prefix string, countKey string) []string {
+	seen := map[string]struct{}{}
+
+	for key := range m {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		elemKey := strings.TrimPrefix(key, prefix)
+
+		if elemKey == countKey {
+			continue
+		}
+
+		if dot := strings.IndexByte(elemKey, '.'); dot != -1 {
+			elemKey = elemKey[:dot]
+		}
+
+		seen[elemKey] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(seen))
+
+	for key := range seen {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// rawStateFlatmapValidateKeys returns an error for the first flatmap key, in
+// sorted order, that does not refer to a value of the given type.
+func rawStateFlatmapValidateKeys(typ tftypes.Type, m map[string]string) error {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := rawStateFlatmapValidateKey(tftypes.NewAttributePath(), typ, strings.Split(key, ".")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rawStateFlatmapValidateKey returns an error if the remaining segments of a
+// flatmap key do not refer to a value of the given type.
+func rawStateFlatmapValidateKey(path *tftypes.AttributePath, typ tftypes.Type, segments []string) error {
+	if len(segments) == 0 {
+		return nil
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		attributeType, ok := typ.AttributeTypes[segments[0]]
+
+		if !ok {
+			return path.WithAttributeName(segments[0]).NewErrorf("unsupported attribute %q", segments[0])
+		}
+
+		return rawStateFlatmapValidateKey(path.WithAttributeName(segments[0]), attributeType, segments[1:])
+	case tftypes.List, tftypes.Tuple:
+		if len(segments) == 1 && segments[0] == "#" {
+			return nil
+		}
+
+		i, err := strconv.Atoi(segments[0])
+
+		if err != nil || i < 0 {
+			return path.NewErrorf("unsupported element key %q", segments[0])
+		}
+
+		var elemType tftypes.Type
+
+		switch typ := typ.(type) {
+		case tftypes.List:
+			elemType = typ.ElementType
+		case tftypes.Tuple:
+			if i >= len(typ.ElementTypes) {
+				return path.NewErrorf("unsupported element key %q", segments[0])
+			}
+
+			elemType = typ.ElementTypes[i]
+		}
+
+		return rawStateFlatmapValidateKey(path.WithElementKeyInt(i), elemType, segments[1:])
+	case tftypes.Set:
+		if len(segments) == 1 && segments[0] == "#" {
+			return nil
+		}
+
+		return rawStateFlatmapValidateKey(path, typ.ElementType, segments[1:])
+	case tftypes.Map:
+		if len(segments) == 1 && segments[0] == "%" {
+			return nil
+		}
+
+		// Keys of primitive maps may contain periods.
+		if rawStateIsPrimitive(typ.ElementType) {
+			return nil
+		}
+
+		return rawStateFlatmapValidateKey(path.WithElementKeyString(segments[0]), typ.ElementType, segments[1:])
+	}
+
+	return path.NewErrorf("unsupported nested key %q", strings.Join(segments, "."))
+}
+
+// rawStateIsPrimitive returns true if the type is a String, Number, or Bool.
+func rawStateIsPrimitive(typ tftypes.Type) bool {
+	return typ.Is(tftypes.String) || typ.Is(tftypes.Number) || typ.Is(tftypes.Bool)
+}
+
+// rawStatePrimitive converts the string representation of a primitive value
+// into a tftypes.Value of the given type.
+func rawStatePrimitive(path *tftypes.AttributePath, typ tftypes.Type, in string) (tftypes.Value, error) {
+	switch {
+	case typ.Is(tftypes.Number):
+		f, _, err := big.ParseFloat(in, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, path.NewErrorf("cannot convert %q to number: %w", in, err)
+		}
+
+		return tftypes.NewValue(typ, f), nil
+	case typ.Is(tftypes.Bool):
+		b, err := strconv.ParseBool(in)
+
+		if err != nil {
+			return tftypes.Value{}, path.NewErrorf("cannot convert %q to bool: %w", in, err)
+		}
+
+		return tftypes.NewValue(typ, b), nil
+	}
+
+	return tftypes.NewValue(typ, in), nil
+}
+
+// rawStateNormalizeBlock converts null list, set, and map nested blocks into
+// empty collections and null group nested blocks into blocks with null
+// attributes, recursively.
+func rawStateNormalizeBlock(path *tftypes.AttributePath, block *SchemaBlock, in tftypes.Value) (tftypes.Value, error) {
+	if block == nil || in.IsNull() || !in.IsKnown() {
+		return in, nil
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := in.As(&attributes); err != nil {
+		return tftypes.Value{}, path.NewError(err)
+	}
+
+	for _, nestedBlock := range block.BlockTypes {
+		if nestedBlock == nil {
+			continue
+		}
+
+		nestedPath := path.WithAttributeName(nestedBlock.TypeName)
+		value, ok := attributes[nestedBlock.TypeName]
+
+		if !ok || !value.IsKnown() {
+			continue
+		}
+
+		switch nestedBlock.Nesting {
+		case SchemaNestedBlockNestingModeSingle, SchemaNestedBlockNestingModeGroup:
+			if value.IsNull() && nestedBlock.Nesting == SchemaNestedBlockNestingModeGroup {
+				v, err := rawStateValueFromJSON(nestedPath, value.Type(), map[string]interface{}{})
+
+				if err != nil {
+					return tftypes.Value{}, err
+				}
+
+				value = v
+			}
+
+			v, err := rawStateNormalizeBlock(nestedPath, nestedBlock.Block, value)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			attributes[nestedBlock.TypeName] = v
+		case SchemaNestedBlockNestingModeList, SchemaNestedBlockNestingModeSet:
+			var elems []tftypes.Value
+
+			if !value.IsNull() {
+				if err := value.As(&elems); err != nil {
+					return tftypes.Value{}, nestedPath.NewError(err)
+				}
+			}
+
+			vals := make([]tftypes.Value, 0, len(elems))
+
+			for i, elem := range elems {
+				v, err := rawStateNormalizeBlock(nestedPath.WithElementKeyInt(i), nestedBlock.Block, elem)
+
+				if err != nil {
+					return tftypes.Value{}, err
+				}
+
+				vals = append(vals, v)
+			}
+
+			attributes[nestedBlock.TypeName] = tftypes.NewValue(value.Type(), vals)
+		case SchemaNestedBlockNestingModeMap:
+			var elems map[string]tftypes.Value
+
+			if !value.IsNull() {
+				if err := value.As(&elems); err != nil {
+					return tftypes.Value{}, nestedPath.NewError(err)
+				}
+			}
+
+			vals := make(map[string]tftypes.Value, len(elems))
+
+			for key, elem := range elems {
+				v, err := rawStateNormalizeBlock(nestedPath.WithElementKeyString(key), nestedBlock.Block, elem)
+
+				if err != nil {
+					return tftypes.Value{}, err
+				}
+
+				vals[key] = v
+			}
+
+			attributes[nestedBlock.TypeName] = tftypes.NewValue(value.Type(), vals)
+		}
+	}
+
+	return tftypes.NewValue(in.Type(), attributes), nil
+}
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6
+
+import (
+	"context"
+)
+
+// StateStoreMetadata describes metadata for a state store in the GetMetadata
+// RPC.
+type StateStoreMetadata struct {
+	// TypeName is the name of the state store.
+	TypeName string
+}
+
+// StateStoreServer is an interface containing the methods a state store
+// implementation needs to fill.
+//
+// State stores allow Terraform to persist state through the provider, in
+// place of a backend built into Terraform. The server transfers state
+// between Terraform and the provider in chunks, so implementations always
+// receive and return whole states.
+type StateStoreServer interface {
+	// ValidateStateStoreConfig is called when Terraform is checking that a
+	// state store's configuration is valid. It is guaranteed to have types
+	// conforming to your schema. This is your opportunity to do custom or
+	// advanced validation prior to the state store being configured.
+	ValidateStateStoreConfig(context.Context, *ValidateStateStoreConfigRequest) (*ValidateStateStoreConfigResponse, error)
+
+	// ConfigureStateStore is called to pass the user-specified state store
+	// configuration to the provider before any other state store RPC.
+	ConfigureStateStore(context.Context, *ConfigureStateStoreRequest) (*ConfigureStateStoreResponse, error)
+
+	// ReadStateBytes is called when Terraform wants to read a state.
+	ReadStateBytes(context.Context, *ReadStateBytesRequest) (*ReadStateBytesResponse, error)
+
+	// WriteStateBytes is called when Terraform wants to persist a state.
+	WriteStateBytes(context.Context, *WriteStateBytesRequest) (*WriteStateBytesResponse, error)
+
+	// LockState is called when Terraform wants to acquire the lock of a
+	// state before changing it.
+	LockState(context.Context, *LockStateRequest) (*LockStateResponse, error)
+
+	// UnlockState is called when Terraform wants to release a lock
+	// previously acquired with LockState.
+	UnlockState(context.Context, *UnlockStateRequest) (*UnlockStateResponse, error)
+
+	// GetStates is called when Terraform wants to list the states, such as
+	// the workspaces, of the state store.
+	GetStates(context.Context, *GetStatesRequest) (*GetStatesResponse, error)
+
+	// DeleteState is called when Terraform wants to delete a state.
+	DeleteState(context.Context, *DeleteStateRequest) (*DeleteStateResponse, error)
+}
+
+// ValidateStateStoreConfigRequest is the request Terraform sends when it
+// wants to validate a state store's configuration.
+type ValidateStateStoreConfigRequest struct {
+	// TypeName is the type of state store Terraform is validating.
+	TypeName string
+
+	// Config is the configuration the user supplied for that state store.
+	// See the documentation on `DynamicValue` for more information about
+	// safely accessing the configuration.
+	//
+	// The configuration is represented as a tftypes.Object, with each
+	// attribute and nested block getting its own key and value.
+	Config *DynamicValue
+}
+
+// ValidateStateStoreConfigResponse is the response from the provider about
+// the validity of a state store's configuration.
+type ValidateStateStoreConfigResponse struct {
+	// Diagnostics report errors or warnings related to the given
+	// configuration. Returning an empty slice indicates a successful
+	// validation with no warnings or errors generated.
+	Diagnostics []*Diagnostic
+}
+
+// ConfigureStateStoreRequest is the request Terraform sends to supply the
+// provider with the configuration of a state store.
+type ConfigureStateStoreRequest struct {
+	// TypeName is the type of state store Terraform is configuring.
+	TypeName string
+
+	// Config is the configuration the user supplied for that state store.
+	// This information should usually be persisted for use in later state
+	// store RPC requests. See the documentation on `DynamicValue` for more
+	// information about safely accessing the configuration.
+	//
+	// The configuration is represented as a tftypes.Object, with each
+	// attribute and nested block getting its own key and value.
+	Config *DynamicValue
+}
+
+// ConfigureStateStoreResponse is the response from the provider after
+// configuring a state store.
+type ConfigureStateStoreResponse struct {
+	// Diagnostics report errors or warnings related to the state store's
+	// configuration. Returning an empty slice indicates success, with no
+	// errors or warnings generated.
+	Diagnostics []*Diagnostic
+}
+
+// ReadStateBytesRequest is the request Terraform sends when it wants to read
+// a state.
+type ReadStateBytesRequest struct {
+	// TypeName is the type of state store Terraform is reading from.
+	TypeName string
+
+	// StateID is the identifier of the state, such as the workspace name.
+	StateID string
+}
+
+// ReadStateBytesResponse is the response from the provider when reading a
+// state.
+type ReadStateBytesResponse struct {
+	// Bytes is the whole state. Returning no bytes indicates that the state
+	// does not exist yet.
+	Bytes []byte
+
+	// Diagnostics report errors or warnings related to reading the state.
+	// Returning an empty slice indicates success, with no errors or
+	// warnings generated.
+	Diagnostics []*Diagnostic
+}
+
+// WriteStateBytesRequest is the request Terraform sends when it wants to
+// persist a state.
+type WriteStateBytesRequest struct {
+	// TypeName is the type of state store Terraform is writing to.
+	TypeName string
+
+	// StateID is the identifier of the state, such as the workspace name.
+	StateID string
+
+	// Bytes is the whole state, assembled by the server from the chunks
+	// sent by Terraform.
+	Bytes []byte
+}
+
+// WriteStateBytesResponse is the response from the provider when persisting
+// a state.
+type WriteStateBytesResponse struct {
+	// Diagnostics report errors or warnings related to writing the state.
+	// Returning an empty slice indicates success, with no errors or
+	// warnings generated.
+	Diagnostics []*Diagnostic
+}
+
+// LockStateRequest is the request Terraform sends when it wants to acquire
+// the lock of a state.
+type LockStateRequest struct {
+	// TypeName is the type of state store Terraform is locking.
+	TypeName string
+
+	// StateID is the identifier of the state, such as the workspace name.
+	StateID string
+
+	// Operation describes the Terraform operation acquiring the lock, which
+	// can be displayed to practitioners when the state is already locked.
+	Operation string
+}
+
+// LockStateResponse is the response from the provider when acquiring the
+// lock of a state.
+type LockStateResponse struct {
+	// LockID is the identifier of the acquired lock, which Terraform sends
+	// in the UnlockState RPC.
+	LockID string
+
+	// Diagnostics report errors or warnings related to acquiring the lock,
+	// such as the state already being locked. Returning an empty slice
+	// indicates success, with no errors or warnings generated.
+	Diagnostics []*Diagnostic
+}
+
+// UnlockStateRequest is the request Terraform sends when it wants to release
+// the lock of a state.
+type UnlockStateRequest struct {
+	// TypeName is the type of state store Terraform is unlocking.
+	TypeName string
+
+	// StateID is the identifier of the state, such as the workspace name.
+	StateID string
+
+	// LockID is the identifier of the lock returned by LockState.
+	LockID string
+}
+
+// UnlockStateResponse is the response from the provider when releasing the
+// lock of a state.
+type UnlockStateResponse struct {
+	// Diagnostics report errors or warnings related to releasing the lock.
+	// Returning an empty slice indicates success, with no errors or
+	// warnings generated.
+	Diagnostics []*Diagnostic
+}
+
+// GetStatesRequest is the request Terraform sends when it wants to list the
+// states of a state store.
+type GetStatesRequest struct {
+	// TypeName is the type of state store Terraform is listing.
+	TypeName string
+}
+
+// GetStatesResponse is the response from the provider when listing the
+// states of a state store.
+type GetStatesResponse struct {
+	// StateIDs are the identifiers of all states in the state store.
+	StateIDs []string
+
+	// Diagnostics report errors or warnings related to listing the states.
+	// Returning an empty slice indicates success, with no errors or
+	// warnings generated.
+	Diagnostics []*Diagnostic
+}
+
+// DeleteStateRequest is the request Terraform sends when it wants to delete a
+// state.
+type DeleteStateRequest struct {
+	// TypeName is the type of state store Terraform is deleting from.
+	TypeName string
+
+	// StateID is the identifier of the state, such as the workspace name.
+	StateID string
+}
+
+// DeleteStateResponse is the response from the provider when deleting a
+// state.
+type DeleteStateResponse struct {
+	// Diagnostics report errors or warnings related to deleting the state.
+	// Returning an empty slice indicates success, with no errors or
+	// warnings generated.
+	Diagnostics []*Diagnostic
+}
@@ -0,0 +1,400 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/fromproto"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/toproto"
+)
+
+const (
+	// protocolVersion is the major version of the protocol supported by the
+	// client.
+	protocolVersion = tfprotov6.ProtocolVersionMajor
+
+	// grpcMaxMessageSize is the maximum gRPC send and receive message sizes
+	// for the client, which matches the maximum sizes of tf6server.
+	grpcMaxMessageSize = 256 << 20
+)
+
+var (
+	_ tfprotov6.ProviderServerWithActions            = &Client{}
+	_ tfprotov6.ProviderServerWithEphemeralResources = &Client{}
+	_ tfprotov6.ProviderServerWithStateStores        = &Client{}
+)
+
+// Client is a tfprotov6.ProviderServer, including all optional interfaces,
+// which calls the RPCs of a provider over gRPC. Requests and responses are
+// converted between the tfprotov6 and protocol buffers types, so the Client
+// can be used anywhere a tfprotov6.ProviderServer is expected, such as being
+// served by tf6server.
+//
+// RPC errors, such as the provider not implementing an RPC, are returned
+// unmodified as gRPC status errors.
+type Client struct {
+	client tfplugin6.ProviderClient
+	conn   *grpc.ClientConn
+
+	// stateStoreChunkSizes is the chunk size negotiated by
+	// ConfigureStateStore for each state store type.
+	stateStoreChunkSizes   map[string]int64
+	stateStoreChunkSizesMu sync.Mutex
+}
+
+// New returns a Client which calls the RPCs of a provider using an existing
+// gRPC connection. The caller is responsible for closing the connection.
+func New(conn grpc.ClientConnInterface) *Client {
+	return &Client{
+		client: tfplugin6.NewProviderClient(conn),
+	}
+}
+
+// Dial returns a Client connected to the provider listening at addr, such as
+// a provider started with managed debug mode. The connection does not use
+// transport security unless overridden by opts. Close must be called to close
+// the connection.
+func Dial(addr net.Addr, opts ...grpc.DialOption) (*Client, error) {
+	if addr == nil {
+		return nil, errors.New("provider address is nil")
+	}
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		var d net.Dialer
+
+		return d.DialContext(ctx, addr.Network(), addr.String())
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(dialer),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(grpcMaxMessageSize),
+			grpc.MaxCallSendMsgSize(grpcMaxMessageSize),
+		),
+	}
+
+	conn, err := grpc.NewClient("passthrough:///"+addr.String(), append(dialOpts, opts...)...)
+
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to provider at %s: %w", addr, err)
+	}
+
+	return &Client{
+		client: tfplugin6.NewProviderClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// DialReattachConfig returns a Client connected to the provider described by
+// the go-plugin reattach configuration, such as the configuration sent by the
+// tf6server.WithDebug ServeOpt. An error is returned if the configuration is
+// not for a gRPC provider using protocol version 6. See Dial for more
+// information.
+func DialReattachConfig(config *plugin.ReattachConfig, opts ...grpc.DialOption) (*Client, error) {
+	if config == nil {
+		return nil, errors.New("reattach configuration is nil")
+	}
+
+	if config.Protocol != "" && config.Protocol != plugin.ProtocolGRPC {
+		return nil, fmt.Errorf("unsupported reattach configuration protocol %q, expected %q", config.Protocol, plugin.ProtocolGRPC)
+	}
+
+	if config.ProtocolVersion != 0 && config.ProtocolVersion != protocolVersion {
+		return nil, fmt.Errorf("unsupported reattach configuration protocol version %d, expected %d", config.ProtocolVersion, protocolVersion)
+	}
+
+	return Dial(config.Addr, opts...)
+}
+
+// Close closes the connection created by Dial or DialReattachConfig. It is a
+// no-op for a Client created by New.
+func (c *Client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+
+	return c.conn.Close()
+}
+
+func (c *Client) GetMetadata(ctx context.Context, req *tfprotov6.GetMetadataRequest) (*tfprotov6.GetMetadataResponse, error) {
+	protoResp, err := c.client.GetMetadata(ctx, toproto.GetMetadata_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetMetadataResponse(protoResp)
+}
+
+func (c *Client) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	protoResp, err := c.client.GetProviderSchema(ctx, toproto.GetProviderSchema_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetProviderSchemaResponse(protoResp)
+}
+
+func (c *Client) ValidateProviderConfig(ctx context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
+	protoResp, err := c.client.ValidateProviderConfig(ctx, toproto.ValidateProviderConfig_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateProviderConfigResponse(protoResp)
+}
+
+func (c *Client) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	protoResp, err := c.client.ConfigureProvider(ctx, toproto.ConfigureProvider_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ConfigureProviderResponse(protoResp)
+}
+
+func (c *Client) StopProvider(ctx context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	protoResp, err := c.client.StopProvider(ctx, toproto.StopProvider_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.StopProviderResponse(protoResp), nil
+}
+
+func (c *Client) ValidateDataResourceConfig(ctx context.Context, req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
+	protoResp, err := c.client.ValidateDataResourceConfig(ctx, toproto.ValidateDataResourceConfig_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateDataResourceConfigResponse(protoResp)
+}
+
+func (c *Client) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	protoResp, err := c.client.ReadDataSource(ctx, toproto.ReadDataSource_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ReadDataSourceResponse(protoResp)
+}
+
+func (c *Client) ValidateEphemeralResourceConfig(ctx context.Context, req *tfprotov6.ValidateEphemeralResourceConfigRequest) (*tfprotov6.ValidateEphemeralResourceConfigResponse, error) {
+	protoResp, err := c.client.ValidateEphemeralResourceConfig(ctx, toproto.ValidateEphemeralResourceConfig_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateEphemeralResourceConfigResponse(protoResp)
+}
+
+func (c *Client) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	protoResp, err := c.client.OpenEphemeralResource(ctx, toproto.OpenEphemeralResource_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.OpenEphemeralResourceResponse(protoResp)
+}
+
+func (c *Client) RenewEphemeralResource(ctx context.Context, req *tfprotov6.RenewEphemeralResourceRequest) (*tfprotov6.RenewEphemeralResourceResponse, error) {
+	protoResp, err := c.client.RenewEphemeralResource(ctx, toproto.RenewEphemeralResource_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.RenewEphemeralResourceResponse(protoResp)
+}
+
+func (c *Client) CloseEphemeralResource(ctx context.Context, req *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	protoResp, err := c.client.CloseEphemeralResource(ctx, toproto.CloseEphemeralResource_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.CloseEphemeralResourceResponse(protoResp)
+}
+
+func (c *Client) ValidateActionConfig(ctx context.Context, req *tfprotov6.ValidateActionConfigRequest) (*tfprotov6.ValidateActionConfigResponse, error) {
+	protoResp, err := c.client.ValidateActionConfig(ctx, toproto.ValidateActionConfig_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateActionConfigResponse(protoResp)
+}
+
+// InvokeAction returns a response whose events are received from the
+// provider as they are iterated. An error receiving an event is returned as a
+// completed event with an error diagnostic.
+func (c *Client) InvokeAction(ctx context.Context, req *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionResponse, error) {
+	stream, err := c.client.InvokeAction(ctx, toproto.InvokeAction_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tfprotov6.InvokeActionResponse{
+		Events: func(yield func(tfprotov6.InvokeActionEvent) bool) {
+			for {
+				protoEvent, err := stream.Recv()
+
+				if errors.Is(err, io.EOF) {
+					return
+				}
+
+				if err != nil {
+					yield(invokeActionErrorEvent(err))
+
+					return
+				}
+
+				event, err := fromproto.InvokeActionEvent(protoEvent)
+
+				if err != nil {
+					yield(invokeActionErrorEvent(err))
+
+					return
+				}
+
+				if !yield(*event) {
+					return
+				}
+			}
+		},
+	}
+
+	return resp, nil
+}
+
+// invokeActionErrorEvent returns a completed event with an error diagnostic
+// for an error receiving an InvokeAction event.
+func invokeActionErrorEvent(err error) tfprotov6.InvokeActionEvent {
+	return tfprotov6.InvokeActionEvent{
+		Type: tfprotov6.CompletedInvokeActionEventType{
+			Diagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Error Receiving InvokeAction Event",
+					Detail:   "An unexpected error was encountered receiving an event from the provider: " + err.Error(),
+				},
+			},
+		},
+	}
+}
+
+func (c *Client) ValidateResourceConfig(ctx context.Context, req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
+	protoResp, err := c.client.ValidateResourceConfig(ctx, toproto.ValidateResourceConfig_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateResourceConfigResponse(protoResp)
+}
+
+func (c *Client) UpgradeResourceState(ctx context.Context, req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
+	protoResp, err := c.client.UpgradeResourceState(ctx, toproto.UpgradeResourceState_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.UpgradeResourceStateResponse(protoResp)
+}
+
+func (c *Client) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	protoResp, err := c.client.ReadResource(ctx, toproto.ReadResource_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ReadResourceResponse(protoResp)
+}
+
+func (c *Client) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	protoResp, err := c.client.PlanResourceChange(ctx, toproto.PlanResourceChange_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.PlanResourceChangeResponse(protoResp)
+}
+
+func (c *Client) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	protoResp, err := c.client.ApplyResourceChange(ctx, toproto.ApplyResourceChange_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ApplyResourceChangeResponse(protoResp)
+}
+
+func (c *Client) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	protoResp, err := c.client.ImportResourceState(ctx, toproto.ImportResourceState_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ImportResourceStateResponse(protoResp)
+}
+
+func (c *Client) MoveResourceState(ctx context.Context, req *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error) {
+	protoResp, err := c.client.MoveResourceState(ctx, toproto.MoveResourceState_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.MoveResourceStateResponse(protoResp)
+}
+
+func (c *Client) CallFunction(ctx context.Context, req *tfprotov6.CallFunctionRequest) (*tfprotov6.CallFunctionResponse, error) {
+	protoResp, err := c.client.CallFunction(ctx, toproto.CallFunction_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.CallFunctionResponse(protoResp), nil
+}
+
+func (c *Client) GetFunctions(ctx context.Context, req *tfprotov6.GetFunctionsRequest) (*tfprotov6.GetFunctionsResponse, error) {
+	protoResp, err := c.client.GetFunctions(ctx, toproto.GetFunctions_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetFunctionsResponse(protoResp)
+}
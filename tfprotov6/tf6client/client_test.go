@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6client_test
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6client"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testProviderServer struct {
+	tfprotov6.ProviderServer
+	tfprotov6.ActionServer
+	tfprotov6.StateStoreServer
+
+	// states is the state of each state ID written by WriteStateBytes.
+	states map[string][]byte
+}
+
+func (s testProviderServer) GetProviderSchema(_ context.Context, _ *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	return &tfprotov6.GetProviderSchemaResponse{
+		Provider: testSchema,
+		ResourceSchemas: map[string]*tfprotov6.Schema{
+			"test_resource": testSchema,
+		},
+	}, nil
+}
+
+func (s testProviderServer) ConfigureProvider(_ context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	return &tfprotov6.ConfigureProviderResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  "test version " + req.TerraformVersion,
+			},
+		},
+	}, nil
+}
+
+func (s testProviderServer) InvokeAction(_ context.Context, _ *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionResponse, error) {
+	return &tfprotov6.InvokeActionResponse{
+		Events: func(yield func(tfprotov6.InvokeActionEvent) bool) {
+			if !yield(tfprotov6.InvokeActionEvent{Type: tfprotov6.ProgressInvokeActionEventType{Message: "test progress"}}) {
+				return
+			}
+
+			yield(tfprotov6.InvokeActionEvent{Type: tfprotov6.CompletedInvokeActionEventType{}})
+		},
+	}, nil
+}
+
+func (s testProviderServer) ConfigureStateStore(_ context.Context, _ *tfprotov6.ConfigureStateStoreRequest) (*tfprotov6.ConfigureStateStoreResponse, error) {
+	return &tfprotov6.ConfigureStateStoreResponse{}, nil
+}
+
+func (s testProviderServer) ReadStateBytes(_ context.Context, req *tfprotov6.ReadStateBytesRequest) (*tfprotov6.ReadStateBytesResponse, error) {
+	return &tfprotov6.ReadStateBytesResponse{
+		Bytes: s.states[req.StateID],
+	}, nil
+}
+
+func (s testProviderServer) WriteStateBytes(_ context.Context, req *tfprotov6.WriteStateBytesRequest) (*tfprotov6.WriteStateBytesResponse, error) {
+	s.states[req.StateID] = req.Bytes
+
+	return &tfprotov6.WriteStateBytesResponse{}, nil
+}
+
+var testSchema = &tfprotov6.Schema{
+	Block: &tfprotov6.SchemaBlock{
+		Attributes: []*tfprotov6.SchemaAttribute{
+			{
+				Name:     "id",
+				Type:     tftypes.String,
+				Computed: true,
+			},
+		},
+	},
+}
+
+// testServe serves the provider over gRPC on a local TCP listener, returning
+// the listener address.
+func testServe(t *testing.T, provider tfprotov6.ProviderServer) net.Addr {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("unable to listen: %s", err)
+	}
+
+	grpcServer := grpc.NewServer()
+
+	if provider != nil {
+		tfplugin6.RegisterProviderServer(grpcServer, tf6server.New("test", provider))
+	}
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	t.Cleanup(grpcServer.Stop)
+
+	return listener.Addr()
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	client, err := tf6client.Dial(testServe(t, testProviderServer{}))
+
+	if err != nil {
+		t.Fatalf("unexpected error dialing: %s", err)
+	}
+
+	t.Cleanup(func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("unexpected error closing: %s", err)
+		}
+	})
+
+	ctx := context.Background()
+
+	schemaResp, err := client.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected GetProviderSchema error: %s", err)
+	}
+
+	if !schemaResp.Provider.Equal(testSchema) || !schemaResp.ResourceSchemas["test_resource"].Equal(testSchema) {
+		t.Errorf("unexpected GetProviderSchema response: %#v", schemaResp)
+	}
+
+	configureResp, err := client.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion: "1.0.0",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ConfigureProvider error: %s", err)
+	}
+
+	expectedDiagnostics := []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityWarning,
+			Summary:  "test version 1.0.0",
+		},
+	}
+
+	if diff := cmp.Diff(configureResp.Diagnostics, expectedDiagnostics); diff != "" {
+		t.Errorf("unexpected ConfigureProvider diagnostics difference: %s", diff)
+	}
+
+	invokeResp, err := client.InvokeAction(ctx, &tfprotov6.InvokeActionRequest{
+		ActionType: "test_action",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected InvokeAction error: %s", err)
+	}
+
+	var events []tfprotov6.InvokeActionEvent
+
+	invokeResp.Events(func(event tfprotov6.InvokeActionEvent) bool {
+		events = append(events, event)
+
+		return true
+	})
+
+	expectedEvents := []tfprotov6.InvokeActionEvent{
+		{Type: tfprotov6.ProgressInvokeActionEventType{Message: "test progress"}},
+		{Type: tfprotov6.CompletedInvokeActionEventType{}},
+	}
+
+	if diff := cmp.Diff(events, expectedEvents); diff != "" {
+		t.Errorf("unexpected InvokeAction events difference: %s", diff)
+	}
+}
+
+func TestClient_stateStore(t *testing.T) {
+	t.Parallel()
+
+	client, err := tf6client.Dial(testServe(t, testProviderServer{states: map[string][]byte{}}))
+
+	if err != nil {
+		t.Fatalf("unexpected error dialing: %s", err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	ctx := context.Background()
+
+	if _, err := client.ConfigureStateStore(ctx, &tfprotov6.ConfigureStateStoreRequest{TypeName: "test_store"}); err != nil {
+		t.Fatalf("unexpected ConfigureStateStore error: %s", err)
+	}
+
+	// The state is larger than the default chunk size, so it is sent and
+	// received in multiple chunks.
+	state := bytes.Repeat([]byte("test-state"), 300_000)
+
+	writeResp, err := client.WriteStateBytes(ctx, &tfprotov6.WriteStateBytesRequest{
+		TypeName: "test_store",
+		StateID:  "default",
+		Bytes:    state,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected WriteStateBytes error: %s", err)
+	}
+
+	if len(writeResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected WriteStateBytes diagnostics: %v", writeResp.Diagnostics)
+	}
+
+	readResp, err := client.ReadStateBytes(ctx, &tfprotov6.ReadStateBytesRequest{
+		TypeName: "test_store",
+		StateID:  "default",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ReadStateBytes error: %s", err)
+	}
+
+	if len(readResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected ReadStateBytes diagnostics: %v", readResp.Diagnostics)
+	}
+
+	if !bytes.Equal(readResp.Bytes, state) {
+		t.Errorf("expected %d state bytes, got %d", len(state), len(readResp.Bytes))
+	}
+}
+
+func TestClient_unimplemented(t *testing.T) {
+	t.Parallel()
+
+	client, err := tf6client.Dial(testServe(t, nil))
+
+	if err != nil {
+		t.Fatalf("unexpected error dialing: %s", err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	_, err = client.GetMetadata(context.Background(), &tfprotov6.GetMetadataRequest{})
+
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("expected Unimplemented error, got: %v", err)
+	}
+}
+
+func TestDialReattachConfig(t *testing.T) {
+	t.Parallel()
+
+	addr := testServe(t, testProviderServer{})
+
+	testCases := map[string]struct {
+		config        *plugin.ReattachConfig
+		expectedError string
+	}{
+		"nil": {
+			config:        nil,
+			expectedError: "reattach configuration is nil",
+		},
+		"nil-addr": {
+			config:        &plugin.ReattachConfig{},
+			expectedError: "provider address is nil",
+		},
+		"netrpc": {
+			config: &plugin.ReattachConfig{
+				Protocol: plugin.ProtocolNetRPC,
+				Addr:     addr,
+			},
+			expectedError: `unsupported reattach configuration protocol "netrpc", expected "grpc"`,
+		},
+		"protocol-version-5": {
+			config: &plugin.ReattachConfig{
+				Protocol:        plugin.ProtocolGRPC,
+				ProtocolVersion: 5,
+				Addr:            addr,
+			},
+			expectedError: "unsupported reattach configuration protocol version 5, expected 6",
+		},
+		"valid": {
+			config: &plugin.ReattachConfig{
+				Protocol:        plugin.ProtocolGRPC,
+				ProtocolVersion: 6,
+				Addr:            addr,
+				Test:            true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := tf6client.DialReattachConfig(testCase.config)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			t.Cleanup(func() {
+				_ = client.Close()
+			})
+
+			if _, err := client.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{}); err != nil {
+				t.Errorf("unexpected GetProviderSchema error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tf6client implements a gRPC client for providers which use
+// protocol version 5, exposed as a tfprotov6.ProviderServer.
+//
+// The client enables proxy providers, provider composition, and tooling which
+// calls provider RPCs directly, such as against a provider started with
+// managed debug mode:
+//
+//	client, err := tf6client.Dial(addr)
+//
+//	if err != nil {
+//		// handle error
+//	}
+//
+//	defer client.Close()
+//
+//	resp, err := client.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+//
+// Providers can also be connected to using the JSON format of the
+// TF_REATTACH_PROVIDERS environment variable with DialReattachProviders.
+package tf6client
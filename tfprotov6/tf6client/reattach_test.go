@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6client_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6client"
+)
+
+func TestParseReattachProviders(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         string
+		expected      map[string]*plugin.ReattachConfig
+		expectedError string
+	}{
+		"empty": {
+			value:    `{}`,
+			expected: map[string]*plugin.ReattachConfig{},
+		},
+		"unix": {
+			value: `{"registry.terraform.io/hashicorp/test":{"Protocol":"grpc","ProtocolVersion":6,"Pid":123,"Test":true,"Addr":{"Network":"unix","String":"/tmp/plugin"}}}`,
+			expected: map[string]*plugin.ReattachConfig{
+				"registry.terraform.io/hashicorp/test": {
+					Protocol:        plugin.ProtocolGRPC,
+					ProtocolVersion: 6,
+					Pid:             123,
+					Test:            true,
+					Addr:            &net.UnixAddr{Name: "/tmp/plugin", Net: "unix"},
+				},
+			},
+		},
+		"tcp-short-address": {
+			value: `{"hashicorp/test":{"Protocol":"grpc","ProtocolVersion":6,"Pid":123,"Test":true,"Addr":{"Network":"tcp","String":"127.0.0.1:1234"}}}`,
+			expected: map[string]*plugin.ReattachConfig{
+				"registry.terraform.io/hashicorp/test": {
+					Protocol:        plugin.ProtocolGRPC,
+					ProtocolVersion: 6,
+					Pid:             123,
+					Test:            true,
+					Addr:            &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234},
+				},
+			},
+		},
+		"invalid-json": {
+			value:         `{`,
+			expectedError: "Error parsing TF_REATTACH_PROVIDERS: unexpected end of JSON input",
+		},
+		"invalid-provider-address": {
+			value:         `{"registry.terraform.io/hashicorp/test/extra":{"Addr":{"Network":"unix","String":"/tmp/plugin"}}}`,
+			expectedError: `invalid provider address "registry.terraform.io/hashicorp/test/extra"`,
+		},
+		"unknown-network": {
+			value:         `{"hashicorp/test":{"Addr":{"Network":"udp","String":"127.0.0.1:1234"}}}`,
+			expectedError: `Error parsing TF_REATTACH_PROVIDERS address for "hashicorp/test": unknown address type "udp"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tf6client.ParseReattachProviders(testCase.value)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDialReattachProviders(t *testing.T) {
+	t.Parallel()
+
+	addr := testServe(t, testProviderServer{})
+	value := fmt.Sprintf(`{"registry.terraform.io/hashicorp/test":{"Protocol":"grpc","ProtocolVersion":6,"Pid":123,"Test":true,"Addr":{"Network":%q,"String":%q}}}`, addr.Network(), addr.String())
+
+	testCases := map[string]struct {
+		providerAddress string
+		expectedError   string
+	}{
+		"fully-qualified-address": {
+			providerAddress: "registry.terraform.io/hashicorp/test",
+		},
+		"short-address": {
+			providerAddress: "hashicorp/test",
+		},
+		"not-found": {
+			providerAddress: "hashicorp/other",
+			expectedError:   `provider "hashicorp/other" not found in TF_REATTACH_PROVIDERS`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := tf6client.DialReattachProviders(value, testCase.providerAddress)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			t.Cleanup(func() {
+				_ = client.Close()
+			})
+
+			if _, err := client.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{}); err != nil {
+				t.Errorf("unexpected GetProviderSchema error: %s", err)
+			}
+		})
+	}
+}
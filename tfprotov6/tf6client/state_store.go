@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6client
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/fromproto"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/toproto"
+)
+
+// defaultStateStoreChunkSize is the size in bytes of WriteStateBytes chunks
+// when ConfigureStateStore was not called for the state store type or the
+// provider did not return a chunk size, which matches the default chunk size
+// of tf6server.
+const defaultStateStoreChunkSize int64 = 1 << 20
+
+func (c *Client) ValidateStateStoreConfig(ctx context.Context, req *tfprotov6.ValidateStateStoreConfigRequest) (*tfprotov6.ValidateStateStoreConfigResponse, error) {
+	protoResp, err := c.client.ValidateStateStoreConfig(ctx, toproto.ValidateStateStoreConfig_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateStateStoreConfigResponse(protoResp)
+}
+
+// ConfigureStateStore records the chunk size returned by the provider, which
+// is used to split the state of WriteStateBytes requests for the state store
+// type.
+func (c *Client) ConfigureStateStore(ctx context.Context, req *tfprotov6.ConfigureStateStoreRequest) (*tfprotov6.ConfigureStateStoreResponse, error) {
+	protoResp, err := c.client.ConfigureStateStore(ctx, toproto.ConfigureStateStore_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if chunkSize := protoResp.Capabilities.GetChunkSize(); chunkSize > 0 && req != nil {
+		c.stateStoreChunkSizesMu.Lock()
+
+		if c.stateStoreChunkSizes == nil {
+			c.stateStoreChunkSizes = make(map[string]int64)
+		}
+
+		c.stateStoreChunkSizes[req.TypeName] = chunkSize
+
+		c.stateStoreChunkSizesMu.Unlock()
+	}
+
+	return fromproto.ConfigureStateStoreResponse(protoResp)
+}
+
+// ReadStateBytes receives all chunks of the state from the provider and
+// returns a response containing the whole state.
+func (c *Client) ReadStateBytes(ctx context.Context, req *tfprotov6.ReadStateBytesRequest) (*tfprotov6.ReadStateBytesResponse, error) {
+	stream, err := c.client.ReadStateBytes(ctx, toproto.ReadStateBytes_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	whole := &tfplugin6.ReadStateBytes_Response{}
+
+	for {
+		chunk, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		whole.Bytes = append(whole.Bytes, chunk.Bytes...)
+		whole.Diagnostics = append(whole.Diagnostics, chunk.Diagnostics...)
+	}
+
+	return fromproto.ReadStateBytesResponse(whole)
+}
+
+// WriteStateBytes splits the state into chunks of the size negotiated by
+// ConfigureStateStore and sends them to the provider.
+func (c *Client) WriteStateBytes(ctx context.Context, req *tfprotov6.WriteStateBytesRequest) (*tfprotov6.WriteStateBytesResponse, error) {
+	stream, err := c.client.WriteStateBytes(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	whole := toproto.WriteStateBytes_RequestChunk(req)
+
+	if whole == nil {
+		whole = &tfplugin6.WriteStateBytes_RequestChunk{}
+	}
+
+	for _, chunk := range splitWriteStateBytes(whole, c.stateStoreChunkSize(whole.Meta.GetTypeName())) {
+		if err := stream.Send(chunk); err != nil {
+			// The error of the stream is only returned by CloseAndRecv.
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, err
+		}
+	}
+
+	protoResp, err := stream.CloseAndRecv()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.WriteStateBytesResponse(protoResp)
+}
+
+func (c *Client) LockState(ctx context.Context, req *tfprotov6.LockStateRequest) (*tfprotov6.LockStateResponse, error) {
+	protoResp, err := c.client.LockState(ctx, toproto.LockState_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.LockStateResponse(protoResp)
+}
+
+func (c *Client) UnlockState(ctx context.Context, req *tfprotov6.UnlockStateRequest) (*tfprotov6.UnlockStateResponse, error) {
+	protoResp, err := c.client.UnlockState(ctx, toproto.UnlockState_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.UnlockStateResponse(protoResp)
+}
+
+func (c *Client) GetStates(ctx context.Context, req *tfprotov6.GetStatesRequest) (*tfprotov6.GetStatesResponse, error) {
+	protoResp, err := c.client.GetStates(ctx, toproto.GetStates_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetStatesResponse(protoResp)
+}
+
+func (c *Client) DeleteState(ctx context.Context, req *tfprotov6.DeleteStateRequest) (*tfprotov6.DeleteStateResponse, error) {
+	protoResp, err := c.client.DeleteState(ctx, toproto.DeleteState_Request(req))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromproto.DeleteStateResponse(protoResp)
+}
+
+// stateStoreChunkSize returns the chunk size negotiated for the state store
+// type, or the default chunk size if ConfigureStateStore was not called.
+func (c *Client) stateStoreChunkSize(typeName string) int64 {
+	c.stateStoreChunkSizesMu.Lock()
+	defer c.stateStoreChunkSizesMu.Unlock()
+
+	if chunkSize, ok := c.stateStoreChunkSizes[typeName]; ok {
+		return chunkSize
+	}
+
+	return defaultStateStoreChunkSize
+}
+
+// splitWriteStateBytes splits a WriteStateBytes chunk containing the whole
+// state into chunks of at most chunkSize bytes. The metadata is only sent
+// with the first chunk.
+func splitWriteStateBytes(whole *tfplugin6.WriteStateBytes_RequestChunk, chunkSize int64) []*tfplugin6.WriteStateBytes_RequestChunk {
+	totalLength := int64(len(whole.Bytes))
+
+	if totalLength <= chunkSize {
+		return []*tfplugin6.WriteStateBytes_RequestChunk{whole}
+	}
+
+	chunks := make([]*tfplugin6.WriteStateBytes_RequestChunk, 0, (totalLength+chunkSize-1)/chunkSize)
+
+	for start := int64(0); start < totalLength; start += chunkSize {
+		end := min(start+chunkSize, totalLength)
+
+		chunk := &tfplugin6.WriteStateBytes_RequestChunk{
+			Bytes: whole.Bytes[start:end],
+			Range: &tfplugin6.StateRange{
+				Start: start,
+				End:   end,
+			},
+			TotalLength: totalLength,
+		}
+
+		if start == 0 {
+			chunk.Meta = whole.Meta
+		}
+
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tf6middleware wraps a tfprotov6.ProviderServer with interceptors, so
+// cross-cutting provider logic, such as caching, validation, or logging, can
+// be layered around every RPC without gRPC-level hooks. For example:
+//
+//	server := tf6middleware.Wrap(provider, func(ctx context.Context, rpc string, req any, next tf6middleware.Handler) (any, error) {
+//		start := time.Now()
+//
+//		resp, err := next(ctx, req)
+//
+//		log.Printf("%s took %s", rpc, time.Since(start))
+//
+//		return resp, err
+//	})
+//
+// Interceptors receive the typed tfprotov6 request of each RPC, such as
+// *tfprotov6.ReadResourceRequest, and return the typed response, such as
+// *tfprotov6.ReadResourceResponse, so they can use type switches to handle
+// specific RPCs.
+package tf6middleware
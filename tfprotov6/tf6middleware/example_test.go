@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6middleware_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6middleware"
+)
+
+func ExampleWrap() {
+	// Log the type name of every data source read.
+	logging := func(ctx context.Context, rpc string, req any, next tf6middleware.Handler) (any, error) {
+		if readReq, ok := req.(*tfprotov6.ReadDataSourceRequest); ok {
+			fmt.Printf("%s: %s\n", rpc, readReq.TypeName)
+		}
+
+		return next(ctx, req)
+	}
+
+	server := tf6middleware.Wrap(testProviderServer{}, logging)
+
+	resp, _ := server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{
+		TypeName: "example_data_source",
+	})
+
+	fmt.Println(resp.Diagnostics[0].Summary)
+
+	// Output:
+	// ReadDataSource: example_data_source
+	// server example_data_source
+}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6middleware_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6middleware"
+)
+
+type testProviderServer struct {
+	tfprotov6.ProviderServer
+}
+
+func (s testProviderServer) ReadDataSource(_ context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	return &tfprotov6.ReadDataSourceResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  "server " + req.TypeName,
+			},
+		},
+	}, nil
+}
+
+type testEphemeralProviderServer struct {
+	testProviderServer
+	tfprotov6.EphemeralResourceServer
+}
+
+func (s testEphemeralProviderServer) CloseEphemeralResource(_ context.Context, req *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	return &tfprotov6.CloseEphemeralResourceResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  "server " + req.TypeName,
+			},
+		},
+	}, nil
+}
+
+// testSummaryInterceptor returns an Interceptor which appends a warning
+// diagnostic with the summary to ReadDataSource responses.
+func testSummaryInterceptor(summary string) tf6middleware.Interceptor {
+	return func(ctx context.Context, rpc string, req any, next tf6middleware.Handler) (any, error) {
+		resp, err := next(ctx, req)
+
+		if readResp, ok := resp.(*tfprotov6.ReadDataSourceResponse); ok && rpc == "ReadDataSource" {
+			readResp.Diagnostics = append(readResp.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  summary,
+			})
+		}
+
+		return resp, err
+	}
+}
+
+func TestWrap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		interceptors  []tf6middleware.Interceptor
+		expected      *tfprotov6.ReadDataSourceResponse
+		expectedError string
+	}{
+		"no-interceptors": {
+			expected: &tfprotov6.ReadDataSourceResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityWarning,
+						Summary:  "server test_data_source",
+					},
+				},
+			},
+		},
+		"order": {
+			interceptors: []tf6middleware.Interceptor{
+				testSummaryInterceptor("first"),
+				testSummaryInterceptor("second"),
+			},
+			expected: &tfprotov6.ReadDataSourceResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityWarning,
+						Summary:  "server test_data_source",
+					},
+					{
+						Severity: tfprotov6.DiagnosticSeverityWarning,
+						Summary:  "second",
+					},
+					{
+						Severity: tfprotov6.DiagnosticSeverityWarning,
+						Summary:  "first",
+					},
+				},
+			},
+		},
+		"modified-request": {
+			interceptors: []tf6middleware.Interceptor{
+				func(ctx context.Context, _ string, req any, next tf6middleware.Handler) (any, error) {
+					if readReq, ok := req.(*tfprotov6.ReadDataSourceRequest); ok {
+						req = &tfprotov6.ReadDataSourceRequest{
+							TypeName: readReq.TypeName + "_modified",
+						}
+					}
+
+					return next(ctx, req)
+				},
+			},
+			expected: &tfprotov6.ReadDataSourceResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityWarning,
+						Summary:  "server test_data_source_modified",
+					},
+				},
+			},
+		},
+		"short-circuit": {
+			interceptors: []tf6middleware.Interceptor{
+				func(_ context.Context, _ string, _ any, _ tf6middleware.Handler) (any, error) {
+					return &tfprotov6.ReadDataSourceResponse{}, nil
+				},
+				testSummaryInterceptor("not called"),
+			},
+			expected: &tfprotov6.ReadDataSourceResponse{},
+		},
+		"nil-response": {
+			interceptors: []tf6middleware.Interceptor{
+				func(_ context.Context, _ string, _ any, _ tf6middleware.Handler) (any, error) {
+					return nil, nil
+				},
+			},
+			expected: nil,
+		},
+		"invalid-request-type": {
+			interceptors: []tf6middleware.Interceptor{
+				func(ctx context.Context, _ string, _ any, next tf6middleware.Handler) (any, error) {
+					return next(ctx, &tfprotov6.ReadResourceRequest{})
+				},
+			},
+			expectedError: "ReadDataSource interceptor passed request of type *tfprotov6.ReadResourceRequest, expected *tfprotov6.ReadDataSourceRequest",
+		},
+		"invalid-response-type": {
+			interceptors: []tf6middleware.Interceptor{
+				func(_ context.Context, _ string, _ any, _ tf6middleware.Handler) (any, error) {
+					return &tfprotov6.ReadResourceResponse{}, nil
+				},
+			},
+			expectedError: "ReadDataSource interceptor returned response of type *tfprotov6.ReadResourceResponse, expected *tfprotov6.ReadDataSourceResponse",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tf6middleware.Wrap(testProviderServer{}, testCase.interceptors...)
+
+			got, err := server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{
+				TypeName: "test_data_source",
+			})
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWrap_optionalInterfaces(t *testing.T) {
+	t.Parallel()
+
+	var rpcs []string
+
+	interceptor := func(ctx context.Context, rpc string, req any, next tf6middleware.Handler) (any, error) {
+		rpcs = append(rpcs, rpc)
+
+		return next(ctx, req)
+	}
+
+	ctx := context.Background()
+	req := &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "test_ephemeral_resource",
+	}
+
+	got, err := tf6middleware.Wrap(testEphemeralProviderServer{}, interceptor).(tfprotov6.ProviderServerWithEphemeralResources).CloseEphemeralResource(ctx, req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got.Diagnostics[0].Summary, "server test_ephemeral_resource"); diff != "" {
+		t.Errorf("unexpected implemented difference: %s", diff)
+	}
+
+	got, err = tf6middleware.Wrap(testProviderServer{}, interceptor).(tfprotov6.ProviderServerWithEphemeralResources).CloseEphemeralResource(ctx, req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got.Diagnostics[0].Summary, "Provider Ephemeral Resource Not Implemented"); diff != "" {
+		t.Errorf("unexpected not implemented difference: %s", diff)
+	}
+
+	actionResp, err := tf6middleware.Wrap(testProviderServer{}, interceptor).(tfprotov6.ProviderServerWithActions).InvokeAction(ctx, &tfprotov6.InvokeActionRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var events []tfprotov6.InvokeActionEvent
+
+	actionResp.Events(func(event tfprotov6.InvokeActionEvent) bool {
+		events = append(events, event)
+
+		return true
+	})
+
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+
+	completed, ok := events[0].Type.(tfprotov6.CompletedInvokeActionEventType)
+
+	if !ok || len(completed.Diagnostics) != 1 || completed.Diagnostics[0].Summary != "Provider Action Not Implemented" {
+		t.Errorf("unexpected InvokeAction event: %#v", events[0])
+	}
+
+	stateResp, err := tf6middleware.Wrap(testProviderServer{}, interceptor).(tfprotov6.ProviderServerWithStateStores).GetStates(ctx, &tfprotov6.GetStatesRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(stateResp.Diagnostics[0].Summary, "Provider State Store Not Implemented"); diff != "" {
+		t.Errorf("unexpected state store not implemented difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(rpcs, []string{"CloseEphemeralResource", "CloseEphemeralResource", "InvokeAction", "GetStates"}); diff != "" {
+		t.Errorf("unexpected intercepted RPCs difference: %s", diff)
+	}
+}
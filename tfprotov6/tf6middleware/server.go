@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6middleware
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func (s wrappedServer) GetMetadata(ctx context.Context, req *tfprotov6.GetMetadataRequest) (*tfprotov6.GetMetadataResponse, error) {
+	return intercept(ctx, s, "GetMetadata", req, s.server.GetMetadata)
+}
+
+func (s wrappedServer) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	return intercept(ctx, s, "GetProviderSchema", req, s.server.GetProviderSchema)
+}
+
+func (s wrappedServer) ValidateProviderConfig(ctx context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
+	return intercept(ctx, s, "ValidateProviderConfig", req, s.server.ValidateProviderConfig)
+}
+
+func (s wrappedServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	return intercept(ctx, s, "ConfigureProvider", req, s.server.ConfigureProvider)
+}
+
+func (s wrappedServer) StopProvider(ctx context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	return intercept(ctx, s, "StopProvider", req, s.server.StopProvider)
+}
+
+func (s wrappedServer) ValidateDataResourceConfig(ctx context.Context, req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
+	return intercept(ctx, s, "ValidateDataResourceConfig", req, s.server.ValidateDataResourceConfig)
+}
+
+func (s wrappedServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	return intercept(ctx, s, "ReadDataSource", req, s.server.ReadDataSource)
+}
+
+func (s wrappedServer) ValidateResourceConfig(ctx context.Context, req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
+	return intercept(ctx, s, "ValidateResourceConfig", req, s.server.ValidateResourceConfig)
+}
+
+func (s wrappedServer) UpgradeResourceState(ctx context.Context, req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
+	return intercept(ctx, s, "UpgradeResourceState", req, s.server.UpgradeResourceState)
+}
+
+func (s wrappedServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	return intercept(ctx, s, "ReadResource", req, s.server.ReadResource)
+}
+
+func (s wrappedServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	return intercept(ctx, s, "PlanResourceChange", req, s.server.PlanResourceChange)
+}
+
+func (s wrappedServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	return intercept(ctx, s, "ApplyResourceChange", req, s.server.ApplyResourceChange)
+}
+
+func (s wrappedServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	return intercept(ctx, s, "ImportResourceState", req, s.server.ImportResourceState)
+}
+
+func (s wrappedServer) MoveResourceState(ctx context.Context, req *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error) {
+	return intercept(ctx, s, "MoveResourceState", req, s.server.MoveResourceState)
+}
+
+func (s wrappedServer) CallFunction(ctx context.Context, req *tfprotov6.CallFunctionRequest) (*tfprotov6.CallFunctionResponse, error) {
+	return intercept(ctx, s, "CallFunction", req, s.server.CallFunction)
+}
+
+func (s wrappedServer) GetFunctions(ctx context.Context, req *tfprotov6.GetFunctionsRequest) (*tfprotov6.GetFunctionsResponse, error) {
+	return intercept(ctx, s, "GetFunctions", req, s.server.GetFunctions)
+}
+
+func (s wrappedServer) ValidateEphemeralResourceConfig(ctx context.Context, req *tfprotov6.ValidateEphemeralResourceConfigRequest) (*tfprotov6.ValidateEphemeralResourceConfigResponse, error) {
+	return intercept(ctx, s, "ValidateEphemeralResourceConfig", req, s.ephemeralResources.ValidateEphemeralResourceConfig)
+}
+
+func (s wrappedServer) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	return intercept(ctx, s, "OpenEphemeralResource", req, s.ephemeralResources.OpenEphemeralResource)
+}
+
+func (s wrappedServer) RenewEphemeralResource(ctx context.Context, req *tfprotov6.RenewEphemeralResourceRequest) (*tfprotov6.RenewEphemeralResourceResponse, error) {
+	return intercept(ctx, s, "RenewEphemeralResource", req, s.ephemeralResources.RenewEphemeralResource)
+}
+
+func (s wrappedServer) CloseEphemeralResource(ctx context.Context, req *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	return intercept(ctx, s, "CloseEphemeralResource", req, s.ephemeralResources.CloseEphemeralResource)
+}
+
+func (s wrappedServer) ValidateActionConfig(ctx context.Context, req *tfprotov6.ValidateActionConfigRequest) (*tfprotov6.ValidateActionConfigResponse, error) {
+	return intercept(ctx, s, "ValidateActionConfig", req, s.actions.ValidateActionConfig)
+}
+
+func (s wrappedServer) InvokeAction(ctx context.Context, req *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionResponse, error) {
+	return intercept(ctx, s, "InvokeAction", req, s.actions.InvokeAction)
+}
+
+func (s wrappedServer) ValidateStateStoreConfig(ctx context.Context, req *tfprotov6.ValidateStateStoreConfigRequest) (*tfprotov6.ValidateStateStoreConfigResponse, error) {
+	return intercept(ctx, s, "ValidateStateStoreConfig", req, s.stateStores.ValidateStateStoreConfig)
+}
+
+func (s wrappedServer) ConfigureStateStore(ctx context.Context, req *tfprotov6.ConfigureStateStoreRequest) (*tfprotov6.ConfigureStateStoreResponse, error) {
+	return intercept(ctx, s, "ConfigureStateStore", req, s.stateStores.ConfigureStateStore)
+}
+
+func (s wrappedServer) ReadStateBytes(ctx context.Context, req *tfprotov6.ReadStateBytesRequest) (*tfprotov6.ReadStateBytesResponse, error) {
+	return intercept(ctx, s, "ReadStateBytes", req, s.stateStores.ReadStateBytes)
+}
+
+func (s wrappedServer) WriteStateBytes(ctx context.Context, req *tfprotov6.WriteStateBytesRequest) (*tfprotov6.WriteStateBytesResponse, error) {
+	return intercept(ctx, s, "WriteStateBytes", req, s.stateStores.WriteStateBytes)
+}
+
+func (s wrappedServer) LockState(ctx context.Context, req *tfprotov6.LockStateRequest) (*tfprotov6.LockStateResponse, error) {
+	return intercept(ctx, s, "LockState", req, s.stateStores.LockState)
+}
+
+func (s wrappedServer) UnlockState(ctx context.Context, req *tfprotov6.UnlockStateRequest) (*tfprotov6.UnlockStateResponse, error) {
+	return intercept(ctx, s, "UnlockState", req, s.stateStores.UnlockState)
+}
+
+func (s wrappedServer) GetStates(ctx context.Context, req *tfprotov6.GetStatesRequest) (*tfprotov6.GetStatesResponse, error) {
+	return intercept(ctx, s, "GetStates", req, s.stateStores.GetStates)
+}
+
+func (s wrappedServer) DeleteState(ctx context.Context, req *tfprotov6.DeleteStateRequest) (*tfprotov6.DeleteStateResponse, error) {
+	return intercept(ctx, s, "DeleteState", req, s.stateStores.DeleteState)
+}
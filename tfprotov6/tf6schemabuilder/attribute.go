@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributeBuilder builds a tfprotov6.SchemaAttribute. Create one with the
+// Attribute or NestedAttribute functions.
+type AttributeBuilder struct {
+	attribute *tfprotov6.SchemaAttribute
+}
+
+// Attribute returns a new AttributeBuilder for an attribute with the given
+// name and type. One of Required, Optional, or Computed must be called for
+// the attribute to be valid.
+func Attribute(name string, typ tftypes.Type) *AttributeBuilder {
+	return &AttributeBuilder{
+		attribute: &tfprotov6.SchemaAttribute{
+			Name: name,
+			Type: typ,
+		},
+	}
+}
+
+// NestedAttribute returns a new AttributeBuilder for an attribute with the
+// given name, whose type is an object of the given attributes in the given
+// nesting mode. One of Required, Optional, or Computed must be called for the
+// attribute to be valid.
+func NestedAttribute(name string, nesting tfprotov6.SchemaObjectNestingMode, attributes ...*AttributeBuilder) *AttributeBuilder {
+	nestedType := &tfprotov6.SchemaObject{
+		Nesting: nesting,
+	}
+
+	for _, attribute := range attributes {
+		nestedType.Attributes = append(nestedType.Attributes, attribute.schemaAttribute())
+	}
+
+	return &AttributeBuilder{
+		attribute: &tfprotov6.SchemaAttribute{
+			Name:       name,
+			NestedType: nestedType,
+		},
+	}
+}
+
+// Required marks the attribute as required in configuration.
+func (b *AttributeBuilder) Required() *AttributeBuilder {
+	b.attribute.Required = true
+
+	return b
+}
+
+// Optional marks the attribute as optional in configuration.
+func (b *AttributeBuilder) Optional() *AttributeBuilder {
+	b.attribute.Optional = true
+
+	return b
+}
+
+// Computed marks the attribute as set by the provider. It can be combined
+// with Optional to allow the provider to set a value when configuration does
+// not.
+func (b *AttributeBuilder) Computed() *AttributeBuilder {
+	b.attribute.Computed = true
+
+	return b
+}
+
+// Sensitive marks the attribute value as sensitive in Terraform output.
+func (b *AttributeBuilder) Sensitive() *AttributeBuilder {
+	b.attribute.Sensitive = true
+
+	return b
+}
+
+// Deprecated marks the attribute as deprecated.
+func (b *AttributeBuilder) Deprecated() *AttributeBuilder {
+	b.attribute.Deprecated = true
+
+	return b
+}
+
+// Description sets the plaintext description of the attribute.
+func (b *AttributeBuilder) Description(description string) *AttributeBuilder {
+	b.attribute.Description = description
+	b.attribute.DescriptionKind = tfprotov6.StringKindPlain
+
+	return b
+}
+
+// MarkdownDescription sets the markdown description of the attribute.
+func (b *AttributeBuilder) MarkdownDescription(description string) *AttributeBuilder {
+	b.attribute.Description = description
+	b.attribute.DescriptionKind = tfprotov6.StringKindMarkdown
+
+	return b
+}
+
+// schemaAttribute returns the attribute being built, or nil if the builder is
+// nil, which is reported when the schema is validated.
+func (b *AttributeBuilder) schemaAttribute() *tfprotov6.SchemaAttribute {
+	if b == nil {
+		return nil
+	}
+
+	return b.attribute
+}
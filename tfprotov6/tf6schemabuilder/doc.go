@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tf6schemabuilder provides chainable constructors for building
+// tfprotov6.Schema, as an alternative to authoring large schemas as nested
+// struct literals. For example:
+//
+//	schema, err := tf6schemabuilder.Schema().
+//		Attributes(
+//			tf6schemabuilder.Attribute("id", tftypes.String).Computed(),
+//			tf6schemabuilder.Attribute("name", tftypes.String).Required(),
+//		).
+//		Blocks(
+//			tf6schemabuilder.Block("rule", tfprotov6.SchemaNestedBlockNestingModeList).
+//				MaxItems(10).
+//				Attributes(
+//					tf6schemabuilder.Attribute("priority", tftypes.Number).Required(),
+//				),
+//		).
+//		Build()
+//
+// Builders modify and return themselves, so they should not be shared between
+// schemas while being built. Built schemas are validated and are not affected
+// by further builder changes.
+package tf6schemabuilder
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6schemabuilder
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// SchemaBuilder builds a tfprotov6.Schema. Create one with the Schema
+// function.
+type SchemaBuilder struct {
+	schema *tfprotov6.Schema
+	block  *BlockBuilder
+}
+
+// Schema returns a new SchemaBuilder for a schema with version 0 and an empty
+// root block.
+func Schema() *SchemaBuilder {
+	block := Block("", tfprotov6.SchemaNestedBlockNestingModeInvalid)
+
+	return &SchemaBuilder{
+		schema: &tfprotov6.Schema{
+			Block: block.nestedBlock.Block,
+		},
+		block: block,
+	}
+}
+
+// Version sets the version of the schema, which is used for state upgrades.
+func (b *SchemaBuilder) Version(version int64) *SchemaBuilder {
+	b.schema.Version = version
+
+	return b
+}
+
+// Description sets the plaintext description of the root block.
+func (b *SchemaBuilder) Description(description string) *SchemaBuilder {
+	b.block.Description(description)
+
+	return b
+}
+
+// MarkdownDescription sets the markdown description of the root block.
+func (b *SchemaBuilder) MarkdownDescription(description string) *SchemaBuilder {
+	b.block.MarkdownDescription(description)
+
+	return b
+}
+
+// Deprecated marks the root block as deprecated.
+func (b *SchemaBuilder) Deprecated() *SchemaBuilder {
+	b.block.Deprecated()
+
+	return b
+}
+
+// Attributes adds attributes to the root block.
+func (b *SchemaBuilder) Attributes(attributes ...*AttributeBuilder) *SchemaBuilder {
+	b.block.Attributes(attributes...)
+
+	return b
+}
+
+// Blocks adds nested blocks to the root block.
+func (b *SchemaBuilder) Blocks(blocks ...*BlockBuilder) *SchemaBuilder {
+	b.block.Blocks(blocks...)
+
+	return b
+}
+
+// Build returns a copy of the built schema, or an error describing every
+// protocol violation reported by tfprotov6.Schema.Validate.
+func (b *SchemaBuilder) Build() (*tfprotov6.Schema, error) {
+	schema := b.schema.Copy()
+
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+// MustBuild returns a copy of the built schema, panicking if it is invalid.
+// It is intended for schemas which are known to be valid at compile time,
+// such as those declared in provider code which is covered by unit testing.
+func (b *SchemaBuilder) MustBuild() *tfprotov6.Schema {
+	schema, err := b.Build()
+
+	if err != nil {
+		panic("invalid schema: " + err.Error())
+	}
+
+	return schema
+}
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6schemabuilder_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6schemabuilder"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaBuilderBuild(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		builder       *tf6schemabuilder.SchemaBuilder
+		expected      *tfprotov6.Schema
+		expectedError string
+	}{
+		"empty": {
+			builder: tf6schemabuilder.Schema(),
+			expected: &tfprotov6.Schema{
+				Block: &tfprotov6.SchemaBlock{},
+			},
+		},
+		"schema": {
+			builder: tf6schemabuilder.Schema().
+				Version(2).
+				MarkdownDescription("test *description*").
+				Deprecated().
+				Attributes(
+					tf6schemabuilder.Attribute("id", tftypes.String).Computed(),
+					tf6schemabuilder.Attribute("name", tftypes.String).Required().Description("test name"),
+					tf6schemabuilder.Attribute("password", tftypes.String).Optional().Sensitive(),
+					tf6schemabuilder.Attribute("tags", tftypes.Map{ElementType: tftypes.String}).
+						Optional().
+						Computed().
+						Deprecated().
+						MarkdownDescription("test *tags*"),
+					tf6schemabuilder.NestedAttribute(
+						"settings",
+						tfprotov6.SchemaObjectNestingModeList,
+						tf6schemabuilder.Attribute("enabled", tftypes.Bool).Required(),
+					).Optional(),
+				).
+				Blocks(
+					tf6schemabuilder.Block("rule", tfprotov6.SchemaNestedBlockNestingModeSet).
+						MinItems(1).
+						MaxItems(10).
+						Description("test rule").
+						Attributes(
+							tf6schemabuilder.Attribute("priority", tftypes.Number).Required(),
+						).
+						Blocks(
+							tf6schemabuilder.Block("filter", tfprotov6.SchemaNestedBlockNestingModeSingle).
+								MarkdownDescription("test *filter*").
+								Deprecated(),
+						),
+				),
+			expected: &tfprotov6.Schema{
+				Version: 2,
+				Block: &tfprotov6.SchemaBlock{
+					Description:     "test *description*",
+					DescriptionKind: tfprotov6.StringKindMarkdown,
+					Deprecated:      true,
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "id",
+							Type:     tftypes.String,
+							Computed: true,
+						},
+						{
+							Name:        "name",
+							Type:        tftypes.String,
+							Required:    true,
+							Description: "test name",
+						},
+						{
+							Name:      "password",
+							Type:      tftypes.String,
+							Optional:  true,
+							Sensitive: true,
+						},
+						{
+							Name:            "tags",
+							Type:            tftypes.Map{ElementType: tftypes.String},
+							Optional:        true,
+							Computed:        true,
+							Deprecated:      true,
+							Description:     "test *tags*",
+							DescriptionKind: tfprotov6.StringKindMarkdown,
+						},
+						{
+							Name:     "settings",
+							Optional: true,
+							NestedType: &tfprotov6.SchemaObject{
+								Nesting: tfprotov6.SchemaObjectNestingModeList,
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{
+										Name:     "enabled",
+										Type:     tftypes.Bool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+					BlockTypes: []*tfprotov6.SchemaNestedBlock{
+						{
+							TypeName: "rule",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeSet,
+							MinItems: 1,
+							MaxItems: 10,
+							Block: &tfprotov6.SchemaBlock{
+								Description: "test rule",
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{
+										Name:     "priority",
+										Type:     tftypes.Number,
+										Required: true,
+									},
+								},
+								BlockTypes: []*tfprotov6.SchemaNestedBlock{
+									{
+										TypeName: "filter",
+										Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+										Block: &tfprotov6.SchemaBlock{
+											Description:     "test *filter*",
+											DescriptionKind: tfprotov6.StringKindMarkdown,
+											Deprecated:      true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"invalid": {
+			builder: tf6schemabuilder.Schema().
+				Attributes(
+					nil,
+					tf6schemabuilder.Attribute("no_flags", tftypes.String),
+				).
+				Blocks(
+					tf6schemabuilder.Block("invalid", tfprotov6.SchemaNestedBlockNestingModeMap).MaxItems(1),
+				),
+			expectedError: "root block: attribute is nil\n" +
+				`attribute "no_flags": one of Required, Optional, or Computed must be set` + "\n" +
+				`block "invalid": MinItems and MaxItems must be 0 in MAP nesting mode`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.builder.Build()
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error:\n%s\ngot:\n%s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaBuilderBuild_Independent(t *testing.T) {
+	t.Parallel()
+
+	attribute := tf6schemabuilder.Attribute("test", tftypes.String).Optional()
+	builder := tf6schemabuilder.Schema().Attributes(attribute)
+
+	got, err := builder.Build()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	attribute.Sensitive()
+	builder.Version(1).Attributes(tf6schemabuilder.Attribute("other", tftypes.String).Computed())
+
+	expected := &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:     "test",
+					Type:     tftypes.String,
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestSchemaBuilderMustBuild(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic, got none")
+		}
+	}()
+
+	tf6schemabuilder.Schema().
+		Attributes(tf6schemabuilder.Attribute("no_flags", tftypes.String)).
+		MustBuild()
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6schemajson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// FunctionSignature is the JSON representation of a tfprotov6.Function.
+type FunctionSignature struct {
+	Description        string               `json:"description,omitempty"`
+	Summary            string               `json:"summary,omitempty"`
+	DeprecationMessage string               `json:"deprecation_message,omitempty"`
+	ReturnType         json.RawMessage      `json:"return_type"`
+	Parameters         []*FunctionParameter `json:"parameters,omitempty"`
+	VariadicParameter  *FunctionParameter   `json:"variadic_parameter,omitempty"`
+}
+
+// FunctionParameter is the JSON representation of a
+// tfprotov6.FunctionParameter.
+type FunctionParameter struct {
+	Name        string          `json:"name,omitempty"`
+	Description string          `json:"description,omitempty"`
+	IsNullable  bool            `json:"is_nullable,omitempty"`
+	Type        json.RawMessage `json:"type"`
+}
+
+// NewFunctionSignature returns the JSON representation of a
+// tfprotov6.Function. It returns an error if the function cannot be
+// represented, such as a missing return or parameter type.
+func NewFunctionSignature(function *tfprotov6.Function) (*FunctionSignature, error) {
+	if function == nil {
+		return nil, errors.New("function is nil")
+	}
+
+	if function.Return == nil {
+		return nil, errors.New("return is nil")
+	}
+
+	returnType, err := typeJSON(function.Return.Type)
+
+	if err != nil {
+		return nil, fmt.Errorf("return: %w", err)
+	}
+
+	result := &FunctionSignature{
+		Description:        function.Description,
+		Summary:            function.Summary,
+		DeprecationMessage: function.DeprecationMessage,
+		ReturnType:         returnType,
+	}
+
+	for position, parameter := range function.Parameters {
+		jsonParameter, err := newFunctionParameter(parameter)
+
+		if err != nil {
+			return nil, fmt.Errorf("parameter %d: %w", position, err)
+		}
+
+		result.Parameters = append(result.Parameters, jsonParameter)
+	}
+
+	if function.VariadicParameter != nil {
+		result.VariadicParameter, err = newFunctionParameter(function.VariadicParameter)
+
+		if err != nil {
+			return nil, fmt.Errorf("variadic parameter: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// newFunctionParameter returns the JSON representation of a function
+// parameter.
+func newFunctionParameter(parameter *tfprotov6.FunctionParameter) (*FunctionParameter, error) {
+	if parameter == nil {
+		return nil, errors.New("parameter is nil")
+	}
+
+	parameterType, err := typeJSON(parameter.Type)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &FunctionParameter{
+		Name:        parameter.Name,
+		Description: parameter.Description,
+		IsNullable:  parameter.AllowNullValue,
+		Type:        parameterType,
+	}, nil
+}
@@ -0,0 +1,433 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6schemajson_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6schemajson"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	testSchema := &tfprotov6.Schema{
+		Version: 1,
+		Block: &tfprotov6.SchemaBlock{
+			Description:     "test *block*",
+			DescriptionKind: tfprotov6.StringKindMarkdown,
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name:     "id",
+					Type:     tftypes.String,
+					Computed: true,
+				},
+				{
+					Name:        "tags",
+					Type:        tftypes.Map{ElementType: tftypes.String},
+					Description: "test tags",
+					Optional:    true,
+					Sensitive:   true,
+					Deprecated:  true,
+				},
+				{
+					Name:     "settings",
+					Optional: true,
+					NestedType: &tfprotov6.SchemaObject{
+						Nesting: tfprotov6.SchemaObjectNestingModeSet,
+						Attributes: []*tfprotov6.SchemaAttribute{
+							{
+								Name:     "enabled",
+								Type:     tftypes.Bool,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+			BlockTypes: []*tfprotov6.SchemaNestedBlock{
+				{
+					TypeName: "rule",
+					Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+					MinItems: 1,
+					MaxItems: 2,
+					Block: &tfprotov6.SchemaBlock{
+						Attributes: []*tfprotov6.SchemaAttribute{
+							{
+								Name:     "priority",
+								Type:     tftypes.Number,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		resp          *tfprotov6.GetProviderSchemaResponse
+		expected      string
+		expectedError string
+	}{
+		"empty": {
+			resp: &tfprotov6.GetProviderSchemaResponse{},
+			expected: `{
+				"format_version": "1.0",
+				"provider_schemas": {
+					"registry.terraform.io/hashicorp/test": {}
+				}
+			}`,
+		},
+		"schemas": {
+			resp: &tfprotov6.GetProviderSchemaResponse{
+				Provider: &tfprotov6.Schema{
+					Block: &tfprotov6.SchemaBlock{},
+				},
+				ProviderMeta: &tfprotov6.Schema{
+					Block: &tfprotov6.SchemaBlock{},
+				},
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource": testSchema,
+				},
+				DataSourceSchemas: map[string]*tfprotov6.Schema{
+					"test_data_source": {
+						Block: &tfprotov6.SchemaBlock{
+							BlockTypes: []*tfprotov6.SchemaNestedBlock{
+								{
+									TypeName: "group",
+									Nesting:  tfprotov6.SchemaNestedBlockNestingModeGroup,
+									Block:    &tfprotov6.SchemaBlock{},
+								},
+							},
+						},
+					},
+				},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_ephemeral_resource": {
+						Block: &tfprotov6.SchemaBlock{},
+					},
+				},
+				ActionSchemas: map[string]*tfprotov6.ActionSchema{
+					"test_action": {
+						Schema: &tfprotov6.Schema{
+							Block: &tfprotov6.SchemaBlock{},
+						},
+					},
+				},
+				StateStoreSchemas: map[string]*tfprotov6.Schema{
+					"test_state_store": {
+						Block: &tfprotov6.SchemaBlock{},
+					},
+				},
+				Functions: map[string]*tfprotov6.Function{
+					"test_function": {
+						Summary:            "test summary",
+						Description:        "test description",
+						DeprecationMessage: "test deprecation",
+						Parameters: []*tfprotov6.FunctionParameter{
+							{
+								Name:           "input",
+								Description:    "test input",
+								AllowNullValue: true,
+								Type:           tftypes.String,
+							},
+						},
+						VariadicParameter: &tfprotov6.FunctionParameter{
+							Name: "rest",
+							Type: tftypes.List{ElementType: tftypes.Bool},
+						},
+						Return: &tfprotov6.FunctionReturn{
+							Type: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"result": tftypes.DynamicPseudoType,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: `{
+				"format_version": "1.0",
+				"provider_schemas": {
+					"registry.terraform.io/hashicorp/test": {
+						"provider": {
+							"version": 0,
+							"block": {
+								"description_kind": "plain"
+							}
+						},
+						"resource_schemas": {
+							"test_resource": {
+								"version": 1,
+								"block": {
+									"attributes": {
+										"id": {
+											"type": "string",
+											"description_kind": "plain",
+											"computed": true
+										},
+										"settings": {
+											"nested_type": {
+												"attributes": {
+													"enabled": {
+														"type": "bool",
+														"description_kind": "plain",
+														"required": true
+													}
+												},
+												"nesting_mode": "set"
+											},
+											"description_kind": "plain",
+											"optional": true
+										},
+										"tags": {
+											"type": ["map", "string"],
+											"description": "test tags",
+											"description_kind": "plain",
+											"deprecated": true,
+											"optional": true,
+											"sensitive": true
+										}
+									},
+									"block_types": {
+										"rule": {
+											"nesting_mode": "list",
+											"block": {
+												"attributes": {
+													"priority": {
+														"type": "number",
+														"description_kind": "plain",
+														"required": true
+													}
+												},
+												"description_kind": "plain"
+											},
+											"min_items": 1,
+											"max_items": 2
+										}
+									},
+									"description": "test *block*",
+									"description_kind": "markdown"
+								}
+							}
+						},
+						"data_source_schemas": {
+							"test_data_source": {
+								"version": 0,
+								"block": {
+									"block_types": {
+										"group": {
+											"nesting_mode": "group",
+											"block": {
+												"description_kind": "plain"
+											}
+										}
+									},
+									"description_kind": "plain"
+								}
+							}
+						},
+						"ephemeral_resource_schemas": {
+							"test_ephemeral_resource": {
+								"version": 0,
+								"block": {
+									"description_kind": "plain"
+								}
+							}
+						},
+						"functions": {
+							"test_function": {
+								"description": "test description",
+								"summary": "test summary",
+								"deprecation_message": "test deprecation",
+								"return_type": ["object", {"result": "dynamic"}],
+								"parameters": [
+									{
+										"name": "input",
+										"description": "test input",
+										"is_nullable": true,
+										"type": "string"
+									}
+								],
+								"variadic_parameter": {
+									"name": "rest",
+									"type": ["list", "bool"]
+								}
+							}
+						},
+						"action_schemas": {
+							"test_action": {
+								"block": {
+									"description_kind": "plain"
+								}
+							}
+						},
+						"state_store_schemas": {
+							"test_state_store": {
+								"version": 0,
+								"block": {
+									"description_kind": "plain"
+								}
+							}
+						}
+					}
+				}
+			}`,
+		},
+		"nil": {
+			resp:          nil,
+			expectedError: "GetProviderSchema response is nil",
+		},
+		"error-diagnostics": {
+			resp: &tfprotov6.GetProviderSchemaResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					tfprotov6.NewErrorDiagnostic("test summary", "test detail"),
+				},
+			},
+			expectedError: "GetProviderSchema response contains error diagnostics: test summary: test detail",
+		},
+		"nil-schema": {
+			resp: &tfprotov6.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource": nil,
+				},
+			},
+			expectedError: `resource "test_resource": schema is nil`,
+		},
+		"nil-attribute-type": {
+			resp: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{
+					"test_data_source": {
+						Block: &tfprotov6.SchemaBlock{
+							BlockTypes: []*tfprotov6.SchemaNestedBlock{
+								{
+									TypeName: "nested",
+									Nesting:  tfprotov6.SchemaNestedBlockNestingModeSet,
+									Block: &tfprotov6.SchemaBlock{
+										Attributes: []*tfprotov6.SchemaAttribute{
+											{
+												Name:     "test",
+												Optional: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: `data source "test_data_source": block "nested": attribute "test": type is nil`,
+		},
+		"invalid-nesting-mode": {
+			resp: &tfprotov6.GetProviderSchemaResponse{
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_ephemeral_resource": {
+						Block: &tfprotov6.SchemaBlock{
+							BlockTypes: []*tfprotov6.SchemaNestedBlock{
+								{
+									TypeName: "nested",
+									Block:    &tfprotov6.SchemaBlock{},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: `ephemeral resource "test_ephemeral_resource": block "nested": invalid nesting mode INVALID`,
+		},
+		"invalid-nested-type": {
+			resp: &tfprotov6.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name:     "nested",
+									Optional: true,
+									NestedType: &tfprotov6.SchemaObject{
+										Nesting: tfprotov6.SchemaObjectNestingModeList,
+										Attributes: []*tfprotov6.SchemaAttribute{
+											{
+												Name:     "test",
+												Optional: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: `resource "test_resource": attribute "nested": attribute "test": type is nil`,
+		},
+		"nil-function-return": {
+			resp: &tfprotov6.GetProviderSchemaResponse{
+				Functions: map[string]*tfprotov6.Function{
+					"test_function": {},
+				},
+			},
+			expectedError: `function "test_function": return is nil`,
+		},
+		"nil-function-parameter-type": {
+			resp: &tfprotov6.GetProviderSchemaResponse{
+				Functions: map[string]*tfprotov6.Function{
+					"test_function": {
+						Parameters: []*tfprotov6.FunctionParameter{
+							{
+								Name: "input",
+							},
+						},
+						Return: &tfprotov6.FunctionReturn{
+							Type: tftypes.String,
+						},
+					},
+				},
+			},
+			expectedError: `function "test_function": parameter 0: type is nil`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tf6schemajson.Marshal("registry.terraform.io/hashicorp/test", testCase.resp)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var expected bytes.Buffer
+
+			if err := json.Compact(&expected, []byte(testCase.expected)); err != nil {
+				t.Fatalf("unable to compact expected JSON: %s", err)
+			}
+
+			if string(got) != expected.String() {
+				t.Errorf("expected JSON:\n%s\ngot:\n%s", expected.String(), got)
+			}
+		})
+	}
+}
@@ -0,0 +1,434 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+)
+
+type testActionProviderServer struct {
+	tfprotov6.ProviderServer
+
+	events func(yield func(tfprotov6.InvokeActionEvent) bool)
+	err    error
+}
+
+func (s testActionProviderServer) ValidateActionConfig(ctx context.Context, _ *tfprotov6.ValidateActionConfigRequest) (*tfprotov6.ValidateActionConfigResponse, error) {
+	actionType, _ := tf6server.ActionTypeFromContext(ctx)
+
+	return &tfprotov6.ValidateActionConfigResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  actionType,
+			},
+		},
+	}, nil
+}
+
+func (s testActionProviderServer) InvokeAction(_ context.Context, _ *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return &tfprotov6.InvokeActionResponse{
+		Events: s.events,
+	}, nil
+}
+
+// testInvokeActionStream is a grpc.ServerStreamingServer which records sent
+// InvokeAction events.
+type testInvokeActionStream struct {
+	grpc.ServerStream
+
+	events  []*tfplugin6.InvokeAction_Event
+	sendErr error
+}
+
+func (s *testInvokeActionStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *testInvokeActionStream) Send(event *tfplugin6.InvokeAction_Event) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+
+	s.events = append(s.events, event)
+
+	return nil
+}
+
+// testInvokeActionEvents returns an InvokeAction events iterator of the
+// given events.
+func testInvokeActionEvents(events ...tfprotov6.InvokeActionEvent) func(yield func(tfprotov6.InvokeActionEvent) bool) {
+	return func(yield func(tfprotov6.InvokeActionEvent) bool) {
+		for _, event := range events {
+			if !yield(event) {
+				return
+			}
+		}
+	}
+}
+
+func testProgressInvokeActionEvent(message string) *tfplugin6.InvokeAction_Event {
+	return &tfplugin6.InvokeAction_Event{
+		Type: &tfplugin6.InvokeAction_Event_Progress_{
+			Progress: &tfplugin6.InvokeAction_Event_Progress{
+				Message: message,
+			},
+		},
+	}
+}
+
+func testCompletedInvokeActionEvent(diags ...*tfplugin6.Diagnostic) *tfplugin6.InvokeAction_Event {
+	return &tfplugin6.InvokeAction_Event{
+		Type: &tfplugin6.InvokeAction_Event_Completed_{
+			Completed: &tfplugin6.InvokeAction_Event_Completed{
+				Diagnostics: diags,
+			},
+		},
+	}
+}
+
+func TestValidateActionConfig(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testActionProviderServer{})
+
+	got, err := server.ValidateActionConfig(context.Background(), &tfplugin6.ValidateActionConfig_Request{
+		ActionType: "test_action",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfplugin6.ValidateActionConfig_Response{
+		Diagnostics: []*tfplugin6.Diagnostic{
+			{
+				Severity: tfplugin6.Diagnostic_WARNING,
+				Summary:  "test_action",
+			},
+		},
+	}
+
+	if !proto.Equal(got, expected) {
+		t.Errorf("expected response %s, got %s", expected, got)
+	}
+}
+
+func TestInvokeAction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		downstream testActionProviderServer
+		expected   []*tfplugin6.InvokeAction_Event
+	}{
+		"events": {
+			downstream: testActionProviderServer{
+				events: testInvokeActionEvents(
+					tfprotov6.InvokeActionEvent{
+						Type: tfprotov6.ProgressInvokeActionEventType{
+							Message: "test progress 1",
+						},
+					},
+					tfprotov6.InvokeActionEvent{
+						Type: tfprotov6.ProgressInvokeActionEventType{
+							Message: "test progress 2",
+						},
+					},
+					tfprotov6.InvokeActionEvent{
+						Type: tfprotov6.CompletedInvokeActionEventType{
+							Diagnostics: []*tfprotov6.Diagnostic{
+								{
+									Severity: tfprotov6.DiagnosticSeverityWarning,
+									Summary:  "test summary",
+								},
+							},
+						},
+					},
+				),
+			},
+			expected: []*tfplugin6.InvokeAction_Event{
+				testProgressInvokeActionEvent("test progress 1"),
+				testProgressInvokeActionEvent("test progress 2"),
+				testCompletedInvokeActionEvent(&tfplugin6.Diagnostic{
+					Severity: tfplugin6.Diagnostic_WARNING,
+					Summary:  "test summary",
+				}),
+			},
+		},
+		"events-after-completed": {
+			downstream: testActionProviderServer{
+				events: testInvokeActionEvents(
+					tfprotov6.InvokeActionEvent{
+						Type: tfprotov6.CompletedInvokeActionEventType{},
+					},
+					tfprotov6.InvokeActionEvent{
+						Type: tfprotov6.ProgressInvokeActionEventType{
+							Message: "test progress",
+						},
+					},
+				),
+			},
+			expected: []*tfplugin6.InvokeAction_Event{
+				testCompletedInvokeActionEvent(),
+			},
+		},
+		"missing-completed": {
+			downstream: testActionProviderServer{
+				events: testInvokeActionEvents(
+					tfprotov6.InvokeActionEvent{
+						Type: tfprotov6.ProgressInvokeActionEventType{
+							Message: "test progress",
+						},
+					},
+				),
+			},
+			expected: []*tfplugin6.InvokeAction_Event{
+				testProgressInvokeActionEvent("test progress"),
+				testCompletedInvokeActionEvent(),
+			},
+		},
+		"nil-events": {
+			downstream: testActionProviderServer{},
+			expected: []*tfplugin6.InvokeAction_Event{
+				testCompletedInvokeActionEvent(),
+			},
+		},
+		"unknown-event-type": {
+			downstream: testActionProviderServer{
+				events: testInvokeActionEvents(
+					tfprotov6.InvokeActionEvent{},
+					tfprotov6.InvokeActionEvent{
+						Type: tfprotov6.CompletedInvokeActionEventType{},
+					},
+				),
+			},
+			expected: []*tfplugin6.InvokeAction_Event{
+				testCompletedInvokeActionEvent(),
+			},
+		},
+		"downstream-joined-errors": {
+			downstream: testActionProviderServer{
+				err: errors.Join(errors.New("test error 1"), errors.New("test error 2")),
+			},
+			expected: []*tfplugin6.InvokeAction_Event{
+				testCompletedInvokeActionEvent(
+					&tfplugin6.Diagnostic{
+						Severity: tfplugin6.Diagnostic_ERROR,
+						Summary:  "Provider Error",
+						Detail:   "test error 1",
+					},
+					&tfplugin6.Diagnostic{
+						Severity: tfplugin6.Diagnostic_ERROR,
+						Summary:  "Provider Error",
+						Detail:   "test error 2",
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tf6server.New("registry.terraform.io/hashicorp/test", testCase.downstream)
+			stream := &testInvokeActionStream{}
+
+			err := server.InvokeAction(&tfplugin6.InvokeAction_Request{
+				ActionType: "test_action",
+			}, stream)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(stream.events, testCase.expected, cmpopts.EquateEmpty(), protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInvokeActionErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		downstream testActionProviderServer
+		sendErr    error
+		expected   string
+	}{
+		"downstream-error": {
+			downstream: testActionProviderServer{
+				err: errors.New("test error"),
+			},
+			expected: "test error",
+		},
+		"send-error": {
+			downstream: testActionProviderServer{
+				events: testInvokeActionEvents(
+					tfprotov6.InvokeActionEvent{
+						Type: tfprotov6.ProgressInvokeActionEventType{
+							Message: "test progress",
+						},
+					},
+				),
+			},
+			sendErr:  errors.New("test send error"),
+			expected: "test send error",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tf6server.New("registry.terraform.io/hashicorp/test", testCase.downstream)
+			stream := &testInvokeActionStream{
+				sendErr: testCase.sendErr,
+			}
+
+			err := server.InvokeAction(&tfplugin6.InvokeAction_Request{
+				ActionType: "test_action",
+			}, stream)
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expected)
+			}
+
+			if err.Error() != testCase.expected {
+				t.Errorf("expected error %q, got %q", testCase.expected, err)
+			}
+		})
+	}
+}
+
+func TestActionNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testContextProviderServer{})
+
+	responses := map[string]func() ([]*tfplugin6.Diagnostic, error){
+		"ValidateActionConfig": func() ([]*tfplugin6.Diagnostic, error) {
+			resp, err := server.ValidateActionConfig(context.Background(), &tfplugin6.ValidateActionConfig_Request{})
+			return resp.GetDiagnostics(), err
+		},
+		"InvokeAction": func() ([]*tfplugin6.Diagnostic, error) {
+			stream := &testInvokeActionStream{}
+			err := server.InvokeAction(&tfplugin6.InvokeAction_Request{}, stream)
+
+			if len(stream.events) != 1 {
+				return nil, err
+			}
+
+			return stream.events[0].GetCompleted().GetDiagnostics(), err
+		},
+	}
+
+	for rpc, f := range responses {
+		rpc, f := rpc, f
+
+		t.Run(rpc, func(t *testing.T) {
+			t.Parallel()
+
+			diags, err := f()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+			}
+
+			if diags[0].Severity != tfplugin6.Diagnostic_ERROR || diags[0].Summary != "Provider Action Not Implemented" {
+				t.Errorf("unexpected diagnostic: %s", diags[0])
+			}
+		})
+	}
+}
+
+func TestInvokeActionReplay(t *testing.T) {
+	t.Parallel()
+
+	var recording bytes.Buffer
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testActionProviderServer{
+		events: testInvokeActionEvents(
+			tfprotov6.InvokeActionEvent{
+				Type: tfprotov6.ProgressInvokeActionEventType{
+					Message: "test progress",
+				},
+			},
+			tfprotov6.InvokeActionEvent{
+				Type: tfprotov6.CompletedInvokeActionEventType{},
+			},
+		),
+	}, tf6server.WithRecording(&recording))
+
+	err := server.InvokeAction(&tfplugin6.InvokeAction_Request{
+		ActionType: "test_action",
+	}, &testInvokeActionStream{})
+
+	if err != nil {
+		t.Fatalf("unexpected error recording: %s", err)
+	}
+
+	replayServer, err := tf6server.NewReplayProviderServer(&recording)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := replayServer.(tfprotov6.ProviderServerWithActions).InvokeAction(context.Background(), &tfprotov6.InvokeActionRequest{
+		ActionType: "test_action",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error replaying: %s", err)
+	}
+
+	var got []tfprotov6.InvokeActionEvent
+
+	resp.Events(func(event tfprotov6.InvokeActionEvent) bool {
+		got = append(got, event)
+
+		return true
+	})
+
+	expected := []tfprotov6.InvokeActionEvent{
+		{
+			Type: tfprotov6.ProgressInvokeActionEventType{
+				Message: "test progress",
+			},
+		},
+		{
+			Type: tfprotov6.CompletedInvokeActionEventType{},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+)
+
+type testContextProviderServer struct {
+	tfprotov6.ProviderServer
+
+	got map[string]string
+}
+
+func (s testContextProviderServer) ReadResource(ctx context.Context, _ *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	for name, f := range map[string]func(context.Context) (string, bool){
+		"DataSourceType":  tf6server.DataSourceTypeFromContext,
+		"ProviderAddress": tf6server.ProviderAddressFromContext,
+		"RequestID":       tf6server.RequestIDFromContext,
+		"ResourceType":    tf6server.ResourceTypeFromContext,
+		"RPC":             tf6server.RPCFromContext,
+	} {
+		if value, ok := f(ctx); ok {
+			s.got[name] = value
+		}
+	}
+
+	return &tfprotov6.ReadResourceResponse{}, nil
+}
+
+func TestContextAccessors(t *testing.T) {
+	t.Parallel()
+
+	downstream := testContextProviderServer{
+		got: map[string]string{},
+	}
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", downstream)
+
+	_, err := server.ReadResource(context.Background(), &tfplugin6.ReadResource_Request{
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if downstream.got["RequestID"] == "" {
+		t.Errorf("expected request ID, got none")
+	}
+
+	delete(downstream.got, "RequestID")
+
+	expected := map[string]string{
+		"ProviderAddress": "registry.terraform.io/hashicorp/test",
+		"ResourceType":    "test_resource",
+		"RPC":             "ReadResource",
+	}
+
+	if diff := cmp.Diff(downstream.got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if _, ok := tf6server.RPCFromContext(context.Background()); ok {
+		t.Error("expected RPC to not be found in background context")
+	}
+}
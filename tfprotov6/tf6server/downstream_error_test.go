@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestDownstreamErrorDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected []*tfprotov6.Diagnostic
+	}{
+		"single": {
+			err:      errors.New("test error"),
+			expected: nil,
+		},
+		"wrapped": {
+			err:      fmt.Errorf("test context: %w", errors.New("test error")),
+			expected: nil,
+		},
+		"multiple-wrapped": {
+			err:      fmt.Errorf("test context: %w, %w", errors.New("test error 1"), errors.New("test error 2")),
+			expected: nil,
+		},
+		"joined": {
+			err: errors.Join(errors.New("test error 1"), errors.New("test error 2")),
+			expected: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Provider Error",
+					Detail:   "test error 1",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Provider Error",
+					Detail:   "test error 2",
+				},
+			},
+		},
+		"joined-nested": {
+			err: errors.Join(
+				errors.New("test error 1"),
+				errors.Join(errors.New("test error 2"), errors.New("test error 3")),
+				fmt.Errorf("test context: %w", errors.Join(errors.New("test error 4"), errors.New("test error 5"))),
+			),
+			expected: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Provider Error",
+					Detail:   "test error 1",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Provider Error",
+					Detail:   "test error 2",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Provider Error",
+					Detail:   "test error 3",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Provider Error",
+					Detail:   "test context: test error 4\ntest error 5",
+				},
+			},
+		},
+		"resource-not-found": {
+			err: fmt.Errorf("test context: %w", tfprotov6.ErrResourceNotFound),
+			expected: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Resource Not Found",
+					Detail:   "test context: resource not found",
+				},
+			},
+		},
+		"joined-resource-not-found": {
+			err: errors.Join(errors.New("test error"), tfprotov6.ErrResourceNotFound),
+			expected: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Provider Error",
+					Detail:   "test error",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Resource Not Found",
+					Detail:   "resource not found",
+				},
+			},
+		},
+		"unsupported-rpc": {
+			err:      tfprotov6.ErrUnsupportedRPC,
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := downstreamErrorDiagnostics(testCase.err)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDownstreamErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err          error
+		expectedCode codes.Code
+	}{
+		"error": {
+			err:          errors.New("test error"),
+			expectedCode: codes.Unknown,
+		},
+		"resource-not-found": {
+			err:          tfprotov6.ErrResourceNotFound,
+			expectedCode: codes.Unknown,
+		},
+		"unsupported-rpc": {
+			err:          tfprotov6.ErrUnsupportedRPC,
+			expectedCode: codes.Unimplemented,
+		},
+		"unsupported-rpc-wrapped": {
+			err:          fmt.Errorf("test context: %w", tfprotov6.ErrUnsupportedRPC),
+			expectedCode: codes.Unimplemented,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := downstreamErrorStatus(testCase.err)
+
+			if diff := cmp.Diff(status.Code(got), testCase.expectedCode); diff != "" {
+				t.Errorf("unexpected code difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(status.Convert(got).Message(), testCase.err.Error()); diff != "" {
+				t.Errorf("unexpected message difference: %s", diff)
+			}
+		})
+	}
+}
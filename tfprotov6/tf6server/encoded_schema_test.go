@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testEncodedSchemaProviderServer struct {
+	tfprotov6.ProviderServer
+
+	encoded []byte
+}
+
+func (s testEncodedSchemaProviderServer) EncodedProviderSchema(_ context.Context, _ *tfprotov6.GetProviderSchemaRequest) ([]byte, error) {
+	return s.encoded, nil
+}
+
+func TestEncodedProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	encoded, err := tf6server.EncodeGetProviderSchemaResponse(&tfprotov6.GetProviderSchemaResponse{
+		ResourceSchemas: map[string]*tfprotov6.Schema{
+			"test_resource": {
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "id",
+							Type:     tftypes.String,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ServerCapabilities: &tfprotov6.ServerCapabilities{
+			PlanDestroy: true,
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error encoding: %s", err)
+	}
+
+	// The downstream GetProviderSchema is never called, so the embedded nil
+	// ProviderServer would panic if it were.
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testEncodedSchemaProviderServer{encoded: encoded})
+
+	got, err := server.GetProviderSchema(context.Background(), &tfplugin6.GetProviderSchema_Request{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfplugin6.GetProviderSchema_Response{
+		DataSourceSchemas: map[string]*tfplugin6.Schema{},
+		Functions:         map[string]*tfplugin6.Function{},
+		ResourceSchemas: map[string]*tfplugin6.Schema{
+			"test_resource": {
+				Block: &tfplugin6.Schema_Block{
+					Attributes: []*tfplugin6.Schema_Attribute{
+						{
+							Name:     "id",
+							Type:     []byte(`"string"`),
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ServerCapabilities: &tfplugin6.ServerCapabilities{
+			PlanDestroy: true,
+		},
+	}
+
+	if !proto.Equal(got, expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestEncodedProviderSchema_Invalid(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testEncodedSchemaProviderServer{encoded: []byte("invalid")})
+
+	_, err := server.GetProviderSchema(context.Background(), &tfplugin6.GetProviderSchema_Request{})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
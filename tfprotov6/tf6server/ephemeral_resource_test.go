@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+)
+
+type testEphemeralResourceProviderServer struct {
+	tfprotov6.ProviderServer
+
+	renewAt time.Time
+}
+
+func (s testEphemeralResourceProviderServer) ValidateEphemeralResourceConfig(_ context.Context, _ *tfprotov6.ValidateEphemeralResourceConfigRequest) (*tfprotov6.ValidateEphemeralResourceConfigResponse, error) {
+	return &tfprotov6.ValidateEphemeralResourceConfigResponse{}, nil
+}
+
+func (s testEphemeralResourceProviderServer) OpenEphemeralResource(ctx context.Context, _ *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	typeName, _ := tf6server.EphemeralResourceTypeFromContext(ctx)
+
+	return &tfprotov6.OpenEphemeralResourceResponse{
+		Private: []byte(typeName),
+		RenewAt: s.renewAt,
+	}, nil
+}
+
+func (s testEphemeralResourceProviderServer) RenewEphemeralResource(_ context.Context, req *tfprotov6.RenewEphemeralResourceRequest) (*tfprotov6.RenewEphemeralResourceResponse, error) {
+	return &tfprotov6.RenewEphemeralResourceResponse{
+		Private: req.Private,
+		RenewAt: s.renewAt,
+	}, nil
+}
+
+func (s testEphemeralResourceProviderServer) CloseEphemeralResource(_ context.Context, _ *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	return &tfprotov6.CloseEphemeralResourceResponse{}, nil
+}
+
+func TestOpenEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	renewAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testEphemeralResourceProviderServer{
+		renewAt: renewAt,
+	})
+
+	got, err := server.OpenEphemeralResource(context.Background(), &tfplugin6.OpenEphemeralResource_Request{
+		TypeName: "test_ephemeral_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfplugin6.OpenEphemeralResource_Response{
+		Diagnostics: []*tfplugin6.Diagnostic{},
+		Private:     []byte("test_ephemeral_resource"),
+		RenewAt:     timestamppb.New(renewAt),
+	}
+
+	if !proto.Equal(got, expected) {
+		t.Errorf("expected response %s, got %s", expected, got)
+	}
+}
+
+func TestOpenEphemeralResourceDeferred(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testDeferredEphemeralResourceProviderServer{})
+
+	got, err := server.OpenEphemeralResource(context.Background(), &tfplugin6.OpenEphemeralResource_Request{
+		TypeName: "test_ephemeral_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got.Diagnostics) != 1 || got.Diagnostics[0].Summary != "Invalid Deferred Response" {
+		t.Errorf("expected invalid deferred response diagnostic, got: %s", got.Diagnostics)
+	}
+}
+
+type testDeferredEphemeralResourceProviderServer struct {
+	testEphemeralResourceProviderServer
+}
+
+func (s testDeferredEphemeralResourceProviderServer) OpenEphemeralResource(_ context.Context, _ *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	return &tfprotov6.OpenEphemeralResourceResponse{
+		Deferred: &tfprotov6.Deferred{
+			Reason: tfprotov6.DeferredReasonResourceConfigUnknown,
+		},
+	}, nil
+}
+
+func TestRenewEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testEphemeralResourceProviderServer{})
+
+	got, err := server.RenewEphemeralResource(context.Background(), &tfplugin6.RenewEphemeralResource_Request{
+		TypeName: "test_ephemeral_resource",
+		Private:  []byte("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A zero RenewAt must not be sent, as Terraform would renew immediately.
+	expected := &tfplugin6.RenewEphemeralResource_Response{
+		Diagnostics: []*tfplugin6.Diagnostic{},
+		Private:     []byte("test"),
+	}
+
+	if !proto.Equal(got, expected) {
+		t.Errorf("expected response %s, got %s", expected, got)
+	}
+}
+
+func TestEphemeralResourceNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testContextProviderServer{})
+
+	responses := map[string]func() ([]*tfplugin6.Diagnostic, error){
+		"ValidateEphemeralResourceConfig": func() ([]*tfplugin6.Diagnostic, error) {
+			resp, err := server.ValidateEphemeralResourceConfig(context.Background(), &tfplugin6.ValidateEphemeralResourceConfig_Request{})
+			return resp.GetDiagnostics(), err
+		},
+		"OpenEphemeralResource": func() ([]*tfplugin6.Diagnostic, error) {
+			resp, err := server.OpenEphemeralResource(context.Background(), &tfplugin6.OpenEphemeralResource_Request{})
+			return resp.GetDiagnostics(), err
+		},
+		"RenewEphemeralResource": func() ([]*tfplugin6.Diagnostic, error) {
+			resp, err := server.RenewEphemeralResource(context.Background(), &tfplugin6.RenewEphemeralResource_Request{})
+			return resp.GetDiagnostics(), err
+		},
+		"CloseEphemeralResource": func() ([]*tfplugin6.Diagnostic, error) {
+			resp, err := server.CloseEphemeralResource(context.Background(), &tfplugin6.CloseEphemeralResource_Request{})
+			return resp.GetDiagnostics(), err
+		},
+	}
+
+	for rpc, f := range responses {
+		rpc, f := rpc, f
+
+		t.Run(rpc, func(t *testing.T) {
+			t.Parallel()
+
+			diags, err := f()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+			}
+
+			if diags[0].Severity != tfplugin6.Diagnostic_ERROR || diags[0].Summary != "Provider Ephemeral Resource Not Implemented" {
+				t.Errorf("unexpected diagnostic: %s", diags[0])
+			}
+		})
+	}
+}
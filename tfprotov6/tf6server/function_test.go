@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testFunctionProviderServer struct {
+	tfprotov6.ProviderServer
+
+	callFunction func(*tfprotov6.CallFunctionRequest) *tfprotov6.CallFunctionResponse
+	functions    map[string]*tfprotov6.Function
+}
+
+func (s testFunctionProviderServer) CallFunction(_ context.Context, req *tfprotov6.CallFunctionRequest) (*tfprotov6.CallFunctionResponse, error) {
+	return s.callFunction(req), nil
+}
+
+func (s testFunctionProviderServer) GetFunctions(_ context.Context, _ *tfprotov6.GetFunctionsRequest) (*tfprotov6.GetFunctionsResponse, error) {
+	return &tfprotov6.GetFunctionsResponse{
+		Functions: s.functions,
+	}, nil
+}
+
+func TestCallFunction(t *testing.T) {
+	t.Parallel()
+
+	argument, err := tfprotov6.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, "test"))
+
+	if err != nil {
+		t.Fatalf("unexpected error creating argument: %s", err)
+	}
+
+	testCases := map[string]struct {
+		resp     *tfprotov6.CallFunctionResponse
+		expected *tfplugin6.CallFunction_Response
+	}{
+		"result": {
+			resp: &tfprotov6.CallFunctionResponse{
+				Result: &argument,
+			},
+			expected: &tfplugin6.CallFunction_Response{
+				Result: &tfplugin6.DynamicValue{
+					Msgpack: argument.MsgPack,
+				},
+			},
+		},
+		"error": {
+			resp: &tfprotov6.CallFunctionResponse{
+				Error: &tfprotov6.FunctionError{
+					Text:             "test error",
+					FunctionArgument: proto.Int64(0),
+				},
+			},
+			expected: &tfplugin6.CallFunction_Response{
+				Error: &tfplugin6.FunctionError{
+					Text:             "test error",
+					FunctionArgument: proto.Int64(0),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got *tfprotov6.CallFunctionRequest
+
+			server := tf6server.New("registry.terraform.io/hashicorp/test", testFunctionProviderServer{
+				callFunction: func(req *tfprotov6.CallFunctionRequest) *tfprotov6.CallFunctionResponse {
+					got = req
+
+					return testCase.resp
+				},
+			})
+
+			resp, err := server.CallFunction(context.Background(), &tfplugin6.CallFunction_Request{
+				Name: "test_function",
+				Arguments: []*tfplugin6.DynamicValue{
+					{
+						Msgpack: argument.MsgPack,
+					},
+				},
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.Name != "test_function" {
+				t.Errorf("expected downstream function name %q, got %q", "test_function", got.Name)
+			}
+
+			if len(got.Arguments) != 1 {
+				t.Fatalf("expected 1 downstream argument, got %d", len(got.Arguments))
+			}
+
+			gotArgument, err := got.Arguments[0].Unmarshal(tftypes.String)
+
+			if err != nil {
+				t.Fatalf("unexpected error unmarshaling argument: %s", err)
+			}
+
+			if !gotArgument.Equal(tftypes.NewValue(tftypes.String, "test")) {
+				t.Errorf("unexpected downstream argument: %s", gotArgument)
+			}
+
+			if !proto.Equal(resp, testCase.expected) {
+				t.Errorf("expected response %s, got %s", testCase.expected, resp)
+			}
+		})
+	}
+}
+
+func TestGetFunctions(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testFunctionProviderServer{
+		functions: map[string]*tfprotov6.Function{
+			"test_function": {
+				Parameters: []*tfprotov6.FunctionParameter{
+					{
+						Name: "input",
+						Type: tftypes.String,
+					},
+				},
+				Return: &tfprotov6.FunctionReturn{
+					Type: tftypes.Bool,
+				},
+				Summary: "test summary",
+			},
+		},
+	})
+
+	got, err := server.GetFunctions(context.Background(), &tfplugin6.GetFunctions_Request{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfplugin6.GetFunctions_Response{
+		Diagnostics: []*tfplugin6.Diagnostic{},
+		Functions: map[string]*tfplugin6.Function{
+			"test_function": {
+				Parameters: []*tfplugin6.Function_Parameter{
+					{
+						Name: "input",
+						Type: []byte(`"string"`),
+					},
+				},
+				Return: &tfplugin6.Function_Return{
+					Type: []byte(`"bool"`),
+				},
+				Summary: "test summary",
+			},
+		},
+	}
+
+	if !proto.Equal(got, expected) {
+		t.Errorf("expected response %s, got %s", expected, got)
+	}
+}
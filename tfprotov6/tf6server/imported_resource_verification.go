@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/internal/logging"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/fromproto"
+)
+
+// importedResourcesDiagnostics returns error diagnostics for each imported
+// resource which cannot be read by the downstream server, as enabled by
+// WithImportedResourceVerification. Verification is skipped when the import
+// returned error diagnostics or was deferred.
+func (s *server) importedResourcesDiagnostics(ctx context.Context, req *tfprotov6.ImportResourceStateRequest, resp *tfprotov6.ImportResourceStateResponse) []*tfprotov6.Diagnostic {
+	if resp.Deferred != nil || len(resp.ImportedResources) == 0 {
+		return nil
+	}
+
+	for _, diag := range resp.Diagnostics {
+		if diag != nil && diag.Severity == tfprotov6.DiagnosticSeverityError {
+			return nil
+		}
+	}
+
+	resourceSchemas, diags := s.importedResourceSchemas(ctx, req.TypeName)
+
+	if len(diags) > 0 {
+		return diags
+	}
+
+	for _, importedResource := range resp.ImportedResources {
+		if importedResource == nil {
+			continue
+		}
+
+		logging.ProtocolTrace(ctx, "Verifying imported resource", map[string]interface{}{logging.KeyResourceType: importedResource.TypeName})
+
+		diags = append(diags, s.importedResourceDiagnostics(ctx, req, resourceSchemas, importedResource)...)
+	}
+
+	return diags
+}
+
+// importedResourceSchemas returns the resource schemas of the downstream
+// server. The schemas are retrieved the same way as the GetProviderSchema
+// RPC, so tfprotov6.ProviderServerWithEncodedProviderSchema implementations
+// are supported, and are cached once retrieved without error diagnostics.
+func (s *server) importedResourceSchemas(ctx context.Context, typeName string) (map[string]*tfprotov6.Schema, []*tfprotov6.Diagnostic) {
+	s.importedResourceSchemasMu.Lock()
+	defer s.importedResourceSchemasMu.Unlock()
+
+	if s.importedResourceSchemasCache != nil {
+		return s.importedResourceSchemasCache, nil
+	}
+
+	schemaResp, err := s.downstreamProviderSchema(ctx)
+
+	if err != nil {
+		return nil, []*tfprotov6.Diagnostic{
+			importedResourceVerificationDiag(typeName, fmt.Sprintf("Unable to retrieve the provider schema: %s", err)),
+		}
+	}
+
+	if schemaResp == nil {
+		return nil, []*tfprotov6.Diagnostic{
+			importedResourceVerificationDiag(typeName, "Retrieving the provider schema returned no response."),
+		}
+	}
+
+	var diags []*tfprotov6.Diagnostic
+
+	for _, diag := range schemaResp.Diagnostics {
+		if diag != nil && diag.Severity == tfprotov6.DiagnosticSeverityError {
+			diags = append(diags, importedResourceVerificationDiag(typeName, fmt.Sprintf("Retrieving the provider schema returned an error diagnostic: %s: %s", diag.Summary, diag.Detail)))
+		}
+	}
+
+	if len(diags) > 0 {
+		return nil, diags
+	}
+
+	s.importedResourceSchemasCache = schemaResp.ResourceSchemas
+
+	if s.importedResourceSchemasCache == nil {
+		s.importedResourceSchemasCache = map[string]*tfprotov6.Schema{}
+	}
+
+	return s.importedResourceSchemasCache, nil
+}
+
+// downstreamProviderSchema returns the GetProviderSchema response of the
+// downstream server, decoding it for
+// tfprotov6.ProviderServerWithEncodedProviderSchema implementations.
+func (s *server) downstreamProviderSchema(ctx context.Context) (*tfprotov6.GetProviderSchemaResponse, error) {
+	req := &tfprotov6.GetProviderSchemaRequest{}
+
+	if downstream, ok := s.downstream.(tfprotov6.ProviderServerWithEncodedProviderSchema); ok {
+		protoResp, err := encodedProviderSchema(ctx, downstream, req)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return fromproto.GetProviderSchemaResponse(protoResp)
+	}
+
+	return s.downstream.GetProviderSchema(ctx, req)
+}
+
+// importedResourceDiagnostics returns error diagnostics if the imported
+// resource cannot be read by the downstream server.
+func (s *server) importedResourceDiagnostics(ctx context.Context, req *tfprotov6.ImportResourceStateRequest, resourceSchemas map[string]*tfprotov6.Schema, importedResource *tfprotov6.ImportedResource) []*tfprotov6.Diagnostic {
+	typeName := importedResource.TypeName
+
+	if importedResource.State == nil {
+		return []*tfprotov6.Diagnostic{
+			importedResourceVerificationDiag(typeName, "The imported resource has no state."),
+		}
+	}
+
+	schema, ok := resourceSchemas[typeName]
+
+	if !ok || schema == nil {
+		return []*tfprotov6.Diagnostic{
+			importedResourceVerificationDiag(typeName, "The provider schema does not contain the imported resource type."),
+		}
+	}
+
+	readReq := &tfprotov6.ReadResourceRequest{
+		TypeName:     typeName,
+		CurrentState: importedResource.State,
+		Private:      importedResource.Private,
+	}
+
+	if req.ClientCapabilities != nil {
+		readReq.ClientCapabilities = &tfprotov6.ReadResourceClientCapabilities{
+			DeferralAllowed: req.ClientCapabilities.DeferralAllowed,
+		}
+	}
+
+	readResp, err := s.downstream.ReadResource(ctx, readReq)
+
+	if err != nil {
+		return []*tfprotov6.Diagnostic{
+			importedResourceVerificationDiag(typeName, fmt.Sprintf("Reading the imported resource returned an error: %s", err)),
+		}
+	}
+
+	if readResp == nil {
+		return []*tfprotov6.Diagnostic{
+			importedResourceVerificationDiag(typeName, "Reading the imported resource returned no response."),
+		}
+	}
+
+	for _, diag := range readResp.Diagnostics {
+		if diag != nil && diag.Severity == tfprotov6.DiagnosticSeverityError {
+			return []*tfprotov6.Diagnostic{
+				importedResourceVerificationDiag(typeName, fmt.Sprintf("Reading the imported resource returned an error diagnostic: %s: %s", diag.Summary, diag.Detail)),
+			}
+		}
+	}
+
+	if readResp.Deferred != nil {
+		return nil
+	}
+
+	if readResp.NewState == nil {
+		return []*tfprotov6.Diagnostic{
+			importedResourceVerificationDiag(typeName, "Reading the imported resource returned no state."),
+		}
+	}
+
+	newState, err := readResp.NewState.Unmarshal(schema.ValueType())
+
+	if err != nil {
+		return []*tfprotov6.Diagnostic{
+			importedResourceVerificationDiag(typeName, fmt.Sprintf("Reading the imported resource returned a state which does not match the resource schema: %s", err)),
+		}
+	}
+
+	if newState.IsNull() {
+		return []*tfprotov6.Diagnostic{
+			importedResourceVerificationDiag(typeName, "Reading the imported resource returned a null state, which indicates the resource does not exist."),
+		}
+	}
+
+	return nil
+}
+
+func importedResourceVerificationDiag(typeName string, detail string) *tfprotov6.Diagnostic {
+	return &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "Imported Resource Verification Failed",
+		Detail: fmt.Sprintf("The provider returned an imported %s resource which could not be verified. ", typeName) +
+			"This is an issue with the provider and should be reported to the provider developers.\n\n" +
+			detail,
+	}
+}
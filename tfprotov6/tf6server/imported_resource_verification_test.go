@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testImportedResourceType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"id": tftypes.String,
+	},
+}
+
+type testImportedResourceProviderServer struct {
+	tfprotov6.ProviderServer
+
+	// schemaCalls, if set, is incremented for each GetProviderSchema call.
+	schemaCalls *atomic.Int64
+
+	schemaDiagnostics []*tfprotov6.Diagnostic
+	readResp          *tfprotov6.ReadResourceResponse
+	readRespNil       bool
+}
+
+func (s testImportedResourceProviderServer) GetProviderSchema(_ context.Context, _ *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	if s.schemaCalls != nil {
+		s.schemaCalls.Add(1)
+	}
+
+	return &tfprotov6.GetProviderSchemaResponse{
+		Diagnostics: s.schemaDiagnostics,
+		ResourceSchemas: map[string]*tfprotov6.Schema{
+			"test_resource": {
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "id",
+							Type:     tftypes.String,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (s testImportedResourceProviderServer) ImportResourceState(_ context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	state, err := tfprotov6.NewDynamicValue(testImportedResourceType, tftypes.NewValue(testImportedResourceType, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, req.ID),
+	}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov6.ImportResourceStateResponse{
+		ImportedResources: []*tfprotov6.ImportedResource{
+			{
+				TypeName: req.TypeName,
+				State:    &state,
+			},
+		},
+	}, nil
+}
+
+func (s testImportedResourceProviderServer) ReadResource(_ context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	if s.readRespNil {
+		return nil, nil
+	}
+
+	if s.readResp != nil {
+		return s.readResp, nil
+	}
+
+	return &tfprotov6.ReadResourceResponse{
+		NewState: req.CurrentState,
+	}, nil
+}
+
+func TestWithImportedResourceVerification(t *testing.T) {
+	t.Parallel()
+
+	nullState, err := tfprotov6.NewDynamicValue(testImportedResourceType, tftypes.NewValue(testImportedResourceType, nil))
+
+	if err != nil {
+		t.Fatalf("unexpected error creating null state: %s", err)
+	}
+
+	testCases := map[string]struct {
+		schemaDiagnostics []*tfprotov6.Diagnostic
+		readResp          *tfprotov6.ReadResourceResponse
+		readRespNil       bool
+		expected          []*tfplugin6.Diagnostic
+	}{
+		"valid": {},
+		"schema-error-diagnostic": {
+			schemaDiagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+			},
+			expected: []*tfplugin6.Diagnostic{
+				{
+					Severity: tfplugin6.Diagnostic_ERROR,
+					Summary:  "Imported Resource Verification Failed",
+					Detail: "The provider returned an imported test_resource resource which could not be verified. " +
+						"This is an issue with the provider and should be reported to the provider developers.\n\n" +
+						"Retrieving the provider schema returned an error diagnostic: test summary: test detail",
+				},
+			},
+		},
+		"schema-warning-diagnostic": {
+			schemaDiagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "test summary",
+					Detail:   "test detail",
+				},
+			},
+		},
+		"read-nil-response": {
+			readRespNil: true,
+			expected: []*tfplugin6.Diagnostic{
+				{
+					Severity: tfplugin6.Diagnostic_ERROR,
+					Summary:  "Imported Resource Verification Failed",
+					Detail: "The provider returned an imported test_resource resource which could not be verified. " +
+						"This is an issue with the provider and should be reported to the provider developers.\n\n" +
+						"Reading the imported resource returned no response.",
+				},
+			},
+		},
+		"read-error-diagnostic": {
+			readResp: &tfprotov6.ReadResourceResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "test summary",
+						Detail:   "test detail",
+					},
+				},
+			},
+			expected: []*tfplugin6.Diagnostic{
+				{
+					Severity: tfplugin6.Diagnostic_ERROR,
+					Summary:  "Imported Resource Verification Failed",
+					Detail: "The provider returned an imported test_resource resource which could not be verified. " +
+						"This is an issue with the provider and should be reported to the provider developers.\n\n" +
+						"Reading the imported resource returned an error diagnostic: test summary: test detail",
+				},
+			},
+		},
+		"missing-state": {
+			readResp: &tfprotov6.ReadResourceResponse{},
+			expected: []*tfplugin6.Diagnostic{
+				{
+					Severity: tfplugin6.Diagnostic_ERROR,
+					Summary:  "Imported Resource Verification Failed",
+					Detail: "The provider returned an imported test_resource resource which could not be verified. " +
+						"This is an issue with the provider and should be reported to the provider developers.\n\n" +
+						"Reading the imported resource returned no state.",
+				},
+			},
+		},
+		"null-state": {
+			readResp: &tfprotov6.ReadResourceResponse{
+				NewState: &nullState,
+			},
+			expected: []*tfplugin6.Diagnostic{
+				{
+					Severity: tfplugin6.Diagnostic_ERROR,
+					Summary:  "Imported Resource Verification Failed",
+					Detail: "The provider returned an imported test_resource resource which could not be verified. " +
+						"This is an issue with the provider and should be reported to the provider developers.\n\n" +
+						"Reading the imported resource returned a null state, which indicates the resource does not exist.",
+				},
+			},
+		},
+		"incorrect-type": {
+			readResp: &tfprotov6.ReadResourceResponse{
+				NewState: &tfprotov6.DynamicValue{
+					JSON: []byte(`{"id":"test-id","extra":true}`),
+				},
+			},
+			expected: []*tfplugin6.Diagnostic{
+				{
+					Severity: tfplugin6.Diagnostic_ERROR,
+					Summary:  "Imported Resource Verification Failed",
+					Detail: "The provider returned an imported test_resource resource which could not be verified. " +
+						"This is an issue with the provider and should be reported to the provider developers.\n\n" +
+						`Reading the imported resource returned a state which does not match the resource schema: AttributeName("extra"): unsupported attribute "extra"`,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tf6server.New(
+				"registry.terraform.io/hashicorp/test",
+				testImportedResourceProviderServer{
+					schemaDiagnostics: testCase.schemaDiagnostics,
+					readResp:          testCase.readResp,
+					readRespNil:       testCase.readRespNil,
+				},
+				tf6server.WithImportedResourceVerification(),
+			)
+
+			resp, err := server.ImportResourceState(context.Background(), &tfplugin6.ImportResourceState_Request{
+				TypeName: "test_resource",
+				Id:       "test-id",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected, cmpopts.EquateEmpty(), protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWithImportedResourceVerification_SchemaCache(t *testing.T) {
+	t.Parallel()
+
+	var schemaCalls atomic.Int64
+
+	server := tf6server.New(
+		"registry.terraform.io/hashicorp/test",
+		testImportedResourceProviderServer{schemaCalls: &schemaCalls},
+		tf6server.WithImportedResourceVerification(),
+	)
+
+	for i := 0; i < 3; i++ {
+		resp, err := server.ImportResourceState(context.Background(), &tfplugin6.ImportResourceState_Request{
+			TypeName: "test_resource",
+			Id:       "test-id",
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(resp.Diagnostics) > 0 {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+	}
+
+	if got := schemaCalls.Load(); got != 1 {
+		t.Errorf("expected 1 GetProviderSchema call, got %d", got)
+	}
+}
+
+type testEncodedImportedResourceProviderServer struct {
+	testImportedResourceProviderServer
+}
+
+func (s testEncodedImportedResourceProviderServer) GetProviderSchema(_ context.Context, _ *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	return nil, errors.New("unexpected GetProviderSchema call")
+}
+
+func (s testEncodedImportedResourceProviderServer) EncodedProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) ([]byte, error) {
+	resp, err := s.testImportedResourceProviderServer.GetProviderSchema(ctx, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tf6server.EncodeGetProviderSchemaResponse(resp)
+}
+
+func TestWithImportedResourceVerification_EncodedProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New(
+		"registry.terraform.io/hashicorp/test",
+		testEncodedImportedResourceProviderServer{},
+		tf6server.WithImportedResourceVerification(),
+	)
+
+	resp, err := server.ImportResourceState(context.Background(), &tfplugin6.ImportResourceState_Request{
+		TypeName: "test_resource",
+		Id:       "test-id",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, []*tfplugin6.Diagnostic(nil), cmpopts.EquateEmpty(), protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testMoveResourceStateProviderServer struct {
+	tfprotov6.ProviderServer
+
+	moveResourceState func(context.Context, *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error)
+}
+
+func (s testMoveResourceStateProviderServer) MoveResourceState(ctx context.Context, req *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error) {
+	return s.moveResourceState(ctx, req)
+}
+
+func TestMoveResourceState(t *testing.T) {
+	t.Parallel()
+
+	targetState, err := tfprotov6.NewDynamicValue(
+		tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}},
+		tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "test"),
+		}),
+	)
+
+	if err != nil {
+		t.Fatalf("unexpected error creating target state: %s", err)
+	}
+
+	var gotReq *tfprotov6.MoveResourceStateRequest
+	var gotResourceType string
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testMoveResourceStateProviderServer{
+		moveResourceState: func(ctx context.Context, req *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error) {
+			gotReq = req
+			gotResourceType, _ = tf6server.ResourceTypeFromContext(ctx)
+
+			return &tfprotov6.MoveResourceStateResponse{
+				TargetPrivate: []byte(`{}`),
+				TargetState:   &targetState,
+			}, nil
+		},
+	})
+
+	got, err := server.MoveResourceState(context.Background(), &tfplugin6.MoveResourceState_Request{
+		SourcePrivate:         []byte(`{"source":true}`),
+		SourceProviderAddress: "registry.terraform.io/hashicorp/other",
+		SourceSchemaVersion:   2,
+		SourceState: &tfplugin6.RawState{
+			Json: []byte(`{"id":"test"}`),
+		},
+		SourceTypeName: "other_resource",
+		TargetTypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedReq := &tfprotov6.MoveResourceStateRequest{
+		SourcePrivate:         []byte(`{"source":true}`),
+		SourceProviderAddress: "registry.terraform.io/hashicorp/other",
+		SourceSchemaVersion:   2,
+		SourceState: &tfprotov6.RawState{
+			JSON: []byte(`{"id":"test"}`),
+		},
+		SourceTypeName: "other_resource",
+		TargetTypeName: "test_resource",
+	}
+
+	if diff := cmp.Diff(gotReq, expectedReq); diff != "" {
+		t.Errorf("unexpected downstream request difference: %s", diff)
+	}
+
+	if gotResourceType != "test_resource" {
+		t.Errorf("expected resource type %q in context, got %q", "test_resource", gotResourceType)
+	}
+
+	expected := &tfplugin6.MoveResourceState_Response{
+		Diagnostics:   []*tfplugin6.Diagnostic{},
+		TargetPrivate: []byte(`{}`),
+		TargetState: &tfplugin6.DynamicValue{
+			Msgpack: targetState.MsgPack,
+		},
+	}
+
+	if !proto.Equal(got, expected) {
+		t.Errorf("expected response %s, got %s", expected, got)
+	}
+}
+
+func TestMoveResourceStateJoinedErrors(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testMoveResourceStateProviderServer{
+		moveResourceState: func(_ context.Context, _ *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error) {
+			return nil, errors.Join(errors.New("first error"), errors.New("second error"))
+		},
+	})
+
+	got, err := server.MoveResourceState(context.Background(), &tfplugin6.MoveResourceState_Request{
+		TargetTypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfplugin6.MoveResourceState_Response{
+		Diagnostics: []*tfplugin6.Diagnostic{
+			{
+				Severity: tfplugin6.Diagnostic_ERROR,
+				Summary:  "Provider Error",
+				Detail:   "first error",
+			},
+			{
+				Severity: tfplugin6.Diagnostic_ERROR,
+				Summary:  "Provider Error",
+				Detail:   "second error",
+			},
+		},
+	}
+
+	if !proto.Equal(got, expected) {
+		t.Errorf("expected response %s, got %s", expected, got)
+	}
+}
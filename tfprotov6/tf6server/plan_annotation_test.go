@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testPlanAnnotationProviderServer struct {
+	tfprotov6.ProviderServer
+}
+
+func (s testPlanAnnotationProviderServer) PlanResourceChange(_ context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	return &tfprotov6.PlanResourceChangeResponse{
+		PlannedState: req.ProposedNewState,
+		Annotations: []*tfprotov6.PlanAnnotation{
+			{
+				Kind:      tfprotov6.PlanAnnotationKindUnknown,
+				Attribute: tftypes.NewAttributePath().WithAttributeName("endpoint"),
+				Reason:    "The endpoint is assigned after the instance is created.",
+			},
+			nil,
+			{
+				Kind:      tfprotov6.PlanAnnotationKindRequiresReplace,
+				Attribute: tftypes.NewAttributePath().WithAttributeName("region"),
+				Reason:    "The region of an instance cannot be updated.",
+			},
+		},
+	}, nil
+}
+
+func TestWithPlanAnnotationDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts     []tf6server.ServeOpt
+		expected []*tfplugin6.Diagnostic
+	}{
+		"disabled": {},
+		"enabled": {
+			opts: []tf6server.ServeOpt{
+				tf6server.WithPlanAnnotationDiagnostics(),
+			},
+			expected: []*tfplugin6.Diagnostic{
+				{
+					Severity: tfplugin6.Diagnostic_WARNING,
+					Summary:  "Attribute Value Unknown Until Apply",
+					Detail:   "The endpoint is assigned after the instance is created.",
+					Attribute: &tfplugin6.AttributePath{
+						Steps: []*tfplugin6.AttributePath_Step{
+							{
+								Selector: &tfplugin6.AttributePath_Step_AttributeName{
+									AttributeName: "endpoint",
+								},
+							},
+						},
+					},
+				},
+				{
+					Severity: tfplugin6.Diagnostic_WARNING,
+					Summary:  "Attribute Change Requires Replacement",
+					Detail:   "The region of an instance cannot be updated.",
+					Attribute: &tfplugin6.AttributePath{
+						Steps: []*tfplugin6.AttributePath_Step{
+							{
+								Selector: &tfplugin6.AttributePath_Step_AttributeName{
+									AttributeName: "region",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tf6server.New(
+				"registry.terraform.io/hashicorp/test",
+				testPlanAnnotationProviderServer{},
+				testCase.opts...,
+			)
+
+			resp, err := server.PlanResourceChange(context.Background(), &tfplugin6.PlanResourceChange_Request{
+				TypeName: "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected, cmpopts.EquateEmpty(), protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
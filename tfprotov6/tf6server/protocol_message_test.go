@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/hashicorp/terraform-plugin-go/internal/logging"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProtocolMessageDump(t *testing.T) { //nolint:paralleltest // t.Setenv cannot be used with t.Parallel
+	dumpDir := t.TempDir()
+
+	t.Setenv(logging.EnvTfLogSdkProtoDumpDir, dumpDir)
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testFunctionProviderServer{
+		functions: map[string]*tfprotov6.Function{
+			"test_function": {
+				Return: &tfprotov6.FunctionReturn{
+					Type: tftypes.String,
+				},
+			},
+		},
+	})
+
+	req := &tfplugin6.GetFunctions_Request{}
+
+	resp, err := server.GetFunctions(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries, err := os.ReadDir(dumpDir)
+
+	if err != nil {
+		t.Fatalf("unexpected error reading dump directory: %s", err)
+	}
+
+	if len(entries) != 4 {
+		t.Fatalf("expected 4 files, got %d: %v", len(entries), entries)
+	}
+
+	// The sequence is shared by every server in the process, so only the
+	// order of the files is known.
+	matches := regexp.MustCompile(`^(\d{6})_`).FindStringSubmatch(entries[0].Name())
+
+	if matches == nil {
+		t.Fatalf("expected sequenced file name, got %q", entries[0].Name())
+	}
+
+	sequence, err := strconv.Atoi(matches[1])
+
+	if err != nil {
+		t.Fatalf("unexpected error parsing sequence: %s", err)
+	}
+
+	requestFile := fmt.Sprintf("%06d_GetFunctions_Request", sequence)
+	responseFile := fmt.Sprintf("%06d_GetFunctions_Response", sequence+1)
+
+	var got []string
+
+	for _, entry := range entries {
+		got = append(got, entry.Name())
+	}
+
+	expected := []string{
+		requestFile + ".binpb",
+		requestFile + ".json",
+		responseFile + ".binpb",
+		responseFile + ".json",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Fatalf("unexpected file names difference: %s", diff)
+	}
+
+	testCases := map[string]struct {
+		file      string
+		unmarshal func([]byte, proto.Message) error
+		got       proto.Message
+		expected  proto.Message
+	}{
+		"request-binpb": {
+			file:      requestFile + ".binpb",
+			unmarshal: proto.Unmarshal,
+			got:       &tfplugin6.GetFunctions_Request{},
+			expected:  req,
+		},
+		"request-json": {
+			file:      requestFile + ".json",
+			unmarshal: protojson.Unmarshal,
+			got:       &tfplugin6.GetFunctions_Request{},
+			expected:  req,
+		},
+		"response-binpb": {
+			file:      responseFile + ".binpb",
+			unmarshal: proto.Unmarshal,
+			got:       &tfplugin6.GetFunctions_Response{},
+			expected:  resp,
+		},
+		"response-json": {
+			file:      responseFile + ".json",
+			unmarshal: protojson.Unmarshal,
+			got:       &tfplugin6.GetFunctions_Response{},
+			expected:  resp,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) { //nolint:paralleltest // t.Setenv cannot be used with t.Parallel
+			contents, err := os.ReadFile(filepath.Join(dumpDir, testCase.file))
+
+			if err != nil {
+				t.Fatalf("unexpected error reading file: %s", err)
+			}
+
+			if err := testCase.unmarshal(contents, testCase.got); err != nil {
+				t.Fatalf("unexpected error unmarshaling file: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.got, testCase.expected, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server
+
+import (
+	"context"
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-go/internal/recording"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/fromproto"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/toproto"
+)
+
+var (
+	_ tfprotov6.ProviderServerWithActions            = &replayServer{}
+	_ tfprotov6.ProviderServerWithEphemeralResources = &replayServer{}
+	_ tfprotov6.ProviderServerWithStateStores        = &replayServer{}
+)
+
+// replayServer is a tfprotov6.ProviderServer, including all optional
+// interfaces, which responds to requests with responses previously recorded
+// via WithRecording.
+type replayServer struct {
+	replayer *recording.Replayer
+}
+
+// NewReplayProviderServer returns a tfprotov6.ProviderServer which responds
+// to requests using the RPC exchanges recorded via the WithRecording ServeOpt
+// and read from r. Each recorded exchange is replayed at most once, in the
+// order recorded, for a request equal to the recorded request. Requests
+// without a matching recorded exchange return an error.
+//
+// The returned server can be passed to Serve or New like any other provider
+// server, enabling deterministic testing of Terraform configurations without
+// calling real infrastructure APIs.
+func NewReplayProviderServer(r io.Reader) (tfprotov6.ProviderServer, error) {
+	replayer, err := recording.NewReplayer(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &replayServer{
+		replayer: replayer,
+	}, nil
+}
+
+func (s *replayServer) GetMetadata(_ context.Context, req *tfprotov6.GetMetadataRequest) (*tfprotov6.GetMetadataResponse, error) {
+	protoResp := &tfplugin6.GetMetadata_Response{}
+
+	if err := s.replayer.Replay("GetMetadata", toproto.GetMetadata_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetMetadataResponse(protoResp)
+}
+
+func (s *replayServer) GetProviderSchema(_ context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	protoResp := &tfplugin6.GetProviderSchema_Response{}
+
+	if err := s.replayer.Replay("GetProviderSchema", toproto.GetProviderSchema_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetProviderSchemaResponse(protoResp)
+}
+
+func (s *replayServer) ValidateProviderConfig(_ context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
+	protoResp := &tfplugin6.ValidateProviderConfig_Response{}
+
+	if err := s.replayer.Replay("ValidateProviderConfig", toproto.ValidateProviderConfig_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateProviderConfigResponse(protoResp)
+}
+
+func (s *replayServer) ConfigureProvider(_ context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	protoResp := &tfplugin6.ConfigureProvider_Response{}
+
+	if err := s.replayer.Replay("ConfigureProvider", toproto.ConfigureProvider_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ConfigureProviderResponse(protoResp)
+}
+
+func (s *replayServer) StopProvider(_ context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	protoResp := &tfplugin6.StopProvider_Response{}
+
+	if err := s.replayer.Replay("StopProvider", toproto.StopProvider_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.StopProviderResponse(protoResp), nil
+}
+
+func (s *replayServer) ValidateDataResourceConfig(_ context.Context, req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
+	protoResp := &tfplugin6.ValidateDataResourceConfig_Response{}
+
+	if err := s.replayer.Replay("ValidateDataResourceConfig", toproto.ValidateDataResourceConfig_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateDataResourceConfigResponse(protoResp)
+}
+
+func (s *replayServer) ReadDataSource(_ context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	protoResp := &tfplugin6.ReadDataSource_Response{}
+
+	if err := s.replayer.Replay("ReadDataSource", toproto.ReadDataSource_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ReadDataSourceResponse(protoResp)
+}
+
+func (s *replayServer) ValidateEphemeralResourceConfig(_ context.Context, req *tfprotov6.ValidateEphemeralResourceConfigRequest) (*tfprotov6.ValidateEphemeralResourceConfigResponse, error) {
+	protoResp := &tfplugin6.ValidateEphemeralResourceConfig_Response{}
+
+	if err := s.replayer.Replay("ValidateEphemeralResourceConfig", toproto.ValidateEphemeralResourceConfig_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateEphemeralResourceConfigResponse(protoResp)
+}
+
+func (s *replayServer) OpenEphemeralResource(_ context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	protoResp := &tfplugin6.OpenEphemeralResource_Response{}
+
+	if err := s.replayer.Replay("OpenEphemeralResource", toproto.OpenEphemeralResource_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.OpenEphemeralResourceResponse(protoResp)
+}
+
+func (s *replayServer) RenewEphemeralResource(_ context.Context, req *tfprotov6.RenewEphemeralResourceRequest) (*tfprotov6.RenewEphemeralResourceResponse, error) {
+	protoResp := &tfplugin6.RenewEphemeralResource_Response{}
+
+	if err := s.replayer.Replay("RenewEphemeralResource", toproto.RenewEphemeralResource_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.RenewEphemeralResourceResponse(protoResp)
+}
+
+func (s *replayServer) CloseEphemeralResource(_ context.Context, req *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	protoResp := &tfplugin6.CloseEphemeralResource_Response{}
+
+	if err := s.replayer.Replay("CloseEphemeralResource", toproto.CloseEphemeralResource_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.CloseEphemeralResourceResponse(protoResp)
+}
+
+func (s *replayServer) ValidateActionConfig(_ context.Context, req *tfprotov6.ValidateActionConfigRequest) (*tfprotov6.ValidateActionConfigResponse, error) {
+	protoResp := &tfplugin6.ValidateActionConfig_Response{}
+
+	if err := s.replayer.Replay("ValidateActionConfig", toproto.ValidateActionConfig_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateActionConfigResponse(protoResp)
+}
+
+// InvokeAction replays the recorded events of the action, which are recorded
+// as separate exchanges, up to and including the completed event.
+func (s *replayServer) InvokeAction(_ context.Context, req *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionResponse, error) {
+	protoReq := toproto.InvokeAction_Request(req)
+
+	var events []tfprotov6.InvokeActionEvent
+
+	for {
+		protoEvent := &tfplugin6.InvokeAction_Event{}
+
+		if err := s.replayer.Replay("InvokeAction", protoReq, protoEvent); err != nil {
+			return nil, err
+		}
+
+		event, err := fromproto.InvokeActionEvent(protoEvent)
+
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, *event)
+
+		if _, ok := event.Type.(tfprotov6.CompletedInvokeActionEventType); ok {
+			break
+		}
+	}
+
+	resp := &tfprotov6.InvokeActionResponse{
+		Events: func(yield func(tfprotov6.InvokeActionEvent) bool) {
+			for _, event := range events {
+				if !yield(event) {
+					return
+				}
+			}
+		},
+	}
+
+	return resp, nil
+}
+
+func (s *replayServer) ValidateStateStoreConfig(_ context.Context, req *tfprotov6.ValidateStateStoreConfigRequest) (*tfprotov6.ValidateStateStoreConfigResponse, error) {
+	protoResp := &tfplugin6.ValidateStateStoreConfig_Response{}
+
+	if err := s.replayer.Replay("ValidateStateStoreConfig", toproto.ValidateStateStoreConfig_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateStateStoreConfigResponse(protoResp)
+}
+
+func (s *replayServer) ConfigureStateStore(_ context.Context, req *tfprotov6.ConfigureStateStoreRequest) (*tfprotov6.ConfigureStateStoreResponse, error) {
+	protoResp := &tfplugin6.ConfigureStateStore_Response{}
+
+	if err := s.replayer.Replay("ConfigureStateStore", toproto.ConfigureStateStore_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ConfigureStateStoreResponse(protoResp)
+}
+
+func (s *replayServer) ReadStateBytes(_ context.Context, req *tfprotov6.ReadStateBytesRequest) (*tfprotov6.ReadStateBytesResponse, error) {
+	protoResp := &tfplugin6.ReadStateBytes_Response{}
+
+	if err := s.replayer.Replay("ReadStateBytes", toproto.ReadStateBytes_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ReadStateBytesResponse(protoResp)
+}
+
+func (s *replayServer) WriteStateBytes(_ context.Context, req *tfprotov6.WriteStateBytesRequest) (*tfprotov6.WriteStateBytesResponse, error) {
+	protoResp := &tfplugin6.WriteStateBytes_Response{}
+
+	if err := s.replayer.Replay("WriteStateBytes", toproto.WriteStateBytes_RequestChunk(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.WriteStateBytesResponse(protoResp)
+}
+
+func (s *replayServer) LockState(_ context.Context, req *tfprotov6.LockStateRequest) (*tfprotov6.LockStateResponse, error) {
+	protoResp := &tfplugin6.LockState_Response{}
+
+	if err := s.replayer.Replay("LockState", toproto.LockState_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.LockStateResponse(protoResp)
+}
+
+func (s *replayServer) UnlockState(_ context.Context, req *tfprotov6.UnlockStateRequest) (*tfprotov6.UnlockStateResponse, error) {
+	protoResp := &tfplugin6.UnlockState_Response{}
+
+	if err := s.replayer.Replay("UnlockState", toproto.UnlockState_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.UnlockStateResponse(protoResp)
+}
+
+func (s *replayServer) GetStates(_ context.Context, req *tfprotov6.GetStatesRequest) (*tfprotov6.GetStatesResponse, error) {
+	protoResp := &tfplugin6.GetStates_Response{}
+
+	if err := s.replayer.Replay("GetStates", toproto.GetStates_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetStatesResponse(protoResp)
+}
+
+func (s *replayServer) DeleteState(_ context.Context, req *tfprotov6.DeleteStateRequest) (*tfprotov6.DeleteStateResponse, error) {
+	protoResp := &tfplugin6.DeleteState_Response{}
+
+	if err := s.replayer.Replay("DeleteState", toproto.DeleteState_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.DeleteStateResponse(protoResp)
+}
+
+func (s *replayServer) ValidateResourceConfig(_ context.Context, req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
+	protoResp := &tfplugin6.ValidateResourceConfig_Response{}
+
+	if err := s.replayer.Replay("ValidateResourceConfig", toproto.ValidateResourceConfig_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ValidateResourceConfigResponse(protoResp)
+}
+
+func (s *replayServer) UpgradeResourceState(_ context.Context, req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
+	protoResp := &tfplugin6.UpgradeResourceState_Response{}
+
+	if err := s.replayer.Replay("UpgradeResourceState", toproto.UpgradeResourceState_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.UpgradeResourceStateResponse(protoResp)
+}
+
+func (s *replayServer) ReadResource(_ context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	protoResp := &tfplugin6.ReadResource_Response{}
+
+	if err := s.replayer.Replay("ReadResource", toproto.ReadResource_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ReadResourceResponse(protoResp)
+}
+
+func (s *replayServer) PlanResourceChange(_ context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	protoResp := &tfplugin6.PlanResourceChange_Response{}
+
+	if err := s.replayer.Replay("PlanResourceChange", toproto.PlanResourceChange_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.PlanResourceChangeResponse(protoResp)
+}
+
+func (s *replayServer) ApplyResourceChange(_ context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	protoResp := &tfplugin6.ApplyResourceChange_Response{}
+
+	if err := s.replayer.Replay("ApplyResourceChange", toproto.ApplyResourceChange_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ApplyResourceChangeResponse(protoResp)
+}
+
+func (s *replayServer) ImportResourceState(_ context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	protoResp := &tfplugin6.ImportResourceState_Response{}
+
+	if err := s.replayer.Replay("ImportResourceState", toproto.ImportResourceState_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.ImportResourceStateResponse(protoResp)
+}
+
+func (s *replayServer) MoveResourceState(_ context.Context, req *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error) {
+	protoResp := &tfplugin6.MoveResourceState_Response{}
+
+	if err := s.replayer.Replay("MoveResourceState", toproto.MoveResourceState_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.MoveResourceStateResponse(protoResp)
+}
+
+func (s *replayServer) CallFunction(_ context.Context, req *tfprotov6.CallFunctionRequest) (*tfprotov6.CallFunctionResponse, error) {
+	protoResp := &tfplugin6.CallFunction_Response{}
+
+	if err := s.replayer.Replay("CallFunction", toproto.CallFunction_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.CallFunctionResponse(protoResp), nil
+}
+
+func (s *replayServer) GetFunctions(_ context.Context, req *tfprotov6.GetFunctionsRequest) (*tfprotov6.GetFunctionsResponse, error) {
+	protoResp := &tfplugin6.GetFunctions_Response{}
+
+	if err := s.replayer.Replay("GetFunctions", toproto.GetFunctions_Request(req), protoResp); err != nil {
+		return nil, err
+	}
+
+	return fromproto.GetFunctionsResponse(protoResp)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+)
+
+type testReplayProviderServer struct {
+	tfprotov6.ProviderServer
+}
+
+func (s testReplayProviderServer) ReadResource(_ context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	return &tfprotov6.ReadResourceResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  "test summary",
+				Detail:   "test detail",
+			},
+		},
+		NewState: req.CurrentState,
+		Private:  []byte(`{"read":"` + req.TypeName + `"}`),
+	}, nil
+}
+
+func TestNewReplayProviderServer(t *testing.T) {
+	t.Parallel()
+
+	var recording bytes.Buffer
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testReplayProviderServer{}, tf6server.WithRecording(&recording))
+
+	_, err := server.ReadResource(context.Background(), &tfplugin6.ReadResource_Request{
+		CurrentState: &tfplugin6.DynamicValue{
+			Json: []byte(`{"id":"test"}`),
+		},
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error recording: %s", err)
+	}
+
+	replayServer, err := tf6server.NewReplayProviderServer(&recording)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	req := &tfprotov6.ReadResourceRequest{
+		CurrentState: &tfprotov6.DynamicValue{
+			JSON: []byte(`{"id":"test"}`),
+		},
+		TypeName: "test_resource",
+	}
+
+	got, err := replayServer.ReadResource(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("unexpected error replaying: %s", err)
+	}
+
+	expected := &tfprotov6.ReadResourceResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  "test summary",
+				Detail:   "test detail",
+			},
+		},
+		NewState: &tfprotov6.DynamicValue{
+			JSON: []byte(`{"id":"test"}`),
+		},
+		Private: []byte(`{"read":"test_resource"}`),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = replayServer.ReadResource(context.Background(), req)
+
+	if err == nil {
+		t.Fatal("expected error replaying an already replayed exchange")
+	}
+
+	_, err = replayServer.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName: "other_resource",
+	})
+
+	if err == nil {
+		t.Fatal("expected error replaying an unrecorded request")
+	}
+}
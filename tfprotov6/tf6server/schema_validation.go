@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// schemaNameRegexp matches valid Terraform configuration identifiers for
+// type names.
+var schemaNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// validateProviderSchema calls GetProviderSchema on the provider server and
+// returns an error describing every protocol violation in the returned
+// schemas, as enabled by WithProviderSchemaValidation.
+func validateProviderSchema(ctx context.Context, provider tfprotov6.ProviderServer) error {
+	resp, err := provider.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return fmt.Errorf("Error validating provider schema: %w", err)
+	}
+
+	if resp == nil {
+		return errors.New("Error validating provider schema: GetProviderSchema returned no response")
+	}
+
+	var errs []error
+
+	for _, diag := range resp.Diagnostics {
+		if diag != nil && diag.Severity == tfprotov6.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("error diagnostic: %s: %s", diag.Summary, diag.Detail))
+		}
+	}
+
+	if resp.Provider != nil {
+		errs = append(errs, validateSchema("provider", resp.Provider)...)
+	}
+
+	if resp.ProviderMeta != nil {
+		errs = append(errs, validateSchema("provider_meta", resp.ProviderMeta)...)
+	}
+
+	errs = append(errs, validateSchemas("resource", resp.ResourceSchemas)...)
+	errs = append(errs, validateSchemas("data source", resp.DataSourceSchemas)...)
+	errs = append(errs, validateSchemas("ephemeral resource", resp.EphemeralResourceSchemas)...)
+
+	actionSchemas := make(map[string]*tfprotov6.Schema, len(resp.ActionSchemas))
+
+	for typeName, actionSchema := range resp.ActionSchemas {
+		if actionSchema == nil {
+			actionSchemas[typeName] = nil
+
+			continue
+		}
+
+		actionSchemas[typeName] = actionSchema.Schema
+	}
+
+	errs = append(errs, validateSchemas("action", actionSchemas)...)
+	errs = append(errs, validateSchemas("state store", resp.StateStoreSchemas)...)
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("Error validating provider schema: %w", errors.Join(errs...))
+}
+
+// validateSchemas returns errors for invalid type names and schemas, in type
+// name order.
+func validateSchemas(kind string, schemas map[string]*tfprotov6.Schema) []error {
+	typeNames := make([]string, 0, len(schemas))
+
+	for typeName := range schemas {
+		typeNames = append(typeNames, typeName)
+	}
+
+	sort.Strings(typeNames)
+
+	var errs []error
+
+	for _, typeName := range typeNames {
+		prefix := fmt.Sprintf("%s %q", kind, typeName)
+
+		if !schemaNameRegexp.MatchString(typeName) {
+			errs = append(errs, fmt.Errorf("%s: invalid type name", prefix))
+		}
+
+		errs = append(errs, validateSchema(prefix, schemas[typeName])...)
+	}
+
+	return errs
+}
+
+// validateSchema returns errors for an invalid schema, prefixing each
+// underlying error of Schema.Validate.
+func validateSchema(prefix string, schema *tfprotov6.Schema) []error {
+	err := schema.Validate()
+
+	if err == nil {
+		return nil
+	}
+
+	unwrapped := []error{err}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		unwrapped = joined.Unwrap()
+	}
+
+	errs := make([]error, 0, len(unwrapped))
+
+	for _, e := range unwrapped {
+		errs = append(errs, fmt.Errorf("%s: %w", prefix, e))
+	}
+
+	return errs
+}
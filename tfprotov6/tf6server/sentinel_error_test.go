@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+)
+
+type testSentinelErrorProviderServer struct {
+	tfprotov6.UnimplementedProviderServer
+
+	err error
+}
+
+func (s testSentinelErrorProviderServer) GetMetadata(_ context.Context, _ *tfprotov6.GetMetadataRequest) (*tfprotov6.GetMetadataResponse, error) {
+	return nil, s.err
+}
+
+func (s testSentinelErrorProviderServer) ReadResource(_ context.Context, _ *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	return nil, s.err
+}
+
+func (s testSentinelErrorProviderServer) PlanResourceChange(_ context.Context, _ *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	return nil, s.err
+}
+
+func TestSentinelErrors_ResourceNotFound(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testSentinelErrorProviderServer{
+		err: fmt.Errorf("test_resource: %w", tfprotov6.ErrResourceNotFound),
+	})
+
+	readResp, err := server.ReadResource(context.Background(), &tfplugin6.ReadResource_Request{
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if readResp.NewState != nil {
+		t.Errorf("expected null NewState, got: %s", readResp.NewState)
+	}
+
+	if len(readResp.Diagnostics) != 0 {
+		t.Errorf("unexpected ReadResource diagnostics: %v", readResp.Diagnostics)
+	}
+
+	planResp, err := server.PlanResourceChange(context.Background(), &tfplugin6.PlanResourceChange_Request{
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedDiagnostics := []*tfplugin6.Diagnostic{
+		{
+			Severity: tfplugin6.Diagnostic_ERROR,
+			Summary:  "Resource Not Found",
+			Detail:   "test_resource: resource not found",
+		},
+	}
+
+	if diff := cmp.Diff(planResp.Diagnostics, expectedDiagnostics, cmp.Comparer(func(x, y *tfplugin6.Diagnostic) bool {
+		return x.Severity == y.Severity && x.Summary == y.Summary && x.Detail == y.Detail
+	})); diff != "" {
+		t.Errorf("unexpected PlanResourceChange diagnostics difference: %s", diff)
+	}
+}
+
+func TestSentinelErrors_UnsupportedRPC(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testSentinelErrorProviderServer{
+		err: tfprotov6.ErrUnsupportedRPC,
+	})
+
+	_, err := server.GetMetadata(context.Background(), &tfplugin6.GetMetadata_Request{})
+
+	if diff := cmp.Diff(status.Code(err), codes.Unimplemented); diff != "" {
+		t.Errorf("unexpected code difference: %s", diff)
+	}
+}
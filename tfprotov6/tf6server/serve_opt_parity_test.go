@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// protocolV5OnlyServeOpts contains the tf5server ServeOpt functions which
+// are intentionally not available in tf6server.
+var protocolV5OnlyServeOpts = map[string]bool{
+	// Protocol version 6 has no deprecated RPCs.
+	"WithDeprecatedRPCLogs": true,
+}
+
+// TestServeOptParity verifies tf5server and tf6server expose the same
+// ServeOpt functions, so the packages do not drift apart.
+func TestServeOptParity(t *testing.T) {
+	t.Parallel()
+
+	v5ServeOpts := testServeOptFuncs(t, filepath.Join("..", "..", "tfprotov5", "tf5server"))
+	v6ServeOpts := testServeOptFuncs(t, ".")
+
+	if len(v5ServeOpts) == 0 {
+		t.Fatal("expected tf5server ServeOpt functions, got none")
+	}
+
+	var expected []string
+
+	for _, name := range v5ServeOpts {
+		if !protocolV5OnlyServeOpts[name] {
+			expected = append(expected, name)
+		}
+	}
+
+	if diff := cmp.Diff(v6ServeOpts, expected); diff != "" {
+		t.Errorf("tf6server ServeOpt functions differ from tf5server: %s", diff)
+	}
+}
+
+// testServeOptFuncs returns the sorted names of exported functions returning
+// ServeOpt in the package source directory.
+func testServeOptFuncs(t *testing.T, dir string) []string {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+
+	if err != nil {
+		t.Fatalf("unable to list package files: %s", err)
+	}
+
+	var result []string
+
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+
+		if err != nil {
+			t.Fatalf("unable to parse %s: %s", path, err)
+		}
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Recv != nil || !funcDecl.Name.IsExported() {
+				continue
+			}
+
+			results := funcDecl.Type.Results
+
+			if results == nil || len(results.List) != 1 {
+				continue
+			}
+
+			if ident, ok := results.List[0].Type.(*ast.Ident); ok && ident.Name == "ServeOpt" {
+				result = append(result, funcDecl.Name.Name)
+			}
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
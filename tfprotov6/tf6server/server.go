@@ -5,20 +5,27 @@ package tf6server
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"regexp"
 	"runtime"
-	"strings"
+	"slices"
 	"sync"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/hashicorp/terraform-plugin-go/internal/clock"
 	"github.com/hashicorp/terraform-plugin-go/internal/logging"
+	"github.com/hashicorp/terraform-plugin-go/internal/manageddebug"
+	"github.com/hashicorp/terraform-plugin-go/internal/ratelimit"
+	"github.com/hashicorp/terraform-plugin-go/internal/recording"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/fromproto"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tf6serverlogging"
@@ -36,35 +43,18 @@ const (
 	// protocolVersionMajor represents the major version number of the protocol
 	// being served. This is used during the plugin handshake to validate the
 	// server and client are compatible.
-	//
-	// In the future, it may be possible to include this information directly
-	// in the protocol buffers rather than recreating a constant here.
-	protocolVersionMajor uint = 6
+	protocolVersionMajor uint = tfprotov6.ProtocolVersionMajor
 
 	// protocolVersionMinor represents the minor version number of the protocol
-	// being served. Backwards compatible additions are possible in the
-	// protocol definitions, which is when this may be increased. While it is
-	// not used in plugin negotiation, it can be helpful to include this value
-	// for debugging, such as in logs.
-	//
-	// In the future, it may be possible to include this information directly
-	// in the protocol buffers rather than recreating a constant here.
-	protocolVersionMinor uint = 6
+	// being served. While it is not used in plugin negotiation, it can be
+	// helpful to include this value for debugging, such as in logs.
+	protocolVersionMinor uint = tfprotov6.ProtocolVersionMinor
 )
 
 // protocolVersion represents the combined major and minor version numbers of
 // the protocol being served.
 var protocolVersion string = fmt.Sprintf("%d.%d", protocolVersionMajor, protocolVersionMinor)
 
-const (
-	// envTfReattachProviders is the environment variable used by Terraform CLI
-	// to directly connect to already running provider processes, such as those
-	// being inspected by debugging processes. When connecting to providers in
-	// this manner, Terraform CLI disables certain plugin handshake checks and
-	// will not stop the provider process.
-	envTfReattachProviders = "TF_REATTACH_PROVIDERS"
-)
-
 const (
 	// grpcMaxMessageSize is the maximum gRPC send and receive message sizes
 	// for the server.
@@ -85,6 +75,20 @@ const (
 	grpcMaxMessageSize = 256 << 20
 )
 
+// ErrProviderStopped is the cause of request context cancellation when
+// Terraform calls the StopProvider RPC, such as when a user interrupts Terraform.
+// Downstream implementations can compare context.Cause(ctx) with this error
+// to distinguish Terraform interrupts from other cancellations, such as the
+// client disconnecting or a deadline being exceeded.
+var ErrProviderStopped = errors.New("provider stopped by Terraform")
+
+// ErrServerShutdown is the cause of request context cancellation when the
+// server shuts itself down, such as when the debug context given to
+// WithDebug is canceled or a managed debug server restarts. Downstream
+// implementations can compare context.Cause(ctx) with this error to
+// distinguish server shutdowns from Terraform interrupts.
+var ErrServerShutdown = errors.New("provider server shut down")
+
 // ServeOpt is an interface for defining options that can be passed to the
 // Serve function. Each implementation modifies the ServeConfig being
 // generated. A slice of ServeOpts then, cumulatively applied, render a full
@@ -101,14 +105,45 @@ type ServeConfig struct {
 	debugCh      chan *plugin.ReattachConfig
 	debugCloseCh chan struct{}
 
+	// shutdownCh is closed once the server shuts itself down.
+	shutdownCh chan struct{}
+
 	managedDebug                      bool
 	managedDebugReattachConfigTimeout time.Duration
 	managedDebugStopSignals           []os.Signal
+	managedDebugRefreshSignals        []os.Signal
+	managedDebugRestartSignals        []os.Signal
+	managedDebugReattachConfigFunc    func(string)
+	managedDebugEnvFile               string
 
 	disableLogInitStderr bool
 	disableLogLocation   bool
 	useLoggingSink       testing.T
 	envVar               string
+
+	recordingWriter io.Writer
+
+	clock Clock
+
+	slowRPCThreshold time.Duration
+
+	verifyImportedResources bool
+
+	planAnnotationDiagnostics bool
+
+	validateProviderSchema bool
+
+	rpcRateLimits map[string]rpcRateLimit
+
+	autoMTLSMode AutoMTLSMode
+	tlsProvider  func() (*tls.Config, error)
+
+	grpcKeepaliveParams *keepalive.ServerParameters
+	grpcKeepalivePolicy *keepalive.EnforcementPolicy
+
+	handshakeConfig *plugin.HandshakeConfig
+
+	standaloneFunc func() error
 }
 
 type serveConfigFunc func(*ServeConfig) error
@@ -142,8 +177,11 @@ func WithDebug(ctx context.Context, config chan *plugin.ReattachConfig, closeCh
 // Reattach configuration is output to stdout with human friendly instructions.
 // By default, the server can be stopped with os.Interrupt (SIGINT; ctrl-c).
 //
-// Refer to the optional WithManagedDebugStopSignals and
-// WithManagedDebugReattachConfigTimeout ServeOpt for additional configuration.
+// Refer to the optional WithManagedDebugStopSignals,
+// WithManagedDebugRefreshSignals, WithManagedDebugRestartSignals,
+// WithManagedDebugReattachConfigFunc, WithManagedDebugEnvFile, and
+// WithManagedDebugReattachConfigTimeout ServeOpt for additional
+// configuration.
 //
 // The reattach configuration output of this handling is not protected by
 // compatibility guarantees. Use the WithDebug ServeOpt for advanced use cases.
@@ -168,6 +206,74 @@ func WithManagedDebugStopSignals(signals []os.Signal) ServeOpt {
 	})
 }
 
+// WithManagedDebugRefreshSignals returns a ServeOpt that will set the signals
+// which cause a debug managed process (WithManagedDebug) to output its
+// reattach configuration and status again, such as syscall.SIGHUP. This is
+// useful when the original output is no longer available. When not
+// configured, no signals are handled.
+func WithManagedDebugRefreshSignals(signals []os.Signal) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		in.managedDebugRefreshSignals = signals
+		return nil
+	})
+}
+
+// WithManagedDebugRestartSignals returns a ServeOpt that will set the signals
+// which cause a debug managed process (WithManagedDebug) to restart in place,
+// such as syscall.SIGUSR2. When restarted, the process stops accepting new
+// connections, waits for active connections such as running Terraform
+// commands to close, and then replaces itself with a new execution of the
+// provider executable. The process ID and address in the reattach
+// configuration are unchanged, so recompiled provider code can be debugged
+// without updating the TF_REATTACH_PROVIDERS environment variable. When not
+// configured, no signals are handled.
+//
+// This functionality is experimental and only supported on Unix platforms.
+// It may change or be removed in future versions.
+func WithManagedDebugRestartSignals(signals []os.Signal) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		if len(signals) > 0 && runtime.GOOS == "windows" {
+			return errors.New("restart signals are not supported on Windows")
+		}
+		in.managedDebugRestartSignals = signals
+		return nil
+	})
+}
+
+// WithManagedDebugReattachConfigFunc returns a ServeOpt that will call the
+// given function with the reattach configuration of a debug managed process
+// (WithManagedDebug), in addition to outputting it to stdout. The reattach
+// configuration is the JSON value for the TF_REATTACH_PROVIDERS environment
+// variable, which wrapper programs can pass to a Terraform CLI process.
+//
+// The function is called once the server is ready to accept connections and
+// must not block, as the server lifecycle is not managed until it returns.
+func WithManagedDebugReattachConfigFunc(f func(reattachConfig string)) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		if f == nil {
+			return errors.New("reattach configuration function cannot be nil")
+		}
+		in.managedDebugReattachConfigFunc = f
+		return nil
+	})
+}
+
+// WithManagedDebugEnvFile returns a ServeOpt that will write the reattach
+// configuration of a debug managed process (WithManagedDebug) to the given
+// file path in dotenv format, in addition to outputting it to stdout. Tooling
+// such as direnv or editor launch configurations can then load the
+// TF_REATTACH_PROVIDERS environment variable automatically. The file is
+// overwritten if it exists and is removed when the server stops.
+func WithManagedDebugEnvFile(path string) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		if path == "" {
+			return errors.New("env file path cannot be empty")
+		}
+		in.managedDebugEnvFile = path
+		return nil
+	})
+}
+
 // WithManagedDebugReattachConfigTimeout returns a ServeOpt that will set the timeout
 // for a debug managed process to start and return its reattach configuration.
 // When not configured, 2 seconds is the default.
@@ -178,6 +284,15 @@ func WithManagedDebugReattachConfigTimeout(timeout time.Duration) ServeOpt {
 	})
 }
 
+// withShutdown returns a ServeOpt that will cancel in-flight request contexts
+// with ErrServerShutdown once shutdownCh is closed.
+func withShutdown(shutdownCh chan struct{}) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		in.shutdownCh = shutdownCh
+		return nil
+	})
+}
+
 // WithGoPluginLogger returns a ServeOpt that will set the logger that
 // go-plugin should use to log messages.
 func WithGoPluginLogger(logger hclog.Logger) ServeOpt {
@@ -190,6 +305,11 @@ func WithGoPluginLogger(logger hclog.Logger) ServeOpt {
 // WithLoggingSink returns a ServeOpt that will enable the logging sink, which
 // is used in test frameworks to control where terraform-plugin-log output is
 // written and at what levels, mimicking Terraform's logging sink behaviors.
+//
+// Without the logging sink, terraform-plugin-log output is always written as
+// JSON lines. With the logging sink, output is human readable text unless the
+// TF_LOG environment variable is set to JSON, which enables JSON lines at the
+// TRACE level.
 func WithLoggingSink(t testing.T) ServeOpt {
 	return serveConfigFunc(func(in *ServeConfig) error {
 		in.useLoggingSink = t
@@ -232,6 +352,317 @@ func WithLogEnvVarName(name string) ServeOpt {
 	})
 }
 
+// WithRecording returns a ServeOpt that will record every successful RPC
+// request and response exchange to the given writer as JSON Lines. The
+// recording can later be replayed with NewReplayProviderServer, enabling
+// deterministic testing of Terraform configurations without calling the
+// real provider.
+//
+// Recordings contain all protocol data, including sensitive values, and
+// should be handled accordingly.
+func WithRecording(w io.Writer) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		in.recordingWriter = w
+		return nil
+	})
+}
+
+// Clock is a source of time for the server, such as for measuring request
+// durations and waiting on timeouts. It can be replaced with WithClock to
+// control time in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After waits for the duration to elapse and then sends the current
+	// time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// WithClock returns a ServeOpt that will set the Clock used by the server.
+// When not configured, the system time is used.
+func WithClock(c Clock) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		if c == nil {
+			return errors.New("clock cannot be nil")
+		}
+		in.clock = c
+		return nil
+	})
+}
+
+// WithSlowRPCThreshold returns a ServeOpt that will generate a WARN log for
+// any downstream request which takes longer than the given duration. The log
+// is generated as soon as the duration elapses, even if the downstream
+// request has not returned, and includes the RPC name, resource or data
+// source type, and elapsed time, which can help identify operations that
+// appear to hang. When not configured, no slow RPC logs are generated.
+func WithSlowRPCThreshold(threshold time.Duration) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		if threshold <= 0 {
+			return errors.New("slow RPC threshold must be greater than zero")
+		}
+		in.slowRPCThreshold = threshold
+		return nil
+	})
+}
+
+// WithImportedResourceVerification returns a ServeOpt that will verify each
+// resource returned by ImportResourceState by calling ReadResource with the
+// imported state. Error diagnostics are added to the ImportResourceState
+// response if the read fails, returns a null state, or returns a state which
+// does not match the resource schema. This can surface broken import
+// implementations early and precisely, but causes additional downstream
+// requests, so it is intended for development and testing.
+func WithImportedResourceVerification() ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		in.verifyImportedResources = true
+		return nil
+	})
+}
+
+// WithPlanAnnotationDiagnostics returns a ServeOpt that will add a warning
+// diagnostic to the PlanResourceChange response for each annotation in the
+// PlanResourceChangeResponse Annotations field. Annotations are always
+// logged, but warning diagnostics are also displayed by Terraform, which
+// helps practitioners understand why a plan contains unknown values or
+// resource replacements.
+func WithPlanAnnotationDiagnostics() ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		in.planAnnotationDiagnostics = true
+		return nil
+	})
+}
+
+// WithProviderSchemaValidation returns a ServeOpt that will call
+// GetProviderSchema on a new provider server when Serve is called and
+// validate the returned schemas for protocol violations, such as nil blocks,
+// invalid type names, or duplicate attribute names. Serve returns an error
+// describing every violation instead of starting the server, rather than
+// Terraform reporting errors when later decoding data with the schemas.
+func WithProviderSchemaValidation() ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		in.validateProviderSchema = true
+		return nil
+	})
+}
+
+// rpcNames contains the name of every RPC handled by the server, as used in
+// the tf_rpc field in logs. It must be updated whenever an RPC is added.
+var rpcNames = map[string]struct{}{
+	"ApplyResourceChange":             {},
+	"CallFunction":                    {},
+	"CloseEphemeralResource":          {},
+	"ConfigureProvider":               {},
+	"ConfigureStateStore":             {},
+	"DeleteState":                     {},
+	"GetFunctions":                    {},
+	"GetMetadata":                     {},
+	"GetProviderSchema":               {},
+	"GetStates":                       {},
+	"ImportResourceState":             {},
+	"InvokeAction":                    {},
+	"LockState":                       {},
+	"MoveResourceState":               {},
+	"OpenEphemeralResource":           {},
+	"PlanResourceChange":              {},
+	"ReadDataSource":                  {},
+	"ReadResource":                    {},
+	"ReadStateBytes":                  {},
+	"RenewEphemeralResource":          {},
+	"StopProvider":                    {},
+	"UnlockState":                     {},
+	"UpgradeResourceState":            {},
+	"ValidateActionConfig":            {},
+	"ValidateDataResourceConfig":      {},
+	"ValidateEphemeralResourceConfig": {},
+	"ValidateProviderConfig":          {},
+	"ValidateResourceConfig":          {},
+	"ValidateStateStoreConfig":        {},
+	"WriteStateBytes":                 {},
+}
+
+// rpcRateLimit is the configuration of a WithRPCRateLimit ServeOpt.
+type rpcRateLimit struct {
+	limit float64
+	burst int
+}
+
+// WithRPCRateLimit returns a ServeOpt that will limit the rate of downstream
+// requests for the given RPC, such as ReadResource, to limit requests per
+// second on average, with bursts of up to burst requests. The RPC name is the
+// same as the tf_rpc field in logs. Requests over the limit wait until they
+// are allowed or Terraform cancels the request. This can be used to enforce
+// API rate limits centrally for providers wrapping heavily throttled APIs.
+// When not configured, requests are not limited.
+//
+// The StopProvider RPC cannot be limited, so the provider can always be
+// stopped. An error is returned for unknown RPC names, such as misspelled
+// names.
+func WithRPCRateLimit(rpc string, limit float64, burst int) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		if _, ok := rpcNames[rpc]; !ok {
+			return fmt.Errorf("unknown RPC %q cannot be rate limited", rpc)
+		}
+
+		if rpc == "StopProvider" {
+			return errors.New("the StopProvider RPC cannot be rate limited")
+		}
+
+		if limit <= 0 {
+			return errors.New("RPC rate limit must be greater than zero")
+		}
+
+		if burst < 1 {
+			return errors.New("RPC rate limit burst must be at least one")
+		}
+
+		if in.rpcRateLimits == nil {
+			in.rpcRateLimits = map[string]rpcRateLimit{}
+		}
+
+		in.rpcRateLimits[rpc] = rpcRateLimit{
+			limit: limit,
+			burst: burst,
+		}
+		return nil
+	})
+}
+
+// WithStandaloneFunc returns a ServeOpt that will call the given function
+// when the provider binary is executed directly, rather than by Terraform or
+// in debug mode, instead of outputting the go-plugin message that the binary
+// is a plugin which should not be executed directly. Serve then returns the
+// error returned by the function, if any, without serving the provider. This
+// can be used to output installation instructions or to suggest running the
+// provider in debug mode.
+func WithStandaloneFunc(f func() error) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		if f == nil {
+			return errors.New("standalone function cannot be nil")
+		}
+		in.standaloneFunc = f
+		return nil
+	})
+}
+
+// envPluginClientCert is the environment variable go-plugin clients use to
+// pass their certificate when negotiating AutoMTLS.
+const envPluginClientCert = "PLUGIN_CLIENT_CERT"
+
+// AutoMTLSMode controls how the server handles go-plugin automatic mutual TLS
+// (AutoMTLS), which Terraform requests by default when starting providers.
+type AutoMTLSMode int
+
+const (
+	// AutoMTLSModeDefault enables AutoMTLS when Terraform requests it,
+	// which is the go-plugin default behavior.
+	AutoMTLSModeDefault AutoMTLSMode = 0
+
+	// AutoMTLSModeDisabled serves the provider without TLS, even if
+	// Terraform requests AutoMTLS. The client must then connect without
+	// TLS, such as when reattaching to a provider in debug mode from an
+	// environment which has inherited Terraform's client certificate.
+	//
+	// go-plugin always enables AutoMTLS when the PLUGIN_CLIENT_CERT
+	// environment variable is set, so Serve unsets it while go-plugin
+	// starts and restores it before any RPCs are handled. Other servers
+	// started concurrently in the same process may not see the variable
+	// during that time.
+	AutoMTLSModeDisabled AutoMTLSMode = 1
+
+	// AutoMTLSModeRequired causes Serve to return an error if Terraform
+	// did not request AutoMTLS, rather than serving without TLS.
+	AutoMTLSModeRequired AutoMTLSMode = 2
+)
+
+// WithAutoMTLS returns a ServeOpt that will set how the server handles
+// go-plugin AutoMTLS. When not configured, AutoMTLSModeDefault is used.
+//
+// This option cannot be combined with WithTLSProvider.
+func WithAutoMTLS(mode AutoMTLSMode) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		if in.tlsProvider != nil {
+			return errors.New("cannot set both WithAutoMTLS and WithTLSProvider")
+		}
+
+		switch mode {
+		case AutoMTLSModeDefault, AutoMTLSModeDisabled, AutoMTLSModeRequired:
+		default:
+			return fmt.Errorf("unknown AutoMTLS mode: %d", mode)
+		}
+
+		in.autoMTLSMode = mode
+		return nil
+	})
+}
+
+// WithTLSProvider returns a ServeOpt that will configure the server to use
+// the TLS configuration returned by the passed function instead of AutoMTLS,
+// such as when custom certificate material is required. Terraform must be
+// configured to trust the certificate separately.
+//
+// This option cannot be combined with WithAutoMTLS.
+func WithTLSProvider(provider func() (*tls.Config, error)) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		if provider == nil {
+			return errors.New("TLS provider cannot be nil")
+		}
+
+		if in.autoMTLSMode != AutoMTLSModeDefault {
+			return errors.New("cannot set both WithAutoMTLS and WithTLSProvider")
+		}
+
+		in.tlsProvider = provider
+		return nil
+	})
+}
+
+// WithGRPCKeepalive returns a ServeOpt that will configure the keepalive
+// parameters and keepalive enforcement policy of the gRPC server. By default,
+// the gRPC server does not send keepalive pings and closes connections of
+// clients which send pings more often than every five minutes. Long-lived
+// connections over unreliable networks, such as debug sessions using TCP or
+// remote containers, can be kept healthy by sending keepalive pings and
+// permitting client pings.
+//
+// Refer to the google.golang.org/grpc/keepalive package documentation for
+// details about each field.
+func WithGRPCKeepalive(params keepalive.ServerParameters, policy keepalive.EnforcementPolicy) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		if params.MaxConnectionIdle < 0 || params.MaxConnectionAge < 0 || params.MaxConnectionAgeGrace < 0 ||
+			params.Time < 0 || params.Timeout < 0 || policy.MinTime < 0 {
+			return errors.New("gRPC keepalive durations cannot be negative")
+		}
+
+		in.grpcKeepaliveParams = &params
+		in.grpcKeepalivePolicy = &policy
+		return nil
+	})
+}
+
+// WithHandshakeConfig returns a ServeOpt that will override the go-plugin
+// handshake configuration, which includes the magic cookie environment
+// variable and the protocol version advertised to the client. Terraform CLI
+// will not be able to start the provider unless the configuration matches
+// its expectations, so this is only intended for test harnesses and custom
+// orchestrators which start providers outside of Terraform CLI and perform
+// their own handshake.
+func WithHandshakeConfig(config plugin.HandshakeConfig) ServeOpt {
+	return serveConfigFunc(func(in *ServeConfig) error {
+		if config.MagicCookieKey == "" || config.MagicCookieValue == "" {
+			return errors.New("handshake magic cookie key and value cannot be empty")
+		}
+
+		if config.ProtocolVersion == 0 {
+			return errors.New("handshake protocol version cannot be zero")
+		}
+
+		in.handshakeConfig = &config
+		return nil
+	})
+}
+
 // Serve starts a tfprotov6.ProviderServer serving, ready for Terraform to
 // connect to it. The name passed in should be the fully qualified name that
 // users will enter in the source field of the required_providers block, like
@@ -245,6 +676,7 @@ func Serve(name string, serverFactory func() tfprotov6.ProviderServer, opts ...S
 	// Defaults
 	conf := ServeConfig{
 		managedDebugReattachConfigTimeout: 2 * time.Second,
+		clock:                             clock.Real(),
 		managedDebugStopSignals:           []os.Signal{os.Interrupt},
 	}
 
@@ -255,6 +687,16 @@ func Serve(name string, serverFactory func() tfprotov6.ProviderServer, opts ...S
 		}
 	}
 
+	// restoreClientCert restores the AutoMTLS client certificate
+	// environment variable if it was unset to disable AutoMTLS.
+	restoreClientCert := func() {}
+
+	provider := &GRPCProviderPlugin{
+		GRPCProvider: serverFactory,
+		Opts:         opts,
+		Name:         name,
+	}
+
 	serveConfig := &plugin.ServeConfig{
 		HandshakeConfig: plugin.HandshakeConfig{
 			ProtocolVersion:  protocolVersionMajor,
@@ -262,16 +704,24 @@ func Serve(name string, serverFactory func() tfprotov6.ProviderServer, opts ...S
 			MagicCookieValue: "d602bf8f470bc67ca7faa0386276bbdd4330efaf76d1a219cb4d6991ca9872b2",
 		},
 		Plugins: plugin.PluginSet{
-			"provider": &GRPCProviderPlugin{
-				GRPCProvider: serverFactory,
-				Opts:         opts,
-				Name:         name,
-			},
+			"provider": provider,
 		},
 		GRPCServer: func(opts []grpc.ServerOption) *grpc.Server {
+			// go-plugin has read the client certificate environment
+			// variable by the time the gRPC server is created.
+			restoreClientCert()
+
 			opts = append(opts, grpc.MaxRecvMsgSize(grpcMaxMessageSize))
 			opts = append(opts, grpc.MaxSendMsgSize(grpcMaxMessageSize))
 
+			if conf.grpcKeepaliveParams != nil {
+				opts = append(opts, grpc.KeepaliveParams(*conf.grpcKeepaliveParams))
+			}
+
+			if conf.grpcKeepalivePolicy != nil {
+				opts = append(opts, grpc.KeepaliveEnforcementPolicy(*conf.grpcKeepalivePolicy))
+			}
+
 			return grpc.NewServer(opts...)
 		},
 	}
@@ -280,8 +730,55 @@ func Serve(name string, serverFactory func() tfprotov6.ProviderServer, opts ...S
 		serveConfig.Logger = conf.logger
 	}
 
+	if conf.handshakeConfig != nil {
+		serveConfig.HandshakeConfig = *conf.handshakeConfig
+	}
+
+	// Terraform sets the handshake environment variable when starting
+	// providers, so it is only missing when the binary is executed directly.
+	if conf.standaloneFunc != nil && conf.debugCh == nil && !conf.managedDebug &&
+		os.Getenv(serveConfig.MagicCookieKey) != serveConfig.MagicCookieValue {
+		return conf.standaloneFunc()
+	}
+
+	if conf.validateProviderSchema {
+		if err := validateProviderSchema(context.Background(), serverFactory()); err != nil {
+			return err
+		}
+	}
+
+	if conf.tlsProvider != nil {
+		serveConfig.TLSProvider = conf.tlsProvider
+	}
+
+	switch conf.autoMTLSMode {
+	case AutoMTLSModeDisabled:
+		// go-plugin enables AutoMTLS whenever the client certificate
+		// environment variable is set, without any configuration to
+		// disable it, so the variable is unset until the gRPC server is
+		// created.
+		if clientCert, ok := os.LookupEnv(envPluginClientCert); ok {
+			if err := os.Unsetenv(envPluginClientCert); err != nil {
+				return fmt.Errorf("error disabling AutoMTLS: %w", err)
+			}
+
+			restoreClientCert = sync.OnceFunc(func() {
+				_ = os.Setenv(envPluginClientCert, clientCert)
+			})
+
+			defer restoreClientCert()
+		}
+	case AutoMTLSModeRequired:
+		if os.Getenv(envPluginClientCert) == "" {
+			return errors.New("AutoMTLS is required, but Terraform did not provide a client certificate")
+		}
+	}
+
+	debugCancel := func() {}
+
 	if conf.managedDebug {
 		ctx, cancel := context.WithCancel(context.Background())
+		debugCancel = cancel
 		signalCh := make(chan os.Signal, len(conf.managedDebugStopSignals))
 
 		signal.Notify(signalCh, conf.managedDebugStopSignals...)
@@ -305,8 +802,27 @@ func Serve(name string, serverFactory func() tfprotov6.ProviderServer, opts ...S
 	}
 
 	if conf.debugCh != nil {
+		// go-plugin stops the gRPC server once its context is canceled,
+		// which cancels in-flight request contexts the same way as a client
+		// disconnecting, so they are first canceled with ErrServerShutdown.
+		shutdownCh := make(chan struct{})
+		pluginCtx, pluginCancel := context.WithCancel(context.Background())
+
+		defer pluginCancel()
+
+		go func() {
+			select {
+			case <-conf.debugCtx.Done():
+				close(shutdownCh)
+				pluginCancel()
+			case <-pluginCtx.Done():
+			}
+		}()
+
+		provider.Opts = append(slices.Clip(opts), withShutdown(shutdownCh))
+
 		serveConfig.Test = &plugin.ServeTestConfig{
-			Context:          conf.debugCtx,
+			Context:          pluginCtx,
 			ReattachConfigCh: conf.debugCh,
 			CloseCh:          conf.debugCloseCh,
 		}
@@ -323,7 +839,7 @@ func Serve(name string, serverFactory func() tfprotov6.ProviderServer, opts ...S
 
 	select {
 	case pluginReattachConfig = <-conf.debugCh:
-	case <-time.After(conf.managedDebugReattachConfigTimeout):
+	case <-conf.clock.After(conf.managedDebugReattachConfigTimeout):
 		return errors.New("timeout waiting on reattach configuration")
 	}
 
@@ -331,59 +847,84 @@ func Serve(name string, serverFactory func() tfprotov6.ProviderServer, opts ...S
 		return errors.New("nil reattach configuration received")
 	}
 
-	// Duplicate implementation is required because the go-plugin
-	// ReattachConfig.Addr implementation is not friendly for JSON encoding
-	// and to avoid importing terraform-exec.
-	type reattachConfigAddr struct {
-		Network string
-		String  string
-	}
+	// The reattach configuration address must remain valid across
+	// restarts, so Terraform connects through a proxy listener which is
+	// handed off to the restarted process.
+	reattachAddr := pluginReattachConfig.Addr
 
-	type reattachConfig struct {
-		Protocol        string
-		ProtocolVersion int
-		Pid             int
-		Test            bool
-		Addr            reattachConfigAddr
+	var restartProxy *manageddebug.Proxy
+
+	if len(conf.managedDebugRestartSignals) > 0 {
+		proxy, err := manageddebug.NewProxy(pluginReattachConfig.Addr)
+
+		if err != nil {
+			return fmt.Errorf("Error creating managed debug restart listener: %w", err)
+		}
+
+		defer func() {
+			_ = proxy.Close()
+		}()
+
+		go proxy.Serve()
+
+		restartProxy = proxy
+		reattachAddr = proxy.Addr()
 	}
 
-	reattachBytes, err := json.Marshal(map[string]reattachConfig{
-		name: {
-			Protocol:        string(pluginReattachConfig.Protocol),
-			ProtocolVersion: pluginReattachConfig.ProtocolVersion,
-			Pid:             pluginReattachConfig.Pid,
-			Test:            pluginReattachConfig.Test,
-			Addr: reattachConfigAddr{
-				Network: pluginReattachConfig.Addr.Network(),
-				String:  pluginReattachConfig.Addr.String(),
-			},
-		},
-	})
+	reattachStr, err := manageddebug.ReattachConfigString(name, pluginReattachConfig, reattachAddr)
 
 	if err != nil {
-		return fmt.Errorf("Error building reattach string: %w", err)
+		return err
 	}
 
-	reattachStr := string(reattachBytes)
+	startTime := conf.clock.Now()
 
 	// This is currently intended to be executed via provider main function and
 	// human friendly, so output directly to stdout.
-	fmt.Printf("Provider started. To attach Terraform CLI, set the %s environment variable with the following:\n\n", envTfReattachProviders)
+	manageddebug.WriteReattachConfig(os.Stdout, reattachStr)
+
+	if conf.managedDebugEnvFile != "" {
+		if err := manageddebug.WriteEnvFile(conf.managedDebugEnvFile, reattachStr); err != nil {
+			return err
+		}
+
+		defer func() {
+			_ = os.Remove(conf.managedDebugEnvFile)
+		}()
+	}
+
+	if conf.managedDebugReattachConfigFunc != nil {
+		conf.managedDebugReattachConfigFunc(reattachStr)
+	}
+
+	refreshCh := make(chan os.Signal, 1)
 
-	switch runtime.GOOS {
-	case "windows":
-		fmt.Printf("\tCommand Prompt:\tset \"%s=%s\"\n", envTfReattachProviders, reattachStr)
-		fmt.Printf("\tPowerShell:\t$env:%s='%s'\n", envTfReattachProviders, strings.ReplaceAll(reattachStr, `'`, `''`))
-	default:
-		fmt.Printf("\t%s='%s'\n", envTfReattachProviders, strings.ReplaceAll(reattachStr, `'`, `'"'"'`))
+	if len(conf.managedDebugRefreshSignals) > 0 {
+		signal.Notify(refreshCh, conf.managedDebugRefreshSignals...)
+		defer signal.Stop(refreshCh)
 	}
 
-	fmt.Println("")
+	restartCh := make(chan os.Signal, 1)
 
-	// Wait for the server to be done.
-	<-conf.debugCloseCh
+	if restartProxy != nil {
+		signal.Notify(restartCh, conf.managedDebugRestartSignals...)
+		defer signal.Stop(restartCh)
+	}
 
-	return nil
+	// Wait for the server to be done, outputting the reattach configuration
+	// again whenever a refresh signal is received and restarting whenever a
+	// restart signal is received.
+	for {
+		select {
+		case <-refreshCh:
+			fmt.Printf("Provider running with PID %d for %s.\n", pluginReattachConfig.Pid, conf.clock.Now().Sub(startTime).Round(time.Second))
+			manageddebug.WriteReattachConfig(os.Stdout, reattachStr)
+		case <-restartCh:
+			return manageddebug.Restart(conf.debugCtx, restartProxy, debugCancel, conf.debugCloseCh)
+		case <-conf.debugCloseCh:
+			return nil
+		}
+	}
 }
 
 type server struct {
@@ -393,6 +934,9 @@ type server struct {
 	stopMu sync.Mutex
 	stopCh chan struct{}
 
+	// shutdownCh, if set, is closed once the server shuts itself down.
+	shutdownCh chan struct{}
+
 	tflogSDKOpts tfsdklog.Options
 	tflogOpts    tflog.Options
 	useTFLogSink bool
@@ -403,35 +947,90 @@ type server struct {
 	// debugging purposes.
 	protocolDataDir string
 
+	// protocolDumpDir is a directory to store raw protocol buffers request
+	// and response messages for debugging purposes.
+	protocolDumpDir string
+
 	// protocolVersion is the protocol version for the server.
 	protocolVersion string
+
+	// recorder, if set, records all successful RPC exchanges.
+	recorder *recording.Recorder
+
+	// clock is the source of time for the server.
+	clock Clock
+
+	// slowRPCThreshold, if greater than zero, is the duration after which
+	// downstream requests generate a WARN log.
+	slowRPCThreshold time.Duration
+
+	// verifyImportedResources enables calling ReadResource for each
+	// resource returned by ImportResourceState.
+	verifyImportedResources bool
+
+	// importedResourceSchemasCache contains the downstream resource schemas
+	// used to verify imported resources, once retrieved.
+	importedResourceSchemasMu    sync.Mutex
+	importedResourceSchemasCache map[string]*tfprotov6.Schema
+
+	// planAnnotationDiagnostics enables returning PlanResourceChange
+	// annotations as warning diagnostics.
+	planAnnotationDiagnostics bool
+
+	// rateLimiters contains the rate limiter for each RPC with a
+	// configured rate limit.
+	rateLimiters map[string]*ratelimit.Limiter
+
+	// stateStoreChunkSizes contains the chunk size negotiated by
+	// ConfigureStateStore for each state store type.
+	stateStoreChunkSizes   map[string]int64
+	stateStoreChunkSizesMu sync.Mutex
 }
 
-func mergeStop(ctx context.Context, cancel context.CancelFunc, stopCh chan struct{}) {
+func mergeStop(ctx context.Context, cancel context.CancelCauseFunc, stopCh chan struct{}, shutdownCh chan struct{}, servedCh chan struct{}) {
 	select {
 	case <-ctx.Done():
-		return
+		// The gRPC server cancels the request context with
+		// context.Canceled once a request is served, so only log causes,
+		// such as the client disconnecting or exceeded deadlines, while
+		// the request is still being served.
+		select {
+		case <-servedCh:
+			return
+		default:
+		}
+
+		logging.ProtocolTrace(ctx, "Request context canceled", map[string]interface{}{logging.KeyContextCancelCause: context.Cause(ctx).Error()})
 	case <-stopCh:
-		cancel()
+		logging.ProtocolTrace(ctx, "Canceling request context due to provider stop", map[string]interface{}{logging.KeyContextCancelCause: ErrProviderStopped.Error()})
+		cancel(ErrProviderStopped)
+	case <-shutdownCh:
+		logging.ProtocolTrace(ctx, "Canceling request context due to server shutdown", map[string]interface{}{logging.KeyContextCancelCause: ErrServerShutdown.Error()})
+		cancel(ErrServerShutdown)
+	case <-servedCh:
+		cancel(nil)
 	}
 }
 
 // stoppableContext returns a context that wraps `ctx` but will be canceled
-// when the server's stopCh is closed.
+// when the server's stopCh or shutdownCh is closed, and a function which
+// must be called once the request is served.
 //
 // This is used to cancel all in-flight contexts when the Stop method of the
-// server is called.
-func (s *server) stoppableContext(ctx context.Context) context.Context {
+// server is called or the server shuts itself down. The cancellation cause of
+// those contexts is ErrProviderStopped or ErrServerShutdown respectively.
+func (s *server) stoppableContext(ctx context.Context) (context.Context, func()) {
 	s.stopMu.Lock()
 	defer s.stopMu.Unlock()
 
-	stoppable, cancel := context.WithCancel(ctx)
-	go mergeStop(stoppable, cancel, s.stopCh)
-	return stoppable
+	servedCh := make(chan struct{})
+	stoppable, cancel := context.WithCancelCause(ctx)
+	go mergeStop(stoppable, cancel, s.stopCh, s.shutdownCh, servedCh)
+	return stoppable, func() { close(servedCh) }
 }
 
 // loggingContext returns a context that wraps `ctx` and has
-// terraform-plugin-log loggers injected.
+// terraform-plugin-log loggers and the server clock injected.
 func (s *server) loggingContext(ctx context.Context) context.Context {
 	if s.useTFLogSink {
 		ctx = tfsdklog.RegisterTestSink(ctx, s.testHandle)
@@ -441,6 +1040,8 @@ func (s *server) loggingContext(ctx context.Context) context.Context {
 	ctx = logging.RequestIdContext(ctx)
 	ctx = logging.ProviderAddressContext(ctx, s.name)
 	ctx = logging.ProtocolVersionContext(ctx, s.protocolVersion)
+	ctx = clock.NewContext(ctx, s.clock)
+	ctx = tf6serverlogging.SlowRPCThresholdContext(ctx, s.slowRPCThreshold)
 
 	return ctx
 }
@@ -474,16 +1075,68 @@ func New(name string, serve tfprotov6.ProviderServer, opts ...ServeOpt) tfplugin
 	if envVar != "" {
 		options = append(options, tfsdklog.WithLogName(envVar), tflog.WithLevelFromEnv(logging.EnvTfLogProvider, envVar))
 	}
+	serverClock := conf.clock
+	if serverClock == nil {
+		serverClock = clock.Real()
+	}
+	var recorder *recording.Recorder
+	if conf.recordingWriter != nil {
+		recorder = recording.NewRecorder(conf.recordingWriter)
+	}
+	rateLimiters := make(map[string]*ratelimit.Limiter, len(conf.rpcRateLimits))
+	for rpc, rateLimit := range conf.rpcRateLimits {
+		rateLimiters[rpc] = ratelimit.New(rateLimit.limit, rateLimit.burst)
+	}
 	return &server{
-		downstream:      serve,
-		stopCh:          make(chan struct{}),
-		tflogOpts:       options,
-		tflogSDKOpts:    sdkOptions,
-		name:            name,
-		useTFLogSink:    conf.useLoggingSink != nil,
-		testHandle:      conf.useLoggingSink,
-		protocolDataDir: os.Getenv(logging.EnvTfLogSdkProtoDataDir),
-		protocolVersion: protocolVersion,
+		downstream:       serve,
+		stopCh:           make(chan struct{}),
+		shutdownCh:       conf.shutdownCh,
+		tflogOpts:        options,
+		tflogSDKOpts:     sdkOptions,
+		name:             name,
+		useTFLogSink:     conf.useLoggingSink != nil,
+		testHandle:       conf.useLoggingSink,
+		protocolDataDir:  os.Getenv(logging.EnvTfLogSdkProtoDataDir),
+		protocolDumpDir:  os.Getenv(logging.EnvTfLogSdkProtoDumpDir),
+		protocolVersion:  protocolVersion,
+		recorder:         recorder,
+		clock:            serverClock,
+		slowRPCThreshold: conf.slowRPCThreshold,
+
+		verifyImportedResources:   conf.verifyImportedResources,
+		planAnnotationDiagnostics: conf.planAnnotationDiagnostics,
+		rateLimiters:              rateLimiters,
+	}
+}
+
+// waitRateLimit blocks until a downstream request for the RPC is allowed by
+// its rate limit, if configured.
+func (s *server) waitRateLimit(ctx context.Context, rpc string) error {
+	limiter, ok := s.rateLimiters[rpc]
+
+	if !ok {
+		return nil
+	}
+
+	logging.ProtocolTrace(ctx, "Waiting for RPC rate limit")
+
+	if err := limiter.Wait(ctx); err != nil {
+		logging.ProtocolError(ctx, "Error waiting for RPC rate limit", map[string]interface{}{logging.KeyError: err})
+
+		return err
+	}
+
+	return nil
+}
+
+// recordExchange records the RPC request and response, if recording is
+// enabled. Errors are logged rather than returned, so recording issues do
+// not affect the response to Terraform.
+func (s *server) recordExchange(ctx context.Context, rpc string, protoReq proto.Message, protoResp proto.Message) {
+	err := s.recorder.Record(rpc, protoReq, protoResp)
+
+	if err != nil {
+		logging.ProtocolError(ctx, "Unable to record exchange", map[string]interface{}{logging.KeyError: err})
 	}
 }
 
@@ -491,217 +1144,1200 @@ func (s *server) GetMetadata(ctx context.Context, protoReq *tfplugin6.GetMetadat
 	rpc := "GetMetadata"
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
-	ctx = s.stoppableContext(ctx)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
 	req := fromproto.GetMetadataRequest(protoReq)
 
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
 	resp, err := s.downstream.GetMetadata(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
-		return nil, err
-	}
+		tf6serverlogging.DownstreamError(ctx, err)
 
-	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
-	tf6serverlogging.ServerCapabilities(ctx, resp.ServerCapabilities)
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.GetMetadataResponse{
+			Diagnostics: diags,
+		}
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+	tf6serverlogging.ServerCapabilities(ctx, resp.ServerCapabilities)
 
 	protoResp := toproto.GetMetadata_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+	return protoResp, nil
+}
+
+func (s *server) GetProviderSchema(ctx context.Context, protoReq *tfplugin6.GetProviderSchema_Request) (*tfplugin6.GetProviderSchema_Response, error) {
+	rpc := "GetProviderSchema"
+	ctx = s.loggingContext(ctx)
+	ctx = logging.RpcContext(ctx, rpc)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.GetProviderSchemaRequest(protoReq)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	if downstream, ok := s.downstream.(tfprotov6.ProviderServerWithEncodedProviderSchema); ok {
+		protoResp, err := encodedProviderSchema(ctx, downstream, req)
+
+		if err != nil {
+			tf6serverlogging.DownstreamError(ctx, err)
+
+			diags := downstreamErrorDiagnostics(err)
+
+			if diags == nil {
+				return nil, downstreamErrorStatus(err)
+			}
+
+			protoResp = toproto.GetProviderSchema_Response(&tfprotov6.GetProviderSchemaResponse{
+				Diagnostics: diags,
+			})
+		}
+
+		diags, err := fromproto.Diagnostics(protoResp.Diagnostics)
+
+		if err != nil {
+			logging.ProtocolWarn(ctx, "Unable to decode encoded provider schema diagnostics", map[string]interface{}{logging.KeyError: err})
+		}
+
+		tf6serverlogging.DownstreamResponse(ctx, diags)
+		tf6serverlogging.ServerCapabilities(ctx, fromproto.ServerCapabilities(protoResp.ServerCapabilities))
+
+		logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+		s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+		return protoResp, nil
+	}
+
+	resp, err := s.downstream.GetProviderSchema(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.GetProviderSchemaResponse{
+			Diagnostics: diags,
+		}
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+	tf6serverlogging.ServerCapabilities(ctx, resp.ServerCapabilities)
+
+	protoResp := toproto.GetProviderSchema_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+	return protoResp, nil
+}
+
+func (s *server) ConfigureProvider(ctx context.Context, protoReq *tfplugin6.ConfigureProvider_Request) (*tfplugin6.ConfigureProvider_Response, error) {
+	rpc := "ConfigureProvider"
+	ctx = s.loggingContext(ctx)
+	ctx = logging.RpcContext(ctx, rpc)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.ConfigureProviderRequest(protoReq)
+
+	tf6serverlogging.ConfigureProviderClientCapabilities(ctx, req.ClientCapabilities)
+	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	resp, err := s.downstream.ConfigureProvider(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.ConfigureProviderResponse{
+			Diagnostics: diags,
+		}
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+
+	protoResp := toproto.ConfigureProvider_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+	return protoResp, nil
+}
+
+func (s *server) ValidateProviderConfig(ctx context.Context, protoReq *tfplugin6.ValidateProviderConfig_Request) (*tfplugin6.ValidateProviderConfig_Response, error) {
+	rpc := "ValidateProviderConfig"
+	ctx = s.loggingContext(ctx)
+	ctx = logging.RpcContext(ctx, rpc)
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.ValidateProviderConfigRequest(protoReq)
+
+	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	resp, err := s.downstream.ValidateProviderConfig(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.ValidateProviderConfigResponse{
+			Diagnostics: diags,
+		}
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+
+	protoResp := toproto.ValidateProviderConfig_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+	return protoResp, nil
+}
+
+// stop closes the stopCh associated with the server and replaces it with a new
+// one.
+//
+// This causes all in-flight requests for the server to have their contexts
+// canceled.
+func (s *server) stop() {
+	s.stopMu.Lock()
+	defer s.stopMu.Unlock()
+
+	close(s.stopCh)
+	s.stopCh = make(chan struct{})
+}
+
+func (s *server) StopProvider(ctx context.Context, protoReq *tfplugin6.StopProvider_Request) (*tfplugin6.StopProvider_Response, error) {
+	rpc := "StopProvider"
+	ctx = s.loggingContext(ctx)
+	ctx = logging.RpcContext(ctx, rpc)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.StopProviderRequest(protoReq)
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	resp, err := s.downstream.StopProvider(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+		return nil, downstreamErrorStatus(err)
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, nil)
+	logging.ProtocolTrace(ctx, "Closing all our contexts")
+	s.stop()
+	logging.ProtocolTrace(ctx, "Closed all our contexts")
+
+	protoResp := toproto.StopProvider_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+	return protoResp, nil
+}
+
+func (s *server) ValidateDataResourceConfig(ctx context.Context, protoReq *tfplugin6.ValidateDataResourceConfig_Request) (*tfplugin6.ValidateDataResourceConfig_Response, error) {
+	rpc := "ValidateDataResourceConfig"
+	ctx = s.loggingContext(ctx)
+	ctx = logging.RpcContext(ctx, rpc)
+	ctx = logging.DataSourceContext(ctx, protoReq.TypeName)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.ValidateDataResourceConfigRequest(protoReq)
+
+	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	resp, err := s.downstream.ValidateDataResourceConfig(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.ValidateDataResourceConfigResponse{
+			Diagnostics: diags,
+		}
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+
+	protoResp := toproto.ValidateDataResourceConfig_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+	return protoResp, nil
+}
+
+func (s *server) ReadDataSource(ctx context.Context, protoReq *tfplugin6.ReadDataSource_Request) (*tfplugin6.ReadDataSource_Response, error) {
+	rpc := "ReadDataSource"
+	ctx = s.loggingContext(ctx)
+	ctx = logging.RpcContext(ctx, rpc)
+	ctx = logging.DataSourceContext(ctx, protoReq.TypeName)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.ReadDataSourceRequest(protoReq)
+
+	tf6serverlogging.ReadDataSourceClientCapabilities(ctx, req.ClientCapabilities)
+	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
+	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "ProviderMeta", req.ProviderMeta)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	resp, err := s.downstream.ReadDataSource(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.ReadDataSourceResponse{
+			Diagnostics: diags,
+		}
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+
+	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Response", "State", resp.State)
+	tf6serverlogging.Deferred(ctx, resp.Deferred)
+
+	if resp.Deferred != nil && !req.ClientCapabilities.GetDeferralAllowed() {
+		resp.Diagnostics = append(resp.Diagnostics, invalidDeferredResponseDiag(resp.Deferred.Reason))
+	}
+
+	protoResp := toproto.ReadDataSource_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+	return protoResp, nil
+}
+
+func (s *server) ValidateEphemeralResourceConfig(ctx context.Context, protoReq *tfplugin6.ValidateEphemeralResourceConfig_Request) (*tfplugin6.ValidateEphemeralResourceConfig_Response, error) {
+	rpc := "ValidateEphemeralResourceConfig"
+	ctx = s.loggingContext(ctx)
+	ctx = logging.RpcContext(ctx, rpc)
+	ctx = logging.EphemeralResourceContext(ctx, protoReq.TypeName)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.ValidateEphemeralResourceConfigRequest(protoReq)
+
+	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	downstream, ok := s.downstream.(tfprotov6.ProviderServerWithEphemeralResources)
+
+	if !ok {
+		logging.ProtocolError(ctx, "ProviderServer does not implement EphemeralResourceServer")
+
+		downstream = tfprotov6.UnimplementedProviderServer{}
+	}
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	resp, err := downstream.ValidateEphemeralResourceConfig(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.ValidateEphemeralResourceConfigResponse{
+			Diagnostics: diags,
+		}
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+
+	protoResp := toproto.ValidateEphemeralResourceConfig_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+	return protoResp, nil
+}
+
+func (s *server) OpenEphemeralResource(ctx context.Context, protoReq *tfplugin6.OpenEphemeralResource_Request) (*tfplugin6.OpenEphemeralResource_Response, error) {
+	rpc := "OpenEphemeralResource"
+	ctx = s.loggingContext(ctx)
+	ctx = logging.RpcContext(ctx, rpc)
+	ctx = logging.EphemeralResourceContext(ctx, protoReq.TypeName)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.OpenEphemeralResourceRequest(protoReq)
+
+	tf6serverlogging.OpenEphemeralResourceClientCapabilities(ctx, req.ClientCapabilities)
+	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	downstream, ok := s.downstream.(tfprotov6.ProviderServerWithEphemeralResources)
+
+	if !ok {
+		logging.ProtocolError(ctx, "ProviderServer does not implement EphemeralResourceServer")
+
+		downstream = tfprotov6.UnimplementedProviderServer{}
+	}
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	resp, err := downstream.OpenEphemeralResource(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.OpenEphemeralResourceResponse{
+			Diagnostics: diags,
+		}
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Response", "Result", resp.Result)
+	logging.ProtocolPrivateData(ctx, s.protocolDataDir, rpc, "Response", "Private", resp.Private)
+	tf6serverlogging.Deferred(ctx, resp.Deferred)
+
+	if resp.Deferred != nil && !req.ClientCapabilities.GetDeferralAllowed() {
+		resp.Diagnostics = append(resp.Diagnostics, invalidDeferredResponseDiag(resp.Deferred.Reason))
+	}
+
+	protoResp := toproto.OpenEphemeralResource_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+	return protoResp, nil
+}
+
+func (s *server) RenewEphemeralResource(ctx context.Context, protoReq *tfplugin6.RenewEphemeralResource_Request) (*tfplugin6.RenewEphemeralResource_Response, error) {
+	rpc := "RenewEphemeralResource"
+	ctx = s.loggingContext(ctx)
+	ctx = logging.RpcContext(ctx, rpc)
+	ctx = logging.EphemeralResourceContext(ctx, protoReq.TypeName)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.RenewEphemeralResourceRequest(protoReq)
+
+	logging.ProtocolPrivateData(ctx, s.protocolDataDir, rpc, "Request", "Private", req.Private)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	downstream, ok := s.downstream.(tfprotov6.ProviderServerWithEphemeralResources)
+
+	if !ok {
+		logging.ProtocolError(ctx, "ProviderServer does not implement EphemeralResourceServer")
+
+		downstream = tfprotov6.UnimplementedProviderServer{}
+	}
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	resp, err := downstream.RenewEphemeralResource(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.RenewEphemeralResourceResponse{
+			Diagnostics: diags,
+		}
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+	logging.ProtocolPrivateData(ctx, s.protocolDataDir, rpc, "Response", "Private", resp.Private)
+
+	protoResp := toproto.RenewEphemeralResource_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+	return protoResp, nil
+}
+
+func (s *server) CloseEphemeralResource(ctx context.Context, protoReq *tfplugin6.CloseEphemeralResource_Request) (*tfplugin6.CloseEphemeralResource_Response, error) {
+	rpc := "CloseEphemeralResource"
+	ctx = s.loggingContext(ctx)
+	ctx = logging.RpcContext(ctx, rpc)
+	ctx = logging.EphemeralResourceContext(ctx, protoReq.TypeName)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.CloseEphemeralResourceRequest(protoReq)
+
+	logging.ProtocolPrivateData(ctx, s.protocolDataDir, rpc, "Request", "Private", req.Private)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	downstream, ok := s.downstream.(tfprotov6.ProviderServerWithEphemeralResources)
+
+	if !ok {
+		logging.ProtocolError(ctx, "ProviderServer does not implement EphemeralResourceServer")
+
+		downstream = tfprotov6.UnimplementedProviderServer{}
+	}
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	resp, err := downstream.CloseEphemeralResource(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.CloseEphemeralResourceResponse{
+			Diagnostics: diags,
+		}
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+
+	protoResp := toproto.CloseEphemeralResource_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+	return protoResp, nil
+}
+
+func (s *server) ValidateActionConfig(ctx context.Context, protoReq *tfplugin6.ValidateActionConfig_Request) (*tfplugin6.ValidateActionConfig_Response, error) {
+	rpc := "ValidateActionConfig"
+	ctx = s.loggingContext(ctx)
+	ctx = logging.RpcContext(ctx, rpc)
+	ctx = logging.ActionContext(ctx, protoReq.ActionType)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.ValidateActionConfigRequest(protoReq)
+
+	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	downstream, ok := s.downstream.(tfprotov6.ProviderServerWithActions)
+
+	if !ok {
+		logging.ProtocolError(ctx, "ProviderServer does not implement ActionServer")
+
+		downstream = tfprotov6.UnimplementedProviderServer{}
+	}
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	resp, err := downstream.ValidateActionConfig(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.ValidateActionConfigResponse{
+			Diagnostics: diags,
+		}
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+
+	protoResp := toproto.ValidateActionConfig_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+	return protoResp, nil
+}
+
+func (s *server) InvokeAction(protoReq *tfplugin6.InvokeAction_Request, protoStream grpc.ServerStreamingServer[tfplugin6.InvokeAction_Event]) error {
+	rpc := "InvokeAction"
+	ctx := s.loggingContext(protoStream.Context())
+	ctx = logging.RpcContext(ctx, rpc)
+	ctx = logging.ActionContext(ctx, protoReq.ActionType)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.InvokeActionRequest(protoReq)
+
+	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return err
+	}
+
+	downstream, ok := s.downstream.(tfprotov6.ProviderServerWithActions)
+
+	if !ok {
+		logging.ProtocolError(ctx, "ProviderServer does not implement ActionServer")
+
+		downstream = tfprotov6.UnimplementedProviderServer{}
+	}
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	resp, err := downstream.InvokeAction(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return downstreamErrorStatus(err)
+		}
+
+		return s.sendInvokeActionCompletedEvent(ctx, protoReq, protoStream, diags)
+	}
+
+	if resp.Events == nil {
+		return s.sendInvokeActionCompletedEvent(ctx, protoReq, protoStream, nil)
+	}
+
+	var completed bool
+	var sendErr error
+
+	resp.Events(func(event tfprotov6.InvokeActionEvent) bool {
+		switch eventType := event.Type.(type) {
+		case tfprotov6.ProgressInvokeActionEventType:
+			sendErr = s.sendInvokeActionEvent(ctx, protoReq, protoStream, event)
+		case tfprotov6.CompletedInvokeActionEventType:
+			completed = true
+			sendErr = s.sendInvokeActionCompletedEvent(ctx, protoReq, protoStream, eventType.Diagnostics)
+		default:
+			logging.ProtocolWarn(ctx, fmt.Sprintf("Ignoring downstream InvokeAction event of unknown type %T", event.Type))
+
+			return true
+		}
+
+		// Events following the completed event are not sent.
+		return sendErr == nil && !completed
+	})
+
+	if sendErr != nil || completed {
+		return sendErr
+	}
+
+	logging.ProtocolWarn(ctx, "Downstream InvokeAction events did not include a completed event, sending one without diagnostics")
+
+	return s.sendInvokeActionCompletedEvent(ctx, protoReq, protoStream, nil)
+}
+
+// sendInvokeActionEvent logs, records, and sends an InvokeAction event to
+// Terraform.
+func (s *server) sendInvokeActionEvent(ctx context.Context, protoReq *tfplugin6.InvokeAction_Request, protoStream grpc.ServerStreamingServer[tfplugin6.InvokeAction_Event], event tfprotov6.InvokeActionEvent) error {
+	rpc := "InvokeAction"
+
+	protoEvent := toproto.InvokeAction_Event(&event)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Event", protoEvent)
+	s.recordExchange(ctx, rpc, protoReq, protoEvent)
+
+	return protoStream.Send(protoEvent)
+}
+
+// sendInvokeActionCompletedEvent sends the completed InvokeAction event,
+// which is the last event of the stream.
+func (s *server) sendInvokeActionCompletedEvent(ctx context.Context, protoReq *tfplugin6.InvokeAction_Request, protoStream grpc.ServerStreamingServer[tfplugin6.InvokeAction_Event], diags []*tfprotov6.Diagnostic) error {
+	tf6serverlogging.DownstreamResponse(ctx, diags)
+
+	return s.sendInvokeActionEvent(ctx, protoReq, protoStream, tfprotov6.InvokeActionEvent{
+		Type: tfprotov6.CompletedInvokeActionEventType{
+			Diagnostics: diags,
+		},
+	})
+}
+
+func (s *server) ValidateStateStoreConfig(ctx context.Context, protoReq *tfplugin6.ValidateStateStoreConfig_Request) (*tfplugin6.ValidateStateStoreConfig_Response, error) {
+	rpc := "ValidateStateStoreConfig"
+	ctx = s.loggingContext(ctx)
+	ctx = logging.RpcContext(ctx, rpc)
+	ctx = logging.StateStoreContext(ctx, protoReq.TypeName)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.ValidateStateStoreConfigRequest(protoReq)
+
+	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	downstream, ok := s.downstream.(tfprotov6.ProviderServerWithStateStores)
+
+	if !ok {
+		logging.ProtocolError(ctx, "ProviderServer does not implement StateStoreServer")
+
+		downstream = tfprotov6.UnimplementedProviderServer{}
+	}
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	resp, err := downstream.ValidateStateStoreConfig(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.ValidateStateStoreConfigResponse{
+			Diagnostics: diags,
+		}
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+
+	protoResp := toproto.ValidateStateStoreConfig_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
+
+	return protoResp, nil
+}
+
+func (s *server) ConfigureStateStore(ctx context.Context, protoReq *tfplugin6.ConfigureStateStore_Request) (*tfplugin6.ConfigureStateStore_Response, error) {
+	rpc := "ConfigureStateStore"
+	ctx = s.loggingContext(ctx)
+	ctx = logging.RpcContext(ctx, rpc)
+	ctx = logging.StateStoreContext(ctx, protoReq.TypeName)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
+	logging.ProtocolTrace(ctx, "Received request")
+	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
+
+	req := fromproto.ConfigureStateStoreRequest(protoReq)
+
+	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	downstream, ok := s.downstream.(tfprotov6.ProviderServerWithStateStores)
+
+	if !ok {
+		logging.ProtocolError(ctx, "ProviderServer does not implement StateStoreServer")
+
+		downstream = tfprotov6.UnimplementedProviderServer{}
+	}
+
+	ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+	resp, err := downstream.ConfigureStateStore(ctx, req)
+
+	if err != nil {
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.ConfigureStateStoreResponse{
+			Diagnostics: diags,
+		}
+	}
+
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+
+	protoResp := toproto.ConfigureStateStore_Response(resp)
+
+	// The capabilities are negotiated by the server rather than the
+	// provider, so they are not recorded and replays can use any chunk size.
+	s.recordExchange(ctx, rpc, toproto.ConfigureStateStore_Request(req), protoResp)
+
+	chunkSize := s.setStateStoreChunkSize(protoReq.TypeName, protoReq.Capabilities.GetChunkSize())
+
+	logging.ProtocolTrace(ctx, "Negotiated state store chunk size", map[string]interface{}{logging.KeyStateStoreChunkSize: chunkSize})
+
+	protoResp.Capabilities = &tfplugin6.StateStoreServerCapabilities{
+		ChunkSize: chunkSize,
+	}
+
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
 
 	return protoResp, nil
 }
 
-func (s *server) GetProviderSchema(ctx context.Context, protoReq *tfplugin6.GetProviderSchema_Request) (*tfplugin6.GetProviderSchema_Response, error) {
-	rpc := "GetProviderSchema"
-	ctx = s.loggingContext(ctx)
+func (s *server) ReadStateBytes(protoReq *tfplugin6.ReadStateBytes_Request, protoStream grpc.ServerStreamingServer[tfplugin6.ReadStateBytes_Response]) error {
+	rpc := "ReadStateBytes"
+	ctx := s.loggingContext(protoStream.Context())
 	ctx = logging.RpcContext(ctx, rpc)
-	ctx = s.stoppableContext(ctx)
+	ctx = logging.StateStoreContext(ctx, protoReq.TypeName)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
-	req := fromproto.GetProviderSchemaRequest(protoReq)
+	req := fromproto.ReadStateBytesRequest(protoReq)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return err
+	}
+
+	downstream, ok := s.downstream.(tfprotov6.ProviderServerWithStateStores)
+
+	if !ok {
+		logging.ProtocolError(ctx, "ProviderServer does not implement StateStoreServer")
+
+		downstream = tfprotov6.UnimplementedProviderServer{}
+	}
 
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
-	resp, err := s.downstream.GetProviderSchema(ctx, req)
+	resp, err := downstream.ReadStateBytes(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
-		return nil, err
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.ReadStateBytesResponse{
+			Diagnostics: diags,
+		}
 	}
 
 	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
-	tf6serverlogging.ServerCapabilities(ctx, resp.ServerCapabilities)
 
-	protoResp := toproto.GetProviderSchema_Response(resp)
+	// The whole state is recorded, so replays are independent of the
+	// chunk size.
+	protoResp := toproto.ReadStateBytes_Response(resp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
-	return protoResp, nil
+	for _, protoChunk := range splitStateBytes(protoResp, s.stateStoreChunkSize(protoReq.TypeName)) {
+		logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoChunk)
+
+		if err := protoStream.Send(protoChunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (s *server) ConfigureProvider(ctx context.Context, protoReq *tfplugin6.ConfigureProvider_Request) (*tfplugin6.ConfigureProvider_Response, error) {
-	rpc := "ConfigureProvider"
-	ctx = s.loggingContext(ctx)
+func (s *server) WriteStateBytes(protoStream grpc.ClientStreamingServer[tfplugin6.WriteStateBytes_RequestChunk, tfplugin6.WriteStateBytes_Response]) error {
+	rpc := "WriteStateBytes"
+	ctx := s.loggingContext(protoStream.Context())
 	ctx = logging.RpcContext(ctx, rpc)
-	ctx = s.stoppableContext(ctx)
+
+	// The state store type is only known once the first chunk is received,
+	// so all chunks are received before the request is logged.
+	protoReq, diag, err := assembleStateBytes(protoStream)
+
+	if err != nil {
+		logging.ProtocolError(ctx, "Error receiving request", map[string]interface{}{logging.KeyError: err})
+
+		return err
+	}
+
+	ctx = logging.StateStoreContext(ctx, protoReq.Meta.GetTypeName())
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
-	req := fromproto.ConfigureProviderRequest(protoReq)
+	var resp *tfprotov6.WriteStateBytesResponse
 
-	tf6serverlogging.ConfigureProviderClientCapabilities(ctx, req.ClientCapabilities)
-	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
+	if diag != nil {
+		logging.ProtocolError(ctx, "Invalid request chunks", map[string]interface{}{logging.KeyError: diag.Detail})
 
-	ctx = tf6serverlogging.DownstreamRequest(ctx)
+		resp = &tfprotov6.WriteStateBytesResponse{
+			Diagnostics: []*tfprotov6.Diagnostic{
+				diag,
+			},
+		}
+	} else {
+		downstream, ok := s.downstream.(tfprotov6.ProviderServerWithStateStores)
 
-	resp, err := s.downstream.ConfigureProvider(ctx, req)
+		if !ok {
+			logging.ProtocolError(ctx, "ProviderServer does not implement StateStoreServer")
 
-	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
-		return nil, err
+			downstream = tfprotov6.UnimplementedProviderServer{}
+		}
+
+		req := fromproto.WriteStateBytesRequest(protoReq)
+
+		if err := s.waitRateLimit(ctx, rpc); err != nil {
+			return err
+		}
+
+		ctx = tf6serverlogging.DownstreamRequest(ctx)
+
+		resp, err = downstream.WriteStateBytes(ctx, req)
+
+		if err != nil {
+			tf6serverlogging.DownstreamError(ctx, err)
+
+			diags := downstreamErrorDiagnostics(err)
+
+			if diags == nil {
+				return downstreamErrorStatus(err)
+			}
+
+			resp = &tfprotov6.WriteStateBytesResponse{
+				Diagnostics: diags,
+			}
+		}
 	}
 
 	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
 
-	protoResp := toproto.ConfigureProvider_Response(resp)
+	protoResp := toproto.WriteStateBytes_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
 
-	return protoResp, nil
+	if diag == nil {
+		s.recordExchange(ctx, rpc, protoReq, protoResp)
+	}
+
+	return protoStream.SendAndClose(protoResp)
 }
 
-func (s *server) ValidateProviderConfig(ctx context.Context, protoReq *tfplugin6.ValidateProviderConfig_Request) (*tfplugin6.ValidateProviderConfig_Response, error) {
-	rpc := "ValidateProviderConfig"
+func (s *server) LockState(ctx context.Context, protoReq *tfplugin6.LockState_Request) (*tfplugin6.LockState_Response, error) {
+	rpc := "LockState"
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
+	ctx = logging.StateStoreContext(ctx, protoReq.TypeName)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
-	req := fromproto.ValidateProviderConfigRequest(protoReq)
+	req := fromproto.LockStateRequest(protoReq)
 
-	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	downstream, ok := s.downstream.(tfprotov6.ProviderServerWithStateStores)
+
+	if !ok {
+		logging.ProtocolError(ctx, "ProviderServer does not implement StateStoreServer")
+
+		downstream = tfprotov6.UnimplementedProviderServer{}
+	}
 
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
-	resp, err := s.downstream.ValidateProviderConfig(ctx, req)
+	resp, err := downstream.LockState(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
-		return nil, err
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.LockStateResponse{
+			Diagnostics: diags,
+		}
 	}
 
 	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
 
-	protoResp := toproto.ValidateProviderConfig_Response(resp)
+	protoResp := toproto.LockState_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
 	return protoResp, nil
 }
 
-// stop closes the stopCh associated with the server and replaces it with a new
-// one.
-//
-// This causes all in-flight requests for the server to have their contexts
-// canceled.
-func (s *server) stop() {
-	s.stopMu.Lock()
-	defer s.stopMu.Unlock()
-
-	close(s.stopCh)
-	s.stopCh = make(chan struct{})
-}
-
-func (s *server) StopProvider(ctx context.Context, protoReq *tfplugin6.StopProvider_Request) (*tfplugin6.StopProvider_Response, error) {
-	rpc := "StopProvider"
+func (s *server) UnlockState(ctx context.Context, protoReq *tfplugin6.UnlockState_Request) (*tfplugin6.UnlockState_Response, error) {
+	rpc := "UnlockState"
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
-	ctx = s.stoppableContext(ctx)
+	ctx = logging.StateStoreContext(ctx, protoReq.TypeName)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
-	req := fromproto.StopProviderRequest(protoReq)
+	req := fromproto.UnlockStateRequest(protoReq)
+
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	downstream, ok := s.downstream.(tfprotov6.ProviderServerWithStateStores)
+
+	if !ok {
+		logging.ProtocolError(ctx, "ProviderServer does not implement StateStoreServer")
+
+		downstream = tfprotov6.UnimplementedProviderServer{}
+	}
 
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
-	resp, err := s.downstream.StopProvider(ctx, req)
+	resp, err := downstream.UnlockState(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
-		return nil, err
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.UnlockStateResponse{
+			Diagnostics: diags,
+		}
 	}
 
-	tf6serverlogging.DownstreamResponse(ctx, nil)
-	logging.ProtocolTrace(ctx, "Closing all our contexts")
-	s.stop()
-	logging.ProtocolTrace(ctx, "Closed all our contexts")
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
 
-	protoResp := toproto.StopProvider_Response(resp)
+	protoResp := toproto.UnlockState_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
 	return protoResp, nil
 }
 
-func (s *server) ValidateDataResourceConfig(ctx context.Context, protoReq *tfplugin6.ValidateDataResourceConfig_Request) (*tfplugin6.ValidateDataResourceConfig_Response, error) {
-	rpc := "ValidateDataResourceConfig"
+func (s *server) GetStates(ctx context.Context, protoReq *tfplugin6.GetStates_Request) (*tfplugin6.GetStates_Response, error) {
+	rpc := "GetStates"
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
-	ctx = logging.DataSourceContext(ctx, protoReq.TypeName)
-	ctx = s.stoppableContext(ctx)
+	ctx = logging.StateStoreContext(ctx, protoReq.TypeName)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
-	req := fromproto.ValidateDataResourceConfigRequest(protoReq)
+	req := fromproto.GetStatesRequest(protoReq)
 
-	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	downstream, ok := s.downstream.(tfprotov6.ProviderServerWithStateStores)
+
+	if !ok {
+		logging.ProtocolError(ctx, "ProviderServer does not implement StateStoreServer")
+
+		downstream = tfprotov6.UnimplementedProviderServer{}
+	}
 
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
-	resp, err := s.downstream.ValidateDataResourceConfig(ctx, req)
+	resp, err := downstream.GetStates(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
-		return nil, err
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.GetStatesResponse{
+			Diagnostics: diags,
+		}
 	}
 
 	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
 
-	protoResp := toproto.ValidateDataResourceConfig_Response(resp)
+	protoResp := toproto.GetStates_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
 	return protoResp, nil
 }
 
-func (s *server) ReadDataSource(ctx context.Context, protoReq *tfplugin6.ReadDataSource_Request) (*tfplugin6.ReadDataSource_Response, error) {
-	rpc := "ReadDataSource"
+func (s *server) DeleteState(ctx context.Context, protoReq *tfplugin6.DeleteState_Request) (*tfplugin6.DeleteState_Response, error) {
+	rpc := "DeleteState"
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
-	ctx = logging.DataSourceContext(ctx, protoReq.TypeName)
-	ctx = s.stoppableContext(ctx)
+	ctx = logging.StateStoreContext(ctx, protoReq.TypeName)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
-	req := fromproto.ReadDataSourceRequest(protoReq)
+	req := fromproto.DeleteStateRequest(protoReq)
 
-	tf6serverlogging.ReadDataSourceClientCapabilities(ctx, req.ClientCapabilities)
-	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
-	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "ProviderMeta", req.ProviderMeta)
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
+	downstream, ok := s.downstream.(tfprotov6.ProviderServerWithStateStores)
+
+	if !ok {
+		logging.ProtocolError(ctx, "ProviderServer does not implement StateStoreServer")
+
+		downstream = tfprotov6.UnimplementedProviderServer{}
+	}
 
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
-	resp, err := s.downstream.ReadDataSource(ctx, req)
+	resp, err := downstream.DeleteState(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
-		return nil, err
-	}
+		tf6serverlogging.DownstreamError(ctx, err)
 
-	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+		diags := downstreamErrorDiagnostics(err)
 
-	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Response", "State", resp.State)
-	tf6serverlogging.Deferred(ctx, resp.Deferred)
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
 
-	if resp.Deferred != nil && (req.ClientCapabilities == nil || !req.ClientCapabilities.DeferralAllowed) {
-		resp.Diagnostics = append(resp.Diagnostics, invalidDeferredResponseDiag(resp.Deferred.Reason))
+		resp = &tfprotov6.DeleteStateResponse{
+			Diagnostics: diags,
+		}
 	}
 
-	protoResp := toproto.ReadDataSource_Response(resp)
+	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
+
+	protoResp := toproto.DeleteState_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
 	return protoResp, nil
 }
@@ -711,26 +2347,44 @@ func (s *server) ValidateResourceConfig(ctx context.Context, protoReq *tfplugin6
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
 	ctx = logging.ResourceContext(ctx, protoReq.TypeName)
-	ctx = s.stoppableContext(ctx)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
 	req := fromproto.ValidateResourceConfigRequest(protoReq)
 
+	tf6serverlogging.ValidateResourceConfigClientCapabilities(ctx, req.ClientCapabilities)
 	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "Config", req.Config)
 
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
 	resp, err := s.downstream.ValidateResourceConfig(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
-		return nil, err
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.ValidateResourceConfigResponse{
+			Diagnostics: diags,
+		}
 	}
 
 	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
 
 	protoResp := toproto.ValidateResourceConfig_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
 	return protoResp, nil
 }
@@ -740,25 +2394,42 @@ func (s *server) UpgradeResourceState(ctx context.Context, protoReq *tfplugin6.U
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
 	ctx = logging.ResourceContext(ctx, protoReq.TypeName)
-	ctx = s.stoppableContext(ctx)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
 	req := fromproto.UpgradeResourceStateRequest(protoReq)
 
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
 	resp, err := s.downstream.UpgradeResourceState(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
-		return nil, err
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.UpgradeResourceStateResponse{
+			Diagnostics: diags,
+		}
 	}
 
 	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
 	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Response", "UpgradedState", resp.UpgradedState)
 
 	protoResp := toproto.UpgradeResourceState_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
 	return protoResp, nil
 }
@@ -768,9 +2439,11 @@ func (s *server) ReadResource(ctx context.Context, protoReq *tfplugin6.ReadResou
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
 	ctx = logging.ResourceContext(ctx, protoReq.TypeName)
-	ctx = s.stoppableContext(ctx)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
 	req := fromproto.ReadResourceRequest(protoReq)
 
@@ -779,13 +2452,32 @@ func (s *server) ReadResource(ctx context.Context, protoReq *tfplugin6.ReadResou
 	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "ProviderMeta", req.ProviderMeta)
 	logging.ProtocolPrivateData(ctx, s.protocolDataDir, rpc, "Request", "Private", req.Private)
 
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
 	resp, err := s.downstream.ReadResource(ctx, req)
 
+	if err != nil && downstreamErrorResourceNotFound(err) {
+		logging.ProtocolTrace(ctx, "Downstream resource not found, returning null state", map[string]interface{}{logging.KeyError: err})
+
+		resp, err = &tfprotov6.ReadResourceResponse{}, nil
+	}
+
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
-		return nil, err
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.ReadResourceResponse{
+			Diagnostics: diags,
+		}
 	}
 
 	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
@@ -793,11 +2485,13 @@ func (s *server) ReadResource(ctx context.Context, protoReq *tfplugin6.ReadResou
 	logging.ProtocolPrivateData(ctx, s.protocolDataDir, rpc, "Response", "Private", resp.Private)
 	tf6serverlogging.Deferred(ctx, resp.Deferred)
 
-	if resp.Deferred != nil && (req.ClientCapabilities == nil || !req.ClientCapabilities.DeferralAllowed) {
+	if resp.Deferred != nil && !req.ClientCapabilities.GetDeferralAllowed() {
 		resp.Diagnostics = append(resp.Diagnostics, invalidDeferredResponseDiag(resp.Deferred.Reason))
 	}
 
 	protoResp := toproto.ReadResource_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
 	return protoResp, nil
 }
@@ -807,9 +2501,11 @@ func (s *server) PlanResourceChange(ctx context.Context, protoReq *tfplugin6.Pla
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
 	ctx = logging.ResourceContext(ctx, protoReq.TypeName)
-	ctx = s.stoppableContext(ctx)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
 	req := fromproto.PlanResourceChangeRequest(protoReq)
 
@@ -820,25 +2516,49 @@ func (s *server) PlanResourceChange(ctx context.Context, protoReq *tfplugin6.Pla
 	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "ProviderMeta", req.ProviderMeta)
 	logging.ProtocolPrivateData(ctx, s.protocolDataDir, rpc, "Request", "PriorPrivate", req.PriorPrivate)
 
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
 	resp, err := s.downstream.PlanResourceChange(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
-		return nil, err
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.PlanResourceChangeResponse{
+			Diagnostics: diags,
+		}
 	}
 
 	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
 	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Response", "PlannedState", resp.PlannedState)
 	logging.ProtocolPrivateData(ctx, s.protocolDataDir, rpc, "Response", "PlannedPrivate", resp.PlannedPrivate)
 	tf6serverlogging.Deferred(ctx, resp.Deferred)
+	tf6serverlogging.PlanAnnotations(ctx, resp.Annotations)
 
-	if resp.Deferred != nil && (req.ClientCapabilities == nil || !req.ClientCapabilities.DeferralAllowed) {
+	if resp.Deferred != nil && !req.ClientCapabilities.GetDeferralAllowed() {
 		resp.Diagnostics = append(resp.Diagnostics, invalidDeferredResponseDiag(resp.Deferred.Reason))
 	}
 
+	if s.planAnnotationDiagnostics {
+		for _, annotation := range resp.Annotations {
+			if diag := annotation.Diagnostic(); diag != nil {
+				resp.Diagnostics = append(resp.Diagnostics, diag)
+			}
+		}
+	}
+
 	protoResp := toproto.PlanResourceChange_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
 	return protoResp, nil
 }
@@ -848,9 +2568,11 @@ func (s *server) ApplyResourceChange(ctx context.Context, protoReq *tfplugin6.Ap
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
 	ctx = logging.ResourceContext(ctx, protoReq.TypeName)
-	ctx = s.stoppableContext(ctx)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
 	req := fromproto.ApplyResourceChangeRequest(protoReq)
 
@@ -860,13 +2582,26 @@ func (s *server) ApplyResourceChange(ctx context.Context, protoReq *tfplugin6.Ap
 	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", "ProviderMeta", req.ProviderMeta)
 	logging.ProtocolPrivateData(ctx, s.protocolDataDir, rpc, "Request", "PlannedPrivate", req.PlannedPrivate)
 
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
 	resp, err := s.downstream.ApplyResourceChange(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
-		return nil, err
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.ApplyResourceChangeResponse{
+			Diagnostics: diags,
+		}
 	}
 
 	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
@@ -874,6 +2609,8 @@ func (s *server) ApplyResourceChange(ctx context.Context, protoReq *tfplugin6.Ap
 	logging.ProtocolPrivateData(ctx, s.protocolDataDir, rpc, "Response", "Private", resp.Private)
 
 	protoResp := toproto.ApplyResourceChange_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
 	return protoResp, nil
 }
@@ -883,21 +2620,36 @@ func (s *server) ImportResourceState(ctx context.Context, protoReq *tfplugin6.Im
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
 	ctx = logging.ResourceContext(ctx, protoReq.TypeName)
-	ctx = s.stoppableContext(ctx)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
 	req := fromproto.ImportResourceStateRequest(protoReq)
 
 	tf6serverlogging.ImportResourceStateClientCapabilities(ctx, req.ClientCapabilities)
 
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
 	resp, err := s.downstream.ImportResourceState(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
-		return nil, err
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.ImportResourceStateResponse{
+			Diagnostics: diags,
+		}
 	}
 
 	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
@@ -908,11 +2660,17 @@ func (s *server) ImportResourceState(ctx context.Context, protoReq *tfplugin6.Im
 	}
 	tf6serverlogging.Deferred(ctx, resp.Deferred)
 
-	if resp.Deferred != nil && (req.ClientCapabilities == nil || !req.ClientCapabilities.DeferralAllowed) {
+	if resp.Deferred != nil && !req.ClientCapabilities.GetDeferralAllowed() {
 		resp.Diagnostics = append(resp.Diagnostics, invalidDeferredResponseDiag(resp.Deferred.Reason))
 	}
 
+	if s.verifyImportedResources {
+		resp.Diagnostics = append(resp.Diagnostics, s.importedResourcesDiagnostics(ctx, req, resp)...)
+	}
+
 	protoResp := toproto.ImportResourceState_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
 	return protoResp, nil
 }
@@ -922,26 +2680,42 @@ func (s *server) MoveResourceState(ctx context.Context, protoReq *tfplugin6.Move
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
 	ctx = logging.ResourceContext(ctx, protoReq.TargetTypeName)
-	ctx = s.stoppableContext(ctx)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
 	req := fromproto.MoveResourceStateRequest(protoReq)
 
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
 	resp, err := s.downstream.MoveResourceState(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]interface{}{logging.KeyError: err})
+		tf6serverlogging.DownstreamError(ctx, err)
 
-		return nil, err
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.MoveResourceStateResponse{
+			Diagnostics: diags,
+		}
 	}
 
 	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
 	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Response", "TargetState", resp.TargetState)
 
 	protoResp := toproto.MoveResourceState_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
 	return protoResp, nil
 }
@@ -950,9 +2724,11 @@ func (s *server) CallFunction(ctx context.Context, protoReq *tfplugin6.CallFunct
 	rpc := "CallFunction"
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
-	ctx = s.stoppableContext(ctx)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
 	req := fromproto.CallFunctionRequest(protoReq)
 
@@ -960,19 +2736,25 @@ func (s *server) CallFunction(ctx context.Context, protoReq *tfplugin6.CallFunct
 		logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Request", fmt.Sprintf("Arguments_%d", position), argument)
 	}
 
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
 	resp, err := s.downstream.CallFunction(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]any{logging.KeyError: err})
-		return nil, err
+		tf6serverlogging.DownstreamError(ctx, err)
+		return nil, downstreamErrorStatus(err)
 	}
 
 	tf6serverlogging.DownstreamResponseWithError(ctx, resp.Error)
 	logging.ProtocolData(ctx, s.protocolDataDir, rpc, "Response", "Result", resp.Result)
 
 	protoResp := toproto.CallFunction_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
 	return protoResp, nil
 }
@@ -981,24 +2763,41 @@ func (s *server) GetFunctions(ctx context.Context, protoReq *tfplugin6.GetFuncti
 	rpc := "GetFunctions"
 	ctx = s.loggingContext(ctx)
 	ctx = logging.RpcContext(ctx, rpc)
-	ctx = s.stoppableContext(ctx)
+	ctx, served := s.stoppableContext(ctx)
+	defer served()
 	logging.ProtocolTrace(ctx, "Received request")
 	defer logging.ProtocolTrace(ctx, "Served request")
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Request", protoReq)
 
 	req := fromproto.GetFunctionsRequest(protoReq)
 
+	if err := s.waitRateLimit(ctx, rpc); err != nil {
+		return nil, err
+	}
+
 	ctx = tf6serverlogging.DownstreamRequest(ctx)
 
 	resp, err := s.downstream.GetFunctions(ctx, req)
 
 	if err != nil {
-		logging.ProtocolError(ctx, "Error from downstream", map[string]any{logging.KeyError: err})
-		return nil, err
+		tf6serverlogging.DownstreamError(ctx, err)
+
+		diags := downstreamErrorDiagnostics(err)
+
+		if diags == nil {
+			return nil, downstreamErrorStatus(err)
+		}
+
+		resp = &tfprotov6.GetFunctionsResponse{
+			Diagnostics: diags,
+		}
 	}
 
 	tf6serverlogging.DownstreamResponse(ctx, resp.Diagnostics)
 
 	protoResp := toproto.GetFunctions_Response(resp)
+	logging.ProtocolMessage(ctx, s.protocolDumpDir, rpc, "Response", protoResp)
+	s.recordExchange(ctx, rpc, protoReq, protoResp)
 
 	return protoResp, nil
 }
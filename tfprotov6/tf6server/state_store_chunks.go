@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+)
+
+// defaultStateStoreChunkSize is the size in bytes of ReadStateBytes chunks
+// when Terraform did not propose a chunk size in ConfigureStateStore. It is
+// well below the default gRPC message size limit of 4 MiB.
+const defaultStateStoreChunkSize int64 = 1 << 20
+
+// stateStoreChunkOverhead is the size in bytes reserved in each
+// ReadStateBytes message for fields other than the state bytes, such as the
+// byte range, total length, and diagnostics sent with the first chunk.
+const stateStoreChunkOverhead int64 = 1 << 20
+
+// maxStateStoreChunkSize is the largest chunk size in bytes which can be
+// negotiated, so ReadStateBytes messages do not exceed grpcMaxMessageSize.
+const maxStateStoreChunkSize int64 = grpcMaxMessageSize - stateStoreChunkOverhead
+
+// setStateStoreChunkSize negotiates the chunk size of the state store type
+// from the chunk size proposed by Terraform and returns it. Proposals larger
+// than maxStateStoreChunkSize are lowered to it.
+func (s *server) setStateStoreChunkSize(typeName string, proposed int64) int64 {
+	chunkSize := proposed
+
+	if chunkSize <= 0 {
+		chunkSize = defaultStateStoreChunkSize
+	}
+
+	if chunkSize > maxStateStoreChunkSize {
+		chunkSize = maxStateStoreChunkSize
+	}
+
+	s.stateStoreChunkSizesMu.Lock()
+	defer s.stateStoreChunkSizesMu.Unlock()
+
+	if s.stateStoreChunkSizes == nil {
+		s.stateStoreChunkSizes = make(map[string]int64)
+	}
+
+	s.stateStoreChunkSizes[typeName] = chunkSize
+
+	return chunkSize
+}
+
+// stateStoreChunkSize returns the chunk size negotiated for the state store
+// type, or the default chunk size if ConfigureStateStore was not called.
+func (s *server) stateStoreChunkSize(typeName string) int64 {
+	s.stateStoreChunkSizesMu.Lock()
+	defer s.stateStoreChunkSizesMu.Unlock()
+
+	if chunkSize, ok := s.stateStoreChunkSizes[typeName]; ok {
+		return chunkSize
+	}
+
+	return defaultStateStoreChunkSize
+}
+
+// splitStateBytes splits a ReadStateBytes response containing the whole state
+// into chunks of at most chunkSize bytes. Diagnostics are only sent with the
+// first chunk.
+func splitStateBytes(whole *tfplugin6.ReadStateBytes_Response, chunkSize int64) []*tfplugin6.ReadStateBytes_Response {
+	totalLength := int64(len(whole.Bytes))
+
+	if totalLength <= chunkSize {
+		return []*tfplugin6.ReadStateBytes_Response{whole}
+	}
+
+	chunks := make([]*tfplugin6.ReadStateBytes_Response, 0, (totalLength+chunkSize-1)/chunkSize)
+
+	for start := int64(0); start < totalLength; start += chunkSize {
+		end := min(start+chunkSize, totalLength)
+
+		chunk := &tfplugin6.ReadStateBytes_Response{
+			Bytes: whole.Bytes[start:end],
+			Range: &tfplugin6.StateRange{
+				Start: start,
+				End:   end,
+			},
+			TotalLength: totalLength,
+		}
+
+		if start == 0 {
+			chunk.Diagnostics = whole.Diagnostics
+		}
+
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// assembleStateBytes receives all WriteStateBytes chunks from Terraform and
+// returns a single chunk containing the metadata and the whole state. Chunks
+// which do not form a contiguous state return an error diagnostic, while
+// errors receiving chunks are returned as-is.
+func assembleStateBytes(protoStream grpc.ClientStreamingServer[tfplugin6.WriteStateBytes_RequestChunk, tfplugin6.WriteStateBytes_Response]) (*tfplugin6.WriteStateBytes_RequestChunk, *tfprotov6.Diagnostic, error) {
+	var whole *tfplugin6.WriteStateBytes_RequestChunk
+
+	for {
+		chunk, err := protoStream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if whole == nil {
+			whole = &tfplugin6.WriteStateBytes_RequestChunk{
+				Meta:        chunk.Meta,
+				TotalLength: chunk.TotalLength,
+			}
+
+			if chunk.Meta == nil {
+				return whole, invalidStateChunksDiag("The first chunk did not include the state metadata."), nil
+			}
+		} else if chunk.Meta != nil {
+			return whole, invalidStateChunksDiag("A chunk other than the first chunk included the state metadata."), nil
+		}
+
+		if chunk.TotalLength != whole.TotalLength {
+			return whole, invalidStateChunksDiag(fmt.Sprintf("A chunk declared a total length of %d bytes, expected %d bytes.", chunk.TotalLength, whole.TotalLength)), nil
+		}
+
+		start := int64(len(whole.Bytes))
+		end := start + int64(len(chunk.Bytes))
+
+		if chunk.Range.GetStart() != start || chunk.Range.GetEnd() != end {
+			return whole, invalidStateChunksDiag(fmt.Sprintf("A chunk declared the byte range %d-%d, expected %d-%d.", chunk.Range.GetStart(), chunk.Range.GetEnd(), start, end)), nil
+		}
+
+		whole.Bytes = append(whole.Bytes, chunk.Bytes...)
+	}
+
+	if whole == nil {
+		return &tfplugin6.WriteStateBytes_RequestChunk{}, invalidStateChunksDiag("No chunks were received."), nil
+	}
+
+	if int64(len(whole.Bytes)) != whole.TotalLength {
+		return whole, invalidStateChunksDiag(fmt.Sprintf("Received %d bytes, expected a total length of %d bytes.", len(whole.Bytes), whole.TotalLength)), nil
+	}
+
+	whole.Range = &tfplugin6.StateRange{
+		Start: 0,
+		End:   whole.TotalLength,
+	}
+
+	return whole, nil, nil
+}
+
+// invalidStateChunksDiag returns an error diagnostic for WriteStateBytes
+// chunks which do not form a contiguous state.
+func invalidStateChunksDiag(detail string) *tfprotov6.Diagnostic {
+	return &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "Invalid WriteStateBytes Request",
+		Detail: "The provider received state chunks which do not form a contiguous state. " +
+			"This is always an issue in Terraform and should be reported to the Terraform maintainers.\n\n" + detail,
+	}
+}
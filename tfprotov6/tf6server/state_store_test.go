@@ -0,0 +1,735 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6server_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+)
+
+// testStateStoreProviderServer is an in-memory state store.
+type testStateStoreProviderServer struct {
+	tfprotov6.ProviderServer
+
+	mu     *sync.Mutex
+	states map[string][]byte
+	locks  map[string]string
+}
+
+func newTestStateStoreProviderServer() testStateStoreProviderServer {
+	return testStateStoreProviderServer{
+		mu:     &sync.Mutex{},
+		states: map[string][]byte{},
+		locks:  map[string]string{},
+	}
+}
+
+func (s testStateStoreProviderServer) ValidateStateStoreConfig(ctx context.Context, _ *tfprotov6.ValidateStateStoreConfigRequest) (*tfprotov6.ValidateStateStoreConfigResponse, error) {
+	typeName, _ := tf6server.StateStoreTypeFromContext(ctx)
+
+	return &tfprotov6.ValidateStateStoreConfigResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  typeName,
+			},
+		},
+	}, nil
+}
+
+func (s testStateStoreProviderServer) ConfigureStateStore(_ context.Context, _ *tfprotov6.ConfigureStateStoreRequest) (*tfprotov6.ConfigureStateStoreResponse, error) {
+	return &tfprotov6.ConfigureStateStoreResponse{}, nil
+}
+
+func (s testStateStoreProviderServer) ReadStateBytes(_ context.Context, req *tfprotov6.ReadStateBytesRequest) (*tfprotov6.ReadStateBytesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return &tfprotov6.ReadStateBytesResponse{
+		Bytes: s.states[req.StateID],
+	}, nil
+}
+
+func (s testStateStoreProviderServer) WriteStateBytes(_ context.Context, req *tfprotov6.WriteStateBytesRequest) (*tfprotov6.WriteStateBytesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[req.StateID] = req.Bytes
+
+	return &tfprotov6.WriteStateBytesResponse{}, nil
+}
+
+func (s testStateStoreProviderServer) LockState(_ context.Context, req *tfprotov6.LockStateRequest) (*tfprotov6.LockStateResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if operation, ok := s.locks[req.StateID]; ok {
+		return &tfprotov6.LockStateResponse{
+			Diagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "State Locked",
+					Detail:   "The state is locked by " + operation,
+				},
+			},
+		}, nil
+	}
+
+	s.locks[req.StateID] = req.Operation
+
+	return &tfprotov6.LockStateResponse{
+		LockID: req.StateID + "-lock",
+	}, nil
+}
+
+func (s testStateStoreProviderServer) UnlockState(_ context.Context, req *tfprotov6.UnlockStateRequest) (*tfprotov6.UnlockStateResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.locks, req.StateID)
+
+	return &tfprotov6.UnlockStateResponse{}, nil
+}
+
+func (s testStateStoreProviderServer) GetStates(_ context.Context, _ *tfprotov6.GetStatesRequest) (*tfprotov6.GetStatesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := &tfprotov6.GetStatesResponse{}
+
+	for stateID := range s.states {
+		resp.StateIDs = append(resp.StateIDs, stateID)
+	}
+
+	sort.Strings(resp.StateIDs)
+
+	return resp, nil
+}
+
+func (s testStateStoreProviderServer) DeleteState(_ context.Context, req *tfprotov6.DeleteStateRequest) (*tfprotov6.DeleteStateResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.states, req.StateID)
+
+	return &tfprotov6.DeleteStateResponse{}, nil
+}
+
+// testReadStateBytesStream is a grpc.ServerStreamingServer which records sent
+// ReadStateBytes chunks.
+type testReadStateBytesStream struct {
+	grpc.ServerStream
+
+	chunks []*tfplugin6.ReadStateBytes_Response
+}
+
+func (s *testReadStateBytesStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *testReadStateBytesStream) Send(chunk *tfplugin6.ReadStateBytes_Response) error {
+	s.chunks = append(s.chunks, chunk)
+
+	return nil
+}
+
+// testWriteStateBytesStream is a grpc.ClientStreamingServer which receives
+// the given WriteStateBytes chunks and records the response.
+type testWriteStateBytesStream struct {
+	grpc.ServerStream
+
+	chunks []*tfplugin6.WriteStateBytes_RequestChunk
+	resp   *tfplugin6.WriteStateBytes_Response
+}
+
+func (s *testWriteStateBytesStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *testWriteStateBytesStream) Recv() (*tfplugin6.WriteStateBytes_RequestChunk, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+
+	return chunk, nil
+}
+
+func (s *testWriteStateBytesStream) SendAndClose(resp *tfplugin6.WriteStateBytes_Response) error {
+	s.resp = resp
+
+	return nil
+}
+
+func testWriteStateBytesChunk(meta *tfplugin6.WriteStateBytes_RequestChunkMeta, data string, totalLength, start int64) *tfplugin6.WriteStateBytes_RequestChunk {
+	return &tfplugin6.WriteStateBytes_RequestChunk{
+		Bytes: []byte(data),
+		Meta:  meta,
+		Range: &tfplugin6.StateRange{
+			Start: start,
+			End:   start + int64(len(data)),
+		},
+		TotalLength: totalLength,
+	}
+}
+
+func TestValidateStateStoreConfig(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", newTestStateStoreProviderServer())
+
+	got, err := server.ValidateStateStoreConfig(context.Background(), &tfplugin6.ValidateStateStoreConfig_Request{
+		TypeName: "test_state_store",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfplugin6.ValidateStateStoreConfig_Response{
+		Diagnostics: []*tfplugin6.Diagnostic{
+			{
+				Severity: tfplugin6.Diagnostic_WARNING,
+				Summary:  "test_state_store",
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestConfigureStateStore(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		capabilities *tfplugin6.StateStoreClientCapabilities
+		expected     int64
+	}{
+		"no-capabilities": {
+			capabilities: nil,
+			expected:     1 << 20,
+		},
+		"chunk-size": {
+			capabilities: &tfplugin6.StateStoreClientCapabilities{
+				ChunkSize: 4096,
+			},
+			expected: 4096,
+		},
+		"chunk-size-exceeds-max-message-size": {
+			capabilities: &tfplugin6.StateStoreClientCapabilities{
+				ChunkSize: 1 << 30,
+			},
+			// gRPC max message size of 256MB minus the 1MB reserved for
+			// other message fields.
+			expected: 255 << 20,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := tf6server.New("registry.terraform.io/hashicorp/test", newTestStateStoreProviderServer())
+
+			got, err := server.ConfigureStateStore(context.Background(), &tfplugin6.ConfigureStateStore_Request{
+				Capabilities: testCase.capabilities,
+				TypeName:     "test_state_store",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.GetCapabilities().GetChunkSize() != testCase.expected {
+				t.Errorf("expected chunk size %d, got %d", testCase.expected, got.GetCapabilities().GetChunkSize())
+			}
+		})
+	}
+}
+
+func TestReadStateBytes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		chunkSize int64
+		state     string
+		expected  []*tfplugin6.ReadStateBytes_Response
+	}{
+		"empty": {
+			chunkSize: 4,
+			state:     "",
+			expected: []*tfplugin6.ReadStateBytes_Response{
+				{
+					Range: &tfplugin6.StateRange{},
+				},
+			},
+		},
+		"single-chunk": {
+			chunkSize: 0,
+			state:     "0123456789",
+			expected: []*tfplugin6.ReadStateBytes_Response{
+				{
+					Bytes:       []byte("0123456789"),
+					Range:       &tfplugin6.StateRange{End: 10},
+					TotalLength: 10,
+				},
+			},
+		},
+		"multiple-chunks": {
+			chunkSize: 4,
+			state:     "0123456789",
+			expected: []*tfplugin6.ReadStateBytes_Response{
+				{
+					Bytes:       []byte("0123"),
+					Range:       &tfplugin6.StateRange{End: 4},
+					TotalLength: 10,
+				},
+				{
+					Bytes:       []byte("4567"),
+					Range:       &tfplugin6.StateRange{Start: 4, End: 8},
+					TotalLength: 10,
+				},
+				{
+					Bytes:       []byte("89"),
+					Range:       &tfplugin6.StateRange{Start: 8, End: 10},
+					TotalLength: 10,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			downstream := newTestStateStoreProviderServer()
+			downstream.states["default"] = []byte(testCase.state)
+
+			server := tf6server.New("registry.terraform.io/hashicorp/test", downstream)
+
+			_, err := server.ConfigureStateStore(context.Background(), &tfplugin6.ConfigureStateStore_Request{
+				Capabilities: &tfplugin6.StateStoreClientCapabilities{
+					ChunkSize: testCase.chunkSize,
+				},
+				TypeName: "test_state_store",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error configuring: %s", err)
+			}
+
+			stream := &testReadStateBytesStream{}
+
+			err = server.ReadStateBytes(&tfplugin6.ReadStateBytes_Request{
+				StateId:  "default",
+				TypeName: "test_state_store",
+			}, stream)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(stream.chunks, testCase.expected, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteStateBytes(t *testing.T) {
+	t.Parallel()
+
+	meta := &tfplugin6.WriteStateBytes_RequestChunkMeta{
+		StateId:  "default",
+		TypeName: "test_state_store",
+	}
+
+	testCases := map[string]struct {
+		chunks        []*tfplugin6.WriteStateBytes_RequestChunk
+		expectedState string
+		expectedError string
+	}{
+		"single-chunk": {
+			chunks: []*tfplugin6.WriteStateBytes_RequestChunk{
+				testWriteStateBytesChunk(meta, "0123456789", 10, 0),
+			},
+			expectedState: "0123456789",
+		},
+		"multiple-chunks": {
+			chunks: []*tfplugin6.WriteStateBytes_RequestChunk{
+				testWriteStateBytesChunk(meta, "0123", 10, 0),
+				testWriteStateBytesChunk(nil, "4567", 10, 4),
+				testWriteStateBytesChunk(nil, "89", 10, 8),
+			},
+			expectedState: "0123456789",
+		},
+		"no-chunks": {
+			chunks:        nil,
+			expectedError: "No chunks were received.",
+		},
+		"missing-meta": {
+			chunks: []*tfplugin6.WriteStateBytes_RequestChunk{
+				testWriteStateBytesChunk(nil, "0123456789", 10, 0),
+			},
+			expectedError: "The first chunk did not include the state metadata.",
+		},
+		"repeated-meta": {
+			chunks: []*tfplugin6.WriteStateBytes_RequestChunk{
+				testWriteStateBytesChunk(meta, "0123", 10, 0),
+				testWriteStateBytesChunk(meta, "456789", 10, 4),
+			},
+			expectedError: "A chunk other than the first chunk included the state metadata.",
+		},
+		"range-gap": {
+			chunks: []*tfplugin6.WriteStateBytes_RequestChunk{
+				testWriteStateBytesChunk(meta, "0123", 10, 0),
+				testWriteStateBytesChunk(nil, "6789", 10, 6),
+			},
+			expectedError: "A chunk declared the byte range 6-10, expected 4-8.",
+		},
+		"total-length-changed": {
+			chunks: []*tfplugin6.WriteStateBytes_RequestChunk{
+				testWriteStateBytesChunk(meta, "0123", 10, 0),
+				testWriteStateBytesChunk(nil, "456789", 12, 4),
+			},
+			expectedError: "A chunk declared a total length of 12 bytes, expected 10 bytes.",
+		},
+		"truncated": {
+			chunks: []*tfplugin6.WriteStateBytes_RequestChunk{
+				testWriteStateBytesChunk(meta, "0123", 10, 0),
+			},
+			expectedError: "Received 4 bytes, expected a total length of 10 bytes.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			downstream := newTestStateStoreProviderServer()
+			server := tf6server.New("registry.terraform.io/hashicorp/test", downstream)
+			stream := &testWriteStateBytesStream{
+				chunks: testCase.chunks,
+			}
+
+			err := server.WriteStateBytes(stream)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			diags := stream.resp.GetDiagnostics()
+
+			if testCase.expectedError != "" {
+				if len(diags) != 1 {
+					t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+				}
+
+				if diags[0].Summary != "Invalid WriteStateBytes Request" || !strings.HasSuffix(diags[0].Detail, testCase.expectedError) {
+					t.Errorf("unexpected diagnostic: %s", diags[0])
+				}
+
+				if _, ok := downstream.states["default"]; ok {
+					t.Error("expected state to not be written")
+				}
+
+				return
+			}
+
+			if len(diags) != 0 {
+				t.Fatalf("unexpected diagnostics: %s", diags)
+			}
+
+			if got := string(downstream.states["default"]); got != testCase.expectedState {
+				t.Errorf("expected state %q, got %q", testCase.expectedState, got)
+			}
+		})
+	}
+}
+
+func TestLockState(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", newTestStateStoreProviderServer())
+
+	req := &tfplugin6.LockState_Request{
+		Operation: "apply",
+		StateId:   "default",
+		TypeName:  "test_state_store",
+	}
+
+	got, err := server.LockState(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.LockId != "default-lock" || len(got.Diagnostics) != 0 {
+		t.Fatalf("unexpected response: %s", got)
+	}
+
+	got, err = server.LockState(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got.Diagnostics) != 1 || got.Diagnostics[0].Summary != "State Locked" {
+		t.Fatalf("expected State Locked diagnostic, got: %s", got)
+	}
+
+	_, err = server.UnlockState(context.Background(), &tfplugin6.UnlockState_Request{
+		LockId:   "default-lock",
+		StateId:  "default",
+		TypeName: "test_state_store",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error unlocking: %s", err)
+	}
+
+	got, err = server.LockState(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.LockId != "default-lock" || len(got.Diagnostics) != 0 {
+		t.Errorf("unexpected response after unlocking: %s", got)
+	}
+}
+
+func TestGetStatesDeleteState(t *testing.T) {
+	t.Parallel()
+
+	downstream := newTestStateStoreProviderServer()
+	downstream.states["default"] = []byte("{}")
+	downstream.states["test"] = []byte("{}")
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", downstream)
+
+	_, err := server.DeleteState(context.Background(), &tfplugin6.DeleteState_Request{
+		StateId:  "test",
+		TypeName: "test_state_store",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error deleting: %s", err)
+	}
+
+	got, err := server.GetStates(context.Background(), &tfplugin6.GetStates_Request{
+		TypeName: "test_state_store",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got.StateId, []string{"default"}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestStateStoreNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", testContextProviderServer{})
+
+	responses := map[string]func() ([]*tfplugin6.Diagnostic, error){
+		"ValidateStateStoreConfig": func() ([]*tfplugin6.Diagnostic, error) {
+			resp, err := server.ValidateStateStoreConfig(context.Background(), &tfplugin6.ValidateStateStoreConfig_Request{})
+			return resp.GetDiagnostics(), err
+		},
+		"ConfigureStateStore": func() ([]*tfplugin6.Diagnostic, error) {
+			resp, err := server.ConfigureStateStore(context.Background(), &tfplugin6.ConfigureStateStore_Request{})
+			return resp.GetDiagnostics(), err
+		},
+		"ReadStateBytes": func() ([]*tfplugin6.Diagnostic, error) {
+			stream := &testReadStateBytesStream{}
+			err := server.ReadStateBytes(&tfplugin6.ReadStateBytes_Request{}, stream)
+
+			if len(stream.chunks) != 1 {
+				return nil, err
+			}
+
+			return stream.chunks[0].GetDiagnostics(), err
+		},
+		"WriteStateBytes": func() ([]*tfplugin6.Diagnostic, error) {
+			stream := &testWriteStateBytesStream{
+				chunks: []*tfplugin6.WriteStateBytes_RequestChunk{
+					testWriteStateBytesChunk(&tfplugin6.WriteStateBytes_RequestChunkMeta{}, "", 0, 0),
+				},
+			}
+			err := server.WriteStateBytes(stream)
+			return stream.resp.GetDiagnostics(), err
+		},
+		"LockState": func() ([]*tfplugin6.Diagnostic, error) {
+			resp, err := server.LockState(context.Background(), &tfplugin6.LockState_Request{})
+			return resp.GetDiagnostics(), err
+		},
+		"UnlockState": func() ([]*tfplugin6.Diagnostic, error) {
+			resp, err := server.UnlockState(context.Background(), &tfplugin6.UnlockState_Request{})
+			return resp.GetDiagnostics(), err
+		},
+		"GetStates": func() ([]*tfplugin6.Diagnostic, error) {
+			resp, err := server.GetStates(context.Background(), &tfplugin6.GetStates_Request{})
+			return resp.GetDiagnostics(), err
+		},
+		"DeleteState": func() ([]*tfplugin6.Diagnostic, error) {
+			resp, err := server.DeleteState(context.Background(), &tfplugin6.DeleteState_Request{})
+			return resp.GetDiagnostics(), err
+		},
+	}
+
+	for rpc, f := range responses {
+		rpc, f := rpc, f
+
+		t.Run(rpc, func(t *testing.T) {
+			t.Parallel()
+
+			diags, err := f()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+			}
+
+			if diags[0].Severity != tfplugin6.Diagnostic_ERROR || diags[0].Summary != "Provider State Store Not Implemented" {
+				t.Errorf("unexpected diagnostic: %s", diags[0])
+			}
+		})
+	}
+}
+
+func TestStateStoreReplay(t *testing.T) {
+	t.Parallel()
+
+	var recording bytes.Buffer
+
+	server := tf6server.New("registry.terraform.io/hashicorp/test", newTestStateStoreProviderServer(), tf6server.WithRecording(&recording))
+
+	_, err := server.ConfigureStateStore(context.Background(), &tfplugin6.ConfigureStateStore_Request{
+		Capabilities: &tfplugin6.StateStoreClientCapabilities{
+			ChunkSize: 4,
+		},
+		TypeName: "test_state_store",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error configuring: %s", err)
+	}
+
+	meta := &tfplugin6.WriteStateBytes_RequestChunkMeta{
+		StateId:  "default",
+		TypeName: "test_state_store",
+	}
+
+	err = server.WriteStateBytes(&testWriteStateBytesStream{
+		chunks: []*tfplugin6.WriteStateBytes_RequestChunk{
+			testWriteStateBytesChunk(meta, "0123", 10, 0),
+			testWriteStateBytesChunk(nil, "456789", 10, 4),
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error writing: %s", err)
+	}
+
+	err = server.ReadStateBytes(&tfplugin6.ReadStateBytes_Request{
+		StateId:  "default",
+		TypeName: "test_state_store",
+	}, &testReadStateBytesStream{})
+
+	if err != nil {
+		t.Fatalf("unexpected error reading: %s", err)
+	}
+
+	replayProviderServer, err := tf6server.NewReplayProviderServer(&recording)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Replays are independent of the chunk size negotiated while recording.
+	replayServer := tf6server.New("registry.terraform.io/hashicorp/test", replayProviderServer)
+
+	_, err = replayServer.ConfigureStateStore(context.Background(), &tfplugin6.ConfigureStateStore_Request{
+		Capabilities: &tfplugin6.StateStoreClientCapabilities{
+			ChunkSize: 6,
+		},
+		TypeName: "test_state_store",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error replaying ConfigureStateStore: %s", err)
+	}
+
+	writeStream := &testWriteStateBytesStream{
+		chunks: []*tfplugin6.WriteStateBytes_RequestChunk{
+			testWriteStateBytesChunk(meta, "012345", 10, 0),
+			testWriteStateBytesChunk(nil, "6789", 10, 6),
+		},
+	}
+
+	err = replayServer.WriteStateBytes(writeStream)
+
+	if err != nil {
+		t.Fatalf("unexpected error replaying WriteStateBytes: %s", err)
+	}
+
+	if diags := writeStream.resp.GetDiagnostics(); len(diags) != 0 {
+		t.Fatalf("unexpected WriteStateBytes diagnostics: %s", diags)
+	}
+
+	readStream := &testReadStateBytesStream{}
+
+	err = replayServer.ReadStateBytes(&tfplugin6.ReadStateBytes_Request{
+		StateId:  "default",
+		TypeName: "test_state_store",
+	}, readStream)
+
+	if err != nil {
+		t.Fatalf("unexpected error replaying ReadStateBytes: %s", err)
+	}
+
+	var got []byte
+
+	for _, chunk := range readStream.chunks {
+		if len(chunk.Diagnostics) != 0 {
+			t.Fatalf("unexpected ReadStateBytes diagnostics: %s", chunk.Diagnostics)
+		}
+
+		got = append(got, chunk.Bytes...)
+	}
+
+	if len(readStream.chunks) != 2 || string(got) != "0123456789" {
+		t.Errorf("expected state in 2 chunks, got %q in %d chunks", got, len(readStream.chunks))
+	}
+}
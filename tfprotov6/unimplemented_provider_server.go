@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6
+
+import (
+	"context"
+)
+
+var (
+	_ ProviderServerWithActions            = UnimplementedProviderServer{}
+	_ ProviderServerWithEphemeralResources = UnimplementedProviderServer{}
+	_ ProviderServerWithStateStores        = UnimplementedProviderServer{}
+)
+
+// UnimplementedProviderServer implements all ProviderServer RPCs by returning
+// an error diagnostic, or a function error for CallFunction, explaining that
+// the provider does not implement them. GetMetadata returns
+// ErrUnsupportedRPC and StopProvider succeeds. It also
+// implements the optional interfaces ProviderServerWithEphemeralResources,
+// ProviderServerWithActions, and ProviderServerWithStateStores.
+//
+// Embed UnimplementedProviderServer in partial ProviderServer
+// implementations and test doubles, so they continue to compile when RPCs
+// are added to ProviderServer. The RPCs return the same diagnostics as
+// NewProviderServerFromParts with nil parts.
+type UnimplementedProviderServer struct{}
+
+// GetMetadata returns ErrUnsupportedRPC, so Terraform falls back to
+// GetProviderSchema.
+func (UnimplementedProviderServer) GetMetadata(ctx context.Context, req *GetMetadataRequest) (*GetMetadataResponse, error) {
+	return partsProviderServer{}.GetMetadata(ctx, req)
+}
+
+// GetProviderSchema returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRequest) (*GetProviderSchemaResponse, error) {
+	return partsProviderServer{}.GetProviderSchema(ctx, req)
+}
+
+// ValidateProviderConfig returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ValidateProviderConfig(ctx context.Context, req *ValidateProviderConfigRequest) (*ValidateProviderConfigResponse, error) {
+	return partsProviderServer{}.ValidateProviderConfig(ctx, req)
+}
+
+// ConfigureProvider returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ConfigureProvider(ctx context.Context, req *ConfigureProviderRequest) (*ConfigureProviderResponse, error) {
+	return partsProviderServer{}.ConfigureProvider(ctx, req)
+}
+
+// StopProvider returns an empty response.
+func (UnimplementedProviderServer) StopProvider(ctx context.Context, req *StopProviderRequest) (*StopProviderResponse, error) {
+	return partsProviderServer{}.StopProvider(ctx, req)
+}
+
+// ValidateResourceConfig returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ValidateResourceConfig(ctx context.Context, req *ValidateResourceConfigRequest) (*ValidateResourceConfigResponse, error) {
+	return partsProviderServer{}.ValidateResourceConfig(ctx, req)
+}
+
+// UpgradeResourceState returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) UpgradeResourceState(ctx context.Context, req *UpgradeResourceStateRequest) (*UpgradeResourceStateResponse, error) {
+	return partsProviderServer{}.UpgradeResourceState(ctx, req)
+}
+
+// ReadResource returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ReadResource(ctx context.Context, req *ReadResourceRequest) (*ReadResourceResponse, error) {
+	return partsProviderServer{}.ReadResource(ctx, req)
+}
+
+// PlanResourceChange returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) PlanResourceChange(ctx context.Context, req *PlanResourceChangeRequest) (*PlanResourceChangeResponse, error) {
+	return partsProviderServer{}.PlanResourceChange(ctx, req)
+}
+
+// ApplyResourceChange returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ApplyResourceChange(ctx context.Context, req *ApplyResourceChangeRequest) (*ApplyResourceChangeResponse, error) {
+	return partsProviderServer{}.ApplyResourceChange(ctx, req)
+}
+
+// ImportResourceState returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ImportResourceState(ctx context.Context, req *ImportResourceStateRequest) (*ImportResourceStateResponse, error) {
+	return partsProviderServer{}.ImportResourceState(ctx, req)
+}
+
+// MoveResourceState returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) MoveResourceState(ctx context.Context, req *MoveResourceStateRequest) (*MoveResourceStateResponse, error) {
+	return partsProviderServer{}.MoveResourceState(ctx, req)
+}
+
+// ValidateDataResourceConfig returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ValidateDataResourceConfig(ctx context.Context, req *ValidateDataResourceConfigRequest) (*ValidateDataResourceConfigResponse, error) {
+	return partsProviderServer{}.ValidateDataResourceConfig(ctx, req)
+}
+
+// ReadDataSource returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest) (*ReadDataSourceResponse, error) {
+	return partsProviderServer{}.ReadDataSource(ctx, req)
+}
+
+// CallFunction returns a not implemented function error.
+func (UnimplementedProviderServer) CallFunction(ctx context.Context, req *CallFunctionRequest) (*CallFunctionResponse, error) {
+	return partsProviderServer{}.CallFunction(ctx, req)
+}
+
+// GetFunctions returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) GetFunctions(ctx context.Context, req *GetFunctionsRequest) (*GetFunctionsResponse, error) {
+	return partsProviderServer{}.GetFunctions(ctx, req)
+}
+
+// ValidateEphemeralResourceConfig returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ValidateEphemeralResourceConfig(ctx context.Context, req *ValidateEphemeralResourceConfigRequest) (*ValidateEphemeralResourceConfigResponse, error) {
+	return partsProviderServer{}.ValidateEphemeralResourceConfig(ctx, req)
+}
+
+// OpenEphemeralResource returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) OpenEphemeralResource(ctx context.Context, req *OpenEphemeralResourceRequest) (*OpenEphemeralResourceResponse, error) {
+	return partsProviderServer{}.OpenEphemeralResource(ctx, req)
+}
+
+// RenewEphemeralResource returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) RenewEphemeralResource(ctx context.Context, req *RenewEphemeralResourceRequest) (*RenewEphemeralResourceResponse, error) {
+	return partsProviderServer{}.RenewEphemeralResource(ctx, req)
+}
+
+// CloseEphemeralResource returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) CloseEphemeralResource(ctx context.Context, req *CloseEphemeralResourceRequest) (*CloseEphemeralResourceResponse, error) {
+	return partsProviderServer{}.CloseEphemeralResource(ctx, req)
+}
+
+// ValidateActionConfig returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ValidateActionConfig(ctx context.Context, req *ValidateActionConfigRequest) (*ValidateActionConfigResponse, error) {
+	return partsProviderServer{}.ValidateActionConfig(ctx, req)
+}
+
+// InvokeAction returns a completed event with a not implemented error
+// diagnostic.
+func (UnimplementedProviderServer) InvokeAction(ctx context.Context, req *InvokeActionRequest) (*InvokeActionResponse, error) {
+	return partsProviderServer{}.InvokeAction(ctx, req)
+}
+
+// ValidateStateStoreConfig returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ValidateStateStoreConfig(ctx context.Context, req *ValidateStateStoreConfigRequest) (*ValidateStateStoreConfigResponse, error) {
+	return partsProviderServer{}.ValidateStateStoreConfig(ctx, req)
+}
+
+// ConfigureStateStore returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ConfigureStateStore(ctx context.Context, req *ConfigureStateStoreRequest) (*ConfigureStateStoreResponse, error) {
+	return partsProviderServer{}.ConfigureStateStore(ctx, req)
+}
+
+// ReadStateBytes returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) ReadStateBytes(ctx context.Context, req *ReadStateBytesRequest) (*ReadStateBytesResponse, error) {
+	return partsProviderServer{}.ReadStateBytes(ctx, req)
+}
+
+// WriteStateBytes returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) WriteStateBytes(ctx context.Context, req *WriteStateBytesRequest) (*WriteStateBytesResponse, error) {
+	return partsProviderServer{}.WriteStateBytes(ctx, req)
+}
+
+// LockState returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) LockState(ctx context.Context, req *LockStateRequest) (*LockStateResponse, error) {
+	return partsProviderServer{}.LockState(ctx, req)
+}
+
+// UnlockState returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) UnlockState(ctx context.Context, req *UnlockStateRequest) (*UnlockStateResponse, error) {
+	return partsProviderServer{}.UnlockState(ctx, req)
+}
+
+// GetStates returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) GetStates(ctx context.Context, req *GetStatesRequest) (*GetStatesResponse, error) {
+	return partsProviderServer{}.GetStates(ctx, req)
+}
+
+// DeleteState returns a not implemented error diagnostic.
+func (UnimplementedProviderServer) DeleteState(ctx context.Context, req *DeleteStateRequest) (*DeleteStateResponse, error) {
+	return partsProviderServer{}.DeleteState(ctx, req)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// testEmbeddedUnimplementedProviderServer only implements ReadDataSource.
+type testEmbeddedUnimplementedProviderServer struct {
+	tfprotov6.UnimplementedProviderServer
+}
+
+func (s testEmbeddedUnimplementedProviderServer) ReadDataSource(_ context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	return &tfprotov6.ReadDataSourceResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  req.TypeName,
+			},
+		},
+	}, nil
+}
+
+func TestUnimplementedProviderServer(t *testing.T) {
+	t.Parallel()
+
+	var server tfprotov6.ProviderServer = testEmbeddedUnimplementedProviderServer{}
+
+	testCases := map[string]struct {
+		call                func() ([]*tfprotov6.Diagnostic, error)
+		expectedDiagnostics []*tfprotov6.Diagnostic
+	}{
+		"GetProviderSchema": {
+			call: func() ([]*tfprotov6.Diagnostic, error) {
+				resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedDiagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Provider Configuration Not Implemented",
+					Detail: "A GetProviderSchema call was received by the provider, however the provider does not implement the RPC. " +
+						"Either upgrade the provider to a version that implements configuration support or this is always an error in the provider that should be reported to the provider developers.",
+				},
+			},
+		},
+		"ReadDataSource": {
+			call: func() ([]*tfprotov6.Diagnostic, error) {
+				resp, err := server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{
+					TypeName: "test_data_source",
+				})
+				return resp.Diagnostics, err
+			},
+			expectedDiagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "test_data_source",
+				},
+			},
+		},
+		"ReadResource": {
+			call: func() ([]*tfprotov6.Diagnostic, error) {
+				resp, err := server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedDiagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Provider Resource Not Implemented",
+					Detail: "A ReadResource call was received by the provider, however the provider does not implement the RPC. " +
+						"Either upgrade the provider to a version that implements resource support or this is always an error in the provider that should be reported to the provider developers.",
+				},
+			},
+		},
+		"OpenEphemeralResource": {
+			call: func() ([]*tfprotov6.Diagnostic, error) {
+				resp, err := server.(tfprotov6.ProviderServerWithEphemeralResources).OpenEphemeralResource(context.Background(), &tfprotov6.OpenEphemeralResourceRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedDiagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Provider Ephemeral Resource Not Implemented",
+					Detail: "A OpenEphemeralResource call was received by the provider, however the provider does not implement the RPC. " +
+						"Either upgrade the provider to a version that implements ephemeral resource support or this is always an error in the provider that should be reported to the provider developers.",
+				},
+			},
+		},
+		"GetStates": {
+			call: func() ([]*tfprotov6.Diagnostic, error) {
+				resp, err := server.(tfprotov6.ProviderServerWithStateStores).GetStates(context.Background(), &tfprotov6.GetStatesRequest{})
+				return resp.Diagnostics, err
+			},
+			expectedDiagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Provider State Store Not Implemented",
+					Detail: "A GetStates call was received by the provider, however the provider does not implement the RPC. " +
+						"Either upgrade the provider to a version that implements state store support or this is always an error in the provider that should be reported to the provider developers.",
+				},
+			},
+		},
+		"StopProvider": {
+			call: func() ([]*tfprotov6.Diagnostic, error) {
+				resp, err := server.StopProvider(context.Background(), &tfprotov6.StopProviderRequest{})
+
+				if resp.Error != "" {
+					t.Errorf("unexpected StopProvider error: %s", resp.Error)
+				}
+
+				return nil, err
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags, err := testCase.call()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnimplementedProviderServerCallFunction(t *testing.T) {
+	t.Parallel()
+
+	resp, err := tfprotov6.UnimplementedProviderServer{}.CallFunction(context.Background(), &tfprotov6.CallFunctionRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &tfprotov6.CallFunctionResponse{
+		Error: &tfprotov6.FunctionError{
+			Text: "A CallFunction call was received by the provider, however the provider does not implement the RPC. " +
+				"Either upgrade the provider to a version that implements function support or this is always an error in the provider that should be reported to the provider developers.",
+		},
+	}
+
+	if diff := cmp.Diff(resp, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestUnimplementedProviderServerGetMetadata(t *testing.T) {
+	t.Parallel()
+
+	resp, err := tfprotov6.UnimplementedProviderServer{}.GetMetadata(context.Background(), &tfprotov6.GetMetadataRequest{})
+
+	if !errors.Is(err, tfprotov6.ErrUnsupportedRPC) {
+		t.Errorf("expected ErrUnsupportedRPC, got: %v", err)
+	}
+
+	if resp != nil {
+		t.Errorf("unexpected response: %v", resp)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ValidateActionConfigRequest returns the tfprotov5 equivalent of the tfprotov6
+// ValidateActionConfigRequest.
+func ValidateActionConfigRequest(in *tfprotov6.ValidateActionConfigRequest) *tfprotov5.ValidateActionConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ValidateActionConfigRequest{
+		ActionType: in.ActionType,
+		Config:     DynamicValue(in.Config),
+	}
+}
+
+// InvokeActionRequest returns the tfprotov5 equivalent of the tfprotov6
+// InvokeActionRequest.
+func InvokeActionRequest(in *tfprotov6.InvokeActionRequest) *tfprotov5.InvokeActionRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.InvokeActionRequest{
+		ActionType: in.ActionType,
+		Config:     DynamicValue(in.Config),
+	}
+}
+
+// ValidateActionConfigResponse returns the tfprotov5 equivalent of the
+// tfprotov6 ValidateActionConfigResponse.
+func ValidateActionConfigResponse(in *tfprotov6.ValidateActionConfigResponse) *tfprotov5.ValidateActionConfigResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ValidateActionConfigResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// ProgressInvokeActionEventType returns the tfprotov5 equivalent of the
+// tfprotov6 ProgressInvokeActionEventType.
+func ProgressInvokeActionEventType(in tfprotov6.ProgressInvokeActionEventType) tfprotov5.ProgressInvokeActionEventType {
+	return tfprotov5.ProgressInvokeActionEventType{
+		Message: in.Message,
+	}
+}
+
+// CompletedInvokeActionEventType returns the tfprotov5 equivalent of the
+// tfprotov6 CompletedInvokeActionEventType.
+func CompletedInvokeActionEventType(in tfprotov6.CompletedInvokeActionEventType) tfprotov5.CompletedInvokeActionEventType {
+	return tfprotov5.CompletedInvokeActionEventType{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// ActionMetadata returns the tfprotov5 equivalent of the tfprotov6
+// ActionMetadata.
+func ActionMetadata(in tfprotov6.ActionMetadata) tfprotov5.ActionMetadata {
+	return tfprotov5.ActionMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+// InvokeActionResponse returns the tfprotov5 equivalent of the tfprotov6
+// InvokeActionResponse. Events are converted as they are yielded.
+func InvokeActionResponse(in *tfprotov6.InvokeActionResponse) *tfprotov5.InvokeActionResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.InvokeActionResponse{}
+
+	if in.Events != nil {
+		resp.Events = func(yield func(tfprotov5.InvokeActionEvent) bool) {
+			in.Events(func(event tfprotov6.InvokeActionEvent) bool {
+				return yield(InvokeActionEvent(event))
+			})
+		}
+	}
+
+	return resp
+}
+
+// InvokeActionEvent returns the tfprotov5 equivalent of the tfprotov6
+// InvokeActionEvent. Unknown event types are converted into a nil Type.
+func InvokeActionEvent(in tfprotov6.InvokeActionEvent) tfprotov5.InvokeActionEvent {
+	switch eventType := in.Type.(type) {
+	case tfprotov6.ProgressInvokeActionEventType:
+		return tfprotov5.InvokeActionEvent{
+			Type: ProgressInvokeActionEventType(eventType),
+		}
+	case tfprotov6.CompletedInvokeActionEventType:
+		return tfprotov5.InvokeActionEvent{
+			Type: CompletedInvokeActionEventType(eventType),
+		}
+	default:
+		return tfprotov5.InvokeActionEvent{}
+	}
+}
+
+// ActionSchema returns the tfprotov5 equivalent of the tfprotov6
+// ActionSchema. See Schema for more information about the error.
+func ActionSchema(in *tfprotov6.ActionSchema) (*tfprotov5.ActionSchema, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	schema, err := Schema(in.Schema)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov5.ActionSchema{
+		Schema: schema,
+	}, nil
+}
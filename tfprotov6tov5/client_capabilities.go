@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ConfigureProviderClientCapabilities returns the tfprotov5 equivalent of the
+// tfprotov6 ConfigureProviderClientCapabilities.
+func ConfigureProviderClientCapabilities(in *tfprotov6.ConfigureProviderClientCapabilities) *tfprotov5.ConfigureProviderClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ConfigureProviderClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// ValidateResourceTypeConfigClientCapabilities returns the tfprotov5 equivalent
+// of the tfprotov6 ValidateResourceConfigClientCapabilities.
+func ValidateResourceTypeConfigClientCapabilities(in *tfprotov6.ValidateResourceConfigClientCapabilities) *tfprotov5.ValidateResourceTypeConfigClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ValidateResourceTypeConfigClientCapabilities{
+		WriteOnlyAttributesAllowed: in.WriteOnlyAttributesAllowed,
+	}
+}
+
+// ReadResourceClientCapabilities returns the tfprotov5 equivalent of the
+// tfprotov6 ReadResourceClientCapabilities.
+func ReadResourceClientCapabilities(in *tfprotov6.ReadResourceClientCapabilities) *tfprotov5.ReadResourceClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ReadResourceClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// PlanResourceChangeClientCapabilities returns the tfprotov5 equivalent of the
+// tfprotov6 PlanResourceChangeClientCapabilities.
+func PlanResourceChangeClientCapabilities(in *tfprotov6.PlanResourceChangeClientCapabilities) *tfprotov5.PlanResourceChangeClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.PlanResourceChangeClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// ImportResourceStateClientCapabilities returns the tfprotov5 equivalent of the
+// tfprotov6 ImportResourceStateClientCapabilities.
+func ImportResourceStateClientCapabilities(in *tfprotov6.ImportResourceStateClientCapabilities) *tfprotov5.ImportResourceStateClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ImportResourceStateClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// ReadDataSourceClientCapabilities returns the tfprotov5 equivalent of the
+// tfprotov6 ReadDataSourceClientCapabilities.
+func ReadDataSourceClientCapabilities(in *tfprotov6.ReadDataSourceClientCapabilities) *tfprotov5.ReadDataSourceClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ReadDataSourceClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// OpenEphemeralResourceClientCapabilities returns the tfprotov5 equivalent of
+// the tfprotov6 OpenEphemeralResourceClientCapabilities.
+func OpenEphemeralResourceClientCapabilities(in *tfprotov6.OpenEphemeralResourceClientCapabilities) *tfprotov5.OpenEphemeralResourceClientCapabilities {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.OpenEphemeralResourceClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ValidateDataSourceConfigRequest returns the tfprotov5 equivalent of the
+// tfprotov6 ValidateDataResourceConfigRequest.
+func ValidateDataSourceConfigRequest(in *tfprotov6.ValidateDataResourceConfigRequest) *tfprotov5.ValidateDataSourceConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ValidateDataSourceConfigRequest{
+		Config:   DynamicValue(in.Config),
+		TypeName: in.TypeName,
+	}
+}
+
+// ReadDataSourceRequest returns the tfprotov5 equivalent of the tfprotov6
+// ReadDataSourceRequest.
+func ReadDataSourceRequest(in *tfprotov6.ReadDataSourceRequest) *tfprotov5.ReadDataSourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ReadDataSourceRequest{
+		ClientCapabilities: ReadDataSourceClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		ProviderMeta:       DynamicValue(in.ProviderMeta),
+		TypeName:           in.TypeName,
+	}
+}
+
+// ValidateDataSourceConfigResponse returns the tfprotov5 equivalent of the
+// tfprotov6 ValidateDataResourceConfigResponse.
+func ValidateDataSourceConfigResponse(in *tfprotov6.ValidateDataResourceConfigResponse) *tfprotov5.ValidateDataSourceConfigResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ValidateDataSourceConfigResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// ReadDataSourceResponse returns the tfprotov5 equivalent of the tfprotov6
+// ReadDataSourceResponse.
+func ReadDataSourceResponse(in *tfprotov6.ReadDataSourceResponse) *tfprotov5.ReadDataSourceResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ReadDataSourceResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: Diagnostics(in.Diagnostics),
+		State:       DynamicValue(in.State),
+	}
+}
+
+// DataSourceMetadata returns the tfprotov5 equivalent of the tfprotov6
+// DataSourceMetadata.
+func DataSourceMetadata(in tfprotov6.DataSourceMetadata) tfprotov5.DataSourceMetadata {
+	return tfprotov5.DataSourceMetadata{
+		TypeName: in.TypeName,
+	}
+}
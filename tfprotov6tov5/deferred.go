@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// Deferred returns the tfprotov5 equivalent of the tfprotov6 Deferred.
+func Deferred(in *tfprotov6.Deferred) *tfprotov5.Deferred {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.Deferred{
+		Reason: DeferredReason(in.Reason),
+	}
+}
+
+// DeferredReason returns the tfprotov5 equivalent of the tfprotov6
+// DeferredReason.
+func DeferredReason(in tfprotov6.DeferredReason) tfprotov5.DeferredReason {
+	return tfprotov5.DeferredReason(in)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// Diagnostic returns the tfprotov5 equivalent of the tfprotov6 Diagnostic.
+func Diagnostic(in *tfprotov6.Diagnostic) *tfprotov5.Diagnostic {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.Diagnostic{
+		Attribute: in.Attribute,
+		Detail:    in.Detail,
+		Severity:  DiagnosticSeverity(in.Severity),
+		Summary:   in.Summary,
+	}
+}
+
+// Diagnostics returns the tfprotov5 equivalents of the tfprotov6 diagnostics.
+func Diagnostics(in []*tfprotov6.Diagnostic) []*tfprotov5.Diagnostic {
+	if in == nil {
+		return nil
+	}
+
+	diags := make([]*tfprotov5.Diagnostic, 0, len(in))
+
+	for _, diag := range in {
+		diags = append(diags, Diagnostic(diag))
+	}
+
+	return diags
+}
+
+// DiagnosticSeverity returns the tfprotov5 equivalent of the tfprotov6
+// DiagnosticSeverity.
+func DiagnosticSeverity(in tfprotov6.DiagnosticSeverity) tfprotov5.DiagnosticSeverity {
+	return tfprotov5.DiagnosticSeverity(in)
+}
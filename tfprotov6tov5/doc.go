@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tfprotov6tov5 converts tfprotov6 types into their tfprotov5
+// equivalents, such as schemas, diagnostics, dynamic values, and RPC requests
+// and responses, for translation layers between protocol versions, such as
+// the tf5to6server and tf6to5server packages.
+//
+// Conversions of types which can contain protocol version 6 only constructs,
+// such as nested attributes in schemas, return an error when those constructs
+// are present. Fields without a protocol version 5 equivalent, such as state
+// store schemas, are dropped. Use the tfprotov5tov6 package for the opposite
+// direction. Nil inputs return nil.
+package tfprotov6tov5
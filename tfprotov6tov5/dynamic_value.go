@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// DynamicValue returns the tfprotov5 equivalent of the tfprotov6
+// DynamicValue. The encoded data is shared, not copied.
+func DynamicValue(in *tfprotov6.DynamicValue) *tfprotov5.DynamicValue {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.DynamicValue{
+		JSON:    in.JSON,
+		MsgPack: in.MsgPack,
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6tov5"
+)
+
+func TestDynamicValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.DynamicValue
+		expected *tfprotov5.DynamicValue
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"json": {
+			in:       &tfprotov6.DynamicValue{JSON: []byte(`{"test":true}`)},
+			expected: &tfprotov5.DynamicValue{JSON: []byte(`{"test":true}`)},
+		},
+		"msgpack": {
+			in:       &tfprotov6.DynamicValue{MsgPack: []byte{0x81, 0xa4}},
+			expected: &tfprotov5.DynamicValue{MsgPack: []byte{0x81, 0xa4}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfprotov6tov5.DynamicValue(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ValidateEphemeralResourceConfigRequest returns the tfprotov5 equivalent of
+// the tfprotov6 ValidateEphemeralResourceConfigRequest.
+func ValidateEphemeralResourceConfigRequest(in *tfprotov6.ValidateEphemeralResourceConfigRequest) *tfprotov5.ValidateEphemeralResourceConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ValidateEphemeralResourceConfigRequest{
+		Config:   DynamicValue(in.Config),
+		TypeName: in.TypeName,
+	}
+}
+
+// OpenEphemeralResourceRequest returns the tfprotov5 equivalent of the
+// tfprotov6 OpenEphemeralResourceRequest.
+func OpenEphemeralResourceRequest(in *tfprotov6.OpenEphemeralResourceRequest) *tfprotov5.OpenEphemeralResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.OpenEphemeralResourceRequest{
+		ClientCapabilities: OpenEphemeralResourceClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TypeName:           in.TypeName,
+	}
+}
+
+// RenewEphemeralResourceRequest returns the tfprotov5 equivalent of the
+// tfprotov6 RenewEphemeralResourceRequest.
+func RenewEphemeralResourceRequest(in *tfprotov6.RenewEphemeralResourceRequest) *tfprotov5.RenewEphemeralResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.RenewEphemeralResourceRequest{
+		Private:  in.Private,
+		TypeName: in.TypeName,
+	}
+}
+
+// CloseEphemeralResourceRequest returns the tfprotov5 equivalent of the
+// tfprotov6 CloseEphemeralResourceRequest.
+func CloseEphemeralResourceRequest(in *tfprotov6.CloseEphemeralResourceRequest) *tfprotov5.CloseEphemeralResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.CloseEphemeralResourceRequest{
+		Private:  in.Private,
+		TypeName: in.TypeName,
+	}
+}
+
+// ValidateEphemeralResourceConfigResponse returns the tfprotov5 equivalent of
+// the tfprotov6 ValidateEphemeralResourceConfigResponse.
+func ValidateEphemeralResourceConfigResponse(in *tfprotov6.ValidateEphemeralResourceConfigResponse) *tfprotov5.ValidateEphemeralResourceConfigResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ValidateEphemeralResourceConfigResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// OpenEphemeralResourceResponse returns the tfprotov5 equivalent of the
+// tfprotov6 OpenEphemeralResourceResponse.
+func OpenEphemeralResourceResponse(in *tfprotov6.OpenEphemeralResourceResponse) *tfprotov5.OpenEphemeralResourceResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.OpenEphemeralResourceResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: Diagnostics(in.Diagnostics),
+		Private:     in.Private,
+		RenewAt:     in.RenewAt,
+		Result:      DynamicValue(in.Result),
+	}
+}
+
+// RenewEphemeralResourceResponse returns the tfprotov5 equivalent of the
+// tfprotov6 RenewEphemeralResourceResponse.
+func RenewEphemeralResourceResponse(in *tfprotov6.RenewEphemeralResourceResponse) *tfprotov5.RenewEphemeralResourceResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.RenewEphemeralResourceResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+		Private:     in.Private,
+		RenewAt:     in.RenewAt,
+	}
+}
+
+// CloseEphemeralResourceResponse returns the tfprotov5 equivalent of the
+// tfprotov6 CloseEphemeralResourceResponse.
+func CloseEphemeralResourceResponse(in *tfprotov6.CloseEphemeralResourceResponse) *tfprotov5.CloseEphemeralResourceResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.CloseEphemeralResourceResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// EphemeralResourceMetadata returns the tfprotov5 equivalent of the tfprotov6
+// EphemeralResourceMetadata.
+func EphemeralResourceMetadata(in tfprotov6.EphemeralResourceMetadata) tfprotov5.EphemeralResourceMetadata {
+	return tfprotov5.EphemeralResourceMetadata{
+		TypeName: in.TypeName,
+	}
+}
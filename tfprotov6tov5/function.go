@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// CallFunctionRequest returns the tfprotov5 equivalent of the tfprotov6
+// CallFunctionRequest.
+func CallFunctionRequest(in *tfprotov6.CallFunctionRequest) *tfprotov5.CallFunctionRequest {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.CallFunctionRequest{
+		Name: in.Name,
+	}
+
+	if in.Arguments != nil {
+		resp.Arguments = make([]*tfprotov5.DynamicValue, 0, len(in.Arguments))
+
+		for _, v := range in.Arguments {
+			resp.Arguments = append(resp.Arguments, DynamicValue(v))
+		}
+	}
+
+	return resp
+}
+
+// GetFunctionsRequest returns the tfprotov5 equivalent of the tfprotov6
+// GetFunctionsRequest.
+func GetFunctionsRequest(in *tfprotov6.GetFunctionsRequest) *tfprotov5.GetFunctionsRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.GetFunctionsRequest{}
+}
+
+// CallFunctionResponse returns the tfprotov5 equivalent of the tfprotov6
+// CallFunctionResponse.
+func CallFunctionResponse(in *tfprotov6.CallFunctionResponse) *tfprotov5.CallFunctionResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.CallFunctionResponse{
+		Error:  FunctionError(in.Error),
+		Result: DynamicValue(in.Result),
+	}
+}
+
+// GetFunctionsResponse returns the tfprotov5 equivalent of the tfprotov6
+// GetFunctionsResponse.
+func GetFunctionsResponse(in *tfprotov6.GetFunctionsResponse) *tfprotov5.GetFunctionsResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.GetFunctionsResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+
+	if in.Functions != nil {
+		resp.Functions = make(map[string]*tfprotov5.Function, len(in.Functions))
+
+		for k, v := range in.Functions {
+			resp.Functions[k] = Function(v)
+		}
+	}
+
+	return resp
+}
+
+// FunctionMetadata returns the tfprotov5 equivalent of the tfprotov6
+// FunctionMetadata.
+func FunctionMetadata(in tfprotov6.FunctionMetadata) tfprotov5.FunctionMetadata {
+	return tfprotov5.FunctionMetadata{
+		Name: in.Name,
+	}
+}
+
+// Function returns the tfprotov5 equivalent of the tfprotov6 Function.
+func Function(in *tfprotov6.Function) *tfprotov5.Function {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.Function{
+		DeprecationMessage: in.DeprecationMessage,
+		Description:        in.Description,
+		DescriptionKind:    StringKind(in.DescriptionKind),
+		Return:             FunctionReturn(in.Return),
+		Summary:            in.Summary,
+		VariadicParameter:  FunctionParameter(in.VariadicParameter),
+	}
+
+	if in.Parameters != nil {
+		resp.Parameters = make([]*tfprotov5.FunctionParameter, 0, len(in.Parameters))
+
+		for _, v := range in.Parameters {
+			resp.Parameters = append(resp.Parameters, FunctionParameter(v))
+		}
+	}
+
+	return resp
+}
+
+// FunctionParameter returns the tfprotov5 equivalent of the tfprotov6
+// FunctionParameter.
+func FunctionParameter(in *tfprotov6.FunctionParameter) *tfprotov5.FunctionParameter {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.FunctionParameter{
+		AllowNullValue:     in.AllowNullValue,
+		AllowUnknownValues: in.AllowUnknownValues,
+		Description:        in.Description,
+		DescriptionKind:    StringKind(in.DescriptionKind),
+		Name:               in.Name,
+		Type:               in.Type,
+	}
+}
+
+// FunctionReturn returns the tfprotov5 equivalent of the tfprotov6
+// FunctionReturn.
+func FunctionReturn(in *tfprotov6.FunctionReturn) *tfprotov5.FunctionReturn {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.FunctionReturn{
+		Type: in.Type,
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// PlanAnnotation returns the tfprotov5 equivalent of the tfprotov6
+// PlanAnnotation.
+func PlanAnnotation(in *tfprotov6.PlanAnnotation) *tfprotov5.PlanAnnotation {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.PlanAnnotation{
+		Attribute: in.Attribute,
+		Kind:      PlanAnnotationKind(in.Kind),
+		Reason:    in.Reason,
+	}
+}
+
+// PlanAnnotationKind returns the tfprotov5 equivalent of the tfprotov6
+// PlanAnnotationKind.
+func PlanAnnotationKind(in tfprotov6.PlanAnnotationKind) tfprotov5.PlanAnnotationKind {
+	return tfprotov5.PlanAnnotationKind(in)
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// GetMetadataRequest returns the tfprotov5 equivalent of the tfprotov6
+// GetMetadataRequest.
+func GetMetadataRequest(in *tfprotov6.GetMetadataRequest) *tfprotov5.GetMetadataRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.GetMetadataRequest{}
+}
+
+// GetProviderSchemaRequest returns the tfprotov5 equivalent of the tfprotov6
+// GetProviderSchemaRequest.
+func GetProviderSchemaRequest(in *tfprotov6.GetProviderSchemaRequest) *tfprotov5.GetProviderSchemaRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.GetProviderSchemaRequest{}
+}
+
+// PrepareProviderConfigRequest returns the tfprotov5 equivalent of the
+// tfprotov6 ValidateProviderConfigRequest.
+func PrepareProviderConfigRequest(in *tfprotov6.ValidateProviderConfigRequest) *tfprotov5.PrepareProviderConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.PrepareProviderConfigRequest{
+		Config: DynamicValue(in.Config),
+	}
+}
+
+// ConfigureProviderRequest returns the tfprotov5 equivalent of the tfprotov6
+// ConfigureProviderRequest.
+func ConfigureProviderRequest(in *tfprotov6.ConfigureProviderRequest) *tfprotov5.ConfigureProviderRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ConfigureProviderRequest{
+		ClientCapabilities: ConfigureProviderClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TerraformVersion:   in.TerraformVersion,
+	}
+}
+
+// StopProviderRequest returns the tfprotov5 equivalent of the tfprotov6
+// StopProviderRequest.
+func StopProviderRequest(in *tfprotov6.StopProviderRequest) *tfprotov5.StopProviderRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.StopProviderRequest{}
+}
+
+// GetMetadataResponse returns the tfprotov5 equivalent of the tfprotov6
+// GetMetadataResponse. StateStores are dropped, since state stores are not
+// supported by protocol version 5.
+func GetMetadataResponse(in *tfprotov6.GetMetadataResponse) *tfprotov5.GetMetadataResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.GetMetadataResponse{
+		Diagnostics:        Diagnostics(in.Diagnostics),
+		ServerCapabilities: ServerCapabilities(in.ServerCapabilities),
+	}
+
+	if in.Actions != nil {
+		resp.Actions = make([]tfprotov5.ActionMetadata, 0, len(in.Actions))
+
+		for _, v := range in.Actions {
+			resp.Actions = append(resp.Actions, ActionMetadata(v))
+		}
+	}
+
+	if in.DataSources != nil {
+		resp.DataSources = make([]tfprotov5.DataSourceMetadata, 0, len(in.DataSources))
+
+		for _, v := range in.DataSources {
+			resp.DataSources = append(resp.DataSources, DataSourceMetadata(v))
+		}
+	}
+
+	if in.EphemeralResources != nil {
+		resp.EphemeralResources = make([]tfprotov5.EphemeralResourceMetadata, 0, len(in.EphemeralResources))
+
+		for _, v := range in.EphemeralResources {
+			resp.EphemeralResources = append(resp.EphemeralResources, EphemeralResourceMetadata(v))
+		}
+	}
+
+	if in.Functions != nil {
+		resp.Functions = make([]tfprotov5.FunctionMetadata, 0, len(in.Functions))
+
+		for _, v := range in.Functions {
+			resp.Functions = append(resp.Functions, FunctionMetadata(v))
+		}
+	}
+
+	if in.Resources != nil {
+		resp.Resources = make([]tfprotov5.ResourceMetadata, 0, len(in.Resources))
+
+		for _, v := range in.Resources {
+			resp.Resources = append(resp.Resources, ResourceMetadata(v))
+		}
+	}
+
+	return resp
+}
+
+// GetProviderSchemaResponse returns the tfprotov5 equivalent of the tfprotov6
+// GetProviderSchemaResponse. StateStoreSchemas are dropped, since state stores
+// are not supported by protocol version 5. An error is returned if any schema
+// cannot be converted, see Schema for more information.
+func GetProviderSchemaResponse(in *tfprotov6.GetProviderSchemaResponse) (*tfprotov5.GetProviderSchemaResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var err error
+
+	resp := &tfprotov5.GetProviderSchemaResponse{
+		Diagnostics:        Diagnostics(in.Diagnostics),
+		ServerCapabilities: ServerCapabilities(in.ServerCapabilities),
+	}
+
+	resp.Provider, err = Schema(in.Provider)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert provider schema: %w", err)
+	}
+
+	resp.ProviderMeta, err = Schema(in.ProviderMeta)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert provider meta schema: %w", err)
+	}
+
+	if in.ActionSchemas != nil {
+		resp.ActionSchemas = make(map[string]*tfprotov5.ActionSchema, len(in.ActionSchemas))
+
+		for k, v := range in.ActionSchemas {
+			resp.ActionSchemas[k], err = ActionSchema(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert action %q schema: %w", k, err)
+			}
+		}
+	}
+
+	if in.DataSourceSchemas != nil {
+		resp.DataSourceSchemas = make(map[string]*tfprotov5.Schema, len(in.DataSourceSchemas))
+
+		for k, v := range in.DataSourceSchemas {
+			resp.DataSourceSchemas[k], err = Schema(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert data source %q schema: %w", k, err)
+			}
+		}
+	}
+
+	if in.EphemeralResourceSchemas != nil {
+		resp.EphemeralResourceSchemas = make(map[string]*tfprotov5.Schema, len(in.EphemeralResourceSchemas))
+
+		for k, v := range in.EphemeralResourceSchemas {
+			resp.EphemeralResourceSchemas[k], err = Schema(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert ephemeral resource %q schema: %w", k, err)
+			}
+		}
+	}
+
+	if in.Functions != nil {
+		resp.Functions = make(map[string]*tfprotov5.Function, len(in.Functions))
+
+		for k, v := range in.Functions {
+			resp.Functions[k] = Function(v)
+		}
+	}
+
+	if in.ResourceSchemas != nil {
+		resp.ResourceSchemas = make(map[string]*tfprotov5.Schema, len(in.ResourceSchemas))
+
+		for k, v := range in.ResourceSchemas {
+			resp.ResourceSchemas[k], err = Schema(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert resource %q schema: %w", k, err)
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+// PrepareProviderConfigResponse returns the tfprotov5 equivalent of the
+// tfprotov6 ValidateProviderConfigResponse.
+func PrepareProviderConfigResponse(in *tfprotov6.ValidateProviderConfigResponse) *tfprotov5.PrepareProviderConfigResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.PrepareProviderConfigResponse{
+		Diagnostics:    Diagnostics(in.Diagnostics),
+		PreparedConfig: DynamicValue(in.PreparedConfig),
+	}
+}
+
+// ConfigureProviderResponse returns the tfprotov5 equivalent of the tfprotov6
+// ConfigureProviderResponse.
+func ConfigureProviderResponse(in *tfprotov6.ConfigureProviderResponse) *tfprotov5.ConfigureProviderResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ConfigureProviderResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// StopProviderResponse returns the tfprotov5 equivalent of the tfprotov6
+// StopProviderResponse.
+func StopProviderResponse(in *tfprotov6.StopProviderResponse) *tfprotov5.StopProviderResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.StopProviderResponse{
+		Error: in.Error,
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6tov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGetProviderSchemaResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            *tfprotov6.GetProviderSchemaResponse
+		expected      *tfprotov5.GetProviderSchemaResponse
+		expectedError error
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"schemas": {
+			in: &tfprotov6.GetProviderSchemaResponse{
+				Provider: &tfprotov6.Schema{
+					Block: &tfprotov6.SchemaBlock{},
+				},
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name:     "test",
+									Type:     tftypes.String,
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+				StateStoreSchemas: map[string]*tfprotov6.Schema{
+					"test_store": {
+						Block: &tfprotov6.SchemaBlock{},
+					},
+				},
+			},
+			expected: &tfprotov5.GetProviderSchemaResponse{
+				Provider: &tfprotov5.Schema{
+					Block: &tfprotov5.SchemaBlock{},
+				},
+				ResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_resource": {
+						Block: &tfprotov5.SchemaBlock{
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name:     "test",
+									Type:     tftypes.String,
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"nested-attributes": {
+			in: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{
+					"test_data_source": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name: "test",
+									NestedType: &tfprotov6.SchemaObject{
+										Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+									},
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: tfprotov6tov5.ErrSchemaAttributeNestedTypeNotImplemented,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfprotov6tov5.GetProviderSchemaResponse(testCase.in)
+
+			if !errors.Is(err, testCase.expectedError) {
+				t.Errorf("expected error %v, got: %v", testCase.expectedError, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
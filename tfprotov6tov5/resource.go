@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ValidateResourceTypeConfigRequest returns the tfprotov5 equivalent of the
+// tfprotov6 ValidateResourceConfigRequest.
+func ValidateResourceTypeConfigRequest(in *tfprotov6.ValidateResourceConfigRequest) *tfprotov5.ValidateResourceTypeConfigRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ValidateResourceTypeConfigRequest{
+		ClientCapabilities: ValidateResourceTypeConfigClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		TypeName:           in.TypeName,
+	}
+}
+
+// UpgradeResourceStateRequest returns the tfprotov5 equivalent of the tfprotov6
+// UpgradeResourceStateRequest.
+func UpgradeResourceStateRequest(in *tfprotov6.UpgradeResourceStateRequest) *tfprotov5.UpgradeResourceStateRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.UpgradeResourceStateRequest{
+		RawState: RawState(in.RawState),
+		TypeName: in.TypeName,
+		Version:  in.Version,
+	}
+}
+
+// ReadResourceRequest returns the tfprotov5 equivalent of the tfprotov6
+// ReadResourceRequest.
+func ReadResourceRequest(in *tfprotov6.ReadResourceRequest) *tfprotov5.ReadResourceRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ReadResourceRequest{
+		ClientCapabilities: ReadResourceClientCapabilities(in.ClientCapabilities),
+		CurrentState:       DynamicValue(in.CurrentState),
+		Private:            in.Private,
+		ProviderMeta:       DynamicValue(in.ProviderMeta),
+		TypeName:           in.TypeName,
+	}
+}
+
+// PlanResourceChangeRequest returns the tfprotov5 equivalent of the tfprotov6
+// PlanResourceChangeRequest.
+func PlanResourceChangeRequest(in *tfprotov6.PlanResourceChangeRequest) *tfprotov5.PlanResourceChangeRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.PlanResourceChangeRequest{
+		ClientCapabilities: PlanResourceChangeClientCapabilities(in.ClientCapabilities),
+		Config:             DynamicValue(in.Config),
+		PriorPrivate:       in.PriorPrivate,
+		PriorState:         DynamicValue(in.PriorState),
+		ProposedNewState:   DynamicValue(in.ProposedNewState),
+		ProviderMeta:       DynamicValue(in.ProviderMeta),
+		TypeName:           in.TypeName,
+	}
+}
+
+// ApplyResourceChangeRequest returns the tfprotov5 equivalent of the tfprotov6
+// ApplyResourceChangeRequest.
+func ApplyResourceChangeRequest(in *tfprotov6.ApplyResourceChangeRequest) *tfprotov5.ApplyResourceChangeRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ApplyResourceChangeRequest{
+		Config:         DynamicValue(in.Config),
+		PlannedPrivate: in.PlannedPrivate,
+		PlannedState:   DynamicValue(in.PlannedState),
+		PriorState:     DynamicValue(in.PriorState),
+		ProviderMeta:   DynamicValue(in.ProviderMeta),
+		TypeName:       in.TypeName,
+	}
+}
+
+// ImportResourceStateRequest returns the tfprotov5 equivalent of the tfprotov6
+// ImportResourceStateRequest.
+func ImportResourceStateRequest(in *tfprotov6.ImportResourceStateRequest) *tfprotov5.ImportResourceStateRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ImportResourceStateRequest{
+		ClientCapabilities: ImportResourceStateClientCapabilities(in.ClientCapabilities),
+		ID:                 in.ID,
+		TypeName:           in.TypeName,
+	}
+}
+
+// MoveResourceStateRequest returns the tfprotov5 equivalent of the tfprotov6
+// MoveResourceStateRequest.
+func MoveResourceStateRequest(in *tfprotov6.MoveResourceStateRequest) *tfprotov5.MoveResourceStateRequest {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.MoveResourceStateRequest{
+		SourcePrivate:         in.SourcePrivate,
+		SourceProviderAddress: in.SourceProviderAddress,
+		SourceSchemaVersion:   in.SourceSchemaVersion,
+		SourceState:           RawState(in.SourceState),
+		SourceTypeName:        in.SourceTypeName,
+		TargetTypeName:        in.TargetTypeName,
+	}
+}
+
+// ValidateResourceTypeConfigResponse returns the tfprotov5 equivalent of the
+// tfprotov6 ValidateResourceConfigResponse.
+func ValidateResourceTypeConfigResponse(in *tfprotov6.ValidateResourceConfigResponse) *tfprotov5.ValidateResourceTypeConfigResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ValidateResourceTypeConfigResponse{
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+}
+
+// UpgradeResourceStateResponse returns the tfprotov5 equivalent of the
+// tfprotov6 UpgradeResourceStateResponse.
+func UpgradeResourceStateResponse(in *tfprotov6.UpgradeResourceStateResponse) *tfprotov5.UpgradeResourceStateResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.UpgradeResourceStateResponse{
+		Diagnostics:   Diagnostics(in.Diagnostics),
+		UpgradedState: DynamicValue(in.UpgradedState),
+	}
+}
+
+// ReadResourceResponse returns the tfprotov5 equivalent of the tfprotov6
+// ReadResourceResponse.
+func ReadResourceResponse(in *tfprotov6.ReadResourceResponse) *tfprotov5.ReadResourceResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ReadResourceResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: Diagnostics(in.Diagnostics),
+		NewState:    DynamicValue(in.NewState),
+		Private:     in.Private,
+	}
+}
+
+// PlanResourceChangeResponse returns the tfprotov5 equivalent of the tfprotov6
+// PlanResourceChangeResponse.
+func PlanResourceChangeResponse(in *tfprotov6.PlanResourceChangeResponse) *tfprotov5.PlanResourceChangeResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.PlanResourceChangeResponse{
+		Deferred:                    Deferred(in.Deferred),
+		Diagnostics:                 Diagnostics(in.Diagnostics),
+		PlannedPrivate:              in.PlannedPrivate,
+		PlannedState:                DynamicValue(in.PlannedState),
+		RequiresReplace:             in.RequiresReplace,
+		UnsafeToUseLegacyTypeSystem: in.UnsafeToUseLegacyTypeSystem,
+	}
+
+	if in.Annotations != nil {
+		resp.Annotations = make([]*tfprotov5.PlanAnnotation, 0, len(in.Annotations))
+
+		for _, v := range in.Annotations {
+			resp.Annotations = append(resp.Annotations, PlanAnnotation(v))
+		}
+	}
+
+	return resp
+}
+
+// ApplyResourceChangeResponse returns the tfprotov5 equivalent of the tfprotov6
+// ApplyResourceChangeResponse.
+func ApplyResourceChangeResponse(in *tfprotov6.ApplyResourceChangeResponse) *tfprotov5.ApplyResourceChangeResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ApplyResourceChangeResponse{
+		Diagnostics:                 Diagnostics(in.Diagnostics),
+		NewState:                    DynamicValue(in.NewState),
+		Private:                     in.Private,
+		UnsafeToUseLegacyTypeSystem: in.UnsafeToUseLegacyTypeSystem,
+	}
+}
+
+// ImportResourceStateResponse returns the tfprotov5 equivalent of the tfprotov6
+// ImportResourceStateResponse.
+func ImportResourceStateResponse(in *tfprotov6.ImportResourceStateResponse) *tfprotov5.ImportResourceStateResponse {
+	if in == nil {
+		return nil
+	}
+
+	resp := &tfprotov5.ImportResourceStateResponse{
+		Deferred:    Deferred(in.Deferred),
+		Diagnostics: Diagnostics(in.Diagnostics),
+	}
+
+	if in.ImportedResources != nil {
+		resp.ImportedResources = make([]*tfprotov5.ImportedResource, 0, len(in.ImportedResources))
+
+		for _, v := range in.ImportedResources {
+			resp.ImportedResources = append(resp.ImportedResources, ImportedResource(v))
+		}
+	}
+
+	return resp
+}
+
+// MoveResourceStateResponse returns the tfprotov5 equivalent of the tfprotov6
+// MoveResourceStateResponse.
+func MoveResourceStateResponse(in *tfprotov6.MoveResourceStateResponse) *tfprotov5.MoveResourceStateResponse {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.MoveResourceStateResponse{
+		Diagnostics:   Diagnostics(in.Diagnostics),
+		TargetPrivate: in.TargetPrivate,
+		TargetState:   DynamicValue(in.TargetState),
+	}
+}
+
+// ResourceMetadata returns the tfprotov5 equivalent of the tfprotov6
+// ResourceMetadata.
+func ResourceMetadata(in tfprotov6.ResourceMetadata) tfprotov5.ResourceMetadata {
+	return tfprotov5.ResourceMetadata{
+		TypeName: in.TypeName,
+	}
+}
+
+// ImportedResource returns the tfprotov5 equivalent of the tfprotov6
+// ImportedResource.
+func ImportedResource(in *tfprotov6.ImportedResource) *tfprotov5.ImportedResource {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.ImportedResource{
+		Private:  in.Private,
+		State:    DynamicValue(in.State),
+		TypeName: in.TypeName,
+	}
+}
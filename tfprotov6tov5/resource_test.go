@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6tov5"
+)
+
+func TestReadResourceRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.ReadResourceRequest
+		expected *tfprotov5.ReadResourceRequest
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"all-fields": {
+			in: &tfprotov6.ReadResourceRequest{
+				ClientCapabilities: &tfprotov6.ReadResourceClientCapabilities{
+					DeferralAllowed: true,
+				},
+				CurrentState: &tfprotov6.DynamicValue{JSON: []byte(`{"test":true}`)},
+				Private:      []byte(`{}`),
+				ProviderMeta: &tfprotov6.DynamicValue{JSON: []byte(`{}`)},
+				TypeName:     "test_resource",
+			},
+			expected: &tfprotov5.ReadResourceRequest{
+				ClientCapabilities: &tfprotov5.ReadResourceClientCapabilities{
+					DeferralAllowed: true,
+				},
+				CurrentState: &tfprotov5.DynamicValue{JSON: []byte(`{"test":true}`)},
+				Private:      []byte(`{}`),
+				ProviderMeta: &tfprotov5.DynamicValue{JSON: []byte(`{}`)},
+				TypeName:     "test_resource",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfprotov6tov5.ReadResourceRequest(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMoveResourceStateRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.MoveResourceStateRequest
+		expected *tfprotov5.MoveResourceStateRequest
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"all-fields": {
+			in: &tfprotov6.MoveResourceStateRequest{
+				SourcePrivate:         []byte(`{}`),
+				SourceProviderAddress: "registry.terraform.io/example/example",
+				SourceSchemaVersion:   1,
+				SourceState: &tfprotov6.RawState{
+					JSON: []byte(`{"test":true}`),
+				},
+				SourceTypeName: "example_resource",
+				TargetTypeName: "test_resource",
+			},
+			expected: &tfprotov5.MoveResourceStateRequest{
+				SourcePrivate:         []byte(`{}`),
+				SourceProviderAddress: "registry.terraform.io/example/example",
+				SourceSchemaVersion:   1,
+				SourceState: &tfprotov5.RawState{
+					JSON: []byte(`{"test":true}`),
+				},
+				SourceTypeName: "example_resource",
+				TargetTypeName: "test_resource",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfprotov6tov5.MoveResourceStateRequest(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ErrSchemaAttributeNestedTypeNotImplemented is returned when converting a
+// schema containing an attribute with a NestedType, which is not supported
+// by protocol version 5.
+var ErrSchemaAttributeNestedTypeNotImplemented = errors.New("SchemaAttribute NestedType is not supported in protocol version 5")
+
+// Schema returns the tfprotov5 equivalent of the tfprotov6 Schema. The error
+// wraps ErrSchemaAttributeNestedTypeNotImplemented and is a
+// tftypes.AttributePathError for the first attribute with a NestedType, if
+// any. The path only contains AttributeName steps.
+func Schema(in *tfprotov6.Schema) (*tfprotov5.Schema, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	block, err := SchemaBlock(in.Block)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &tfprotov5.Schema{
+		Block:   block,
+		Version: in.Version,
+	}
+
+	return schema, nil
+}
+
+// SchemaBlock returns the tfprotov5 equivalent of the tfprotov6 SchemaBlock.
+// See Schema for more information about the error.
+func SchemaBlock(in *tfprotov6.SchemaBlock) (*tfprotov5.SchemaBlock, error) {
+	return schemaBlock(tftypes.NewAttributePath(), in)
+}
+
+// SchemaAttribute returns the tfprotov5 equivalent of the tfprotov6
+// SchemaAttribute. See Schema for more information about the error.
+func SchemaAttribute(in *tfprotov6.SchemaAttribute) (*tfprotov5.SchemaAttribute, error) {
+	return schemaAttribute(tftypes.NewAttributePath(), in)
+}
+
+// SchemaNestedBlock returns the tfprotov5 equivalent of the tfprotov6
+// SchemaNestedBlock. See Schema for more information about the error.
+func SchemaNestedBlock(in *tfprotov6.SchemaNestedBlock) (*tfprotov5.SchemaNestedBlock, error) {
+	return schemaNestedBlock(tftypes.NewAttributePath(), in)
+}
+
+// SchemaNestedBlockNestingMode returns the tfprotov5 equivalent of the
+// tfprotov6 SchemaNestedBlockNestingMode.
+func SchemaNestedBlockNestingMode(in tfprotov6.SchemaNestedBlockNestingMode) tfprotov5.SchemaNestedBlockNestingMode {
+	return tfprotov5.SchemaNestedBlockNestingMode(in)
+}
+
+// StringKind returns the tfprotov5 equivalent of the tfprotov6 StringKind.
+func StringKind(in tfprotov6.StringKind) tfprotov5.StringKind {
+	return tfprotov5.StringKind(in)
+}
+
+func schemaBlock(path *tftypes.AttributePath, in *tfprotov6.SchemaBlock) (*tfprotov5.SchemaBlock, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	block := &tfprotov5.SchemaBlock{
+		Deprecated:      in.Deprecated,
+		Description:     in.Description,
+		DescriptionKind: StringKind(in.DescriptionKind),
+		Version:         in.Version,
+	}
+
+	for _, attribute := range in.Attributes {
+		converted, err := schemaAttribute(path, attribute)
+
+		if err != nil {
+			return nil, err
+		}
+
+		block.Attributes = append(block.Attributes, converted)
+	}
+
+	for _, blockType := range in.BlockTypes {
+		converted, err := schemaNestedBlock(path, blockType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		block.BlockTypes = append(block.BlockTypes, converted)
+	}
+
+	return block, nil
+}
+
+func schemaAttribute(path *tftypes.AttributePath, in *tfprotov6.SchemaAttribute) (*tfprotov5.SchemaAttribute, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	if in.NestedType != nil {
+		return nil, path.WithAttributeName(in.Name).NewError(ErrSchemaAttributeNestedTypeNotImplemented)
+	}
+
+	attribute := &tfprotov5.SchemaAttribute{
+		Computed:        in.Computed,
+		Deprecated:      in.Deprecated,
+		Description:     in.Description,
+		DescriptionKind: StringKind(in.DescriptionKind),
+		Name:            in.Name,
+		Optional:        in.Optional,
+		Required:        in.Required,
+		Sensitive:       in.Sensitive,
+		Type:            in.Type,
+	}
+
+	return attribute, nil
+}
+
+func schemaNestedBlock(path *tftypes.AttributePath, in *tfprotov6.SchemaNestedBlock) (*tfprotov5.SchemaNestedBlock, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	block, err := schemaBlock(path.WithAttributeName(in.TypeName), in.Block)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nestedBlock := &tfprotov5.SchemaNestedBlock{
+		Block:    block,
+		MaxItems: in.MaxItems,
+		MinItems: in.MinItems,
+		Nesting:  SchemaNestedBlockNestingMode(in.Nesting),
+		TypeName: in.TypeName,
+	}
+
+	return nestedBlock, nil
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6tov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            *tfprotov6.Schema
+		expected      *tfprotov5.Schema
+		expectedError string
+	}{
+		"nil": {
+			in:       nil,
+			expected: nil,
+		},
+		"all-fields": {
+			in: &tfprotov6.Schema{
+				Version: 1,
+				Block: &tfprotov6.SchemaBlock{
+					Version:         2,
+					Description:     "test block",
+					DescriptionKind: tfprotov6.StringKindMarkdown,
+					Deprecated:      true,
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:            "test_attribute",
+							Type:            tftypes.List{ElementType: tftypes.String},
+							Description:     "test attribute",
+							DescriptionKind: tfprotov6.StringKindMarkdown,
+							Required:        true,
+							Sensitive:       true,
+							Deprecated:      true,
+						},
+						nil,
+					},
+					BlockTypes: []*tfprotov6.SchemaNestedBlock{
+						{
+							TypeName: "test_block",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+							MinItems: 1,
+							MaxItems: 2,
+							Block: &tfprotov6.SchemaBlock{
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{
+										Name:     "nested_attribute",
+										Type:     tftypes.Number,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.Schema{
+				Version: 1,
+				Block: &tfprotov5.SchemaBlock{
+					Version:         2,
+					Description:     "test block",
+					DescriptionKind: tfprotov5.StringKindMarkdown,
+					Deprecated:      true,
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:            "test_attribute",
+							Type:            tftypes.List{ElementType: tftypes.String},
+							Description:     "test attribute",
+							DescriptionKind: tfprotov5.StringKindMarkdown,
+							Required:        true,
+							Sensitive:       true,
+							Deprecated:      true,
+						},
+						nil,
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							TypeName: "test_block",
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+							MinItems: 1,
+							MaxItems: 2,
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:     "nested_attribute",
+										Type:     tftypes.Number,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"nested-attribute": {
+			in: &tfprotov6.Schema{
+				Block: &tfprotov6.SchemaBlock{
+					BlockTypes: []*tfprotov6.SchemaNestedBlock{
+						{
+							TypeName: "test_block",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+							Block: &tfprotov6.SchemaBlock{
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{
+										Name:     "nested_attribute",
+										Optional: true,
+										NestedType: &tfprotov6.SchemaObject{
+											Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: `AttributeName("test_block").AttributeName("nested_attribute"): SchemaAttribute NestedType is not supported in protocol version 5`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfprotov6tov5.Schema(testCase.in)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !errors.Is(err, tfprotov6tov5.ErrSchemaAttributeNestedTypeNotImplemented) {
+					t.Errorf("expected ErrSchemaAttributeNestedTypeNotImplemented, got: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Errorf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfprotov6tov5
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// RawState returns the tfprotov5 equivalent of the tfprotov6 RawState.
+func RawState(in *tfprotov6.RawState) *tfprotov5.RawState {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.RawState{
+		Flatmap: in.Flatmap,
+		JSON:    in.JSON,
+	}
+}
@@ -56,6 +56,12 @@ func (a *AttributePath) Steps() []AttributePathStep {
 	return steps
 }
 
+// String returns a human-readable representation of the AttributePath for
+// logging and error messages, such as
+// `AttributeName("tags").ElementKeyString("Name")`. Attribute names and
+// element keys are quoted and escaped, so the output is unambiguous. Use
+// FormatAttributePath and ParseAttributePath to store a path as a string and
+// reconstruct it later.
 func (a *AttributePath) String() string {
 	var res strings.Builder
 	for pos, step := range a.Steps() {
@@ -64,9 +70,9 @@ func (a *AttributePath) String() string {
 		}
 		switch v := step.(type) {
 		case AttributeName:
-			res.WriteString(`AttributeName("` + string(v) + `")`)
+			res.WriteString(`AttributeName(` + strconv.Quote(string(v)) + `)`)
 		case ElementKeyString:
-			res.WriteString(`ElementKeyString("` + string(v) + `")`)
+			res.WriteString(`ElementKeyString(` + strconv.Quote(string(v)) + `)`)
 		case ElementKeyInt:
 			res.WriteString(`ElementKeyInt(` + strconv.FormatInt(int64(v), 10) + `)`)
 		case ElementKeyValue:
@@ -4,7 +4,9 @@
 package tftypes
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 )
 
 // AttributePathError represents an error associated with part of a
@@ -44,3 +46,108 @@ func (a AttributePathError) Error() string {
 func (a AttributePathError) Unwrap() error {
 	return a.err
 }
+
+// Is returns true if target is an AttributePathError, or a pointer to one,
+// with an equal Path and either no error or an error that errors.Is matches
+// with the error of the AttributePathError. This allows errors.Is to check for
+// an error at a specific path:
+//
+//	errors.Is(err, path.NewError(ErrSomething))
+//
+// or for any error at a specific path:
+//
+//	errors.Is(err, AttributePathError{Path: path})
+func (a AttributePathError) Is(target error) bool {
+	var other AttributePathError
+
+	switch target := target.(type) {
+	case AttributePathError:
+		other = target
+	case *AttributePathError:
+		if target == nil {
+			return false
+		}
+		other = *target
+	default:
+		return false
+	}
+
+	if !a.Path.Equal(other.Path) {
+		return false
+	}
+
+	return other.err == nil || errors.Is(a.err, other.err)
+}
+
+// As allows errors.As to find the AttributePathError with a
+// *AttributePathError target, in addition to an AttributePathError target.
+func (a AttributePathError) As(target interface{}) bool {
+	t, ok := target.(**AttributePathError)
+	if !ok {
+		return false
+	}
+
+	ape := a
+	*t = &ape
+
+	return true
+}
+
+// AttributePathErrors is an error collecting several AttributePathErrors,
+// such as every invalid location of a Value, so they can be returned
+// together. errors.Is and errors.As check each of the AttributePathErrors.
+type AttributePathErrors []AttributePathError
+
+// Append adds the errors to the collection, ignoring nil errors.
+// AttributePathErrors are added as is, and errors wrapping several errors,
+// such as AttributePathErrors and those returned by errors.Join, have each
+// of their errors added individually. Other errors are added with an empty
+// path.
+func (e *AttributePathErrors) Append(errs ...error) {
+	for _, err := range errs {
+		switch err := err.(type) {
+		case nil:
+			continue
+		case AttributePathError:
+			*e = append(*e, err)
+		case *AttributePathError:
+			if err != nil {
+				*e = append(*e, *err)
+			}
+		case interface{ Unwrap() []error }:
+			e.Append(err.Unwrap()...)
+		default:
+			*e = append(*e, AttributePathError{
+				Path: NewAttributePath(),
+				err:  err,
+			})
+		}
+	}
+}
+
+// Error returns the messages of the AttributePathErrors, one per line.
+func (e AttributePathErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the AttributePathErrors as a slice of errors, for errors.Is
+// and errors.As.
+func (e AttributePathErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// Err returns the collection as an error, or nil if it is empty.
+func (e AttributePathErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
@@ -5,6 +5,7 @@ package tftypes
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -99,3 +100,140 @@ func TestAttributePathErrorEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestAttributePathErrorIs(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("test error")
+	path := NewAttributePath().WithAttributeName("test")
+
+	testCases := map[string]struct {
+		err      error
+		target   error
+		expected bool
+	}{
+		"wrapped-error": {
+			err:      path.NewError(errTest),
+			target:   errTest,
+			expected: true,
+		},
+		"same-path-and-error": {
+			err:      path.NewError(errTest),
+			target:   NewAttributePath().WithAttributeName("test").NewError(errTest),
+			expected: true,
+		},
+		"same-path-any-error": {
+			err:      path.NewError(errTest),
+			target:   AttributePathError{Path: NewAttributePath().WithAttributeName("test")},
+			expected: true,
+		},
+		"same-path-pointer": {
+			err:      path.NewError(errTest),
+			target:   &AttributePathError{Path: NewAttributePath().WithAttributeName("test")},
+			expected: true,
+		},
+		"different-path": {
+			err:      path.NewError(errTest),
+			target:   NewAttributePath().WithAttributeName("other").NewError(errTest),
+			expected: false,
+		},
+		"different-error": {
+			err:      path.NewError(errTest),
+			target:   path.NewError(errors.New("test error")),
+			expected: false,
+		},
+		"within-fmt-wrap": {
+			err:      fmt.Errorf("context: %w", path.NewError(errTest)),
+			target:   AttributePathError{Path: path},
+			expected: true,
+		},
+		"within-collection": {
+			err: AttributePathErrors{
+				{Path: NewAttributePath().WithAttributeName("other"), err: errors.New("other error")},
+				{Path: path, err: errTest},
+			}.Err(),
+			target:   AttributePathError{Path: path},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := errors.Is(testCase.err, testCase.target)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("Unexpected results (-wanted +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributePathErrorAs(t *testing.T) {
+	t.Parallel()
+
+	path := NewAttributePath().WithAttributeName("test")
+	err := fmt.Errorf("context: %w", path.NewErrorf("test error"))
+
+	var ape AttributePathError
+	if !errors.As(err, &ape) {
+		t.Fatal("expected errors.As to find the AttributePathError")
+	}
+	if !ape.Path.Equal(path) {
+		t.Errorf("expected path %s, got %s", path, ape.Path)
+	}
+
+	var apePtr *AttributePathError
+	if !errors.As(err, &apePtr) {
+		t.Fatal("expected errors.As to find the *AttributePathError")
+	}
+	if !apePtr.Path.Equal(path) {
+		t.Errorf("expected path %s, got %s", path, apePtr.Path)
+	}
+}
+
+func TestAttributePathErrors(t *testing.T) {
+	t.Parallel()
+
+	var errs AttributePathErrors
+
+	if errs.Err() != nil {
+		t.Fatalf("expected no error for an empty collection, got: %s", errs.Err())
+	}
+
+	errs.Append(
+		nil,
+		NewAttributePath().WithAttributeName("a").NewErrorf("first"),
+		errors.Join(
+			NewAttributePath().WithAttributeName("b").NewErrorf("second"),
+			errors.New("third"),
+		),
+		AttributePathErrors{
+			{Path: NewAttributePath().WithElementKeyInt(1), err: errors.New("fourth")},
+		},
+	)
+
+	expected := AttributePathErrors{
+		{Path: NewAttributePath().WithAttributeName("a"), err: errors.New("first")},
+		{Path: NewAttributePath().WithAttributeName("b"), err: errors.New("second")},
+		{Path: NewAttributePath(), err: errors.New("third")},
+		{Path: NewAttributePath().WithElementKeyInt(1), err: errors.New("fourth")},
+	}
+
+	if diff := cmp.Diff(expected, errs); diff != "" {
+		t.Errorf("Unexpected results (-wanted +got): %s", diff)
+	}
+
+	expectedMsg := "AttributeName(\"a\"): first\nAttributeName(\"b\"): second\nthird\nElementKeyInt(1): fourth"
+
+	if diff := cmp.Diff(expectedMsg, errs.Err().Error()); diff != "" {
+		t.Errorf("Unexpected error message (-wanted +got): %s", diff)
+	}
+
+	var ape AttributePathError
+	if !errors.As(errs.Err(), &ape) || !ape.Path.Equal(NewAttributePath().WithAttributeName("a")) {
+		t.Errorf("expected errors.As to find the first AttributePathError, got %v", ape)
+	}
+}
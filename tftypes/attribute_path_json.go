@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// attributePathStepJSON is the JSON representation of an AttributePathStep,
+// matching the AttributePath.Step message of the Terraform plugin protocol,
+// where exactly one field is set.
+type attributePathStepJSON struct {
+	AttributeName    *string `json:"attribute_name,omitempty"`
+	ElementKeyString *string `json:"element_key_string,omitempty"`
+	ElementKeyInt    *int64  `json:"element_key_int,omitempty"`
+}
+
+// MarshalJSON returns the JSON representation of the AttributePath, which is
+// an array of steps matching the AttributePath.Step message of the Terraform
+// plugin protocol, such as
+// `[{"attribute_name":"tags"},{"element_key_string":"Name"}]`. An error is
+// returned if the AttributePath contains an ElementKeyValue step, which
+// cannot be represented.
+func (a *AttributePath) MarshalJSON() ([]byte, error) {
+	steps := make([]attributePathStepJSON, 0, len(a.Steps()))
+
+	for pos, step := range a.Steps() {
+		switch step := step.(type) {
+		case AttributeName:
+			name := string(step)
+			steps = append(steps, attributePathStepJSON{AttributeName: &name})
+		case ElementKeyString:
+			key := string(step)
+			steps = append(steps, attributePathStepJSON{ElementKeyString: &key})
+		case ElementKeyInt:
+			key := int64(step)
+			steps = append(steps, attributePathStepJSON{ElementKeyInt: &key})
+		default:
+			return nil, NewAttributePathWithSteps(a.Steps()[:pos+1]).NewErrorf("%T steps cannot be encoded as JSON", step)
+		}
+	}
+
+	return json.Marshal(steps)
+}
+
+// UnmarshalJSON sets the AttributePath to the steps in the JSON
+// representation returned by MarshalJSON.
+//
+// For interoperability with the paths in Terraform's JSON plan output, such
+// as `replace_paths`, steps may also be plain strings and integers, such as
+// `["tags","Name"]`. As those paths do not distinguish attribute names from
+// map keys without the schema, plain strings are always AttributeName steps
+// and plain integers are ElementKeyInt steps.
+func (a *AttributePath) UnmarshalJSON(data []byte) error {
+	var rawSteps []json.RawMessage
+
+	if err := json.Unmarshal(data, &rawSteps); err != nil {
+		return fmt.Errorf("error decoding attribute path: %w", err)
+	}
+
+	steps := make([]AttributePathStep, 0, len(rawSteps))
+
+	for pos, rawStep := range rawSteps {
+		step, err := attributePathStepFromJSON(rawStep)
+
+		if err != nil {
+			return fmt.Errorf("error decoding attribute path step %d: %w", pos, err)
+		}
+
+		steps = append(steps, step)
+	}
+
+	a.steps = steps
+
+	return nil
+}
+
+// attributePathStepFromJSON returns the AttributePathStep for a single JSON
+// step, as described by AttributePath.UnmarshalJSON.
+func attributePathStepFromJSON(data json.RawMessage) (AttributePathStep, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var raw interface{}
+
+	if err := dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	switch raw := raw.(type) {
+	case string:
+		return AttributeName(raw), nil
+	case json.Number:
+		key, err := raw.Int64()
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid element key %s: %w", raw, err)
+		}
+
+		return ElementKeyInt(key), nil
+	case map[string]interface{}:
+		var step attributePathStepJSON
+
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+
+		if err := dec.Decode(&step); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case len(raw) != 1:
+			return nil, errors.New("expected exactly one of attribute_name, element_key_string, or element_key_int")
+		case step.AttributeName != nil:
+			return AttributeName(*step.AttributeName), nil
+		case step.ElementKeyString != nil:
+			return ElementKeyString(*step.ElementKeyString), nil
+		case step.ElementKeyInt != nil:
+			return ElementKeyInt(*step.ElementKeyInt), nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected step %s", data)
+}
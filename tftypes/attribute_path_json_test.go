@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAttributePathMarshalJSON(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		path          *AttributePath
+		expected      string
+		expectedError string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			path:     NewAttributePath(),
+			expected: `[]`,
+		},
+		"attribute-name": {
+			path:     NewAttributePath().WithAttributeName("testing"),
+			expected: `[{"attribute_name":"testing"}]`,
+		},
+		"element-key-string": {
+			path:     NewAttributePath().WithElementKeyString("testing"),
+			expected: `[{"element_key_string":"testing"}]`,
+		},
+		"element-key-int": {
+			path:     NewAttributePath().WithElementKeyInt(0),
+			expected: `[{"element_key_int":0}]`,
+		},
+		"long": {
+			path:     NewAttributePath().WithAttributeName("testing").WithElementKeyString("key").WithElementKeyInt(20).WithAttributeName("testing2"),
+			expected: `[{"attribute_name":"testing"},{"element_key_string":"key"},{"element_key_int":20},{"attribute_name":"testing2"}]`,
+		},
+		"element-key-value": {
+			path:          NewAttributePath().WithAttributeName("testing").WithElementKeyValue(NewValue(String, "testing")),
+			expectedError: `AttributeName("testing").ElementKeyValue(tftypes.String<"testing">): tftypes.ElementKeyValue steps cannot be encoded as JSON`,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.path.MarshalJSON()
+
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+					t.Fatalf("Unexpected error (-wanted, +got): %s", diff)
+				}
+
+				return
+			}
+
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+
+			if diff := cmp.Diff(test.expected, string(got)); diff != "" {
+				t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributePathUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		json          string
+		expected      *AttributePath
+		expectedError string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			json:     `[]`,
+			expected: NewAttributePath(),
+		},
+		"attribute-name": {
+			json:     `[{"attribute_name":"testing"}]`,
+			expected: NewAttributePath().WithAttributeName("testing"),
+		},
+		"element-key-string": {
+			json:     `[{"element_key_string":"testing"}]`,
+			expected: NewAttributePath().WithElementKeyString("testing"),
+		},
+		"element-key-int": {
+			json:     `[{"element_key_int":0}]`,
+			expected: NewAttributePath().WithElementKeyInt(0),
+		},
+		"long": {
+			json:     `[{"attribute_name":"testing"},{"element_key_string":"key"},{"element_key_int":20},{"attribute_name":"testing2"}]`,
+			expected: NewAttributePath().WithAttributeName("testing").WithElementKeyString("key").WithElementKeyInt(20).WithAttributeName("testing2"),
+		},
+		"terraform-plan-steps": {
+			json:     `["testing",20,"testing2"]`,
+			expected: NewAttributePath().WithAttributeName("testing").WithElementKeyInt(20).WithAttributeName("testing2"),
+		},
+		"invalid-json": {
+			json:          `[{"attribute_name":"testing"}`,
+			expectedError: "error decoding attribute path: unexpected end of JSON input",
+		},
+		"multiple-fields": {
+			json:          `[{"attribute_name":"testing","element_key_int":1}]`,
+			expectedError: "error decoding attribute path step 0: expected exactly one of attribute_name, element_key_string, or element_key_int",
+		},
+		"unknown-field": {
+			json:          `[{"attribute_name":"testing"},{"element_key_value":"testing"}]`,
+			expectedError: `error decoding attribute path step 1: json: unknown field "element_key_value"`,
+		},
+		"fractional-element-key": {
+			json:          `[1.5]`,
+			expectedError: `error decoding attribute path step 0: invalid element key 1.5: strconv.ParseInt: parsing "1.5": invalid syntax`,
+		},
+		"boolean": {
+			json:          `[true]`,
+			expectedError: "error decoding attribute path step 0: unexpected step true",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewAttributePath()
+			err := got.UnmarshalJSON([]byte(test.json))
+
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+					t.Fatalf("Unexpected error (-wanted, +got): %s", diff)
+				}
+
+				return
+			}
+
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+
+			if !test.expected.Equal(got) {
+				t.Errorf("Expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ParseAttributePath returns the AttributePath described by the given string
+// in Terraform configuration reference notation, such as
+// `network_interfaces[0].ipv6_addresses` or `tags["Name"]`. This allows
+// paths from external systems, such as validation tools, to be used in
+// diagnostics.
+//
+// Attribute names are separated by periods. Element keys are enclosed in
+// square brackets, where integer keys become ElementKeyInt steps and
+// double-quoted, Go-escaped keys become ElementKeyString steps. An empty
+// string returns an empty AttributePath. ElementKeyValue steps cannot be
+// described.
+//
+// The output of the AttributePath String method, such as
+// `AttributeName("tags").ElementKeyString("Name")`, is also accepted, except
+// for ElementKeyValue steps, so paths can be reconstructed from logs and
+// error messages. Parentheses cannot be part of attribute names in reference
+// notation, so the two notations are never ambiguous.
+func ParseAttributePath(s string) (*AttributePath, error) {
+	if strings.HasPrefix(s, attributePathStepAttributeName+"(") ||
+		strings.HasPrefix(s, attributePathStepElementKeyString+"(") ||
+		strings.HasPrefix(s, attributePathStepElementKeyInt+"(") ||
+		strings.HasPrefix(s, attributePathStepElementKeyValue+"(") {
+		return parseAttributePathSteps(s)
+	}
+
+	path := NewAttributePath()
+	pos := 0
+
+	for pos < len(s) {
+		switch {
+		case s[pos] == '[':
+			end := attributePathElementKeyEnd(s, pos)
+
+			if end == -1 {
+				return nil, fmt.Errorf("invalid attribute path %q: unterminated element key at position %d", s, pos)
+			}
+
+			key := s[pos+1 : end]
+
+			switch {
+			case strings.HasPrefix(key, `"`):
+				unquoted, err := strconv.Unquote(key)
+
+				if err != nil {
+					return nil, fmt.Errorf("invalid attribute path %q: invalid string element key at position %d: %w", s, pos, err)
+				}
+
+				path = path.WithElementKeyString(unquoted)
+			default:
+				index, err := strconv.Atoi(key)
+
+				// Only canonical integers are accepted, such as 1 rather
+				// than +1 or 01, so formatting the path returns the input.
+				if err != nil || !attributePathIndexRegexp.MatchString(key) {
+					return nil, fmt.Errorf("invalid attribute path %q: element key at position %d must be a non-negative integer or quoted string", s, pos)
+				}
+
+				path = path.WithElementKeyInt(index)
+			}
+
+			pos = end + 1
+		case s[pos] == '.' && pos > 0:
+			pos++
+
+			fallthrough
+		default:
+			if pos > 0 && s[pos-1] != '.' {
+				return nil, fmt.Errorf("invalid attribute path %q: expected \".\" or \"[\" at position %d", s, pos)
+			}
+
+			end := pos
+
+			for end < len(s) && !strings.ContainsRune(attributePathReservedCharacters, rune(s[end])) {
+				end++
+			}
+
+			if end == pos {
+				return nil, fmt.Errorf("invalid attribute path %q: expected attribute name at position %d", s, pos)
+			}
+
+			if end < len(s) && s[end] != '.' && s[end] != '[' {
+				return nil, fmt.Errorf("invalid attribute path %q: unexpected %q at position %d", s, s[end], end)
+			}
+
+			path = path.WithAttributeName(s[pos:end])
+			pos = end
+		}
+	}
+
+	return path, nil
+}
+
+// parseAttributePathSteps returns the AttributePath described by the output of
+// the AttributePath String method.
+func parseAttributePathSteps(s string) (*AttributePath, error) {
+	path := NewAttributePath()
+	pos := 0
+
+	for {
+		open := strings.IndexByte(s[pos:], '(')
+
+		if open == -1 {
+			return nil, fmt.Errorf("invalid attribute path %q: expected step at position %d", s, pos)
+		}
+
+		stepType := s[pos : pos+open]
+		argPos := pos + open + 1
+
+		var argEnd int
+
+		switch stepType {
+		case attributePathStepAttributeName, attributePathStepElementKeyString:
+			quoted, err := strconv.QuotedPrefix(s[argPos:])
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid attribute path %q: invalid quoted string at position %d: %w", s, argPos, err)
+			}
+
+			unquoted, err := strconv.Unquote(quoted)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid attribute path %q: invalid quoted string at position %d: %w", s, argPos, err)
+			}
+
+			if stepType == attributePathStepAttributeName {
+				path = path.WithAttributeName(unquoted)
+			} else {
+				path = path.WithElementKeyString(unquoted)
+			}
+
+			argEnd = argPos + len(quoted)
+		case attributePathStepElementKeyInt:
+			argEnd = strings.IndexByte(s[argPos:], ')')
+
+			if argEnd == -1 {
+				return nil, fmt.Errorf("invalid attribute path %q: unterminated step at position %d", s, pos)
+			}
+
+			argEnd += argPos
+			key := s[argPos:argEnd]
+			index, err := strconv.Atoi(key)
+
+			// Only canonical integers are accepted, as with reference
+			// notation.
+			if err != nil || strconv.Itoa(index) != key {
+				return nil, fmt.Errorf("invalid attribute path %q: element key at position %d must be an integer", s, argPos)
+			}
+
+			path = path.WithElementKeyInt(index)
+		case attributePathStepElementKeyValue:
+			return nil, fmt.Errorf("invalid attribute path %q: %s steps cannot be parsed", s, stepType)
+		default:
+			return nil, fmt.Errorf("invalid attribute path %q: unknown step %q at position %d", s, stepType, pos)
+		}
+
+		if argEnd >= len(s) || s[argEnd] != ')' {
+			return nil, fmt.Errorf("invalid attribute path %q: expected \")\" at position %d", s, argEnd)
+		}
+
+		pos = argEnd + 1
+
+		if pos == len(s) {
+			return path, nil
+		}
+
+		if s[pos] != '.' {
+			return nil, fmt.Errorf("invalid attribute path %q: expected \".\" at position %d", s, pos)
+		}
+
+		pos++
+	}
+}
+
+// FormatAttributePath returns the given AttributePath in the Terraform
+// configuration reference notation accepted by ParseAttributePath, such as
+// `network_interfaces[0].ipv6_addresses`. An error is returned if the path
+// contains an ElementKeyValue step, a negative ElementKeyInt step, or an
+// attribute name which cannot be described by the notation, such as a name
+// containing a period. The error is an AttributePathError for the step.
+func FormatAttributePath(path *AttributePath) (string, error) {
+	var result strings.Builder
+
+	steps := path.Steps()
+
+	for pos, step := range steps {
+		switch step := step.(type) {
+		case AttributeName:
+			if step == "" || strings.ContainsAny(string(step), attributePathReservedCharacters) {
+				return "", NewAttributePathWithSteps(steps[:pos+1]).NewErrorf("attribute name %q cannot be formatted", string(step))
+			}
+
+			if pos != 0 {
+				result.WriteString(".")
+			}
+
+			result.WriteString(string(step))
+		case ElementKeyString:
+			result.WriteString("[" + strconv.Quote(string(step)) + "]")
+		case ElementKeyInt:
+			if step < 0 {
+				return "", NewAttributePathWithSteps(steps[:pos+1]).NewErrorf("negative element key %d cannot be formatted", int64(step))
+			}
+
+			result.WriteString("[" + strconv.FormatInt(int64(step), 10) + "]")
+		default:
+			return "", NewAttributePathWithSteps(steps[:pos+1]).NewErrorf("%T steps cannot be formatted", step)
+		}
+	}
+
+	return result.String(), nil
+}
+
+// attributePathIndexRegexp matches the canonical notation of non-negative
+// integer element keys, without signs or leading zeros.
+var attributePathIndexRegexp = regexp.MustCompile(`^(0|[1-9][0-9]*)$`)
+
+// attributePathReservedCharacters are the characters which cannot be part of
+// attribute names in ParseAttributePath notation.
+const attributePathReservedCharacters = `.[]"()`
+
+// Step names used by the output of the AttributePath String method.
+const (
+	attributePathStepAttributeName    = "AttributeName"
+	attributePathStepElementKeyInt    = "ElementKeyInt"
+	attributePathStepElementKeyString = "ElementKeyString"
+	attributePathStepElementKeyValue  = "ElementKeyValue"
+)
+
+// attributePathElementKeyEnd returns the position of the closing bracket of
+// the element key starting at the opening bracket at pos, skipping brackets
+// in quoted keys, or -1 if there is none.
+func attributePathElementKeyEnd(s string, pos int) int {
+	inQuotes := false
+
+	for i := pos + 1; i < len(s); i++ {
+		switch {
+		case inQuotes && s[i] == '\\':
+			i++
+		case s[i] == '"':
+			inQuotes = !inQuotes
+		case s[i] == ']' && !inQuotes:
+			return i
+		}
+	}
+
+	return -1
+}
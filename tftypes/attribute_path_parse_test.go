@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseAttributePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            string
+		expected      *AttributePath
+		expectedError string
+	}{
+		"empty": {
+			in:       "",
+			expected: NewAttributePath(),
+		},
+		"attribute": {
+			in:       "id",
+			expected: NewAttributePath().WithAttributeName("id"),
+		},
+		"nested-attributes": {
+			in:       "network_interface.ipv6-addresses",
+			expected: NewAttributePath().WithAttributeName("network_interface").WithAttributeName("ipv6-addresses"),
+		},
+		"element-key-int": {
+			in:       "network_interfaces[0].ipv6_addresses[12]",
+			expected: NewAttributePath().WithAttributeName("network_interfaces").WithElementKeyInt(0).WithAttributeName("ipv6_addresses").WithElementKeyInt(12),
+		},
+		"element-key-string": {
+			in:       `tags["Name"]`,
+			expected: NewAttributePath().WithAttributeName("tags").WithElementKeyString("Name"),
+		},
+		"element-key-string-escaped": {
+			in:       `tags["a.b[\"c\"]\n"]`,
+			expected: NewAttributePath().WithAttributeName("tags").WithElementKeyString("a.b[\"c\"]\n"),
+		},
+		"element-keys-consecutive": {
+			in:       `matrix[1][2]`,
+			expected: NewAttributePath().WithAttributeName("matrix").WithElementKeyInt(1).WithElementKeyInt(2),
+		},
+		"element-key-root": {
+			in:       `[0].id`,
+			expected: NewAttributePath().WithElementKeyInt(0).WithAttributeName("id"),
+		},
+		"leading-period": {
+			in:            ".id",
+			expectedError: `invalid attribute path ".id": expected attribute name at position 0`,
+		},
+		"trailing-period": {
+			in:            "id.",
+			expectedError: `invalid attribute path "id.": expected attribute name at position 3`,
+		},
+		"double-period": {
+			in:            "a..b",
+			expectedError: `invalid attribute path "a..b": expected attribute name at position 2`,
+		},
+		"missing-period": {
+			in:            "a[0]b",
+			expectedError: `invalid attribute path "a[0]b": expected "." or "[" at position 4`,
+		},
+		"unterminated-element-key": {
+			in:            "a[0",
+			expectedError: `invalid attribute path "a[0": unterminated element key at position 1`,
+		},
+		"unexpected-bracket": {
+			in:            "a]",
+			expectedError: `invalid attribute path "a]": unexpected ']' at position 1`,
+		},
+		"element-key-negative": {
+			in:            "a[-1]",
+			expectedError: `invalid attribute path "a[-1]": element key at position 1 must be a non-negative integer or quoted string`,
+		},
+		"element-key-plus-sign": {
+			in:            "a[+1]",
+			expectedError: `invalid attribute path "a[+1]": element key at position 1 must be a non-negative integer or quoted string`,
+		},
+		"element-key-negative-zero": {
+			in:            "a[-0]",
+			expectedError: `invalid attribute path "a[-0]": element key at position 1 must be a non-negative integer or quoted string`,
+		},
+		"element-key-leading-zero": {
+			in:            "a[01]",
+			expectedError: `invalid attribute path "a[01]": element key at position 1 must be a non-negative integer or quoted string`,
+		},
+		"element-key-empty": {
+			in:            "a[]",
+			expectedError: `invalid attribute path "a[]": element key at position 1 must be a non-negative integer or quoted string`,
+		},
+		"element-key-unquoted": {
+			in:            "a[b]",
+			expectedError: `invalid attribute path "a[b]": element key at position 1 must be a non-negative integer or quoted string`,
+		},
+		"element-key-invalid-string": {
+			in:            `a["b"c"]`,
+			expectedError: `invalid attribute path "a[\"b\"c\"]": unterminated element key at position 1`,
+		},
+		"element-key-invalid-escape": {
+			in:            `a["\q"]`,
+			expectedError: `invalid attribute path "a[\"\\q\"]": invalid string element key at position 1: invalid syntax`,
+		},
+		"attribute-parenthesis": {
+			in:            "a(b)",
+			expectedError: `invalid attribute path "a(b)": unexpected '(' at position 1`,
+		},
+		"steps": {
+			in:       `AttributeName("tags").ElementKeyString("a.b[\"c\"]\n").ElementKeyInt(-1).AttributeName("x(y)")`,
+			expected: NewAttributePath().WithAttributeName("tags").WithElementKeyString("a.b[\"c\"]\n").WithElementKeyInt(-1).WithAttributeName("x(y)"),
+		},
+		"steps-element-key-first": {
+			in:       `ElementKeyInt(0)`,
+			expected: NewAttributePath().WithElementKeyInt(0),
+		},
+		"steps-element-key-value": {
+			in:            `AttributeName("a").ElementKeyValue(tftypes.String<"b">)`,
+			expectedError: `invalid attribute path "AttributeName(\"a\").ElementKeyValue(tftypes.String<\"b\">)": ElementKeyValue steps cannot be parsed`,
+		},
+		"steps-unknown": {
+			in:            `AttributeName("a").Other("b")`,
+			expectedError: `invalid attribute path "AttributeName(\"a\").Other(\"b\")": unknown step "Other" at position 19`,
+		},
+		"steps-unquoted": {
+			in:            `AttributeName(a)`,
+			expectedError: `invalid attribute path "AttributeName(a)": invalid quoted string at position 14: invalid syntax`,
+		},
+		"steps-non-canonical-int": {
+			in:            `AttributeName("a").ElementKeyInt(01)`,
+			expectedError: `invalid attribute path "AttributeName(\"a\").ElementKeyInt(01)": element key at position 33 must be an integer`,
+		},
+		"steps-unterminated": {
+			in:            `AttributeName("a"`,
+			expectedError: `invalid attribute path "AttributeName(\"a\"": expected ")" at position 17`,
+		},
+		"steps-trailing-period": {
+			in:            `AttributeName("a").`,
+			expectedError: `invalid attribute path "AttributeName(\"a\").": expected step at position 19`,
+		},
+		"steps-missing-period": {
+			in:            `AttributeName("a")ElementKeyInt(1)`,
+			expectedError: `invalid attribute path "AttributeName(\"a\")ElementKeyInt(1)": expected "." at position 18`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseAttributePath(testCase.in)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatAttributePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            *AttributePath
+		expected      string
+		expectedError string
+	}{
+		"nil": {
+			in:       nil,
+			expected: "",
+		},
+		"attributes": {
+			in:       NewAttributePath().WithAttributeName("network_interface").WithAttributeName("ipv6_addresses"),
+			expected: "network_interface.ipv6_addresses",
+		},
+		"element-keys": {
+			in:       NewAttributePath().WithAttributeName("network_interfaces").WithElementKeyInt(0).WithAttributeName("tags").WithElementKeyString("a.b[\"c\"]"),
+			expected: `network_interfaces[0].tags["a.b[\"c\"]"]`,
+		},
+		"element-key-root": {
+			in:       NewAttributePath().WithElementKeyInt(0).WithAttributeName("id"),
+			expected: "[0].id",
+		},
+		"attribute-name-reserved": {
+			in:            NewAttributePath().WithAttributeName("a").WithAttributeName("b.c"),
+			expectedError: `AttributeName("a").AttributeName("b.c"): attribute name "b.c" cannot be formatted`,
+		},
+		"attribute-name-parenthesis": {
+			in:            NewAttributePath().WithAttributeName("a(b)"),
+			expectedError: `AttributeName("a(b)"): attribute name "a(b)" cannot be formatted`,
+		},
+		"element-key-int-negative": {
+			in:            NewAttributePath().WithAttributeName("a").WithElementKeyInt(-1),
+			expectedError: `AttributeName("a").ElementKeyInt(-1): negative element key -1 cannot be formatted`,
+		},
+		"element-key-value": {
+			in:            NewAttributePath().WithAttributeName("a").WithElementKeyValue(NewValue(String, "b")),
+			expectedError: `AttributeName("a").ElementKeyValue(tftypes.String<"b">): tftypes.ElementKeyValue steps cannot be formatted`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := FormatAttributePath(testCase.in)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			roundTrip, err := ParseAttributePath(got)
+
+			if err != nil {
+				t.Fatalf("unexpected round trip error: %s", err)
+			}
+
+			if !roundTrip.Equal(testCase.in) {
+				t.Errorf("expected round trip path %s, got %s", testCase.in, roundTrip)
+			}
+		})
+	}
+}
+
+func TestAttributePathStringRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// A fixed seed keeps failures reproducible.
+	rng := rand.New(rand.NewPCG(1, 2))
+	characters := []rune(`abz_-.[]"()\ 09` + "\n\t\u00e9\u2603")
+
+	randomString := func() string {
+		runes := make([]rune, rng.IntN(6))
+
+		for i := range runes {
+			runes[i] = characters[rng.IntN(len(characters))]
+		}
+
+		return string(runes)
+	}
+
+	for i := 0; i < 1000; i++ {
+		path := NewAttributePath()
+
+		for steps := rng.IntN(5); steps > 0; steps-- {
+			switch rng.IntN(3) {
+			case 0:
+				path = path.WithAttributeName(randomString())
+			case 1:
+				path = path.WithElementKeyString(randomString())
+			case 2:
+				path = path.WithElementKeyInt(rng.IntN(2001) - 1000)
+			}
+		}
+
+		str := path.String()
+
+		// An empty path has an empty String, which is parsed as reference
+		// notation.
+		got, err := ParseAttributePath(str)
+
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", str, err)
+		}
+
+		if !got.Equal(path) {
+			t.Fatalf("expected round trip path %s, got %s", path, got)
+		}
+
+		formatted, err := FormatAttributePath(path)
+
+		// Not every path can be described in reference notation, such as
+		// paths with negative element keys.
+		if err != nil {
+			continue
+		}
+
+		got, err = ParseAttributePath(formatted)
+
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", formatted, err)
+		}
+
+		if !got.Equal(path) {
+			t.Fatalf("expected round trip path %s, got %s", path, got)
+		}
+	}
+}
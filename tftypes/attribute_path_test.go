@@ -778,6 +778,10 @@ func TestAttributePathString(t *testing.T) {
 			path:     NewAttributePath().WithElementKeyString("testing"),
 			expected: `ElementKeyString("testing")`,
 		},
+		"element-key-string-escaped": {
+			path:     NewAttributePath().WithElementKeyString(`quote"backslash\`),
+			expected: `ElementKeyString("quote\"backslash\\")`,
+		},
 		"element-key-int": {
 			path:     NewAttributePath().WithElementKeyInt(1234),
 			expected: `ElementKeyInt(1234)`,
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// binaryFormatVersion1 is the first version of the binary encoding of Types
+// and Values.
+//
+// Version 1 Types are encoded as the version byte followed by the JSON type
+// signature. Version 1 Values are encoded as the version byte, the uvarint
+// length of the JSON type signature, the JSON type signature, and the
+// msgpack encoding of the value using that type.
+//
+// The binary encoding is stable: data encoded with a version will always be
+// decodable by later releases of this package. Changes to the encoding must
+// introduce a new version rather than modify an existing one.
+const binaryFormatVersion1 byte = 1
+
+// TypeFromBinary returns a Type from its binary representation, as returned
+// by the MarshalBinary method of the Type.
+func TypeFromBinary(data []byte) (Type, error) {
+	typeJSON, err := binaryFormatPayload(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	typ, err := ParseJSONType(typeJSON)
+
+	if err != nil {
+		return nil, fmt.Errorf("error decoding type: %w", err)
+	}
+
+	return typ, nil
+}
+
+// MarshalBinary returns a versioned, self-describing binary representation
+// of the Value, including its Type. It implements the
+// encoding.BinaryMarshaler interface, so Values can be persisted by
+// encoding/gob or in key-value stores and later decoded with
+// Value.UnmarshalBinary.
+func (val Value) MarshalBinary() ([]byte, error) {
+	if val.Type() == nil {
+		return nil, errors.New("cannot marshal Value without a Type")
+	}
+
+	typeJSON, err := val.Type().MarshalJSON()
+
+	if err != nil {
+		return nil, fmt.Errorf("error encoding type: %w", err)
+	}
+
+	valueMsgPack, err := val.MarshalMsgPack(val.Type()) //nolint:staticcheck
+
+	if err != nil {
+		return nil, fmt.Errorf("error encoding value: %w", err)
+	}
+
+	buf := make([]byte, 0, 1+binary.MaxVarintLen64+len(typeJSON)+len(valueMsgPack))
+	buf = append(buf, binaryFormatVersion1)
+	buf = binary.AppendUvarint(buf, uint64(len(typeJSON)))
+	buf = append(buf, typeJSON...)
+	buf = append(buf, valueMsgPack...)
+
+	return buf, nil
+}
+
+// UnmarshalBinary sets the Value from its binary representation, as returned
+// by Value.MarshalBinary. It implements the encoding.BinaryUnmarshaler
+// interface.
+func (val *Value) UnmarshalBinary(data []byte) error {
+	payload, err := binaryFormatPayload(data)
+
+	if err != nil {
+		return err
+	}
+
+	typeLen, n := binary.Uvarint(payload)
+
+	if n <= 0 || typeLen > uint64(len(payload)-n) {
+		return errors.New("error decoding type: invalid type length")
+	}
+
+	typeJSON := payload[n : n+int(typeLen)]
+	valueMsgPack := payload[n+int(typeLen):]
+
+	typ, err := ParseJSONType(typeJSON)
+
+	if err != nil {
+		return fmt.Errorf("error decoding type: %w", err)
+	}
+
+	result, err := ValueFromMsgPack(valueMsgPack, typ) //nolint:staticcheck
+
+	if err != nil {
+		return fmt.Errorf("error decoding value: %w", err)
+	}
+
+	*val = result
+
+	return nil
+}
+
+// MarshalBinary returns a versioned binary representation of the type. It
+// implements the encoding.BinaryMarshaler interface. Use TypeFromBinary to
+// decode it.
+func (p primitive) MarshalBinary() ([]byte, error) {
+	return marshalBinaryType(p)
+}
+
+// MarshalBinary returns a versioned binary representation of the List
+// type, including its ElementType. It implements the
+// encoding.BinaryMarshaler interface.
+func (l List) MarshalBinary() ([]byte, error) {
+	return marshalBinaryType(l)
+}
+
+// UnmarshalBinary sets the List type from its binary representation. It
+// implements the encoding.BinaryUnmarshaler interface.
+func (l *List) UnmarshalBinary(data []byte) error {
+	typ, err := TypeFromBinary(data)
+
+	if err != nil {
+		return err
+	}
+
+	list, ok := typ.(List)
+
+	if !ok {
+		return fmt.Errorf("cannot unmarshal %s into List", typ)
+	}
+
+	*l = list
+
+	return nil
+}
+
+// MarshalBinary returns a versioned binary representation of the Map type,
+// including its ElementType. It implements the encoding.BinaryMarshaler
+// interface.
+func (m Map) MarshalBinary() ([]byte, error) {
+	return marshalBinaryType(m)
+}
+
+// UnmarshalBinary sets the Map type from its binary representation. It
+// implements the encoding.BinaryUnmarshaler interface.
+func (m *Map) UnmarshalBinary(data []byte) error {
+	typ, err := TypeFromBinary(data)
+
+	if err != nil {
+		return err
+	}
+
+	mapType, ok := typ.(Map)
+
+	if !ok {
+		return fmt.Errorf("cannot unmarshal %s into Map", typ)
+	}
+
+	*m = mapType
+
+	return nil
+}
+
+// MarshalBinary returns a versioned binary representation of the Object
+// type, including its AttributeTypes and OptionalAttributes. It implements
+// the encoding.BinaryMarshaler interface.
+func (o Object) MarshalBinary() ([]byte, error) {
+	return marshalBinaryType(o)
+}
+
+// UnmarshalBinary sets the Object type from its binary representation. It
+// implements the encoding.BinaryUnmarshaler interface.
+func (o *Object) UnmarshalBinary(data []byte) error {
+	typ, err := TypeFromBinary(data)
+
+	if err != nil {
+		return err
+	}
+
+	object, ok := typ.(Object)
+
+	if !ok {
+		return fmt.Errorf("cannot unmarshal %s into Object", typ)
+	}
+
+	*o = object
+
+	return nil
+}
+
+// MarshalBinary returns a versioned binary representation of the Set type,
+// including its ElementType. It implements the encoding.BinaryMarshaler
+// interface.
+func (s Set) MarshalBinary() ([]byte, error) {
+	return marshalBinaryType(s)
+}
+
+// UnmarshalBinary sets the Set type from its binary representation. It
+// implements the encoding.BinaryUnmarshaler interface.
+func (s *Set) UnmarshalBinary(data []byte) error {
+	typ, err := TypeFromBinary(data)
+
+	if err != nil {
+		return err
+	}
+
+	set, ok := typ.(Set)
+
+	if !ok {
+		return fmt.Errorf("cannot unmarshal %s into Set", typ)
+	}
+
+	*s = set
+
+	return nil
+}
+
+// MarshalBinary returns a versioned binary representation of the Tuple
+// type, including its ElementTypes. It implements the
+// encoding.BinaryMarshaler interface.
+func (tu Tuple) MarshalBinary() ([]byte, error) {
+	return marshalBinaryType(tu)
+}
+
+// UnmarshalBinary sets the Tuple type from its binary representation. It
+// implements the encoding.BinaryUnmarshaler interface.
+func (tu *Tuple) UnmarshalBinary(data []byte) error {
+	typ, err := TypeFromBinary(data)
+
+	if err != nil {
+		return err
+	}
+
+	tuple, ok := typ.(Tuple)
+
+	if !ok {
+		return fmt.Errorf("cannot unmarshal %s into Tuple", typ)
+	}
+
+	*tu = tuple
+
+	return nil
+}
+
+// marshalBinaryType returns the current version binary representation of a
+// Type.
+func marshalBinaryType(t Type) ([]byte, error) {
+	typeJSON, err := t.MarshalJSON()
+
+	if err != nil {
+		return nil, fmt.Errorf("error encoding type: %w", err)
+	}
+
+	return append([]byte{binaryFormatVersion1}, typeJSON...), nil
+}
+
+// binaryFormatPayload verifies the version of binary data and returns the
+// data following the version byte.
+func binaryFormatPayload(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, errors.New("cannot unmarshal empty binary data")
+	}
+
+	if data[0] != binaryFormatVersion1 {
+		return nil, fmt.Errorf("unsupported binary format version %d", data[0])
+	}
+
+	return data[1:], nil
+}
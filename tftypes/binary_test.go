@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math/big"
+	"testing"
+)
+
+func TestValueBinaryRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	objectType := Object{
+		AttributeTypes: map[string]Type{
+			"dynamic": DynamicPseudoType,
+			"list":    List{ElementType: String},
+			"number":  Number,
+			"set":     Set{ElementType: Bool},
+		},
+		OptionalAttributes: map[string]struct{}{
+			"set": {},
+		},
+	}
+
+	testCases := map[string]struct {
+		value Value
+	}{
+		"string": {
+			value: NewValue(String, "hello"),
+		},
+		"string-null": {
+			value: NewValue(String, nil),
+		},
+		"string-unknown": {
+			value: NewValue(String, UnknownValue),
+		},
+		"number": {
+			value: NewValue(Number, big.NewFloat(1.5)),
+		},
+		"map": {
+			value: NewValue(Map{ElementType: Number}, map[string]Value{
+				"a": NewValue(Number, big.NewFloat(1)),
+				"b": NewValue(Number, UnknownValue),
+			}),
+		},
+		"tuple": {
+			value: NewValue(Tuple{ElementTypes: []Type{String, Bool}}, []Value{
+				NewValue(String, "a"),
+				NewValue(Bool, true),
+			}),
+		},
+		"object": {
+			value: NewValue(objectType, map[string]Value{
+				"dynamic": NewValue(String, "dynamic"),
+				"list": NewValue(List{ElementType: String}, []Value{
+					NewValue(String, "a"),
+				}),
+				"number": NewValue(Number, big.NewFloat(2)),
+				"set":    NewValue(Set{ElementType: Bool}, nil),
+			}),
+		},
+		"object-null": {
+			value: NewValue(objectType, nil),
+		},
+		"object-unknown": {
+			value: NewValue(objectType, UnknownValue),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := testCase.value.MarshalBinary()
+
+			if err != nil {
+				t.Fatalf("unexpected error marshaling: %s", err)
+			}
+
+			if data[0] != binaryFormatVersion1 {
+				t.Errorf("expected version %d, got %d", binaryFormatVersion1, data[0])
+			}
+
+			var got Value
+
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("unexpected error unmarshaling: %s", err)
+			}
+
+			if !got.Type().Equal(testCase.value.Type()) {
+				t.Errorf("expected type %s, got %s", testCase.value.Type(), got.Type())
+			}
+
+			if !got.Equal(testCase.value) {
+				t.Errorf("expected value %s, got %s", testCase.value, got)
+			}
+		})
+	}
+}
+
+func TestValueBinaryGob(t *testing.T) {
+	t.Parallel()
+
+	type cacheEntry struct {
+		Key   string
+		Value Value
+	}
+
+	expected := cacheEntry{
+		Key: "example",
+		Value: NewValue(List{ElementType: String}, []Value{
+			NewValue(String, "a"),
+			NewValue(String, UnknownValue),
+		}),
+	}
+
+	var buf bytes.Buffer
+
+	if err := gob.NewEncoder(&buf).Encode(expected); err != nil {
+		t.Fatalf("unexpected error encoding: %s", err)
+	}
+
+	var got cacheEntry
+
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("unexpected error decoding: %s", err)
+	}
+
+	if got.Key != expected.Key {
+		t.Errorf("expected key %q, got %q", expected.Key, got.Key)
+	}
+
+	if !got.Value.Equal(expected.Value) {
+		t.Errorf("expected value %s, got %s", expected.Value, got.Value)
+	}
+}
+
+func TestValueUnmarshalBinaryErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data          []byte
+		expectedError string
+	}{
+		"empty": {
+			data:          nil,
+			expectedError: "cannot unmarshal empty binary data",
+		},
+		"unsupported-version": {
+			data:          []byte{0xff},
+			expectedError: "unsupported binary format version 255",
+		},
+		"missing-type-length": {
+			data:          []byte{binaryFormatVersion1},
+			expectedError: "error decoding type: invalid type length",
+		},
+		"truncated-type": {
+			data:          append([]byte{binaryFormatVersion1, 20}, `"string"`...),
+			expectedError: "error decoding type: invalid type length",
+		},
+		"invalid-value": {
+			data:          append([]byte{binaryFormatVersion1, 8}, `"string"`...),
+			expectedError: "error decoding value: error peeking next byte: EOF",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got Value
+
+			err := got.UnmarshalBinary(testCase.data)
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if err.Error() != testCase.expectedError {
+				t.Errorf("expected error %q, got %q", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestValueMarshalBinaryNoType(t *testing.T) {
+	t.Parallel()
+
+	_, err := Value{}.MarshalBinary()
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestTypeBinaryRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ Type
+	}{
+		"bool": {
+			typ: Bool,
+		},
+		"dynamic": {
+			typ: DynamicPseudoType,
+		},
+		"list": {
+			typ: List{ElementType: String},
+		},
+		"map": {
+			typ: Map{ElementType: Number},
+		},
+		"object": {
+			typ: Object{
+				AttributeTypes: map[string]Type{
+					"a": String,
+					"b": Set{ElementType: Bool},
+				},
+				OptionalAttributes: map[string]struct{}{
+					"b": {},
+				},
+			},
+		},
+		"set": {
+			typ: Set{ElementType: DynamicPseudoType},
+		},
+		"tuple": {
+			typ: Tuple{ElementTypes: []Type{String, Number}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := marshalBinaryType(testCase.typ)
+
+			if err != nil {
+				t.Fatalf("unexpected error marshaling: %s", err)
+			}
+
+			got, err := TypeFromBinary(data)
+
+			if err != nil {
+				t.Fatalf("unexpected error unmarshaling: %s", err)
+			}
+
+			if !got.Equal(testCase.typ) {
+				t.Errorf("expected type %s, got %s", testCase.typ, got)
+			}
+		})
+	}
+}
+
+func TestTypeUnmarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	data, err := List{ElementType: String}.MarshalBinary()
+
+	if err != nil {
+		t.Fatalf("unexpected error marshaling: %s", err)
+	}
+
+	var list List
+
+	if err := list.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error unmarshaling: %s", err)
+	}
+
+	if !list.Equal(List{ElementType: String}) {
+		t.Errorf("expected List[String], got %s", list)
+	}
+
+	var set Set
+
+	err = set.UnmarshalBinary(data)
+
+	if err == nil {
+		t.Fatal("expected error unmarshaling List into Set, got none")
+	}
+
+	if expected := "cannot unmarshal tftypes.List[tftypes.String] into Set"; err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err)
+	}
+}
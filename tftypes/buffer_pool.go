@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"bytes"
+	"sync"
+)
+
+// maxPooledBufferSize is the capacity above which buffers are not returned to
+// bufferPool, so a single very large value does not keep its memory alive.
+const maxPooledBufferSize = 1024 * 1024
+
+// bufferPool reuses the buffers values are encoded into, reducing allocations
+// when many values are encoded, such as during plans of many resources.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// getBuffer returns an empty buffer from bufferPool. It should be returned
+// with putBuffer once its contents are no longer referenced.
+func getBuffer() *bytes.Buffer {
+	buf := bufferPool.Get().(*bytes.Buffer) //nolint:forcetypeassert // bufferPool only contains *bytes.Buffer
+	buf.Reset()
+
+	return buf
+}
+
+// putBuffer returns a buffer from getBuffer to bufferPool.
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBufferSize {
+		return
+	}
+
+	bufferPool.Put(buf)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes_test
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func ExampleValue_Diff() {
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+			"tags": tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+	prior := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "example"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"Team": tftypes.NewValue(tftypes.String, "a"),
+		}),
+	})
+	planned := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "renamed"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"Owner": tftypes.NewValue(tftypes.String, "b"),
+		}),
+	})
+
+	diffs, err := prior.Diff(planned)
+	if err != nil {
+		panic(err)
+	}
+
+	// diffs are not ordered, so sort them for stable output
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Path.String() < diffs[j].Path.String()
+	})
+
+	// Value1 is nil for added values and Value2 is nil for removed values
+	for _, diff := range diffs {
+		switch {
+		case diff.Value1 == nil:
+			fmt.Println("added:", diff.Path, diff.Value2)
+		case diff.Value2 == nil:
+			fmt.Println("removed:", diff.Path, diff.Value1)
+		case diff.Value1.Type().Is(tftypes.String):
+			fmt.Println("changed:", diff.Path, diff.Value1, "=>", diff.Value2)
+		}
+	}
+
+	// Output:
+	// changed: AttributeName("name") tftypes.String<"example"> => tftypes.String<"renamed">
+	// added: AttributeName("tags").ElementKeyString("Owner") tftypes.String<"b">
+	// removed: AttributeName("tags").ElementKeyString("Team") tftypes.String<"a">
+}
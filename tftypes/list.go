@@ -95,7 +95,11 @@ func valueFromList(typ Type, in interface{}) (Value, error) {
 				return Value{}, NewAttributePath().WithElementKeyInt(pos).NewErrorf("can't use %s as %s", v.Type(), typ)
 			}
 			if valType == nil {
+				// Types without complete information, such as an
+				// Object without AttributeTypes, are never Equal,
+				// so the first element cannot be compared to itself.
 				valType = v.Type()
+				continue
 			}
 			if !v.Type().Equal(valType) {
 				return Value{}, fmt.Errorf("lists must only contain one type of element, saw %s and %s", valType, v.Type())
@@ -112,8 +116,6 @@ func valueFromList(typ Type, in interface{}) (Value, error) {
 
 // MarshalJSON returns a JSON representation of the full type signature of `l`,
 // including its ElementType.
-//
-// Deprecated: this is not meant to be called by third-party code.
 func (l List) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 
@@ -85,8 +85,6 @@ func (m Map) supportedGoTypes() []string {
 
 // MarshalJSON returns a JSON representation of the full type signature of `m`,
 // including its ElementType.
-//
-// Deprecated: this is not meant to be called by third-party code.
 func (m Map) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 
@@ -116,7 +114,11 @@ func valueFromMap(typ Type, in interface{}) (Value, error) {
 				return Value{}, NewAttributePath().WithElementKeyString(k).NewErrorf("can't use %s as %s", v.Type(), typ)
 			}
 			if elType == nil {
+				// Types without complete information, such as an
+				// Object without AttributeTypes, are never Equal,
+				// so the first element cannot be compared to itself.
 				elType = v.Type()
+				continue
 			}
 			if !elType.Equal(v.Type()) {
 				return Value{}, fmt.Errorf("maps must only contain one type of element, saw %s and %s", elType, v.Type())
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// defaultNumberPrecision is the precision, in bits, of Numbers parsed from
+// strings when no other precision is requested. According to
+// https://github.com/hashicorp/go-cty/blob/85980079f637862fa8e43ddc82dd74315e2f4c85/cty/value_init.go#L49
+// base 10, precision 512, and rounding to nearest even is the standard way
+// to handle numbers arriving as strings.
+const defaultNumberPrecision = 512
+
+// parseNumber parses the base 10 number in s, rounded to prec bits of
+// precision, or defaultNumberPrecision if prec is 0. Integers within the range
+// of int64 or uint64 are stored exactly without the cost of parsing a
+// big.Float, so their precision is never lower than the 64 bits they need.
+func parseNumber(s string, prec uint) (*big.Float, error) {
+	if prec == 0 {
+		prec = defaultNumberPrecision
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return new(big.Float).SetPrec(max(prec, 64)).SetInt64(i), nil
+	}
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return new(big.Float).SetPrec(max(prec, 64)).SetUint64(u), nil
+	}
+	f, _, err := big.ParseFloat(s, 10, prec, big.ToNearestEven)
+	return f, err
+}
+
+// float64ToNumber returns a Number with the value of f, rounded to prec bits
+// of precision if prec is not 0. Otherwise the 53 bits of precision of a
+// float64 are used, so the value is exact.
+func float64ToNumber(f float64, prec uint) *big.Float {
+	if prec == 0 {
+		return big.NewFloat(f)
+	}
+	return new(big.Float).SetPrec(prec).SetFloat64(f)
+}
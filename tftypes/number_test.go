@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseNumber(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input         string
+		prec          uint
+		expected      string
+		expectedPrec  uint
+		expectedError bool
+	}
+	tests := map[string]testCase{
+		"int64": {
+			input:        "-9223372036854775808",
+			expected:     "-9223372036854775808",
+			expectedPrec: 512,
+		},
+		"uint64": {
+			input:        "18446744073709551615",
+			expected:     "18446744073709551615",
+			expectedPrec: 512,
+		},
+		"int64-precision": {
+			input:        "9007199254740993",
+			prec:         128,
+			expected:     "9007199254740993",
+			expectedPrec: 128,
+		},
+		"int64-minimum-precision": {
+			input:        "9007199254740993",
+			prec:         24,
+			expected:     "9007199254740993",
+			expectedPrec: 64,
+		},
+		"larger-integer": {
+			input:        "18446744073709551616",
+			expected:     "18446744073709551616",
+			expectedPrec: 512,
+		},
+		"fraction": {
+			input:        "1.5",
+			expected:     "1.5",
+			expectedPrec: 512,
+		},
+		"fraction-precision": {
+			input:        "0.1",
+			prec:         24,
+			expected:     "0.1",
+			expectedPrec: 24,
+		},
+		"exponent": {
+			input:        "1e3",
+			expected:     "1000",
+			expectedPrec: 512,
+		},
+		"invalid": {
+			input:         "abc",
+			expectedError: true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseNumber(test.input, test.prec)
+			if err != nil {
+				if !test.expectedError {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if test.expectedError {
+				t.Fatalf("expected error, got %s", got)
+			}
+
+			if got.Cmp(mustParseFloat(t, test.expected, test.expectedPrec)) != 0 {
+				t.Errorf("expected %s, got %s", test.expected, got.Text('f', -1))
+			}
+			if got.Prec() != test.expectedPrec {
+				t.Errorf("expected precision %d, got %d", test.expectedPrec, got.Prec())
+			}
+		})
+	}
+}
+
+func TestParseNumber_Exact(t *testing.T) {
+	t.Parallel()
+
+	// 2^53 + 1 cannot be represented by a float64 or a big.Float with a
+	// precision of 53 bits, but must survive parsing.
+	got, err := parseNumber("9007199254740993", 53)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if i, acc := got.Int64(); acc != big.Exact || i != 9007199254740993 {
+		t.Errorf("expected exact 9007199254740993, got %d (%s)", i, acc)
+	}
+}
+
+func TestFloat64ToNumber(t *testing.T) {
+	t.Parallel()
+
+	if got := float64ToNumber(0.1, 0); got.Prec() != 53 {
+		t.Errorf("expected precision 53, got %d", got.Prec())
+	}
+
+	got := float64ToNumber(0.1, 8)
+	if got.Prec() != 8 {
+		t.Errorf("expected precision 8, got %d", got.Prec())
+	}
+	if got.Cmp(big.NewFloat(0.1)) == 0 {
+		t.Errorf("expected 0.1 to be rounded, got %s", got.Text('g', -1))
+	}
+}
+
+func mustParseFloat(t *testing.T, s string, prec uint) *big.Float {
+	t.Helper()
+
+	f, _, err := big.ParseFloat(s, 10, prec, big.ToNearestEven)
+	if err != nil {
+		t.Fatalf("error parsing %q: %s", s, err)
+	}
+	return f
+}
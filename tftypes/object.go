@@ -16,6 +16,14 @@ import (
 // string name. The number of attributes, their names, and their types are part
 // of the type signature for the Object, and so two Objects with different
 // attribute names or types are considered to be distinct types.
+//
+// The empty object type, which has no attributes, must be declared as
+// Object{AttributeTypes: map[string]Type{}}. An Object without AttributeTypes
+// is an incomplete type, which is only suitable for Is comparisons and is
+// never Equal to another type. As a convenience, NewValue completes the type
+// of a known Object value created without AttributeTypes and without
+// attributes, so it is Equal to the same value after a msgpack or JSON
+// round trip.
 type Object struct {
 	// AttributeTypes is a map of attributes to their types. The key should
 	// be the name of the attribute, and the value should be the type of
@@ -116,8 +124,9 @@ func (o Object) Equal(other Type) bool {
 //
 // If the other type is DynamicPseudoType, it will return true.
 // If the other type is not a Object, it will return false.
-// If the other Object does not have matching AttributeTypes length, it will
-// return false.
+// If the other Object has an attribute that is not in the current Object's
+// AttributeTypes and is not one of the other Object's OptionalAttributes, it
+// will return false.
 // If the other Object does not have a type compatible ElementType for every
 // nested attribute, it will return false.
 //
@@ -133,9 +142,6 @@ func (o Object) UsableAs(other Type) bool {
 	if len(o.OptionalAttributes) > 0 {
 		panic("Objects with OptionalAttributes cannot be used.")
 	}
-	if len(v.AttributeTypes) != len(o.AttributeTypes) {
-		return false
-	}
 	for k, typ := range o.AttributeTypes {
 		otherTyp, ok := v.AttributeTypes[k]
 		if !ok {
@@ -145,6 +151,11 @@ func (o Object) UsableAs(other Type) bool {
 			return false
 		}
 	}
+	for k := range v.AttributeTypes {
+		if _, ok := o.AttributeTypes[k]; !ok && !v.attrIsOptional(k) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -221,6 +232,13 @@ func valueFromObject(types map[string]Type, optionalAttrs map[string]struct{}, i
 				}
 			}
 		}
+		// An Object without AttributeTypes and without attribute values
+		// can only be the empty object, so ensure its type is complete
+		// and therefore Equal to the empty object type after encoding
+		// round trips.
+		if types == nil && len(value) == 0 {
+			types = map[string]Type{}
+		}
 		return Value{
 			typ:   Object{AttributeTypes: types, OptionalAttributes: optionalAttrs},
 			value: value,
@@ -232,8 +250,6 @@ func valueFromObject(types map[string]Type, optionalAttrs map[string]struct{}, i
 
 // MarshalJSON returns a JSON representation of the full type signature of `o`,
 // including the AttributeTypes and, if present, OptionalAttributes.
-//
-// Deprecated: this is not meant to be called by third-party code.
 func (o Object) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 
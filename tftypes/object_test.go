@@ -588,6 +588,40 @@ func TestObjectUsableAs(t *testing.T) {
 			},
 			expected: false,
 		},
+		"object-object-optional-missing": {
+			object: Object{
+				AttributeTypes: map[string]Type{
+					"required": String,
+				},
+			},
+			other: Object{
+				AttributeTypes: map[string]Type{
+					"optional": String,
+					"required": String,
+				},
+				OptionalAttributes: map[string]struct{}{
+					"optional": {},
+				},
+			},
+			expected: true,
+		},
+		"object-object-optional-required-missing": {
+			object: Object{
+				AttributeTypes: map[string]Type{
+					"optional": String,
+				},
+			},
+			other: Object{
+				AttributeTypes: map[string]Type{
+					"optional": String,
+					"required": String,
+				},
+				OptionalAttributes: map[string]struct{}{
+					"optional": {},
+				},
+			},
+			expected: false,
+		},
 		"object-object-required": {
 			object: Object{
 				AttributeTypes: map[string]Type{
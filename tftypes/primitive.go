@@ -75,6 +75,8 @@ func (p primitive) String() string {
 
 func (p primitive) private() {}
 
+// MarshalJSON returns a JSON representation of the primitive type, which is
+// its name as a JSON string.
 func (p primitive) MarshalJSON() ([]byte, error) {
 	switch p.name {
 	case String.name:
@@ -91,7 +91,11 @@ func valueFromSet(typ Type, in interface{}) (Value, error) {
 				return Value{}, NewAttributePath().WithElementKeyValue(v).NewErrorf("can't use %s as %s", v.Type(), typ)
 			}
 			if elType == nil {
+				// Types without complete information, such as an
+				// Object without AttributeTypes, are never Equal,
+				// so the first element cannot be compared to itself.
 				elType = v.Type()
+				continue
 			}
 			if !elType.Equal(v.Type()) {
 				return Value{}, fmt.Errorf("sets must only contain one type of element, saw %s and %s", elType, v.Type())
@@ -108,8 +112,6 @@ func valueFromSet(typ Type, in interface{}) (Value, error) {
 
 // MarshalJSON returns a JSON representation of the full type signature of `s`,
 // including its ElementType.
-//
-// Deprecated: this is not meant to be called by third-party code.
 func (s Set) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 
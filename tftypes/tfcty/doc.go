@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tfcty converts between tftypes and go-cty types and values.
+//
+// Providers migrating from terraform-plugin-sdk, which is built on go-cty,
+// can use this package to mix code using either type system during the
+// transition. Types and values are converted as is, including nulls, unknown
+// values, and marks, with the following exceptions:
+//
+//   - go-cty capsule types have no tftypes equivalent and cannot be
+//     converted.
+//   - Refinements of go-cty unknown values are not represented in tftypes
+//     and are dropped.
+//   - go-cty normalizes strings to Unicode Normalization Form C.
+//   - go-cty Lists, Sets, and Maps require all their elements to have the
+//     same type, so tftypes collections whose element type is
+//     DynamicPseudoType are converted with the type of their elements, and
+//     cannot be converted if the types of their elements differ.
+package tfcty
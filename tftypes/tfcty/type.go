@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcty
+
+import (
+	"fmt"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ToCtyType returns the go-cty type equivalent to the tftypes.Type.
+func ToCtyType(typ tftypes.Type) (cty.Type, error) {
+	if typ == nil {
+		return cty.NilType, fmt.Errorf("missing type")
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		return cty.String, nil
+	case typ.Is(tftypes.Number):
+		return cty.Number, nil
+	case typ.Is(tftypes.Bool):
+		return cty.Bool, nil
+	case typ.Is(tftypes.DynamicPseudoType):
+		return cty.DynamicPseudoType, nil
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.List:
+		elementType, err := ToCtyType(typ.ElementType)
+		if err != nil {
+			return cty.NilType, err
+		}
+		return cty.List(elementType), nil
+	case tftypes.Set:
+		elementType, err := ToCtyType(typ.ElementType)
+		if err != nil {
+			return cty.NilType, err
+		}
+		return cty.Set(elementType), nil
+	case tftypes.Map:
+		elementType, err := ToCtyType(typ.ElementType)
+		if err != nil {
+			return cty.NilType, err
+		}
+		return cty.Map(elementType), nil
+	case tftypes.Tuple:
+		elementTypes := make([]cty.Type, len(typ.ElementTypes))
+		for pos, elementType := range typ.ElementTypes {
+			ctyType, err := ToCtyType(elementType)
+			if err != nil {
+				return cty.NilType, err
+			}
+			elementTypes[pos] = ctyType
+		}
+		return cty.Tuple(elementTypes), nil
+	case tftypes.Object:
+		attributeTypes := make(map[string]cty.Type, len(typ.AttributeTypes))
+		for name, attributeType := range typ.AttributeTypes {
+			ctyType, err := ToCtyType(attributeType)
+			if err != nil {
+				return cty.NilType, err
+			}
+			attributeTypes[name] = ctyType
+		}
+		if len(typ.OptionalAttributes) == 0 {
+			return cty.Object(attributeTypes), nil
+		}
+		optional := make([]string, 0, len(typ.OptionalAttributes))
+		for name := range typ.OptionalAttributes {
+			optional = append(optional, name)
+		}
+		return cty.ObjectWithOptionalAttrs(attributeTypes, optional), nil
+	}
+
+	return cty.NilType, fmt.Errorf("unsupported type %s", typ)
+}
+
+// FromCtyType returns the tftypes.Type equivalent to the go-cty type.
+// Capsule types are not supported.
+func FromCtyType(typ cty.Type) (tftypes.Type, error) {
+	switch {
+	case typ == cty.NilType:
+		return nil, fmt.Errorf("missing type")
+	case typ == cty.DynamicPseudoType:
+		return tftypes.DynamicPseudoType, nil
+	case typ == cty.String:
+		return tftypes.String, nil
+	case typ == cty.Number:
+		return tftypes.Number, nil
+	case typ == cty.Bool:
+		return tftypes.Bool, nil
+	case typ.IsListType():
+		elementType, err := FromCtyType(typ.ElementType())
+		if err != nil {
+			return nil, err
+		}
+		return tftypes.List{ElementType: elementType}, nil
+	case typ.IsSetType():
+		elementType, err := FromCtyType(typ.ElementType())
+		if err != nil {
+			return nil, err
+		}
+		return tftypes.Set{ElementType: elementType}, nil
+	case typ.IsMapType():
+		elementType, err := FromCtyType(typ.ElementType())
+		if err != nil {
+			return nil, err
+		}
+		return tftypes.Map{ElementType: elementType}, nil
+	case typ.IsTupleType():
+		ctyTypes := typ.TupleElementTypes()
+		elementTypes := make([]tftypes.Type, len(ctyTypes))
+		for pos, ctyType := range ctyTypes {
+			elementType, err := FromCtyType(ctyType)
+			if err != nil {
+				return nil, err
+			}
+			elementTypes[pos] = elementType
+		}
+		return tftypes.Tuple{ElementTypes: elementTypes}, nil
+	case typ.IsObjectType():
+		ctyTypes := typ.AttributeTypes()
+		attributeTypes := make(map[string]tftypes.Type, len(ctyTypes))
+		for name, ctyType := range ctyTypes {
+			attributeType, err := FromCtyType(ctyType)
+			if err != nil {
+				return nil, err
+			}
+			attributeTypes[name] = attributeType
+		}
+		result := tftypes.Object{AttributeTypes: attributeTypes}
+		if optional := typ.OptionalAttributes(); len(optional) > 0 {
+			result.OptionalAttributes = make(map[string]struct{}, len(optional))
+			for name := range optional {
+				result.OptionalAttributes[name] = struct{}{}
+			}
+		}
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", typ.FriendlyName())
+}
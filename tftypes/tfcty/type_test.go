@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcty_test
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes/tfcty"
+)
+
+func TestTypes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfType  tftypes.Type
+		ctyType cty.Type
+	}{
+		"string": {
+			tfType:  tftypes.String,
+			ctyType: cty.String,
+		},
+		"number": {
+			tfType:  tftypes.Number,
+			ctyType: cty.Number,
+		},
+		"bool": {
+			tfType:  tftypes.Bool,
+			ctyType: cty.Bool,
+		},
+		"dynamic": {
+			tfType:  tftypes.DynamicPseudoType,
+			ctyType: cty.DynamicPseudoType,
+		},
+		"list": {
+			tfType:  tftypes.List{ElementType: tftypes.String},
+			ctyType: cty.List(cty.String),
+		},
+		"set": {
+			tfType:  tftypes.Set{ElementType: tftypes.Number},
+			ctyType: cty.Set(cty.Number),
+		},
+		"map": {
+			tfType:  tftypes.Map{ElementType: tftypes.Bool},
+			ctyType: cty.Map(cty.Bool),
+		},
+		"tuple": {
+			tfType:  tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.DynamicPseudoType}},
+			ctyType: cty.Tuple([]cty.Type{cty.String, cty.DynamicPseudoType}),
+		},
+		"object": {
+			tfType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"a": tftypes.String,
+					"b": tftypes.List{ElementType: tftypes.Number},
+				},
+			},
+			ctyType: cty.Object(map[string]cty.Type{
+				"a": cty.String,
+				"b": cty.List(cty.Number),
+			}),
+		},
+		"object-optional": {
+			tfType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"a": tftypes.String,
+					"b": tftypes.Number,
+				},
+				OptionalAttributes: map[string]struct{}{
+					"b": {},
+				},
+			},
+			ctyType: cty.ObjectWithOptionalAttrs(map[string]cty.Type{
+				"a": cty.String,
+				"b": cty.Number,
+			}, []string{"b"}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctyType, err := tfcty.ToCtyType(testCase.tfType)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !ctyType.Equals(testCase.ctyType) {
+				t.Errorf("expected go-cty type %#v, got %#v", testCase.ctyType, ctyType)
+			}
+
+			tfType, err := tfcty.FromCtyType(testCase.ctyType)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !tfType.Equal(testCase.tfType) {
+				t.Errorf("expected tftypes type %s, got %s", testCase.tfType, tfType)
+			}
+		})
+	}
+}
+
+func TestFromCtyType_capsule(t *testing.T) {
+	t.Parallel()
+
+	_, err := tfcty.FromCtyType(cty.Capsule("test", nil))
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
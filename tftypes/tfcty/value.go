@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcty
+
+import (
+	"math/big"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ToCtyValue returns the go-cty value equivalent to the tftypes.Value,
+// including the marks of the Value and its elements and attributes.
+func ToCtyValue(val tftypes.Value) (cty.Value, error) {
+	return toCtyValue(tftypes.NewAttributePath(), val)
+}
+
+func toCtyValue(path *tftypes.AttributePath, val tftypes.Value) (cty.Value, error) {
+	typ, err := ToCtyType(val.Type())
+	if err != nil {
+		return cty.NilVal, path.NewError(err)
+	}
+
+	result, err := toCtyUnmarkedValue(path, val, typ)
+	if err != nil {
+		return cty.NilVal, err
+	}
+
+	if marks := val.Marks(); len(marks) > 0 {
+		ctyMarks := make(cty.ValueMarks, len(marks))
+		for mark := range marks {
+			ctyMarks[mark] = struct{}{}
+		}
+		result = result.WithMarks(ctyMarks)
+	}
+
+	return result, nil
+}
+
+func toCtyUnmarkedValue(path *tftypes.AttributePath, val tftypes.Value, typ cty.Type) (cty.Value, error) {
+	if !val.IsKnown() {
+		return cty.UnknownVal(typ), nil
+	}
+
+	if val.IsNull() {
+		return cty.NullVal(typ), nil
+	}
+
+	switch {
+	case typ == cty.String:
+		var s string
+		if err := val.As(&s); err != nil {
+			return cty.NilVal, path.NewError(err)
+		}
+		return cty.StringVal(s), nil
+	case typ == cty.Number:
+		var n big.Float
+		if err := val.As(&n); err != nil {
+			return cty.NilVal, path.NewError(err)
+		}
+		return cty.NumberVal(&n), nil
+	case typ == cty.Bool:
+		var b bool
+		if err := val.As(&b); err != nil {
+			return cty.NilVal, path.NewError(err)
+		}
+		return cty.BoolVal(b), nil
+	case typ.IsListType(), typ.IsSetType(), typ.IsTupleType():
+		var elems []tftypes.Value
+		if err := val.As(&elems); err != nil {
+			return cty.NilVal, path.NewError(err)
+		}
+
+		ctyElems := make([]cty.Value, len(elems))
+		for pos, elem := range elems {
+			elementPath := path.WithElementKeyInt(pos)
+			if typ.IsSetType() {
+				elementPath = path.WithElementKeyValue(elem)
+			}
+
+			ctyElem, err := toCtyValue(elementPath, elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			ctyElems[pos] = ctyElem
+		}
+
+		switch {
+		case typ.IsTupleType():
+			return cty.TupleVal(ctyElems), nil
+		case len(ctyElems) == 0 && typ.IsListType():
+			return cty.ListValEmpty(typ.ElementType()), nil
+		case len(ctyElems) == 0:
+			return cty.SetValEmpty(typ.ElementType()), nil
+		}
+
+		if err := checkCtyElementTypes(path, ctyElems); err != nil {
+			return cty.NilVal, err
+		}
+
+		if typ.IsListType() {
+			return cty.ListVal(ctyElems), nil
+		}
+		return cty.SetVal(ctyElems), nil
+	case typ.IsMapType(), typ.IsObjectType():
+		var attrs map[string]tftypes.Value
+		if err := val.As(&attrs); err != nil {
+			return cty.NilVal, path.NewError(err)
+		}
+
+		ctyAttrs := make(map[string]cty.Value, len(attrs))
+		for name, attr := range attrs {
+			attributePath := path.WithElementKeyString(name)
+			if typ.IsObjectType() {
+				attributePath = path.WithAttributeName(name)
+			}
+
+			ctyAttr, err := toCtyValue(attributePath, attr)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			ctyAttrs[name] = ctyAttr
+		}
+
+		if typ.IsObjectType() {
+			// go-cty objects always have every attribute, so unset
+			// optional attributes are null.
+			for name, attributeType := range typ.AttributeTypes() {
+				if _, ok := ctyAttrs[name]; !ok {
+					ctyAttrs[name] = cty.NullVal(attributeType)
+				}
+			}
+			return cty.ObjectVal(ctyAttrs), nil
+		}
+
+		if len(ctyAttrs) == 0 {
+			return cty.MapValEmpty(typ.ElementType()), nil
+		}
+
+		elems := make([]cty.Value, 0, len(ctyAttrs))
+		for _, elem := range ctyAttrs {
+			elems = append(elems, elem)
+		}
+
+		if err := checkCtyElementTypes(path, elems); err != nil {
+			return cty.NilVal, err
+		}
+
+		return cty.MapVal(ctyAttrs), nil
+	}
+
+	return cty.NilVal, path.NewErrorf("unsupported type %s", val.Type())
+}
+
+// checkCtyElementTypes returns an error if the elements do not all have the
+// same type, as go-cty requires for the elements of Lists, Sets, and Maps.
+func checkCtyElementTypes(path *tftypes.AttributePath, elems []cty.Value) error {
+	for _, elem := range elems[1:] {
+		if !elem.Type().Equals(elems[0].Type()) {
+			return path.NewErrorf("go-cty collections must only contain one type of element, saw %s and %s", elems[0].Type().FriendlyName(), elem.Type().FriendlyName())
+		}
+	}
+
+	return nil
+}
+
+// FromCtyValue returns the tftypes.Value equivalent to the go-cty value,
+// including the marks of the value and its elements and attributes.
+// Refinements of unknown values are dropped.
+func FromCtyValue(val cty.Value) (tftypes.Value, error) {
+	return fromCtyValue(tftypes.NewAttributePath(), val)
+}
+
+func fromCtyValue(path *tftypes.AttributePath, val cty.Value) (tftypes.Value, error) {
+	val, marks := val.Unmark()
+
+	typ, err := FromCtyType(val.Type())
+	if err != nil {
+		return tftypes.Value{}, path.NewError(err)
+	}
+
+	result, err := fromCtyUnmarkedValue(path, val, typ)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	if len(marks) > 0 {
+		tfMarks := make([]interface{}, 0, len(marks))
+		for mark := range marks {
+			tfMarks = append(tfMarks, mark)
+		}
+		result = result.Mark(tfMarks...)
+	}
+
+	return result, nil
+}
+
+func fromCtyUnmarkedValue(path *tftypes.AttributePath, val cty.Value, typ tftypes.Type) (tftypes.Value, error) {
+	if !val.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	if val.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	var value interface{}
+
+	ctyType := val.Type()
+
+	switch {
+	case ctyType == cty.String:
+		value = val.AsString()
+	case ctyType == cty.Number:
+		value = val.AsBigFloat()
+	case ctyType == cty.Bool:
+		value = val.True()
+	case ctyType.IsListType(), ctyType.IsSetType(), ctyType.IsTupleType():
+		elems := make([]tftypes.Value, 0, val.LengthInt())
+		for it := val.ElementIterator(); it.Next(); {
+			_, ctyElem := it.Element()
+
+			// Set elements do not have a position, so report errors
+			// for them at the path of the Set.
+			elementPath := path
+			if !ctyType.IsSetType() {
+				elementPath = path.WithElementKeyInt(len(elems))
+			}
+
+			elem, err := fromCtyValue(elementPath, ctyElem)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			elems = append(elems, elem)
+		}
+		value = elems
+	case ctyType.IsMapType(), ctyType.IsObjectType():
+		attrs := make(map[string]tftypes.Value, val.LengthInt())
+		for it := val.ElementIterator(); it.Next(); {
+			ctyKey, ctyAttr := it.Element()
+			name := ctyKey.AsString()
+
+			attributePath := path.WithElementKeyString(name)
+			if ctyType.IsObjectType() {
+				attributePath = path.WithAttributeName(name)
+			}
+
+			attr, err := fromCtyValue(attributePath, ctyAttr)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			attrs[name] = attr
+		}
+		value = attrs
+	default:
+		return tftypes.Value{}, path.NewErrorf("unsupported type %s", ctyType.FriendlyName())
+	}
+
+	if err := tftypes.ValidateValue(typ, value); err != nil {
+		return tftypes.Value{}, path.NewError(err)
+	}
+
+	return tftypes.NewValue(typ, value), nil
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcty_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes/tfcty"
+)
+
+type testMark string
+
+const testMarkSensitive testMark = "sensitive"
+
+func TestValues(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":  tftypes.String,
+			"count": tftypes.Number,
+			"tags":  tftypes.Map{ElementType: tftypes.String},
+			"ids":   tftypes.Set{ElementType: tftypes.Number},
+		},
+	}
+
+	testCases := map[string]struct {
+		tfValue  tftypes.Value
+		ctyValue cty.Value
+	}{
+		"string": {
+			tfValue:  tftypes.NewValue(tftypes.String, "hello"),
+			ctyValue: cty.StringVal("hello"),
+		},
+		"number": {
+			tfValue:  tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+			ctyValue: cty.NumberFloatVal(1.5),
+		},
+		"bool": {
+			tfValue:  tftypes.NewValue(tftypes.Bool, true),
+			ctyValue: cty.True,
+		},
+		"null": {
+			tfValue:  tftypes.NewValue(tftypes.String, nil),
+			ctyValue: cty.NullVal(cty.String),
+		},
+		"unknown": {
+			tfValue:  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+			ctyValue: cty.UnknownVal(cty.List(cty.String)),
+		},
+		"dynamic-null": {
+			tfValue:  tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+			ctyValue: cty.NullVal(cty.DynamicPseudoType),
+		},
+		"list": {
+			tfValue: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			ctyValue: cty.ListVal([]cty.Value{
+				cty.StringVal("a"),
+				cty.UnknownVal(cty.String),
+			}),
+		},
+		"list-empty": {
+			tfValue:  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+			ctyValue: cty.ListValEmpty(cty.String),
+		},
+		"set-empty": {
+			tfValue:  tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+			ctyValue: cty.SetValEmpty(cty.String),
+		},
+		"map-empty": {
+			tfValue:  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{}),
+			ctyValue: cty.MapValEmpty(cty.String),
+		},
+		"tuple": {
+			tfValue: tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.Bool, nil),
+			}),
+			ctyValue: cty.TupleVal([]cty.Value{
+				cty.StringVal("a"),
+				cty.NullVal(cty.Bool),
+			}),
+		},
+		"object": {
+			tfValue: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, "test"),
+				"count": tftypes.NewValue(tftypes.Number, 2),
+				"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"env": tftypes.NewValue(tftypes.String, "prod"),
+				}),
+				"ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{
+					tftypes.NewValue(tftypes.Number, 1),
+					tftypes.NewValue(tftypes.Number, 2),
+				}),
+			}),
+			ctyValue: cty.ObjectVal(map[string]cty.Value{
+				"name":  cty.StringVal("test"),
+				"count": cty.NumberIntVal(2),
+				"tags": cty.MapVal(map[string]cty.Value{
+					"env": cty.StringVal("prod"),
+				}),
+				"ids": cty.SetVal([]cty.Value{
+					cty.NumberIntVal(1),
+					cty.NumberIntVal(2),
+				}),
+			}),
+		},
+		"marks": {
+			tfValue: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a").Mark(testMarkSensitive),
+				tftypes.NewValue(tftypes.String, "b"),
+			}).Mark(testMarkSensitive),
+			ctyValue: cty.ListVal([]cty.Value{
+				cty.StringVal("a").Mark(testMarkSensitive),
+				cty.StringVal("b"),
+			}).Mark(testMarkSensitive),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctyValue, err := tfcty.ToCtyValue(testCase.tfValue)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !ctyValue.RawEquals(testCase.ctyValue) {
+				t.Errorf("expected go-cty value %#v, got %#v", testCase.ctyValue, ctyValue)
+			}
+
+			tfValue, err := tfcty.FromCtyValue(testCase.ctyValue)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !tfValue.Equal(testCase.tfValue) {
+				t.Errorf("expected tftypes value %s, got %s", testCase.tfValue, tfValue)
+			}
+
+			_, expectedMarks := testCase.tfValue.UnmarkDeepWithPaths()
+			_, gotMarks := tfValue.UnmarkDeepWithPaths()
+			if diff := cmp.Diff(expectedMarks, gotMarks); diff != "" {
+				t.Errorf("unexpected difference in marks: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToCtyValue_optionalAttributes(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"a": tftypes.String,
+			"b": tftypes.Number,
+		},
+		OptionalAttributes: map[string]struct{}{
+			"b": {},
+		},
+	}
+
+	got, err := tfcty.ToCtyValue(tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"a": tftypes.NewValue(tftypes.String, "test"),
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := cty.ObjectVal(map[string]cty.Value{
+		"a": cty.StringVal("test"),
+		"b": cty.NullVal(cty.Number),
+	})
+	if !got.RawEquals(expected) {
+		t.Errorf("expected %#v, got %#v", expected, got)
+	}
+}
+
+func TestToCtyValue_dynamicElements(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"value": tftypes.DynamicPseudoType,
+		},
+	}
+	listType := tftypes.List{ElementType: objectType}
+
+	got, err := tfcty.ToCtyValue(tftypes.NewValue(listType, []tftypes.Value{
+		tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"value": tftypes.NewValue(tftypes.String, "a"),
+		}),
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := cty.ListVal([]cty.Value{
+		cty.ObjectVal(map[string]cty.Value{
+			"value": cty.StringVal("a"),
+		}),
+	})
+	if !got.RawEquals(expected) {
+		t.Errorf("expected %#v, got %#v", expected, got)
+	}
+
+	_, err = tfcty.ToCtyValue(tftypes.NewValue(listType, []tftypes.Value{
+		tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"value": tftypes.NewValue(tftypes.String, "a"),
+		}),
+		tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"value": tftypes.NewValue(tftypes.Number, 1),
+		}),
+	}))
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -13,6 +13,13 @@ import (
 // potentially of differing types. The number of elements and their types are
 // part of the type signature for the Tuple, and so two Tuples with different
 // numbers or types of elements are considered to be distinct types.
+//
+// The empty tuple type, which has no elements, must be declared as
+// Tuple{ElementTypes: []Type{}}. A Tuple without ElementTypes is an incomplete
+// type, which is only suitable for Is comparisons and is never Equal to
+// another type. As a convenience, NewValue completes the type of a known Tuple
+// value created without ElementTypes and without elements, so it is Equal to
+// the same value after a msgpack or JSON round trip.
 type Tuple struct {
 	ElementTypes []Type
 
@@ -134,6 +141,13 @@ func valueFromTuple(types []Type, in interface{}) (Value, error) {
 				}
 			}
 		}
+		// A Tuple without ElementTypes and without element values can
+		// only be the empty tuple, so ensure its type is complete and
+		// therefore Equal to the empty tuple type after encoding round
+		// trips.
+		if types == nil && len(value) == 0 {
+			types = []Type{}
+		}
 		return Value{
 			typ:   Tuple{ElementTypes: types},
 			value: value,
@@ -145,8 +159,6 @@ func valueFromTuple(types []Type, in interface{}) (Value, error) {
 
 // MarshalJSON returns a JSON representation of the full type signature of
 // `tu`, including the ElementTypes.
-//
-// Deprecated: this is not meant to be called by third-party code.
 func (tu Tuple) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 
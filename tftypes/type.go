@@ -40,12 +40,10 @@ type Type interface {
 	// String returns a string representation of the Type's name.
 	String() string
 
-	// MarshalJSON returns a JSON representation of the Type's signature.
-	// It is modeled based on Terraform's requirements for type signature
-	// JSON representations, and may change over time to match Terraform's
-	// formatting. The error return should always be nil.
-	//
-	// Deprecated: this is not meant to be called by third-party code.
+	// MarshalJSON returns a JSON representation of the Type's signature,
+	// using the same format as Terraform's type JSON representations, such as
+	// `["list","string"]`. The result can be parsed with ParseJSONType. The
+	// error return should always be nil.
 	MarshalJSON() ([]byte, error)
 
 	// private is meant to keep this interface from being implemented by
@@ -81,11 +79,10 @@ type jsonType struct {
 	t Type
 }
 
-// ParseJSONType returns a Type from its JSON representation. The JSON
-// representation should come from Terraform or from MarshalJSON as the format
-// is not part of this package's API guarantees.
-//
-// Deprecated: this is not meant to be called by third-party code.
+// ParseJSONType returns a Type from its JSON representation, as returned by
+// the Type's MarshalJSON method. This is the same format Terraform uses for
+// type JSON representations, such as the types in `terraform providers schema
+// -json` output, so types can be exchanged with tooling outside of Go.
 func ParseJSONType(buf []byte) (Type, error) {
 	var t jsonType
 	err := json.Unmarshal(buf, &t)
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"fmt"
+)
+
+// TypeMismatch explains why a type or Value is not usable as another type,
+// as returned by TypeMismatches and Value.TypeMismatches. It implements
+// error, so it can be returned to callers directly.
+type TypeMismatch struct {
+	// Path is the location of the mismatch, relative to the type or
+	// Value being checked.
+	Path *AttributePath
+
+	// Expected is the type required at Path, or nil if there is an
+	// object attribute at Path which is not part of the expected type.
+	Expected Type
+
+	// Actual is the type at Path, or nil if a required object attribute
+	// is missing at Path or a Value at Path is missing its type.
+	Actual Type
+}
+
+// Error returns a human-readable explanation of the mismatch, prefixed by
+// its path.
+func (m TypeMismatch) Error() string {
+	var path string
+	if len(m.Path.Steps()) > 0 {
+		path = m.Path.String() + ": "
+	}
+
+	switch {
+	case m.Expected == nil:
+		return fmt.Sprintf("%sunexpected attribute of type %s", path, m.Actual)
+	case m.Actual == nil:
+		return fmt.Sprintf("%smissing %s", path, m.Expected)
+	default:
+		return fmt.Sprintf("%scan't use %s as %s", path, m.Actual, m.Expected)
+	}
+}
+
+// TypeMismatches explains why `t` is not usable as `other`, returning a
+// TypeMismatch for every location where `t.UsableAs(other)` does not hold,
+// in depth-first order with object attributes sorted by name. It returns nil
+// if `t` is usable as `other`.
+//
+// Object attributes and Tuple elements are checked individually, so each
+// mismatched attribute or element is reported at its own path. List, Set, and
+// Map element types cannot be located by an AttributePath, so mismatched
+// element types are reported at the path of the collection. Use
+// Value.TypeMismatches to locate the mismatched elements of a Value.
+func TypeMismatches(t, other Type) []TypeMismatch {
+	return typeMismatches(NewAttributePath(), t, other)
+}
+
+func typeMismatches(path *AttributePath, t, other Type) []TypeMismatch {
+	if t == nil || other == nil {
+		return []TypeMismatch{{Path: path, Expected: other, Actual: t}}
+	}
+
+	if other.Is(DynamicPseudoType) {
+		return nil
+	}
+
+	switch typ := t.(type) {
+	case Object:
+		otherObject, ok := other.(Object)
+
+		if !ok {
+			break
+		}
+
+		var mismatches []TypeMismatch
+
+		for _, name := range sortedTypeKeys(typ.AttributeTypes) {
+			attributePath := path.WithAttributeName(name)
+			otherType, ok := otherObject.AttributeTypes[name]
+
+			if !ok {
+				mismatches = append(mismatches, TypeMismatch{Path: attributePath, Actual: typ.AttributeTypes[name]})
+				continue
+			}
+
+			mismatches = append(mismatches, typeMismatches(attributePath, typ.AttributeTypes[name], otherType)...)
+		}
+
+		for _, name := range sortedTypeKeys(otherObject.AttributeTypes) {
+			if _, ok := typ.AttributeTypes[name]; ok || otherObject.attrIsOptional(name) {
+				continue
+			}
+
+			mismatches = append(mismatches, TypeMismatch{Path: path.WithAttributeName(name), Expected: otherObject.AttributeTypes[name]})
+		}
+
+		return mismatches
+	case Tuple:
+		otherTuple, ok := other.(Tuple)
+
+		if !ok || len(typ.ElementTypes) != len(otherTuple.ElementTypes) {
+			break
+		}
+
+		var mismatches []TypeMismatch
+
+		for pos, elementType := range typ.ElementTypes {
+			mismatches = append(mismatches, typeMismatches(path.WithElementKeyInt(pos), elementType, otherTuple.ElementTypes[pos])...)
+		}
+
+		return mismatches
+	}
+
+	if t.UsableAs(other) {
+		return nil
+	}
+
+	return []TypeMismatch{{Path: path, Expected: other, Actual: t}}
+}
+
+// TypeMismatches explains why the Value is not usable as `t`, returning a
+// TypeMismatch for every location where the type of the Value, or of its
+// elements or attributes, is not usable as the type required there, in
+// depth-first order with map keys and object attributes sorted. It returns
+// nil if the Value's type is usable as `t`.
+//
+// Unlike the TypeMismatches function, the elements of known Lists, Sets, and
+// Maps are checked individually, so each mismatched element is reported at
+// its own path. Collections without mismatched elements, such as empty
+// collections, are reported at their own path.
+func (val Value) TypeMismatches(t Type) []TypeMismatch {
+	return valueTypeMismatches(NewAttributePath(), val, t)
+}
+
+func valueTypeMismatches(path *AttributePath, val Value, t Type) []TypeMismatch {
+	if val.Type() == nil || t == nil {
+		return []TypeMismatch{{Path: path, Expected: t, Actual: val.Type()}}
+	}
+
+	if val.Type().UsableAs(t) {
+		return nil
+	}
+
+	if !val.IsKnown() || val.IsNull() {
+		return typeMismatches(path, val.Type(), t)
+	}
+
+	var mismatches []TypeMismatch
+
+	switch typ := t.(type) {
+	case List:
+		elems, ok := val.value.([]Value)
+
+		if !ok || !val.Type().Is(List{}) {
+			break
+		}
+
+		for pos, elem := range elems {
+			mismatches = append(mismatches, valueTypeMismatches(path.WithElementKeyInt(pos), elem, typ.ElementType)...)
+		}
+	case Set:
+		elems, ok := val.value.([]Value)
+
+		if !ok || !val.Type().Is(Set{}) {
+			break
+		}
+
+		for _, elem := range elems {
+			mismatches = append(mismatches, valueTypeMismatches(path.WithElementKeyValue(elem), elem, typ.ElementType)...)
+		}
+	case Map:
+		elems, ok := val.value.(map[string]Value)
+
+		if !ok || !val.Type().Is(Map{}) {
+			break
+		}
+
+		for _, key := range sortedValueKeys(elems) {
+			mismatches = append(mismatches, valueTypeMismatches(path.WithElementKeyString(key), elems[key], typ.ElementType)...)
+		}
+	case Tuple:
+		elems, ok := val.value.([]Value)
+
+		if !ok || !val.Type().Is(Tuple{}) || len(elems) != len(typ.ElementTypes) {
+			break
+		}
+
+		for pos, elem := range elems {
+			mismatches = append(mismatches, valueTypeMismatches(path.WithElementKeyInt(pos), elem, typ.ElementTypes[pos])...)
+		}
+	case Object:
+		attrs, ok := val.value.(map[string]Value)
+
+		if !ok || !val.Type().Is(Object{}) {
+			break
+		}
+
+		for _, name := range sortedValueKeys(attrs) {
+			attributePath := path.WithAttributeName(name)
+			attributeType, ok := typ.AttributeTypes[name]
+
+			if !ok {
+				mismatches = append(mismatches, TypeMismatch{Path: attributePath, Actual: attrs[name].Type()})
+				continue
+			}
+
+			mismatches = append(mismatches, valueTypeMismatches(attributePath, attrs[name], attributeType)...)
+		}
+
+		for _, name := range sortedTypeKeys(typ.AttributeTypes) {
+			if _, ok := attrs[name]; ok || typ.attrIsOptional(name) {
+				continue
+			}
+
+			mismatches = append(mismatches, TypeMismatch{Path: path.WithAttributeName(name), Expected: typ.AttributeTypes[name]})
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return mismatches
+	}
+
+	return typeMismatches(path, val.Type(), t)
+}
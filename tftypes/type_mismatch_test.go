@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTypeMismatches(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		t        Type
+		other    Type
+		expected []string
+	}
+	tests := map[string]testCase{
+		"primitive-usable": {
+			t:     String,
+			other: String,
+		},
+		"primitive-dynamic": {
+			t:     String,
+			other: DynamicPseudoType,
+		},
+		"primitive": {
+			t:        String,
+			other:    Number,
+			expected: []string{"can't use tftypes.String as tftypes.Number"},
+		},
+		"kind": {
+			t:        List{ElementType: String},
+			other:    Set{ElementType: String},
+			expected: []string{"can't use tftypes.List[tftypes.String] as tftypes.Set[tftypes.String]"},
+		},
+		"list-element": {
+			t:        List{ElementType: Object{AttributeTypes: map[string]Type{"a": String}}},
+			other:    List{ElementType: Object{AttributeTypes: map[string]Type{"a": Number}}},
+			expected: []string{`can't use tftypes.List[tftypes.Object["a":tftypes.String]] as tftypes.List[tftypes.Object["a":tftypes.Number]]`},
+		},
+		"object-attributes": {
+			t: Object{AttributeTypes: map[string]Type{
+				"a":     String,
+				"b":     Number,
+				"extra": Bool,
+				"nested": Object{AttributeTypes: map[string]Type{
+					"c": Bool,
+				}},
+			}},
+			other: Object{
+				AttributeTypes: map[string]Type{
+					"a":        String,
+					"b":        Bool,
+					"missing":  String,
+					"optional": String,
+					"nested": Object{AttributeTypes: map[string]Type{
+						"c": String,
+					}},
+				},
+				OptionalAttributes: map[string]struct{}{
+					"optional": {},
+				},
+			},
+			expected: []string{
+				`AttributeName("b"): can't use tftypes.Number as tftypes.Bool`,
+				`AttributeName("extra"): unexpected attribute of type tftypes.Bool`,
+				`AttributeName("nested").AttributeName("c"): can't use tftypes.Bool as tftypes.String`,
+				`AttributeName("missing"): missing tftypes.String`,
+			},
+		},
+		"tuple-elements": {
+			t:     Tuple{ElementTypes: []Type{String, Number, Bool}},
+			other: Tuple{ElementTypes: []Type{String, String, DynamicPseudoType}},
+			expected: []string{
+				`ElementKeyInt(1): can't use tftypes.Number as tftypes.String`,
+			},
+		},
+		"tuple-length": {
+			t:        Tuple{ElementTypes: []Type{String}},
+			other:    Tuple{ElementTypes: []Type{String, String}},
+			expected: []string{`can't use tftypes.Tuple[tftypes.String] as tftypes.Tuple[tftypes.String, tftypes.String]`},
+		},
+		"dynamic": {
+			t:        DynamicPseudoType,
+			other:    String,
+			expected: []string{`can't use tftypes.DynamicPseudoType as tftypes.String`},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mismatches := TypeMismatches(test.t, test.other)
+
+			var got []string
+			for _, mismatch := range mismatches {
+				got = append(got, mismatch.Error())
+			}
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+			}
+
+			if usable := test.t.UsableAs(test.other); usable != (len(mismatches) == 0) {
+				t.Errorf("expected mismatches to be consistent with UsableAs %t, got %d mismatches", usable, len(mismatches))
+			}
+		})
+	}
+}
+
+func TestTypeMismatches_fields(t *testing.T) {
+	t.Parallel()
+
+	got := TypeMismatches(
+		Object{AttributeTypes: map[string]Type{"a": String, "b": Bool}},
+		Object{AttributeTypes: map[string]Type{"a": Number, "c": Bool}},
+	)
+	expected := []TypeMismatch{
+		{
+			Path:     NewAttributePath().WithAttributeName("a"),
+			Expected: Number,
+			Actual:   String,
+		},
+		{
+			Path:   NewAttributePath().WithAttributeName("b"),
+			Actual: Bool,
+		},
+		{
+			Path:     NewAttributePath().WithAttributeName("c"),
+			Expected: Bool,
+		},
+	}
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+	}
+}
+
+func TestValueTypeMismatches(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val      Value
+		t        Type
+		expected []string
+	}
+	tests := map[string]testCase{
+		"usable": {
+			val: NewValue(List{ElementType: String}, []Value{NewValue(String, "a")}),
+			t:   List{ElementType: String},
+		},
+		"primitive": {
+			val:      NewValue(String, "a"),
+			t:        Number,
+			expected: []string{"can't use tftypes.String as tftypes.Number"},
+		},
+		"list-elements": {
+			val: NewValue(List{ElementType: Object{AttributeTypes: map[string]Type{"a": String}}}, []Value{
+				NewValue(Object{AttributeTypes: map[string]Type{"a": String}}, map[string]Value{
+					"a": NewValue(String, "x"),
+				}),
+				NewValue(Object{AttributeTypes: map[string]Type{"a": String}}, map[string]Value{
+					"a": NewValue(String, "y"),
+				}),
+			}),
+			t: List{ElementType: Object{AttributeTypes: map[string]Type{"a": Bool}}},
+			expected: []string{
+				`ElementKeyInt(0).AttributeName("a"): can't use tftypes.String as tftypes.Bool`,
+				`ElementKeyInt(1).AttributeName("a"): can't use tftypes.String as tftypes.Bool`,
+			},
+		},
+		"list-empty": {
+			val:      NewValue(List{ElementType: String}, []Value{}),
+			t:        List{ElementType: Number},
+			expected: []string{`can't use tftypes.List[tftypes.String] as tftypes.List[tftypes.Number]`},
+		},
+		"list-null": {
+			val:      NewValue(List{ElementType: String}, nil),
+			t:        List{ElementType: Number},
+			expected: []string{`can't use tftypes.List[tftypes.String] as tftypes.List[tftypes.Number]`},
+		},
+		"set-elements": {
+			val: NewValue(Set{ElementType: String}, []Value{
+				NewValue(String, "a"),
+			}),
+			t:        Set{ElementType: Bool},
+			expected: []string{`ElementKeyValue(tftypes.String<"a">): can't use tftypes.String as tftypes.Bool`},
+		},
+		"map-elements": {
+			val: NewValue(Map{ElementType: String}, map[string]Value{
+				"b": NewValue(String, "2"),
+				"a": NewValue(String, "1"),
+			}),
+			t: Map{ElementType: Number},
+			expected: []string{
+				`ElementKeyString("a"): can't use tftypes.String as tftypes.Number`,
+				`ElementKeyString("b"): can't use tftypes.String as tftypes.Number`,
+			},
+		},
+		"tuple-elements": {
+			val: NewValue(Tuple{ElementTypes: []Type{String, Number}}, []Value{
+				NewValue(String, "a"),
+				NewValue(Number, 1),
+			}),
+			t:        Tuple{ElementTypes: []Type{String, String}},
+			expected: []string{`ElementKeyInt(1): can't use tftypes.Number as tftypes.String`},
+		},
+		"object-attributes": {
+			val: NewValue(Object{AttributeTypes: map[string]Type{"a": String, "extra": Bool}}, map[string]Value{
+				"a":     NewValue(String, "x"),
+				"extra": NewValue(Bool, true),
+			}),
+			t: Object{AttributeTypes: map[string]Type{"a": Number, "missing": String}},
+			expected: []string{
+				`AttributeName("a"): can't use tftypes.String as tftypes.Number`,
+				`AttributeName("extra"): unexpected attribute of type tftypes.Bool`,
+				`AttributeName("missing"): missing tftypes.String`,
+			},
+		},
+		"zero-value": {
+			val:      Value{},
+			t:        String,
+			expected: []string{`missing tftypes.String`},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, mismatch := range test.val.TypeMismatches(test.t) {
+				got = append(got, mismatch.Error())
+			}
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseType returns the Type described by the given string in the type
+// constraint syntax of Terraform configuration, such as
+// `list(object({name=string, tags=map(string)}))`. This allows types to be
+// declared concisely in tests and configuration-driven tooling.
+//
+// The primitive types are `string`, `number`, and `bool`, and `any` is
+// DynamicPseudoType. Collection types are written as `list(T)`, `set(T)`,
+// and `map(T)`, tuples as `tuple([T, ...])`, and objects as
+// `object({name = T, ...})`, where attribute names may be quoted and
+// attributes may be separated by commas or newlines. Object attributes
+// declared as `optional(T)` are added to the Object's OptionalAttributes;
+// default values are not supported.
+func ParseType(s string) (Type, error) {
+	p := &typeParser{s: s}
+
+	typ, err := p.parseType()
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+
+	if p.pos < len(p.s) {
+		return nil, p.errorf("unexpected %q after type", p.s[p.pos])
+	}
+
+	return typ, nil
+}
+
+// typeParser holds the state of ParseType.
+type typeParser struct {
+	s   string
+	pos int
+}
+
+func (p *typeParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid type %q: %s at position %d", p.s, fmt.Sprintf(format, args...), p.pos)
+}
+
+func (p *typeParser) skipSpace() {
+	for p.pos < len(p.s) && unicode.IsSpace(rune(p.s[p.pos])) {
+		p.pos++
+	}
+}
+
+// consume skips whitespace and then the given character, returning whether
+// the character was found.
+func (p *typeParser) consume(c byte) bool {
+	p.skipSpace()
+
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+func (p *typeParser) expect(c byte) error {
+	if !p.consume(c) {
+		return p.errorf("expected %q", c)
+	}
+
+	return nil
+}
+
+// skipSeparators skips whitespace and commas between tuple elements and
+// object attributes.
+func (p *typeParser) skipSeparators() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ',' || unicode.IsSpace(rune(p.s[p.pos]))) {
+		p.pos++
+	}
+}
+
+func (p *typeParser) parseIdentifier() string {
+	p.skipSpace()
+
+	start := p.pos
+
+	for p.pos < len(p.s) && (p.s[p.pos] == '_' || p.s[p.pos] == '-' || unicode.IsLetter(rune(p.s[p.pos])) || unicode.IsDigit(rune(p.s[p.pos]))) {
+		p.pos++
+	}
+
+	return p.s[start:p.pos]
+}
+
+func (p *typeParser) parseType() (Type, error) {
+	start := p.pos
+	name := p.parseIdentifier()
+
+	switch name {
+	case "string":
+		return String, nil
+	case "number":
+		return Number, nil
+	case "bool":
+		return Bool, nil
+	case "any":
+		return DynamicPseudoType, nil
+	case "list", "set", "map":
+		elementType, err := p.parseTypeArgument()
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch name {
+		case "list":
+			return List{ElementType: elementType}, nil
+		case "set":
+			return Set{ElementType: elementType}, nil
+		default:
+			return Map{ElementType: elementType}, nil
+		}
+	case "tuple":
+		return p.parseTuple()
+	case "object":
+		return p.parseObject()
+	case "optional":
+		p.pos = start
+		return nil, p.errorf("optional is only valid for object attributes")
+	case "":
+		if p.pos < len(p.s) {
+			return nil, p.errorf("unexpected %q, expected type", p.s[p.pos])
+		}
+
+		return nil, p.errorf("expected type")
+	}
+
+	p.pos = start
+	p.skipSpace()
+
+	return nil, p.errorf("unknown type %q", name)
+}
+
+// parseTypeArgument parses the parenthesized type of a collection type or
+// optional attribute.
+func (p *typeParser) parseTypeArgument() (Type, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+
+	typ, err := p.parseType()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if p.consume(',') {
+		return nil, p.errorf("unexpected additional argument")
+	}
+
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+
+	return typ, nil
+}
+
+func (p *typeParser) parseTuple() (Type, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+
+	if err := p.expect('['); err != nil {
+		return nil, err
+	}
+
+	elementTypes := []Type{}
+
+	for p.skipSeparators(); !p.consume(']'); p.skipSeparators() {
+		elementType, err := p.parseType()
+
+		if err != nil {
+			return nil, err
+		}
+
+		elementTypes = append(elementTypes, elementType)
+	}
+
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+
+	return Tuple{ElementTypes: elementTypes}, nil
+}
+
+func (p *typeParser) parseObject() (Type, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+
+	if err := p.expect('{'); err != nil {
+		return nil, err
+	}
+
+	attributeTypes := map[string]Type{}
+	optionalAttributes := map[string]struct{}{}
+
+	for p.skipSeparators(); !p.consume('}'); p.skipSeparators() {
+		start := p.pos
+		name, err := p.parseAttributeName()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := attributeTypes[name]; ok {
+			p.pos = start
+			p.skipSpace()
+
+			return nil, p.errorf("duplicate attribute %q", name)
+		}
+
+		if !p.consume('=') && !p.consume(':') {
+			return nil, p.errorf("expected \"=\" after attribute name")
+		}
+
+		p.skipSpace()
+		typeStart := p.pos
+
+		if p.parseIdentifier() == "optional" {
+			attributeType, err := p.parseTypeArgument()
+
+			if err != nil {
+				return nil, err
+			}
+
+			attributeTypes[name] = attributeType
+			optionalAttributes[name] = struct{}{}
+
+			continue
+		}
+
+		p.pos = typeStart
+		attributeType, err := p.parseType()
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributeTypes[name] = attributeType
+	}
+
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+
+	if len(optionalAttributes) == 0 {
+		return Object{AttributeTypes: attributeTypes}, nil
+	}
+
+	return Object{AttributeTypes: attributeTypes, OptionalAttributes: optionalAttributes}, nil
+}
+
+func (p *typeParser) parseAttributeName() (string, error) {
+	p.skipSpace()
+
+	if p.pos >= len(p.s) || p.s[p.pos] != '"' {
+		name := p.parseIdentifier()
+
+		if name == "" {
+			return "", p.errorf("expected attribute name")
+		}
+
+		return name, nil
+	}
+
+	quoted, err := strconv.QuotedPrefix(p.s[p.pos:])
+
+	if err != nil {
+		return "", p.errorf("invalid quoted attribute name: %s", err)
+	}
+
+	name, err := strconv.Unquote(quoted)
+
+	if err != nil {
+		return "", p.errorf("invalid quoted attribute name: %s", err)
+	}
+
+	p.pos += len(quoted)
+
+	if strings.TrimSpace(name) == "" {
+		return "", p.errorf("expected attribute name")
+	}
+
+	return name, nil
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseType(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		in            string
+		expected      Type
+		expectedError string
+	}
+
+	tests := map[string]testCase{
+		"string": {
+			in:       "string",
+			expected: String,
+		},
+		"number": {
+			in:       " number ",
+			expected: Number,
+		},
+		"bool": {
+			in:       "bool",
+			expected: Bool,
+		},
+		"any": {
+			in:       "any",
+			expected: DynamicPseudoType,
+		},
+		"list": {
+			in:       "list(string)",
+			expected: List{ElementType: String},
+		},
+		"set": {
+			in:       "set( number )",
+			expected: Set{ElementType: Number},
+		},
+		"map": {
+			in:       "map(list(bool))",
+			expected: Map{ElementType: List{ElementType: Bool}},
+		},
+		"tuple": {
+			in:       "tuple([string, number, any,])",
+			expected: Tuple{ElementTypes: []Type{String, Number, DynamicPseudoType}},
+		},
+		"tuple-empty": {
+			in:       "tuple([])",
+			expected: Tuple{ElementTypes: []Type{}},
+		},
+		"object": {
+			in: "list(object({name=string, tags=map(string)}))",
+			expected: List{ElementType: Object{AttributeTypes: map[string]Type{
+				"name": String,
+				"tags": Map{ElementType: String},
+			}}},
+		},
+		"object-empty": {
+			in:       "object({})",
+			expected: Object{AttributeTypes: map[string]Type{}},
+		},
+		"object-multiline": {
+			in: `object({
+				"quoted name" = string
+				nested: object({
+					id = optional(number)
+				})
+			})`,
+			expected: Object{AttributeTypes: map[string]Type{
+				"quoted name": String,
+				"nested": Object{
+					AttributeTypes:     map[string]Type{"id": Number},
+					OptionalAttributes: map[string]struct{}{"id": {}},
+				},
+			}},
+		},
+		"empty": {
+			in:            "",
+			expectedError: `invalid type "": expected type at position 0`,
+		},
+		"unknown-type": {
+			in:            "list(strings)",
+			expectedError: `invalid type "list(strings)": unknown type "strings" at position 5`,
+		},
+		"unterminated": {
+			in:            "map(string",
+			expectedError: `invalid type "map(string": expected ')' at position 10`,
+		},
+		"trailing": {
+			in:            "string string",
+			expectedError: `invalid type "string string": unexpected 's' after type at position 7`,
+		},
+		"extra-argument": {
+			in:            "list(string, number)",
+			expectedError: `invalid type "list(string, number)": unexpected additional argument at position 12`,
+		},
+		"optional-outside-object": {
+			in:            "list(optional(string))",
+			expectedError: `invalid type "list(optional(string))": optional is only valid for object attributes at position 5`,
+		},
+		"optional-default": {
+			in:            `object({name=optional(string, "default")})`,
+			expectedError: `invalid type "object({name=optional(string, \"default\")})": unexpected additional argument at position 29`,
+		},
+		"duplicate-attribute": {
+			in:            "object({name=string, name=number})",
+			expectedError: `invalid type "object({name=string, name=number})": duplicate attribute "name" at position 21`,
+		},
+		"missing-equals": {
+			in:            "object({name})",
+			expectedError: `invalid type "object({name})": expected "=" after attribute name at position 12`,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseType(test.in)
+
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+					t.Fatalf("Unexpected error (-wanted, +got): %s", diff)
+				}
+
+				return
+			}
+
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+
+			if !got.Equal(test.expected) {
+				t.Errorf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
@@ -7,11 +7,10 @@ import (
 	"bytes"
 	"fmt"
 	"math/big"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
-
-	msgpack "github.com/vmihailenco/msgpack/v5"
 )
 
 // ValueConverter is an interface that provider-defined types can implement to
@@ -44,6 +43,12 @@ type ValueCreator interface {
 type Value struct {
 	typ   Type
 	value interface{}
+
+	// marks are the marks of the Value, which are not part of its
+	// value. See Value.Mark. They are never modified once set, so they
+	// can be shared between Values, and are stored as a pointer to keep
+	// Value comparable.
+	marks *ValueMarks
 }
 
 func (val Value) String() string {
@@ -229,11 +234,14 @@ func (val Value) Equal(o Value) bool {
 }
 
 // Copy returns a defensively-copied clone of Value that shares no underlying
-// data structures with the original Value and can be mutated without
+// data structures with the original Value, including the elements of
+// collections and the *big.Float of Numbers, and can be mutated without
 // accidentally mutating the original.
 func (val Value) Copy() Value {
 	newVal := val.value
 	switch v := val.value.(type) {
+	case *big.Float:
+		newVal = new(big.Float).Copy(v)
 	case []Value:
 		newVals := make([]Value, 0, len(v))
 		for _, value := range v {
@@ -247,7 +255,9 @@ func (val Value) Copy() Value {
 		}
 		newVal = newVals
 	}
-	return NewValue(val.Type(), newVal)
+	result := NewValue(val.Type(), newVal)
+	result.marks = val.marks
+	return result
 }
 
 // NewValue returns a Value constructed using the specified Type and stores the
@@ -402,6 +412,33 @@ func newValue(t Type, val interface{}) (Value, error) {
 // it's a pointer to a []Value, if the Value is null, the []Value will be set
 // to an empty slice.
 //
+// For any other type, `dst` must be a non-nil pointer and the Value is
+// converted using reflection:
+//
+//   - Strings can be converted into string kinds, Bools into bool kinds,
+//     and Numbers into big.Float values and integer and floating point
+//     kinds, returning an error if the number cannot be represented exactly
+//     by an integer kind or overflows the kind.
+//   - Lists, Sets, and Tuples can be converted into slices, converting each
+//     element into the slice's element type.
+//   - Maps and Objects can be converted into maps with string keys,
+//     converting each element or attribute into the map's element type.
+//   - Objects can be converted into structs. Each exported field with a
+//     `tftypes:"name"` struct tag is set to the object attribute of that
+//     name, and an error is returned if the attribute does not exist.
+//     Untagged fields and fields tagged `tftypes:"-"` are left unchanged, as
+//     are object attributes without a field.
+//   - Pointers are set to nil for null Values, otherwise they are allocated if
+//     needed and the Value is converted into the type they point to. Other
+//     types are set to their zero value for null Values.
+//   - Values nested in the Value are converted with their FromTerraform5Value
+//     method if their type or a pointer to their type implements
+//     ValueConverter, and are set directly if their type is Value, which
+//     allows unknown values to be kept.
+//
+// Errors for nested Values are AttributePathErrors indicating the Value which
+// could not be converted.
+//
 // Future builtin conversions may be added over time.
 //
 // If `val` is unknown, an error will be returned, as unknown values can't be
@@ -521,6 +558,10 @@ func (val Value) As(dst interface{}) error {
 		}
 		return val.As(*target)
 	}
+	target := reflect.ValueOf(dst)
+	if target.Kind() == reflect.Ptr && !target.IsNil() {
+		return valueAsReflect(val, target.Elem(), NewAttributePath())
+	}
 	return fmt.Errorf("can't unmarshal into %T, needs FromTerraform5Value method", dst)
 }
 
@@ -569,6 +610,57 @@ func (val Value) IsFullyKnown() bool {
 	panic(fmt.Sprintf("unknown type %T", val.Type()))
 }
 
+// UnknownPaths returns the AttributePaths of the unknown Values within `val`,
+// so providers can report exactly which attributes or elements are unknown,
+// such as when a configuration requires known values during plan. An empty
+// AttributePath is returned if `val` itself is unknown, and nil is returned if
+// `val` is fully known, as reported by IsFullyKnown.
+//
+// The paths are in depth-first order. Elements of Lists, Sets, and Tuples are
+// in the order of their Value, and elements of Maps and attributes of
+// Objects are sorted by their keys, so the result is stable across calls.
+// Elements of Sets are identified by their Value, as in Walk.
+func (val Value) UnknownPaths() []*AttributePath {
+	return appendUnknownPaths(nil, NewAttributePath(), val)
+}
+
+// appendUnknownPaths appends the AttributePaths of the unknown Values within
+// `val`, which is at `path`, to `paths`.
+func appendUnknownPaths(paths []*AttributePath, path *AttributePath, val Value) []*AttributePath {
+	if !val.IsKnown() {
+		return append(paths, path)
+	}
+
+	switch v := val.value.(type) {
+	case []Value:
+		_, isSet := val.Type().(Set)
+		for pos, el := range v {
+			if isSet {
+				paths = appendUnknownPaths(paths, path.WithElementKeyValue(el), el)
+			} else {
+				paths = appendUnknownPaths(paths, path.WithElementKeyInt(pos), el)
+			}
+		}
+	case map[string]Value:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		_, isObject := val.Type().(Object)
+		for _, k := range keys {
+			if isObject {
+				paths = appendUnknownPaths(paths, path.WithAttributeName(k), v[k])
+			} else {
+				paths = appendUnknownPaths(paths, path.WithElementKeyString(k), v[k])
+			}
+		}
+	}
+
+	return paths
+}
+
 // IsNull returns true if the Value is null.
 func (val Value) IsNull() bool {
 	return val.value == nil
@@ -579,14 +671,20 @@ func (val Value) IsNull() bool {
 //
 // Deprecated: this is not meant to be called by third parties. Don't use it.
 func (val Value) MarshalMsgPack(t Type) ([]byte, error) {
-	var buf bytes.Buffer
-	enc := msgpack.NewEncoder(&buf)
+	buf := getBuffer()
+	defer putBuffer(buf)
 
-	err := marshalMsgPack(val, t, NewAttributePath(), enc)
+	err := encodeMsgPackBuffer(buf, val, t)
 	if err != nil {
 		return nil, err
 	}
-	return buf.Bytes(), nil
+	return bytes.Clone(buf.Bytes()), nil
+}
+
+// ToJSON returns the JSON encoding of the Value, using `t` to determine how
+// the Value should be encoded. It is equivalent to ValueToJSON.
+func (val Value) ToJSON(t Type) ([]byte, error) {
+	return ValueToJSON(val, t)
 }
 
 func unexpectedValueTypeError(p *AttributePath, expected, got interface{}, typ Type) error {
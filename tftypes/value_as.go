@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+var (
+	bigFloatType       = reflect.TypeOf(big.Float{})
+	valueType          = reflect.TypeOf(Value{})
+	valueConverterType = reflect.TypeOf((*ValueConverter)(nil)).Elem()
+)
+
+// valueAsReflect implements the reflection-based conversions of Value.As,
+// setting target, which must be settable, to val. The path is the path of val
+// within the Value being converted, used to annotate errors.
+func valueAsReflect(val Value, target reflect.Value, path *AttributePath) error {
+	if target.CanAddr() && target.Addr().Type().Implements(valueConverterType) {
+		//nolint:forcetypeassert // Implements check above guarantees this type assertion
+		return annotateValueAsError(path, target.Addr().Interface().(ValueConverter).FromTerraform5Value(val))
+	}
+
+	if target.Kind() == reflect.Ptr && target.Type().Implements(valueConverterType) {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+
+		//nolint:forcetypeassert // Implements check above guarantees this type assertion
+		return annotateValueAsError(path, target.Interface().(ValueConverter).FromTerraform5Value(val))
+	}
+
+	if target.Type() == valueType {
+		target.Set(reflect.ValueOf(val))
+		return nil
+	}
+
+	if !val.IsKnown() {
+		return path.NewErrorf("unmarshaling unknown values is not supported")
+	}
+
+	if target.Kind() == reflect.Ptr {
+		if val.IsNull() {
+			target.Set(reflect.Zero(target.Type()))
+			return nil
+		}
+
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+
+		return valueAsReflect(val, target.Elem(), path)
+	}
+
+	if val.IsNull() {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+
+	if target.Type() == bigFloatType {
+		v, ok := val.value.(*big.Float)
+		if !ok {
+			return valueAsMismatchError(val, target, path)
+		}
+		target.Addr().Interface().(*big.Float).Copy(v) //nolint:forcetypeassert // type check above guarantees this type assertion
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.String:
+		v, ok := val.value.(string)
+		if !ok {
+			return valueAsMismatchError(val, target, path)
+		}
+		target.SetString(v)
+		return nil
+	case reflect.Bool:
+		v, ok := val.value.(bool)
+		if !ok {
+			return valueAsMismatchError(val, target, path)
+		}
+		target.SetBool(v)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, ok := val.value.(*big.Float)
+		if !ok {
+			return valueAsMismatchError(val, target, path)
+		}
+		i, acc := v.Int64()
+		if !v.IsInt() || acc != big.Exact || target.OverflowInt(i) {
+			return path.NewErrorf("can't unmarshal %s into %s, value %s cannot be represented", val.Type(), target.Type(), v.Text('f', -1))
+		}
+		target.SetInt(i)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, ok := val.value.(*big.Float)
+		if !ok {
+			return valueAsMismatchError(val, target, path)
+		}
+		u, acc := v.Uint64()
+		if !v.IsInt() || acc != big.Exact || target.OverflowUint(u) {
+			return path.NewErrorf("can't unmarshal %s into %s, value %s cannot be represented", val.Type(), target.Type(), v.Text('f', -1))
+		}
+		target.SetUint(u)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		v, ok := val.value.(*big.Float)
+		if !ok {
+			return valueAsMismatchError(val, target, path)
+		}
+		f, _ := v.Float64()
+		if target.OverflowFloat(f) {
+			return path.NewErrorf("can't unmarshal %s into %s, value %s cannot be represented", val.Type(), target.Type(), v.Text('f', -1))
+		}
+		target.SetFloat(f)
+		return nil
+	case reflect.Slice:
+		v, ok := val.value.([]Value)
+		if !ok {
+			return valueAsMismatchError(val, target, path)
+		}
+		slice := reflect.MakeSlice(target.Type(), len(v), len(v))
+		for pos, elem := range v {
+			elemPath := path.WithElementKeyInt(pos)
+			if val.Type().Is(Set{}) {
+				elemPath = path.WithElementKeyValue(elem)
+			}
+			if err := valueAsReflect(elem, slice.Index(pos), elemPath); err != nil {
+				return err
+			}
+		}
+		target.Set(slice)
+		return nil
+	case reflect.Map:
+		v, ok := val.value.(map[string]Value)
+		if !ok || target.Type().Key().Kind() != reflect.String {
+			return valueAsMismatchError(val, target, path)
+		}
+		m := reflect.MakeMapWithSize(target.Type(), len(v))
+		for key, elem := range v {
+			elemPath := path.WithElementKeyString(key)
+			if val.Type().Is(Object{}) {
+				elemPath = path.WithAttributeName(key)
+			}
+			mapElem := reflect.New(target.Type().Elem()).Elem()
+			if err := valueAsReflect(elem, mapElem, elemPath); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(key).Convert(target.Type().Key()), mapElem)
+		}
+		target.Set(m)
+		return nil
+	case reflect.Struct:
+		v, ok := val.value.(map[string]Value)
+		if !ok || !val.Type().Is(Object{}) {
+			return valueAsMismatchError(val, target, path)
+		}
+		for i := 0; i < target.NumField(); i++ {
+			name := valueAsFieldName(target.Type().Field(i))
+			if name == "" {
+				continue
+			}
+			attr, ok := v[name]
+			if !ok {
+				return path.WithAttributeName(name).NewErrorf("can't unmarshal %s into %s, object has no attribute %q for field %s", val.Type(), target.Type(), name, target.Type().Field(i).Name)
+			}
+			if err := valueAsReflect(attr, target.Field(i), path.WithAttributeName(name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return valueAsMismatchError(val, target, path)
+}
+
+// valueAsFieldName returns the object attribute name of a struct field from
+// its `tftypes` struct tag, or an empty string if the field is unexported,
+// untagged, or tagged with "-".
+func valueAsFieldName(field reflect.StructField) string {
+	if field.PkgPath != "" {
+		return ""
+	}
+
+	name, _, _ := strings.Cut(field.Tag.Get("tftypes"), ",")
+
+	if name == "-" {
+		return ""
+	}
+
+	return name
+}
+
+func valueAsMismatchError(val Value, target reflect.Value, path *AttributePath) error {
+	return path.NewErrorf("can't unmarshal %s into %s", val.Type(), target.Type())
+}
+
+// annotateValueAsError returns err as an AttributePathError for path, unless
+// err is nil.
+func annotateValueAsError(path *AttributePath, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return path.NewError(err)
+}
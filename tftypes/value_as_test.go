@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type valueAsTestConverter struct {
+	value string
+}
+
+func (c *valueAsTestConverter) FromTerraform5Value(val Value) error {
+	return val.As(&c.value)
+}
+
+type valueAsTestString string
+
+type valueAsTestNested struct {
+	Name *string `tftypes:"name"`
+}
+
+type valueAsTestStruct struct {
+	String    string               `tftypes:"string"`
+	Int       int64                `tftypes:"int"`
+	Uint      uint8                `tftypes:"uint"`
+	Float     float64              `tftypes:"float"`
+	BigFloat  *big.Float           `tftypes:"big_float"`
+	Bool      *bool                `tftypes:"bool"`
+	List      []string             `tftypes:"list"`
+	Map       map[string]int       `tftypes:"map"`
+	Nested    *valueAsTestNested   `tftypes:"nested"`
+	Objects   []valueAsTestNested  `tftypes:"objects"`
+	Converter valueAsTestConverter `tftypes:"converter"`
+	Raw       Value                `tftypes:"raw"`
+	Ignored   string               `tftypes:"-"`
+	Untagged  string
+	Extra     map[string]*valueAsTestNested `tftypes:"extra"`
+}
+
+func TestValueAs_Reflect(t *testing.T) {
+	t.Parallel()
+
+	nestedType := Object{AttributeTypes: map[string]Type{"name": String}}
+	objectType := Object{
+		AttributeTypes: map[string]Type{
+			"string":    String,
+			"int":       Number,
+			"uint":      Number,
+			"float":     Number,
+			"big_float": Number,
+			"bool":      Bool,
+			"list":      List{ElementType: String},
+			"map":       Map{ElementType: Number},
+			"nested":    nestedType,
+			"objects":   Set{ElementType: nestedType},
+			"converter": String,
+			"raw":       String,
+			"extra":     Map{ElementType: nestedType},
+			"unused":    String,
+		},
+	}
+	strPointer := func(in string) *string {
+		return &in
+	}
+	boolPointer := func(in bool) *bool {
+		return &in
+	}
+
+	type testCase struct {
+		in            Value
+		as            func() interface{}
+		expected      interface{}
+		expectedError string
+	}
+
+	tests := map[string]testCase{
+		"struct": {
+			in: NewValue(objectType, map[string]Value{
+				"string":    NewValue(String, "hello"),
+				"int":       NewValue(Number, -123),
+				"uint":      NewValue(Number, 255),
+				"float":     NewValue(Number, 1.5),
+				"big_float": NewValue(Number, 2.5),
+				"bool":      NewValue(Bool, true),
+				"list": NewValue(List{ElementType: String}, []Value{
+					NewValue(String, "a"),
+					NewValue(String, "b"),
+				}),
+				"map": NewValue(Map{ElementType: Number}, map[string]Value{
+					"one": NewValue(Number, 1),
+				}),
+				"nested": NewValue(nestedType, map[string]Value{
+					"name": NewValue(String, "nested"),
+				}),
+				"objects": NewValue(Set{ElementType: nestedType}, []Value{
+					NewValue(nestedType, map[string]Value{
+						"name": NewValue(String, nil),
+					}),
+				}),
+				"converter": NewValue(String, "converted"),
+				"raw":       NewValue(String, UnknownValue),
+				"extra": NewValue(Map{ElementType: nestedType}, map[string]Value{
+					"null": NewValue(nestedType, nil),
+				}),
+				"unused": NewValue(String, "unused"),
+			}),
+			as: func() interface{} {
+				return &valueAsTestStruct{
+					Ignored:  "ignored",
+					Untagged: "untagged",
+				}
+			},
+			expected: &valueAsTestStruct{
+				String:    "hello",
+				Int:       -123,
+				Uint:      255,
+				Float:     1.5,
+				BigFloat:  big.NewFloat(2.5),
+				Bool:      boolPointer(true),
+				List:      []string{"a", "b"},
+				Map:       map[string]int{"one": 1},
+				Nested:    &valueAsTestNested{Name: strPointer("nested")},
+				Objects:   []valueAsTestNested{{Name: nil}},
+				Converter: valueAsTestConverter{value: "converted"},
+				Raw:       NewValue(String, UnknownValue),
+				Ignored:   "ignored",
+				Untagged:  "untagged",
+				Extra:     map[string]*valueAsTestNested{"null": nil},
+			},
+		},
+		"null-struct-pointer": {
+			in: NewValue(nestedType, nil),
+			as: func() interface{} {
+				p := &valueAsTestNested{}
+				return &p
+			},
+			expected: func() interface{} {
+				var p *valueAsTestNested
+				return &p
+			}(),
+		},
+		"null-slice": {
+			in: NewValue(List{ElementType: String}, nil),
+			as: func() interface{} {
+				s := []string{"a"}
+				return &s
+			},
+			expected: func() interface{} {
+				var s []string
+				return &s
+			}(),
+		},
+		"tuple-values": {
+			in: NewValue(Tuple{ElementTypes: []Type{String, Number}}, []Value{
+				NewValue(String, "a"),
+				NewValue(Number, 1),
+			}),
+			as: func() interface{} {
+				return &[]Value{}
+			},
+			expected: &[]Value{
+				NewValue(String, "a"),
+				NewValue(Number, 1),
+			},
+		},
+		"object-map": {
+			in: NewValue(nestedType, map[string]Value{
+				"name": NewValue(String, "hello"),
+			}),
+			as: func() interface{} {
+				return &map[string]string{}
+			},
+			expected: &map[string]string{"name": "hello"},
+		},
+		"string-kind": {
+			in: NewValue(String, "hello"),
+			as: func() interface{} {
+				s := valueAsTestString("")
+				return &s
+			},
+			expected: func() interface{} {
+				s := valueAsTestString("hello")
+				return &s
+			}(),
+		},
+		"type-mismatch": {
+			in: NewValue(nestedType, map[string]Value{
+				"name": NewValue(String, "hello"),
+			}),
+			as: func() interface{} {
+				return &struct {
+					Name int `tftypes:"name"`
+				}{}
+			},
+			expectedError: `AttributeName("name"): can't unmarshal tftypes.String into int`,
+		},
+		"missing-attribute": {
+			in: NewValue(nestedType, map[string]Value{
+				"name": NewValue(String, "hello"),
+			}),
+			as: func() interface{} {
+				return &struct {
+					Other string `tftypes:"other"`
+				}{}
+			},
+			expectedError: `AttributeName("other"): can't unmarshal tftypes.Object["name":tftypes.String] into struct { Other string "tftypes:\"other\"" }, object has no attribute "other" for field Other`,
+		},
+		"unknown-nested": {
+			in: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, UnknownValue),
+			}),
+			as: func() interface{} {
+				return &[]string{}
+			},
+			expectedError: `ElementKeyInt(1): unmarshaling unknown values is not supported`,
+		},
+		"fractional-int": {
+			in: NewValue(Map{ElementType: Number}, map[string]Value{
+				"key": NewValue(Number, 1.5),
+			}),
+			as: func() interface{} {
+				return &map[string]int{}
+			},
+			expectedError: `ElementKeyString("key"): can't unmarshal tftypes.Number into int, value 1.5 cannot be represented`,
+		},
+		"overflow-uint": {
+			in: NewValue(Number, 256),
+			as: func() interface{} {
+				var u uint8
+				return &u
+			},
+			expectedError: `can't unmarshal tftypes.Number into uint8, value 256 cannot be represented`,
+		},
+		"negative-uint": {
+			in: NewValue(Number, -1),
+			as: func() interface{} {
+				var u uint
+				return &u
+			},
+			expectedError: `can't unmarshal tftypes.Number into uint, value -1 cannot be represented`,
+		},
+		"struct-from-map": {
+			in: NewValue(Map{ElementType: String}, map[string]Value{
+				"name": NewValue(String, "hello"),
+			}),
+			as: func() interface{} {
+				return &valueAsTestNested{}
+			},
+			expectedError: `can't unmarshal tftypes.Map[tftypes.String] into tftypes.valueAsTestNested`,
+		},
+		"unsupported-kind": {
+			in: NewValue(String, "hello"),
+			as: func() interface{} {
+				var c chan string
+				return &c
+			},
+			expectedError: `can't unmarshal tftypes.String into chan string`,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.as()
+			err := test.in.As(got)
+
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+					t.Fatalf("Unexpected error (-wanted, +got): %s", diff)
+				}
+
+				return
+			}
+
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+
+			if diff := cmp.Diff(test.expected, got, cmp.AllowUnexported(valueAsTestConverter{}), cmp.Comparer(func(a, b *big.Float) bool {
+				return a.Cmp(b) == 0
+			})); diff != "" {
+				t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
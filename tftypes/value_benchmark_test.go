@@ -51,3 +51,87 @@ func benchmarkValueApplyTerraform5AttributePathStep(b *testing.B, elements int)
 		}
 	}
 }
+
+func BenchmarkValueMarshalMsgPack1000(b *testing.B) {
+	value, typ := benchmarkEncodeValue(1000)
+
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		_, err := value.MarshalMsgPack(typ) //nolint:staticcheck
+
+		if err != nil {
+			b.Fatalf("unexpected MarshalMsgPack error: %s", err)
+		}
+	}
+}
+
+func BenchmarkAppendValueMsgPack1000(b *testing.B) {
+	value, typ := benchmarkEncodeValue(1000)
+
+	b.ReportAllocs()
+
+	var buf []byte
+
+	for n := 0; n < b.N; n++ {
+		var err error
+
+		buf, err = AppendValueMsgPack(buf[:0], value, typ)
+
+		if err != nil {
+			b.Fatalf("unexpected AppendValueMsgPack error: %s", err)
+		}
+	}
+}
+
+func BenchmarkValueEncodedSizeEstimate1000(b *testing.B) {
+	value, typ := benchmarkEncodeValue(1000)
+
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		value.EncodedSizeEstimate(typ)
+	}
+}
+
+func BenchmarkValueToJSON1000(b *testing.B) {
+	value, typ := benchmarkEncodeValue(1000)
+
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		_, err := ValueToJSON(value, typ)
+
+		if err != nil {
+			b.Fatalf("unexpected ValueToJSON error: %s", err)
+		}
+	}
+}
+
+// benchmarkEncodeValue returns a list of objects with the given number of
+// elements to benchmark encoding.
+func benchmarkEncodeValue(elements int) (Value, Type) {
+	objectType := Object{
+		AttributeTypes: map[string]Type{
+			"element_index": Number,
+			"test_string":   String,
+		},
+	}
+	listType := List{
+		ElementType: objectType,
+	}
+
+	listElements := make([]Value, elements)
+
+	for index := range listElements {
+		listElements[index] = NewValue(
+			objectType,
+			map[string]Value{
+				"element_index": NewValue(Number, index),
+				"test_string":   NewValue(String, "test value"),
+			},
+		)
+	}
+
+	return NewValue(listType, listElements), listType
+}
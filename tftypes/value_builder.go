@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+// ObjectBuilder builds a Value of an Object type one attribute at a time,
+// without copying the attributes set so far each time an attribute is
+// added. Create one with NewObjectBuilder.
+//
+// An ObjectBuilder can continue to be used after Build, without modifying
+// the Values it already built.
+type ObjectBuilder struct {
+	typ   Object
+	attrs map[string]Value
+
+	// built is whether attrs is used by a built Value, and must be copied
+	// before it is modified.
+	built bool
+}
+
+// NewObjectBuilder returns a new ObjectBuilder for a Value of the given
+// Object type, with no attributes set.
+func NewObjectBuilder(typ Object) *ObjectBuilder {
+	return &ObjectBuilder{
+		typ:   typ,
+		attrs: make(map[string]Value, len(typ.AttributeTypes)),
+	}
+}
+
+// Set sets the attribute with the given name to val, replacing any value
+// previously set for the attribute.
+func (b *ObjectBuilder) Set(name string, val Value) *ObjectBuilder {
+	if b.built {
+		b.attrs = copyValueMap(b.attrs)
+		b.built = false
+	}
+
+	b.attrs[name] = val
+
+	return b
+}
+
+// Len returns the number of attributes set.
+func (b *ObjectBuilder) Len() int {
+	return len(b.attrs)
+}
+
+// Build returns the Object Value with the attributes set, or an error if
+// they are not valid for the Object type, such as when a required attribute
+// is not set or an attribute is not part of the type.
+func (b *ObjectBuilder) Build() (Value, error) {
+	for name, val := range b.attrs {
+		if val.Type() == nil {
+			return Value{}, NewAttributePath().WithAttributeName(name).NewErrorf("missing value type")
+		}
+	}
+
+	val, err := newValue(b.typ, b.attrs)
+	if err != nil {
+		return Value{}, err
+	}
+
+	b.built = true
+
+	return val, nil
+}
+
+// MustBuild is identical to Build, except it panics instead of returning an
+// error.
+func (b *ObjectBuilder) MustBuild() Value {
+	val, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+// ListBuilder builds a Value of a List type one element at a time, without
+// copying the elements appended so far each time an element is appended.
+// Create one with NewListBuilder.
+//
+// A ListBuilder can continue to be used after Build, without modifying the
+// Values it already built.
+type ListBuilder struct {
+	typ   List
+	elems []Value
+}
+
+// NewListBuilder returns a new ListBuilder for a Value of the given List
+// type, with no elements.
+func NewListBuilder(typ List) *ListBuilder {
+	return &ListBuilder{
+		typ: typ,
+	}
+}
+
+// Grow increases the capacity of the ListBuilder, if necessary, to fit
+// another n elements without allocating memory.
+func (b *ListBuilder) Grow(n int) *ListBuilder {
+	if n > cap(b.elems)-len(b.elems) {
+		elems := make([]Value, len(b.elems), len(b.elems)+n)
+		copy(elems, b.elems)
+		b.elems = elems
+	}
+
+	return b
+}
+
+// Append appends the elements to the end of the list.
+func (b *ListBuilder) Append(vals ...Value) *ListBuilder {
+	b.elems = append(b.elems, vals...)
+
+	return b
+}
+
+// Len returns the number of elements appended.
+func (b *ListBuilder) Len() int {
+	return len(b.elems)
+}
+
+// Build returns the List Value with the elements appended, or an error if
+// they are not valid for the List type, such as when an element's type is
+// not usable as the element type.
+func (b *ListBuilder) Build() (Value, error) {
+	for pos, val := range b.elems {
+		if val.Type() == nil {
+			return Value{}, NewAttributePath().WithElementKeyInt(pos).NewErrorf("missing value type")
+		}
+	}
+
+	// Limiting the capacity of the built Value's elements ensures later
+	// appends never write to memory it uses.
+	elems := b.elems[:len(b.elems):len(b.elems)]
+	if elems == nil {
+		elems = []Value{}
+	}
+
+	return newValue(b.typ, elems)
+}
+
+// MustBuild is identical to Build, except it panics instead of returning an
+// error.
+func (b *ListBuilder) MustBuild() Value {
+	val, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+// MapBuilder builds a Value of a Map type one element at a time, without
+// copying the elements set so far each time an element is added. Create one
+// with NewMapBuilder.
+//
+// A MapBuilder can continue to be used after Build, without modifying the
+// Values it already built.
+type MapBuilder struct {
+	typ   Map
+	elems map[string]Value
+
+	// built is whether elems is used by a built Value, and must be copied
+	// before it is modified.
+	built bool
+}
+
+// NewMapBuilder returns a new MapBuilder for a Value of the given Map type,
+// with no elements.
+func NewMapBuilder(typ Map) *MapBuilder {
+	return &MapBuilder{
+		typ:   typ,
+		elems: map[string]Value{},
+	}
+}
+
+// Set sets the element with the given key to val, replacing any value
+// previously set for the key.
+func (b *MapBuilder) Set(key string, val Value) *MapBuilder {
+	if b.built {
+		b.elems = copyValueMap(b.elems)
+		b.built = false
+	}
+
+	b.elems[key] = val
+
+	return b
+}
+
+// Len returns the number of elements set.
+func (b *MapBuilder) Len() int {
+	return len(b.elems)
+}
+
+// Build returns the Map Value with the elements set, or an error if they are
+// not valid for the Map type, such as when an element's type is not usable
+// as the element type.
+func (b *MapBuilder) Build() (Value, error) {
+	for key, val := range b.elems {
+		if val.Type() == nil {
+			return Value{}, NewAttributePath().WithElementKeyString(key).NewErrorf("missing value type")
+		}
+	}
+
+	val, err := newValue(b.typ, b.elems)
+	if err != nil {
+		return Value{}, err
+	}
+
+	b.built = true
+
+	return val, nil
+}
+
+// MustBuild is identical to Build, except it panics instead of returning an
+// error.
+func (b *MapBuilder) MustBuild() Value {
+	val, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+// copyValueMap returns a shallow copy of m, with room for another element.
+func copyValueMap(m map[string]Value) map[string]Value {
+	result := make(map[string]Value, len(m)+1)
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestObjectBuilder(t *testing.T) {
+	t.Parallel()
+
+	objectType := Object{
+		AttributeTypes: map[string]Type{
+			"name":  String,
+			"count": Number,
+			"tags":  DynamicPseudoType,
+		},
+		OptionalAttributes: map[string]struct{}{
+			"tags": {},
+		},
+	}
+
+	type testCase struct {
+		build         func(*ObjectBuilder)
+		expected      Value
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"all": {
+			build: func(b *ObjectBuilder) {
+				b.Set("name", NewValue(String, "a")).
+					Set("count", NewValue(Number, 1)).
+					Set("tags", NewValue(List{ElementType: String}, nil))
+			},
+			expected: NewValue(objectType, map[string]Value{
+				"name":  NewValue(String, "a"),
+				"count": NewValue(Number, 1),
+				"tags":  NewValue(List{ElementType: String}, nil),
+			}),
+		},
+		"optional-unset": {
+			build: func(b *ObjectBuilder) {
+				b.Set("name", NewValue(String, "a")).
+					Set("count", NewValue(Number, 1))
+			},
+			expected: NewValue(objectType, map[string]Value{
+				"name":  NewValue(String, "a"),
+				"count": NewValue(Number, 1),
+			}),
+		},
+		"replaced": {
+			build: func(b *ObjectBuilder) {
+				b.Set("name", NewValue(String, "a")).
+					Set("count", NewValue(Number, 1)).
+					Set("name", NewValue(String, "b"))
+			},
+			expected: NewValue(objectType, map[string]Value{
+				"name":  NewValue(String, "b"),
+				"count": NewValue(Number, 1),
+			}),
+		},
+		"required-unset": {
+			build: func(b *ObjectBuilder) {
+				b.Set("name", NewValue(String, "a"))
+			},
+			expectedError: `can't create a tftypes.Value of type tftypes.Object["count":tftypes.Number, "name":tftypes.String, "tags":tftypes.DynamicPseudoType], required attribute "count" not set`,
+		},
+		"unknown-attribute": {
+			build: func(b *ObjectBuilder) {
+				b.Set("name", NewValue(String, "a")).
+					Set("count", NewValue(Number, 1)).
+					Set("other", NewValue(String, "b"))
+			},
+			expectedError: `can't set a value on "other" in tftypes.NewValue, key not part of the object type tftypes.Object["count":tftypes.Number, "name":tftypes.String, "tags":tftypes.DynamicPseudoType]`,
+		},
+		"wrong-type": {
+			build: func(b *ObjectBuilder) {
+				b.Set("name", NewValue(Number, 1)).
+					Set("count", NewValue(Number, 1))
+			},
+			expectedError: `AttributeName("name"): can't use tftypes.Number as tftypes.String`,
+		},
+		"zero-value": {
+			build: func(b *ObjectBuilder) {
+				b.Set("name", Value{}).
+					Set("count", NewValue(Number, 1))
+			},
+			expectedError: `AttributeName("name"): missing value type`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b := NewObjectBuilder(objectType)
+			test.build(b)
+
+			got, err := b.Build()
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+					t.Errorf("Unexpected error (-wanted, +got): %s", diff)
+				}
+				return
+			}
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectBuilder_reuse(t *testing.T) {
+	t.Parallel()
+
+	objectType := Object{AttributeTypes: map[string]Type{"name": String}}
+	b := NewObjectBuilder(objectType).Set("name", NewValue(String, "a"))
+
+	first := b.MustBuild()
+	second := b.Set("name", NewValue(String, "b")).MustBuild()
+
+	if diff := cmp.Diff(NewValue(objectType, map[string]Value{"name": NewValue(String, "a")}), first); diff != "" {
+		t.Errorf("Unexpected modification of built value (-wanted, +got): %s", diff)
+	}
+	if diff := cmp.Diff(NewValue(objectType, map[string]Value{"name": NewValue(String, "b")}), second); diff != "" {
+		t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+	}
+}
+
+func TestListBuilder(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		typ           List
+		build         func(*ListBuilder)
+		expected      Value
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"empty": {
+			typ:      List{ElementType: String},
+			build:    func(*ListBuilder) {},
+			expected: NewValue(List{ElementType: String}, []Value{}),
+		},
+		"elements": {
+			typ: List{ElementType: String},
+			build: func(b *ListBuilder) {
+				b.Grow(3).
+					Append(NewValue(String, "a")).
+					Append(NewValue(String, "b"), NewValue(String, nil))
+			},
+			expected: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, "b"),
+				NewValue(String, nil),
+			}),
+		},
+		"dynamic": {
+			typ: List{ElementType: DynamicPseudoType},
+			build: func(b *ListBuilder) {
+				b.Append(NewValue(String, "a"), NewValue(String, "b"))
+			},
+			expected: NewValue(List{ElementType: DynamicPseudoType}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, "b"),
+			}),
+		},
+		"wrong-type": {
+			typ: List{ElementType: String},
+			build: func(b *ListBuilder) {
+				b.Append(NewValue(String, "a"), NewValue(Bool, true))
+			},
+			expectedError: `ElementKeyInt(1): can't use tftypes.Bool as tftypes.String`,
+		},
+		"mixed-types": {
+			typ: List{ElementType: DynamicPseudoType},
+			build: func(b *ListBuilder) {
+				b.Append(NewValue(String, "a"), NewValue(Bool, true))
+			},
+			expectedError: `lists must only contain one type of element, saw tftypes.String and tftypes.Bool`,
+		},
+		"zero-value": {
+			typ: List{ElementType: String},
+			build: func(b *ListBuilder) {
+				b.Append(NewValue(String, "a"), Value{})
+			},
+			expectedError: `ElementKeyInt(1): missing value type`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b := NewListBuilder(test.typ)
+			test.build(b)
+
+			got, err := b.Build()
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+					t.Errorf("Unexpected error (-wanted, +got): %s", diff)
+				}
+				return
+			}
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestListBuilder_reuse(t *testing.T) {
+	t.Parallel()
+
+	typ := List{ElementType: String}
+	b := NewListBuilder(typ).Grow(10).Append(NewValue(String, "a"))
+
+	first := b.MustBuild()
+	second := b.Append(NewValue(String, "b")).MustBuild()
+
+	if b.Len() != 2 {
+		t.Errorf("expected 2 elements, got %d", b.Len())
+	}
+	if diff := cmp.Diff(NewValue(typ, []Value{NewValue(String, "a")}), first); diff != "" {
+		t.Errorf("Unexpected modification of built value (-wanted, +got): %s", diff)
+	}
+	if diff := cmp.Diff(NewValue(typ, []Value{NewValue(String, "a"), NewValue(String, "b")}), second); diff != "" {
+		t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+	}
+}
+
+func TestMapBuilder(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		typ           Map
+		build         func(*MapBuilder)
+		expected      Value
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"empty": {
+			typ:      Map{ElementType: String},
+			build:    func(*MapBuilder) {},
+			expected: NewValue(Map{ElementType: String}, map[string]Value{}),
+		},
+		"elements": {
+			typ: Map{ElementType: String},
+			build: func(b *MapBuilder) {
+				b.Set("a", NewValue(String, "1")).
+					Set("b", NewValue(String, "2")).
+					Set("a", NewValue(String, "3"))
+			},
+			expected: NewValue(Map{ElementType: String}, map[string]Value{
+				"a": NewValue(String, "3"),
+				"b": NewValue(String, "2"),
+			}),
+		},
+		"wrong-type": {
+			typ: Map{ElementType: String},
+			build: func(b *MapBuilder) {
+				b.Set("a", NewValue(Number, 1))
+			},
+			expectedError: `ElementKeyString("a"): can't use tftypes.Number as tftypes.String`,
+		},
+		"zero-value": {
+			typ: Map{ElementType: String},
+			build: func(b *MapBuilder) {
+				b.Set("a", Value{})
+			},
+			expectedError: `ElementKeyString("a"): missing value type`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b := NewMapBuilder(test.typ)
+			test.build(b)
+
+			got, err := b.Build()
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+					t.Errorf("Unexpected error (-wanted, +got): %s", diff)
+				}
+				return
+			}
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapBuilder_reuse(t *testing.T) {
+	t.Parallel()
+
+	typ := Map{ElementType: String}
+	b := NewMapBuilder(typ).Set("a", NewValue(String, "1"))
+
+	first := b.MustBuild()
+	second := b.Set("a", NewValue(String, "2")).MustBuild()
+
+	if diff := cmp.Diff(NewValue(typ, map[string]Value{"a": NewValue(String, "1")}), first); diff != "" {
+		t.Errorf("Unexpected modification of built value (-wanted, +got): %s", diff)
+	}
+	if diff := cmp.Diff(NewValue(typ, map[string]Value{"a": NewValue(String, "2")}), second); diff != "" {
+		t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+	}
+}
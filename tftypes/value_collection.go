@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"fmt"
+)
+
+// ListAppend returns a copy of the List Value with the elements appended to
+// its end. An error is returned if the Value is not a known, non-null List
+// or the elements are not valid for its type.
+func (val Value) ListAppend(elems ...Value) (Value, error) {
+	return val.listInsert("ListAppend", -1, elems...)
+}
+
+// ListInsert returns a copy of the List Value with the elements inserted
+// before the element at position `pos`, or appended if `pos` is the length of
+// the List or -1. An error is returned if the Value is not a known, non-null
+// List, `pos` is out of range, or the elements are not valid for its type.
+func (val Value) ListInsert(pos int, elems ...Value) (Value, error) {
+	return val.listInsert("ListInsert", pos, elems...)
+}
+
+func (val Value) listInsert(method string, pos int, elems ...Value) (Value, error) {
+	existing, err := val.collectionElements(List{}, method)
+	if err != nil {
+		return Value{}, err
+	}
+
+	if pos == -1 {
+		pos = len(existing)
+	}
+	if pos < 0 || pos > len(existing) {
+		return Value{}, fmt.Errorf("can't insert at position %d of a list with %d elements", pos, len(existing))
+	}
+
+	result := make([]Value, 0, len(existing)+len(elems))
+	result = append(result, existing[:pos]...)
+	result = append(result, elems...)
+	result = append(result, existing[pos:]...)
+
+	for i, el := range result {
+		if el.Type() == nil {
+			return Value{}, NewAttributePath().WithElementKeyInt(i).NewErrorf("missing value type")
+		}
+	}
+
+	return val.withCollectionElements(result)
+}
+
+// SetAdd returns a copy of the Set Value with the elements added, ignoring
+// elements which are equal to an element already in the Set. An error is
+// returned if the Value is not a known, non-null Set or the elements are not
+// valid for its type.
+func (val Value) SetAdd(elems ...Value) (Value, error) {
+	existing, err := val.collectionElements(Set{}, "SetAdd")
+	if err != nil {
+		return Value{}, err
+	}
+
+	result := make([]Value, len(existing), len(existing)+len(elems))
+	copy(result, existing)
+
+	for _, el := range elems {
+		if el.Type() == nil {
+			return Value{}, NewAttributePath().WithElementKeyValue(el).NewErrorf("missing value type")
+		}
+
+		if containsValue(result, el) {
+			continue
+		}
+
+		result = append(result, el)
+	}
+
+	return val.withCollectionElements(result)
+}
+
+// SetRemove returns a copy of the Set Value without the elements equal to
+// any of `elems`. Elements which are not in the Set are ignored. An error is
+// returned if the Value is not a known, non-null Set.
+func (val Value) SetRemove(elems ...Value) (Value, error) {
+	existing, err := val.collectionElements(Set{}, "SetRemove")
+	if err != nil {
+		return Value{}, err
+	}
+
+	result := make([]Value, 0, len(existing))
+
+	for _, el := range existing {
+		if containsValue(elems, el) {
+			continue
+		}
+
+		result = append(result, el)
+	}
+
+	return val.withCollectionElements(result)
+}
+
+// MapPut returns a copy of the Map Value with the element for `key` set to
+// `elem`, replacing any existing element for `key`. An error is returned if
+// the Value is not a known, non-null Map or the element is not valid for its
+// type.
+func (val Value) MapPut(key string, elem Value) (Value, error) {
+	existing, err := val.mapElements("MapPut")
+	if err != nil {
+		return Value{}, err
+	}
+
+	if elem.Type() == nil {
+		return Value{}, NewAttributePath().WithElementKeyString(key).NewErrorf("missing value type")
+	}
+
+	result := copyValueMap(existing)
+	result[key] = elem
+
+	return val.withCollectionElements(result)
+}
+
+// MapDelete returns a copy of the Map Value without the elements for
+// `keys`. Keys which are not in the Map are ignored. An error is returned if
+// the Value is not a known, non-null Map.
+func (val Value) MapDelete(keys ...string) (Value, error) {
+	existing, err := val.mapElements("MapDelete")
+	if err != nil {
+		return Value{}, err
+	}
+
+	result := copyValueMap(existing)
+	for _, key := range keys {
+		delete(result, key)
+	}
+
+	return val.withCollectionElements(result)
+}
+
+// collectionElements returns the elements of the List or Set Value, or an
+// error naming `method` if the Value is not a known, non-null `kind`.
+func (val Value) collectionElements(kind Type, method string) ([]Value, error) {
+	if err := val.checkCollection(kind, method); err != nil {
+		return nil, err
+	}
+
+	elems, ok := val.value.([]Value)
+	if !ok {
+		return nil, fmt.Errorf("can't use %s on %s, unexpected value %T", method, val.Type(), val.value)
+	}
+
+	return elems, nil
+}
+
+// mapElements returns the elements of the Map Value, or an error naming
+// `method` if the Value is not a known, non-null Map.
+func (val Value) mapElements(method string) (map[string]Value, error) {
+	if err := val.checkCollection(Map{}, method); err != nil {
+		return nil, err
+	}
+
+	elems, ok := val.value.(map[string]Value)
+	if !ok {
+		return nil, fmt.Errorf("can't use %s on %s, unexpected value %T", method, val.Type(), val.value)
+	}
+
+	return elems, nil
+}
+
+// checkCollection returns an error naming `method` if the Value is not a
+// known, non-null `kind`.
+func (val Value) checkCollection(kind Type, method string) error {
+	if val.Type() == nil {
+		return fmt.Errorf("can't use %s on a Value with a missing type", method)
+	}
+	if !val.Type().Is(kind) {
+		return fmt.Errorf("can't use %s on %s", method, val.Type())
+	}
+	if val.IsNull() {
+		return fmt.Errorf("can't use %s on a null %s", method, val.Type())
+	}
+	if !val.IsKnown() {
+		return fmt.Errorf("can't use %s on an unknown %s", method, val.Type())
+	}
+	return nil
+}
+
+// withCollectionElements returns a copy of the collection Value with the
+// elements replaced, keeping its type and marks, or an error if the elements
+// are not valid for its type.
+func (val Value) withCollectionElements(elems interface{}) (Value, error) {
+	result, err := newValue(val.Type(), elems)
+	if err != nil {
+		return Value{}, err
+	}
+
+	result.marks = val.marks
+
+	return result, nil
+}
+
+// containsValue returns true if any of `vals` is Equal to `val`.
+func containsValue(vals []Value, val Value) bool {
+	for _, v := range vals {
+		if v.Equal(val) {
+			return true
+		}
+	}
+	return false
+}
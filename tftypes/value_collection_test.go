@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValueCollectionHelpers(t *testing.T) {
+	t.Parallel()
+
+	listType := List{ElementType: String}
+	setType := Set{ElementType: String}
+	mapType := Map{ElementType: String}
+
+	list := NewValue(listType, []Value{
+		NewValue(String, "a"),
+		NewValue(String, "b"),
+	})
+	set := NewValue(setType, []Value{
+		NewValue(String, "a"),
+		NewValue(String, "b"),
+	})
+	m := NewValue(mapType, map[string]Value{
+		"a": NewValue(String, "1"),
+		"b": NewValue(String, "2"),
+	})
+
+	type testCase struct {
+		apply         func() (Value, error)
+		expected      Value
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"ListAppend": {
+			apply: func() (Value, error) {
+				return list.ListAppend(NewValue(String, "c"), NewValue(String, nil))
+			},
+			expected: NewValue(listType, []Value{
+				NewValue(String, "a"),
+				NewValue(String, "b"),
+				NewValue(String, "c"),
+				NewValue(String, nil),
+			}),
+		},
+		"ListAppend-empty": {
+			apply: func() (Value, error) {
+				return NewValue(listType, []Value{}).ListAppend(NewValue(String, "a"))
+			},
+			expected: NewValue(listType, []Value{
+				NewValue(String, "a"),
+			}),
+		},
+		"ListAppend-wrong-type": {
+			apply: func() (Value, error) {
+				return list.ListAppend(NewValue(Bool, true))
+			},
+			expectedError: `ElementKeyInt(2): can't use tftypes.Bool as tftypes.String`,
+		},
+		"ListAppend-zero-value": {
+			apply: func() (Value, error) {
+				return list.ListAppend(Value{})
+			},
+			expectedError: `ElementKeyInt(2): missing value type`,
+		},
+		"ListAppend-null": {
+			apply: func() (Value, error) {
+				return NewValue(listType, nil).ListAppend(NewValue(String, "a"))
+			},
+			expectedError: `can't use ListAppend on a null tftypes.List[tftypes.String]`,
+		},
+		"ListAppend-unknown": {
+			apply: func() (Value, error) {
+				return NewValue(listType, UnknownValue).ListAppend(NewValue(String, "a"))
+			},
+			expectedError: `can't use ListAppend on an unknown tftypes.List[tftypes.String]`,
+		},
+		"ListAppend-set": {
+			apply: func() (Value, error) {
+				return set.ListAppend(NewValue(String, "a"))
+			},
+			expectedError: `can't use ListAppend on tftypes.Set[tftypes.String]`,
+		},
+		"ListAppend-missing-type": {
+			apply: func() (Value, error) {
+				return Value{}.ListAppend(NewValue(String, "a"))
+			},
+			expectedError: `can't use ListAppend on a Value with a missing type`,
+		},
+		"ListInsert-start": {
+			apply: func() (Value, error) {
+				return list.ListInsert(0, NewValue(String, "c"))
+			},
+			expected: NewValue(listType, []Value{
+				NewValue(String, "c"),
+				NewValue(String, "a"),
+				NewValue(String, "b"),
+			}),
+		},
+		"ListInsert-middle": {
+			apply: func() (Value, error) {
+				return list.ListInsert(1, NewValue(String, "c"), NewValue(String, "d"))
+			},
+			expected: NewValue(listType, []Value{
+				NewValue(String, "a"),
+				NewValue(String, "c"),
+				NewValue(String, "d"),
+				NewValue(String, "b"),
+			}),
+		},
+		"ListInsert-end": {
+			apply: func() (Value, error) {
+				return list.ListInsert(2, NewValue(String, "c"))
+			},
+			expected: NewValue(listType, []Value{
+				NewValue(String, "a"),
+				NewValue(String, "b"),
+				NewValue(String, "c"),
+			}),
+		},
+		"ListInsert-out-of-range": {
+			apply: func() (Value, error) {
+				return list.ListInsert(3, NewValue(String, "c"))
+			},
+			expectedError: `can't insert at position 3 of a list with 2 elements`,
+		},
+		"ListInsert-negative": {
+			apply: func() (Value, error) {
+				return list.ListInsert(-2, NewValue(String, "c"))
+			},
+			expectedError: `can't insert at position -2 of a list with 2 elements`,
+		},
+		"ListInsert-dynamic-mixed": {
+			apply: func() (Value, error) {
+				return NewValue(List{ElementType: DynamicPseudoType}, []Value{
+					NewValue(String, "a"),
+				}).ListInsert(0, NewValue(Bool, true))
+			},
+			expectedError: `lists must only contain one type of element, saw tftypes.Bool and tftypes.String`,
+		},
+		"SetAdd": {
+			apply: func() (Value, error) {
+				return set.SetAdd(NewValue(String, "c"), NewValue(String, "a"), NewValue(String, "c"))
+			},
+			expected: NewValue(setType, []Value{
+				NewValue(String, "a"),
+				NewValue(String, "b"),
+				NewValue(String, "c"),
+			}),
+		},
+		"SetAdd-wrong-type": {
+			apply: func() (Value, error) {
+				return set.SetAdd(NewValue(Number, 1))
+			},
+			expectedError: `ElementKeyValue(tftypes.Number<"1">): can't use tftypes.Number as tftypes.String`,
+		},
+		"SetAdd-list": {
+			apply: func() (Value, error) {
+				return list.SetAdd(NewValue(String, "c"))
+			},
+			expectedError: `can't use SetAdd on tftypes.List[tftypes.String]`,
+		},
+		"SetRemove": {
+			apply: func() (Value, error) {
+				return set.SetRemove(NewValue(String, "a"), NewValue(String, "c"))
+			},
+			expected: NewValue(setType, []Value{
+				NewValue(String, "b"),
+			}),
+		},
+		"SetRemove-all": {
+			apply: func() (Value, error) {
+				return set.SetRemove(NewValue(String, "a"), NewValue(String, "b"))
+			},
+			expected: NewValue(setType, []Value{}),
+		},
+		"SetRemove-null": {
+			apply: func() (Value, error) {
+				return NewValue(setType, nil).SetRemove(NewValue(String, "a"))
+			},
+			expectedError: `can't use SetRemove on a null tftypes.Set[tftypes.String]`,
+		},
+		"MapPut-new": {
+			apply: func() (Value, error) {
+				return m.MapPut("c", NewValue(String, "3"))
+			},
+			expected: NewValue(mapType, map[string]Value{
+				"a": NewValue(String, "1"),
+				"b": NewValue(String, "2"),
+				"c": NewValue(String, "3"),
+			}),
+		},
+		"MapPut-replace": {
+			apply: func() (Value, error) {
+				return m.MapPut("a", NewValue(String, "3"))
+			},
+			expected: NewValue(mapType, map[string]Value{
+				"a": NewValue(String, "3"),
+				"b": NewValue(String, "2"),
+			}),
+		},
+		"MapPut-wrong-type": {
+			apply: func() (Value, error) {
+				return m.MapPut("c", NewValue(Number, 3))
+			},
+			expectedError: `ElementKeyString("c"): can't use tftypes.Number as tftypes.String`,
+		},
+		"MapPut-zero-value": {
+			apply: func() (Value, error) {
+				return m.MapPut("c", Value{})
+			},
+			expectedError: `ElementKeyString("c"): missing value type`,
+		},
+		"MapPut-object": {
+			apply: func() (Value, error) {
+				return NewValue(Object{AttributeTypes: map[string]Type{}}, map[string]Value{}).MapPut("c", NewValue(String, "3"))
+			},
+			expectedError: `can't use MapPut on tftypes.Object[]`,
+		},
+		"MapDelete": {
+			apply: func() (Value, error) {
+				return m.MapDelete("a", "c")
+			},
+			expected: NewValue(mapType, map[string]Value{
+				"b": NewValue(String, "2"),
+			}),
+		},
+		"MapDelete-unknown": {
+			apply: func() (Value, error) {
+				return NewValue(mapType, UnknownValue).MapDelete("a")
+			},
+			expectedError: `can't use MapDelete on an unknown tftypes.Map[tftypes.String]`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.apply()
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+					t.Errorf("Unexpected error (-wanted, +got): %s", diff)
+				}
+				return
+			}
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueCollectionHelpers_immutable(t *testing.T) {
+	t.Parallel()
+
+	listType := List{ElementType: String}
+	list := NewValue(listType, []Value{
+		NewValue(String, "a"),
+		NewValue(String, "b"),
+	}).Mark(testMarkSensitive)
+
+	inserted, err := list.ListInsert(1, NewValue(String, "c"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !inserted.HasMark(testMarkSensitive) {
+		t.Errorf("expected the new list to keep the marks of the original list")
+	}
+
+	m := NewValue(Map{ElementType: String}, map[string]Value{
+		"a": NewValue(String, "1"),
+	})
+	if _, err := m.MapPut("b", NewValue(String, "2")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := m.MapDelete("a"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedList := NewValue(listType, []Value{
+		NewValue(String, "a"),
+		NewValue(String, "b"),
+	})
+	if !list.Equal(expectedList) {
+		t.Errorf("expected ListInsert to not modify the original list, got %s", list)
+	}
+
+	expectedMap := NewValue(Map{ElementType: String}, map[string]Value{
+		"a": NewValue(String, "1"),
+	})
+	if diff := cmp.Diff(expectedMap, m); diff != "" {
+		t.Errorf("Unexpected modification of the original map (-wanted, +got): %s", diff)
+	}
+}
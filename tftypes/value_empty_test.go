@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// TestValueEmptyObjectAndTupleRoundTrip verifies empty Object and Tuple
+// values are consistently handled across NewValue, msgpack, and JSON.
+func TestValueEmptyObjectAndTupleRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	emptyObject := Object{AttributeTypes: map[string]Type{}}
+	emptyTuple := Tuple{ElementTypes: []Type{}}
+	wrapperObject := Object{AttributeTypes: map[string]Type{
+		"object": emptyObject,
+		"tuple":  emptyTuple,
+	}}
+
+	testCases := map[string]struct {
+		value Value
+		typ   Type
+		hex   string
+		json  string
+	}{
+		"object": {
+			value: NewValue(emptyObject, map[string]Value{}),
+			typ:   emptyObject,
+			hex:   "80",
+			json:  `{}`,
+		},
+		"object-null": {
+			value: NewValue(emptyObject, nil),
+			typ:   emptyObject,
+			hex:   "c0",
+			json:  `null`,
+		},
+		"object-unknown": {
+			value: NewValue(emptyObject, UnknownValue),
+			typ:   emptyObject,
+			hex:   "d40000",
+		},
+		"object-incomplete-type": {
+			value: NewValue(Object{}, map[string]Value{}),
+			typ:   emptyObject,
+			hex:   "80",
+			json:  `{}`,
+		},
+		"tuple": {
+			value: NewValue(emptyTuple, []Value{}),
+			typ:   emptyTuple,
+			hex:   "90",
+			json:  `[]`,
+		},
+		"tuple-null": {
+			value: NewValue(emptyTuple, nil),
+			typ:   emptyTuple,
+			hex:   "c0",
+			json:  `null`,
+		},
+		"tuple-unknown": {
+			value: NewValue(emptyTuple, UnknownValue),
+			typ:   emptyTuple,
+			hex:   "d40000",
+		},
+		"tuple-incomplete-type": {
+			value: NewValue(Tuple{}, []Value{}),
+			typ:   emptyTuple,
+			hex:   "90",
+			json:  `[]`,
+		},
+		"list-object": {
+			value: NewValue(List{ElementType: emptyObject}, []Value{
+				NewValue(emptyObject, map[string]Value{}),
+				NewValue(emptyObject, map[string]Value{}),
+			}),
+			typ:  List{ElementType: emptyObject},
+			hex:  "928080",
+			json: `[{},{}]`,
+		},
+		"set-tuple": {
+			value: NewValue(Set{ElementType: emptyTuple}, []Value{
+				NewValue(emptyTuple, []Value{}),
+			}),
+			typ:  Set{ElementType: emptyTuple},
+			hex:  "9190",
+			json: `[[]]`,
+		},
+		"map-object": {
+			value: NewValue(Map{ElementType: emptyObject}, map[string]Value{
+				"a": NewValue(emptyObject, map[string]Value{}),
+			}),
+			typ:  Map{ElementType: emptyObject},
+			hex:  "81a16180",
+			json: `{"a":{}}`,
+		},
+		"object-attributes": {
+			value: NewValue(wrapperObject, map[string]Value{
+				"object": NewValue(emptyObject, map[string]Value{}),
+				"tuple":  NewValue(emptyTuple, []Value{}),
+			}),
+			typ:  wrapperObject,
+			hex:  "82a66f626a65637480a57475706c6590",
+			json: `{"object":{},"tuple":[]}`,
+		},
+		"tuple-elements": {
+			value: NewValue(Tuple{ElementTypes: []Type{emptyObject, emptyTuple}}, []Value{
+				NewValue(emptyObject, map[string]Value{}),
+				NewValue(emptyTuple, []Value{}),
+			}),
+			typ:  Tuple{ElementTypes: []Type{emptyObject, emptyTuple}},
+			hex:  "928090",
+			json: `[{},[]]`,
+		},
+		"dynamic-object": {
+			value: NewValue(emptyObject, map[string]Value{}),
+			typ:   DynamicPseudoType,
+			hex:   "92c40d5b226f626a656374222c7b7d5d80",
+			json:  `{"value":{},"type":["object",{}]}`,
+		},
+		"dynamic-tuple": {
+			value: NewValue(emptyTuple, []Value{}),
+			typ:   DynamicPseudoType,
+			hex:   "92c40c5b227475706c65222c5b5d5d90",
+			json:  `{"value":[],"type":["tuple",[]]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			msgpack, err := testCase.value.MarshalMsgPack(testCase.typ)
+
+			if err != nil {
+				t.Fatalf("unexpected error marshaling msgpack: %s", err)
+			}
+
+			if got := hex.EncodeToString(msgpack); got != testCase.hex {
+				t.Errorf("expected msgpack %s, got %s", testCase.hex, got)
+			}
+
+			got, err := ValueFromMsgPack(msgpack, testCase.typ)
+
+			if err != nil {
+				t.Fatalf("unexpected error unmarshaling msgpack: %s", err)
+			}
+
+			if !got.Equal(testCase.value) {
+				t.Errorf("expected msgpack round trip value %s, got %s", testCase.value, got)
+			}
+
+			// Unknown values cannot be represented in JSON.
+			if testCase.json == "" {
+				return
+			}
+
+			got, err = ValueFromJSON([]byte(testCase.json), testCase.typ)
+
+			if err != nil {
+				t.Fatalf("unexpected error unmarshaling JSON: %s", err)
+			}
+
+			if !got.Equal(testCase.value) {
+				t.Errorf("expected JSON value %s, got %s", testCase.value, got)
+			}
+		})
+	}
+}
+
+func TestNewValueIncompleteTypeCollection(t *testing.T) {
+	t.Parallel()
+
+	// Collections of values with incomplete types previously returned a
+	// confusing error about mixed element types, even with one element.
+	testCases := map[string]struct {
+		typ Type
+		val interface{}
+	}{
+		"list-object": {
+			typ: List{ElementType: Object{}},
+			val: []Value{NewValue(Object{}, map[string]Value{})},
+		},
+		"set-tuple": {
+			typ: Set{ElementType: Tuple{}},
+			val: []Value{NewValue(Tuple{}, []Value{})},
+		},
+		"map-object-null": {
+			typ: Map{ElementType: Object{}},
+			val: map[string]Value{"a": NewValue(Object{}, nil)},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := ValidateValue(testCase.typ, testCase.val); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -211,3 +211,164 @@ func (val1 Value) deepEqual(val2 Value) (bool, error) {
 
 	return !hasDiff, err
 }
+
+// ValueEqualOpts contains options that can be used to modify the behaviour of
+// Value.EqualWithOptions.
+type ValueEqualOpts struct {
+	// Comparers are used to compare the known, non-null Values at matching
+	// paths instead of the default equality rules, such as to consider
+	// semantically equivalent JSON strings equal. The first matching
+	// ValueComparer is used.
+	Comparers []ValueComparer
+}
+
+// ValueComparer compares Values with custom equality semantics. A
+// ValueComparer matches Values at Path, if set, which have the Type, if set.
+// A ValueComparer without Path and Type matches all Values.
+type ValueComparer struct {
+	// Path restricts the ValueComparer to the Values at the path, relative
+	// to the Values being compared.
+	Path *AttributePath
+
+	// Type restricts the ValueComparer to Values whose Type is Equal to
+	// Type.
+	Type Type
+
+	// Equal returns whether the two Values are considered equal. Both
+	// Values are always known and not null.
+	Equal func(val1, val2 Value) bool
+}
+
+func (c ValueComparer) matches(path *AttributePath, val1, val2 Value) bool {
+	if c.Path != nil && !c.Path.Equal(path) {
+		return false
+	}
+
+	if c.Type != nil && (!c.Type.Equal(val1.Type()) || !c.Type.Equal(val2.Type())) {
+		return false
+	}
+
+	return true
+}
+
+// EqualWithOptions returns true if two Values should be considered equal,
+// using the same rules as Equal except that Values matching a ValueComparer
+// in opts are compared using that ValueComparer, at any depth. This allows
+// providers to ignore differences which are not meaningful, such as
+// formatting differences of JSON strings, when comparing prior and proposed
+// values.
+//
+// Elements of Sets are matched using EqualWithOptions, so a Set is equal to
+// another Set if they have the same number of elements and each element of
+// either Set is equal to an element of the other Set.
+func (val Value) EqualWithOptions(o Value, opts ValueEqualOpts) bool {
+	if val.Type() == nil && o.Type() == nil && val.value == nil && o.value == nil {
+		return true
+	}
+
+	if val.Type() == nil || o.Type() == nil {
+		return false
+	}
+
+	if !val.Type().Equal(o.Type()) {
+		return false
+	}
+
+	return val.equalWithOptions(o, NewAttributePath(), opts)
+}
+
+func (val1 Value) equalWithOptions(val2 Value, path *AttributePath, opts ValueEqualOpts) bool {
+	if val1.Type() == nil || val2.Type() == nil {
+		return false
+	}
+
+	if !val1.IsKnown() || !val2.IsKnown() {
+		return val1.IsKnown() == val2.IsKnown()
+	}
+
+	if val1.IsNull() || val2.IsNull() {
+		return val1.IsNull() == val2.IsNull()
+	}
+
+	for _, comparer := range opts.Comparers {
+		if comparer.Equal != nil && comparer.matches(path, val1, val2) {
+			return comparer.Equal(val1, val2)
+		}
+	}
+
+	switch v1 := val1.value.(type) {
+	case string, bool:
+		return v1 == val2.value
+	case *big.Float:
+		v2, ok := val2.value.(*big.Float)
+
+		return ok && v1.Cmp(v2) == 0
+	case []Value:
+		v2, ok := val2.value.([]Value)
+
+		if !ok || len(v1) != len(v2) {
+			return false
+		}
+
+		if val1.Type().Is(Set{}) {
+			return setElementsEqualWithOptions(v1, v2, path, opts) && setElementsEqualWithOptions(v2, v1, path, opts)
+		}
+
+		for pos := range v1 {
+			if !v1[pos].equalWithOptions(v2[pos], path.WithElementKeyInt(pos), opts) {
+				return false
+			}
+		}
+
+		return true
+	case map[string]Value:
+		v2, ok := val2.value.(map[string]Value)
+
+		if !ok || len(v1) != len(v2) {
+			return false
+		}
+
+		for key, el1 := range v1 {
+			el2, ok := v2[key]
+
+			if !ok {
+				return false
+			}
+
+			elPath := path.WithElementKeyString(key)
+
+			if val1.Type().Is(Object{}) {
+				elPath = path.WithAttributeName(key)
+			}
+
+			if !el1.equalWithOptions(el2, elPath, opts) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// setElementsEqualWithOptions returns whether each element of s1 is equal to
+// an element of s2.
+func setElementsEqualWithOptions(s1, s2 []Value, path *AttributePath, opts ValueEqualOpts) bool {
+	for _, el1 := range s1 {
+		found := false
+
+		for _, el2 := range s2 {
+			if el1.equalWithOptions(el2, path.WithElementKeyValue(el1), opts) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"math"
+	"math/big"
+)
+
+// ValueToGoOpts contains options that can be used to modify the behaviour when
+// converting a Value into native Go values.
+type ValueToGoOpts struct {
+	// Unknown is called for each unknown value and returns the Go value to
+	// use in its place, such as a placeholder string. If Unknown is nil, an
+	// error is returned for unknown values.
+	Unknown func(path *AttributePath, val Value) (interface{}, error)
+}
+
+// ToGo converts the Value into a tree of native Go values, suitable for
+// passing to encoders, template engines, and other packages that do not know
+// about tftypes:
+//
+//   - Null values become nil.
+//   - Strings become string and Bools become bool.
+//   - Numbers become int64 if they are integers within its range, uint64 if
+//     they are larger integers within its range, float64 if they can be
+//     represented exactly as one, and *big.Float otherwise.
+//   - Lists, Sets, and Tuples become []interface{}, in the order of the
+//     Value's elements.
+//   - Maps and Objects become map[string]interface{}.
+//
+// An error is returned if the Value or any Value nested in it is unknown. Use
+// ToGoWithOpts to represent unknown values instead.
+func (val Value) ToGo() (interface{}, error) {
+	return val.ToGoWithOpts(ValueToGoOpts{})
+}
+
+// ToGoWithOpts is identical to ToGo with the exception that it accepts
+// ValueToGoOpts which can be used to modify the conversion, such as
+// representing unknown values.
+func (val Value) ToGoWithOpts(opts ValueToGoOpts) (interface{}, error) {
+	return valueToGo(val, NewAttributePath(), opts)
+}
+
+func valueToGo(val Value, path *AttributePath, opts ValueToGoOpts) (interface{}, error) {
+	if !val.IsKnown() {
+		if opts.Unknown == nil {
+			return nil, path.NewErrorf("unknown values cannot be converted to Go values")
+		}
+
+		result, err := opts.Unknown(path, val)
+
+		if err != nil {
+			return nil, path.NewError(err)
+		}
+
+		return result, nil
+	}
+
+	if val.IsNull() {
+		return nil, nil
+	}
+
+	switch v := val.value.(type) {
+	case string, bool:
+		return v, nil
+	case *big.Float:
+		return numberToGo(v), nil
+	case []Value:
+		result := make([]interface{}, 0, len(v))
+
+		for pos, elem := range v {
+			elemPath := path.WithElementKeyInt(pos)
+
+			if val.Type().Is(Set{}) {
+				elemPath = path.WithElementKeyValue(elem)
+			}
+
+			elemResult, err := valueToGo(elem, elemPath, opts)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, elemResult)
+		}
+
+		return result, nil
+	case map[string]Value:
+		result := make(map[string]interface{}, len(v))
+
+		for key, elem := range v {
+			elemPath := path.WithElementKeyString(key)
+
+			if val.Type().Is(Object{}) {
+				elemPath = path.WithAttributeName(key)
+			}
+
+			elemResult, err := valueToGo(elem, elemPath, opts)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = elemResult
+		}
+
+		return result, nil
+	}
+
+	return nil, path.NewErrorf("cannot convert %s value of type %T to Go values", val.Type(), val.value)
+}
+
+// numberToGo returns the smallest native representation of a Number without
+// loss of precision.
+func numberToGo(f *big.Float) interface{} {
+	if f.IsInt() {
+		if i, acc := f.Int64(); acc == big.Exact {
+			return i
+		}
+		if u, acc := f.Uint64(); acc == big.Exact {
+			return u
+		}
+	}
+
+	if fl, acc := f.Float64(); acc == big.Exact && !math.IsInf(fl, 0) {
+		return fl
+	}
+
+	return new(big.Float).Copy(f)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValueToGo(t *testing.T) {
+	t.Parallel()
+
+	largeNumber, _, err := big.ParseFloat("123456789012345678901234567890", 10, 512, big.ToNearestEven)
+
+	if err != nil {
+		t.Fatalf("unexpected error parsing number: %s", err)
+	}
+
+	type testCase struct {
+		in            Value
+		opts          ValueToGoOpts
+		expected      interface{}
+		expectedError string
+	}
+
+	tests := map[string]testCase{
+		"null": {
+			in:       NewValue(String, nil),
+			expected: nil,
+		},
+		"string": {
+			in:       NewValue(String, "hello"),
+			expected: "hello",
+		},
+		"bool": {
+			in:       NewValue(Bool, true),
+			expected: true,
+		},
+		"number-int": {
+			in:       NewValue(Number, -123),
+			expected: int64(-123),
+		},
+		"number-uint": {
+			in:       NewValue(Number, uint64(math.MaxUint64)),
+			expected: uint64(math.MaxUint64),
+		},
+		"number-float": {
+			in:       NewValue(Number, 1.5),
+			expected: 1.5,
+		},
+		"number-big": {
+			in:       NewValue(Number, largeNumber),
+			expected: largeNumber,
+		},
+		"list": {
+			in: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, nil),
+			}),
+			expected: []interface{}{"a", nil},
+		},
+		"empty-set": {
+			in:       NewValue(Set{ElementType: String}, []Value{}),
+			expected: []interface{}{},
+		},
+		"tuple": {
+			in: NewValue(Tuple{ElementTypes: []Type{String, Number}}, []Value{
+				NewValue(String, "a"),
+				NewValue(Number, 1),
+			}),
+			expected: []interface{}{"a", int64(1)},
+		},
+		"object": {
+			in: NewValue(Object{AttributeTypes: map[string]Type{
+				"name": String,
+				"tags": Map{ElementType: String},
+				"any":  DynamicPseudoType,
+			}}, map[string]Value{
+				"name": NewValue(String, "hello"),
+				"tags": NewValue(Map{ElementType: String}, map[string]Value{
+					"env": NewValue(String, "test"),
+				}),
+				"any": NewValue(Bool, false),
+			}),
+			expected: map[string]interface{}{
+				"name": "hello",
+				"tags": map[string]interface{}{"env": "test"},
+				"any":  false,
+			},
+		},
+		"unknown": {
+			in: NewValue(Object{AttributeTypes: map[string]Type{
+				"list": List{ElementType: String},
+			}}, map[string]Value{
+				"list": NewValue(List{ElementType: String}, []Value{
+					NewValue(String, UnknownValue),
+				}),
+			}),
+			expectedError: `AttributeName("list").ElementKeyInt(0): unknown values cannot be converted to Go values`,
+		},
+		"unknown-opts": {
+			in: NewValue(Map{ElementType: String}, map[string]Value{
+				"known":   NewValue(String, "hello"),
+				"unknown": NewValue(String, UnknownValue),
+			}),
+			opts: ValueToGoOpts{
+				Unknown: func(path *AttributePath, val Value) (interface{}, error) {
+					return "(known after apply)", nil
+				},
+			},
+			expected: map[string]interface{}{
+				"known":   "hello",
+				"unknown": "(known after apply)",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.in.ToGoWithOpts(test.opts)
+
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+					t.Fatalf("Unexpected error (-wanted, +got): %s", diff)
+				}
+
+				return
+			}
+
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+
+			if diff := cmp.Diff(test.expected, got, cmp.Comparer(func(a, b *big.Float) bool {
+				return a.Cmp(b) == 0
+			})); diff != "" {
+				t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Hash returns a hex-encoded SHA-256 hash identifying the Value, consistent
+// with the equality rules of Equal and of ElementKeyValue steps, which locate
+// Set elements: Values which are Equal always have the same hash. This allows
+// providers to deduplicate or index Set elements, such as by using the hash
+// as a map key, with the same semantics as this package. As with any hash,
+// different Values may rarely have the same hash, so Equal should be used to
+// confirm a match when that matters.
+//
+// Following the equality rules, the order of Set elements does not affect
+// the hash, all unknown Values within the Value have the same hash, as do all
+// null Values within the Value, and Numbers are hashed by their numeric value
+// rather than their precision.
+//
+// The hash is stable: the same Value returns the same hash across processes,
+// platforms, and releases of this Go module, so it may be persisted. Any
+// change to the hash of a Value is considered a breaking change and will
+// only be made in a new major version of this Go module.
+func (val Value) Hash() string {
+	var buf strings.Builder
+
+	if val.Type() != nil {
+		// MarshalJSON is always error safe
+		typeJSON, _ := val.Type().MarshalJSON()
+
+		buf.Write(typeJSON)
+	}
+
+	buf.WriteString(";")
+	writeValueHashKey(&buf, val)
+
+	sum := sha256.Sum256([]byte(buf.String()))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// writeValueHashKey writes the canonical representation of val used by Hash
+// to buf.
+func writeValueHashKey(buf *strings.Builder, val Value) {
+	if !val.IsKnown() {
+		buf.WriteString("U")
+		return
+	}
+
+	switch v := val.value.(type) {
+	case nil:
+		buf.WriteString("N")
+	case string:
+		buf.WriteString("S")
+		buf.WriteString(strconv.Quote(v))
+	case bool:
+		buf.WriteString("B")
+		buf.WriteString(strconv.FormatBool(v))
+	case *big.Float:
+		buf.WriteString("#")
+
+		// Zero is normalized as negative zero is equal to zero. The
+		// 'p' format is exact regardless of the precision of v.
+		if v.Sign() == 0 {
+			buf.WriteString("0")
+		} else {
+			buf.WriteString(v.Text('p', 0))
+		}
+	case []Value:
+		if val.Type() != nil && val.Type().Is(Set{}) {
+			keys := make([]string, 0, len(v))
+
+			for _, el := range v {
+				var elBuf strings.Builder
+
+				writeValueHashKey(&elBuf, el)
+				keys = append(keys, elBuf.String())
+			}
+
+			sort.Strings(keys)
+
+			// Equal compares the number of elements and whether each
+			// element of either Set is in the other, so duplicate
+			// elements are only counted.
+			buf.WriteString(strconv.Itoa(len(keys)))
+			buf.WriteString("{")
+
+			for pos, key := range keys {
+				if pos > 0 && key == keys[pos-1] {
+					continue
+				}
+
+				buf.WriteString(key)
+				buf.WriteString(",")
+			}
+
+			buf.WriteString("}")
+
+			return
+		}
+
+		buf.WriteString("[")
+
+		for _, el := range v {
+			writeValueHashKey(buf, el)
+			buf.WriteString(",")
+		}
+
+		buf.WriteString("]")
+	case map[string]Value:
+		keys := make([]string, 0, len(v))
+
+		for key := range v {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		buf.WriteString("(")
+
+		for _, key := range keys {
+			buf.WriteString(strconv.Quote(key))
+			buf.WriteString(":")
+			writeValueHashKey(buf, v[key])
+			buf.WriteString(",")
+		}
+
+		buf.WriteString(")")
+	}
+}
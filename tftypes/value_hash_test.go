@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestValueHash(t *testing.T) {
+	t.Parallel()
+
+	objectType := Object{AttributeTypes: map[string]Type{
+		"name": String,
+		"tags": Set{ElementType: String},
+	}}
+	preciseNumber, _, err := big.ParseFloat("1.5", 10, 512, big.ToNearestEven)
+
+	if err != nil {
+		t.Fatalf("unexpected error parsing number: %s", err)
+	}
+
+	type testCase struct {
+		val1     Value
+		val2     Value
+		expected bool
+	}
+
+	tests := map[string]testCase{
+		"string-equal": {
+			val1:     NewValue(String, "hello"),
+			val2:     NewValue(String, "hello"),
+			expected: true,
+		},
+		"string-different": {
+			val1:     NewValue(String, "hello"),
+			val2:     NewValue(String, "world"),
+			expected: false,
+		},
+		"string-null": {
+			val1:     NewValue(String, ""),
+			val2:     NewValue(String, nil),
+			expected: false,
+		},
+		"string-unknown": {
+			val1:     NewValue(String, UnknownValue),
+			val2:     NewValue(String, UnknownValue),
+			expected: true,
+		},
+		"different-types": {
+			val1:     NewValue(String, nil),
+			val2:     NewValue(Number, nil),
+			expected: false,
+		},
+		"number-precision": {
+			val1:     NewValue(Number, 1.5),
+			val2:     NewValue(Number, preciseNumber),
+			expected: true,
+		},
+		"number-negative-zero": {
+			val1:     NewValue(Number, 0),
+			val2:     NewValue(Number, new(big.Float).Neg(big.NewFloat(0))),
+			expected: true,
+		},
+		"list-order": {
+			val1: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, "b"),
+			}),
+			val2: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "b"),
+				NewValue(String, "a"),
+			}),
+			expected: false,
+		},
+		"set-order": {
+			val1: NewValue(Set{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, "b"),
+			}),
+			val2: NewValue(Set{ElementType: String}, []Value{
+				NewValue(String, "b"),
+				NewValue(String, "a"),
+			}),
+			expected: true,
+		},
+		"set-length": {
+			val1: NewValue(Set{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, "a"),
+			}),
+			val2: NewValue(Set{ElementType: String}, []Value{
+				NewValue(String, "a"),
+			}),
+			expected: false,
+		},
+		"set-duplicates": {
+			val1: NewValue(Set{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, "a"),
+				NewValue(String, "b"),
+			}),
+			val2: NewValue(Set{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, "b"),
+				NewValue(String, "b"),
+			}),
+			expected: true,
+		},
+		"object-equal": {
+			val1: NewValue(objectType, map[string]Value{
+				"name": NewValue(String, "hello"),
+				"tags": NewValue(Set{ElementType: String}, []Value{
+					NewValue(String, "a"),
+					NewValue(String, "b"),
+				}),
+			}),
+			val2: NewValue(objectType, map[string]Value{
+				"name": NewValue(String, "hello"),
+				"tags": NewValue(Set{ElementType: String}, []Value{
+					NewValue(String, "b"),
+					NewValue(String, "a"),
+				}),
+			}),
+			expected: true,
+		},
+		"object-different": {
+			val1: NewValue(objectType, map[string]Value{
+				"name": NewValue(String, "hello"),
+				"tags": NewValue(Set{ElementType: String}, nil),
+			}),
+			val2: NewValue(objectType, map[string]Value{
+				"name": NewValue(String, "hello"),
+				"tags": NewValue(Set{ElementType: String}, []Value{}),
+			}),
+			expected: false,
+		},
+		"map-key-value-boundary": {
+			val1: NewValue(Map{ElementType: String}, map[string]Value{
+				"a": NewValue(String, "b,c"),
+			}),
+			val2: NewValue(Map{ElementType: String}, map[string]Value{
+				"a": NewValue(String, "b"),
+				"c": NewValue(String, ""),
+			}),
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.val1.Hash() == test.val2.Hash()
+
+			if got != test.expected {
+				t.Errorf("expected hashes of %s and %s to be equal: %t, got %t", test.val1, test.val2, test.expected, got)
+			}
+
+			if test.val1.Equal(test.val2) != test.expected {
+				t.Errorf("expected Equal of %s and %s to be %t, hashes are inconsistent with Equal", test.val1, test.val2, test.expected)
+			}
+		})
+	}
+}
+
+func TestValueHash_Stable(t *testing.T) {
+	t.Parallel()
+
+	val := NewValue(Object{AttributeTypes: map[string]Type{
+		"name":  String,
+		"count": Number,
+		"tags":  Set{ElementType: String},
+	}}, map[string]Value{
+		"name":  NewValue(String, "hello"),
+		"count": NewValue(Number, 2),
+		"tags": NewValue(Set{ElementType: String}, []Value{
+			NewValue(String, "b"),
+			NewValue(String, "a"),
+		}),
+	})
+
+	// This hash must never change within a major version of this module.
+	expected := "a3786d55ff9c3f5b81ece3a1caa7a727c262eaa6b1220a7953fc2b7c0a01e21e"
+
+	if got := val.Hash(); got != expected {
+		t.Errorf("expected hash %s, got %s", expected, got)
+	}
+}
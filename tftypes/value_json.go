@@ -6,7 +6,11 @@ package tftypes
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"math/big"
+	"sort"
 	"strings"
 )
 
@@ -29,6 +33,18 @@ type ValueFromJSONOpts struct {
 	// JSON but do not have a corresponding entry in the schema. For example, raw state
 	// where an attribute has been removed from the schema.
 	IgnoreUndefinedAttributes bool
+
+	// NumberPrecision is the precision, in bits, of Numbers. The default is
+	// 512 bits. Integers within the range of int64 or uint64 are always
+	// stored exactly, so their precision is never lower than 64 bits.
+	NumberPrecision uint
+
+	// Strict rejects malformed JSON which is otherwise tolerated, for
+	// callers treating it as untrusted input: data after the JSON value,
+	// duplicate keys in objects, maps, and dynamically-typed values, and
+	// infinite Numbers, such as "Inf" sent as a string or numbers with an
+	// exponent too large to represent.
+	Strict bool
 }
 
 // ValueFromJSONWithOpts is identical to ValueFromJSON with the exception that it
@@ -36,7 +52,47 @@ type ValueFromJSONOpts struct {
 // as ignoring undefined attributes, for instance. This can occur when the JSON
 // being unmarshalled does not have a corresponding attribute in the schema.
 func ValueFromJSONWithOpts(data []byte, typ Type, opts ValueFromJSONOpts) (Value, error) {
-	return jsonUnmarshal(data, typ, NewAttributePath(), opts)
+	val, err := jsonUnmarshal(data, typ, NewAttributePath(), opts)
+	if err != nil {
+		return Value{}, err
+	}
+	if opts.Strict {
+		if err := jsonCheckTrailingData(data); err != nil {
+			return Value{}, err
+		}
+	}
+	return val, nil
+}
+
+// jsonCheckTrailingData returns an error if buf contains anything other than
+// whitespace after its first JSON value.
+func jsonCheckTrailingData(buf []byte) error {
+	dec := jsonByteDecoder(buf)
+
+	var rawVal json.RawMessage
+	if err := dec.Decode(&rawVal); err != nil {
+		return NewAttributePath().NewErrorf("error decoding value: %w", err)
+	}
+
+	offset := dec.InputOffset()
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return NewAttributePath().NewErrorf("invalid JSON, unexpected data after value at offset %d", offset)
+	}
+
+	return nil
+}
+
+// jsonParseNumber parses the number in s as a Number, rejecting infinite
+// Numbers if opts.Strict is set.
+func jsonParseNumber(s string, opts ValueFromJSONOpts) (*big.Float, error) {
+	f, err := parseNumber(s, opts.NumberPrecision)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Strict && f.IsInf() {
+		return nil, fmt.Errorf("number %q is out of range", s)
+	}
+	return f, nil
 }
 
 func jsonByteDecoder(buf []byte) *json.Decoder {
@@ -62,7 +118,7 @@ func jsonUnmarshal(buf []byte, typ Type, p *AttributePath, opts ValueFromJSONOpt
 	case typ.Is(String):
 		return jsonUnmarshalString(buf, typ, p)
 	case typ.Is(Number):
-		return jsonUnmarshalNumber(buf, typ, p)
+		return jsonUnmarshalNumber(buf, typ, p, opts)
 	case typ.Is(Bool):
 		return jsonUnmarshalBool(buf, typ, p)
 	case typ.Is(DynamicPseudoType):
@@ -107,7 +163,7 @@ func jsonUnmarshalString(buf []byte, _ Type, p *AttributePath) (Value, error) {
 	return Value{}, p.NewErrorf("unsupported type %T sent as %s", tok, String)
 }
 
-func jsonUnmarshalNumber(buf []byte, typ Type, p *AttributePath) (Value, error) {
+func jsonUnmarshalNumber(buf []byte, typ Type, p *AttributePath, opts ValueFromJSONOpts) (Value, error) {
 	dec := jsonByteDecoder(buf)
 
 	tok, err := dec.Token()
@@ -116,13 +172,13 @@ func jsonUnmarshalNumber(buf []byte, typ Type, p *AttributePath) (Value, error)
 	}
 	switch numTok := tok.(type) {
 	case json.Number:
-		f, _, err := big.ParseFloat(string(numTok), 10, 512, big.ToNearestEven)
+		f, err := jsonParseNumber(string(numTok), opts)
 		if err != nil {
 			return Value{}, p.NewErrorf("error parsing number: %w", err)
 		}
 		return NewValue(typ, f), nil
 	case string:
-		f, _, err := big.ParseFloat(numTok, 10, 512, big.ToNearestEven)
+		f, err := jsonParseNumber(numTok, opts)
 		if err != nil {
 			return Value{}, p.NewErrorf("error parsing number: %w", err)
 		}
@@ -189,9 +245,12 @@ func jsonUnmarshalDynamicPseudoType(buf []byte, _ Type, p *AttributePath, opts V
 		if err != nil {
 			return Value{}, p.NewErrorf("error decoding value: %w", err)
 		}
+		if opts.Strict && ((key == "type" && t != nil) || (key == "value" && valBody != nil)) {
+			return Value{}, p.NewErrorf("duplicate key %q in dynamically-typed value", key)
+		}
 		switch key {
 		case "type":
-			t, err = ParseJSONType(rawVal) //nolint:staticcheck
+			t, err = ParseJSONType(rawVal)
 			if err != nil {
 				return Value{}, p.NewErrorf("error decoding type information: %w", err)
 			}
@@ -360,6 +419,10 @@ func jsonUnmarshalMap(buf []byte, attrType Type, p *AttributePath, opts ValueFro
 		//fix the path value, we have an actual key now
 		innerPath = p.WithElementKeyString(key)
 
+		if _, ok := vals[key]; ok && opts.Strict {
+			return Value{}, innerPath.NewErrorf("duplicate map key %q", key)
+		}
+
 		var rawVal json.RawMessage
 		err = dec.Decode(&rawVal)
 		if err != nil {
@@ -460,6 +523,7 @@ func jsonUnmarshalObject(buf []byte, attrTypes map[string]Type, p *AttributePath
 	}
 
 	vals := map[string]Value{}
+	seen := map[string]struct{}{}
 	for dec.More() {
 		tok, err := dec.Token()
 		if err != nil {
@@ -470,6 +534,10 @@ func jsonUnmarshalObject(buf []byte, attrTypes map[string]Type, p *AttributePath
 			return Value{}, p.NewErrorf("object attribute key was %T with value %v, not string", tok, tok)
 		}
 		innerPath := p.WithAttributeName(key)
+		if _, ok := seen[key]; ok && opts.Strict {
+			return Value{}, innerPath.NewErrorf("duplicate attribute %q", key)
+		}
+		seen[key] = struct{}{}
 		attrType, ok := attrTypes[key]
 		if !ok {
 			if opts.IgnoreUndefinedAttributes {
@@ -512,3 +580,445 @@ func jsonUnmarshalObject(buf []byte, attrTypes map[string]Type, p *AttributePath
 		AttributeTypes: attrTypes,
 	}, vals), nil
 }
+
+// ValueToJSON returns the JSON encoding of the Value, using the provided Type
+// to determine how the Value should be encoded. It is the inverse of
+// ValueFromJSON. Values with a DynamicPseudoType Type are encoded as an
+// object with "value" and "type" properties. Unknown values and infinite
+// numbers cannot be represented in JSON and return an error; use
+// ValueToJSONWithUnknowns to encode values which may contain unknown values.
+//
+// The encoding matches the JSON encoding Terraform uses for DynamicValues, so
+// the result can be used to create a DynamicValue directly.
+func ValueToJSON(val Value, typ Type) ([]byte, error) {
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	err := jsonMarshal(val, typ, NewAttributePath(), buf)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Clone(buf.Bytes()), nil
+}
+
+// AppendValueJSON appends the JSON encoding of the Value to `dst`, as
+// returned by ValueToJSON, and returns the extended buffer. Callers encoding
+// many values can reuse the same buffer, such as by passing `dst[:0]`, to
+// avoid allocating memory for each value.
+func AppendValueJSON(dst []byte, val Value, typ Type) ([]byte, error) {
+	buf := bytes.NewBuffer(dst)
+
+	err := jsonMarshal(val, typ, NewAttributePath(), buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func jsonMarshal(val Value, typ Type, p *AttributePath, buf *bytes.Buffer) error {
+	if typ.Is(DynamicPseudoType) && !val.Type().Is(DynamicPseudoType) {
+		return jsonMarshalDynamicPseudoType(val, typ, p, buf)
+	}
+	if !val.IsKnown() {
+		return p.NewErrorf("unknown values cannot be encoded as JSON")
+	}
+	if val.IsNull() {
+		buf.WriteString("null")
+		return nil
+	}
+	switch {
+	case typ.Is(String):
+		return jsonMarshalString(val, typ, p, buf)
+	case typ.Is(Number):
+		return jsonMarshalNumber(val, typ, p, buf)
+	case typ.Is(Bool):
+		return jsonMarshalBool(val, typ, p, buf)
+	case typ.Is(List{}):
+		//nolint:forcetypeassert // Is func above guarantees this type assertion
+		return jsonMarshalList(val, typ.(List), p, buf)
+	case typ.Is(Set{}):
+		//nolint:forcetypeassert // Is func above guarantees this type assertion
+		return jsonMarshalSet(val, typ.(Set), p, buf)
+	case typ.Is(Map{}):
+		//nolint:forcetypeassert // Is func above guarantees this type assertion
+		return jsonMarshalMap(val, typ.(Map), p, buf)
+	case typ.Is(Tuple{}):
+		//nolint:forcetypeassert // Is func above guarantees this type assertion
+		return jsonMarshalTuple(val, typ.(Tuple), p, buf)
+	case typ.Is(Object{}):
+		//nolint:forcetypeassert // Is func above guarantees this type assertion
+		return jsonMarshalObject(val, typ.(Object), p, buf)
+	}
+	return p.NewErrorf("unknown type %s", typ)
+}
+
+func jsonMarshalDynamicPseudoType(val Value, _ Type, p *AttributePath, buf *bytes.Buffer) error {
+	typeJSON, err := val.Type().MarshalJSON()
+	if err != nil {
+		return p.NewErrorf("error generating JSON for type %s: %w", val.Type(), err)
+	}
+	buf.WriteString(`{"value":`)
+	err = jsonMarshal(val, val.Type(), p, buf)
+	if err != nil {
+		return err
+	}
+	buf.WriteString(`,"type":`)
+	buf.Write(typeJSON)
+	buf.WriteString(`}`)
+	return nil
+}
+
+func jsonMarshalString(val Value, typ Type, p *AttributePath, buf *bytes.Buffer) error {
+	s, ok := val.value.(string)
+	if !ok {
+		return unexpectedValueTypeError(p, s, val.value, typ)
+	}
+	return jsonMarshalEncode(s, p, buf)
+}
+
+func jsonMarshalNumber(val Value, typ Type, p *AttributePath, buf *bytes.Buffer) error {
+	n, ok := val.value.(*big.Float)
+	if !ok {
+		return unexpectedValueTypeError(p, n, val.value, typ)
+	}
+	if n.IsInf() {
+		return p.NewErrorf("infinite numbers cannot be encoded as JSON")
+	}
+	buf.WriteString(n.Text('f', -1))
+	return nil
+}
+
+func jsonMarshalBool(val Value, typ Type, p *AttributePath, buf *bytes.Buffer) error {
+	b, ok := val.value.(bool)
+	if !ok {
+		return unexpectedValueTypeError(p, b, val.value, typ)
+	}
+	return jsonMarshalEncode(b, p, buf)
+}
+
+func jsonMarshalList(val Value, typ List, p *AttributePath, buf *bytes.Buffer) error {
+	l, ok := val.value.([]Value)
+	if !ok {
+		return unexpectedValueTypeError(p, l, val.value, typ)
+	}
+	buf.WriteString("[")
+	for pos, i := range l {
+		if pos > 0 {
+			buf.WriteString(",")
+		}
+		err := jsonMarshal(i, typ.ElementType, p.WithElementKeyInt(pos), buf)
+		if err != nil {
+			return err
+		}
+	}
+	buf.WriteString("]")
+	return nil
+}
+
+func jsonMarshalSet(val Value, typ Set, p *AttributePath, buf *bytes.Buffer) error {
+	s, ok := val.value.([]Value)
+	if !ok {
+		return unexpectedValueTypeError(p, s, val.value, typ)
+	}
+	buf.WriteString("[")
+	for pos, i := range s {
+		if pos > 0 {
+			buf.WriteString(",")
+		}
+		err := jsonMarshal(i, typ.ElementType, p.WithElementKeyValue(i), buf)
+		if err != nil {
+			return err
+		}
+	}
+	buf.WriteString("]")
+	return nil
+}
+
+func jsonMarshalMap(val Value, typ Map, p *AttributePath, buf *bytes.Buffer) error {
+	m, ok := val.value.(map[string]Value)
+	if !ok {
+		return unexpectedValueTypeError(p, m, val.value, typ)
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	buf.WriteString("{")
+	for pos, k := range keys {
+		if pos > 0 {
+			buf.WriteString(",")
+		}
+		err := jsonMarshalEncode(k, p.WithElementKeyString(k), buf)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(":")
+		err = jsonMarshal(m[k], typ.ElementType, p.WithElementKeyString(k), buf)
+		if err != nil {
+			return err
+		}
+	}
+	buf.WriteString("}")
+	return nil
+}
+
+func jsonMarshalTuple(val Value, typ Tuple, p *AttributePath, buf *bytes.Buffer) error {
+	t, ok := val.value.([]Value)
+	if !ok {
+		return unexpectedValueTypeError(p, t, val.value, typ)
+	}
+	types := typ.ElementTypes
+	if len(t) != len(types) {
+		return p.NewErrorf("expected %d tuple elements, got %d", len(types), len(t))
+	}
+	buf.WriteString("[")
+	for pos, v := range t {
+		if pos > 0 {
+			buf.WriteString(",")
+		}
+		err := jsonMarshal(v, types[pos], p.WithElementKeyInt(pos), buf)
+		if err != nil {
+			return err
+		}
+	}
+	buf.WriteString("]")
+	return nil
+}
+
+func jsonMarshalObject(val Value, typ Object, p *AttributePath, buf *bytes.Buffer) error {
+	o, ok := val.value.(map[string]Value)
+	if !ok {
+		return unexpectedValueTypeError(p, o, val.value, typ)
+	}
+	types := typ.AttributeTypes
+	keys := make([]string, 0, len(types))
+	for k := range types {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	buf.WriteString("{")
+	for pos, k := range keys {
+		v, ok := o[k]
+		if !ok {
+			return p.WithAttributeName(k).NewErrorf("no value set")
+		}
+		if pos > 0 {
+			buf.WriteString(",")
+		}
+		err := jsonMarshalEncode(k, p.WithAttributeName(k), buf)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(":")
+		err = jsonMarshal(v, types[k], p.WithAttributeName(k), buf)
+		if err != nil {
+			return err
+		}
+	}
+	buf.WriteString("}")
+	return nil
+}
+
+func jsonMarshalEncode(v interface{}, p *AttributePath, buf *bytes.Buffer) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return p.NewErrorf("error encoding value: %w", err)
+	}
+	buf.Write(b)
+	return nil
+}
+
+// ValueToJSONWithUnknowns is identical to ValueToJSON, except unknown values
+// are encoded as null and the locations of unknown values are returned as a
+// second JSON document, so no information is lost. The unknowns document has
+// the same structure as the value, similar to "after_unknown" in Terraform's
+// JSON plan output: true marks an unknown value, objects and maps contain
+// only the attributes and elements with unknown values, and lists, sets, and
+// tuples contain a marker for every element, which is false when it has no
+// unknown values. Values without unknown values are false.
+//
+// Deprecated: this function is exported for internal use in
+// terraform-plugin-go.  Third parties should not use it, and its behavior is
+// not covered under the API compatibility guarantees. Don't use this.
+func ValueToJSONWithUnknowns(val Value, typ Type) ([]byte, []byte, error) {
+	unknowns, err := jsonUnknownsMarker(val, NewAttributePath())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	known, err := Transform(val, func(_ *AttributePath, v Value) (Value, error) {
+		if v.IsKnown() {
+			return v, nil
+		}
+		return NewValue(v.Type(), nil), nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := ValueToJSON(known, typ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unknownsData, err := json.Marshal(unknowns)
+	if err != nil {
+		return nil, nil, NewAttributePath().NewErrorf("error encoding unknown values: %w", err)
+	}
+
+	return data, unknownsData, nil
+}
+
+// ValueFromJSONWithUnknowns is identical to ValueFromJSON, except values
+// marked as unknown in the unknowns JSON document, as returned by
+// ValueToJSONWithUnknowns, are returned as unknown values. A nil or empty
+// unknowns document marks no values as unknown.
+//
+// Deprecated: this function is exported for internal use in
+// terraform-plugin-go.  Third parties should not use it, and its behavior is
+// not covered under the API compatibility guarantees. Don't use this.
+func ValueFromJSONWithUnknowns(data []byte, unknowns []byte, typ Type) (Value, error) {
+	val, err := ValueFromJSON(data, typ) //nolint:staticcheck
+	if err != nil {
+		return Value{}, err
+	}
+
+	if len(unknowns) == 0 {
+		return val, nil
+	}
+
+	var marker interface{}
+
+	err = json.Unmarshal(unknowns, &marker)
+	if err != nil {
+		return Value{}, NewAttributePath().NewErrorf("error decoding unknown values: %w", err)
+	}
+
+	return jsonApplyUnknownsMarker(val, marker, NewAttributePath())
+}
+
+// jsonUnknownsMarker returns the unknowns document structure for the Value,
+// as described by ValueToJSONWithUnknowns.
+func jsonUnknownsMarker(val Value, p *AttributePath) (interface{}, error) {
+	if !val.IsKnown() {
+		return true, nil
+	}
+	if val.IsNull() {
+		return false, nil
+	}
+	switch val.Type().(type) {
+	case List, Set, Tuple:
+		elements, ok := val.value.([]Value)
+		if !ok {
+			return nil, p.NewErrorf("cannot convert %T into []tftypes.Value", val.value)
+		}
+		var hasUnknowns bool
+		markers := make([]interface{}, 0, len(elements))
+		for pos, element := range elements {
+			elementPath := p.WithElementKeyInt(pos)
+			if val.Type().Is(Set{}) {
+				elementPath = p.WithElementKeyValue(element)
+			}
+			marker, err := jsonUnknownsMarker(element, elementPath)
+			if err != nil {
+				return nil, err
+			}
+			if marker != false {
+				hasUnknowns = true
+			}
+			markers = append(markers, marker)
+		}
+		if !hasUnknowns {
+			return false, nil
+		}
+		return markers, nil
+	case Map, Object:
+		values, ok := val.value.(map[string]Value)
+		if !ok {
+			return nil, p.NewErrorf("cannot convert %T into map[string]tftypes.Value", val.value)
+		}
+		markers := make(map[string]interface{})
+		for key, v := range values {
+			keyPath := p.WithElementKeyString(key)
+			if val.Type().Is(Object{}) {
+				keyPath = p.WithAttributeName(key)
+			}
+			marker, err := jsonUnknownsMarker(v, keyPath)
+			if err != nil {
+				return nil, err
+			}
+			if marker != false {
+				markers[key] = marker
+			}
+		}
+		if len(markers) == 0 {
+			return false, nil
+		}
+		return markers, nil
+	}
+	return false, nil
+}
+
+// jsonApplyUnknownsMarker returns the Value with the values marked as unknown
+// in the unknowns document structure replaced with unknown values.
+func jsonApplyUnknownsMarker(val Value, marker interface{}, p *AttributePath) (Value, error) {
+	switch marker := marker.(type) {
+	case nil:
+		return val, nil
+	case bool:
+		if !marker {
+			return val, nil
+		}
+		return NewValue(val.Type(), UnknownValue), nil
+	case []interface{}:
+		if val.IsNull() || !(val.Type().Is(List{}) || val.Type().Is(Set{}) || val.Type().Is(Tuple{})) {
+			return Value{}, p.NewErrorf("unexpected unknown values array for %s value", val.Type())
+		}
+		elements, ok := val.value.([]Value)
+		if !ok {
+			return Value{}, p.NewErrorf("cannot convert %T into []tftypes.Value", val.value)
+		}
+		if len(marker) != len(elements) {
+			return Value{}, p.NewErrorf("expected %d unknown values array elements, got %d", len(elements), len(marker))
+		}
+		newElements := make([]Value, 0, len(elements))
+		for pos, element := range elements {
+			newElement, err := jsonApplyUnknownsMarker(element, marker[pos], p.WithElementKeyInt(pos))
+			if err != nil {
+				return Value{}, err
+			}
+			newElements = append(newElements, newElement)
+		}
+		return NewValue(val.Type(), newElements), nil
+	case map[string]interface{}:
+		if val.IsNull() || !(val.Type().Is(Map{}) || val.Type().Is(Object{})) {
+			return Value{}, p.NewErrorf("unexpected unknown values object for %s value", val.Type())
+		}
+		values, ok := val.value.(map[string]Value)
+		if !ok {
+			return Value{}, p.NewErrorf("cannot convert %T into map[string]tftypes.Value", val.value)
+		}
+		newValues := make(map[string]Value, len(values))
+		for key, v := range values {
+			newValues[key] = v
+		}
+		for key, keyMarker := range marker {
+			keyPath := p.WithElementKeyString(key)
+			if val.Type().Is(Object{}) {
+				keyPath = p.WithAttributeName(key)
+			}
+			v, ok := values[key]
+			if !ok {
+				return Value{}, keyPath.NewErrorf("unexpected unknown value marker for undefined key")
+			}
+			newValue, err := jsonApplyUnknownsMarker(v, keyMarker, keyPath)
+			if err != nil {
+				return Value{}, err
+			}
+			newValues[key] = newValue
+		}
+		return NewValue(val.Type(), newValues), nil
+	}
+	return Value{}, p.NewErrorf("unexpected unknown value marker %T", marker)
+}
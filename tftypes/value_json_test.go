@@ -5,6 +5,7 @@ package tftypes
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"testing"
 
@@ -471,3 +472,620 @@ func TestValueFromJSONWithOpts(t *testing.T) {
 		})
 	}
 }
+
+func TestValueFromJSONWithOpts_NumberPrecision(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		json         string
+		opts         ValueFromJSONOpts
+		expected     string
+		expectedPrec uint
+	}
+	tests := map[string]testCase{
+		"int64": {
+			json:         `9007199254740993`,
+			opts:         ValueFromJSONOpts{NumberPrecision: 8},
+			expected:     "9007199254740993",
+			expectedPrec: 64,
+		},
+		"uint64": {
+			json:         `18446744073709551615`,
+			expected:     "18446744073709551615",
+			expectedPrec: 512,
+		},
+		"default": {
+			json:         `0.1`,
+			expected:     "0.1",
+			expectedPrec: 512,
+		},
+		"precision": {
+			json:         `0.1`,
+			opts:         ValueFromJSONOpts{NumberPrecision: 64},
+			expected:     "0.1",
+			expectedPrec: 64,
+		},
+		"string": {
+			json:         `"0.1"`,
+			opts:         ValueFromJSONOpts{NumberPrecision: 64},
+			expected:     "0.1",
+			expectedPrec: 64,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			val, err := ValueFromJSONWithOpts([]byte(test.json), Number, test.opts)
+			if err != nil {
+				t.Fatalf("unexpected error unmarshaling: %s", err)
+			}
+
+			var got big.Float
+			if err := val.As(&got); err != nil {
+				t.Fatalf("unexpected error converting: %s", err)
+			}
+
+			if got.Prec() != test.expectedPrec {
+				t.Errorf("expected precision %d, got %d", test.expectedPrec, got.Prec())
+			}
+
+			if got.Cmp(mustParseFloat(t, test.expected, test.expectedPrec)) != 0 {
+				t.Errorf("expected %s, got %s", test.expected, got.Text('g', -1))
+			}
+		})
+	}
+}
+
+func TestValueFromJSONWithOpts_Strict(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		json string
+		typ  Type
+
+		// value is the result without Strict, if it succeeds.
+		value         Value
+		expectedError string
+	}
+	objectType := Object{AttributeTypes: map[string]Type{"a": String}}
+	tests := map[string]testCase{
+		"valid": {
+			json:  `{"a":"x"}`,
+			typ:   objectType,
+			value: NewValue(objectType, map[string]Value{"a": NewValue(String, "x")}),
+		},
+		"trailing-whitespace": {
+			json:  "\"a\" \n",
+			typ:   String,
+			value: NewValue(String, "a"),
+		},
+		"trailing-data": {
+			json:          `"a" "b"`,
+			typ:           String,
+			value:         NewValue(String, "a"),
+			expectedError: `invalid JSON, unexpected data after value at offset 3`,
+		},
+		"trailing-delimiter": {
+			json:          `{"a":"x"}}`,
+			typ:           objectType,
+			value:         NewValue(objectType, map[string]Value{"a": NewValue(String, "x")}),
+			expectedError: `invalid JSON, unexpected data after value at offset 9`,
+		},
+		"duplicate-attribute": {
+			json:          `{"a":"x","a":"y"}`,
+			typ:           objectType,
+			value:         NewValue(objectType, map[string]Value{"a": NewValue(String, "y")}),
+			expectedError: `AttributeName("a"): duplicate attribute "a"`,
+		},
+		"duplicate-map-key": {
+			json:          `{"a":"x","a":"y"}`,
+			typ:           Map{ElementType: String},
+			value:         NewValue(Map{ElementType: String}, map[string]Value{"a": NewValue(String, "y")}),
+			expectedError: `ElementKeyString("a"): duplicate map key "a"`,
+		},
+		"duplicate-dynamic-type": {
+			json:          `{"type":"string","type":"number","value":"1"}`,
+			typ:           DynamicPseudoType,
+			value:         NewValue(Number, 1),
+			expectedError: `duplicate key "type" in dynamically-typed value`,
+		},
+		"duplicate-dynamic-value": {
+			json:          `{"type":"string","value":"a","value":"b"}`,
+			typ:           DynamicPseudoType,
+			value:         NewValue(String, "b"),
+			expectedError: `duplicate key "value" in dynamically-typed value`,
+		},
+		"number-inf-string": {
+			json:          `"-Inf"`,
+			typ:           Number,
+			value:         NewValue(Number, big.NewFloat(math.Inf(-1))),
+			expectedError: `error parsing number: number "-Inf" is out of range`,
+		},
+		"number-exponent-overflow": {
+			json:          `1e1000000000`,
+			typ:           Number,
+			value:         NewValue(Number, big.NewFloat(math.Inf(1))),
+			expectedError: `error parsing number: number "1e1000000000" is out of range`,
+		},
+		"nested": {
+			json:          `{"a":{"b":1,"b":2}}`,
+			typ:           Map{ElementType: Map{ElementType: Number}},
+			value:         NewValue(Map{ElementType: Map{ElementType: Number}}, map[string]Value{"a": NewValue(Map{ElementType: Number}, map[string]Value{"b": NewValue(Number, 2)})}),
+			expectedError: `ElementKeyString("a").ElementKeyString("b"): duplicate map key "b"`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			val, err := ValueFromJSONWithOpts([]byte(test.json), test.typ, ValueFromJSONOpts{})
+			if err != nil {
+				t.Fatalf("unexpected error unmarshaling without Strict: %s", err)
+			}
+			if diff := cmp.Diff(test.value, val); diff != "" {
+				t.Errorf("Unexpected results without Strict (-wanted, +got): %s", diff)
+			}
+
+			val, err = ValueFromJSONWithOpts([]byte(test.json), test.typ, ValueFromJSONOpts{Strict: true})
+			if test.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error unmarshaling: %s", err)
+				}
+				if diff := cmp.Diff(test.value, val); diff != "" {
+					t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+			if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+				t.Errorf("Unexpected error (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueToJSON(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		value         Value
+		typ           Type
+		json          string
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"null": {
+			value: NewValue(String, nil),
+			typ:   String,
+			json:  `null`,
+		},
+		"unknown": {
+			value:         NewValue(String, UnknownValue),
+			typ:           String,
+			expectedError: "unknown values cannot be encoded as JSON",
+		},
+		"string": {
+			value: NewValue(String, "hello \"world\""),
+			typ:   String,
+			json:  `"hello \"world\""`,
+		},
+		"number-int": {
+			value: NewValue(Number, big.NewFloat(15)),
+			typ:   Number,
+			json:  `15`,
+		},
+		"number-float": {
+			value: NewValue(Number, big.NewFloat(-1.5)),
+			typ:   Number,
+			json:  `-1.5`,
+		},
+		"number-infinity": {
+			value:         NewValue(Number, big.NewFloat(math.Inf(1))),
+			typ:           Number,
+			expectedError: "infinite numbers cannot be encoded as JSON",
+		},
+		"bool": {
+			value: NewValue(Bool, true),
+			typ:   Bool,
+			json:  `true`,
+		},
+		"list": {
+			value: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, nil),
+			}),
+			typ:  List{ElementType: String},
+			json: `["a",null]`,
+		},
+		"list-unknown-element": {
+			value: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, UnknownValue),
+			}),
+			typ:           List{ElementType: String},
+			expectedError: "ElementKeyInt(1): unknown values cannot be encoded as JSON",
+		},
+		"set": {
+			value: NewValue(Set{ElementType: Bool}, []Value{
+				NewValue(Bool, true),
+				NewValue(Bool, false),
+			}),
+			typ:  Set{ElementType: Bool},
+			json: `[true,false]`,
+		},
+		"map": {
+			value: NewValue(Map{ElementType: Number}, map[string]Value{
+				"b": NewValue(Number, big.NewFloat(2)),
+				"a": NewValue(Number, big.NewFloat(1)),
+			}),
+			typ:  Map{ElementType: Number},
+			json: `{"a":1,"b":2}`,
+		},
+		"tuple": {
+			value: NewValue(Tuple{ElementTypes: []Type{String, Bool}}, []Value{
+				NewValue(String, "a"),
+				NewValue(Bool, false),
+			}),
+			typ:  Tuple{ElementTypes: []Type{String, Bool}},
+			json: `["a",false]`,
+		},
+		"object": {
+			value: NewValue(Object{
+				AttributeTypes: map[string]Type{
+					"string": String,
+					"list":   List{ElementType: Number},
+				},
+			}, map[string]Value{
+				"string": NewValue(String, "a"),
+				"list": NewValue(List{ElementType: Number}, []Value{
+					NewValue(Number, big.NewFloat(1)),
+				}),
+			}),
+			typ: Object{
+				AttributeTypes: map[string]Type{
+					"string": String,
+					"list":   List{ElementType: Number},
+				},
+			},
+			json: `{"list":[1],"string":"a"}`,
+		},
+		"dynamic": {
+			value: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "a"),
+			}),
+			typ:  DynamicPseudoType,
+			json: `{"value":["a"],"type":["list","string"]}`,
+		},
+		"dynamic-object-attribute": {
+			value: NewValue(Object{
+				AttributeTypes: map[string]Type{
+					"static":  Bool,
+					"dynamic": DynamicPseudoType,
+				},
+			}, map[string]Value{
+				"static":  NewValue(Bool, true),
+				"dynamic": NewValue(Bool, true),
+			}),
+			typ: Object{
+				AttributeTypes: map[string]Type{
+					"static":  Bool,
+					"dynamic": DynamicPseudoType,
+				},
+			},
+			json: `{"dynamic":{"value":true,"type":"bool"},"static":true}`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ValueToJSON(test.value, test.typ)
+			if test.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", test.expectedError)
+				}
+				if err.Error() != test.expectedError {
+					t.Fatalf("expected error %q, got %q", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error marshaling: %s", err)
+			}
+			if string(got) != test.json {
+				t.Errorf("expected JSON %s, got %s", test.json, got)
+			}
+			method, err := test.value.ToJSON(test.typ)
+			if err != nil {
+				t.Fatalf("unexpected error marshaling with Value.ToJSON: %s", err)
+			}
+			if string(method) != test.json {
+				t.Errorf("expected Value.ToJSON JSON %s, got %s", test.json, method)
+			}
+			val, err := ValueFromJSON(got, test.typ)
+			if err != nil {
+				t.Fatalf("unexpected error unmarshaling: %s", err)
+			}
+			if diff := cmp.Diff(test.value, val); diff != "" {
+				t.Errorf("Unexpected round trip results (-wanted +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueToJSONWithUnknowns(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		value         Value
+		typ           Type
+		json          string
+		unknowns      string
+		expectedError string
+	}
+	objectType := Object{
+		AttributeTypes: map[string]Type{
+			"string":  String,
+			"list":    List{ElementType: Number},
+			"map":     Map{ElementType: Bool},
+			"dynamic": DynamicPseudoType,
+		},
+	}
+	tests := map[string]testCase{
+		"null": {
+			value:    NewValue(String, nil),
+			typ:      String,
+			json:     `null`,
+			unknowns: `false`,
+		},
+		"unknown": {
+			value:    NewValue(String, UnknownValue),
+			typ:      String,
+			json:     `null`,
+			unknowns: `true`,
+		},
+		"string": {
+			value:    NewValue(String, "a"),
+			typ:      String,
+			json:     `"a"`,
+			unknowns: `false`,
+		},
+		"number-infinity": {
+			value:         NewValue(Number, big.NewFloat(math.Inf(1))),
+			typ:           Number,
+			expectedError: "infinite numbers cannot be encoded as JSON",
+		},
+		"list-unknown-element": {
+			value: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, UnknownValue),
+			}),
+			typ:      List{ElementType: String},
+			json:     `["a",null]`,
+			unknowns: `[false,true]`,
+		},
+		"set-unknown-element": {
+			value: NewValue(Set{ElementType: Bool}, []Value{
+				NewValue(Bool, true),
+				NewValue(Bool, UnknownValue),
+			}),
+			typ:      Set{ElementType: Bool},
+			json:     `[true,null]`,
+			unknowns: `[false,true]`,
+		},
+		"tuple-unknown-element": {
+			value: NewValue(Tuple{ElementTypes: []Type{String, Bool}}, []Value{
+				NewValue(String, UnknownValue),
+				NewValue(Bool, false),
+			}),
+			typ:      Tuple{ElementTypes: []Type{String, Bool}},
+			json:     `[null,false]`,
+			unknowns: `[true,false]`,
+		},
+		"object-known": {
+			value: NewValue(objectType, map[string]Value{
+				"string":  NewValue(String, "a"),
+				"list":    NewValue(List{ElementType: Number}, nil),
+				"map":     NewValue(Map{ElementType: Bool}, nil),
+				"dynamic": NewValue(DynamicPseudoType, nil),
+			}),
+			typ:      objectType,
+			json:     `{"dynamic":null,"list":null,"map":null,"string":"a"}`,
+			unknowns: `false`,
+		},
+		"object-unknowns": {
+			value: NewValue(objectType, map[string]Value{
+				"string": NewValue(String, UnknownValue),
+				"list": NewValue(List{ElementType: Number}, []Value{
+					NewValue(Number, big.NewFloat(1)),
+					NewValue(Number, UnknownValue),
+				}),
+				"map": NewValue(Map{ElementType: Bool}, map[string]Value{
+					"a": NewValue(Bool, true),
+					"b": NewValue(Bool, UnknownValue),
+				}),
+				"dynamic": NewValue(DynamicPseudoType, UnknownValue),
+			}),
+			typ:      objectType,
+			json:     `{"dynamic":null,"list":[1,null],"map":{"a":true,"b":null},"string":null}`,
+			unknowns: `{"dynamic":true,"list":[false,true],"map":{"b":true},"string":true}`,
+		},
+		"object-unknown": {
+			value:    NewValue(objectType, UnknownValue),
+			typ:      objectType,
+			json:     `null`,
+			unknowns: `true`,
+		},
+		"dynamic-unknown-string": {
+			value:    NewValue(String, UnknownValue),
+			typ:      DynamicPseudoType,
+			json:     `{"value":null,"type":"string"}`,
+			unknowns: `true`,
+		},
+		"dynamic-unknown-element": {
+			value: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, UnknownValue),
+			}),
+			typ:      DynamicPseudoType,
+			json:     `{"value":[null],"type":["list","string"]}`,
+			unknowns: `[true]`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, gotUnknowns, err := ValueToJSONWithUnknowns(test.value, test.typ)
+			if test.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", test.expectedError)
+				}
+				if err.Error() != test.expectedError {
+					t.Fatalf("expected error %q, got %q", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error marshaling: %s", err)
+			}
+			if string(got) != test.json {
+				t.Errorf("expected JSON %s, got %s", test.json, got)
+			}
+			if string(gotUnknowns) != test.unknowns {
+				t.Errorf("expected unknowns JSON %s, got %s", test.unknowns, gotUnknowns)
+			}
+			val, err := ValueFromJSONWithUnknowns(got, gotUnknowns, test.typ)
+			if err != nil {
+				t.Fatalf("unexpected error unmarshaling: %s", err)
+			}
+			if diff := cmp.Diff(test.value, val); diff != "" {
+				t.Errorf("Unexpected round trip results (-wanted +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueFromJSONWithUnknowns(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		json          string
+		unknowns      string
+		typ           Type
+		expected      Value
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"no-unknowns": {
+			json:     `["a"]`,
+			unknowns: ``,
+			typ:      List{ElementType: String},
+			expected: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "a"),
+			}),
+		},
+		"unknowns-null": {
+			json:     `["a"]`,
+			unknowns: `null`,
+			typ:      List{ElementType: String},
+			expected: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "a"),
+			}),
+		},
+		"invalid-unknowns": {
+			json:          `["a"]`,
+			unknowns:      `[`,
+			typ:           List{ElementType: String},
+			expectedError: "error decoding unknown values: unexpected end of JSON input",
+		},
+		"unknowns-element-count": {
+			json:          `["a"]`,
+			unknowns:      `[true,false]`,
+			typ:           List{ElementType: String},
+			expectedError: "expected 1 unknown values array elements, got 2",
+		},
+		"unknowns-array-for-object": {
+			json:     `{"a":"b"}`,
+			unknowns: `[true]`,
+			typ: Object{
+				AttributeTypes: map[string]Type{
+					"a": String,
+				},
+			},
+			expectedError: `unexpected unknown values array for tftypes.Object["a":tftypes.String] value`,
+		},
+		"unknowns-undefined-attribute": {
+			json:     `{"a":"b"}`,
+			unknowns: `{"c":true}`,
+			typ: Object{
+				AttributeTypes: map[string]Type{
+					"a": String,
+				},
+			},
+			expectedError: `AttributeName("c"): unexpected unknown value marker for undefined key`,
+		},
+		"unknowns-invalid-marker": {
+			json:          `"a"`,
+			unknowns:      `1`,
+			typ:           String,
+			expectedError: "unexpected unknown value marker float64",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ValueFromJSONWithUnknowns([]byte(test.json), []byte(test.unknowns), test.typ)
+			if test.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", test.expectedError)
+				}
+				if err.Error() != test.expectedError {
+					t.Fatalf("expected error %q, got %q", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected results (-wanted +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAppendValueJSON(t *testing.T) {
+	t.Parallel()
+
+	typ := Map{ElementType: String}
+	value := NewValue(typ, map[string]Value{
+		"a": NewValue(String, "b"),
+	})
+
+	got, err := AppendValueJSON([]byte("prefix"), value, typ)
+	if err != nil {
+		t.Fatalf("unexpected error appending: %s", err)
+	}
+
+	if diff := cmp.Diff(`prefix{"a":"b"}`, string(got)); diff != "" {
+		t.Errorf("unexpected difference (-wanted, +got): %s", diff)
+	}
+
+	first, err := ValueToJSON(value, typ)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling: %s", err)
+	}
+
+	// Encoding another value must not modify results from pooled buffers.
+	if _, err := ValueToJSON(NewValue(String, "other"), String); err != nil {
+		t.Fatalf("unexpected error marshaling: %s", err)
+	}
+
+	if diff := cmp.Diff(`{"a":"b"}`, string(first)); diff != "" {
+		t.Errorf("unexpected modification of previous result (-wanted, +got): %s", diff)
+	}
+
+	if _, err := AppendValueJSON(nil, NewValue(String, UnknownValue), String); err == nil {
+		t.Errorf("expected error appending unknown value, got none")
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ValueMarks is a set of marks, which are arbitrary metadata carried
+// alongside a Value, such as whether the Value is sensitive. Marks must be
+// comparable, so they can be used as map keys; a common choice is a
+// constant of an unexported type defined by the package using the mark.
+type ValueMarks map[interface{}]struct{}
+
+// NewValueMarks returns a ValueMarks containing the given marks.
+func NewValueMarks(marks ...interface{}) ValueMarks {
+	result := make(ValueMarks, len(marks))
+	for _, mark := range marks {
+		result[mark] = struct{}{}
+	}
+	return result
+}
+
+// Has returns true if the ValueMarks contains the mark.
+func (m ValueMarks) Has(mark interface{}) bool {
+	_, ok := m[mark]
+	return ok
+}
+
+// String returns a human-readable representation of the marks, sorted for
+// consistency.
+func (m ValueMarks) String() string {
+	marks := make([]string, 0, len(m))
+	for mark := range m {
+		marks = append(marks, fmt.Sprintf("%v", mark))
+	}
+	sort.Strings(marks)
+	return fmt.Sprintf("%v", marks)
+}
+
+// len returns the number of marks, which is 0 if m is nil.
+func (m *ValueMarks) len() int {
+	if m == nil {
+		return 0
+	}
+	return len(*m)
+}
+
+// PathValueMarks associates marks with the Value at an AttributePath, as
+// returned by Value.UnmarkDeepWithPaths.
+type PathValueMarks struct {
+	Path  *AttributePath
+	Marks ValueMarks
+}
+
+// Mark returns a copy of the Value with the given marks added to any marks it
+// already has. The marks are carried with the Value, including when it is an
+// element or attribute of another Value and when it is passed through Walk,
+// Transform, Copy, and SetAtPath, but are not encoded and do not affect
+// Equal or Hash.
+//
+// Marks only apply to the Value itself, not to its elements or attributes.
+// Use MarkWithPaths to mark elements or attributes.
+func (val Value) Mark(marks ...interface{}) Value {
+	if len(marks) == 0 {
+		return val
+	}
+
+	newMarks := make(ValueMarks, val.marks.len()+len(marks))
+	if val.marks != nil {
+		for mark := range *val.marks {
+			newMarks[mark] = struct{}{}
+		}
+	}
+	for _, mark := range marks {
+		newMarks[mark] = struct{}{}
+	}
+
+	val.marks = &newMarks
+
+	return val
+}
+
+// Marks returns a copy of the marks of the Value itself, not including the
+// marks of its elements or attributes. It returns nil if the Value has no
+// marks.
+func (val Value) Marks() ValueMarks {
+	if val.marks.len() == 0 {
+		return nil
+	}
+
+	result := make(ValueMarks, val.marks.len())
+	for mark := range *val.marks {
+		result[mark] = struct{}{}
+	}
+	return result
+}
+
+// HasMark returns true if the Value itself has the mark.
+func (val Value) HasMark(mark interface{}) bool {
+	return val.marks != nil && val.marks.Has(mark)
+}
+
+// IsMarked returns true if the Value itself has any marks.
+func (val Value) IsMarked() bool {
+	return val.marks.len() > 0
+}
+
+// ContainsMarked returns true if the Value or any of its elements or
+// attributes have marks.
+func (val Value) ContainsMarked() bool {
+	if val.IsMarked() {
+		return true
+	}
+
+	switch v := val.value.(type) {
+	case []Value:
+		for _, el := range v {
+			if el.ContainsMarked() {
+				return true
+			}
+		}
+	case map[string]Value:
+		for _, el := range v {
+			if el.ContainsMarked() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Unmark returns a copy of the Value without its own marks, and those marks.
+// The marks of its elements and attributes are kept.
+func (val Value) Unmark() (Value, ValueMarks) {
+	marks := val.Marks()
+	val.marks = nil
+	return val, marks
+}
+
+// UnmarkDeepWithPaths returns a copy of the Value without any marks,
+// including the marks of its elements and attributes, and the marks that were
+// removed, with the AttributePath of the Value they were on. The marks can be
+// restored with MarkWithPaths.
+//
+// The result is in depth-first order, with elements of Maps and attributes
+// of Objects sorted by their keys. Elements of Sets are identified by their
+// Value without marks.
+func (val Value) UnmarkDeepWithPaths() (Value, []PathValueMarks) {
+	var pvm []PathValueMarks
+
+	result := unmarkDeep(NewAttributePath(), val, &pvm)
+
+	return result, pvm
+}
+
+func unmarkDeep(path *AttributePath, val Value, pvm *[]PathValueMarks) Value {
+	val, marks := val.Unmark()
+	if len(marks) > 0 {
+		*pvm = append(*pvm, PathValueMarks{Path: path, Marks: marks})
+	}
+
+	if !val.ContainsMarked() {
+		return val
+	}
+
+	switch v := val.value.(type) {
+	case []Value:
+		_, isSet := val.Type().(Set)
+		elems := make([]Value, len(v))
+		for pos, el := range v {
+			elementPath := path.WithElementKeyInt(pos)
+			if isSet {
+				elementPath = path.WithElementKeyValue(el.unmarkedDeep())
+			}
+			elems[pos] = unmarkDeep(elementPath, el, pvm)
+		}
+		val.value = elems
+	case map[string]Value:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		_, isObject := val.Type().(Object)
+		elems := make(map[string]Value, len(v))
+		for _, k := range keys {
+			elementPath := path.WithElementKeyString(k)
+			if isObject {
+				elementPath = path.WithAttributeName(k)
+			}
+			elems[k] = unmarkDeep(elementPath, v[k], pvm)
+		}
+		val.value = elems
+	}
+
+	return val
+}
+
+// unmarkedDeep returns a copy of the Value without any marks.
+func (val Value) unmarkedDeep() Value {
+	var pvm []PathValueMarks
+	return unmarkDeep(NewAttributePath(), val, &pvm)
+}
+
+// MarkWithPaths returns a copy of the Value with the marks added to the
+// Values at their AttributePaths, such as those returned by
+// UnmarkDeepWithPaths. Paths which do not exist in the Value are ignored.
+func (val Value) MarkWithPaths(pvm []PathValueMarks) Value {
+	for _, pm := range pvm {
+		if len(pm.Marks) == 0 {
+			continue
+		}
+
+		target, err := val.AtPath(pm.Path)
+		if err != nil {
+			continue
+		}
+
+		marks := make([]interface{}, 0, len(pm.Marks))
+		for mark := range pm.Marks {
+			marks = append(marks, mark)
+		}
+
+		marked, err := val.SetAtPath(pm.Path, target.Mark(marks...))
+		if err != nil {
+			continue
+		}
+		val = marked
+	}
+
+	return val
+}
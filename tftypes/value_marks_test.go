@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type testMark string
+
+const (
+	testMarkSensitive testMark = "sensitive"
+	testMarkOther     testMark = "other"
+)
+
+func TestValueMark(t *testing.T) {
+	t.Parallel()
+
+	val := NewValue(String, "hello")
+	marked := val.Mark(testMarkSensitive).Mark(testMarkOther, testMarkSensitive)
+
+	if val.IsMarked() {
+		t.Errorf("expected original value to not be marked")
+	}
+	if !marked.IsMarked() {
+		t.Errorf("expected value to be marked")
+	}
+	if !marked.HasMark(testMarkSensitive) || !marked.HasMark(testMarkOther) {
+		t.Errorf("expected value to have both marks, got %s", marked.Marks())
+	}
+	if diff := cmp.Diff(NewValueMarks(testMarkOther, testMarkSensitive), marked.Marks()); diff != "" {
+		t.Errorf("Unexpected marks (-wanted, +got): %s", diff)
+	}
+	if got := marked.Marks().String(); got != "[other sensitive]" {
+		t.Errorf("expected marks string %q, got %q", "[other sensitive]", got)
+	}
+	if !marked.Equal(val) {
+		t.Errorf("expected marks to not affect equality")
+	}
+	if marked.Hash() != val.Hash() {
+		t.Errorf("expected marks to not affect the hash")
+	}
+
+	unmarked, marks := marked.Unmark()
+	if unmarked.IsMarked() {
+		t.Errorf("expected unmarked value to not be marked")
+	}
+	if diff := cmp.Diff(NewValueMarks(testMarkOther, testMarkSensitive), marks); diff != "" {
+		t.Errorf("Unexpected removed marks (-wanted, +got): %s", diff)
+	}
+	if !marked.IsMarked() {
+		t.Errorf("expected Unmark to not modify the original value")
+	}
+
+	// Marks are not encoded.
+	got, err := marked.MarshalMsgPack(String) //nolint:staticcheck
+	if err != nil {
+		t.Fatalf("unexpected error marshaling: %s", err)
+	}
+	expected, err := val.MarshalMsgPack(String) //nolint:staticcheck
+	if err != nil {
+		t.Fatalf("unexpected error marshaling: %s", err)
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("Unexpected encoding (-wanted, +got): %s", diff)
+	}
+}
+
+func TestValueMarkWithPaths(t *testing.T) {
+	t.Parallel()
+
+	objectType := Object{AttributeTypes: map[string]Type{
+		"name":     String,
+		"password": String,
+		"tokens":   Set{ElementType: String},
+		"tags":     Map{ElementType: String},
+	}}
+	val := NewValue(objectType, map[string]Value{
+		"name":     NewValue(String, "hello"),
+		"password": NewValue(String, "secret"),
+		"tokens": NewValue(Set{ElementType: String}, []Value{
+			NewValue(String, "a"),
+			NewValue(String, "b"),
+		}),
+		"tags": NewValue(Map{ElementType: String}, map[string]Value{
+			"env": NewValue(String, "prod"),
+		}),
+	})
+
+	pvm := []PathValueMarks{
+		{
+			Path:  NewAttributePath(),
+			Marks: NewValueMarks(testMarkOther),
+		},
+		{
+			Path:  NewAttributePath().WithAttributeName("password"),
+			Marks: NewValueMarks(testMarkSensitive),
+		},
+		{
+			Path:  NewAttributePath().WithAttributeName("tags").WithElementKeyString("env"),
+			Marks: NewValueMarks(testMarkSensitive, testMarkOther),
+		},
+		{
+			Path:  NewAttributePath().WithAttributeName("tokens").WithElementKeyValue(NewValue(String, "b")),
+			Marks: NewValueMarks(testMarkSensitive),
+		},
+		{
+			Path:  NewAttributePath().WithAttributeName("missing"),
+			Marks: NewValueMarks(testMarkSensitive),
+		},
+	}
+
+	marked := val.MarkWithPaths(pvm)
+
+	if !marked.Equal(val) {
+		t.Errorf("expected marks to not affect equality")
+	}
+	if !marked.ContainsMarked() {
+		t.Errorf("expected value to contain marks")
+	}
+	if val.ContainsMarked() {
+		t.Errorf("expected MarkWithPaths to not modify the original value")
+	}
+
+	password, err := marked.AtPath(NewAttributePath().WithAttributeName("password"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !password.HasMark(testMarkSensitive) {
+		t.Errorf("expected password to be marked sensitive")
+	}
+
+	unmarked, gotPVM := marked.UnmarkDeepWithPaths()
+
+	if unmarked.ContainsMarked() {
+		t.Errorf("expected unmarked value to not contain marks")
+	}
+	if !unmarked.Equal(val) {
+		t.Errorf("expected unmarked value to equal the original value")
+	}
+
+	// The missing path is not part of the value, and the remaining marks
+	// are returned in depth-first order with sorted keys.
+	expected := []PathValueMarks{pvm[0], pvm[1], pvm[2], pvm[3]}
+	if diff := cmp.Diff(expected, gotPVM); diff != "" {
+		t.Errorf("Unexpected marks (-wanted, +got): %s", diff)
+	}
+}
+
+func TestValueMarks_preserved(t *testing.T) {
+	t.Parallel()
+
+	listType := List{ElementType: String}
+	val := NewValue(listType, []Value{
+		NewValue(String, "a").Mark(testMarkSensitive),
+		NewValue(String, "b"),
+	}).Mark(testMarkOther)
+
+	t.Run("Walk", func(t *testing.T) {
+		t.Parallel()
+
+		var markedPaths []*AttributePath
+		err := Walk(val, func(path *AttributePath, v Value) (bool, error) {
+			if v.IsMarked() {
+				markedPaths = append(markedPaths, path)
+			}
+			return true, nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		expected := []*AttributePath{
+			NewAttributePath(),
+			NewAttributePath().WithElementKeyInt(0),
+		}
+		if diff := cmp.Diff(expected, markedPaths); diff != "" {
+			t.Errorf("Unexpected marked paths (-wanted, +got): %s", diff)
+		}
+	})
+
+	t.Run("Transform", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := Transform(val, func(path *AttributePath, v Value) (Value, error) {
+			if path.Equal(NewAttributePath().WithElementKeyInt(1)) {
+				return NewValue(String, "c"), nil
+			}
+			return v, nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if !got.HasMark(testMarkOther) {
+			t.Errorf("expected transformed list to keep its marks")
+		}
+		first, err := got.AtPath(NewAttributePath().WithElementKeyInt(0))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !first.HasMark(testMarkSensitive) {
+			t.Errorf("expected unmodified element to keep its marks")
+		}
+	})
+
+	t.Run("Copy", func(t *testing.T) {
+		t.Parallel()
+
+		got := val.Copy()
+
+		if !got.HasMark(testMarkOther) {
+			t.Errorf("expected copied list to keep its marks")
+		}
+		first, err := got.AtPath(NewAttributePath().WithElementKeyInt(0))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !first.HasMark(testMarkSensitive) {
+			t.Errorf("expected copied element to keep its marks")
+		}
+	})
+
+	t.Run("SetAtPath", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := val.SetAtPath(NewAttributePath().WithElementKeyInt(1), NewValue(String, "c"))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if !got.HasMark(testMarkOther) {
+			t.Errorf("expected list to keep its marks")
+		}
+		first, err := got.AtPath(NewAttributePath().WithElementKeyInt(0))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !first.HasMark(testMarkSensitive) {
+			t.Errorf("expected unmodified element to keep its marks")
+		}
+	})
+}
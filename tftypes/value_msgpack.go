@@ -5,7 +5,9 @@ package tftypes
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"math"
 	"math/big"
 	"sort"
@@ -33,10 +35,75 @@ func (u msgPackUnknownType) MarshalMsgpack() ([]byte, error) {
 func ValueFromMsgPack(data []byte, typ Type) (Value, error) {
 	r := bytes.NewReader(data)
 	dec := msgpack.NewDecoder(r)
-	return msgpackUnmarshal(dec, typ, NewAttributePath())
+	return msgpackUnmarshal(dec, typ, NewAttributePath(), ValueFromMsgPackOpts{})
 }
 
-func msgpackUnmarshal(dec *msgpack.Decoder, typ Type, path *AttributePath) (Value, error) {
+// ValueFromMsgPackOpts contains options that can be used to modify the
+// behaviour when unmarshalling MsgPack.
+type ValueFromMsgPackOpts struct {
+	// NumberPrecision is the precision, in bits, of Numbers encoded as
+	// strings or floats. By default, Numbers encoded as strings have a
+	// precision of 512 bits and Numbers encoded as floats have the 53 bits
+	// of precision of a float64. Integers within the range of int64 or
+	// uint64 are always stored exactly, so their precision is never lower
+	// than 64 bits, which is the precision of Numbers encoded as integers.
+	NumberPrecision uint
+
+	// Strict rejects malformed MsgPack which is otherwise tolerated, for
+	// callers treating it as untrusted input: data after the MsgPack value,
+	// duplicate keys in maps, and infinite Numbers, such as infinite floats
+	// or "Inf" sent as a string.
+	Strict bool
+}
+
+// ValueFromMsgPackWithOpts is identical to ValueFromMsgPack with the
+// exception that it accepts ValueFromMsgPackOpts which can be used to modify
+// the unmarshalling behaviour, such as the precision of Numbers.
+//
+// Deprecated: this function is exported for internal use in
+// terraform-plugin-go.  Third parties should not use it, and its behavior is
+// not covered under the API compatibility guarantees. Don't use this.
+func ValueFromMsgPackWithOpts(data []byte, typ Type, opts ValueFromMsgPackOpts) (Value, error) {
+	r := bytes.NewReader(data)
+	dec := msgpack.NewDecoder(r)
+	val, err := msgpackUnmarshal(dec, typ, NewAttributePath(), opts)
+	if err != nil {
+		return Value{}, err
+	}
+	if opts.Strict {
+		if _, err := dec.PeekCode(); !errors.Is(err, io.EOF) {
+			return Value{}, NewAttributePath().NewErrorf("unexpected data after value, %d bytes remaining", r.Len())
+		}
+	}
+	return val, nil
+}
+
+// AppendValueMsgPack appends the MsgPack encoding of the Value to `dst`,
+// using `typ` to determine how the Value should be encoded, and returns the
+// extended buffer. Callers encoding many values can reuse the same buffer,
+// such as by passing `dst[:0]`, to avoid allocating memory for each value.
+func AppendValueMsgPack(dst []byte, val Value, typ Type) ([]byte, error) {
+	buf := bytes.NewBuffer(dst)
+
+	err := encodeMsgPackBuffer(buf, val, typ)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeMsgPackBuffer writes the MsgPack encoding of the Value to buf, using
+// a pooled encoder.
+func encodeMsgPackBuffer(buf *bytes.Buffer, val Value, typ Type) error {
+	enc := msgpack.GetEncoder()
+	defer msgpack.PutEncoder(enc)
+
+	enc.Reset(buf)
+
+	return marshalMsgPack(val, typ, NewAttributePath(), enc)
+}
+
+func msgpackUnmarshal(dec *msgpack.Decoder, typ Type, path *AttributePath, opts ValueFromMsgPackOpts) (Value, error) {
 	peek, err := dec.PeekCode()
 	if err != nil {
 		return Value{}, path.NewErrorf("error peeking next byte: %w", err)
@@ -50,7 +117,7 @@ func msgpackUnmarshal(dec *msgpack.Decoder, typ Type, path *AttributePath) (Valu
 		return NewValue(typ, UnknownValue), nil
 	}
 	if typ.Is(DynamicPseudoType) {
-		return msgpackUnmarshalDynamic(dec, path)
+		return msgpackUnmarshalDynamic(dec, path, opts)
 	}
 	if peek == msgpackCodes.Nil {
 		err := dec.Skip()
@@ -97,21 +164,25 @@ func msgpackUnmarshal(dec *msgpack.Decoder, typ Type, path *AttributePath) (Valu
 			if err != nil {
 				return Value{}, path.NewErrorf("couldn't decode number as float64: %w", err)
 			}
-			return NewValue(Number, big.NewFloat(rv)), nil
+			if math.IsNaN(rv) {
+				return Value{}, path.NewErrorf("couldn't decode number, NaN is not a number")
+			}
+			if opts.Strict && math.IsInf(rv, 0) {
+				return Value{}, path.NewErrorf("number %v is out of range", rv)
+			}
+			return NewValue(Number, float64ToNumber(rv, opts.NumberPrecision)), nil
 		default:
 			rv, err := dec.DecodeString()
 			if err != nil {
 				return Value{}, path.NewErrorf("couldn't decode number as string: %w", err)
 			}
-			// according to
-			// https://github.com/hashicorp/go-cty/blob/85980079f637862fa8e43ddc82dd74315e2f4c85/cty/value_init.go#L49
-			// Base 10, precision 512, and rounding to nearest even
-			// is the standard way to handle numbers arriving as
-			// strings.
-			fv, _, err := big.ParseFloat(rv, 10, 512, big.ToNearestEven)
+			fv, err := parseNumber(rv, opts.NumberPrecision)
 			if err != nil {
 				return Value{}, path.NewErrorf("error parsing %q as number: %w", rv, err)
 			}
+			if opts.Strict && fv.IsInf() {
+				return Value{}, path.NewErrorf("number %q is out of range", rv)
+			}
 			return NewValue(Number, fv), nil
 		}
 	case typ.Is(Bool):
@@ -122,24 +193,24 @@ func msgpackUnmarshal(dec *msgpack.Decoder, typ Type, path *AttributePath) (Valu
 		return NewValue(Bool, rv), nil
 	case typ.Is(List{}):
 		//nolint:forcetypeassert // Is func above guarantees this type assertion
-		return msgpackUnmarshalList(dec, typ.(List).ElementType, path)
+		return msgpackUnmarshalList(dec, typ.(List).ElementType, path, opts)
 	case typ.Is(Set{}):
 		//nolint:forcetypeassert // Is func above guarantees this type assertion
-		return msgpackUnmarshalSet(dec, typ.(Set).ElementType, path)
+		return msgpackUnmarshalSet(dec, typ.(Set).ElementType, path, opts)
 	case typ.Is(Map{}):
 		//nolint:forcetypeassert // Is func above guarantees this type assertion
-		return msgpackUnmarshalMap(dec, typ.(Map).ElementType, path)
+		return msgpackUnmarshalMap(dec, typ.(Map).ElementType, path, opts)
 	case typ.Is(Tuple{}):
 		//nolint:forcetypeassert // Is func above guarantees this type assertion
-		return msgpackUnmarshalTuple(dec, typ.(Tuple).ElementTypes, path)
+		return msgpackUnmarshalTuple(dec, typ.(Tuple).ElementTypes, path, opts)
 	case typ.Is(Object{}):
 		//nolint:forcetypeassert // Is func above guarantees this type assertion
-		return msgpackUnmarshalObject(dec, typ.(Object).AttributeTypes, path)
+		return msgpackUnmarshalObject(dec, typ.(Object), path, opts)
 	}
 	return Value{}, path.NewErrorf("unsupported type %s", typ.String())
 }
 
-func msgpackUnmarshalList(dec *msgpack.Decoder, typ Type, path *AttributePath) (Value, error) {
+func msgpackUnmarshalList(dec *msgpack.Decoder, typ Type, path *AttributePath, opts ValueFromMsgPackOpts) (Value, error) {
 	length, err := dec.DecodeArrayLen()
 	if err != nil {
 		return Value{}, path.NewErrorf("error decoding list length: %w", err)
@@ -159,7 +230,7 @@ func msgpackUnmarshalList(dec *msgpack.Decoder, typ Type, path *AttributePath) (
 	vals := make([]Value, 0, length)
 	for i := 0; i < length; i++ {
 		innerPath := path.WithElementKeyInt(i)
-		val, err := msgpackUnmarshal(dec, typ, innerPath)
+		val, err := msgpackUnmarshal(dec, typ, innerPath, opts)
 		if err != nil {
 			return Value{}, err
 		}
@@ -179,7 +250,7 @@ func msgpackUnmarshalList(dec *msgpack.Decoder, typ Type, path *AttributePath) (
 	}, vals), nil
 }
 
-func msgpackUnmarshalSet(dec *msgpack.Decoder, typ Type, path *AttributePath) (Value, error) {
+func msgpackUnmarshalSet(dec *msgpack.Decoder, typ Type, path *AttributePath, opts ValueFromMsgPackOpts) (Value, error) {
 	length, err := dec.DecodeArrayLen()
 	if err != nil {
 		return Value{}, path.NewErrorf("error decoding set length: %w", err)
@@ -199,7 +270,7 @@ func msgpackUnmarshalSet(dec *msgpack.Decoder, typ Type, path *AttributePath) (V
 	vals := make([]Value, 0, length)
 	for i := 0; i < length; i++ {
 		innerPath := path.WithElementKeyInt(i)
-		val, err := msgpackUnmarshal(dec, typ, innerPath)
+		val, err := msgpackUnmarshal(dec, typ, innerPath, opts)
 		if err != nil {
 			return Value{}, err
 		}
@@ -216,7 +287,7 @@ func msgpackUnmarshalSet(dec *msgpack.Decoder, typ Type, path *AttributePath) (V
 	}, vals), nil
 }
 
-func msgpackUnmarshalMap(dec *msgpack.Decoder, typ Type, path *AttributePath) (Value, error) {
+func msgpackUnmarshalMap(dec *msgpack.Decoder, typ Type, path *AttributePath, opts ValueFromMsgPackOpts) (Value, error) {
 	length, err := dec.DecodeMapLen()
 	if err != nil {
 		return Value{}, path.NewErrorf("error decoding map length: %w", err)
@@ -240,7 +311,10 @@ func msgpackUnmarshalMap(dec *msgpack.Decoder, typ Type, path *AttributePath) (V
 			return Value{}, path.NewErrorf("error decoding map key: %w", err)
 		}
 		innerPath := path.WithElementKeyString(key)
-		val, err := msgpackUnmarshal(dec, typ, innerPath)
+		if _, ok := vals[key]; ok && opts.Strict {
+			return Value{}, innerPath.NewErrorf("duplicate map key %q", key)
+		}
+		val, err := msgpackUnmarshal(dec, typ, innerPath, opts)
 		if err != nil {
 			return Value{}, err
 		}
@@ -252,7 +326,7 @@ func msgpackUnmarshalMap(dec *msgpack.Decoder, typ Type, path *AttributePath) (V
 	}, vals), nil
 }
 
-func msgpackUnmarshalTuple(dec *msgpack.Decoder, types []Type, path *AttributePath) (Value, error) {
+func msgpackUnmarshalTuple(dec *msgpack.Decoder, types []Type, path *AttributePath, opts ValueFromMsgPackOpts) (Value, error) {
 	length, err := dec.DecodeArrayLen()
 	if err != nil {
 		return Value{}, path.NewErrorf("error decoding tuple length: %w", err)
@@ -271,7 +345,7 @@ func msgpackUnmarshalTuple(dec *msgpack.Decoder, types []Type, path *AttributePa
 	for i := 0; i < length; i++ {
 		innerPath := path.WithElementKeyInt(i)
 		typ := types[i]
-		val, err := msgpackUnmarshal(dec, typ, innerPath)
+		val, err := msgpackUnmarshal(dec, typ, innerPath, opts)
 		if err != nil {
 			return Value{}, err
 		}
@@ -283,7 +357,9 @@ func msgpackUnmarshalTuple(dec *msgpack.Decoder, types []Type, path *AttributePa
 	}, vals), nil
 }
 
-func msgpackUnmarshalObject(dec *msgpack.Decoder, types map[string]Type, path *AttributePath) (Value, error) {
+func msgpackUnmarshalObject(dec *msgpack.Decoder, objectType Object, path *AttributePath, opts ValueFromMsgPackOpts) (Value, error) {
+	types := objectType.AttributeTypes
+
 	length, err := dec.DecodeMapLen()
 	if err != nil {
 		return Value{}, path.NewErrorf("error decoding object length: %w", err)
@@ -291,9 +367,7 @@ func msgpackUnmarshalObject(dec *msgpack.Decoder, types map[string]Type, path *A
 
 	switch {
 	case length < 0:
-		return NewValue(Object{
-			AttributeTypes: types,
-		}, nil), nil
+		return NewValue(objectType, nil), nil
 	case length != len(types):
 		return Value{}, path.NewErrorf("error decoding object; expected %d attributes, got %d", len(types), length)
 	}
@@ -309,19 +383,22 @@ func msgpackUnmarshalObject(dec *msgpack.Decoder, types map[string]Type, path *A
 			return Value{}, path.NewErrorf("unknown attribute %q", key)
 		}
 		innerPath := path.WithAttributeName(key)
-		val, err := msgpackUnmarshal(dec, typ, innerPath)
+		// duplicate attributes leave others missing, so they are
+		// always rejected
+		if _, ok := vals[key]; ok {
+			return Value{}, innerPath.NewErrorf("duplicate attribute %q", key)
+		}
+		val, err := msgpackUnmarshal(dec, typ, innerPath, opts)
 		if err != nil {
 			return Value{}, err
 		}
 		vals[key] = val
 	}
 
-	return NewValue(Object{
-		AttributeTypes: types,
-	}, vals), nil
+	return NewValue(objectType, vals), nil
 }
 
-func msgpackUnmarshalDynamic(dec *msgpack.Decoder, path *AttributePath) (Value, error) {
+func msgpackUnmarshalDynamic(dec *msgpack.Decoder, path *AttributePath, opts ValueFromMsgPackOpts) (Value, error) {
 	length, err := dec.DecodeArrayLen()
 	if err != nil {
 		return Value{}, path.NewErrorf("error checking length of DynamicPseudoType value: %w", err)
@@ -338,11 +415,11 @@ func msgpackUnmarshalDynamic(dec *msgpack.Decoder, path *AttributePath) (Value,
 	if err != nil {
 		return Value{}, path.NewErrorf("error decoding bytes: %w", err)
 	}
-	typ, err := ParseJSONType(typeJSON) //nolint:staticcheck
+	typ, err := ParseJSONType(typeJSON)
 	if err != nil {
 		return Value{}, path.NewErrorf("error parsing type information: %w", err)
 	}
-	return msgpackUnmarshal(dec, typ, path)
+	return msgpackUnmarshal(dec, typ, path, opts)
 }
 
 func marshalMsgPack(val Value, typ Type, p *AttributePath, enc *msgpack.Encoder) error {
@@ -446,6 +523,11 @@ func marshalMsgPackNumber(val Value, typ Type, p *AttributePath, enc *msgpack.En
 		if err != nil {
 			return p.NewErrorf("error encoding int value: %w", err)
 		}
+	} else if uv, acc := n.Uint64(); acc == big.Exact && n.IsInt() {
+		err := enc.EncodeUint(uv)
+		if err != nil {
+			return p.NewErrorf("error encoding uint value: %w", err)
+		}
 	} else if fv, acc := n.Float64(); acc == big.Exact && !n.IsInt() {
 		err := enc.EncodeFloat64(fv)
 		if err != nil {
@@ -513,11 +595,18 @@ func marshalMsgPackMap(val Value, typ Map, p *AttributePath, enc *msgpack.Encode
 	if !ok {
 		return unexpectedValueTypeError(p, m, val.value, typ)
 	}
+	// Sort the keys so the same Map is always encoded the same way.
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
 	err := enc.EncodeMapLen(len(m))
 	if err != nil {
 		return p.NewErrorf("error encoding map length: %w", err)
 	}
-	for k, v := range m {
+	for _, k := range keys {
+		v := m[k]
 		err := marshalMsgPack(NewValue(String, k), String, p.WithElementKeyString(k), enc)
 		if err != nil {
 			return p.NewErrorf("error encoding map key: %w", err)
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+)
+
+// MarshalCanonicalMsgPack returns the canonical MsgPack encoding of the
+// Value, using `t` to determine how the Value should be encoded. Values which
+// are Equal always have the same canonical encoding, so it can be compared
+// byte for byte or hashed to identify the Value, such as in a cache key
+// shared between processes. The canonical encoding can be decoded with
+// ValueFromMsgPack like any other MsgPack encoding of the Value.
+//
+// In addition to the sorted Map and Object keys of every MsgPack encoding,
+// the canonical encoding:
+//
+//   - Sorts Set elements by their canonical encoding. Sets are expected not
+//     to contain duplicate elements.
+//   - Encodes Numbers by their numeric value rather than their precision,
+//     after rounding them to the 512 bits of precision Terraform uses.
+//     Integers within the range of int64 or uint64 are encoded as integers
+//     and other Numbers which are exactly representable as a float64 are
+//     encoded as floats.
+//
+// Use Hash for a digest of the Value which does not depend on `t`.
+func (val Value) MarshalCanonicalMsgPack(t Type) ([]byte, error) {
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	err := encodeMsgPackBuffer(buf, canonicalMsgPackValue(val, t), t)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Clone(buf.Bytes()), nil
+}
+
+// canonicalMsgPackValue returns a copy of val with its Numbers normalized
+// and its Set elements sorted by their canonical encoding. Values which are
+// not valid for `typ` are returned as is, for the encoder to report.
+func canonicalMsgPackValue(val Value, typ Type) Value {
+	if !val.IsKnown() || val.IsNull() {
+		return val
+	}
+
+	if typ.Is(DynamicPseudoType) {
+		typ = val.Type()
+	}
+
+	switch typ := typ.(type) {
+	case primitive:
+		n, ok := val.value.(*big.Float)
+		if !ok || !typ.Is(Number) {
+			return val
+		}
+		return Value{typ: val.Type(), value: canonicalNumber(n)}
+	case List:
+		return canonicalMsgPackElements(val, func(int) Type { return typ.ElementType })
+	case Tuple:
+		return canonicalMsgPackElements(val, func(pos int) Type {
+			if pos >= len(typ.ElementTypes) {
+				return nil
+			}
+			return typ.ElementTypes[pos]
+		})
+	case Set:
+		result := canonicalMsgPackElements(val, func(int) Type { return typ.ElementType })
+		elems, ok := result.value.([]Value)
+		if !ok {
+			return val
+		}
+		return sortCanonicalMsgPackSet(result.Type(), elems, typ.ElementType)
+	case Map:
+		return canonicalMsgPackAttributes(val, func(string) Type { return typ.ElementType })
+	case Object:
+		return canonicalMsgPackAttributes(val, func(name string) Type { return typ.AttributeTypes[name] })
+	}
+
+	return val
+}
+
+// canonicalNumber returns n rounded to defaultNumberPrecision bits of
+// precision, with negative zero replaced by zero.
+func canonicalNumber(n *big.Float) *big.Float {
+	if n.IsInf() {
+		return n
+	}
+	if n.Sign() == 0 {
+		return new(big.Float)
+	}
+	return new(big.Float).SetPrec(defaultNumberPrecision).Set(n)
+}
+
+// canonicalMsgPackElements returns a copy of the List, Set, or Tuple val with
+// canonical elements. The elementType function returns the type of the
+// element at a position, or nil if there is no such element.
+func canonicalMsgPackElements(val Value, elementType func(int) Type) Value {
+	elems, ok := val.value.([]Value)
+	if !ok {
+		return val
+	}
+
+	result := make([]Value, len(elems))
+	for pos, el := range elems {
+		typ := elementType(pos)
+		if typ == nil {
+			result[pos] = el
+			continue
+		}
+		result[pos] = canonicalMsgPackValue(el, typ)
+	}
+
+	return Value{typ: val.Type(), value: result}
+}
+
+// canonicalMsgPackAttributes returns a copy of the Map or Object val with
+// canonical elements or attributes. The attributeType function returns the
+// type of the element or attribute with a name, or nil if there is no such
+// attribute.
+func canonicalMsgPackAttributes(val Value, attributeType func(string) Type) Value {
+	attrs, ok := val.value.(map[string]Value)
+	if !ok {
+		return val
+	}
+
+	result := make(map[string]Value, len(attrs))
+	for name, attr := range attrs {
+		typ := attributeType(name)
+		if typ == nil {
+			result[name] = attr
+			continue
+		}
+		result[name] = canonicalMsgPackValue(attr, typ)
+	}
+
+	return Value{typ: val.Type(), value: result}
+}
+
+// sortCanonicalMsgPackSet returns a Set Value of the canonical elements,
+// sorted by their encoding.
+func sortCanonicalMsgPackSet(setType Type, elems []Value, elementType Type) Value {
+	type encodedElement struct {
+		value   Value
+		msgpack []byte
+	}
+
+	encoded := make([]encodedElement, len(elems))
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	for pos, el := range elems {
+		buf.Reset()
+
+		err := encodeMsgPackBuffer(buf, el, elementType)
+		if err != nil {
+			// leave invalid elements for the encoder to report
+			// with their full path
+			return Value{typ: setType, value: elems}
+		}
+
+		encoded[pos] = encodedElement{
+			value:   el,
+			msgpack: bytes.Clone(buf.Bytes()),
+		}
+	}
+
+	sort.SliceStable(encoded, func(i, j int) bool {
+		return bytes.Compare(encoded[i].msgpack, encoded[j].msgpack) < 0
+	})
+
+	result := make([]Value, len(encoded))
+	for pos, el := range encoded {
+		result[pos] = el.value
+	}
+
+	return Value{typ: setType, value: result}
+}
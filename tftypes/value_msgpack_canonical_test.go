@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValueMarshalCanonicalMsgPack(t *testing.T) {
+	t.Parallel()
+
+	setType := Set{ElementType: Object{AttributeTypes: map[string]Type{
+		"name":  String,
+		"count": Number,
+	}}}
+	element := func(name string, count interface{}) Value {
+		return NewValue(setType.ElementType, map[string]Value{
+			"name":  NewValue(String, name),
+			"count": NewValue(Number, count),
+		})
+	}
+	largeNumber, _, err := big.ParseFloat("0.1", 10, 512, big.ToNearestEven)
+	if err != nil {
+		t.Fatalf("unexpected error parsing number: %s", err)
+	}
+
+	type testCase struct {
+		values []Value
+		typ    Type
+		hex    string
+	}
+	tests := map[string]testCase{
+		"map": {
+			values: []Value{
+				NewValue(Map{ElementType: String}, map[string]Value{
+					"b": NewValue(String, "2"),
+					"a": NewValue(String, "1"),
+					"c": NewValue(String, "3"),
+				}),
+			},
+			typ: Map{ElementType: String},
+			hex: "83a161a131a162a132a163a133",
+		},
+		"set": {
+			values: []Value{
+				NewValue(Set{ElementType: String}, []Value{
+					NewValue(String, "b"),
+					NewValue(String, "a"),
+					NewValue(String, UnknownValue),
+					NewValue(String, nil),
+				}),
+				NewValue(Set{ElementType: String}, []Value{
+					NewValue(String, nil),
+					NewValue(String, "a"),
+					NewValue(String, UnknownValue),
+					NewValue(String, "b"),
+				}),
+			},
+			typ: Set{ElementType: String},
+			hex: "94a161a162c0d40000",
+		},
+		"set-of-objects": {
+			values: []Value{
+				NewValue(setType, []Value{element("b", 1), element("a", 2)}),
+				NewValue(setType, []Value{element("a", 2), element("b", 1)}),
+			},
+			typ: setType,
+			hex: "9282a5636f756e7401a46e616d65a16282a5636f756e7402a46e616d65a161",
+		},
+		"number-zero": {
+			values: []Value{
+				NewValue(Number, 0),
+				NewValue(Number, new(big.Float).Neg(big.NewFloat(0))),
+			},
+			typ: Number,
+			hex: "00",
+		},
+		"number-precision": {
+			values: []Value{
+				NewValue(Number, 1.5),
+				NewValue(Number, new(big.Float).SetPrec(512).SetFloat64(1.5)),
+			},
+			typ: Number,
+			hex: "cb3ff8000000000000",
+		},
+		"number-extra-precision": {
+			values: []Value{
+				NewValue(Number, largeNumber),
+				NewValue(Number, new(big.Float).SetPrec(2048).Set(largeNumber)),
+			},
+			typ: Number,
+			hex: "a3302e31",
+		},
+		"dynamic-nested-set": {
+			values: []Value{
+				NewValue(List{ElementType: Set{ElementType: Number}}, []Value{
+					NewValue(Set{ElementType: Number}, []Value{
+						NewValue(Number, 2),
+						NewValue(Number, 1),
+					}),
+				}),
+				NewValue(List{ElementType: Set{ElementType: Number}}, []Value{
+					NewValue(Set{ElementType: Number}, []Value{
+						NewValue(Number, 1),
+						NewValue(Number, 2),
+					}),
+				}),
+			},
+			typ: DynamicPseudoType,
+			hex: "92c4195b226c697374222c5b22736574222c226e756d626572225d5d91920102",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, value := range test.values {
+				got, err := value.MarshalCanonicalMsgPack(test.typ)
+				if err != nil {
+					t.Fatalf("unexpected error marshaling: %s", err)
+				}
+
+				if diff := cmp.Diff(test.hex, hex.EncodeToString(got)); diff != "" {
+					t.Errorf("Unexpected encoding of %s (-wanted, +got): %s", value, diff)
+				}
+
+				decoded, err := ValueFromMsgPack(got, test.typ)
+				if err != nil {
+					t.Fatalf("unexpected error unmarshaling: %s", err)
+				}
+
+				if !decoded.Equal(value) {
+					t.Errorf("expected %s to decode to %s", decoded, value)
+				}
+			}
+		})
+	}
+}
+
+func TestValueMarshalCanonicalMsgPack_error(t *testing.T) {
+	t.Parallel()
+
+	typ := Object{AttributeTypes: map[string]Type{
+		"tags": Set{ElementType: String},
+	}}
+	value := NewValue(typ, map[string]Value{
+		"tags": NewValue(Set{ElementType: String}, []Value{
+			NewValue(String, "a"),
+		}),
+	})
+
+	_, err := value.MarshalCanonicalMsgPack(Object{AttributeTypes: map[string]Type{
+		"tags": Set{ElementType: Number},
+	}})
+
+	expected := `AttributeName("tags").ElementKeyValue(tftypes.String<"a">): unexpected value type string, tftypes.Number values must be of type *big.Float`
+	if err == nil {
+		t.Fatalf("expected error %q, got none", expected)
+	}
+	if diff := cmp.Diff(expected, err.Error()); diff != "" {
+		t.Errorf("Unexpected error (-wanted, +got): %s", diff)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"bytes"
+
+	msgpack "github.com/vmihailenco/msgpack/v5"
+	msgpackCodes "github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// ValueFromMsgPackAtPath returns the Value that `path` is pointing to within
+// the MsgPack-encoded value of the provided Type, as Value.AtPath would for
+// the Value returned by ValueFromMsgPack. Only the Value at `path` is
+// decoded: other attributes and elements are skipped without being decoded,
+// except the elements of Sets, which must be decoded to be compared with
+// ElementKeyValue steps.
+//
+// The error is an AttributePathError for the path up to and including the
+// step which could not be applied. It wraps ErrInvalidStep if the step does
+// not exist in the value, such as a missing attribute or an element of a null
+// or unknown collection.
+//
+// Deprecated: this function is exported for internal use in
+// terraform-plugin-go.  Third parties should not use it, and its behavior is
+// not covered under the API compatibility guarantees. Don't use this.
+func ValueFromMsgPackAtPath(data []byte, typ Type, path *AttributePath) (Value, error) {
+	r := bytes.NewReader(data)
+	dec := msgpack.NewDecoder(r)
+	return msgpackUnmarshalAtPath(dec, typ, NewAttributePath(), path.Steps())
+}
+
+func msgpackUnmarshalAtPath(dec *msgpack.Decoder, typ Type, path *AttributePath, steps []AttributePathStep) (Value, error) {
+	if len(steps) == 0 {
+		return msgpackUnmarshal(dec, typ, path, ValueFromMsgPackOpts{})
+	}
+
+	stepPath := NewAttributePathWithSteps(append(path.Steps(), steps[0]))
+
+	peek, err := dec.PeekCode()
+	if err != nil {
+		return Value{}, path.NewErrorf("error peeking next byte: %w", err)
+	}
+	if msgpackCodes.IsExt(peek) || peek == msgpackCodes.Nil {
+		// unknown and null values have no attributes or elements
+		return Value{}, stepPath.NewError(ErrInvalidStep)
+	}
+	if typ.Is(DynamicPseudoType) {
+		length, err := dec.DecodeArrayLen()
+		if err != nil {
+			return Value{}, path.NewErrorf("error checking length of DynamicPseudoType value: %w", err)
+		}
+		if length != 2 {
+			return Value{}, path.NewErrorf("expected %d elements in DynamicPseudoType array, got %d", 2, length)
+		}
+		typeJSON, err := dec.DecodeBytes()
+		if err != nil {
+			return Value{}, path.NewErrorf("error decoding bytes: %w", err)
+		}
+		typ, err = ParseJSONType(typeJSON)
+		if err != nil {
+			return Value{}, path.NewErrorf("error parsing type information: %w", err)
+		}
+		return msgpackUnmarshalAtPath(dec, typ, path, steps)
+	}
+
+	switch typ := typ.(type) {
+	case Object:
+		name, ok := steps[0].(AttributeName)
+		if !ok {
+			return Value{}, stepPath.NewError(ErrInvalidStep)
+		}
+		attrType, ok := typ.AttributeTypes[string(name)]
+		if !ok {
+			return Value{}, stepPath.NewError(ErrInvalidStep)
+		}
+		return msgpackUnmarshalMapKeyAtPath(dec, string(name), attrType, path, stepPath, steps)
+	case Map:
+		key, ok := steps[0].(ElementKeyString)
+		if !ok {
+			return Value{}, stepPath.NewError(ErrInvalidStep)
+		}
+		return msgpackUnmarshalMapKeyAtPath(dec, string(key), typ.ElementType, path, stepPath, steps)
+	case List:
+		return msgpackUnmarshalArrayIndexAtPath(dec, func(int) Type { return typ.ElementType }, path, stepPath, steps)
+	case Tuple:
+		return msgpackUnmarshalArrayIndexAtPath(dec, func(pos int) Type {
+			if pos >= len(typ.ElementTypes) {
+				return nil
+			}
+			return typ.ElementTypes[pos]
+		}, path, stepPath, steps)
+	case Set:
+		stepValue, ok := steps[0].(ElementKeyValue)
+		if !ok {
+			return Value{}, stepPath.NewError(ErrInvalidStep)
+		}
+		length, err := dec.DecodeArrayLen()
+		if err != nil {
+			return Value{}, path.NewErrorf("error decoding set length: %w", err)
+		}
+		for i := 0; i < length; i++ {
+			val, err := msgpackUnmarshal(dec, typ.ElementType, path.WithElementKeyInt(i), ValueFromMsgPackOpts{})
+			if err != nil {
+				return Value{}, err
+			}
+			equal, err := Value(stepValue).deepEqual(val)
+			if err != nil || !equal {
+				continue
+			}
+			result, remaining, err := val.walkAttributePath(NewAttributePathWithSteps(steps[1:]))
+			if err != nil {
+				failedSteps := steps[:len(steps)-len(remaining.Steps())+1]
+				return Value{}, NewAttributePathWithSteps(append(path.Steps(), failedSteps...)).NewError(err)
+			}
+			return result, nil
+		}
+		return Value{}, stepPath.NewError(ErrInvalidStep)
+	}
+
+	return Value{}, stepPath.NewError(ErrInvalidStep)
+}
+
+// msgpackUnmarshalMapKeyAtPath continues msgpackUnmarshalAtPath with the
+// element or attribute `key` of an encoded map or object, skipping the other
+// elements or attributes.
+func msgpackUnmarshalMapKeyAtPath(dec *msgpack.Decoder, key string, typ Type, path, stepPath *AttributePath, steps []AttributePathStep) (Value, error) {
+	length, err := dec.DecodeMapLen()
+	if err != nil {
+		return Value{}, path.NewErrorf("error decoding map length: %w", err)
+	}
+	for i := 0; i < length; i++ {
+		k, err := dec.DecodeString()
+		if err != nil {
+			return Value{}, path.NewErrorf("error decoding map key: %w", err)
+		}
+		if k == key {
+			return msgpackUnmarshalAtPath(dec, typ, stepPath, steps[1:])
+		}
+		if err := dec.Skip(); err != nil {
+			return Value{}, path.NewErrorf("error skipping map value: %w", err)
+		}
+	}
+	return Value{}, stepPath.NewError(ErrInvalidStep)
+}
+
+// msgpackUnmarshalArrayIndexAtPath continues msgpackUnmarshalAtPath with the
+// element of an encoded list or tuple indicated by the ElementKeyInt step,
+// skipping the elements before it. The elementType function returns the type
+// of the element at a position, or nil if there is no such element.
+func msgpackUnmarshalArrayIndexAtPath(dec *msgpack.Decoder, elementType func(int) Type, path, stepPath *AttributePath, steps []AttributePathStep) (Value, error) {
+	index, ok := steps[0].(ElementKeyInt)
+	if !ok || index < 0 {
+		return Value{}, stepPath.NewError(ErrInvalidStep)
+	}
+	length, err := dec.DecodeArrayLen()
+	if err != nil {
+		return Value{}, path.NewErrorf("error decoding array length: %w", err)
+	}
+	typ := elementType(int(index))
+	if int64(index) >= int64(length) || typ == nil {
+		return Value{}, stepPath.NewError(ErrInvalidStep)
+	}
+	for i := 0; i < int(index); i++ {
+		if err := dec.Skip(); err != nil {
+			return Value{}, path.NewErrorf("error skipping array element: %w", err)
+		}
+	}
+	return msgpackUnmarshalAtPath(dec, typ, stepPath, steps[1:])
+}
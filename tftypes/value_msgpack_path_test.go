@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValueFromMsgPackAtPath(t *testing.T) {
+	t.Parallel()
+
+	tagsType := Set{ElementType: Object{AttributeTypes: map[string]Type{
+		"key":   String,
+		"value": String,
+	}}}
+	objectType := Object{AttributeTypes: map[string]Type{
+		"name":    String,
+		"count":   Number,
+		"list":    List{ElementType: String},
+		"map":     Map{ElementType: Bool},
+		"tuple":   Tuple{ElementTypes: []Type{String, Number}},
+		"tags":    tagsType,
+		"dynamic": DynamicPseudoType,
+		"null":    List{ElementType: String},
+		"unknown": Map{ElementType: String},
+	}}
+	tag := NewValue(tagsType.ElementType, map[string]Value{
+		"key":   NewValue(String, "env"),
+		"value": NewValue(String, "prod"),
+	})
+	value := NewValue(objectType, map[string]Value{
+		"name":  NewValue(String, "hello"),
+		"count": NewValue(Number, 123),
+		"list": NewValue(List{ElementType: String}, []Value{
+			NewValue(String, "a"),
+			NewValue(String, "b"),
+		}),
+		"map": NewValue(Map{ElementType: Bool}, map[string]Value{
+			"x": NewValue(Bool, true),
+			"y": NewValue(Bool, false),
+		}),
+		"tuple": NewValue(Tuple{ElementTypes: []Type{String, Number}}, []Value{
+			NewValue(String, "t"),
+			NewValue(Number, 1),
+		}),
+		"tags": NewValue(tagsType, []Value{tag}),
+		"dynamic": NewValue(Object{AttributeTypes: map[string]Type{"nested": String}}, map[string]Value{
+			"nested": NewValue(String, "n"),
+		}),
+		"null":    NewValue(List{ElementType: String}, nil),
+		"unknown": NewValue(Map{ElementType: String}, UnknownValue),
+	})
+
+	b, err := value.MarshalMsgPack(objectType) //nolint:staticcheck
+	if err != nil {
+		t.Fatalf("unexpected error marshaling: %s", err)
+	}
+
+	tests := map[string]struct {
+		path          *AttributePath
+		expected      Value
+		expectedError string
+	}{
+		"nil": {
+			path:     nil,
+			expected: value,
+		},
+		"empty": {
+			path:     NewAttributePath(),
+			expected: value,
+		},
+		"attribute": {
+			path:     NewAttributePath().WithAttributeName("name"),
+			expected: NewValue(String, "hello"),
+		},
+		"attribute-number": {
+			path:     NewAttributePath().WithAttributeName("count"),
+			expected: NewValue(Number, 123),
+		},
+		"list-element": {
+			path:     NewAttributePath().WithAttributeName("list").WithElementKeyInt(1),
+			expected: NewValue(String, "b"),
+		},
+		"map-element": {
+			path:     NewAttributePath().WithAttributeName("map").WithElementKeyString("y"),
+			expected: NewValue(Bool, false),
+		},
+		"tuple-element": {
+			path:     NewAttributePath().WithAttributeName("tuple").WithElementKeyInt(1),
+			expected: NewValue(Number, 1),
+		},
+		"set-element": {
+			path:     NewAttributePath().WithAttributeName("tags").WithElementKeyValue(tag),
+			expected: tag,
+		},
+		"set-element-attribute": {
+			path:     NewAttributePath().WithAttributeName("tags").WithElementKeyValue(tag).WithAttributeName("value"),
+			expected: NewValue(String, "prod"),
+		},
+		"dynamic-attribute": {
+			path:     NewAttributePath().WithAttributeName("dynamic").WithAttributeName("nested"),
+			expected: NewValue(String, "n"),
+		},
+		"null-collection": {
+			path:     NewAttributePath().WithAttributeName("null"),
+			expected: NewValue(List{ElementType: String}, nil),
+		},
+		"missing-attribute": {
+			path:          NewAttributePath().WithAttributeName("missing"),
+			expectedError: `AttributeName("missing"): step cannot be applied to this value`,
+		},
+		"list-out-of-range": {
+			path:          NewAttributePath().WithAttributeName("list").WithElementKeyInt(2),
+			expectedError: `AttributeName("list").ElementKeyInt(2): step cannot be applied to this value`,
+		},
+		"map-missing-key": {
+			path:          NewAttributePath().WithAttributeName("map").WithElementKeyString("z"),
+			expectedError: `AttributeName("map").ElementKeyString("z"): step cannot be applied to this value`,
+		},
+		"set-missing-element": {
+			path:          NewAttributePath().WithAttributeName("tags").WithElementKeyValue(NewValue(tagsType.ElementType, nil)),
+			expectedError: `AttributeName("tags").ElementKeyValue(tftypes.Object["key":tftypes.String, "value":tftypes.String]<null>): step cannot be applied to this value`,
+		},
+		"set-element-missing-attribute": {
+			path:          NewAttributePath().WithAttributeName("tags").WithElementKeyValue(tag).WithAttributeName("missing"),
+			expectedError: `AttributeName("tags").ElementKeyValue(tftypes.Object["key":tftypes.String, "value":tftypes.String]<"key":tftypes.String<"env">, "value":tftypes.String<"prod">>).AttributeName("missing"): step cannot be applied to this value`,
+		},
+		"null-element": {
+			path:          NewAttributePath().WithAttributeName("null").WithElementKeyInt(0),
+			expectedError: `AttributeName("null").ElementKeyInt(0): step cannot be applied to this value`,
+		},
+		"unknown-element": {
+			path:          NewAttributePath().WithAttributeName("unknown").WithElementKeyString("a"),
+			expectedError: `AttributeName("unknown").ElementKeyString("a"): step cannot be applied to this value`,
+		},
+		"primitive-step": {
+			path:          NewAttributePath().WithAttributeName("name").WithAttributeName("nested"),
+			expectedError: `AttributeName("name").AttributeName("nested"): step cannot be applied to this value`,
+		},
+		"wrong-step-type": {
+			path:          NewAttributePath().WithAttributeName("list").WithElementKeyString("a"),
+			expectedError: `AttributeName("list").ElementKeyString("a"): step cannot be applied to this value`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ValueFromMsgPackAtPath(b, objectType, test.path) //nolint:staticcheck
+
+			// The result must match decoding the whole value.
+			expected, expectedErr := value.AtPath(test.path)
+			if (err == nil) != (expectedErr == nil) {
+				t.Fatalf("expected error %v to match AtPath error %v", err, expectedErr)
+			}
+
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+					t.Errorf("Unexpected error (-wanted, +got): %s", diff)
+				}
+				if diff := cmp.Diff(expectedErr.Error(), err.Error()); diff != "" {
+					t.Errorf("Unexpected difference from AtPath error (-wanted, +got): %s", diff)
+				}
+				if !errors.Is(err, ErrInvalidStep) {
+					t.Errorf("expected error to wrap ErrInvalidStep, got %v", err)
+				}
+				return
+			}
+
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+
+			if diff := cmp.Diff(test.expected, got, cmp.Comparer(numberComparer)); diff != "" {
+				t.Errorf("Unexpected value (-wanted, +got): %s", diff)
+			}
+			if diff := cmp.Diff(expected, got, cmp.Comparer(numberComparer)); diff != "" {
+				t.Errorf("Unexpected difference from AtPath (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
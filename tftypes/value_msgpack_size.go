@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"math"
+	"math/big"
+)
+
+// EncodedSizeEstimate returns the approximate number of bytes in the MsgPack
+// encoding of the Value as `typ`, without encoding it. It is intended for
+// checking Values against size limits, such as the maximum size of gRPC
+// messages, before encoding them.
+//
+// The estimate matches the size of the encoding for Values which can be
+// encoded as `typ`. Values which cannot be encoded, such as those with
+// elements or attributes of the wrong type or missing attributes, are still
+// estimated, skipping missing attributes and counting values of the wrong
+// type as null.
+func (val Value) EncodedSizeEstimate(typ Type) int {
+	if typ == nil {
+		return msgpackNilSize
+	}
+	return msgpackSize(val, typ)
+}
+
+const (
+	// msgpackNilSize is the size of a null value.
+	msgpackNilSize = 1
+
+	// msgpackUnknownSize is the size of msgPackUnknownVal.
+	msgpackUnknownSize = 3
+
+	// msgpackFloat64Size is the size of a float64.
+	msgpackFloat64Size = 9
+)
+
+func msgpackSize(val Value, typ Type) int {
+	// This is called for every element of potentially large Values, so it
+	// uses type implementation details rather than Is(), which results in
+	// memory allocations.
+	if p, ok := typ.(primitive); ok && p.name == DynamicPseudoType.name && val.Type() != nil && !val.Type().Is(DynamicPseudoType) {
+		return msgpackDynamicPseudoTypeSize(val)
+	}
+	if !val.IsKnown() {
+		return msgpackUnknownSize
+	}
+	if val.IsNull() {
+		return msgpackNilSize
+	}
+	switch typ := typ.(type) {
+	case primitive:
+		switch typ.name {
+		case String.name:
+			s, ok := val.value.(string)
+			if !ok {
+				return msgpackNilSize
+			}
+			return msgpackStringSize(s)
+		case Number.name:
+			n, ok := val.value.(*big.Float)
+			if !ok {
+				return msgpackNilSize
+			}
+			return msgpackNumberSize(n)
+		case Bool.name:
+			return 1
+		}
+	case List:
+		return msgpackElementsSize(val, typ.ElementType)
+	case Set:
+		return msgpackElementsSize(val, typ.ElementType)
+	case Tuple:
+		elems, ok := val.value.([]Value)
+		if !ok {
+			return msgpackNilSize
+		}
+		size := msgpackCollectionHeaderSize(len(typ.ElementTypes))
+		for pos, elem := range elems {
+			if pos >= len(typ.ElementTypes) {
+				break
+			}
+			size += msgpackSize(elem, typ.ElementTypes[pos])
+		}
+		return size
+	case Map:
+		elems, ok := val.value.(map[string]Value)
+		if !ok {
+			return msgpackNilSize
+		}
+		size := msgpackCollectionHeaderSize(len(elems))
+		for key, elem := range elems {
+			size += msgpackStringSize(key) + msgpackSize(elem, typ.ElementType)
+		}
+		return size
+	case Object:
+		attrs, ok := val.value.(map[string]Value)
+		if !ok {
+			return msgpackNilSize
+		}
+		size := msgpackCollectionHeaderSize(len(typ.AttributeTypes))
+		for name, attributeType := range typ.AttributeTypes {
+			attr, ok := attrs[name]
+			if !ok {
+				continue
+			}
+			size += msgpackStringSize(name) + msgpackSize(attr, attributeType)
+		}
+		return size
+	}
+	return msgpackNilSize
+}
+
+// msgpackElementsSize returns the size of a List or Set.
+func msgpackElementsSize(val Value, elementType Type) int {
+	elems, ok := val.value.([]Value)
+	if !ok {
+		return msgpackNilSize
+	}
+	size := msgpackCollectionHeaderSize(len(elems))
+	for _, elem := range elems {
+		size += msgpackSize(elem, elementType)
+	}
+	return size
+}
+
+// msgpackDynamicPseudoTypeSize returns the size of a value encoded with its
+// type, as marshalMsgPackDynamicPseudoType does.
+func msgpackDynamicPseudoTypeSize(val Value) int {
+	typeJSON, err := val.Type().MarshalJSON()
+	if err != nil {
+		return msgpackNilSize
+	}
+	return msgpackCollectionHeaderSize(2) + msgpackBytesHeaderSize(len(typeJSON)) + len(typeJSON) + msgpackSize(val, val.Type())
+}
+
+// msgpackNumberSize returns the size of a Number, which is encoded as the
+// smallest integer, a float64, or a string, as marshalMsgPackNumber does.
+func msgpackNumberSize(n *big.Float) int {
+	if n.IsInf() {
+		return msgpackFloat64Size
+	}
+	if iv, acc := n.Int64(); acc == big.Exact {
+		if iv < 0 {
+			return msgpackIntSize(iv)
+		}
+		return msgpackUintSize(uint64(iv))
+	}
+	if uv, acc := n.Uint64(); acc == big.Exact && n.IsInt() {
+		return msgpackUintSize(uv)
+	}
+	if _, acc := n.Float64(); acc == big.Exact && !n.IsInt() {
+		return msgpackFloat64Size
+	}
+	return msgpackStringSize(n.Text('f', -1))
+}
+
+func msgpackUintSize(n uint64) int {
+	switch {
+	case n <= math.MaxInt8:
+		return 1
+	case n <= math.MaxUint8:
+		return 2
+	case n <= math.MaxUint16:
+		return 3
+	case n <= math.MaxUint32:
+		return 5
+	}
+	return 9
+}
+
+func msgpackIntSize(n int64) int {
+	switch {
+	case n >= -32:
+		return 1
+	case n >= math.MinInt8:
+		return 2
+	case n >= math.MinInt16:
+		return 3
+	case n >= math.MinInt32:
+		return 5
+	}
+	return 9
+}
+
+func msgpackStringSize(s string) int {
+	switch l := len(s); {
+	case l < 32:
+		return 1 + l
+	case l < 256:
+		return 2 + l
+	case l <= math.MaxUint16:
+		return 3 + l
+	}
+	return 5 + len(s)
+}
+
+func msgpackBytesHeaderSize(l int) int {
+	switch {
+	case l < 256:
+		return 2
+	case l <= math.MaxUint16:
+		return 3
+	}
+	return 5
+}
+
+// msgpackCollectionHeaderSize returns the size of the header of an array or
+// map with l elements.
+func msgpackCollectionHeaderSize(l int) int {
+	switch {
+	case l < 16:
+		return 1
+	case l <= math.MaxUint16:
+		return 3
+	}
+	return 5
+}
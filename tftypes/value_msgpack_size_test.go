@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestValueEncodedSizeEstimate(t *testing.T) {
+	t.Parallel()
+
+	objectType := Object{
+		AttributeTypes: map[string]Type{
+			"name":    String,
+			"count":   Number,
+			"enabled": Bool,
+			"tags":    Map{ElementType: String},
+			"dynamic": DynamicPseudoType,
+		},
+	}
+	longList := make([]Value, 70000)
+	for pos := range longList {
+		longList[pos] = NewValue(Number, pos)
+	}
+
+	type testCase struct {
+		value Value
+		typ   Type
+	}
+	tests := map[string]testCase{
+		"null":            {value: NewValue(String, nil), typ: String},
+		"unknown":         {value: NewValue(String, UnknownValue), typ: String},
+		"bool":            {value: NewValue(Bool, true), typ: Bool},
+		"string-fixstr":   {value: NewValue(String, "hello"), typ: String},
+		"string-str8":     {value: NewValue(String, strings.Repeat("a", 32)), typ: String},
+		"string-str16":    {value: NewValue(String, strings.Repeat("a", 256)), typ: String},
+		"string-str32":    {value: NewValue(String, strings.Repeat("a", 65536)), typ: String},
+		"string-unicode":  {value: NewValue(String, "こんにちは"), typ: String},
+		"number-fixint":   {value: NewValue(Number, 127), typ: Number},
+		"number-uint8":    {value: NewValue(Number, 255), typ: Number},
+		"number-uint16":   {value: NewValue(Number, 65535), typ: Number},
+		"number-uint32":   {value: NewValue(Number, uint64(math.MaxUint32)), typ: Number},
+		"number-uint64":   {value: NewValue(Number, uint64(math.MaxUint64)), typ: Number},
+		"number-negfix":   {value: NewValue(Number, -32), typ: Number},
+		"number-int8":     {value: NewValue(Number, -33), typ: Number},
+		"number-int16":    {value: NewValue(Number, -129), typ: Number},
+		"number-int32":    {value: NewValue(Number, -32769), typ: Number},
+		"number-int64":    {value: NewValue(Number, int64(math.MinInt64)), typ: Number},
+		"number-float":    {value: NewValue(Number, 1.5), typ: Number},
+		"number-inf":      {value: NewValue(Number, big.NewFloat(math.Inf(-1))), typ: Number},
+		"number-string":   {value: NewValue(Number, mustParseFloat(t, "0.1", 512)), typ: Number},
+		"number-big-int":  {value: NewValue(Number, mustParseFloat(t, "1e30", 512)), typ: Number},
+		"list-empty":      {value: NewValue(List{ElementType: String}, []Value{}), typ: List{ElementType: String}},
+		"list-array16":    {value: NewValue(List{ElementType: Number}, longList[:16]), typ: List{ElementType: Number}},
+		"list-array32":    {value: NewValue(List{ElementType: Number}, longList), typ: List{ElementType: Number}},
+		"set":             {value: NewValue(Set{ElementType: String}, []Value{NewValue(String, "a"), NewValue(String, UnknownValue)}), typ: Set{ElementType: String}},
+		"tuple":           {value: NewValue(Tuple{ElementTypes: []Type{String, Number}}, []Value{NewValue(String, "a"), NewValue(Number, nil)}), typ: Tuple{ElementTypes: []Type{String, Number}}},
+		"map":             {value: NewValue(Map{ElementType: String}, map[string]Value{"a": NewValue(String, "1"), "b": NewValue(String, "2")}), typ: Map{ElementType: String}},
+		"dynamic-unknown": {value: NewValue(DynamicPseudoType, UnknownValue), typ: DynamicPseudoType},
+		"dynamic-string":  {value: NewValue(String, "hello"), typ: DynamicPseudoType},
+		"dynamic-object": {
+			value: NewValue(Object{AttributeTypes: map[string]Type{"a": List{ElementType: String}}}, map[string]Value{
+				"a": NewValue(List{ElementType: String}, []Value{NewValue(String, "b")}),
+			}),
+			typ: DynamicPseudoType,
+		},
+		"object": {
+			value: NewValue(objectType, map[string]Value{
+				"name":    NewValue(String, "test"),
+				"count":   NewValue(Number, 1000),
+				"enabled": NewValue(Bool, nil),
+				"tags": NewValue(Map{ElementType: String}, map[string]Value{
+					"env": NewValue(String, "prod"),
+				}),
+				"dynamic": NewValue(List{ElementType: Number}, []Value{NewValue(Number, 1.5)}),
+			}),
+			typ: objectType,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			encoded, err := AppendValueMsgPack(nil, test.value, test.typ)
+			if err != nil {
+				t.Fatalf("unexpected error encoding: %s", err)
+			}
+
+			if got := test.value.EncodedSizeEstimate(test.typ); got != len(encoded) {
+				t.Errorf("expected an estimate of %d bytes, got %d", len(encoded), got)
+			}
+		})
+	}
+}
+
+func TestValueEncodedSizeEstimate_invalid(t *testing.T) {
+	t.Parallel()
+
+	objectType := Object{
+		AttributeTypes: map[string]Type{
+			"a": String,
+			"b": String,
+		},
+	}
+
+	type testCase struct {
+		value    Value
+		typ      Type
+		expected int
+	}
+	tests := map[string]testCase{
+		"zero-value": {
+			value:    Value{},
+			typ:      String,
+			expected: 1,
+		},
+		"missing-type": {
+			value:    NewValue(String, "hello"),
+			typ:      nil,
+			expected: 1,
+		},
+		"wrong-type": {
+			value:    NewValue(String, "hello"),
+			typ:      Number,
+			expected: 1,
+		},
+		"missing-attribute": {
+			value: NewValue(Object{
+				AttributeTypes: map[string]Type{"a": String, "b": String},
+				OptionalAttributes: map[string]struct{}{
+					"b": {},
+				},
+			}, map[string]Value{
+				"a": NewValue(String, "hello"),
+			}),
+			typ: objectType,
+			// map header, "a" key and value
+			expected: 1 + 2 + 6,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.value.EncodedSizeEstimate(test.typ); got != test.expected {
+				t.Errorf("expected an estimate of %d bytes, got %d", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"bufio"
+	"io"
+
+	msgpack "github.com/vmihailenco/msgpack/v5"
+	msgpackCodes "github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// msgPackEncodeBufferSize is the size of the buffer EncodeMsgPack uses to
+// batch the small writes of the MsgPack encoder.
+const msgPackEncodeBufferSize = 64 * 1024
+
+// EncodeMsgPack writes the MsgPack encoding of the Value to `w`, using `typ`
+// to determine how the Value should be encoded, as with the Value's
+// MarshalMsgPack method. The encoding is written incrementally through a
+// buffer of bounded size, rather than being held in memory in its entirety,
+// reducing peak memory usage for very large values.
+//
+// If an error is returned, some of the encoding may already have been
+// written to `w`.
+func EncodeMsgPack(w io.Writer, val Value, typ Type) error {
+	bw := bufio.NewWriterSize(w, msgPackEncodeBufferSize)
+	enc := msgpack.NewEncoder(bw)
+
+	if err := marshalMsgPack(val, typ, NewAttributePath(), enc); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+// DecodeMsgPackElements decodes a MsgPack-encoded value of a List, Set, Map,
+// Tuple, or Object Type from `r`, calling `fn` with the path and Value of each
+// top-level element or attribute as soon as it is decoded, in the encoded
+// order. Unlike ValueFromMsgPack, the Value containing the elements is never
+// materialized, so memory usage is bounded by the largest element rather
+// than the whole value, such as for resource state containing tens of
+// thousands of set elements.
+//
+// Paths are relative to the decoded value: ElementKeyInt steps for Lists and
+// Tuples, ElementKeyValue steps for Sets, ElementKeyString steps for Maps,
+// and AttributeName steps for Objects. If the decoded value is null or
+// unknown, `fn` is called once with an empty path and the null or unknown
+// Value. A DynamicPseudoType `typ` decodes the elements of the value's
+// concrete type.
+//
+// If `fn` returns an error, decoding stops and the error is returned.
+func DecodeMsgPackElements(r io.Reader, typ Type, fn func(path *AttributePath, val Value) error) error {
+	dec := msgpack.NewDecoder(r)
+
+	return msgpackDecodeElements(dec, typ, NewAttributePath(), fn)
+}
+
+func msgpackDecodeElements(dec *msgpack.Decoder, typ Type, path *AttributePath, fn func(*AttributePath, Value) error) error {
+	peek, err := dec.PeekCode()
+	if err != nil {
+		return path.NewErrorf("error peeking next byte: %w", err)
+	}
+	if msgpackCodes.IsExt(peek) {
+		// as with go-cty, assume all extensions are unknown values
+		err := dec.Skip()
+		if err != nil {
+			return path.NewErrorf("error skipping extension byte: %w", err)
+		}
+		return fn(path, NewValue(typ, UnknownValue))
+	}
+	if peek == msgpackCodes.Nil {
+		err := dec.Skip()
+		if err != nil {
+			return path.NewErrorf("error skipping nil byte: %w", err)
+		}
+		return fn(path, NewValue(typ, nil))
+	}
+	if typ.Is(DynamicPseudoType) {
+		length, err := dec.DecodeArrayLen()
+		if err != nil {
+			return path.NewErrorf("error checking length of DynamicPseudoType value: %w", err)
+		}
+		if length != 2 {
+			return path.NewErrorf("expected %d elements in DynamicPseudoType array, got %d", 2, length)
+		}
+		typeJSON, err := dec.DecodeBytes()
+		if err != nil {
+			return path.NewErrorf("error decoding bytes: %w", err)
+		}
+		typ, err = ParseJSONType(typeJSON)
+		if err != nil {
+			return path.NewErrorf("error parsing type information: %w", err)
+		}
+		return msgpackDecodeElements(dec, typ, path, fn)
+	}
+
+	switch typ := typ.(type) {
+	case List:
+		return msgpackDecodeArrayElements(dec, typ.ElementType, false, path, fn)
+	case Set:
+		return msgpackDecodeArrayElements(dec, typ.ElementType, true, path, fn)
+	case Map:
+		length, err := dec.DecodeMapLen()
+		if err != nil {
+			return path.NewErrorf("error decoding map length: %w", err)
+		}
+		for i := 0; i < length; i++ {
+			key, err := dec.DecodeString()
+			if err != nil {
+				return path.NewErrorf("error decoding map key: %w", err)
+			}
+			innerPath := path.WithElementKeyString(key)
+			val, err := msgpackUnmarshal(dec, typ.ElementType, innerPath, ValueFromMsgPackOpts{})
+			if err != nil {
+				return err
+			}
+			if err := fn(innerPath, val); err != nil {
+				return err
+			}
+		}
+		return nil
+	case Tuple:
+		length, err := dec.DecodeArrayLen()
+		if err != nil {
+			return path.NewErrorf("error decoding tuple length: %w", err)
+		}
+		if length != len(typ.ElementTypes) {
+			return path.NewErrorf("error decoding tuple; expected %d items, got %d", len(typ.ElementTypes), length)
+		}
+		for i := 0; i < length; i++ {
+			innerPath := path.WithElementKeyInt(i)
+			val, err := msgpackUnmarshal(dec, typ.ElementTypes[i], innerPath, ValueFromMsgPackOpts{})
+			if err != nil {
+				return err
+			}
+			if err := fn(innerPath, val); err != nil {
+				return err
+			}
+		}
+		return nil
+	case Object:
+		length, err := dec.DecodeMapLen()
+		if err != nil {
+			return path.NewErrorf("error decoding object length: %w", err)
+		}
+		if length != len(typ.AttributeTypes) {
+			return path.NewErrorf("error decoding object; expected %d attributes, got %d", len(typ.AttributeTypes), length)
+		}
+		for i := 0; i < length; i++ {
+			key, err := dec.DecodeString()
+			if err != nil {
+				return path.NewErrorf("error decoding object key: %w", err)
+			}
+			attrType, exists := typ.AttributeTypes[key]
+			if !exists {
+				return path.NewErrorf("unknown attribute %q", key)
+			}
+			innerPath := path.WithAttributeName(key)
+			val, err := msgpackUnmarshal(dec, attrType, innerPath, ValueFromMsgPackOpts{})
+			if err != nil {
+				return err
+			}
+			if err := fn(innerPath, val); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return path.NewErrorf("cannot decode elements of %s, expected a list, set, map, tuple, or object type", typ)
+}
+
+// msgpackDecodeArrayElements decodes the elements of a List or Set for
+// DecodeMsgPackElements. As the elements are not kept, only the type of the
+// first element is kept to ensure DynamicPseudoType elements all have the
+// same type.
+func msgpackDecodeArrayElements(dec *msgpack.Decoder, elementType Type, set bool, path *AttributePath, fn func(*AttributePath, Value) error) error {
+	length, err := dec.DecodeArrayLen()
+	if err != nil {
+		if set {
+			return path.NewErrorf("error decoding set length: %w", err)
+		}
+		return path.NewErrorf("error decoding list length: %w", err)
+	}
+
+	var firstType Type
+	for i := 0; i < length; i++ {
+		val, err := msgpackUnmarshal(dec, elementType, path.WithElementKeyInt(i), ValueFromMsgPackOpts{})
+		if err != nil {
+			return err
+		}
+		if firstType == nil {
+			firstType = val.Type()
+		} else if !firstType.Equal(val.Type()) {
+			return path.NewErrorf("elements do not all have the same types")
+		}
+		innerPath := path.WithElementKeyInt(i)
+		if set {
+			innerPath = path.WithElementKeyValue(val)
+		}
+		if err := fn(innerPath, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
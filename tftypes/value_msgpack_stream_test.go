@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDecodeMsgPackElements(t *testing.T) {
+	t.Parallel()
+
+	type element struct {
+		Path  *AttributePath
+		Value Value
+	}
+
+	objectType := Object{AttributeTypes: map[string]Type{
+		"name": String,
+		"tags": Set{ElementType: String},
+	}}
+
+	type testCase struct {
+		value         Value
+		typ           Type
+		expected      []element
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"list": {
+			value: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, nil),
+				NewValue(String, UnknownValue),
+			}),
+			typ: List{ElementType: String},
+			expected: []element{
+				{Path: NewAttributePath().WithElementKeyInt(0), Value: NewValue(String, "a")},
+				{Path: NewAttributePath().WithElementKeyInt(1), Value: NewValue(String, nil)},
+				{Path: NewAttributePath().WithElementKeyInt(2), Value: NewValue(String, UnknownValue)},
+			},
+		},
+		"set": {
+			value: NewValue(Set{ElementType: objectType}, []Value{
+				NewValue(objectType, map[string]Value{
+					"name": NewValue(String, "a"),
+					"tags": NewValue(Set{ElementType: String}, []Value{
+						NewValue(String, "b"),
+					}),
+				}),
+			}),
+			typ: Set{ElementType: objectType},
+			expected: []element{
+				{
+					Path: NewAttributePath().WithElementKeyValue(NewValue(objectType, map[string]Value{
+						"name": NewValue(String, "a"),
+						"tags": NewValue(Set{ElementType: String}, []Value{
+							NewValue(String, "b"),
+						}),
+					})),
+					Value: NewValue(objectType, map[string]Value{
+						"name": NewValue(String, "a"),
+						"tags": NewValue(Set{ElementType: String}, []Value{
+							NewValue(String, "b"),
+						}),
+					}),
+				},
+			},
+		},
+		"map": {
+			value: NewValue(Map{ElementType: Number}, map[string]Value{
+				"a": NewValue(Number, 1),
+			}),
+			typ: Map{ElementType: Number},
+			expected: []element{
+				{Path: NewAttributePath().WithElementKeyString("a"), Value: NewValue(Number, 1)},
+			},
+		},
+		"tuple": {
+			value: NewValue(Tuple{ElementTypes: []Type{String, Bool}}, []Value{
+				NewValue(String, "a"),
+				NewValue(Bool, true),
+			}),
+			typ: Tuple{ElementTypes: []Type{String, Bool}},
+			expected: []element{
+				{Path: NewAttributePath().WithElementKeyInt(0), Value: NewValue(String, "a")},
+				{Path: NewAttributePath().WithElementKeyInt(1), Value: NewValue(Bool, true)},
+			},
+		},
+		"object": {
+			value: NewValue(objectType, map[string]Value{
+				"name": NewValue(String, "a"),
+				"tags": NewValue(Set{ElementType: String}, nil),
+			}),
+			typ: objectType,
+			expected: []element{
+				{Path: NewAttributePath().WithAttributeName("name"), Value: NewValue(String, "a")},
+				{Path: NewAttributePath().WithAttributeName("tags"), Value: NewValue(Set{ElementType: String}, nil)},
+			},
+		},
+		"dynamic": {
+			value: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "a"),
+			}),
+			typ: DynamicPseudoType,
+			expected: []element{
+				{Path: NewAttributePath().WithElementKeyInt(0), Value: NewValue(String, "a")},
+			},
+		},
+		"empty": {
+			value: NewValue(List{ElementType: String}, []Value{}),
+			typ:   List{ElementType: String},
+		},
+		"null": {
+			value: NewValue(List{ElementType: String}, nil),
+			typ:   List{ElementType: String},
+			expected: []element{
+				{Path: NewAttributePath(), Value: NewValue(List{ElementType: String}, nil)},
+			},
+		},
+		"unknown": {
+			value: NewValue(objectType, UnknownValue),
+			typ:   objectType,
+			expected: []element{
+				{Path: NewAttributePath(), Value: NewValue(objectType, UnknownValue)},
+			},
+		},
+		"primitive": {
+			value:         NewValue(String, "a"),
+			typ:           String,
+			expectedError: "cannot decode elements of tftypes.String, expected a list, set, map, tuple, or object type",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := test.value.MarshalMsgPack(test.typ) //nolint:staticcheck
+			if err != nil {
+				t.Fatalf("unexpected error marshaling: %s", err)
+			}
+
+			var got []element
+			err = DecodeMsgPackElements(bytes.NewReader(b), test.typ, func(path *AttributePath, val Value) error {
+				got = append(got, element{Path: path, Value: val})
+				return nil
+			})
+
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+					t.Fatalf("Unexpected error (-wanted, +got): %s", diff)
+				}
+				return
+			}
+
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+
+			// Maps and objects are encoded in sorted key order.
+			if diff := cmp.Diff(test.expected, got, cmp.Comparer(numberComparer)); diff != "" {
+				t.Errorf("Unexpected elements (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDecodeMsgPackElements_Stop(t *testing.T) {
+	t.Parallel()
+
+	typ := List{ElementType: String}
+	b, err := NewValue(typ, []Value{
+		NewValue(String, "a"),
+		NewValue(String, "b"),
+	}).MarshalMsgPack(typ) //nolint:staticcheck
+	if err != nil {
+		t.Fatalf("unexpected error marshaling: %s", err)
+	}
+
+	errStop := errors.New("stop")
+	calls := 0
+	err = DecodeMsgPackElements(bytes.NewReader(b), typ, func(_ *AttributePath, _ Value) error {
+		calls++
+		return errStop
+	})
+
+	if !errors.Is(err, errStop) {
+		t.Errorf("expected error %q, got %v", errStop, err)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+type errWriter struct {
+	err error
+}
+
+func (w errWriter) Write(_ []byte) (int, error) {
+	return 0, w.err
+}
+
+func TestEncodeMsgPack(t *testing.T) {
+	t.Parallel()
+
+	largeElements := make([]Value, 0, 10000)
+	for i := 0; i < 10000; i++ {
+		largeElements = append(largeElements, NewValue(Number, i))
+	}
+
+	type testCase struct {
+		value         Value
+		typ           Type
+		writer        io.Writer
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"string": {
+			value: NewValue(String, "hello"),
+			typ:   String,
+		},
+		"unknown": {
+			value: NewValue(String, UnknownValue),
+			typ:   String,
+		},
+		"dynamic": {
+			value: NewValue(Object{AttributeTypes: map[string]Type{
+				"a": List{ElementType: Bool},
+			}}, map[string]Value{
+				"a": NewValue(List{ElementType: Bool}, []Value{
+					NewValue(Bool, true),
+				}),
+			}),
+			typ: DynamicPseudoType,
+		},
+		"larger-than-buffer": {
+			value: NewValue(List{ElementType: Number}, largeElements),
+			typ:   List{ElementType: Number},
+		},
+		"invalid-type": {
+			value:         NewValue(String, "hello"),
+			typ:           Number,
+			expectedError: "unexpected value type string, tftypes.Number values must be of type *big.Float",
+		},
+		"writer-error": {
+			value:         NewValue(String, "hello"),
+			typ:           String,
+			writer:        errWriter{err: errors.New("write failed")},
+			expectedError: "write failed",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			var w io.Writer = &buf
+			if test.writer != nil {
+				w = test.writer
+			}
+
+			err := EncodeMsgPack(w, test.value, test.typ)
+
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+					t.Fatalf("Unexpected error (-wanted, +got): %s", diff)
+				}
+				return
+			}
+
+			if test.expectedError != "" {
+				t.Fatalf("expected error %q, got none", test.expectedError)
+			}
+
+			expected, err := test.value.MarshalMsgPack(test.typ) //nolint:staticcheck
+			if err != nil {
+				t.Fatalf("unexpected error marshaling: %s", err)
+			}
+
+			if !bytes.Equal(expected, buf.Bytes()) {
+				t.Errorf("expected %x, got %x", expected, buf.Bytes())
+			}
+		})
+	}
+}
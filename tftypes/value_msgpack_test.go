@@ -88,7 +88,7 @@ func TestValueFromMsgPack(t *testing.T) {
 			typ:   Number,
 		},
 		"uint64-number": {
-			hex:   "b43138343436373434303733373039353531363135",
+			hex:   "cfffffffffffffffff",
 			value: NewValue(Number, new(big.Float).SetUint64(math.MaxUint64)),
 			typ:   Number,
 		},
@@ -129,7 +129,7 @@ func TestValueFromMsgPack(t *testing.T) {
 			typ:   Number,
 		},
 		"large-uint64": {
-			hex:   "b339323233333732303336383534373735383038",
+			hex:   "cf8000000000000000",
 			value: NewValue(Number, uint64AsFloat),
 			typ:   Number,
 		},
@@ -301,6 +301,17 @@ func TestValueFromMsgPack(t *testing.T) {
 			}),
 			typ: Map{ElementType: String},
 		},
+		"map-string-sorted": {
+			hex: "83a161a131a162a132a163a133",
+			value: NewValue(Map{
+				ElementType: String,
+			}, map[string]Value{
+				"c": NewValue(String, "3"),
+				"a": NewValue(String, "1"),
+				"b": NewValue(String, "2"),
+			}),
+			typ: Map{ElementType: String},
+		},
 		"map-string-unknown": {
 			hex: "81a86772656574696e67d40000",
 			value: NewValue(Map{
@@ -561,3 +572,238 @@ func TestValueFromMsgPack(t *testing.T) {
 		})
 	}
 }
+
+func TestAppendValueMsgPack(t *testing.T) {
+	t.Parallel()
+
+	typ := List{ElementType: String}
+	value := NewValue(typ, []Value{
+		NewValue(String, "hello"),
+		NewValue(String, UnknownValue),
+	})
+
+	expected, err := value.MarshalMsgPack(typ) //nolint:staticcheck
+	if err != nil {
+		t.Fatalf("unexpected error marshaling: %s", err)
+	}
+
+	got, err := AppendValueMsgPack([]byte("prefix"), value, typ)
+	if err != nil {
+		t.Fatalf("unexpected error appending: %s", err)
+	}
+
+	if diff := cmp.Diff(append([]byte("prefix"), expected...), got); diff != "" {
+		t.Errorf("unexpected difference (-wanted, +got): %s", diff)
+	}
+
+	// Encoding another value must not modify results from pooled buffers.
+	expectedCopy := append([]byte(nil), expected...)
+
+	if _, err := NewValue(String, "other").MarshalMsgPack(String); err != nil { //nolint:staticcheck
+		t.Fatalf("unexpected error marshaling: %s", err)
+	}
+
+	if diff := cmp.Diff(expectedCopy, expected); diff != "" {
+		t.Errorf("unexpected modification of previous result (-wanted, +got): %s", diff)
+	}
+
+	if _, err := AppendValueMsgPack(nil, NewValue(String, "hello"), Number); err == nil {
+		t.Errorf("expected error appending invalid value, got none")
+	}
+}
+
+func TestValueFromMsgPackWithOpts(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		hex          string
+		opts         ValueFromMsgPackOpts
+		expected     string
+		expectedPrec uint
+
+		// roundTrip is whether the value must be encoded as hex again.
+		roundTrip bool
+	}
+	tests := map[string]testCase{
+		"int": {
+			hex:          "d085",
+			opts:         ValueFromMsgPackOpts{NumberPrecision: 8},
+			expected:     "-123",
+			expectedPrec: 64,
+			roundTrip:    true,
+		},
+		"uint": {
+			hex:          "cfffffffffffffffff",
+			opts:         ValueFromMsgPackOpts{NumberPrecision: 8},
+			expected:     "18446744073709551615",
+			expectedPrec: 64,
+			roundTrip:    true,
+		},
+		"float-default": {
+			hex:          "cb3ff8000000000000",
+			expected:     "1.5",
+			expectedPrec: 53,
+			roundTrip:    true,
+		},
+		"float-precision": {
+			hex:          "cb3ff8000000000000",
+			opts:         ValueFromMsgPackOpts{NumberPrecision: 128},
+			expected:     "1.5",
+			expectedPrec: 128,
+		},
+		"string-integer": {
+			// "9007199254740993"
+			hex:          "b039303037313939323534373430393933",
+			opts:         ValueFromMsgPackOpts{NumberPrecision: 8},
+			expected:     "9007199254740993",
+			expectedPrec: 64,
+		},
+		"string-default": {
+			// "0.1"
+			hex:          "a3302e31",
+			expected:     "0.1",
+			expectedPrec: 512,
+		},
+		"string-precision": {
+			// "0.1"
+			hex:          "a3302e31",
+			opts:         ValueFromMsgPackOpts{NumberPrecision: 64},
+			expected:     "0.1",
+			expectedPrec: 64,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := hex.DecodeString(test.hex)
+			if err != nil {
+				t.Fatalf("unexpected error parsing hex: %s", err)
+			}
+
+			val, err := ValueFromMsgPackWithOpts(b, Number, test.opts) //nolint:staticcheck
+			if err != nil {
+				t.Fatalf("unexpected error unmarshaling: %s", err)
+			}
+
+			var got big.Float
+			if err := val.As(&got); err != nil {
+				t.Fatalf("unexpected error converting: %s", err)
+			}
+
+			if got.Prec() != test.expectedPrec {
+				t.Errorf("expected precision %d, got %d", test.expectedPrec, got.Prec())
+			}
+
+			if got.Cmp(mustParseFloat(t, test.expected, test.expectedPrec)) != 0 {
+				t.Errorf("expected %s, got %s", test.expected, got.Text('g', -1))
+			}
+
+			if test.roundTrip {
+				roundTrip, err := val.MarshalMsgPack(Number) //nolint:staticcheck
+				if err != nil {
+					t.Fatalf("unexpected error marshaling: %s", err)
+				}
+				if diff := cmp.Diff(test.hex, hex.EncodeToString(roundTrip)); diff != "" {
+					t.Errorf("unexpected round trip difference (-wanted, +got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestValueFromMsgPackWithOpts_Strict(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		hex string
+		typ Type
+
+		// value is the result without Strict, if it succeeds.
+		value         Value
+		expectedError string
+
+		// alwaysError is whether the error is returned without Strict.
+		alwaysError bool
+	}
+	objectType := Object{AttributeTypes: map[string]Type{"a": String, "b": String}}
+	tests := map[string]testCase{
+		"valid": {
+			// "a"
+			hex:   "a161",
+			typ:   String,
+			value: NewValue(String, "a"),
+		},
+		"trailing-data": {
+			// "a" "b"
+			hex:           "a161a162",
+			typ:           String,
+			value:         NewValue(String, "a"),
+			expectedError: `unexpected data after value, 2 bytes remaining`,
+		},
+		"duplicate-map-key": {
+			// {"a": "x", "a": "y"}
+			hex:           "82a161a178a161a179",
+			typ:           Map{ElementType: String},
+			value:         NewValue(Map{ElementType: String}, map[string]Value{"a": NewValue(String, "y")}),
+			expectedError: `ElementKeyString("a"): duplicate map key "a"`,
+		},
+		"duplicate-attribute": {
+			// {"a": "x", "a": "y"}
+			hex:           "82a161a178a161a179",
+			typ:           objectType,
+			expectedError: `AttributeName("a"): duplicate attribute "a"`,
+			alwaysError:   true,
+		},
+		"float-nan": {
+			hex:           "cb7ff8000000000000",
+			typ:           Number,
+			expectedError: `couldn't decode number, NaN is not a number`,
+			alwaysError:   true,
+		},
+		"float-inf": {
+			hex:           "cbfff0000000000000",
+			typ:           Number,
+			value:         NewValue(Number, big.NewFloat(math.Inf(-1))),
+			expectedError: `number -Inf is out of range`,
+		},
+		"string-inf": {
+			// "Inf"
+			hex:           "a3496e66",
+			typ:           Number,
+			value:         NewValue(Number, big.NewFloat(math.Inf(1))),
+			expectedError: `number "Inf" is out of range`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := hex.DecodeString(test.hex)
+			if err != nil {
+				t.Fatalf("unexpected error parsing hex: %s", err)
+			}
+
+			for _, strict := range []bool{false, true} {
+				val, err := ValueFromMsgPackWithOpts(b, test.typ, ValueFromMsgPackOpts{Strict: strict}) //nolint:staticcheck
+				if test.expectedError != "" && (strict || test.alwaysError) {
+					if err == nil {
+						t.Fatalf("expected error %q with Strict %t, got none", test.expectedError, strict)
+					}
+					if diff := cmp.Diff(test.expectedError, err.Error()); diff != "" {
+						t.Errorf("Unexpected error with Strict %t (-wanted, +got): %s", strict, diff)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("unexpected error unmarshaling with Strict %t: %s", strict, err)
+				}
+				if diff := cmp.Diff(test.value, val); diff != "" {
+					t.Errorf("Unexpected results with Strict %t (-wanted, +got): %s", strict, diff)
+				}
+			}
+		})
+	}
+}
@@ -4,8 +4,11 @@
 package tftypes
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -721,6 +724,118 @@ func TestValueIsKnown(t *testing.T) {
 			if test.fullyKnown != fullyKnown {
 				t.Errorf("expected fully known to be %v, is %v", test.fullyKnown, fullyKnown)
 			}
+			if unknownPaths := test.value.UnknownPaths(); test.fullyKnown != (len(unknownPaths) == 0) {
+				t.Errorf("expected fully known to be %v, got unknown paths %v", test.fullyKnown, unknownPaths)
+			}
+		})
+	}
+}
+
+func TestValueUnknownPaths(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		value    Value
+		expected []*AttributePath
+	}
+	tests := map[string]testCase{
+		"known": {
+			value:    NewValue(String, "hello"),
+			expected: nil,
+		},
+		"null": {
+			value:    NewValue(List{ElementType: String}, nil),
+			expected: nil,
+		},
+		"unknown": {
+			value:    NewValue(String, UnknownValue),
+			expected: []*AttributePath{NewAttributePath()},
+		},
+		"unknown-list": {
+			value: NewValue(List{ElementType: String}, UnknownValue),
+			expected: []*AttributePath{
+				NewAttributePath(),
+			},
+		},
+		"list": {
+			value: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, UnknownValue),
+				NewValue(String, "hello"),
+				NewValue(String, UnknownValue),
+			}),
+			expected: []*AttributePath{
+				NewAttributePath().WithElementKeyInt(0),
+				NewAttributePath().WithElementKeyInt(2),
+			},
+		},
+		"set": {
+			value: NewValue(Set{ElementType: String}, []Value{
+				NewValue(String, "hello"),
+				NewValue(String, UnknownValue),
+			}),
+			expected: []*AttributePath{
+				NewAttributePath().WithElementKeyValue(NewValue(String, UnknownValue)),
+			},
+		},
+		"tuple": {
+			value: NewValue(Tuple{ElementTypes: []Type{String, Bool}}, []Value{
+				NewValue(String, "hello"),
+				NewValue(Bool, UnknownValue),
+			}),
+			expected: []*AttributePath{
+				NewAttributePath().WithElementKeyInt(1),
+			},
+		},
+		"map": {
+			value: NewValue(Map{ElementType: String}, map[string]Value{
+				"c": NewValue(String, UnknownValue),
+				"b": NewValue(String, "hello"),
+				"a": NewValue(String, UnknownValue),
+			}),
+			expected: []*AttributePath{
+				NewAttributePath().WithElementKeyString("a"),
+				NewAttributePath().WithElementKeyString("c"),
+			},
+		},
+		"object-nested": {
+			value: NewValue(Object{AttributeTypes: map[string]Type{
+				"name": String,
+				"tags": Map{ElementType: String},
+				"list": List{ElementType: Object{AttributeTypes: map[string]Type{
+					"id": String,
+				}}},
+			}}, map[string]Value{
+				"name": NewValue(String, UnknownValue),
+				"tags": NewValue(Map{ElementType: String}, UnknownValue),
+				"list": NewValue(List{ElementType: Object{AttributeTypes: map[string]Type{
+					"id": String,
+				}}}, []Value{
+					NewValue(Object{AttributeTypes: map[string]Type{
+						"id": String,
+					}}, map[string]Value{
+						"id": NewValue(String, "known"),
+					}),
+					NewValue(Object{AttributeTypes: map[string]Type{
+						"id": String,
+					}}, map[string]Value{
+						"id": NewValue(String, UnknownValue),
+					}),
+				}),
+			}),
+			expected: []*AttributePath{
+				NewAttributePath().WithAttributeName("list").WithElementKeyInt(1).WithAttributeName("id"),
+				NewAttributePath().WithAttributeName("name"),
+				NewAttributePath().WithAttributeName("tags"),
+			},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got := test.value.UnknownPaths()
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected results (-wanted, +got): %s", diff)
+			}
 		})
 	}
 }
@@ -1212,6 +1327,232 @@ func TestValueEqual(t *testing.T) {
 			if result := test.val2.Equal(test.val1); result != test.equal {
 				t.Errorf("expected %v, got %v comparing %s and %s", test.equal, result, test.val2, test.val1)
 			}
+			if result := test.val1.EqualWithOptions(test.val2, ValueEqualOpts{}); result != test.equal {
+				t.Errorf("expected EqualWithOptions %v, got %v comparing %s and %s", test.equal, result, test.val1, test.val2)
+			}
+		})
+	}
+}
+
+func TestValueEqualWithOptions(t *testing.T) {
+	t.Parallel()
+
+	caseInsensitive := ValueComparer{
+		Type: String,
+		Equal: func(val1, val2 Value) bool {
+			var s1, s2 string
+			_ = val1.As(&s1)
+			_ = val2.As(&s2)
+			return strings.EqualFold(s1, s2)
+		},
+	}
+	policyJSON := ValueComparer{
+		Path: NewAttributePath().WithAttributeName("policy"),
+		Equal: func(val1, val2 Value) bool {
+			var s1, s2 string
+			_ = val1.As(&s1)
+			_ = val2.As(&s2)
+			var j1, j2 interface{}
+			if json.Unmarshal([]byte(s1), &j1) != nil || json.Unmarshal([]byte(s2), &j2) != nil {
+				return s1 == s2
+			}
+			return cmp.Equal(j1, j2)
+		},
+	}
+	objectType := Object{AttributeTypes: map[string]Type{
+		"name":   String,
+		"policy": String,
+		"tags":   Set{ElementType: String},
+	}}
+
+	type testCase struct {
+		val1  Value
+		val2  Value
+		opts  ValueEqualOpts
+		equal bool
+	}
+	tests := map[string]testCase{
+		"no-comparers": {
+			val1:  NewValue(String, "Hello"),
+			val2:  NewValue(String, "hello"),
+			equal: false,
+		},
+		"type-comparer": {
+			val1:  NewValue(String, "Hello"),
+			val2:  NewValue(String, "hello"),
+			opts:  ValueEqualOpts{Comparers: []ValueComparer{caseInsensitive}},
+			equal: true,
+		},
+		"type-comparer-other-type": {
+			val1:  NewValue(Number, 1),
+			val2:  NewValue(Number, 2),
+			opts:  ValueEqualOpts{Comparers: []ValueComparer{caseInsensitive}},
+			equal: false,
+		},
+		"type-comparer-null": {
+			val1:  NewValue(String, "hello"),
+			val2:  NewValue(String, nil),
+			opts:  ValueEqualOpts{Comparers: []ValueComparer{caseInsensitive}},
+			equal: false,
+		},
+		"path-comparer": {
+			val1: NewValue(objectType, map[string]Value{
+				"name":   NewValue(String, "test"),
+				"policy": NewValue(String, `{"a": 1, "b": [true]}`),
+				"tags":   NewValue(Set{ElementType: String}, nil),
+			}),
+			val2: NewValue(objectType, map[string]Value{
+				"name":   NewValue(String, "test"),
+				"policy": NewValue(String, `{"b":[true],"a":1}`),
+				"tags":   NewValue(Set{ElementType: String}, nil),
+			}),
+			opts:  ValueEqualOpts{Comparers: []ValueComparer{policyJSON}},
+			equal: true,
+		},
+		"path-comparer-other-path": {
+			val1: NewValue(objectType, map[string]Value{
+				"name":   NewValue(String, `{"a": 1}`),
+				"policy": NewValue(String, nil),
+				"tags":   NewValue(Set{ElementType: String}, nil),
+			}),
+			val2: NewValue(objectType, map[string]Value{
+				"name":   NewValue(String, `{"a":1}`),
+				"policy": NewValue(String, nil),
+				"tags":   NewValue(Set{ElementType: String}, nil),
+			}),
+			opts:  ValueEqualOpts{Comparers: []ValueComparer{policyJSON}},
+			equal: false,
+		},
+		"set-elements": {
+			val1: NewValue(objectType, map[string]Value{
+				"name":   NewValue(String, "test"),
+				"policy": NewValue(String, nil),
+				"tags": NewValue(Set{ElementType: String}, []Value{
+					NewValue(String, "A"),
+					NewValue(String, "b"),
+				}),
+			}),
+			val2: NewValue(objectType, map[string]Value{
+				"name":   NewValue(String, "TEST"),
+				"policy": NewValue(String, nil),
+				"tags": NewValue(Set{ElementType: String}, []Value{
+					NewValue(String, "B"),
+					NewValue(String, "a"),
+				}),
+			}),
+			opts:  ValueEqualOpts{Comparers: []ValueComparer{caseInsensitive}},
+			equal: true,
+		},
+		"set-elements-diff": {
+			val1: NewValue(Set{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, "b"),
+			}),
+			val2: NewValue(Set{ElementType: String}, []Value{
+				NewValue(String, "A"),
+				NewValue(String, "c"),
+			}),
+			opts:  ValueEqualOpts{Comparers: []ValueComparer{caseInsensitive}},
+			equal: false,
+		},
+		"list-elements": {
+			val1: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "a"),
+				NewValue(String, UnknownValue),
+			}),
+			val2: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "A"),
+				NewValue(String, UnknownValue),
+			}),
+			opts:  ValueEqualOpts{Comparers: []ValueComparer{caseInsensitive}},
+			equal: true,
+		},
+		"different-types": {
+			val1:  NewValue(String, "a"),
+			val2:  NewValue(List{ElementType: String}, nil),
+			opts:  ValueEqualOpts{Comparers: []ValueComparer{{Equal: func(_, _ Value) bool { return true }}}},
+			equal: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if result := test.val1.EqualWithOptions(test.val2, test.opts); result != test.equal {
+				t.Errorf("expected %v, got %v comparing %s and %s", test.equal, result, test.val1, test.val2)
+			}
+			if result := test.val2.EqualWithOptions(test.val1, test.opts); result != test.equal {
+				t.Errorf("expected %v, got %v comparing %s and %s", test.equal, result, test.val2, test.val1)
+			}
+		})
+	}
+}
+
+func TestValueCopy(t *testing.T) {
+	t.Parallel()
+	type testCase struct {
+		val    func() Value
+		mutate func(Value)
+	}
+	tests := map[string]testCase{
+		"empty": {
+			val:    func() Value { return Value{} },
+			mutate: func(Value) {},
+		},
+		"unknown": {
+			val:    func() Value { return NewValue(String, UnknownValue) },
+			mutate: func(Value) {},
+		},
+		"number": {
+			val: func() Value { return NewValue(Number, big.NewFloat(1.5)) },
+			mutate: func(v Value) {
+				//nolint:forcetypeassert // test value is a Number
+				v.value.(*big.Float).SetInt64(2)
+			},
+		},
+		"list": {
+			val: func() Value {
+				return NewValue(List{ElementType: Number}, []Value{
+					NewValue(Number, 1),
+					NewValue(Number, 2),
+				})
+			},
+			mutate: func(v Value) {
+				//nolint:forcetypeassert // test value is a List
+				elems := v.value.([]Value)
+				elems[0] = NewValue(Number, 3)
+				//nolint:forcetypeassert // test value is a Number
+				elems[1].value.(*big.Float).SetInt64(4)
+			},
+		},
+		"object": {
+			val: func() Value {
+				return NewValue(Object{AttributeTypes: map[string]Type{
+					"tags": Map{ElementType: String},
+				}}, map[string]Value{
+					"tags": NewValue(Map{ElementType: String}, map[string]Value{
+						"a": NewValue(String, "b"),
+					}),
+				})
+			},
+			mutate: func(v Value) {
+				//nolint:forcetypeassert // test value is an Object of Maps
+				tags := v.value.(map[string]Value)["tags"].value.(map[string]Value)
+				tags["a"] = NewValue(String, "c")
+				tags["d"] = NewValue(String, "e")
+			},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			original := test.val()
+			copied := original.Copy()
+			test.mutate(original)
+			if diff := cmp.Diff(test.val(), copied, cmp.Comparer(numberComparer)); diff != "" {
+				t.Errorf("unexpected copy difference after mutating original (-wanted, +got): %s", diff)
+			}
 		})
 	}
 }
@@ -1709,6 +2050,256 @@ func TestValueWalkAttributePath(t *testing.T) {
 	}
 }
 
+func TestValueAtPath(t *testing.T) {
+	t.Parallel()
+	objectType := Object{AttributeTypes: map[string]Type{
+		"list": List{ElementType: String},
+		"null": Map{ElementType: String},
+	}}
+	objectValue := NewValue(objectType, map[string]Value{
+		"list": NewValue(List{ElementType: String}, []Value{
+			NewValue(String, "foo"), NewValue(String, "bar"),
+		}),
+		"null": NewValue(Map{ElementType: String}, nil),
+	})
+	type testCase struct {
+		val           Value
+		path          *AttributePath
+		expected      Value
+		expectedError error
+	}
+	tests := map[string]testCase{
+		"nil-path": {
+			val:      NewValue(String, "hello"),
+			path:     nil,
+			expected: NewValue(String, "hello"),
+		},
+		"empty-path": {
+			val:      objectValue,
+			path:     NewAttributePath(),
+			expected: objectValue,
+		},
+		"attribute": {
+			val:  objectValue,
+			path: NewAttributePath().WithAttributeName("list"),
+			expected: NewValue(List{ElementType: String}, []Value{
+				NewValue(String, "foo"), NewValue(String, "bar"),
+			}),
+		},
+		"element": {
+			val:      objectValue,
+			path:     NewAttributePath().WithAttributeName("list").WithElementKeyInt(1),
+			expected: NewValue(String, "bar"),
+		},
+		"missing-attribute": {
+			val:           objectValue,
+			path:          NewAttributePath().WithAttributeName("missing").WithElementKeyInt(0),
+			expectedError: NewAttributePath().WithAttributeName("missing").NewError(ErrInvalidStep),
+		},
+		"missing-element": {
+			val:           objectValue,
+			path:          NewAttributePath().WithAttributeName("list").WithElementKeyInt(2),
+			expectedError: NewAttributePath().WithAttributeName("list").WithElementKeyInt(2).NewError(ErrInvalidStep),
+		},
+		"null-collection": {
+			val:           objectValue,
+			path:          NewAttributePath().WithAttributeName("null").WithElementKeyString("a"),
+			expectedError: NewAttributePath().WithAttributeName("null").WithElementKeyString("a").NewError(ErrInvalidStep),
+		},
+		"invalid-step-type": {
+			val:           objectValue,
+			path:          NewAttributePath().WithAttributeName("list").WithElementKeyString("a"),
+			expectedError: NewAttributePath().WithAttributeName("list").WithElementKeyString("a").NewError(ErrInvalidStep),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, err := test.val.AtPath(test.path)
+			if diff := cmp.Diff(test.expectedError, err); diff != "" {
+				t.Fatalf("unexpected error difference: %s", diff)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrInvalidStep) {
+					t.Errorf("expected error to wrap ErrInvalidStep, got: %s", err)
+				}
+				return
+			}
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueSetAtPath(t *testing.T) {
+	t.Parallel()
+	listType := List{ElementType: String}
+	mapType := Map{ElementType: String}
+	setType := Set{ElementType: String}
+	tupleType := Tuple{ElementTypes: []Type{String, Bool}}
+	nestedType := Object{AttributeTypes: map[string]Type{
+		"string": String,
+	}}
+	objectType := Object{AttributeTypes: map[string]Type{
+		"dynamic": DynamicPseudoType,
+		"list":    listType,
+		"map":     mapType,
+		"nested":  nestedType,
+		"set":     setType,
+		"tuple":   tupleType,
+	}}
+	testObject := func(values map[string]Value) Value {
+		object := map[string]Value{
+			"dynamic": NewValue(DynamicPseudoType, nil),
+			"list": NewValue(listType, []Value{
+				NewValue(String, "a"),
+			}),
+			"map":    NewValue(mapType, nil),
+			"nested": NewValue(nestedType, nil),
+			"set": NewValue(setType, []Value{
+				NewValue(String, "a"),
+			}),
+			"tuple": NewValue(tupleType, nil),
+		}
+		for name, value := range values {
+			object[name] = value
+		}
+		return NewValue(objectType, object)
+	}
+	type testCase struct {
+		path          *AttributePath
+		newVal        Value
+		expected      Value
+		expectedError error
+	}
+	tests := map[string]testCase{
+		"empty-path": {
+			path:     NewAttributePath(),
+			newVal:   NewValue(objectType, nil),
+			expected: NewValue(objectType, nil),
+		},
+		"list-element": {
+			path:   NewAttributePath().WithAttributeName("list").WithElementKeyInt(0),
+			newVal: NewValue(String, "b"),
+			expected: testObject(map[string]Value{
+				"list": NewValue(listType, []Value{
+					NewValue(String, "b"),
+				}),
+			}),
+		},
+		"list-append": {
+			path:   NewAttributePath().WithAttributeName("list").WithElementKeyInt(1),
+			newVal: NewValue(String, "b"),
+			expected: testObject(map[string]Value{
+				"list": NewValue(listType, []Value{
+					NewValue(String, "a"),
+					NewValue(String, "b"),
+				}),
+			}),
+		},
+		"list-out-of-range": {
+			path:          NewAttributePath().WithAttributeName("list").WithElementKeyInt(2),
+			newVal:        NewValue(String, "b"),
+			expectedError: NewAttributePath().WithAttributeName("list").WithElementKeyInt(2).NewError(ErrInvalidStep),
+		},
+		"map-created": {
+			path:   NewAttributePath().WithAttributeName("map").WithElementKeyString("key"),
+			newVal: NewValue(String, "b"),
+			expected: testObject(map[string]Value{
+				"map": NewValue(mapType, map[string]Value{
+					"key": NewValue(String, "b"),
+				}),
+			}),
+		},
+		"object-created": {
+			path:   NewAttributePath().WithAttributeName("nested").WithAttributeName("string"),
+			newVal: NewValue(String, "b"),
+			expected: testObject(map[string]Value{
+				"nested": NewValue(nestedType, map[string]Value{
+					"string": NewValue(String, "b"),
+				}),
+			}),
+		},
+		"tuple-created": {
+			path:   NewAttributePath().WithAttributeName("tuple").WithElementKeyInt(1),
+			newVal: NewValue(Bool, true),
+			expected: testObject(map[string]Value{
+				"tuple": NewValue(tupleType, []Value{
+					NewValue(String, nil),
+					NewValue(Bool, true),
+				}),
+			}),
+		},
+		"set-element": {
+			path:   NewAttributePath().WithAttributeName("set").WithElementKeyValue(NewValue(String, "a")),
+			newVal: NewValue(String, "b"),
+			expected: testObject(map[string]Value{
+				"set": NewValue(setType, []Value{
+					NewValue(String, "b"),
+				}),
+			}),
+		},
+		"set-missing-element": {
+			path:          NewAttributePath().WithAttributeName("set").WithElementKeyValue(NewValue(String, "b")),
+			newVal:        NewValue(String, "c"),
+			expectedError: NewAttributePath().WithAttributeName("set").WithElementKeyValue(NewValue(String, "b")).NewError(ErrInvalidStep),
+		},
+		"dynamic": {
+			path:   NewAttributePath().WithAttributeName("dynamic"),
+			newVal: NewValue(Number, big.NewFloat(1)),
+			expected: testObject(map[string]Value{
+				"dynamic": NewValue(Number, big.NewFloat(1)),
+			}),
+		},
+		"dynamic-null-container": {
+			path:          NewAttributePath().WithAttributeName("dynamic").WithAttributeName("string"),
+			newVal:        NewValue(String, "b"),
+			expectedError: NewAttributePath().WithAttributeName("dynamic").WithAttributeName("string").NewError(fmt.Errorf("%w: cannot create value within null tftypes.DynamicPseudoType value", ErrInvalidStep)),
+		},
+		"missing-attribute": {
+			path:          NewAttributePath().WithAttributeName("missing"),
+			newVal:        NewValue(String, "b"),
+			expectedError: NewAttributePath().WithAttributeName("missing").NewError(ErrInvalidStep),
+		},
+		"wrong-type": {
+			path:          NewAttributePath().WithAttributeName("list").WithElementKeyInt(0),
+			newVal:        NewValue(Bool, true),
+			expectedError: NewAttributePath().WithAttributeName("list").WithElementKeyInt(0).NewErrorf("cannot use tftypes.Bool value as tftypes.String"),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			original := testObject(nil)
+			got, err := original.SetAtPath(test.path, test.newVal)
+			if diff := cmp.Diff(test.expectedError, err); diff != "" {
+				t.Fatalf("unexpected error difference: %s", diff)
+			}
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+			if diff := cmp.Diff(testObject(nil), original); diff != "" {
+				t.Errorf("unexpected modification of original value: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueSetAtPath_Unknown(t *testing.T) {
+	t.Parallel()
+	listType := List{ElementType: String}
+	_, err := NewValue(listType, UnknownValue).SetAtPath(NewAttributePath().WithElementKeyInt(0), NewValue(String, "a"))
+	expectedError := NewAttributePath().WithElementKeyInt(0).NewErrorf("cannot set value within unknown value")
+	if diff := cmp.Diff(expectedError, err); diff != "" {
+		t.Errorf("unexpected error difference: %s", diff)
+	}
+}
+
 func TestValueString(t *testing.T) {
 	t.Parallel()
 	type testCase struct {
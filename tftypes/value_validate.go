@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ValidateValueErrors is identical to ValidateValue, except it returns an
+// error for every location where `val` does not conform to `t`, rather than
+// only the first. Errors for locations within the value are
+// AttributePathErrors, indicating the element or attribute, such as every
+// missing object attribute and every element of a list whose type cannot be
+// used as the element type. Nested Values whose type is the same kind of type
+// as expected, such as an object attribute containing an Object with a
+// different attribute type, are validated recursively to report the nested
+// locations. A nil response indicates that the value is valid for the type.
+//
+// This allows provider responses to be validated in tests with precise
+// diagnostics before they are passed to NewValue.
+func ValidateValueErrors(t Type, val interface{}) []error {
+	errs := validateValue(t, val, NewAttributePath())
+
+	// Ensure this is never more lenient than ValidateValue, should a
+	// constraint not be covered by validateValue.
+	if len(errs) == 0 {
+		if err := ValidateValue(t, val); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func validateValue(t Type, val interface{}, path *AttributePath) []error {
+	if t == nil {
+		return []error{path.NewErrorf("missing type")}
+	}
+
+	if val == nil || val == UnknownValue {
+		return nil
+	}
+
+	if creator, ok := val.(ValueCreator); ok {
+		var err error
+
+		val, err = creator.ToTerraform5Value()
+
+		if err != nil {
+			return []error{path.NewErrorf("error creating tftypes.Value: %w", err)}
+		}
+	}
+
+	switch typ := t.(type) {
+	case List:
+		elems, ok := val.([]Value)
+
+		if !ok {
+			break
+		}
+
+		return validateElements(typ.ElementType, elems, path, "lists")
+	case Set:
+		elems, ok := val.([]Value)
+
+		if !ok {
+			break
+		}
+
+		var errs []error
+
+		for _, elem := range elems {
+			errs = append(errs, validateNestedValue(typ.ElementType, elem, path.WithElementKeyValue(elem))...)
+		}
+
+		if len(errs) > 0 {
+			return errs
+		}
+
+		return validateSameTypes(elems, path, "sets")
+	case Map:
+		elems, ok := val.(map[string]Value)
+
+		if !ok {
+			break
+		}
+
+		var errs []error
+
+		keys := sortedValueKeys(elems)
+
+		for _, key := range keys {
+			errs = append(errs, validateNestedValue(typ.ElementType, elems[key], path.WithElementKeyString(key))...)
+		}
+
+		if len(errs) > 0 {
+			return errs
+		}
+
+		orderedElems := make([]Value, 0, len(keys))
+
+		for _, key := range keys {
+			orderedElems = append(orderedElems, elems[key])
+		}
+
+		return validateSameTypes(orderedElems, path, "maps")
+	case Tuple:
+		elems, ok := val.([]Value)
+
+		if !ok || typ.ElementTypes == nil {
+			break
+		}
+
+		if len(elems) != len(typ.ElementTypes) {
+			return []error{path.NewErrorf("can't create a tftypes.Value with %d elements, type %s requires %d elements", len(elems), typ, len(typ.ElementTypes))}
+		}
+
+		var errs []error
+
+		for pos, elem := range elems {
+			errs = append(errs, validateNestedValue(typ.ElementTypes[pos], elem, path.WithElementKeyInt(pos))...)
+		}
+
+		return errs
+	case Object:
+		attrs, ok := val.(map[string]Value)
+
+		if !ok || typ.AttributeTypes == nil {
+			break
+		}
+
+		var errs []error
+
+		for _, name := range sortedTypeKeys(typ.AttributeTypes) {
+			if _, ok := attrs[name]; !ok && !typ.attrIsOptional(name) {
+				errs = append(errs, path.WithAttributeName(name).NewErrorf("required attribute %q not set", name))
+			}
+		}
+
+		for _, name := range sortedValueKeys(attrs) {
+			attrType, ok := typ.AttributeTypes[name]
+
+			if !ok {
+				errs = append(errs, path.WithAttributeName(name).NewErrorf("attribute %q not part of the object type %s", name, typ))
+				continue
+			}
+
+			errs = append(errs, validateNestedValue(attrType, attrs[name], path.WithAttributeName(name))...)
+		}
+
+		return errs
+	}
+
+	if err := ValidateValue(t, val); err != nil {
+		return []error{path.NewError(err)}
+	}
+
+	return nil
+}
+
+// validateElements validates the elements of a list, which must all be
+// usable as elementType and all be of the same type.
+func validateElements(elementType Type, elems []Value, path *AttributePath, kind string) []error {
+	var errs []error
+
+	for pos, elem := range elems {
+		errs = append(errs, validateNestedValue(elementType, elem, path.WithElementKeyInt(pos))...)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return validateSameTypes(elems, path, kind)
+}
+
+// validateSameTypes returns an error if the elements of a collection are not
+// all of the same type, such as elements of different types for a
+// DynamicPseudoType element type.
+func validateSameTypes(elems []Value, path *AttributePath, kind string) []error {
+	var elemType Type
+
+	for _, elem := range elems {
+		if elemType == nil {
+			elemType = elem.Type()
+			continue
+		}
+
+		if !elemType.Equal(elem.Type()) {
+			return []error{path.NewError(fmt.Errorf("%s must only contain one type of element, saw %s and %s", kind, elemType, elem.Type()))}
+		}
+	}
+
+	return nil
+}
+
+// validateNestedValue validates a Value within a collection or object, which
+// must be usable as `t`. If the Value's type is the same kind of type as `t`
+// but not usable as `t`, its contents are validated against `t` to report the
+// nested locations.
+func validateNestedValue(t Type, val Value, path *AttributePath) []error {
+	if val.Type() == nil {
+		return []error{path.NewErrorf("missing value type")}
+	}
+
+	if val.Type().UsableAs(t) {
+		return nil
+	}
+
+	if val.Type().Is(t) && val.IsKnown() && !val.IsNull() {
+		if errs := validateValue(t, val.value, path); len(errs) > 0 {
+			return errs
+		}
+	}
+
+	return []error{path.NewErrorf("can't use %s as %s", val.Type(), t)}
+}
+
+func sortedValueKeys(m map[string]Value) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func sortedTypeKeys(m map[string]Type) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
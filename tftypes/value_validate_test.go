@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateValueErrors(t *testing.T) {
+	t.Parallel()
+
+	objectType := Object{
+		AttributeTypes: map[string]Type{
+			"name":     String,
+			"count":    Number,
+			"optional": Bool,
+			"nested": Object{AttributeTypes: map[string]Type{
+				"id": Number,
+			}},
+		},
+		OptionalAttributes: map[string]struct{}{
+			"optional": {},
+		},
+	}
+
+	type testCase struct {
+		typ      Type
+		val      interface{}
+		expected []string
+	}
+	tests := map[string]testCase{
+		"valid-primitive": {
+			typ: String,
+			val: "hello",
+		},
+		"valid-null": {
+			typ: objectType,
+			val: nil,
+		},
+		"valid-unknown": {
+			typ: List{ElementType: String},
+			val: UnknownValue,
+		},
+		"valid-object": {
+			typ: objectType,
+			val: map[string]Value{
+				"name":  NewValue(String, "hello"),
+				"count": NewValue(Number, 1),
+				"nested": NewValue(Object{AttributeTypes: map[string]Type{
+					"id": Number,
+				}}, map[string]Value{
+					"id": NewValue(Number, 1),
+				}),
+			},
+		},
+		"invalid-primitive": {
+			typ:      String,
+			val:      true,
+			expected: []string{"tftypes.NewValue can't use bool as a tftypes.String; expected types are: string or *string"},
+		},
+		"invalid-collection-go-type": {
+			typ:      List{ElementType: String},
+			val:      "hello",
+			expected: []string{"tftypes.NewValue can't use string as a tftypes.List; expected types are: []tftypes.Value"},
+		},
+		"object-all-errors": {
+			typ: objectType,
+			val: map[string]Value{
+				"name":  NewValue(Number, 1),
+				"other": NewValue(String, "hello"),
+				"nested": NewValue(Object{AttributeTypes: map[string]Type{
+					"id": String,
+				}}, map[string]Value{
+					"id": NewValue(String, "hello"),
+				}),
+			},
+			expected: []string{
+				`AttributeName("count"): required attribute "count" not set`,
+				`AttributeName("name"): can't use tftypes.Number as tftypes.String`,
+				`AttributeName("nested").AttributeName("id"): can't use tftypes.String as tftypes.Number`,
+				`AttributeName("other"): attribute "other" not part of the object type tftypes.Object["count":tftypes.Number, "name":tftypes.String, "nested":tftypes.Object["id":tftypes.Number], "optional":tftypes.Bool?]`,
+			},
+		},
+		"list-elements": {
+			typ: List{ElementType: String},
+			val: []Value{
+				NewValue(Number, 1),
+				NewValue(String, "hello"),
+				NewValue(Bool, true),
+				{},
+			},
+			expected: []string{
+				`ElementKeyInt(0): can't use tftypes.Number as tftypes.String`,
+				`ElementKeyInt(2): can't use tftypes.Bool as tftypes.String`,
+				`ElementKeyInt(3): missing value type`,
+			},
+		},
+		"list-mixed-dynamic": {
+			typ: List{ElementType: DynamicPseudoType},
+			val: []Value{
+				NewValue(String, "hello"),
+				NewValue(Number, 1),
+			},
+			expected: []string{
+				`lists must only contain one type of element, saw tftypes.String and tftypes.Number`,
+			},
+		},
+		"map-elements": {
+			typ: Map{ElementType: List{ElementType: String}},
+			val: map[string]Value{
+				"a": NewValue(List{ElementType: Number}, []Value{
+					NewValue(Number, 1),
+				}),
+				"b": NewValue(String, "hello"),
+			},
+			expected: []string{
+				`ElementKeyString("a").ElementKeyInt(0): can't use tftypes.Number as tftypes.String`,
+				`ElementKeyString("b"): can't use tftypes.String as tftypes.List[tftypes.String]`,
+			},
+		},
+		"set-elements": {
+			typ: Set{ElementType: String},
+			val: []Value{
+				NewValue(Number, 1),
+			},
+			expected: []string{
+				`ElementKeyValue(tftypes.Number<"1">): can't use tftypes.Number as tftypes.String`,
+			},
+		},
+		"tuple-length": {
+			typ: Tuple{ElementTypes: []Type{String, Number}},
+			val: []Value{
+				NewValue(String, "hello"),
+			},
+			expected: []string{
+				`can't create a tftypes.Value with 1 elements, type tftypes.Tuple[tftypes.String, tftypes.Number] requires 2 elements`,
+			},
+		},
+		"tuple-elements": {
+			typ: Tuple{ElementTypes: []Type{String, Number}},
+			val: []Value{
+				NewValue(Number, 1),
+				NewValue(String, "hello"),
+			},
+			expected: []string{
+				`ElementKeyInt(0): can't use tftypes.Number as tftypes.String`,
+				`ElementKeyInt(1): can't use tftypes.String as tftypes.Number`,
+			},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			for _, err := range ValidateValueErrors(test.typ, test.val) {
+				got = append(got, err.Error())
+			}
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected errors (-wanted, +got): %s", diff)
+			}
+
+			if (ValidateValue(test.typ, test.val) == nil) != (len(got) == 0) {
+				t.Errorf("expected ValidateValue to agree with ValidateValueErrors")
+			}
+		})
+	}
+}
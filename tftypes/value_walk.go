@@ -5,13 +5,32 @@ package tftypes
 
 import "fmt"
 
+// AtPath returns the Value that `path` is pointing to within the Value, such
+// as an attribute of an object or an element of a list, so nested values can
+// be read without converting each level with As. An empty or nil path returns
+// the Value itself.
+//
+// The error is an AttributePathError for the path up to and including the
+// step which could not be applied. It wraps ErrInvalidStep if the step does
+// not exist in the Value, such as a missing attribute or an element of a null
+// or unknown collection.
+func (v Value) AtPath(path *AttributePath) (Value, error) {
+	result, remaining, err := v.walkAttributePath(path)
+
+	if err != nil {
+		steps := path.Steps()
+		failedSteps := steps[:len(steps)-len(remaining.Steps())+1]
+
+		return Value{}, NewAttributePathWithSteps(failedSteps).NewError(err)
+	}
+
+	return result, nil
+}
+
 // walkAttributePath will return the Value that `path` is pointing to within the
 // Value. If an error is returned, the AttributePath returned will indicate
 // will indicate the steps that remained to be applied when the error was
 // encountered.
-//
-// This implementation, along with one for Type, could be exported to deprecate
-// the overly generic WalkAttributePath function.
 func (v Value) walkAttributePath(path *AttributePath) (Value, *AttributePath, error) {
 	if path == nil || len(path.steps) == 0 {
 		return v, path, nil
@@ -31,3 +50,186 @@ func (v Value) walkAttributePath(path *AttributePath) (Value, *AttributePath, er
 
 	return nextValue.walkAttributePath(NewAttributePathWithSteps(path.steps[1:]))
 }
+
+// SetAtPath returns a copy of the Value with the value that `path` is pointing
+// to replaced by newVal, such as an attribute of an object or an element of a
+// list. The Value itself is not modified. An empty or nil path returns newVal.
+// The Type of newVal must be usable as the Type at the path, such as the
+// element type of a list.
+//
+// Null containers along the path are created where their contents are
+// unambiguous: null objects become objects with null attributes, null tuples
+// become tuples with null elements, and null maps and lists become empty.
+// Missing map keys are added, and an ElementKeyInt step equal to the length
+// of a list appends an element. Unknown values and null values of
+// DynamicPseudoType cannot be created into containers, and set elements must
+// already exist to be replaced.
+//
+// The error is an AttributePathError for the path up to and including the
+// step which could not be applied. It wraps ErrInvalidStep if the step does
+// not exist in the Value and cannot be created.
+func (v Value) SetAtPath(path *AttributePath, newVal Value) (Value, error) {
+	return v.setAtPath(NewAttributePath(), path.Steps(), v.Type(), newVal)
+}
+
+// setAtPath implements SetAtPath for the remaining steps, where path is the
+// path of the Value and typ is the Type expected at the path by its parent.
+func (v Value) setAtPath(path *AttributePath, steps []AttributePathStep, typ Type, newVal Value) (Value, error) {
+	if len(steps) == 0 {
+		if newVal.Type() == nil || !newVal.Type().UsableAs(typ) {
+			return Value{}, path.NewErrorf("cannot use %s value as %s", newVal.Type(), typ)
+		}
+		return newVal, nil
+	}
+
+	stepPath := NewAttributePathWithSteps(append(path.Steps(), steps[0]))
+
+	if !v.IsKnown() {
+		return Value{}, stepPath.NewErrorf("cannot set value within unknown value")
+	}
+
+	if v.IsNull() {
+		created, err := v.createContainer()
+		if err != nil {
+			return Value{}, stepPath.NewError(err)
+		}
+		created.marks = v.marks
+		v = created
+	}
+
+	switch step := steps[0].(type) {
+	case AttributeName:
+		objectType, ok := v.Type().(Object)
+		if !ok {
+			return Value{}, stepPath.NewError(ErrInvalidStep)
+		}
+		attributeType, ok := objectType.AttributeTypes[string(step)]
+		if !ok {
+			return Value{}, stepPath.NewError(ErrInvalidStep)
+		}
+		return v.setMapValue(stepPath, steps, string(step), attributeType, newVal)
+	case ElementKeyString:
+		mapType, ok := v.Type().(Map)
+		if !ok {
+			return Value{}, stepPath.NewError(ErrInvalidStep)
+		}
+		return v.setMapValue(stepPath, steps, string(step), mapType.ElementType, newVal)
+	case ElementKeyInt:
+		var elementType Type
+		elements, ok := v.value.([]Value)
+		if !ok {
+			return Value{}, stepPath.NewErrorf("cannot convert %T into []tftypes.Value", v.value)
+		}
+		switch typ := v.Type().(type) {
+		case List:
+			elementType = typ.ElementType
+			if int64(step) == int64(len(elements)) {
+				elements = append(elements, NewValue(elementType, nil))
+			}
+		case Tuple:
+			if int64(step) >= 0 && int64(step) < int64(len(typ.ElementTypes)) {
+				elementType = typ.ElementTypes[step]
+			}
+		default:
+			return Value{}, stepPath.NewError(ErrInvalidStep)
+		}
+		if int64(step) < 0 || int64(step) >= int64(len(elements)) {
+			return Value{}, stepPath.NewError(ErrInvalidStep)
+		}
+		return v.setSliceValue(stepPath, steps, elements, int(step), elementType, newVal)
+	case ElementKeyValue:
+		setType, ok := v.Type().(Set)
+		if !ok {
+			return Value{}, stepPath.NewError(ErrInvalidStep)
+		}
+		elements, ok := v.value.([]Value)
+		if !ok {
+			return Value{}, stepPath.NewErrorf("cannot convert %T into []tftypes.Value", v.value)
+		}
+		for pos, element := range elements {
+			if element.Equal(Value(step)) {
+				return v.setSliceValue(stepPath, steps, elements, pos, setType.ElementType, newVal)
+			}
+		}
+		return Value{}, stepPath.NewError(ErrInvalidStep)
+	}
+
+	return Value{}, stepPath.NewErrorf("unsupported attribute path step %T", steps[0])
+}
+
+// setMapValue returns a copy of the object or map Value with the value at the
+// given key replaced by the result of setting the remaining steps in it.
+func (v Value) setMapValue(stepPath *AttributePath, steps []AttributePathStep, key string, elementType Type, newVal Value) (Value, error) {
+	values, ok := v.value.(map[string]Value)
+	if !ok {
+		return Value{}, stepPath.NewErrorf("cannot convert %T into map[string]tftypes.Value", v.value)
+	}
+
+	element, ok := values[key]
+	if !ok {
+		element = NewValue(elementType, nil)
+	}
+
+	newElement, err := element.setAtPath(stepPath, steps[1:], elementType, newVal)
+	if err != nil {
+		return Value{}, err
+	}
+
+	newValues := make(map[string]Value, len(values)+1)
+	for k, value := range values {
+		newValues[k] = value
+	}
+	newValues[key] = newElement
+
+	result, err := newValue(v.Type(), newValues)
+	if err != nil {
+		return Value{}, stepPath.NewError(err)
+	}
+	result.marks = v.marks
+	return result, nil
+}
+
+// setSliceValue returns a copy of the list, set, or tuple Value with the
+// given elements, where the element at pos is replaced by the result of
+// setting the remaining steps in it.
+func (v Value) setSliceValue(stepPath *AttributePath, steps []AttributePathStep, elements []Value, pos int, elementType Type, newVal Value) (Value, error) {
+	newElement, err := elements[pos].setAtPath(stepPath, steps[1:], elementType, newVal)
+	if err != nil {
+		return Value{}, err
+	}
+
+	newElements := make([]Value, len(elements))
+	copy(newElements, elements)
+	newElements[pos] = newElement
+
+	result, err := newValue(v.Type(), newElements)
+	if err != nil {
+		return Value{}, stepPath.NewError(err)
+	}
+	result.marks = v.marks
+	return result, nil
+}
+
+// createContainer returns an empty container Value of the same Type as the
+// null Value, as described by SetAtPath.
+func (v Value) createContainer() (Value, error) {
+	switch typ := v.Type().(type) {
+	case Object:
+		values := make(map[string]Value, len(typ.AttributeTypes))
+		for name, attributeType := range typ.AttributeTypes {
+			values[name] = NewValue(attributeType, nil)
+		}
+		return newValue(typ, values)
+	case Tuple:
+		elements := make([]Value, 0, len(typ.ElementTypes))
+		for _, elementType := range typ.ElementTypes {
+			elements = append(elements, NewValue(elementType, nil))
+		}
+		return newValue(typ, elements)
+	case Map:
+		return newValue(typ, map[string]Value{})
+	case List, Set:
+		return newValue(typ, []Value{})
+	}
+	return Value{}, fmt.Errorf("%w: cannot create value within null %s value", ErrInvalidStep, v.Type())
+}
@@ -187,6 +187,9 @@ func transform(path *AttributePath, val Value, cb func(*AttributePath, Value) (V
 		return val, err
 	}
 
+	// Rebuilt elements and attributes do not carry the marks of the Value.
+	newVal.marks = val.marks
+
 	res, err := cb(path, newVal)
 
 	if err != nil {
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftypes_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func ExampleWalk() {
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+			"tags": tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+	val := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "example"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"Team": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+	})
+
+	// find the paths of all unknown values, however deeply nested
+	err := tftypes.Walk(val, func(path *tftypes.AttributePath, v tftypes.Value) (bool, error) {
+		if !v.IsKnown() {
+			fmt.Println(path)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// AttributeName("tags").ElementKeyString("Team")
+}
+
+func ExampleTransform() {
+	listType := tftypes.List{ElementType: tftypes.String}
+	val := tftypes.NewValue(listType, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "  Hello "),
+		tftypes.NewValue(tftypes.String, nil),
+		tftypes.NewValue(tftypes.String, "World"),
+	})
+
+	// normalize every known, non-null string, however deeply nested
+	normalized, err := tftypes.Transform(val, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.Type().Is(tftypes.String) || !v.IsKnown() || v.IsNull() {
+			return v, nil
+		}
+
+		var s string
+
+		if err := v.As(&s); err != nil {
+			return v, err
+		}
+
+		return tftypes.NewValue(tftypes.String, strings.ToLower(strings.TrimSpace(s))), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(normalized)
+	// Output:
+	// tftypes.List[tftypes.String]<tftypes.String<"hello">, tftypes.String<null>, tftypes.String<"world">>
+}